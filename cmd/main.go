@@ -2,26 +2,107 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/MicahParks/keyfunc"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/holycann/itsrama-portfolio-backend/configs"
+	"github.com/holycann/itsrama-portfolio-backend/internal/accessibility"
+	"github.com/holycann/itsrama-portfolio-backend/internal/activitypub"
+	"github.com/holycann/itsrama-portfolio-backend/internal/admin_session"
+	"github.com/holycann/itsrama-portfolio-backend/internal/analytics"
+	"github.com/holycann/itsrama-portfolio-backend/internal/anomaly"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/bootstrap"
+	"github.com/holycann/itsrama-portfolio-backend/internal/bot"
+	"github.com/holycann/itsrama-portfolio-backend/internal/case_study"
+	"github.com/holycann/itsrama-portfolio-backend/internal/changelog"
+	"github.com/holycann/itsrama-portfolio-backend/internal/chat"
+	"github.com/holycann/itsrama-portfolio-backend/internal/coding_activity"
+	"github.com/holycann/itsrama-portfolio-backend/internal/collection"
+	"github.com/holycann/itsrama-portfolio-backend/internal/command_index"
+	"github.com/holycann/itsrama-portfolio-backend/internal/company"
+	"github.com/holycann/itsrama-portfolio-backend/internal/contract_fixtures"
+	"github.com/holycann/itsrama-portfolio-backend/internal/cors_policy"
+	"github.com/holycann/itsrama-portfolio-backend/internal/deprecation"
+	"github.com/holycann/itsrama-portfolio-backend/internal/diagnostics"
+	"github.com/holycann/itsrama-portfolio-backend/internal/duplicates"
+	"github.com/holycann/itsrama-portfolio-backend/internal/embed"
+	"github.com/holycann/itsrama-portfolio-backend/internal/endorsement"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
 	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experiment"
 	"github.com/holycann/itsrama-portfolio-backend/internal/health"
+	"github.com/holycann/itsrama-portfolio-backend/internal/image_proxy"
+	"github.com/holycann/itsrama-portfolio-backend/internal/importer"
+	"github.com/holycann/itsrama-portfolio-backend/internal/indieauth"
+	"github.com/holycann/itsrama-portfolio-backend/internal/inquiry"
+	"github.com/holycann/itsrama-portfolio-backend/internal/integrity"
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
+	"github.com/holycann/itsrama-portfolio-backend/internal/linkcheck"
+	"github.com/holycann/itsrama-portfolio-backend/internal/log_level"
+	"github.com/holycann/itsrama-portfolio-backend/internal/mail"
+	"github.com/holycann/itsrama-portfolio-backend/internal/maintenance"
+	"github.com/holycann/itsrama-portfolio-backend/internal/media"
 	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now_playing"
+	"github.com/holycann/itsrama-portfolio-backend/internal/offering"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/portal"
+	"github.com/holycann/itsrama-portfolio-backend/internal/profile_stats"
 	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/public_stats"
+	"github.com/holycann/itsrama-portfolio-backend/internal/recruiter"
+	"github.com/holycann/itsrama-portfolio-backend/internal/redirect"
 	"github.com/holycann/itsrama-portfolio-backend/internal/response"
 	"github.com/holycann/itsrama-portfolio-backend/internal/routes"
+	"github.com/holycann/itsrama-portfolio-backend/internal/search"
+	"github.com/holycann/itsrama-portfolio-backend/internal/shutdown"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/sitemap"
+	"github.com/holycann/itsrama-portfolio-backend/internal/storage_usage"
 	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/internal/uses"
+	"github.com/holycann/itsrama-portfolio-backend/internal/webmention"
+	activitypubclient "github.com/holycann/itsrama-portfolio-backend/pkg/activitypub"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/antispam"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/diagram"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/embedding"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/exchangerate"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/fixtures"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/gemini"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/geoip"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/icons"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/mailer"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/profilestats"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/screenshot"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/snippet"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/spotify"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/stripe"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/telegram"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/urlcheck"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/wakatime"
+	webmentionclient "github.com/holycann/itsrama-portfolio-backend/pkg/webmention"
 
 	_ "github.com/holycann/itsrama-portfolio-backend/docs"
 	swaggerFiles "github.com/swaggo/files"
@@ -48,20 +129,200 @@ import (
 type AppDependencies struct {
 	Config        *configs.Config
 	Logger        *logger.Logger
-	JWKS          *keyfunc.JWKS
+	AccessLogger  *logger.Logger
+	JWKS          *middleware.JWKSLoader
 	JWTMiddleware *middleware.Middleware
 	Router        *gin.Engine
+	EventBus      *events.Bus
+
+	// Challenge guard for unauthenticated write endpoints
+	ChallengeGuard *middleware.ChallengeGuard
+
+	// CORS policy whose allowed origins can change at runtime, nil when
+	// CORS is disabled
+	CORSPolicy *middleware.CORSPolicy
+
+	// Per-environment exposure of tagged route groups
+	RouteExposure *routes.Exposure
+
+	// Deprecated routes and the traffic they still receive
+	Deprecations *middleware.Deprecations
+
+	// Counts of database calls and requests above their latency thresholds
+	SlowQueries  *slowcall.Tracker
+	SlowRequests *slowcall.Tracker
 
 	// Supabase Dependencies
 	SupabaseDefault *supabase.SupabaseClient
 	SupabaseAuth    *supabase.SupabaseAuth
-	SupabaseStorage *supabase.SupabaseStorage
+
+	// Object store for uploaded and generated files
+	Storage storage.Storage
+
+	// Direct Postgres connection, set when DB_DRIVER is postgres
+	Database *database.DB
+
+	// Seed data of the in-memory repositories, set in development mode
+	Fixtures *devFixtures
+}
+
+// devFixtures holds the rows the in-memory repositories are seeded with in
+// development mode
+type devFixtures struct {
+	Tenants              []tenant.Tenant
+	TechStacks           []tech_stack.TechStack
+	Projects             []project.Project
+	ProjectTechStacks    []project.ProjectTechStack
+	Experiences          []experience.Experience
+	ExperienceTechStacks []experience.ExperienceTechStack
 }
 
 type FeatureDependencies struct {
 	// Health Dependencies
 	HealthHandler *health.HealthHandler
 
+	// Event Dependencies
+	EventHandler *events.EventHandler
+
+	// Tenant Dependencies
+	TenantHandler    *tenant.TenantHandler
+	TenantService    *tenant.TenantService
+	TenantRepository *tenant.TenantRepository
+	TenantResolver   *tenant.Resolver
+
+	// Mail Dependencies
+	MailHandler    *mail.MailHandler
+	MailService    *mail.MailService
+	MailRepository *mail.MailDeliveryRepository
+	MailQueue      *mail.Queue
+
+	// Job Queue Dependencies
+	JobHandler *jobs.JobHandler
+	JobService *jobs.JobService
+	JobWorker  *jobs.Worker
+
+	// Import Dependencies
+	ImportHandler *importer.ImportHandler
+
+	// Duplicates Dependencies
+	DuplicatesHandler *duplicates.DuplicatesHandler
+
+	// Maintenance Dependencies
+	MaintenanceHandler *maintenance.MaintenanceHandler
+
+	// Notification Dependencies
+	NotificationHandler *notification.NotificationHandler
+	NotificationService *notification.NotificationService
+
+	// Anomaly Detection Dependencies
+	AnomalyDetector *anomaly.Detector
+
+	// Admin Session Dependencies
+	AdminSessionHandler *admin_session.SessionHandler
+	AdminSessionService *admin_session.SessionService
+
+	// Analytics Dependencies
+	AnalyticsHandler    *analytics.AnalyticsHandler
+	AnalyticsService    *analytics.AnalyticsService
+	AnalyticsRepository *analytics.AnalyticsRepository
+	GeoLocator          geoip.Locator
+	AnalyticsRetention  *analytics.RetentionJob
+	FunnelService       *analytics.FunnelService
+	EngagementService   *analytics.EngagementService
+
+	// Image Proxy Dependencies
+	ImageProxyHandler *image_proxy.ImageProxyHandler
+	ImageProxyService *image_proxy.ImageProxyService
+
+	// Asset Dependencies
+	AssetHandler *asset.AssetHandler
+	AssetService *asset.AssetService
+
+	// Changelog Dependencies
+	ChangelogHandler    *changelog.ChangelogHandler
+	ChangelogService    *changelog.ChangelogService
+	ChangelogRepository *changelog.ChangelogRepository
+
+	// Now Dependencies
+	NowHandler    *now.NowHandler
+	NowService    *now.NowService
+	NowRepository *now.NowRepository
+
+	// Page Dependencies
+	PageHandler *page.PageHandler
+	PageService *page.PageService
+
+	// Redirect Dependencies
+	RedirectHandler *redirect.RedirectHandler
+	RedirectService *redirect.RedirectService
+
+	// Collection Dependencies
+	CollectionHandler *collection.CollectionHandler
+	CollectionService *collection.CollectionService
+
+	// Experiment Dependencies
+	ExperimentHandler *experiment.ExperimentHandler
+	ExperimentService *experiment.ExperimentService
+
+	// Now Playing Dependencies
+	NowPlayingHandler *now_playing.NowPlayingHandler
+	NowPlayingService *now_playing.NowPlayingService
+
+	// Coding Activity Dependencies
+	CodingActivityHandler    *coding_activity.CodingActivityHandler
+	CodingActivityService    *coding_activity.CodingActivityService
+	CodingActivityRepository *coding_activity.CodingActivityRepository
+	CodingActivityJob        *coding_activity.Job
+
+	// Profile Stats Dependencies
+	ProfileStatHandler    *profile_stats.ProfileStatHandler
+	ProfileStatService    *profile_stats.ProfileStatService
+	ProfileStatRepository *profile_stats.ProfileStatRepository
+	ProfileStatJob        *profile_stats.Job
+
+	// Public Stats Dependencies
+	PublicStatsHandler *public_stats.PublicStatsHandler
+	PublicStatsService *public_stats.PublicStatsService
+
+	// Offering Dependencies
+	OfferingHandler        *offering.OfferingHandler
+	OfferingService        *offering.OfferingService
+	OfferingRepository     *offering.OfferingRepository
+	ExchangeRateService    *offering.ExchangeRateService
+	ExchangeRateRepository *offering.ExchangeRateRepository
+	ExchangeRateJob        *offering.RateJob
+
+	// Inquiry Dependencies
+	InquiryHandler     *inquiry.InquiryHandler
+	InquiryService     *inquiry.InquiryService
+	InquiryRepository  *inquiry.InquiryRepository
+	ProposalService    *inquiry.ProposalService
+	ProposalRepository *inquiry.ProposalRepository
+	PaymentService     *inquiry.PaymentService
+	PaymentRepository  *inquiry.PaymentRepository
+	InquiryRateLimiter *middleware.RateLimiter
+
+	// Portal Dependencies
+	PortalHandler         *portal.PortalHandler
+	PortalService         *portal.PortalService
+	PortalTokenRepository *portal.TokenRepository
+	PortalRateLimiter     *middleware.RateLimiter
+
+	// Uses Dependencies
+	UsesHandler    *uses.UsesHandler
+	UsesService    *uses.UsesService
+	UsesRepository *uses.UsesRepository
+
+	// Site Config Dependencies
+	SiteConfigHandler    *site_config.SiteConfigHandler
+	SiteConfigService    *site_config.SiteConfigService
+	SiteConfigRepository *site_config.SiteConfigRepository
+
+	// Company Dependencies
+	CompanyHandler    *company.CompanyHandler
+	CompanyService    *company.CompanyService
+	CompanyRepository *company.CompanyRepository
+
 	// Experience Dependencies
 	ExperienceHandler    *experience.ExperienceHandler
 	ExperienceService    *experience.ExperienceService
@@ -71,11 +332,90 @@ type FeatureDependencies struct {
 	ProjectHandler    *project.ProjectHandler
 	ProjectService    *project.ProjectService
 	ProjectRepository *project.ProjectRepository
+	ProjectShare      *project.ShareSigner
+
+	// Recruiter Dependencies
+	RecruiterHandler     *recruiter.RecruiterHandler
+	RecruiterService     *recruiter.RecruiterService
+	RecruiterRateLimiter *middleware.RateLimiter
+
+	// Search Dependencies
+	SearchHandler       *search.SearchHandler
+	SearchService       *search.SearchService
+	EmbeddingRepository *search.EmbeddingRepository
+	SearchJob           *search.Job
+
+	// Chat Dependencies
+	ChatHandler     *chat.ChatHandler
+	ChatService     *chat.ChatService
+	ChatRateLimiter *middleware.RateLimiter
+
+	// Sitemap Dependencies
+	SitemapHandler *sitemap.SitemapHandler
+
+	// Command Index Dependencies
+	CommandIndexHandler *command_index.CommandIndexHandler
+	CommandIndexService *command_index.CommandIndexService
+
+	// Embed Dependencies
+	EmbedHandler *embed.EmbedHandler
+	EmbedService *embed.EmbedService
+
+	// Case Study Dependencies
+	CaseStudyHandler *case_study.CaseStudyHandler
+	CaseStudyService *case_study.CaseStudyService
+
+	// Webmention Dependencies
+	WebmentionHandler     *webmention.WebmentionHandler
+	WebmentionService     *webmention.WebmentionService
+	WebmentionJob         *webmention.Job
+	WebmentionRateLimiter *middleware.RateLimiter
+
+	// ActivityPub Dependencies
+	ActivityPubHandler     *activitypub.ActivityPubHandler
+	ActivityPubService     *activitypub.ActivityPubService
+	ActivityPubJob         *activitypub.Job
+	ActivityPubRateLimiter *middleware.RateLimiter
+
+	// IndieAuth Dependencies
+	IndieAuthHandler     *indieauth.IndieAuthHandler
+	IndieAuthService     *indieauth.IndieAuthService
+	IndieAuthRateLimiter *middleware.RateLimiter
+
+	// Link Check Dependencies
+	LinkCheckHandler *linkcheck.LinkCheckHandler
+	LinkCheckService *linkcheck.LinkCheckService
+	LinkCheckJob     *linkcheck.Job
+
+	// Accessibility Dependencies
+	AccessibilityHandler *accessibility.AccessibilityHandler
+	AccessibilityJob     *accessibility.Job
+
+	// Integrity Dependencies
+	IntegrityHandler *integrity.IntegrityHandler
+
+	// Media Dependencies
+	MediaHandler *media.MediaHandler
+
+	// Storage Usage Dependencies
+	StorageUsageHandler *storage_usage.StorageUsageHandler
 
 	// Tech Stack Dependencies
 	TechStackHandler    *tech_stack.TechStackHandler
 	TechStackService    *tech_stack.TechStackService
 	TechStackRepository *tech_stack.TechStackRepository
+
+	// Endorsement Dependencies
+	EndorsementHandler     *endorsement.EndorsementHandler
+	EndorsementService     *endorsement.EndorsementService
+	EndorsementRateLimiter *middleware.RateLimiter
+
+	// Telegram Bot Dependencies
+	TelegramBot *bot.Bot
+
+	// Concurrency limits of expensive endpoint classes
+	AILimiter     *middleware.ConcurrencyLimiter
+	RenderLimiter *middleware.ConcurrencyLimiter
 }
 
 func main() {
@@ -89,24 +429,88 @@ func main() {
 		}
 	}()
 
+	// Parse command line flags
+	devMode := flag.Bool("dev", false, "Run without external services, serving fixtures from memory")
+	checkOnly := flag.Bool("check", false, "Verify the configuration and external dependencies, then exit")
+	flag.Parse()
+
 	// Initialize dependencies
-	deps, err := initializeAppDependencies()
+	deps, err := initializeAppDependencies(*devMode)
 	if err != nil {
-		fmt.Printf("Failed to initialize dependencies: %v\n", err)
+		fmt.Printf("Failed to initialize dependencies:\n%v\n", err)
 		os.Exit(1)
 	}
 	defer cleanupAppDependencies(deps)
 
 	// Initialize dependencies
-	featureDeps, err := initializeFeatureDependencies(deps.SupabaseDefault, *deps.SupabaseStorage, deps.Config, deps.Logger)
+	featureDeps, err := initializeFeatureDependencies(deps.SupabaseDefault, deps.Database, deps.Fixtures, deps.JWKS, deps.Storage, deps.EventBus, deps.Config, deps.Logger)
 	if err != nil {
 		fmt.Printf("Failed to initialize dependencies: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Verify external dependencies. Failures are reported all at once; the
+	// server still starts degraded, while --check exits with an error.
+	report := bootstrap.Run(ctx, 15*time.Second, startupChecks(deps)...)
+	if *checkOnly {
+		fmt.Print(report.String())
+		if err := report.Err(); err != nil {
+			fmt.Println(err)
+			featureDeps.GeoLocator.Close()
+			cleanupAppDependencies(deps)
+			os.Exit(1)
+		}
+		featureDeps.GeoLocator.Close()
+		return
+	}
+	report.Log(deps.Logger)
+
 	// Setup routes
 	setupRoutes(deps, featureDeps)
 
+	// Load JWKS keys in the background
+	if deps.JWKS != nil {
+		deps.JWKS.Start(ctx)
+	}
+
+	// Start background workers
+	featureDeps.MailQueue.Start(ctx)
+	featureDeps.JobWorker.Start(ctx)
+	featureDeps.AnalyticsRetention.Start(ctx)
+
+	if featureDeps.AnomalyDetector != nil {
+		featureDeps.AnomalyDetector.Start(ctx)
+	}
+
+	if featureDeps.LinkCheckJob != nil {
+		featureDeps.LinkCheckJob.Start(ctx)
+	}
+	if featureDeps.AccessibilityJob != nil {
+		featureDeps.AccessibilityJob.Start(ctx)
+	}
+	if featureDeps.SearchJob != nil {
+		featureDeps.SearchJob.Start(ctx)
+	}
+	if featureDeps.WebmentionJob != nil {
+		featureDeps.WebmentionJob.Start(ctx)
+	}
+	if featureDeps.ActivityPubJob != nil {
+		featureDeps.ActivityPubJob.Start(ctx)
+	}
+	if featureDeps.CodingActivityJob != nil {
+		featureDeps.CodingActivityJob.Start(ctx)
+	}
+	if featureDeps.ProfileStatJob != nil {
+		featureDeps.ProfileStatJob.Start(ctx)
+	}
+	if featureDeps.ExchangeRateJob != nil {
+		featureDeps.ExchangeRateJob.Start(ctx)
+	}
+
+	if featureDeps.TelegramBot != nil {
+		featureDeps.TelegramBot.Start(ctx)
+	}
+
 	// Start server
 	server := createHTTPServer(deps)
 
@@ -114,29 +518,58 @@ func main() {
 	go startServer(server, deps.Logger, deps.Config)
 
 	// Wait for shutdown signal
-	waitForShutdown(server, deps.Logger, deps.Config)
+	waitForShutdown(shutdownSequence(server, deps, featureDeps), deps.Logger, deps.Config)
 }
 
 // initializeDependencies sets up all application dependencies
-func initializeAppDependencies() (*AppDependencies, error) {
+func initializeAppDependencies(devMode bool) (*AppDependencies, error) {
 	// Load configuration
 	cfg, err := configs.LoadConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	// Development mode replaces external services
+	var devData *devFixtures
+	if devMode || cfg.Dev.Enabled {
+		devData, err = initializeDevMode(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize development mode: %w", err)
+		}
+	}
+
+	// Validate configuration, reporting every problem at once
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+
 	// Initialize logging
 	appLogger := initializeLogger(cfg)
+	accessLogger := initializeAccessLogger(cfg)
+
+	// Dependencies that fail to initialize are collected and reported
+	// together
+	var failures []error
+
+	// Initialize slow call tracking
+	slowQueries := slowcall.NewTracker("query", cfg.Diagnostics.SlowQueryThreshold, appLogger)
+	slowRequests := slowcall.NewTracker("request", cfg.Diagnostics.SlowRequestThreshold, appLogger)
 
 	// Initialize Supabase default schema client
-	supabaseDefault, err := supabase.NewSupabaseClient(supabase.SupabaseClientConfig{
+	supabaseConfig := supabase.SupabaseClientConfig{
 		ApiSecret: cfg.Supabase.ApiSecretKey,
 		ProjectID: cfg.Supabase.ProjectID,
 		Schema:    cfg.Database.Schema,
-	})
+	}
+	if cfg.Dev.Enabled {
+		supabaseConfig.URL = cfg.Dev.SupabaseURL
+	}
+	if cfg.Diagnostics.SlowQueryThreshold > 0 {
+		supabaseConfig.SlowQueries = slowQueries
+	}
+	supabaseDefault, err := supabase.NewSupabaseClient(supabaseConfig)
 	if err != nil {
-		appLogger.Error("Failed to initialize Supabase client with default schema", "error", err)
-		os.Exit(1)
+		failures = append(failures, fmt.Errorf("supabase client: %w", err))
 	}
 
 	// Initialize Supabase authentication
@@ -145,27 +578,20 @@ func initializeAppDependencies() (*AppDependencies, error) {
 		ProjectID: cfg.Supabase.ProjectID,
 	})
 	if err != nil {
-		appLogger.Error(err.Error())
-		os.Exit(1)
+		failures = append(failures, fmt.Errorf("supabase auth: %w", err))
 	}
 
-	// Initialize Supabase authentication
-	supabaseStorage, err := supabase.NewSupabaseStorage(supabase.StorageConfig{
-		ProjectID:           cfg.Supabase.ProjectID,
-		JwtApiSecret:        cfg.Supabase.JWTSecret,
-		BucketID:            cfg.Supabase.StorageBucketID,
-		DefaultFolder:       cfg.Supabase.DefaultStorageFolder,
-		MaxFileSize:         cfg.Supabase.MaxFileSize,
-		AllowedFileTypes:    cfg.Supabase.AllowedFileTypes,
-		DefaultCacheControl: cfg.Supabase.CacheControl,
-	})
+	// Initialize direct database connection
+	db, err := initializeDatabase(cfg, slowQueries)
 	if err != nil {
-		appLogger.Error(err.Error())
-		os.Exit(1)
+		failures = append(failures, fmt.Errorf("database: %w", err))
 	}
 
-	// Initialize JWKS
-	jwks := initializeJWKS(cfg, appLogger)
+	// Initialize storage backend
+	fileStorage, err := initializeStorage(cfg)
+	if err != nil {
+		failures = append(failures, fmt.Errorf("storage: %w", err))
+	}
 
 	// Allowed Emails For Backend Access
 	allowedEmails := []string{
@@ -173,47 +599,898 @@ func initializeAppDependencies() (*AppDependencies, error) {
 		"muhamad.ramadhan.dev@gmail.com",
 	}
 
-	// Initialize JWT middleware
-	jwtMiddleware := initializeJWTMiddleware(jwks, allowedEmails, appLogger)
+	// Initialize JWKS and JWT middleware, which development mode skips
+	var jwks *middleware.JWKSLoader
+	var jwtMiddleware *middleware.Middleware
+	if cfg.Dev.Enabled {
+		appLogger.Warn("Development mode: admin routes accept requests without a token")
+		jwtMiddleware = middleware.NewDevMiddleware(allowedEmails, appLogger)
+	} else {
+		jwks = initializeJWKS(cfg, appLogger)
+		jwtMiddleware = initializeJWTMiddleware(jwks, allowedEmails, appLogger)
+	}
+
+	// Initialize challenge guard
+	challengeGuard, err := middleware.NewChallengeGuard(middleware.ChallengeConfig{
+		Enabled:       cfg.Challenge.Enabled,
+		Secret:        cfg.Challenge.Secret,
+		Difficulty:    cfg.Challenge.Difficulty,
+		TTL:           cfg.Challenge.TTL,
+		MinSolveTime:  cfg.Challenge.MinSolveTime,
+		HoneypotField: cfg.Challenge.HoneypotField,
+	}, appLogger)
+	if err != nil {
+		failures = append(failures, fmt.Errorf("challenge guard: %w", err))
+	}
+
+	// Initialize CORS policy
+	corsPolicy, err := initializeCORSPolicy(cfg)
+	if err != nil {
+		failures = append(failures, fmt.Errorf("cors policy: %w", err))
+	}
+
+	// Initialize route exposure
+	routeExposure, err := routes.NewExposure(routes.ExposureConfig{
+		Restricted:      cfg.Routes.Restricted(cfg.Environment),
+		Hidden:          cfg.Routes.HiddenTags,
+		Gated:           cfg.Routes.GatedTags,
+		AllowedNetworks: cfg.Routes.GateAllowedNetworks,
+	}, appLogger)
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	// Initialize route deprecations
+	deprecations, err := middleware.NewDeprecations(middleware.DeprecationConfig{
+		Entries:        cfg.Routes.Deprecated,
+		SunsetEnforced: cfg.Routes.SunsetEnforced,
+		QuietDays:      cfg.Routes.DeprecationQuietDays,
+	}, appLogger)
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	if len(failures) > 0 {
+		if db != nil {
+			db.Close()
+		}
+		return nil, errors.Join(failures...)
+	}
 
 	// Setup Gin router
-	router := initializeRouter(appLogger, cfg)
+	router := initializeRouter(accessLogger, cfg, corsPolicy)
+
+	// Initialize event bus for domain events
+	eventBus := events.NewBus(cfg.Events.HistorySize, cfg.Events.MaxConnections)
 
 	return &AppDependencies{
 		Config:          cfg,
 		Logger:          appLogger,
+		AccessLogger:    accessLogger,
 		SupabaseDefault: supabaseDefault,
 		SupabaseAuth:    supabaseAuth,
-		SupabaseStorage: supabaseStorage,
+		Storage:         fileStorage,
+		Database:        db,
+		Fixtures:        devData,
 		JWKS:            jwks,
 		JWTMiddleware:   jwtMiddleware,
 		Router:          router,
+		EventBus:        eventBus,
+		ChallengeGuard:  challengeGuard,
+		CORSPolicy:      corsPolicy,
+		RouteExposure:   routeExposure,
+		Deprecations:    deprecations,
+		SlowQueries:     slowQueries,
+		SlowRequests:    slowRequests,
 	}, nil
 }
 
-func initializeFeatureDependencies(supabaseDefault *supabase.SupabaseClient, supabaseStorage supabase.SupabaseStorage, cfg *configs.Config, appLogger *logger.Logger) (*FeatureDependencies, error) {
+func initializeFeatureDependencies(supabaseDefault *supabase.SupabaseClient, db *database.DB, devData *devFixtures, jwks *middleware.JWKSLoader, fileStorage storage.Storage, eventBus *events.Bus, cfg *configs.Config, appLogger *logger.Logger) (*FeatureDependencies, error) {
 	// Initialize health dependencies
-	healthHandler := health.NewHealthHandler(supabaseDefault.GetClient())
+	var keySource health.KeySource
+	if jwks != nil {
+		keySource = jwks
+	}
+	healthHandler := health.NewHealthHandler(supabaseDefault.GetClient(), keySource)
+
+	// Initialize event dependencies
+	eventHandler := events.NewEventHandler(eventBus, cfg.Events.KeepAliveInterval, appLogger)
+
+	// Initialize tenant dependencies
+	var tenantRepo tenant.TenantRepository
+	var tenantDomainRepo tenant.TenantDomainRepository
+	if devData != nil {
+		tenantRepo = tenant.NewMemoryTenantRepository(devData.Tenants)
+		tenantDomainRepo = tenant.NewMemoryTenantDomainRepository(nil)
+	} else {
+		tenantRepo = tenant.NewTenantRepository(supabaseDefault)
+		tenantDomainRepo = tenant.NewTenantDomainRepository(supabaseDefault)
+	}
+	tenantService := tenant.NewTenantService(tenantRepo)
+	tenantDomainService := tenant.NewTenantDomainService(tenantDomainRepo, tenantService, cfg.Tenant.DomainVerifyPrefix)
+	tenantResolver := tenant.NewResolver(tenantService, tenantDomainService, cfg.Tenant.Header, cfg.Tenant.BaseDomain, cfg.Tenant.CacheTTL)
+	tenantHandler := tenant.NewTenantHandler(tenantService, tenantDomainService, tenantResolver, appLogger)
+
+	// Initialize mail dependencies
+	appMailer, err := mailer.NewMailer(mailer.Config{
+		Provider: cfg.Mailer.Provider,
+		From:     cfg.Mailer.From,
+		ReplyTo:  cfg.Mailer.ReplyTo,
+		SMTP: mailer.SMTPConfig{
+			Host:     cfg.Mailer.SMTPHost,
+			Port:     cfg.Mailer.SMTPPort,
+			Username: cfg.Mailer.SMTPUsername,
+			Password: cfg.Mailer.SMTPPassword,
+		},
+		Resend: mailer.ResendConfig{
+			APIKey: cfg.Mailer.ResendAPIKey,
+		},
+		SES: mailer.SESConfig{
+			Region:          cfg.Mailer.SESRegion,
+			AccessKeyID:     cfg.Mailer.SESAccessKeyID,
+			SecretAccessKey: cfg.Mailer.SESSecretAccessKey,
+		},
+		Logger: appLogger,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize mailer: %w", err)
+	}
+	mailRepo := mail.NewMailDeliveryRepository(supabaseDefault)
+	mailService := mail.NewMailService(mailRepo, appMailer, cfg.Mailer.MaxAttempts, cfg.Mailer.RetryInterval, cfg.Mailer.BatchSize)
+	mailQueue := mail.NewQueue(mailService, cfg.Mailer.RetryInterval, appLogger)
+	mailHandler := mail.NewMailHandler(mailService, appLogger)
+
+	// Initialize job queue dependencies. Jobs run in the scope of the tenant
+	// that queued them.
+	jobRepo := jobs.NewJobRepository(supabaseDefault)
+	jobService := jobs.NewJobService(jobRepo, func(ctx context.Context, tenantID uuid.UUID) (base.TenantScope, error) {
+		t, err := tenantService.GetTenantByID(ctx, tenantID.String())
+		if err != nil {
+			return base.TenantScope{}, err
+		}
+		return t.Scope(), nil
+	}, eventBus, jobs.Options{
+		MaxAttempts:   cfg.Jobs.MaxAttempts,
+		RetryInterval: cfg.Jobs.RetryInterval,
+		Lease:         cfg.Jobs.Lease,
+		BatchSize:     cfg.Jobs.BatchSize,
+	})
+	jobWorker := jobs.NewWorker(jobService, cfg.Jobs.Workers, cfg.Jobs.PollInterval, appLogger)
+	jobHandler := jobs.NewJobHandler(jobService, appLogger)
+
+	// Initialize spam filter dependencies
+	spamFilter, err := antispam.NewFilterFromConfig(antispam.Config{
+		Engines:   cfg.Antispam.Engines,
+		Threshold: cfg.Antispam.Threshold,
+		Heuristic: antispam.HeuristicConfig{
+			Keywords: cfg.Antispam.Keywords,
+			MaxLinks: cfg.Antispam.MaxLinks,
+		},
+		Akismet: antispam.AkismetConfig{
+			APIKey: cfg.Antispam.AkismetAPIKey,
+			Blog:   cfg.Antispam.AkismetBlog,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize spam filter: %w", err)
+	}
+
+	// Initialize notification dependencies
+	notificationChannelRepo := notification.NewChannelRepository(supabaseDefault)
+	notificationLogRepo := notification.NewLogRepository(supabaseDefault)
+	notificationService := notification.NewNotificationService(notificationChannelRepo, notificationLogRepo, mailService, appLogger)
+	notificationHandler := notification.NewNotificationHandler(notificationService, appLogger)
+
+	// Initialize anomaly detection dependencies
+	var anomalyDetector *anomaly.Detector
+	if cfg.Anomaly.Enabled {
+		anomalyDetector = anomaly.NewDetector(anomaly.Thresholds{
+			Window:          cfg.Anomaly.Window,
+			BaselineWindows: cfg.Anomaly.BaselineWindows,
+			MinRequests:     cfg.Anomaly.MinRequests,
+			DeviationFactor: cfg.Anomaly.DeviationFactor,
+			ErrorRate:       cfg.Anomaly.ErrorRate,
+			AuthFailureRate: cfg.Anomaly.AuthFailureRate,
+			Cooldown:        cfg.Anomaly.Cooldown,
+		}, notificationService, appLogger)
+	}
+
+	// Initialize admin session dependencies
+	var adminSessionRepo admin_session.SessionRepository
+	if devData != nil {
+		adminSessionRepo = admin_session.NewMemorySessionRepository()
+	} else {
+		adminSessionRepo = admin_session.NewSessionRepository(supabaseDefault)
+	}
+	adminSessionService := admin_session.NewSessionService(adminSessionRepo, notificationService)
+	adminSessionHandler := admin_session.NewSessionHandler(adminSessionService, appLogger)
+
+	// Initialize storage usage dependencies. Every feature below writes
+	// through the tracked storage.
+	var storedFileRepo storage_usage.StoredFileRepository
+	if devData != nil {
+		storedFileRepo = storage_usage.NewMemoryStoredFileRepository()
+	} else {
+		storedFileRepo = storage_usage.NewStoredFileRepository(supabaseDefault)
+	}
+	storageUsageService := storage_usage.NewStorageUsageService(storedFileRepo, cfg.Storage.QuotaBytes)
+	storageUsageHandler := storage_usage.NewStorageUsageHandler(storageUsageService, appLogger)
+	fileStorage = storage_usage.NewTrackedStorage(fileStorage, storageUsageService, cfg.Supabase.DefaultStorageFolder, appLogger)
+
+	// Initialize image proxy dependencies
+	imageCache, err := image_proxy.NewCache(cfg.ImageProxy.CacheBackend, cfg.ImageProxy.CacheDir, fileStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize image cache: %w", err)
+	}
+	imageProxyService := image_proxy.NewImageProxyService(fileStorage, imageCache)
+	imageProxyHandler := image_proxy.NewImageProxyHandler(imageProxyService, cfg.ImageProxy.CacheMaxAge, appLogger)
+
+	// Initialize asset dependencies
+	assetRepo := asset.NewAssetRepository(supabaseDefault)
+	assetService := asset.NewAssetService(assetRepo, fileStorage)
+	assetHandler := asset.NewAssetHandler(assetService, appLogger)
+
+	// Initialize changelog dependencies; content services publish through
+	// the changelog so that their events are recorded as entries
+	changelogRepo := changelog.NewChangelogRepository(supabaseDefault)
+	changelogService := changelog.NewChangelogService(changelogRepo, cfg.Changelog.CoalesceWindow)
+	changelogHandler := changelog.NewChangelogHandler(changelogService, appLogger)
+	var contentPublisher events.Publisher = eventBus
+	if cfg.Changelog.AutoRecord {
+		contentPublisher = changelog.NewPublisher(eventBus, changelogService)
+	}
+
+	// Content services publish through the command index cache too, so the
+	// index of the tenant is generated again after its content changes
+	commandIndexCache := command_index.NewCache()
+	contentPublisher = command_index.NewPublisher(contentPublisher, commandIndexCache)
+
+	// Related projects are ranked again after the projects of a tenant change
+	relatedCache := search.NewRelatedCache()
+	contentPublisher = search.NewRelatedPublisher(contentPublisher, relatedCache)
+
+	// Deleted projects are dropped from the collections holding them
+	var collectionRepo collection.CollectionRepository
+	if devData != nil {
+		collectionRepo = collection.NewMemoryCollectionRepository()
+	} else {
+		collectionRepo = collection.NewCollectionRepository(supabaseDefault)
+	}
+	contentPublisher = collection.NewPublisher(contentPublisher, collectionRepo)
+
+	// Initialize now dependencies
+	nowRepo := now.NewNowRepository(supabaseDefault)
+	nowService := now.NewNowService(nowRepo)
+	nowHandler := now.NewNowHandler(nowService, appLogger)
+
+	// Initialize page dependencies
+	var pageRepo page.PageRepository
+	var pageRevisionRepo page.RevisionRepository
+	var pagePreviewRepo page.PreviewRepository
+	if devData != nil {
+		pageRepo = page.NewMemoryPageRepository()
+		pageRevisionRepo = page.NewMemoryRevisionRepository()
+		pagePreviewRepo = page.NewMemoryPreviewRepository()
+	} else {
+		pageRepo = page.NewPageRepository(supabaseDefault)
+		pageRevisionRepo = page.NewRevisionRepository(supabaseDefault)
+		pagePreviewRepo = page.NewPreviewRepository(supabaseDefault)
+	}
+	pagePreviewSecret, err := signingSecret(cfg.PagePreview.Secret, "PAGE_PREVIEW_SECRET", appLogger)
+	if err != nil {
+		return nil, err
+	}
+	pagePreviewSigner := page.NewPreviewSigner(pagePreviewSecret, cfg.PagePreview.BaseURL, cfg.PagePreview.TTL)
+	pageService := page.NewPageService(pageRepo, pageRevisionRepo, pagePreviewRepo, pagePreviewSigner, contentPublisher)
+	pageHandler := page.NewPageHandler(pageService, appLogger)
+
+	// Initialize redirect dependencies
+	var redirectRepo redirect.RedirectRepository
+	if devData != nil {
+		redirectRepo = redirect.NewMemoryRedirectRepository()
+	} else {
+		redirectRepo = redirect.NewRedirectRepository(supabaseDefault)
+	}
+	redirectService := redirect.NewRedirectService(redirectRepo)
+	redirectHandler := redirect.NewRedirectHandler(redirectService, appLogger)
+
+	// Initialize experiment dependencies
+	var experimentRepo experiment.ExperimentRepository
+	var experimentEventRepo experiment.EventRepository
+	if devData != nil {
+		experimentRepo = experiment.NewMemoryExperimentRepository()
+		experimentEventRepo = experiment.NewMemoryEventRepository()
+	} else {
+		experimentRepo = experiment.NewExperimentRepository(supabaseDefault)
+		experimentEventRepo = experiment.NewEventRepository(supabaseDefault)
+	}
+	experimentService := experiment.NewExperimentService(experimentRepo, experimentEventRepo)
+	experimentHandler := experiment.NewExperimentHandler(experimentService, appLogger)
+
+	// Initialize now playing dependencies
+	var spotifyClient *spotify.Client
+	if cfg.Spotify.Enabled {
+		spotifyClient, err = spotify.NewClient(cfg.Spotify.ClientID, cfg.Spotify.ClientSecret, cfg.Spotify.RefreshToken, cfg.Spotify.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize spotify client: %w", err)
+		}
+	}
+	nowPlayingService := now_playing.NewNowPlayingService(spotifyClient, cfg.Spotify.CacheTTL)
+	nowPlayingHandler := now_playing.NewNowPlayingHandler(nowPlayingService, appLogger)
+
+	// Initialize coding activity dependencies
+	var wakatimeClient *wakatime.Client
+	if cfg.WakaTime.Enabled {
+		wakatimeClient, err = wakatime.NewClient(cfg.WakaTime.APIKey, cfg.WakaTime.APIURL, cfg.WakaTime.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize wakatime client: %w", err)
+		}
+	}
+	codingActivityRepo := coding_activity.NewCodingActivityRepository(supabaseDefault)
+	codingActivityService := coding_activity.NewCodingActivityService(codingActivityRepo, wakatimeClient, cfg.WakaTime.SyncDays, cfg.WakaTime.CacheTTL)
+	codingActivityHandler := coding_activity.NewCodingActivityHandler(codingActivityService, appLogger)
+	var codingActivityJob *coding_activity.Job
+	if cfg.WakaTime.Enabled {
+		codingActivityJob = coding_activity.NewJob(codingActivityService, tenantResolver, cfg.WakaTime.Tenant, cfg.WakaTime.Interval, appLogger)
+	}
+
+	// Initialize profile stats dependencies
+	var profileSources []profilestats.Source
+	if cfg.ProfileStats.Enabled {
+		statsClient := profilestats.NewHTTPClient(cfg.ProfileStats.Timeout)
+		if cfg.ProfileStats.GitHubUser != "" {
+			profileSources = append(profileSources, profilestats.Source{
+				Provider: profilestats.NewGitHub(statsClient, cfg.ProfileStats.GitHubToken),
+				Account:  cfg.ProfileStats.GitHubUser,
+			})
+		}
+		if cfg.ProfileStats.StackOverflowUserID != "" {
+			profileSources = append(profileSources, profilestats.Source{
+				Provider: profilestats.NewStackOverflow(statsClient, cfg.ProfileStats.StackExchangeKey),
+				Account:  cfg.ProfileStats.StackOverflowUserID,
+			})
+		}
+		if len(cfg.ProfileStats.NPMPackages) > 0 {
+			profileSources = append(profileSources, profilestats.Source{
+				Provider: profilestats.NewNPM(statsClient),
+				Account:  strings.Join(cfg.ProfileStats.NPMPackages, ","),
+			})
+		}
+	}
+	profileStatRepo := profile_stats.NewProfileStatRepository(supabaseDefault)
+	profileStatService := profile_stats.NewProfileStatService(profileStatRepo, profileSources)
+	profileStatHandler := profile_stats.NewProfileStatHandler(profileStatService, appLogger)
+	var profileStatJob *profile_stats.Job
+	if len(profileSources) > 0 {
+		profileStatJob = profile_stats.NewJob(profileStatService, tenantResolver, cfg.ProfileStats.Tenant, cfg.ProfileStats.Interval, appLogger)
+	}
+
+	// Initialize offering dependencies
+	var rateClient *exchangerate.Client
+	if cfg.Pricing.RefreshEnabled {
+		rateClient, err = exchangerate.NewClient(cfg.Pricing.RatesURL, cfg.Pricing.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize exchange rate client: %w", err)
+		}
+	}
+	exchangeRateRepo := offering.NewExchangeRateRepository(supabaseDefault)
+	exchangeRateService := offering.NewExchangeRateService(exchangeRateRepo, rateClient, cfg.Pricing.BaseCurrency)
+	offeringRepo := offering.NewOfferingRepository(supabaseDefault)
+	offeringService := offering.NewOfferingService(offeringRepo, exchangeRateService)
+	offeringHandler := offering.NewOfferingHandler(offeringService, exchangeRateService, appLogger)
+	var exchangeRateJob *offering.RateJob
+	if cfg.Pricing.RefreshEnabled {
+		exchangeRateJob = offering.NewRateJob(exchangeRateService, cfg.Pricing.RefreshInterval, appLogger)
+	}
+
+	// Initialize uses dependencies
+	usesRepo := uses.NewUsesRepository(supabaseDefault)
+	usesService := uses.NewUsesService(usesRepo, assetService)
+	usesHandler := uses.NewUsesHandler(usesService, appLogger)
+
+	// Initialize site config dependencies
+	siteConfigRepo := site_config.NewSiteConfigRepository(supabaseDefault)
+	siteConfigService := site_config.NewSiteConfigService(siteConfigRepo, fileStorage)
+	siteConfigHandler := site_config.NewSiteConfigHandler(siteConfigService, appLogger)
+
+	// Initialize inquiry dependencies
+	inquiryLinkSecret, err := signingSecret(cfg.Inquiry.LinkSecret, "INQUIRY_LINK_SECRET", appLogger)
+	if err != nil {
+		return nil, err
+	}
+	inquiryLinkSigner := inquiry.NewLinkSigner(inquiryLinkSecret, cfg.Inquiry.PublicURL, cfg.Inquiry.LinkTTL)
+	inquiryRepo := inquiry.NewInquiryRepository(supabaseDefault)
+	inquiryService := inquiry.NewInquiryService(inquiryRepo, spamFilter)
+	proposalRepo := inquiry.NewProposalRepository(supabaseDefault)
+	proposalService := inquiry.NewProposalService(proposalRepo, inquiryService, siteConfigService, fileStorage, mailService, notificationService, inquiryLinkSigner, cfg.Inquiry.AcceptURL, cfg.Pricing.BaseCurrency, cfg.Inquiry.ProposalValidity)
+	var stripeClient *stripe.Client
+	if cfg.Stripe.Enabled {
+		stripeClient, err = stripe.NewClient(cfg.Stripe.SecretKey, cfg.Stripe.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize stripe client: %w", err)
+		}
+	}
+	paymentRepo := inquiry.NewPaymentRepository(supabaseDefault)
+	paymentService := inquiry.NewPaymentService(paymentRepo, proposalRepo, inquiryService, notificationService, stripeClient, inquiry.StripeOptions{
+		WebhookSecret:  cfg.Stripe.WebhookSecret,
+		DepositPercent: cfg.Stripe.DepositPercent,
+		SuccessURL:     cfg.Stripe.SuccessURL,
+		CancelURL:      cfg.Stripe.CancelURL,
+	})
+	inquiryHandler := inquiry.NewInquiryHandler(inquiryService, proposalService, paymentService, experimentService, appLogger)
+	inquiryRateLimiter := middleware.NewRateLimiter(cfg.Inquiry.RateLimit, cfg.Inquiry.RateWindow)
+
+	// Initialize portal dependencies
+	portalTokenRepo := portal.NewTokenRepository(supabaseDefault)
+	portalService := portal.NewPortalService(portalTokenRepo, inquiryService, proposalService, paymentService, siteConfigService, mailService, cfg.Portal.LoginURL, cfg.Portal.LinkTTL, cfg.Portal.SessionTTL)
+	portalHandler := portal.NewPortalHandler(portalService, appLogger)
+	portalRateLimiter := middleware.NewRateLimiter(cfg.Portal.RateLimit, cfg.Portal.RateWindow)
 
 	// Initialize tech stack dependencies
-	techStackRepo := tech_stack.NewTechStackRepository(supabaseDefault)
-	techStackService := tech_stack.NewTechStackService(techStackRepo, supabaseStorage)
+	var iconFetcher *icons.Fetcher
+	if cfg.Icons.AutoFetch {
+		iconFetcher, err = icons.NewFetcher(cfg.Icons.Sources, cfg.Icons.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize icon fetcher: %w", err)
+		}
+	}
+	var techStackRepo tech_stack.TechStackRepository
+	switch {
+	case devData != nil:
+		techStackRepo = tech_stack.NewMemoryTechStackRepository(devData.TechStacks)
+	case db != nil:
+		techStackRepo = tech_stack.NewPostgresTechStackRepository(db)
+	default:
+		techStackRepo = tech_stack.NewTechStackRepository(supabaseDefault)
+	}
+	techStackHistoryRepo := tech_stack.NewTechStackHistoryRepository(supabaseDefault)
+	techStackService := tech_stack.NewTechStackService(techStackRepo, techStackHistoryRepo, fileStorage, assetService, iconFetcher, contentPublisher)
 	techStackHandler := tech_stack.NewTechStackHandler(techStackService, appLogger)
 
+	// Initialize endorsement dependencies
+	endorsementSecret, err := signingSecret(cfg.Endorsement.Secret, "ENDORSEMENT_SECRET", appLogger)
+	if err != nil {
+		return nil, err
+	}
+	endorsementRepo := endorsement.NewEndorsementRepository(supabaseDefault)
+	endorsementService := endorsement.NewEndorsementService(endorsementRepo, techStackService, endorsementSecret, spamFilter)
+	endorsementHandler := endorsement.NewEndorsementHandler(endorsementService, appLogger)
+	endorsementRateLimiter := middleware.NewRateLimiter(cfg.Endorsement.RateLimit, cfg.Endorsement.RateWindow)
+
+	// Initialize company dependencies
+	companyRepo := company.NewCompanyRepository(supabaseDefault)
+	companyService := company.NewCompanyService(companyRepo, assetService)
+	companyHandler := company.NewCompanyHandler(companyService, appLogger)
+
+	dedupMode, err := base.ParseDedupMode(cfg.Dedup.Mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dedup mode: %w", err)
+	}
+
 	// Initialize experience dependencies
-	experienceRepo := experience.NewExperienceRepository(supabaseDefault, supabaseStorage)
-	experienceService := experience.NewExperienceService(experienceRepo, techStackService, supabaseStorage)
+	var experienceRepo experience.ExperienceRepository
+	if devData != nil {
+		experienceRepo = experience.NewMemoryExperienceRepository(devData.Experiences, devData.ExperienceTechStacks, techStackRepo)
+	} else {
+		experienceRepo = experience.NewExperienceRepository(supabaseDefault, fileStorage)
+	}
+	experienceService := experience.NewExperienceService(experienceRepo, techStackService, companyService, fileStorage, assetService, contentPublisher, dedupMode)
 	experienceHandler := experience.NewExperienceHandler(experienceService, appLogger)
+	techStackService.RegisterReferrer(experienceService)
 
 	// Initialize project dependencies
-	projectRepo := project.NewProjectRepository(supabaseDefault, supabaseStorage)
-	projectService := project.NewProjectService(projectRepo, techStackService, supabaseStorage)
-	projectHandler := project.NewProjectHandler(projectService, appLogger)
+	screenshotCapturer, err := screenshot.NewCapturer(screenshot.Config{
+		Provider:   cfg.Screenshot.Provider,
+		APIURL:     cfg.Screenshot.APIURL,
+		ChromePath: cfg.Screenshot.ChromePath,
+		Width:      cfg.Screenshot.Width,
+		Height:     cfg.Screenshot.Height,
+		Timeout:    cfg.Screenshot.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize screenshot capturer: %w", err)
+	}
+	projectShareSecret, err := signingSecret(cfg.ProjectShare.Secret, "PROJECT_SHARE_SECRET", appLogger)
+	if err != nil {
+		return nil, err
+	}
+	projectShareSigner := project.NewShareSigner(projectShareSecret, cfg.ProjectShare.BaseURL, cfg.ProjectShare.TTL)
+	var projectRepo project.ProjectRepository
+	switch {
+	case devData != nil:
+		projectRepo = project.NewMemoryProjectRepository(devData.Projects, devData.ProjectTechStacks, techStackRepo)
+	case db != nil:
+		projectRepo = project.NewPostgresProjectRepository(db, fileStorage)
+	default:
+		projectRepo = project.NewProjectRepository(supabaseDefault, fileStorage)
+	}
+	seriesLookup := collection.NewSeriesLookup(collectionRepo, projectRepo)
+	projectService := project.NewProjectService(projectRepo, techStackService, companyService, fileStorage, assetService, screenshotCapturer, contentPublisher, projectShareSigner, seriesLookup, dedupMode)
+
+	// Initialize collection dependencies
+	collectionService := collection.NewCollectionService(collectionRepo, projectRepo)
+	collectionHandler := collection.NewCollectionHandler(collectionService, appLogger)
+	bulkConfirmSecret, err := signingSecret(cfg.BulkDelete.ConfirmSecret, "BULK_DELETE_CONFIRM_SECRET", appLogger)
+	if err != nil {
+		return nil, err
+	}
+	bulkConfirmer := base.NewBulkConfirmer(bulkConfirmSecret, cfg.BulkDelete.ConfirmThreshold, cfg.BulkDelete.ConfirmTTL, cfg.BulkDelete.RequireSecondAdmin)
+	projectHandler := project.NewProjectHandler(projectService, jobService, bulkConfirmer, appLogger)
+	techStackService.RegisterReferrer(projectService)
+	jobService.Register(project.ScreenshotJob, project.ScreenshotRunner(projectService))
+
+	// Initialize analytics dependencies
+	geoLocator, err := geoip.NewLocator(cfg.Analytics.GeoIPDatabasePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize geoip locator: %w", err)
+	}
+	analyticsRepo := analytics.NewAnalyticsRepository(supabaseDefault)
+	analyticsService := analytics.NewAnalyticsService(analyticsRepo, geoLocator, cfg.Analytics.RespectDNT)
+	analyticsRetention := analytics.NewRetentionJob(analyticsService, cfg.Analytics.Retention, cfg.Analytics.RetentionInterval, appLogger)
+	funnelRepo := analytics.NewFunnelRepository(supabaseDefault)
+	funnelService := analytics.NewFunnelService(funnelRepo, analyticsRepo)
+	engagementRepo := analytics.NewEngagementRepository(supabaseDefault)
+	engagementService := analytics.NewEngagementService(engagementRepo, projectService, cfg.Analytics.RespectDNT)
+	analyticsHandler := analytics.NewAnalyticsHandler(analyticsService, funnelService, engagementService, appLogger)
+
+	// Initialize public stats dependencies
+	publicStatsService := public_stats.NewPublicStatsService(projectService, experienceService, analyticsService)
+	publicStatsHandler := public_stats.NewPublicStatsHandler(publicStatsService, appLogger)
+
+	// Initialize import dependencies. Seed files are imported item by item in
+	// the job queue.
+	jobService.Register(importer.ImportJob, importer.Runner(map[importer.Entity]importer.ItemImporter{
+		importer.EntityProjects:    importer.ProjectImporter(projectService),
+		importer.EntityExperiences: importer.ExperienceImporter(experienceService),
+		importer.EntityTechStacks:  importer.TechStackImporter(techStackService),
+	}))
+	importHandler := importer.NewImportHandler(jobService, map[importer.Entity]importer.Planner{
+		importer.EntityProjects:    importer.ProjectPlanner(projectService),
+		importer.EntityExperiences: importer.ExperiencePlanner(experienceService),
+		importer.EntityTechStacks:  importer.TechStackPlanner(techStackService),
+	}, appLogger)
+
+	// Initialize duplicates dependencies
+	duplicatesHandler := duplicates.NewDuplicatesHandler(projectService, experienceService, appLogger)
+
+	// Initialize maintenance dependencies
+	maintenanceHandler := maintenance.NewMaintenanceHandler(projectService, pageService, appLogger)
+
+	// Initialize recruiter dependencies
+	recruiterService := recruiter.NewRecruiterService(projectService, experienceService, techStackService)
+	recruiterHandler := recruiter.NewRecruiterHandler(recruiterService, appLogger)
+	recruiterRateLimiter := middleware.NewRateLimiter(cfg.Recruiter.JobMatchRateLimit, cfg.Recruiter.JobMatchRateWindow)
+
+	// Initialize search dependencies
+	embedder, err := embedding.NewEmbedder(embedding.Config{
+		Provider:   cfg.Embedding.Provider,
+		APIKey:     cfg.Embedding.APIKey,
+		APIURL:     cfg.Embedding.APIURL,
+		Model:      cfg.Embedding.Model,
+		Dimensions: cfg.Embedding.Dimensions,
+		Timeout:    cfg.Embedding.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize embedder: %w", err)
+	}
+	embeddingRepo := search.NewEmbeddingRepository(supabaseDefault)
+	searchService := search.NewSearchService(embeddingRepo, projectService, experienceService, embedder, cfg.Embedding.BatchSize, relatedCache)
+	searchHandler := search.NewSearchHandler(searchService, appLogger)
+	var searchJob *search.Job
+	if cfg.Embedding.Provider != "" && cfg.Embedding.Provider != "none" {
+		searchJob = search.NewJob(searchService, tenantService, eventBus, cfg.Embedding.ReindexInterval, cfg.Embedding.ReindexDelay, appLogger)
+	}
+
+	// Initialize chat dependencies
+	var chatGenerator chat.Generator
+	if cfg.Chat.Enabled {
+		geminiClient, err := gemini.NewClient(cfg.Gemini.ApiKey, cfg.Gemini.AIModel, gemini.GenerationConfig{
+			Temperature:     cfg.Gemini.Temperature,
+			TopK:            cfg.Gemini.TopK,
+			TopP:            cfg.Gemini.TopP,
+			MaxOutputTokens: cfg.Gemini.MaxTokens,
+		}, cfg.Chat.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize gemini client: %w", err)
+		}
+		chatGenerator = geminiClient
+	}
+	chatService := chat.NewChatService(searchService, siteConfigService, chatGenerator, chat.Options{
+		SessionTTL:  cfg.Chat.SessionTTL,
+		MaxTurns:    cfg.Chat.MaxTurns,
+		MaxMessages: cfg.Chat.MaxMessages,
+		MaxSessions: cfg.Chat.MaxSessions,
+		Passages:    cfg.Chat.Passages,
+	})
+	chatHandler := chat.NewChatHandler(chatService, appLogger)
+	chatRateLimiter := middleware.NewRateLimiter(cfg.Chat.RateLimit, cfg.Chat.RateWindow)
+
+	// Initialize sitemap dependencies
+	sitemapService := sitemap.NewSitemapService(projectService, pageService, siteConfigService, cfg.Sitemap.ProjectPath, cfg.Sitemap.PagePath)
+	sitemapHandler := sitemap.NewSitemapHandler(sitemapService, appLogger)
+
+	// Initialize command index dependencies
+	commandIndexService := command_index.NewCommandIndexService(commandIndexCache, projectService, experienceService, techStackService, pageService, command_index.Paths{
+		Project:    cfg.Sitemap.ProjectPath,
+		Page:       cfg.Sitemap.PagePath,
+		Experience: cfg.CommandIndex.ExperiencePath,
+		TechStack:  cfg.CommandIndex.TechStackPath,
+	})
+	commandIndexHandler := command_index.NewCommandIndexHandler(commandIndexService, appLogger)
+
+	// Initialize embed dependencies; link preview images share the cache of
+	// the image proxy
+	embedService := embed.NewEmbedService(projectService, siteConfigService, imageCache, cfg.Sitemap.ProjectPath, cfg.Embed.BaseURL, cfg.Embed.CacheTTL)
+	embedHandler := embed.NewEmbedHandler(embedService, cfg.Embed.CacheTTL, appLogger)
+
+	// Initialize case study dependencies; the print view brings its own
+	// content security policy unless security headers are turned off
+	printCSP := ""
+	if cfg.Security.HeadersEnabled {
+		printCSP = cfg.Security.PrintCSP
+	}
+	var diagrams *case_study.Diagrams
+	if cfg.Diagram.RendererURL != "" {
+		diagrams = case_study.NewDiagrams(diagram.NewClient(cfg.Diagram.RendererURL, cfg.Diagram.Timeout), fileStorage, appLogger)
+	}
+	var snippets *case_study.Snippets
+	if cfg.Snippet.Enabled {
+		snippets = case_study.NewSnippets(snippet.NewClient(cfg.Snippet.GitHubToken, cfg.Snippet.Timeout), cfg.Snippet.TTL, appLogger)
+	}
+	caseStudyService := case_study.NewCaseStudyService(projectService, siteConfigService, diagrams, snippets, cfg.Sitemap.ProjectPath)
+	caseStudyHandler := case_study.NewCaseStudyHandler(caseStudyService, printCSP, appLogger)
+
+	// Initialize webmention dependencies
+	mentionRepo := webmention.NewMentionRepository(supabaseDefault)
+	sentMentionRepo := webmention.NewSentRepository(supabaseDefault)
+	var webmentionClient *webmentionclient.Client
+	if cfg.Webmention.Enabled {
+		webmentionClient = webmentionclient.NewClient(cfg.Webmention.Timeout, cfg.Webmention.UserAgent)
+	}
+	webmentionService := webmention.NewWebmentionService(mentionRepo, sentMentionRepo, projectService, siteConfigService, webmentionClient, cfg.Webmention.ProjectPath)
+	webmentionHandler := webmention.NewWebmentionHandler(webmentionService, appLogger)
+	webmentionRateLimiter := middleware.NewRateLimiter(cfg.Webmention.RateLimit, cfg.Webmention.RateWindow)
+	var webmentionJob *webmention.Job
+	if cfg.Webmention.Enabled {
+		webmentionJob = webmention.NewJob(webmentionService, tenantService, eventBus, cfg.Webmention.VerifyInterval, cfg.Webmention.SendInterval, cfg.Webmention.SendDelay, appLogger)
+	}
+
+	// Initialize activitypub dependencies
+	actorKeyRepo := activitypub.NewKeyRepository(supabaseDefault)
+	followerRepo := activitypub.NewFollowerRepository(supabaseDefault)
+	postRepo := activitypub.NewPostRepository(supabaseDefault)
+	var activityPubClient *activitypubclient.Client
+	if cfg.ActivityPub.Enabled {
+		activityPubClient = activitypubclient.NewClient(cfg.ActivityPub.Timeout, cfg.ActivityPub.UserAgent)
+	}
+	activityPubService := activitypub.NewActivityPubService(actorKeyRepo, followerRepo, postRepo, projectService, siteConfigService, activityPubClient, activitypub.Options{
+		BaseURL:     cfg.ActivityPub.BaseURL,
+		Domain:      cfg.ActivityPub.Domain,
+		ProjectPath: cfg.ActivityPub.ProjectPath,
+	})
+	activityPubHandler := activitypub.NewActivityPubHandler(activityPubService, tenantResolver, appLogger)
+	activityPubRateLimiter := middleware.NewRateLimiter(cfg.ActivityPub.InboxRateLimit, cfg.ActivityPub.InboxRateWindow)
+	var activityPubJob *activitypub.Job
+	if cfg.ActivityPub.Enabled {
+		activityPubJob = activitypub.NewJob(activityPubService, tenantService, eventBus, cfg.ActivityPub.PublishInterval, cfg.ActivityPub.PublishDelay, appLogger)
+	}
+
+	// Initialize indieauth dependencies
+	indieAuthCodeRepo := indieauth.NewCodeRepository(supabaseDefault)
+	indieAuthAuthorizationRepo := indieauth.NewAuthorizationRepository(supabaseDefault)
+	indieAuthService := indieauth.NewIndieAuthService(indieAuthCodeRepo, indieAuthAuthorizationRepo, siteConfigService, indieauth.Options{
+		Enabled:     cfg.IndieAuth.Enabled,
+		BaseURL:     cfg.IndieAuth.BaseURL,
+		ConsentPath: cfg.IndieAuth.ConsentPath,
+		CodeTTL:     cfg.IndieAuth.CodeTTL,
+		TokenTTL:    cfg.IndieAuth.TokenTTL,
+	})
+	indieAuthHandler := indieauth.NewIndieAuthHandler(indieAuthService, appLogger)
+	indieAuthRateLimiter := middleware.NewRateLimiter(cfg.IndieAuth.RateLimit, cfg.IndieAuth.RateWindow)
+
+	// Initialize link check dependencies
+	brokenLinkRepo := linkcheck.NewBrokenLinkRepository(supabaseDefault)
+	urlChecker := urlcheck.NewChecker(cfg.LinkCheck.Timeout, cfg.LinkCheck.Concurrency, cfg.LinkCheck.UserAgent)
+	linkCheckService := linkcheck.NewLinkCheckService(brokenLinkRepo, projectService, experienceService, urlChecker)
+	linkCheckHandler := linkcheck.NewLinkCheckHandler(linkCheckService, appLogger)
+	var linkCheckJob *linkcheck.Job
+	if cfg.LinkCheck.Enabled {
+		linkCheckJob = linkcheck.NewJob(linkCheckService, tenantService, cfg.LinkCheck.Interval, appLogger)
+	}
+
+	// Initialize integrity dependencies
+	integrityService := integrity.NewIntegrityService(projectService, experienceService, techStackService, urlChecker)
+	integrityHandler := integrity.NewIntegrityHandler(integrityService, appLogger)
+
+	// Initialize media dependencies
+	var altDescriber media.Describer
+	if cfg.Media.AltSuggestions {
+		geminiClient, err := gemini.NewClient(cfg.Gemini.ApiKey, cfg.Gemini.AIModel, gemini.GenerationConfig{
+			Temperature:     cfg.Gemini.Temperature,
+			MaxOutputTokens: cfg.Gemini.MaxTokens,
+		}, cfg.Media.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize gemini client: %w", err)
+		}
+		altDescriber = geminiClient
+	}
+	mediaService := media.NewMediaService(projectService, experienceService, altDescriber, cfg.Media.Timeout)
+	mediaHandler := media.NewMediaHandler(mediaService, appLogger)
+
+	// Initialize accessibility dependencies
+	accessibilityIssueRepo := accessibility.NewIssueRepository(supabaseDefault)
+	accessibilityService := accessibility.NewAccessibilityService(accessibilityIssueRepo, projectService, experienceService, pageService, siteConfigService)
+	accessibilityHandler := accessibility.NewAccessibilityHandler(accessibilityService, appLogger)
+	var accessibilityJob *accessibility.Job
+	if cfg.Accessibility.Enabled {
+		accessibilityJob = accessibility.NewJob(accessibilityService, tenantService, eventBus, cfg.Accessibility.Interval, cfg.Accessibility.Delay, appLogger)
+	}
+
+	// Initialize telegram bot dependencies
+	var telegramBot *bot.Bot
+	if cfg.TelegramBot.Enabled {
+		telegramClient, err := telegram.NewClient(cfg.TelegramBot.BotToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize telegram bot: %w", err)
+		}
+		if len(cfg.TelegramBot.AllowedChatIDs) == 0 {
+			return nil, fmt.Errorf("failed to initialize telegram bot: TELEGRAM_ALLOWED_CHAT_IDS is required")
+		}
+		telegramBot = bot.NewBot(telegramClient, cfg.TelegramBot.AllowedChatIDs, tenantResolver, cfg.TelegramBot.Tenant, appLogger)
+		bot.RegisterProjectCommands(telegramBot, projectService)
+		bot.RegisterExperienceCommands(telegramBot, experienceService)
+	}
+
+	// Initialize concurrency limits for expensive endpoints
+	aiLimiter := middleware.NewConcurrencyLimiter("ai", cfg.Concurrency.AILimit, cfg.Concurrency.AIQueueSize, cfg.Concurrency.QueueTimeout)
+	renderLimiter := middleware.NewConcurrencyLimiter("render", cfg.Concurrency.RenderLimit, cfg.Concurrency.RenderQueueSize, cfg.Concurrency.QueueTimeout)
 
 	return &FeatureDependencies{
 		// Health Dependencies
 		HealthHandler: healthHandler,
 
+		// Event Dependencies
+		EventHandler: eventHandler,
+
+		// Tenant Dependencies
+		TenantHandler:    tenantHandler,
+		TenantService:    &tenantService,
+		TenantRepository: &tenantRepo,
+		TenantResolver:   tenantResolver,
+
+		// Mail Dependencies
+		MailHandler:    mailHandler,
+		MailService:    &mailService,
+		MailRepository: &mailRepo,
+		MailQueue:      mailQueue,
+
+		// Job Queue Dependencies
+		JobHandler: jobHandler,
+		JobService: &jobService,
+		JobWorker:  jobWorker,
+
+		// Import Dependencies
+		ImportHandler: importHandler,
+
+		// Duplicates Dependencies
+		DuplicatesHandler: duplicatesHandler,
+
+		// Maintenance Dependencies
+		MaintenanceHandler: maintenanceHandler,
+
+		// Notification Dependencies
+		NotificationHandler: notificationHandler,
+		NotificationService: &notificationService,
+
+		// Anomaly Detection Dependencies
+		AnomalyDetector: anomalyDetector,
+
+		// Admin Session Dependencies
+		AdminSessionHandler: adminSessionHandler,
+		AdminSessionService: &adminSessionService,
+
+		// Analytics Dependencies
+		AnalyticsHandler:    analyticsHandler,
+		AnalyticsService:    &analyticsService,
+		AnalyticsRepository: &analyticsRepo,
+		GeoLocator:          geoLocator,
+		AnalyticsRetention:  analyticsRetention,
+		FunnelService:       &funnelService,
+		EngagementService:   &engagementService,
+
+		// Image Proxy Dependencies
+		ImageProxyHandler: imageProxyHandler,
+		ImageProxyService: &imageProxyService,
+
+		// Asset Dependencies
+		AssetHandler: assetHandler,
+		AssetService: &assetService,
+
+		// Changelog Dependencies
+		ChangelogHandler:    changelogHandler,
+		ChangelogService:    &changelogService,
+		ChangelogRepository: &changelogRepo,
+
+		// Now Dependencies
+		NowHandler:    nowHandler,
+		NowService:    &nowService,
+		NowRepository: &nowRepo,
+
+		// Page Dependencies
+		PageHandler: pageHandler,
+		PageService: &pageService,
+
+		// Redirect Dependencies
+		RedirectHandler: redirectHandler,
+		RedirectService: &redirectService,
+
+		// Collection Dependencies
+		CollectionHandler: collectionHandler,
+		CollectionService: &collectionService,
+
+		// Experiment Dependencies
+		ExperimentHandler: experimentHandler,
+		ExperimentService: &experimentService,
+
+		// Now Playing Dependencies
+		NowPlayingHandler: nowPlayingHandler,
+		NowPlayingService: &nowPlayingService,
+
+		// Coding Activity Dependencies
+		CodingActivityHandler:    codingActivityHandler,
+		CodingActivityService:    &codingActivityService,
+		CodingActivityRepository: &codingActivityRepo,
+		CodingActivityJob:        codingActivityJob,
+
+		// Profile Stats Dependencies
+		ProfileStatHandler:    profileStatHandler,
+		ProfileStatService:    &profileStatService,
+		ProfileStatRepository: &profileStatRepo,
+		ProfileStatJob:        profileStatJob,
+
+		// Public Stats Dependencies
+		PublicStatsHandler: publicStatsHandler,
+		PublicStatsService: &publicStatsService,
+
+		// Offering Dependencies
+		OfferingHandler:        offeringHandler,
+		OfferingService:        &offeringService,
+		OfferingRepository:     &offeringRepo,
+		ExchangeRateService:    &exchangeRateService,
+		ExchangeRateRepository: &exchangeRateRepo,
+		ExchangeRateJob:        exchangeRateJob,
+
+		// Inquiry Dependencies
+		InquiryHandler:     inquiryHandler,
+		InquiryService:     &inquiryService,
+		InquiryRepository:  &inquiryRepo,
+		ProposalService:    &proposalService,
+		ProposalRepository: &proposalRepo,
+		PaymentService:     &paymentService,
+		PaymentRepository:  &paymentRepo,
+		InquiryRateLimiter: inquiryRateLimiter,
+
+		// Portal Dependencies
+		PortalHandler:         portalHandler,
+		PortalService:         &portalService,
+		PortalTokenRepository: &portalTokenRepo,
+		PortalRateLimiter:     portalRateLimiter,
+
+		// Uses Dependencies
+		UsesHandler:    usesHandler,
+		UsesService:    &usesService,
+		UsesRepository: &usesRepo,
+
+		// Site Config Dependencies
+		SiteConfigHandler:    siteConfigHandler,
+		SiteConfigService:    &siteConfigService,
+		SiteConfigRepository: &siteConfigRepo,
+
+		// Company Dependencies
+		CompanyHandler:    companyHandler,
+		CompanyService:    &companyService,
+		CompanyRepository: &companyRepo,
+
 		// Experience Dependencies
 		ExperienceHandler:    experienceHandler,
 		ExperienceService:    &experienceService,
@@ -223,17 +1500,112 @@ func initializeFeatureDependencies(supabaseDefault *supabase.SupabaseClient, sup
 		ProjectHandler:    projectHandler,
 		ProjectService:    &projectService,
 		ProjectRepository: &projectRepo,
+		ProjectShare:      projectShareSigner,
+
+		// Recruiter Dependencies
+		RecruiterHandler:     recruiterHandler,
+		RecruiterService:     &recruiterService,
+		RecruiterRateLimiter: recruiterRateLimiter,
+
+		// Search Dependencies
+		SearchHandler:       searchHandler,
+		SearchService:       &searchService,
+		EmbeddingRepository: &embeddingRepo,
+		SearchJob:           searchJob,
+
+		// Chat Dependencies
+		ChatHandler:     chatHandler,
+		ChatService:     &chatService,
+		ChatRateLimiter: chatRateLimiter,
+
+		// Sitemap Dependencies
+		SitemapHandler: sitemapHandler,
+
+		// Command Index Dependencies
+		CommandIndexHandler: commandIndexHandler,
+		CommandIndexService: &commandIndexService,
+
+		// Embed Dependencies
+		EmbedHandler: embedHandler,
+		EmbedService: &embedService,
+
+		// Case Study Dependencies
+		CaseStudyHandler: caseStudyHandler,
+		CaseStudyService: &caseStudyService,
+
+		// Webmention Dependencies
+		WebmentionHandler:     webmentionHandler,
+		WebmentionService:     &webmentionService,
+		WebmentionJob:         webmentionJob,
+		WebmentionRateLimiter: webmentionRateLimiter,
+
+		// ActivityPub Dependencies
+		ActivityPubHandler:     activityPubHandler,
+		ActivityPubService:     &activityPubService,
+		ActivityPubJob:         activityPubJob,
+		ActivityPubRateLimiter: activityPubRateLimiter,
+
+		// IndieAuth Dependencies
+		IndieAuthHandler:     indieAuthHandler,
+		IndieAuthService:     &indieAuthService,
+		IndieAuthRateLimiter: indieAuthRateLimiter,
+
+		// Link Check Dependencies
+		LinkCheckHandler: linkCheckHandler,
+		LinkCheckService: &linkCheckService,
+		LinkCheckJob:     linkCheckJob,
+
+		// Integrity Dependencies
+		IntegrityHandler: integrityHandler,
+
+		// Media Dependencies
+		MediaHandler: mediaHandler,
+
+		// Accessibility Dependencies
+		AccessibilityHandler: accessibilityHandler,
+		AccessibilityJob:     accessibilityJob,
+
+		// Storage Usage Dependencies
+		StorageUsageHandler: storageUsageHandler,
 
 		// Tech Stack Dependencies
 		TechStackHandler:    techStackHandler,
 		TechStackService:    &techStackService,
 		TechStackRepository: &techStackRepo,
+
+		// Endorsement Dependencies
+		EndorsementHandler:     endorsementHandler,
+		EndorsementService:     &endorsementService,
+		EndorsementRateLimiter: endorsementRateLimiter,
+
+		// Telegram Bot Dependencies
+		TelegramBot: telegramBot,
+
+		// Concurrency Limits
+		AILimiter:     aiLimiter,
+		RenderLimiter: renderLimiter,
 	}, nil
 }
 
 // cleanupDependencies performs cleanup for all initialized dependencies
 func cleanupAppDependencies(deps *AppDependencies) {
-	// Close logger
+	// Close event bus subscriptions
+	deps.EventBus.Close()
+
+	// Close database connections
+	if deps.Database != nil {
+		deps.Database.Close()
+	}
+
+	// Remove files uploaded in development mode
+	if deps.Config.Dev.Enabled {
+		os.RemoveAll(deps.Config.Storage.LocalDir)
+	}
+
+	// Close loggers
+	if err := deps.AccessLogger.Close(); err != nil {
+		fmt.Printf("Error closing access logger: %v\n", err)
+	}
 	if err := deps.Logger.Close(); err != nil {
 		fmt.Printf("Error closing logger: %v\n", err)
 	}
@@ -246,11 +1618,44 @@ func setupRoutes(deps *AppDependencies, featureDeps *FeatureDependencies) {
 		response.NotFound(c, "route_not_found", "Endpoint not found", c.Request.URL.Path)
 	})
 
+	// Log and count slow requests
+	if deps.Config.Diagnostics.SlowRequestThreshold > 0 {
+		deps.Router.Use(middleware.SlowRequests(deps.SlowRequests))
+	}
+
+	// Count responses for traffic anomaly alerts
+	if featureDeps.AnomalyDetector != nil {
+		deps.Router.Use(featureDeps.AnomalyDetector.Middleware())
+	}
+
+	// Attribute authenticated requests to admin sessions
+	deps.Router.Use(admin_session.Track(*featureDeps.AdminSessionService, deps.Logger))
+
+	// Hide or gate tagged route groups in restricted environments
+	deps.Router.Use(deps.RouteExposure.Middleware())
+	if hidden, gated := deps.RouteExposure.Summary(); len(hidden) > 0 || len(gated) > 0 {
+		deps.Logger.Info("Restricting route exposure", "hidden", hidden, "gated", gated)
+	}
+
+	// Announce and count deprecated routes, rejecting them past their sunset
+	deps.Router.Use(deps.Deprecations.Middleware())
+
 	// Swagger route
-	deps.Router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	deps.RouteExposure.Group(deps.Router, "/swagger", routes.TagDocs).
+		GET("/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	// Profiling routes
+	diagnosticsHandler := diagnostics.NewDiagnosticsHandler(deps.SlowQueries, deps.SlowRequests, deps.Logger)
+	routes.RegisterProfilingRoutes(deps.Router, deps.RouteExposure, diagnosticsHandler, deps.JWTMiddleware)
+
+	// Files of the local storage backend
+	if deps.Config.Storage.Backend == "local" {
+		deps.Router.Static("/uploads", deps.Config.Storage.LocalDir)
+	}
 
 	// Setup API routes
 	v1Group := deps.Router.Group("/api/v1")
+	deps.RouteExposure.Tag(v1Group.BasePath()+"/admin", routes.TagAdmin)
 	{
 		// @Summary API Information
 		// @Description Get comprehensive information about the Itsrama Portfolio Backend API
@@ -258,43 +1663,456 @@ func setupRoutes(deps *AppDependencies, featureDeps *FeatureDependencies) {
 		// @Produce json
 		// @Success 200 {object} map[string]string
 		// @Router /api/v1/ [get]
-		v1Group.GET("/", func(c *gin.Context) {
-			apiInfo := map[string]string{
-				"name":          "Itsrama Portfolio Backend API",
-				"description":   "Comprehensive backend API for Itsrama Portfolio",
-				"documentation": "https://github.com/holycann/itsrama-portfolio-backend",
-				"status":        "operational",
-				"version":       "1.0.0",
-				"environment":   deps.Config.Environment,
-			}
-
-			response.Success(c, http.StatusOK, apiInfo, "API Info")
-		})
+		v1Group.GET("/", apiInfo(deps, "1.0.0"))
 
 		// Health check endpoint with comprehensive system checks
 		v1Group.GET("/health", featureDeps.HealthHandler.GetHealthStatus)
 
-		// Experience Routes
-		routes.RegisterExperienceRoutes(
+		// Proof-of-work challenge endpoint
+		routes.RegisterChallengeRoutes(
 			v1Group,
-			featureDeps.ExperienceHandler,
-			deps.JWTMiddleware,
+			deps.ChallengeGuard,
 		)
 
-		// Project Routes
-		routes.RegisterProjectRoutes(
+		// ActivityPub Routes
+		routes.RegisterActivityPubRoutes(
 			v1Group,
-			featureDeps.ProjectHandler,
-			deps.JWTMiddleware,
+			deps.Router.Group("/.well-known"),
+			featureDeps.ActivityPubHandler,
+			featureDeps.ActivityPubRateLimiter,
 		)
 
-		// Tech Stack Routes
-		routes.RegisterTechStackRoutes(
-			v1Group,
-			featureDeps.TechStackHandler,
+		registerAPIRoutes(v1Group, deps, featureDeps, diagnosticsHandler)
+	}
+
+	// Version 2 serves the same handlers; the serialization profile
+	// assigned by the ResponseProfile middleware renders its envelope
+	v2Group := deps.Router.Group("/api/v2")
+	deps.RouteExposure.Tag(v2Group.BasePath()+"/admin", routes.TagAdmin)
+	{
+		// @Summary API Information (v2)
+		// @Description Get information about the v2 API, which accepts and answers with camelCase keys and uses positional page token pagination and RFC 7807 errors. Page tokens encode an offset rather than a stable cursor, so items created or deleted between requests shift the pages that follow
+		// @Tags System
+		// @Produce json
+		// @Success 200 {object} map[string]string
+		// @Router /api/v2/ [get]
+		v2Group.GET("/", apiInfo(deps, "2.0.0"))
+
+		v2Group.GET("/health", featureDeps.HealthHandler.GetHealthStatus)
+
+		routes.RegisterChallengeRoutes(
+			v2Group,
+			deps.ChallengeGuard,
+		)
+
+		registerAPIRoutes(v2Group, deps, featureDeps, diagnosticsHandler)
+	}
+}
+
+// apiInfo answers the root of an API version
+func apiInfo(deps *AppDependencies, version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiInfo := map[string]string{
+			"name":          "Itsrama Portfolio Backend API",
+			"description":   "Comprehensive backend API for Itsrama Portfolio",
+			"documentation": "https://github.com/holycann/itsrama-portfolio-backend",
+			"status":        "operational",
+			"version":       version,
+			"environment":   deps.Config.Environment,
+		}
+
+		response.Success(c, http.StatusOK, apiInfo, "API Info")
+	}
+}
+
+// registerAPIRoutes registers the feature routes shared by every API
+// version. ActivityPub federation routes stay on v1 only, since remote
+// servers store their URLs.
+func registerAPIRoutes(
+	group *gin.RouterGroup,
+	deps *AppDependencies,
+	featureDeps *FeatureDependencies,
+	diagnosticsHandler *diagnostics.DiagnosticsHandler,
+) {
+	// Resolve the tenant for every route registered below
+	group.Use(featureDeps.TenantResolver.Middleware())
+
+	// Reject uploads over the storage quota before they are processed
+	group.Use(featureDeps.StorageUsageHandler.QuotaGuard())
+
+	// Experience Routes
+	routes.RegisterExperienceRoutes(
+		group,
+		featureDeps.ExperienceHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Changelog Routes
+	routes.RegisterChangelogRoutes(
+		group,
+		featureDeps.ChangelogHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Now Routes
+	routes.RegisterNowRoutes(
+		group,
+		featureDeps.NowHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Page Routes
+	routes.RegisterPageRoutes(
+		group,
+		featureDeps.PageHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Redirect Routes
+	routes.RegisterRedirectRoutes(
+		group,
+		featureDeps.RedirectHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Collection Routes
+	routes.RegisterCollectionRoutes(
+		group,
+		featureDeps.CollectionHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Experiment Routes
+	routes.RegisterExperimentRoutes(
+		group,
+		featureDeps.ExperimentHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Now Playing Routes
+	routes.RegisterNowPlayingRoutes(
+		group,
+		featureDeps.NowPlayingHandler,
+	)
+
+	// Coding Activity Routes
+	routes.RegisterCodingActivityRoutes(
+		group,
+		featureDeps.CodingActivityHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Profile Stats Routes
+	routes.RegisterProfileStatRoutes(
+		group,
+		featureDeps.ProfileStatHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Public Stats Routes
+	routes.RegisterPublicStatsRoutes(
+		group,
+		featureDeps.PublicStatsHandler,
+	)
+
+	// Offering Routes
+	routes.RegisterOfferingRoutes(
+		group,
+		featureDeps.OfferingHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Inquiry Routes
+	routes.RegisterInquiryRoutes(
+		group,
+		featureDeps.InquiryHandler,
+		deps.JWTMiddleware,
+		featureDeps.InquiryRateLimiter,
+		deps.ChallengeGuard,
+	)
+
+	// Portal Routes
+	routes.RegisterPortalRoutes(
+		group,
+		featureDeps.PortalHandler,
+		featureDeps.PortalRateLimiter,
+	)
+
+	// Uses Routes
+	routes.RegisterUsesRoutes(
+		group,
+		featureDeps.UsesHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Company Routes
+	routes.RegisterCompanyRoutes(
+		group,
+		featureDeps.CompanyHandler,
+		featureDeps.ExperienceHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Project Routes
+	routes.RegisterProjectRoutes(
+		group,
+		featureDeps.ProjectHandler,
+		deps.JWTMiddleware,
+		featureDeps.ProjectShare,
+		featureDeps.RenderLimiter,
+	)
+
+	// Recruiter Routes
+	routes.RegisterRecruiterRoutes(
+		group,
+		featureDeps.RecruiterHandler,
+		featureDeps.RecruiterRateLimiter,
+		featureDeps.AILimiter,
+	)
+
+	// Search Routes
+	routes.RegisterSearchRoutes(
+		group,
+		featureDeps.SearchHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Chat Routes
+	routes.RegisterChatRoutes(
+		group,
+		featureDeps.ChatHandler,
+		featureDeps.ChatRateLimiter,
+		featureDeps.AILimiter,
+	)
+
+	// Sitemap Routes
+	routes.RegisterSitemapRoutes(
+		group,
+		featureDeps.SitemapHandler,
+	)
+
+	// Command Index Routes
+	routes.RegisterCommandIndexRoutes(
+		group,
+		featureDeps.CommandIndexHandler,
+	)
+
+	// Embed Routes
+	routes.RegisterEmbedRoutes(
+		group,
+		featureDeps.EmbedHandler,
+	)
+
+	// Case Study Routes
+	routes.RegisterCaseStudyRoutes(
+		group,
+		featureDeps.CaseStudyHandler,
+	)
+
+	// Webmention Routes
+	routes.RegisterWebmentionRoutes(
+		group,
+		featureDeps.WebmentionHandler,
+		deps.JWTMiddleware,
+		featureDeps.WebmentionRateLimiter,
+		deps.ChallengeGuard,
+	)
+
+	// ActivityPub Admin Routes
+	routes.RegisterActivityPubAdminRoutes(
+		group,
+		featureDeps.ActivityPubHandler,
+		deps.JWTMiddleware,
+	)
+
+	// IndieAuth Routes
+	routes.RegisterIndieAuthRoutes(
+		group,
+		featureDeps.IndieAuthHandler,
+		deps.JWTMiddleware,
+		featureDeps.IndieAuthRateLimiter,
+	)
+
+	// Tech Stack Routes
+	routes.RegisterTechStackRoutes(
+		group,
+		featureDeps.TechStackHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Endorsement Routes
+	routes.RegisterEndorsementRoutes(
+		group,
+		featureDeps.EndorsementHandler,
+		deps.JWTMiddleware,
+		featureDeps.EndorsementRateLimiter,
+		deps.ChallengeGuard,
+	)
+
+	// Tenant Routes
+	routes.RegisterTenantRoutes(
+		group,
+		featureDeps.TenantHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Site Config Routes
+	routes.RegisterSiteConfigRoutes(
+		group,
+		featureDeps.SiteConfigHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Mail Routes
+	routes.RegisterMailRoutes(
+		group,
+		featureDeps.MailHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Notification Routes
+	routes.RegisterNotificationRoutes(
+		group,
+		featureDeps.NotificationHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Analytics Routes
+	routes.RegisterAnalyticsRoutes(
+		group,
+		featureDeps.AnalyticsHandler,
+		deps.JWTMiddleware,
+		deps.ChallengeGuard,
+	)
+
+	// Image Proxy Routes
+	routes.RegisterImageProxyRoutes(
+		group,
+		featureDeps.ImageProxyHandler,
+	)
+
+	// Job Routes
+	routes.RegisterJobRoutes(
+		group,
+		featureDeps.JobHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Import Routes
+	routes.RegisterImportRoutes(
+		group,
+		featureDeps.ImportHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Duplicates Routes
+	routes.RegisterDuplicatesRoutes(
+		group,
+		featureDeps.DuplicatesHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Maintenance Routes
+	routes.RegisterMaintenanceRoutes(
+		group,
+		featureDeps.MaintenanceHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Link Check Routes
+	routes.RegisterLinkCheckRoutes(
+		group,
+		featureDeps.LinkCheckHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Integrity Routes
+	routes.RegisterIntegrityRoutes(
+		group,
+		featureDeps.IntegrityHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Media Routes
+	routes.RegisterMediaRoutes(
+		group,
+		featureDeps.MediaHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Accessibility Routes
+	routes.RegisterAccessibilityRoutes(
+		group,
+		featureDeps.AccessibilityHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Storage Usage Routes
+	routes.RegisterStorageUsageRoutes(
+		group,
+		featureDeps.StorageUsageHandler,
+		deps.JWTMiddleware,
+	)
+
+	// CORS Policy Routes
+	if deps.CORSPolicy != nil {
+		routes.RegisterCORSPolicyRoutes(
+			group,
+			cors_policy.NewCORSPolicyHandler(deps.CORSPolicy, deps.Logger),
 			deps.JWTMiddleware,
 		)
 	}
+
+	// Admin Session Routes
+	routes.RegisterAdminSessionRoutes(
+		group,
+		featureDeps.AdminSessionHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Diagnostics Routes
+	routes.RegisterDiagnosticsRoutes(
+		group,
+		diagnosticsHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Log Level Routes
+	routes.RegisterLogLevelRoutes(
+		group,
+		log_level.NewLogLevelHandler(deps.Logger),
+		deps.JWTMiddleware,
+	)
+
+	// Asset Routes
+	routes.RegisterAssetRoutes(
+		group,
+		featureDeps.AssetHandler,
+		deps.JWTMiddleware,
+	)
+
+	// Event Stream Routes
+	routes.RegisterEventRoutes(
+		group,
+		featureDeps.EventHandler,
+		deps.JWTMiddleware,
+		deps.Config.Events.QueryTokenMaxLifetime,
+	)
+
+	// Deprecation Routes
+	routes.RegisterDeprecationRoutes(
+		group,
+		deprecation.NewDeprecationHandler(deps.Deprecations, deps.Router.Routes, deps.Logger),
+		deps.JWTMiddleware,
+	)
+
+	// Fixture Routes, for mock servers and contract tests; tagged debug so
+	// restricted environments hide them
+	deps.RouteExposure.Tag(group.BasePath()+"/_fixtures", routes.TagDebug)
+	routes.RegisterFixturesRoutes(
+		group,
+		contract_fixtures.NewFixturesHandler(deps.Logger),
+	)
 }
 
 // createHTTPServer creates and configures the HTTP server
@@ -320,7 +2138,7 @@ func startServer(server *http.Server, log *logger.Logger, cfg *configs.Config) {
 }
 
 // waitForShutdown handles graceful shutdown of the server
-func waitForShutdown(server *http.Server, log *logger.Logger, cfg *configs.Config) {
+func waitForShutdown(sequence *shutdown.Sequence, log *logger.Logger, cfg *configs.Config) {
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -334,51 +2152,344 @@ func waitForShutdown(server *http.Server, log *logger.Logger, cfg *configs.Confi
 	)
 	defer shutdownCancel()
 
-	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Error("Server shutdown failed", "error", err)
+	if err := sequence.Stop(shutdownCtx); err != nil {
+		log.Error("Shutdown incomplete", "error", err)
 	}
 
 	log.Info("Server exited")
 }
 
+// shutdownSequence lists the components stopped on shutdown, in order.
+// The server drains its requests first, with event streams ended as it
+// starts shutting down since they never finish on their own; the event bus
+// closes once no request can publish to it, background workers are drained
+// next, and the connections they use are closed last.
+func shutdownSequence(server *http.Server, deps *AppDependencies, featureDeps *FeatureDependencies) *shutdown.Sequence {
+	sequence := shutdown.NewSequence(time.Duration(deps.Config.Server.ShutdownComponentTimeout)*time.Second, deps.Logger)
+
+	server.RegisterOnShutdown(deps.EventBus.CloseStreams)
+	sequence.Add("http server", 0, server.Shutdown)
+	sequence.Add("event bus", 0, func(ctx context.Context) error {
+		deps.EventBus.Close()
+		return nil
+	})
+
+	if featureDeps.TelegramBot != nil {
+		sequence.AddFunc("telegram bot", 0, featureDeps.TelegramBot.Stop)
+	}
+
+	// Scheduled jobs
+	if featureDeps.LinkCheckJob != nil {
+		sequence.AddFunc("link check job", 0, featureDeps.LinkCheckJob.Stop)
+	}
+	if featureDeps.AccessibilityJob != nil {
+		sequence.AddFunc("accessibility audit job", 0, featureDeps.AccessibilityJob.Stop)
+	}
+	if featureDeps.SearchJob != nil {
+		sequence.AddFunc("search index job", 0, featureDeps.SearchJob.Stop)
+	}
+	if featureDeps.WebmentionJob != nil {
+		sequence.AddFunc("webmention job", 0, featureDeps.WebmentionJob.Stop)
+	}
+	if featureDeps.ActivityPubJob != nil {
+		sequence.AddFunc("activitypub job", 0, featureDeps.ActivityPubJob.Stop)
+	}
+	if featureDeps.CodingActivityJob != nil {
+		sequence.AddFunc("coding activity job", 0, featureDeps.CodingActivityJob.Stop)
+	}
+	if featureDeps.ProfileStatJob != nil {
+		sequence.AddFunc("profile stat job", 0, featureDeps.ProfileStatJob.Stop)
+	}
+	if featureDeps.ExchangeRateJob != nil {
+		sequence.AddFunc("exchange rate job", 0, featureDeps.ExchangeRateJob.Stop)
+	}
+	sequence.AddFunc("analytics retention job", 0, featureDeps.AnalyticsRetention.Stop)
+	if featureDeps.AnomalyDetector != nil {
+		sequence.AddFunc("anomaly detector", 0, featureDeps.AnomalyDetector.Stop)
+	}
+
+	// Job worker pool, requeueing the jobs it interrupts
+	sequence.AddFunc("job worker pool", 0, featureDeps.JobWorker.Stop)
+
+	// Delivery queue, sending what is already due
+	sequence.Add("mail delivery queue", 0, featureDeps.MailQueue.Drain)
+
+	// Connections
+	sequence.Add("geoip database", 0, func(ctx context.Context) error {
+		return featureDeps.GeoLocator.Close()
+	})
+	if deps.Database != nil {
+		sequence.AddFunc("database pool", 0, deps.Database.Close)
+	}
+
+	return sequence
+}
+
 // initializeLogger sets up the application logger
 func initializeLogger(cfg *configs.Config) *logger.Logger {
-	loggerConfig := logger.LoggerConfig{
-		Path:        cfg.Logging.FilePath,
-		Level:       logger.InfoLevel,
-		Development: cfg.Environment == "development",
-		MaxSize:     cfg.Logging.MaxSize,
-		MaxBackups:  cfg.Logging.MaxBackups,
-		MaxAge:      cfg.Logging.MaxAge,
-		Compress:    cfg.Logging.Compress,
+	return logger.NewLogger(loggerConfig(cfg))
+}
+
+// initializeAccessLogger creates the logger of HTTP access logs, kept apart
+// from the application logs
+func initializeAccessLogger(cfg *configs.Config) *logger.Logger {
+	accessConfig := loggerConfig(cfg)
+	accessConfig.Path = cfg.Logging.AccessFilePath
+	accessConfig.Sinks = cfg.Logging.AccessSinks
+	accessConfig.Syslog.Tag += "-access"
+
+	accessConfig.Loki.Labels["log"] = "access"
+
+	return logger.NewLogger(accessConfig)
+}
+
+// loggerConfig translates the logging settings for the logger package
+func loggerConfig(cfg *configs.Config) logger.LoggerConfig {
+	// Levels are checked by cfg.Validate
+	level, _ := logger.ParseLevel(cfg.Logging.Level)
+	moduleLevels := map[string]logger.LogLevel{}
+	for _, pair := range cfg.Logging.ModuleLevels {
+		module, name, _ := strings.Cut(pair, "=")
+		moduleLevels[strings.TrimSpace(module)], _ = logger.ParseLevel(name)
+	}
+
+	lokiLabels := map[string]string{}
+	for _, pair := range cfg.Logging.LokiLabels {
+		if name, value, ok := strings.Cut(pair, "="); ok {
+			lokiLabels[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
 	}
 
-	return logger.NewLogger(loggerConfig)
+	return logger.LoggerConfig{
+		Path:         cfg.Logging.FilePath,
+		Level:        level,
+		ModuleLevels: moduleLevels,
+		Development:  cfg.Environment == "development",
+		MaxSize:      cfg.Logging.MaxSize,
+		MaxBackups:   cfg.Logging.MaxBackups,
+		MaxAge:       cfg.Logging.MaxAge,
+		Compress:     cfg.Logging.Compress,
+		Sinks:        cfg.Logging.Sinks,
+		Loki: logger.LokiConfig{
+			URL:           cfg.Logging.LokiURL,
+			Labels:        lokiLabels,
+			Username:      cfg.Logging.LokiUsername,
+			Password:      cfg.Logging.LokiPassword,
+			TenantID:      cfg.Logging.LokiTenantID,
+			BatchSize:     cfg.Logging.LokiBatchSize,
+			FlushInterval: cfg.Logging.LokiFlushInterval,
+		},
+		Syslog: logger.SyslogConfig{
+			Network: cfg.Logging.SyslogNetwork,
+			Address: cfg.Logging.SyslogAddress,
+			Tag:     cfg.Logging.SyslogTag,
+		},
+	}
 }
 
-// initializeJWKS retrieves JWKS keys for JWT validation
-func initializeJWKS(cfg *configs.Config, log *logger.Logger) *keyfunc.JWKS {
+// initializeJWKS creates the loader of the JWKS keys for JWT validation.
+// Keys are fetched in the background once started, so the server comes up
+// while the identity provider is unreachable and only authenticated routes
+// are unavailable until the keys load.
+func initializeJWKS(cfg *configs.Config, log *logger.Logger) *middleware.JWKSLoader {
 	jwksURL := fmt.Sprintf("https://%s.supabase.co/auth/v1/.well-known/jwks.json", cfg.Supabase.ProjectID)
 
-	jwks, err := keyfunc.Get(jwksURL, keyfunc.Options{
+	return middleware.NewJWKSLoader(jwksURL, keyfunc.Options{
 		RefreshUnknownKID: true,
 		RefreshErrorHandler: func(err error) {
 			log.Error("JWKS refresh error", "error", err)
 		},
-	})
+	}, log)
+}
+
+// signingSecret returns a configured signing secret. Only development may
+// leave one unset, getting a random secret that invalidates the links and
+// hashes signed before a restart; Config.Validate requires them elsewhere.
+func signingSecret(secret, name string, log *logger.Logger) ([]byte, error) {
+	if secret != "" {
+		return []byte(secret), nil
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %w", name, err)
+	}
+	log.Warn("Signing secret not set, using a random one that changes on restart", "setting", name)
+	return random, nil
+}
+
+// startupChecks lists the external dependencies verified before the
+// server starts
+func startupChecks(deps *AppDependencies) []bootstrap.Check {
+	var checks []bootstrap.Check
+
+	// Development mode only uses Supabase when a local stack is running
+	if !deps.Config.Dev.Enabled {
+		checks = append(checks, bootstrap.Check{
+			Name: "supabase",
+			Run: func(ctx context.Context) error {
+				_, _, err := deps.SupabaseDefault.GetClient().
+					From("tenant").
+					Select("id", "", false).
+					Limit(1, "").
+					Execute()
+				return err
+			},
+		})
+	}
+
+	if deps.Database != nil {
+		checks = append(checks, bootstrap.Check{Name: "database", Run: deps.Database.Ping})
+	}
+
+	checks = append(checks, bootstrap.Check{Name: "storage", Run: deps.Storage.Check})
 
+	if deps.JWKS != nil {
+		checks = append(checks, bootstrap.Check{Name: "jwks", Run: deps.JWKS.Check})
+	}
+
+	return checks
+}
+
+// initializeDevMode points the configuration away from external services
+// and loads the fixtures the in-memory repositories are seeded with. Only
+// tenants, tech stacks, projects and experiences are kept in memory; other
+// features use the Supabase stack at DEV_SUPABASE_URL when one is running.
+func initializeDevMode(cfg *configs.Config) (*devFixtures, error) {
+	cfg.Dev.Enabled = true
+	cfg.Database.Driver = "supabase"
+	if cfg.Supabase.ApiSecretKey == "" {
+		cfg.Supabase.ApiSecretKey = "dev"
+	}
+
+	uploadDir, err := os.MkdirTemp("", "itsrama-dev-uploads-")
 	if err != nil {
-		log.Error("Failed to retrieve JWKS keys", "error", err)
-		os.Exit(1)
+		return nil, err
+	}
+	cfg.Storage.Backend = "local"
+	cfg.Storage.LocalDir = uploadDir
+
+	// Fixtures list the tech stacks of projects and experiences by id
+	type linkedRow struct {
+		ID           uuid.UUID   `json:"id"`
+		TechStackIDs []uuid.UUID `json:"tech_stack_ids"`
+	}
+
+	data := &devFixtures{}
+	var projectLinks, experienceLinks []linkedRow
+	for name, dest := range map[string]interface{}{
+		"tenants":     &data.Tenants,
+		"tech_stacks": &data.TechStacks,
+		"projects":    &data.Projects,
+		"experiences": &data.Experiences,
+	} {
+		if err := fixtures.Load(cfg.Dev.FixturesDir, name, dest); err != nil {
+			return nil, err
+		}
+	}
+	if err := fixtures.Load(cfg.Dev.FixturesDir, "projects", &projectLinks); err != nil {
+		return nil, err
+	}
+	if err := fixtures.Load(cfg.Dev.FixturesDir, "experiences", &experienceLinks); err != nil {
+		return nil, err
+	}
+
+	for _, row := range projectLinks {
+		for _, techStackID := range row.TechStackIDs {
+			data.ProjectTechStacks = append(data.ProjectTechStacks, project.ProjectTechStack{ProjectID: row.ID, TechStackID: techStackID})
+		}
+	}
+	for _, row := range experienceLinks {
+		for _, techStackID := range row.TechStackIDs {
+			data.ExperienceTechStacks = append(data.ExperienceTechStacks, experience.ExperienceTechStack{ExperienceID: row.ID, TechStackID: techStackID})
+		}
+	}
+
+	// Requests resolve to the default tenant, so one is always needed
+	if len(data.Tenants) == 0 {
+		data.Tenants = []tenant.Tenant{{ID: uuid.New(), Slug: "dev", Name: "Development", IsActive: true, IsDefault: true}}
+	}
+
+	return data, nil
+}
+
+// initializeDatabase connects to Postgres when repositories are configured
+// to query it directly. Only the project and tech stack repositories have a
+// Postgres implementation so far, so Config.Validate refuses the driver
+// until the rest of the request path is ported.
+func initializeDatabase(cfg *configs.Config, slowQueries *slowcall.Tracker) (*database.DB, error) {
+	switch cfg.Database.Driver {
+	case "", "supabase":
+		return nil, nil
+	case "postgres":
+		dbConfig := database.Config{
+			Host:     cfg.Database.Host,
+			Port:     cfg.Database.Port,
+			User:     cfg.Database.User,
+			Password: cfg.Database.Password,
+			Name:     cfg.Database.DatabaseName,
+			Schema:   cfg.Database.Schema,
+			SSLMode:  cfg.Database.SSLMode,
+			MaxConns: int32(cfg.Database.MaxConns),
+		}
+		if cfg.Diagnostics.SlowQueryThreshold > 0 {
+			dbConfig.SlowQueries = slowQueries
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		return database.Open(ctx, dbConfig)
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", cfg.Database.Driver)
+	}
+}
+
+// initializeStorage creates the storage backend selected by config
+func initializeStorage(cfg *configs.Config) (storage.Storage, error) {
+	limits := storage.Limits{
+		MaxFileSize:      cfg.Supabase.MaxFileSize,
+		AllowedFileTypes: cfg.Supabase.AllowedFileTypes,
 	}
 
-	log.Info("JWKS keys initialized successfully")
-	return jwks
+	switch cfg.Storage.Backend {
+	case "", "supabase":
+		return supabase.NewSupabaseStorage(supabase.StorageConfig{
+			ProjectID:           cfg.Supabase.ProjectID,
+			JwtApiSecret:        cfg.Supabase.JWTSecret,
+			BucketID:            cfg.Supabase.StorageBucketID,
+			DefaultFolder:       cfg.Supabase.DefaultStorageFolder,
+			MaxFileSize:         cfg.Supabase.MaxFileSize,
+			AllowedFileTypes:    cfg.Supabase.AllowedFileTypes,
+			DefaultCacheControl: cfg.Supabase.CacheControl,
+		})
+	case "s3":
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:        cfg.Storage.S3Endpoint,
+			Region:          cfg.Storage.S3Region,
+			Bucket:          cfg.Storage.S3Bucket,
+			AccessKeyID:     cfg.Storage.S3AccessKeyID,
+			SecretAccessKey: cfg.Storage.S3SecretAccessKey,
+			PublicURL:       cfg.Storage.S3PublicURL,
+			DefaultFolder:   cfg.Supabase.DefaultStorageFolder,
+			CacheControl:    cfg.Supabase.CacheControl,
+			Limits:          limits,
+			Timeout:         cfg.Storage.S3Timeout,
+		})
+	case "local":
+		return storage.NewLocalStorage(storage.LocalConfig{
+			Dir:           cfg.Storage.LocalDir,
+			BaseURL:       cfg.Storage.LocalURL,
+			DefaultFolder: cfg.Supabase.DefaultStorageFolder,
+			Limits:        limits,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+	}
 }
 
 // initializeJWTMiddleware creates JWT authentication middleware
 func initializeJWTMiddleware(
-	jwks *keyfunc.JWKS,
+	jwks *middleware.JWKSLoader,
 	allowedEmails []string,
 	log *logger.Logger,
 ) *middleware.Middleware {
@@ -389,8 +2500,29 @@ func initializeJWTMiddleware(
 	)
 }
 
+// initializeCORSPolicy sets up the CORS policy from the configuration,
+// returning nil when CORS is disabled
+func initializeCORSPolicy(cfg *configs.Config) (*middleware.CORSPolicy, error) {
+	if !cfg.CORS.CORSEnabled {
+		return nil, nil
+	}
+
+	if cfg.Environment != "production" {
+		cfg.CORS.MaxAge = 0
+	}
+
+	return middleware.NewCORSPolicy(cors.Config{
+		AllowOrigins:     cfg.CORS.AllowedOrigins,
+		AllowMethods:     cfg.CORS.AllowedMethods,
+		AllowHeaders:     cfg.CORS.AllowedHeaders,
+		ExposeHeaders:    cfg.CORS.ExposedHeaders,
+		AllowCredentials: cfg.CORS.AllowCredentials,
+		MaxAge:           time.Duration(cfg.CORS.MaxAge) * time.Second,
+	})
+}
+
 // initializeRouter sets up the Gin router with global middleware
-func initializeRouter(log *logger.Logger, cfg *configs.Config) *gin.Engine {
+func initializeRouter(accessLogger *logger.Logger, cfg *configs.Config, corsPolicy *middleware.CORSPolicy) *gin.Engine {
 	// Set Gin mode based on environment
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -400,42 +2532,52 @@ func initializeRouter(log *logger.Logger, cfg *configs.Config) *gin.Engine {
 
 	router := gin.New()
 
-	// Global middleware
+	// Global middleware. Requests get their ID and are logged before
+	// recovery so panics are logged as server errors.
+	router.Use(middleware.RequestID())
+	router.Use(middleware.ResponseProfile(map[string]response.Profile{
+		"/api/v2/": response.ProfileV2,
+	}))
+	router.Use(middleware.AccessLog(accessLogger, middleware.AccessLogConfig{
+		SampleRate: cfg.Logging.AccessSampleRate,
+	}))
 	router.Use(gin.Recovery())
 
-	// CORS Middleware
-	// CORS Middleware
-	if cfg.CORS.CORSEnabled {
-		if cfg.Environment != "production" {
-			cfg.CORS.MaxAge = 0
-		}
+	// Security Headers Middleware
+	router.Use(middleware.SecurityHeaders(middleware.SecurityHeadersConfig{
+		Enabled:               cfg.Security.HeadersEnabled,
+		HSTSMaxAge:            cfg.Security.HSTSMaxAge,
+		HSTSIncludeSubdomains: cfg.Security.HSTSIncludeSubdomains,
+		HSTSPreload:           cfg.Security.HSTSPreload,
+		FrameOptions:          cfg.Security.FrameOptions,
+		ReferrerPolicy:        cfg.Security.ReferrerPolicy,
+		PermissionsPolicy:     cfg.Security.PermissionsPolicy,
+		ContentSecurityPolicy: cfg.Security.ContentSecurityPolicy,
+		PathPolicies: map[string]string{
+			"/swagger/":   cfg.Security.SwaggerCSP,
+			"/api/v1/og/": cfg.Security.OGImageCSP,
+			"/api/v2/og/": cfg.Security.OGImageCSP,
+		},
+	}))
 
-		corsConfig := cors.Config{
-			AllowOrigins:     cfg.CORS.AllowedOrigins,
-			AllowMethods:     cfg.CORS.AllowedMethods,
-			AllowHeaders:     cfg.CORS.AllowedHeaders,
-			ExposeHeaders:    cfg.CORS.ExposedHeaders,
-			AllowCredentials: cfg.CORS.AllowCredentials,
-			MaxAge:           time.Duration(cfg.CORS.MaxAge) * time.Second,
-		}
+	// Body Limit Middleware
+	router.Use(middleware.BodyLimit(
+		middleware.BodyLimitConfig{
+			Default:   cfg.BodyLimit.DefaultBytes,
+			Multipart: cfg.BodyLimit.MultipartBytes,
+		},
+		map[string]middleware.BodyLimitConfig{
+			"/api/v1/analytics/": {Default: cfg.BodyLimit.AnalyticsBytes},
+			"/api/v2/analytics/": {Default: cfg.BodyLimit.AnalyticsBytes},
+		},
+	))
 
-		router.Use(cors.New(corsConfig))
+	// CORS Middleware
+	if corsPolicy != nil {
+		router.Use(corsPolicy.Handler())
 	} else {
 		router.Use(cors.New(cors.DefaultConfig()))
 	}
 
-	// Logging middleware
-	router.Use(func(c *gin.Context) {
-		start := time.Now()
-		c.Next()
-
-		log.Info("Request processed",
-			"method", c.Request.Method,
-			"path", c.Request.URL.Path,
-			"status", c.Writer.Status(),
-			"latency", time.Since(start),
-		)
-	})
-
 	return router
 }
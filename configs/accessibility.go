@@ -0,0 +1,22 @@
+package configs
+
+import "time"
+
+type AccessibilityConfig struct {
+	// Enabled turns on audits of all content on an interval and after
+	// content is published or changed
+	Enabled bool
+
+	// Interval is how often every tenant is audited, and Delay how long to
+	// wait after a content change before doing so
+	Interval time.Duration
+	Delay    time.Duration
+}
+
+func loadAccessibilityConfig() AccessibilityConfig {
+	return AccessibilityConfig{
+		Enabled:  getEnvAsBool("ACCESSIBILITY_AUDIT_ENABLED", true),
+		Interval: time.Duration(getEnvAsInt("ACCESSIBILITY_AUDIT_INTERVAL_HOURS", 24)) * time.Hour,
+		Delay:    time.Duration(getEnvAsInt("ACCESSIBILITY_AUDIT_DELAY_SECONDS", 30)) * time.Second,
+	}
+}
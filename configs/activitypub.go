@@ -0,0 +1,48 @@
+package configs
+
+import "time"
+
+type ActivityPubConfig struct {
+	Enabled bool
+
+	// BaseURL is the public URL of the API, which actor and note IDs are
+	// built from. The path /activitypub/users/{tenant} is appended to it.
+	BaseURL string
+
+	// Domain is the domain of actor handles, @{tenant}@{domain}. It defaults
+	// to the host of BaseURL; when it differs, the site must forward
+	// /.well-known/webfinger to the API.
+	Domain string
+
+	// ProjectPath is the path of project pages on the site, below the
+	// canonical URL of the site config. {slug} is replaced by the project slug.
+	ProjectPath string
+
+	Timeout   time.Duration
+	UserAgent string
+
+	// PublishInterval is how often new public projects are looked for, and
+	// PublishDelay how long to wait after a project changes before doing so
+	PublishInterval time.Duration
+	PublishDelay    time.Duration
+
+	// InboxRateLimit is the number of activities an IP can deliver per
+	// InboxRateWindow
+	InboxRateLimit  int
+	InboxRateWindow time.Duration
+}
+
+func loadActivityPubConfig() ActivityPubConfig {
+	return ActivityPubConfig{
+		Enabled:         getEnvAsBool("ACTIVITYPUB_ENABLED", false),
+		BaseURL:         getEnv("ACTIVITYPUB_BASE_URL", "http://localhost:8080/api/v1"),
+		Domain:          getEnv("ACTIVITYPUB_DOMAIN", ""),
+		ProjectPath:     getEnv("ACTIVITYPUB_PROJECT_PATH", "/projects/{slug}"),
+		Timeout:         time.Duration(getEnvAsInt("ACTIVITYPUB_TIMEOUT_SECONDS", 10)) * time.Second,
+		UserAgent:       getEnv("ACTIVITYPUB_USER_AGENT", "itsrama-activitypub/1.0"),
+		PublishInterval: time.Duration(getEnvAsInt("ACTIVITYPUB_PUBLISH_INTERVAL_MINUTES", 60)) * time.Minute,
+		PublishDelay:    time.Duration(getEnvAsInt("ACTIVITYPUB_PUBLISH_DELAY_SECONDS", 60)) * time.Second,
+		InboxRateLimit:  getEnvAsInt("ACTIVITYPUB_INBOX_RATE_LIMIT", 120),
+		InboxRateWindow: time.Duration(getEnvAsInt("ACTIVITYPUB_INBOX_RATE_WINDOW_MINUTES", 10)) * time.Minute,
+	}
+}
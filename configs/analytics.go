@@ -0,0 +1,24 @@
+package configs
+
+import "time"
+
+type AnalyticsConfig struct {
+	// GeoIPDatabasePath is a local MaxMind GeoIP2/GeoLite2 database, empty to disable lookups
+	GeoIPDatabasePath string
+
+	// RespectDNT skips events from clients sending DNT: 1 or Sec-GPC: 1
+	RespectDNT bool
+
+	// Retention is how long raw events are kept, zero to keep them forever
+	Retention         time.Duration
+	RetentionInterval time.Duration
+}
+
+func loadAnalyticsConfig() AnalyticsConfig {
+	return AnalyticsConfig{
+		GeoIPDatabasePath: getEnv("GEOIP_DATABASE_PATH", ""),
+		RespectDNT:        getEnvAsBool("ANALYTICS_RESPECT_DNT", true),
+		Retention:         time.Duration(getEnvAsInt("ANALYTICS_RETENTION_DAYS", 90)) * 24 * time.Hour,
+		RetentionInterval: time.Duration(getEnvAsInt("ANALYTICS_RETENTION_INTERVAL_MINUTES", 60)) * time.Minute,
+	}
+}
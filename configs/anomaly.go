@@ -0,0 +1,41 @@
+package configs
+
+import "time"
+
+type AnomalyConfig struct {
+	Enabled bool
+
+	// Window is the period requests are counted over, and BaselineWindows
+	// the number of past windows the rolling baseline averages
+	Window          time.Duration
+	BaselineWindows int
+
+	// MinRequests is the request count below which a window is too quiet to
+	// judge error rates or spikes
+	MinRequests int
+
+	// DeviationFactor is how many times the baseline a window must reach,
+	// or fall below for volume drops, to raise an alert
+	DeviationFactor float64
+
+	// ErrorRate and AuthFailureRate are the shares of 5xx responses and
+	// rejected credentials that always count as anomalies
+	ErrorRate       float64
+	AuthFailureRate float64
+
+	// Cooldown is the shortest time between two alerts of the same kind
+	Cooldown time.Duration
+}
+
+func loadAnomalyConfig() AnomalyConfig {
+	return AnomalyConfig{
+		Enabled:         getEnvAsBool("ANOMALY_DETECTION_ENABLED", true),
+		Window:          time.Duration(getEnvAsInt("ANOMALY_WINDOW_SECONDS", 60)) * time.Second,
+		BaselineWindows: getEnvAsInt("ANOMALY_BASELINE_WINDOWS", 30),
+		MinRequests:     getEnvAsInt("ANOMALY_MIN_REQUESTS", 20),
+		DeviationFactor: float64(getEnvAsFloat32("ANOMALY_DEVIATION_FACTOR", 3)),
+		ErrorRate:       float64(getEnvAsFloat32("ANOMALY_ERROR_RATE", 0.05)),
+		AuthFailureRate: float64(getEnvAsFloat32("ANOMALY_AUTH_FAILURE_RATE", 0.25)),
+		Cooldown:        time.Duration(getEnvAsInt("ANOMALY_COOLDOWN_MINUTES", 30)) * time.Minute,
+	}
+}
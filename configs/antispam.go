@@ -0,0 +1,27 @@
+package configs
+
+type AntispamConfig struct {
+	Engines   []string
+	Threshold float64
+
+	// Heuristic engine
+	Keywords []string
+	MaxLinks int
+
+	// Akismet engine
+	AkismetAPIKey string
+	AkismetBlog   string
+}
+
+func loadAntispamConfig() AntispamConfig {
+	return AntispamConfig{
+		Engines:   getEnvAsStringSlice("ANTISPAM_ENGINES", []string{"heuristic"}),
+		Threshold: float64(getEnvAsFloat32("ANTISPAM_THRESHOLD", 0.5)),
+
+		Keywords: getEnvAsStringSlice("ANTISPAM_KEYWORDS", nil),
+		MaxLinks: getEnvAsInt("ANTISPAM_MAX_LINKS", 2),
+
+		AkismetAPIKey: getEnv("AKISMET_API_KEY", ""),
+		AkismetBlog:   getEnv("AKISMET_BLOG_URL", ""),
+	}
+}
@@ -0,0 +1,20 @@
+package configs
+
+type BodyLimitConfig struct {
+	// DefaultBytes limits JSON and other non-multipart request bodies
+	DefaultBytes int64
+
+	// MultipartBytes limits multipart/form-data uploads
+	MultipartBytes int64
+
+	// AnalyticsBytes limits analytics beacons, which are tiny JSON payloads
+	AnalyticsBytes int64
+}
+
+func loadBodyLimitConfig() BodyLimitConfig {
+	return BodyLimitConfig{
+		DefaultBytes:   int64(getEnvAsInt("BODY_LIMIT_DEFAULT_BYTES", 1<<20)),
+		MultipartBytes: int64(getEnvAsInt("BODY_LIMIT_MULTIPART_BYTES", 32<<20)),
+		AnalyticsBytes: int64(getEnvAsInt("BODY_LIMIT_ANALYTICS_BYTES", 16<<10)),
+	}
+}
@@ -0,0 +1,29 @@
+package configs
+
+import "time"
+
+type BulkDeleteConfig struct {
+	// ConfirmThreshold is the number of items above which a bulk delete
+	// must be confirmed by echoing back a token. Zero disables it.
+	ConfirmThreshold int
+
+	// ConfirmTTL is how long a confirmation token can be echoed back
+	ConfirmTTL time.Duration
+
+	// ConfirmSecret signs confirmation tokens. A random secret is used when
+	// it is left empty, which only works with a single instance.
+	ConfirmSecret string
+
+	// RequireSecondAdmin makes a bulk delete confirmable only by an admin
+	// other than the one who asked for it
+	RequireSecondAdmin bool
+}
+
+func loadBulkDeleteConfig() BulkDeleteConfig {
+	return BulkDeleteConfig{
+		ConfirmThreshold:   getEnvAsInt("BULK_DELETE_CONFIRM_THRESHOLD", 10),
+		ConfirmTTL:         time.Duration(getEnvAsInt("BULK_DELETE_CONFIRM_TTL_SECONDS", 300)) * time.Second,
+		ConfirmSecret:      getEnv("BULK_DELETE_CONFIRM_SECRET", ""),
+		RequireSecondAdmin: getEnvAsBool("BULK_DELETE_REQUIRE_SECOND_ADMIN", false),
+	}
+}
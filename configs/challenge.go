@@ -0,0 +1,23 @@
+package configs
+
+import "time"
+
+type ChallengeConfig struct {
+	Enabled       bool
+	Secret        string
+	Difficulty    int
+	TTL           time.Duration
+	MinSolveTime  time.Duration
+	HoneypotField string
+}
+
+func loadChallengeConfig() ChallengeConfig {
+	return ChallengeConfig{
+		Enabled:       getEnvAsBool("CHALLENGE_ENABLED", true),
+		Secret:        getEnv("CHALLENGE_SECRET", ""),
+		Difficulty:    getEnvAsInt("CHALLENGE_DIFFICULTY", 18),
+		TTL:           time.Duration(getEnvAsInt("CHALLENGE_TTL_SECONDS", 300)) * time.Second,
+		MinSolveTime:  time.Duration(getEnvAsInt("CHALLENGE_MIN_SOLVE_SECONDS", 2)) * time.Second,
+		HoneypotField: getEnv("CHALLENGE_HONEYPOT_FIELD", "website"),
+	}
+}
@@ -0,0 +1,18 @@
+package configs
+
+import "time"
+
+type ChangelogConfig struct {
+	// AutoRecord appends changelog entries when content is created, updated or deleted
+	AutoRecord bool
+
+	// CoalesceWindow merges repeated updates of the same entity into one entry
+	CoalesceWindow time.Duration
+}
+
+func loadChangelogConfig() ChangelogConfig {
+	return ChangelogConfig{
+		AutoRecord:     getEnvAsBool("CHANGELOG_AUTO_RECORD", true),
+		CoalesceWindow: time.Duration(getEnvAsInt("CHANGELOG_COALESCE_MINUTES", 60)) * time.Minute,
+	}
+}
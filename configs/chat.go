@@ -0,0 +1,44 @@
+package configs
+
+import "time"
+
+type ChatConfig struct {
+	// Enabled turns on the portfolio chatbot, which answers with Gemini
+	Enabled bool
+
+	// SessionTTL is how long an idle conversation is kept
+	SessionTTL time.Duration
+
+	// MaxTurns is the number of previous questions and answers sent along
+	// with a new question, and MaxMessages the number of questions a
+	// conversation may have
+	MaxTurns    int
+	MaxMessages int
+
+	// MaxSessions bounds the conversations kept in memory
+	MaxSessions int
+
+	// Passages is the number of indexed projects and experiences retrieved
+	// to answer a question
+	Passages int
+
+	// RateLimit is the number of questions an IP can ask per RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+
+	Timeout time.Duration
+}
+
+func loadChatConfig() ChatConfig {
+	return ChatConfig{
+		Enabled:     getEnvAsBool("CHAT_ENABLED", false),
+		SessionTTL:  time.Duration(getEnvAsInt("CHAT_SESSION_TTL_MINUTES", 30)) * time.Minute,
+		MaxTurns:    getEnvAsInt("CHAT_MAX_TURNS", 4),
+		MaxMessages: getEnvAsInt("CHAT_MAX_MESSAGES", 20),
+		MaxSessions: getEnvAsInt("CHAT_MAX_SESSIONS", 1000),
+		Passages:    getEnvAsInt("CHAT_PASSAGES", 5),
+		RateLimit:   getEnvAsInt("CHAT_RATE_LIMIT", 20),
+		RateWindow:  time.Duration(getEnvAsInt("CHAT_RATE_WINDOW_MINUTES", 10)) * time.Minute,
+		Timeout:     time.Duration(getEnvAsInt("CHAT_TIMEOUT_SECONDS", 30)) * time.Second,
+	}
+}
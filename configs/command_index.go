@@ -0,0 +1,16 @@
+package configs
+
+type CommandIndexConfig struct {
+	// ExperiencePath and TechStackPath are the routes of experiences and
+	// tech stacks on the site. {id} is replaced by their ID. Projects and
+	// pages use the paths of the sitemap config.
+	ExperiencePath string
+	TechStackPath  string
+}
+
+func loadCommandIndexConfig() CommandIndexConfig {
+	return CommandIndexConfig{
+		ExperiencePath: getEnv("COMMAND_INDEX_EXPERIENCE_PATH", "/experience#{id}"),
+		TechStackPath:  getEnv("COMMAND_INDEX_TECH_STACK_PATH", "/tech-stack#{id}"),
+	}
+}
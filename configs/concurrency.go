@@ -0,0 +1,28 @@
+package configs
+
+import "time"
+
+// ConcurrencyConfig caps how many requests of each expensive endpoint
+// class run at once. Requests over a limit wait in a queue of the given
+// size for up to QueueTimeout before getting 429.
+type ConcurrencyConfig struct {
+	// AI generation: the chatbot and job description matching
+	AILimit     int
+	AIQueueSize int
+
+	// Rendering: screenshots, exports and documents
+	RenderLimit     int
+	RenderQueueSize int
+
+	QueueTimeout time.Duration
+}
+
+func loadConcurrencyConfig() ConcurrencyConfig {
+	return ConcurrencyConfig{
+		AILimit:         getEnvAsInt("CONCURRENCY_AI_LIMIT", 4),
+		AIQueueSize:     getEnvAsInt("CONCURRENCY_AI_QUEUE_SIZE", 8),
+		RenderLimit:     getEnvAsInt("CONCURRENCY_RENDER_LIMIT", 2),
+		RenderQueueSize: getEnvAsInt("CONCURRENCY_RENDER_QUEUE_SIZE", 4),
+		QueueTimeout:    time.Duration(getEnvAsInt("CONCURRENCY_QUEUE_TIMEOUT_SECONDS", 10)) * time.Second,
+	}
+}
@@ -8,14 +8,60 @@ import (
 )
 
 type Config struct {
-	Environment string
-	Server      ServerConfig
-	CORS        CORSConfig
-	Supabase    SupabaseConfig
-	Database    DatabaseConfig
-	Gemini      GeminiAIConfig
-	Logging     LoggingConfig
-	RateLimiter RateLimiterConfig
+	Environment   string
+	Server        ServerConfig
+	CORS          CORSConfig
+	Supabase      SupabaseConfig
+	Database      DatabaseConfig
+	Storage       StorageConfig
+	Gemini        GeminiAIConfig
+	Logging       LoggingConfig
+	RateLimiter   RateLimiterConfig
+	Events        EventsConfig
+	Tenant        TenantConfig
+	Mailer        MailerConfig
+	Jobs          JobsConfig
+	TelegramBot   TelegramBotConfig
+	Antispam      AntispamConfig
+	Challenge     ChallengeConfig
+	Analytics     AnalyticsConfig
+	Security      SecurityConfig
+	BodyLimit     BodyLimitConfig
+	Concurrency   ConcurrencyConfig
+	ImageProxy    ImageProxyConfig
+	Screenshot    ScreenshotConfig
+	LinkCheck     LinkCheckConfig
+	Accessibility AccessibilityConfig
+	Icons         IconsConfig
+	Endorsement   EndorsementConfig
+	Dedup         DedupConfig
+	Changelog     ChangelogConfig
+	Spotify       SpotifyConfig
+	WakaTime      WakaTimeConfig
+	ProfileStats  ProfileStatsConfig
+	Pricing       PricingConfig
+	Inquiry       InquiryConfig
+	Stripe        StripeConfig
+	Portal        PortalConfig
+	ProjectShare  ProjectShareConfig
+	PagePreview   PagePreviewConfig
+	BulkDelete    BulkDeleteConfig
+	Embedding     EmbeddingConfig
+	Chat          ChatConfig
+	Media         MediaConfig
+	Anomaly       AnomalyConfig
+	Diagnostics   DiagnosticsConfig
+	Recruiter     RecruiterConfig
+	Webmention    WebmentionConfig
+	Sitemap       SitemapConfig
+	CommandIndex  CommandIndexConfig
+	Embed         EmbedConfig
+	Diagram       DiagramConfig
+	Snippet       SnippetConfig
+	ActivityPub   ActivityPubConfig
+	IndieAuth     IndieAuthConfig
+	Routes        RoutesConfig
+	Dev           DevConfig
 }
 
 func LoadConfig() (*Config, error) {
@@ -33,14 +79,60 @@ func LoadConfig() (*Config, error) {
 	}
 
 	config := &Config{
-		Environment: getEnv("APP_ENV", "development"),
-		Server:      loadServerConfig(),
-		CORS:        loadCORSConfig(),
-		Supabase:    loadSupabaseConfig(),
-		Database:    loadDatabaseConfig(),
-		Gemini:      loadGeminiAIConfig(),
-		Logging:     loadLoggingConfig(),
-		RateLimiter: loadRateLimiterConfig(),
+		Environment:   getEnv("APP_ENV", "development"),
+		Server:        loadServerConfig(),
+		CORS:          loadCORSConfig(),
+		Supabase:      loadSupabaseConfig(),
+		Database:      loadDatabaseConfig(),
+		Storage:       loadStorageConfig(),
+		Gemini:        loadGeminiAIConfig(),
+		Logging:       loadLoggingConfig(),
+		RateLimiter:   loadRateLimiterConfig(),
+		Events:        loadEventsConfig(),
+		Tenant:        loadTenantConfig(),
+		Mailer:        loadMailerConfig(),
+		Jobs:          loadJobsConfig(),
+		TelegramBot:   loadTelegramBotConfig(),
+		Antispam:      loadAntispamConfig(),
+		Challenge:     loadChallengeConfig(),
+		Analytics:     loadAnalyticsConfig(),
+		Security:      loadSecurityConfig(),
+		BodyLimit:     loadBodyLimitConfig(),
+		Concurrency:   loadConcurrencyConfig(),
+		ImageProxy:    loadImageProxyConfig(),
+		Screenshot:    loadScreenshotConfig(),
+		LinkCheck:     loadLinkCheckConfig(),
+		Accessibility: loadAccessibilityConfig(),
+		Icons:         loadIconsConfig(),
+		Endorsement:   loadEndorsementConfig(),
+		Dedup:         loadDedupConfig(),
+		Changelog:     loadChangelogConfig(),
+		Spotify:       loadSpotifyConfig(),
+		WakaTime:      loadWakaTimeConfig(),
+		ProfileStats:  loadProfileStatsConfig(),
+		Pricing:       loadPricingConfig(),
+		Inquiry:       loadInquiryConfig(),
+		Stripe:        loadStripeConfig(),
+		Portal:        loadPortalConfig(),
+		ProjectShare:  loadProjectShareConfig(),
+		PagePreview:   loadPagePreviewConfig(),
+		BulkDelete:    loadBulkDeleteConfig(),
+		Embedding:     loadEmbeddingConfig(),
+		Chat:          loadChatConfig(),
+		Media:         loadMediaConfig(),
+		Anomaly:       loadAnomalyConfig(),
+		Diagnostics:   loadDiagnosticsConfig(),
+		Recruiter:     loadRecruiterConfig(),
+		Webmention:    loadWebmentionConfig(),
+		Sitemap:       loadSitemapConfig(),
+		CommandIndex:  loadCommandIndexConfig(),
+		Embed:         loadEmbedConfig(),
+		Diagram:       loadDiagramConfig(),
+		Snippet:       loadSnippetConfig(),
+		ActivityPub:   loadActivityPubConfig(),
+		IndieAuth:     loadIndieAuthConfig(),
+		Routes:        loadRoutesConfig(),
+		Dev:           loadDevConfig(),
 	}
 
 	createDirIfNotExists(config.Logging.FilePath)
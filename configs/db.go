@@ -1,6 +1,9 @@
 package configs
 
 type DatabaseConfig struct {
+	// Driver selects how repositories reach the database: "supabase" for the
+	// REST API or "postgres" to connect directly
+	Driver       string
 	Host         string
 	Port         int
 	User         string
@@ -8,6 +11,8 @@ type DatabaseConfig struct {
 	DatabaseName string
 	PoolMode     string
 	Schema       string
+	SSLMode      string
+	MaxConns     int
 }
 
 type SupabaseConfig struct {
@@ -38,6 +43,7 @@ func loadSupabaseConfig() SupabaseConfig {
 
 func loadDatabaseConfig() DatabaseConfig {
 	return DatabaseConfig{
+		Driver:       getEnv("DB_DRIVER", "supabase"),
 		Host:         getEnv("DB_HOST", "localhost"),
 		Port:         getEnvAsInt("DB_PORT", 5432),
 		User:         getEnv("DB_USER", ""),
@@ -45,5 +51,7 @@ func loadDatabaseConfig() DatabaseConfig {
 		DatabaseName: getEnv("DB_NAME", ""),
 		PoolMode:     getEnv("DB_POOL_MODE", "transaction"),
 		Schema:       getEnv("DB_SCHEMA", "itsrama"),
+		SSLMode:      getEnv("DB_SSL_MODE", "disable"),
+		MaxConns:     getEnvAsInt("DB_MAX_CONNS", 10),
 	}
 }
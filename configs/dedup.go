@@ -0,0 +1,15 @@
+package configs
+
+// DedupConfig configures the duplicate check run when projects and
+// experiences are created
+type DedupConfig struct {
+	// Mode is off, warn (create and report probable duplicates) or block
+	// (reject them unless the request sets allow_duplicate)
+	Mode string
+}
+
+func loadDedupConfig() DedupConfig {
+	return DedupConfig{
+		Mode: getEnv("DEDUP_MODE", "warn"),
+	}
+}
@@ -0,0 +1,23 @@
+package configs
+
+type DevConfig struct {
+	// Enabled runs the API without external services: content is served
+	// from in-memory repositories seeded from FixturesDir, files are written
+	// to a temporary directory and admin routes need no token. It is also
+	// turned on by the --dev flag, and refused when APP_ENV is production.
+	Enabled bool
+
+	FixturesDir string
+
+	// SupabaseURL is used for the features without an in-memory
+	// repository, such as a stack started with the Supabase CLI
+	SupabaseURL string
+}
+
+func loadDevConfig() DevConfig {
+	return DevConfig{
+		Enabled:     getEnvAsBool("DEV_MODE", false),
+		FixturesDir: getEnv("DEV_FIXTURES_DIR", "testdata/fixtures"),
+		SupabaseURL: getEnv("DEV_SUPABASE_URL", "http://127.0.0.1:54321"),
+	}
+}
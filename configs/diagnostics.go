@@ -0,0 +1,18 @@
+package configs
+
+import "time"
+
+type DiagnosticsConfig struct {
+	// SlowQueryThreshold and SlowRequestThreshold are the latencies above
+	// which database calls and handlers are logged and counted as slow.
+	// Zero disables either.
+	SlowQueryThreshold   time.Duration
+	SlowRequestThreshold time.Duration
+}
+
+func loadDiagnosticsConfig() DiagnosticsConfig {
+	return DiagnosticsConfig{
+		SlowQueryThreshold:   time.Duration(getEnvAsInt("SLOW_QUERY_THRESHOLD_MS", 500)) * time.Millisecond,
+		SlowRequestThreshold: time.Duration(getEnvAsInt("SLOW_REQUEST_THRESHOLD_MS", 2000)) * time.Millisecond,
+	}
+}
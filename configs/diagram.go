@@ -0,0 +1,19 @@
+package configs
+
+import "time"
+
+type DiagramConfig struct {
+	// RendererURL is the Kroki compatible server rendering Mermaid and
+	// PlantUML diagrams to SVG. Diagrams stay code blocks when it is empty.
+	RendererURL string
+
+	// Timeout bounds rendering a single diagram
+	Timeout time.Duration
+}
+
+func loadDiagramConfig() DiagramConfig {
+	return DiagramConfig{
+		RendererURL: getEnv("DIAGRAM_RENDERER_URL", ""),
+		Timeout:     time.Duration(getEnvAsInt("DIAGRAM_TIMEOUT_SECONDS", 15)) * time.Second,
+	}
+}
@@ -0,0 +1,20 @@
+package configs
+
+import "time"
+
+type EmbedConfig struct {
+	// BaseURL is the public URL of the API, which the link preview images
+	// returned by oEmbed are served under
+	BaseURL string
+
+	// CacheTTL is how long oEmbed responses and link preview images are
+	// cached by the API and by clients
+	CacheTTL time.Duration
+}
+
+func loadEmbedConfig() EmbedConfig {
+	return EmbedConfig{
+		BaseURL:  getEnv("EMBED_BASE_URL", "http://localhost:8080/api/v1"),
+		CacheTTL: time.Duration(getEnvAsInt("EMBED_CACHE_TTL_MINUTES", 60)) * time.Minute,
+	}
+}
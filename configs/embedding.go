@@ -0,0 +1,40 @@
+package configs
+
+import "time"
+
+type EmbeddingConfig struct {
+	// Provider is "openai", "gemini" or "none"
+	Provider string
+	APIKey   string
+
+	// APIURL overrides the provider's base URL, e.g. for an OpenAI
+	// compatible service
+	APIURL string
+	Model  string
+
+	// Dimensions must match the vector column of the content_embedding table
+	Dimensions int
+	Timeout    time.Duration
+
+	// BatchSize is the number of documents embedded per request
+	BatchSize int
+
+	// ReindexInterval is how often every tenant is re-embedded, and
+	// ReindexDelay how long to wait after a content change before doing so
+	ReindexInterval time.Duration
+	ReindexDelay    time.Duration
+}
+
+func loadEmbeddingConfig() EmbeddingConfig {
+	return EmbeddingConfig{
+		Provider:        getEnv("EMBEDDING_PROVIDER", "none"),
+		APIKey:          getEnv("EMBEDDING_API_KEY", ""),
+		APIURL:          getEnv("EMBEDDING_API_URL", ""),
+		Model:           getEnv("EMBEDDING_MODEL", ""),
+		Dimensions:      getEnvAsInt("EMBEDDING_DIMENSIONS", 768),
+		Timeout:         time.Duration(getEnvAsInt("EMBEDDING_TIMEOUT_SECONDS", 30)) * time.Second,
+		BatchSize:       getEnvAsInt("EMBEDDING_BATCH_SIZE", 32),
+		ReindexInterval: time.Duration(getEnvAsInt("EMBEDDING_REINDEX_INTERVAL_HOURS", 24)) * time.Hour,
+		ReindexDelay:    time.Duration(getEnvAsInt("EMBEDDING_REINDEX_DELAY_SECONDS", 30)) * time.Second,
+	}
+}
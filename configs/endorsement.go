@@ -0,0 +1,21 @@
+package configs
+
+import "time"
+
+type EndorsementConfig struct {
+	// Secret keys the visitor hashes used to deduplicate endorsements.
+	// Deduplication resets on restart when it is left empty.
+	Secret string
+
+	// RateLimit is the number of endorsements an IP can make per RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func loadEndorsementConfig() EndorsementConfig {
+	return EndorsementConfig{
+		Secret:     getEnv("ENDORSEMENT_SECRET", ""),
+		RateLimit:  getEnvAsInt("ENDORSEMENT_RATE_LIMIT", 10),
+		RateWindow: time.Duration(getEnvAsInt("ENDORSEMENT_RATE_WINDOW_MINUTES", 60)) * time.Minute,
+	}
+}
@@ -0,0 +1,22 @@
+package configs
+
+import "time"
+
+type EventsConfig struct {
+	HistorySize       int
+	MaxConnections    int
+	KeepAliveInterval time.Duration
+	// QueryTokenMaxLifetime bounds the remaining lifetime of tokens sent in
+	// the access_token query parameter by clients that cannot set headers.
+	// The default matches the one hour Supabase access tokens are valid for.
+	QueryTokenMaxLifetime time.Duration
+}
+
+func loadEventsConfig() EventsConfig {
+	return EventsConfig{
+		HistorySize:           getEnvAsInt("EVENTS_HISTORY_SIZE", 500),
+		MaxConnections:        getEnvAsInt("EVENTS_MAX_CONNECTIONS", 10),
+		KeepAliveInterval:     time.Duration(getEnvAsInt("EVENTS_KEEP_ALIVE_SECONDS", 15)) * time.Second,
+		QueryTokenMaxLifetime: time.Duration(getEnvAsInt("EVENTS_QUERY_TOKEN_MAX_LIFETIME_SECONDS", 3600)) * time.Second,
+	}
+}
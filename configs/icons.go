@@ -0,0 +1,21 @@
+package configs
+
+import "time"
+
+type IconsConfig struct {
+	// AutoFetch fetches a logo for tech stacks created without an image
+	AutoFetch bool
+
+	// Sources are the icon sets tried in order ("simpleicons", "devicon")
+	Sources []string
+
+	Timeout time.Duration
+}
+
+func loadIconsConfig() IconsConfig {
+	return IconsConfig{
+		AutoFetch: getEnvAsBool("ICON_AUTO_FETCH", true),
+		Sources:   getEnvAsStringSlice("ICON_SOURCES", []string{"simpleicons", "devicon"}),
+		Timeout:   time.Duration(getEnvAsInt("ICON_FETCH_TIMEOUT_SECONDS", 10)) * time.Second,
+	}
+}
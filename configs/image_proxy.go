@@ -0,0 +1,20 @@
+package configs
+
+type ImageProxyConfig struct {
+	// CacheBackend is "disk", "storage" or "none"
+	CacheBackend string
+
+	// CacheDir is the local directory or storage folder holding transformed images
+	CacheDir string
+
+	// CacheMaxAge is the Cache-Control max-age of served images in seconds
+	CacheMaxAge int
+}
+
+func loadImageProxyConfig() ImageProxyConfig {
+	return ImageProxyConfig{
+		CacheBackend: getEnv("IMAGE_CACHE_BACKEND", "disk"),
+		CacheDir:     getEnv("IMAGE_CACHE_DIR", "./cache/images"),
+		CacheMaxAge:  getEnvAsInt("IMAGE_CACHE_MAX_AGE", 31536000),
+	}
+}
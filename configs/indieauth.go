@@ -0,0 +1,40 @@
+package configs
+
+import "time"
+
+type IndieAuthConfig struct {
+	Enabled bool
+
+	// BaseURL is the public URL of the API, which the authorization, token
+	// and metadata endpoints are advertised under. The site should link the
+	// metadata endpoint with rel="indieauth-metadata".
+	BaseURL string
+
+	// ConsentPath is the page on the site, below the canonical URL of the
+	// site config, where the signed-in owner approves authorization requests.
+	// It receives the request as query parameters.
+	ConsentPath string
+
+	// CodeTTL is how long an authorization code can be redeemed
+	CodeTTL time.Duration
+
+	// TokenTTL is how long an access token stays valid
+	TokenTTL time.Duration
+
+	// RateLimit is the number of code redemptions an IP can make per
+	// RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func loadIndieAuthConfig() IndieAuthConfig {
+	return IndieAuthConfig{
+		Enabled:     getEnvAsBool("INDIEAUTH_ENABLED", false),
+		BaseURL:     getEnv("INDIEAUTH_BASE_URL", "http://localhost:8080/api/v1"),
+		ConsentPath: getEnv("INDIEAUTH_CONSENT_PATH", "/admin/indieauth"),
+		CodeTTL:     time.Duration(getEnvAsInt("INDIEAUTH_CODE_TTL_MINUTES", 10)) * time.Minute,
+		TokenTTL:    time.Duration(getEnvAsInt("INDIEAUTH_TOKEN_TTL_DAYS", 90)) * 24 * time.Hour,
+		RateLimit:   getEnvAsInt("INDIEAUTH_RATE_LIMIT", 30),
+		RateWindow:  time.Duration(getEnvAsInt("INDIEAUTH_RATE_WINDOW_MINUTES", 10)) * time.Minute,
+	}
+}
@@ -0,0 +1,39 @@
+package configs
+
+import "time"
+
+type InquiryConfig struct {
+	// LinkSecret signs the links emailed to prospects. Links stop working
+	// on restart when it is left empty.
+	LinkSecret string
+
+	// LinkTTL is how long an emailed link stays valid
+	LinkTTL time.Duration
+
+	// PublicURL is the externally reachable base URL of the API, used to
+	// build the links emailed to prospects
+	PublicURL string
+
+	// AcceptURL is the base URL of the site page where prospects accept
+	// proposals. Acceptance links point at the API when it is left empty.
+	AcceptURL string
+
+	// ProposalValidity is how long a proposal is valid when no validity is given
+	ProposalValidity time.Duration
+
+	// RateLimit is the number of inquiries an IP can submit per RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func loadInquiryConfig() InquiryConfig {
+	return InquiryConfig{
+		LinkSecret:       getEnv("INQUIRY_LINK_SECRET", ""),
+		LinkTTL:          time.Duration(getEnvAsInt("INQUIRY_LINK_TTL_HOURS", 168)) * time.Hour,
+		PublicURL:        getEnv("INQUIRY_PUBLIC_URL", "http://localhost:8080/api/v1"),
+		AcceptURL:        getEnv("INQUIRY_ACCEPT_URL", ""),
+		ProposalValidity: time.Duration(getEnvAsInt("PROPOSAL_VALID_DAYS", 30)) * 24 * time.Hour,
+		RateLimit:        getEnvAsInt("INQUIRY_RATE_LIMIT", 5),
+		RateWindow:       time.Duration(getEnvAsInt("INQUIRY_RATE_WINDOW_MINUTES", 60)) * time.Minute,
+	}
+}
@@ -0,0 +1,25 @@
+package configs
+
+import "time"
+
+type JobsConfig struct {
+	Workers       int
+	PollInterval  time.Duration
+	MaxAttempts   int
+	RetryInterval time.Duration
+	// Lease is how long a job may run without reporting progress before
+	// it is considered abandoned and run again
+	Lease     time.Duration
+	BatchSize int
+}
+
+func loadJobsConfig() JobsConfig {
+	return JobsConfig{
+		Workers:       getEnvAsInt("JOBS_WORKERS", 2),
+		PollInterval:  time.Duration(getEnvAsInt("JOBS_POLL_INTERVAL_SECONDS", 5)) * time.Second,
+		MaxAttempts:   getEnvAsInt("JOBS_MAX_ATTEMPTS", 3),
+		RetryInterval: time.Duration(getEnvAsInt("JOBS_RETRY_INTERVAL_SECONDS", 30)) * time.Second,
+		Lease:         time.Duration(getEnvAsInt("JOBS_LEASE_MINUTES", 15)) * time.Minute,
+		BatchSize:     getEnvAsInt("JOBS_BATCH_SIZE", 10),
+	}
+}
@@ -0,0 +1,21 @@
+package configs
+
+import "time"
+
+type LinkCheckConfig struct {
+	Enabled     bool
+	Interval    time.Duration
+	Timeout     time.Duration
+	Concurrency int
+	UserAgent   string
+}
+
+func loadLinkCheckConfig() LinkCheckConfig {
+	return LinkCheckConfig{
+		Enabled:     getEnvAsBool("LINKCHECK_ENABLED", true),
+		Interval:    time.Duration(getEnvAsInt("LINKCHECK_INTERVAL_HOURS", 24)) * time.Hour,
+		Timeout:     time.Duration(getEnvAsInt("LINKCHECK_TIMEOUT_SECONDS", 10)) * time.Second,
+		Concurrency: getEnvAsInt("LINKCHECK_CONCURRENCY", 4),
+		UserAgent:   getEnv("LINKCHECK_USER_AGENT", "itsrama-linkcheck/1.0"),
+	}
+}
@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type LoggingConfig struct {
 	Level      string
 	FilePath   string
@@ -7,14 +9,64 @@ type LoggingConfig struct {
 	MaxBackups int
 	MaxAge     int
 	Compress   bool
+
+	// ModuleLevels override Level per module as module=level pairs, e.g.
+	// tech_stack=debug,mailer=warn
+	ModuleLevels []string
+
+	// Sinks lists where logs are written: console, file, json, loki and
+	// syslog
+	Sinks []string
+
+	// Loki push API, used by the loki sink. Labels are name=value pairs.
+	LokiURL           string
+	LokiLabels        []string
+	LokiUsername      string
+	LokiPassword      string
+	LokiTenantID      string
+	LokiBatchSize     int
+	LokiFlushInterval time.Duration
+
+	// Access logs are written apart from application logs, to their own
+	// sinks and file. AccessSampleRate is the share of successful requests
+	// logged; errors are always logged.
+	AccessSinks      []string
+	AccessFilePath   string
+	AccessSampleRate float64
+
+	// Syslog server, used by the syslog sink. An empty network and address
+	// use the local syslog daemon.
+	SyslogNetwork string
+	SyslogAddress string
+	SyslogTag     string
 }
 
 func loadLoggingConfig() LoggingConfig {
 	return LoggingConfig{
+		Level:      getEnv("LOG_LEVEL", "info"),
 		FilePath:   getEnv("LOG_FILE_PATH", "./logs/app.log"),
 		MaxSize:    getEnvAsInt("LOG_MAX_SIZE", 100),
 		MaxBackups: getEnvAsInt("LOG_MAX_BACKUPS", 3),
 		MaxAge:     getEnvAsInt("LOG_MAX_AGE", 28),
 		Compress:   getEnvAsBool("LOG_COMPRESS", true),
+
+		ModuleLevels: getEnvAsStringSlice("LOG_MODULE_LEVELS", nil),
+		Sinks:        getEnvAsStringSlice("LOG_SINKS", []string{"console", "file"}),
+
+		LokiURL:           getEnv("LOG_LOKI_URL", ""),
+		LokiLabels:        getEnvAsStringSlice("LOG_LOKI_LABELS", []string{"app=itsrama-portfolio-backend"}),
+		LokiUsername:      getEnv("LOG_LOKI_USERNAME", ""),
+		LokiPassword:      getEnv("LOG_LOKI_PASSWORD", ""),
+		LokiTenantID:      getEnv("LOG_LOKI_TENANT_ID", ""),
+		LokiBatchSize:     getEnvAsInt("LOG_LOKI_BATCH_SIZE", 100),
+		LokiFlushInterval: time.Duration(getEnvAsInt("LOG_LOKI_FLUSH_SECONDS", 5)) * time.Second,
+
+		AccessSinks:      getEnvAsStringSlice("LOG_ACCESS_SINKS", []string{"console", "file"}),
+		AccessFilePath:   getEnv("LOG_ACCESS_FILE_PATH", "./logs/access.log"),
+		AccessSampleRate: float64(getEnvAsFloat32("LOG_ACCESS_SAMPLE_RATE", 1)),
+
+		SyslogNetwork: getEnv("LOG_SYSLOG_NETWORK", ""),
+		SyslogAddress: getEnv("LOG_SYSLOG_ADDRESS", ""),
+		SyslogTag:     getEnv("LOG_SYSLOG_TAG", "itsrama-portfolio-backend"),
 	}
 }
@@ -0,0 +1,47 @@
+package configs
+
+import "time"
+
+type MailerConfig struct {
+	Provider string
+	From     string
+	ReplyTo  string
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	ResendAPIKey string
+
+	SESRegion          string
+	SESAccessKeyID     string
+	SESSecretAccessKey string
+
+	MaxAttempts   int
+	RetryInterval time.Duration
+	BatchSize     int
+}
+
+func loadMailerConfig() MailerConfig {
+	return MailerConfig{
+		Provider: getEnv("MAILER_PROVIDER", "log"),
+		From:     getEnv("MAILER_FROM", ""),
+		ReplyTo:  getEnv("MAILER_REPLY_TO", ""),
+
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+		ResendAPIKey: getEnv("RESEND_API_KEY", ""),
+
+		SESRegion:          getEnv("SES_REGION", ""),
+		SESAccessKeyID:     getEnv("SES_ACCESS_KEY_ID", ""),
+		SESSecretAccessKey: getEnv("SES_SECRET_ACCESS_KEY", ""),
+
+		MaxAttempts:   getEnvAsInt("MAILER_MAX_ATTEMPTS", 5),
+		RetryInterval: time.Duration(getEnvAsInt("MAILER_RETRY_INTERVAL_SECONDS", 60)) * time.Second,
+		BatchSize:     getEnvAsInt("MAILER_BATCH_SIZE", 20),
+	}
+}
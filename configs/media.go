@@ -0,0 +1,19 @@
+package configs
+
+import "time"
+
+type MediaConfig struct {
+	// AltSuggestions turns on alt text suggestions, which describe images
+	// with Gemini
+	AltSuggestions bool
+
+	// Timeout bounds downloading an image and describing it
+	Timeout time.Duration
+}
+
+func loadMediaConfig() MediaConfig {
+	return MediaConfig{
+		AltSuggestions: getEnvAsBool("MEDIA_ALT_SUGGESTIONS_ENABLED", false),
+		Timeout:        time.Duration(getEnvAsInt("MEDIA_TIMEOUT_SECONDS", 30)) * time.Second,
+	}
+}
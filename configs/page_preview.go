@@ -0,0 +1,24 @@
+package configs
+
+import "time"
+
+type PagePreviewConfig struct {
+	// Secret signs draft preview links. Links stop working on restart when
+	// it is left empty.
+	Secret string
+
+	// TTL is how long a preview link works when no expiry is given
+	TTL time.Duration
+
+	// BaseURL is the frontend page rendering previews. The query
+	// ?token=... is appended to it.
+	BaseURL string
+}
+
+func loadPagePreviewConfig() PagePreviewConfig {
+	return PagePreviewConfig{
+		Secret:  getEnv("PAGE_PREVIEW_SECRET", ""),
+		TTL:     time.Duration(getEnvAsInt("PAGE_PREVIEW_TTL_HOURS", 72)) * time.Hour,
+		BaseURL: getEnv("PAGE_PREVIEW_URL", "http://localhost:3000/preview"),
+	}
+}
@@ -0,0 +1,29 @@
+package configs
+
+import "time"
+
+type PortalConfig struct {
+	// LoginURL is the site page that magic links point at. It receives the
+	// login token as the token query parameter and exchanges it for a session.
+	LoginURL string
+
+	// LinkTTL is how long an emailed magic link stays valid
+	LinkTTL time.Duration
+
+	// SessionTTL is how long a portal session lasts after signing in
+	SessionTTL time.Duration
+
+	// RateLimit is the number of magic links an IP can request per RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func loadPortalConfig() PortalConfig {
+	return PortalConfig{
+		LoginURL:   getEnv("PORTAL_LOGIN_URL", "http://localhost:3000/portal/login"),
+		LinkTTL:    time.Duration(getEnvAsInt("PORTAL_LINK_TTL_MINUTES", 15)) * time.Minute,
+		SessionTTL: time.Duration(getEnvAsInt("PORTAL_SESSION_TTL_HOURS", 24)) * time.Hour,
+		RateLimit:  getEnvAsInt("PORTAL_RATE_LIMIT", 5),
+		RateWindow: time.Duration(getEnvAsInt("PORTAL_RATE_WINDOW_MINUTES", 60)) * time.Minute,
+	}
+}
@@ -0,0 +1,27 @@
+package configs
+
+import "time"
+
+type PricingConfig struct {
+	// BaseCurrency is the currency exchange rates are quoted against
+	BaseCurrency string
+
+	// RatesURL is an open.er-api.com compatible endpoint with a {base} placeholder
+	RatesURL string
+
+	// RefreshEnabled refreshes exchange rates on RefreshInterval
+	RefreshEnabled  bool
+	RefreshInterval time.Duration
+
+	Timeout time.Duration
+}
+
+func loadPricingConfig() PricingConfig {
+	return PricingConfig{
+		BaseCurrency:    getEnv("PRICING_BASE_CURRENCY", "USD"),
+		RatesURL:        getEnv("EXCHANGE_RATE_API_URL", "https://open.er-api.com/v6/latest/{base}"),
+		RefreshEnabled:  getEnvAsBool("EXCHANGE_RATE_REFRESH_ENABLED", true),
+		RefreshInterval: time.Duration(getEnvAsInt("EXCHANGE_RATE_REFRESH_HOURS", 12)) * time.Hour,
+		Timeout:         time.Duration(getEnvAsInt("EXCHANGE_RATE_TIMEOUT_SECONDS", 15)) * time.Second,
+	}
+}
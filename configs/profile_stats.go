@@ -0,0 +1,48 @@
+package configs
+
+import (
+	"strings"
+	"time"
+)
+
+type ProfileStatsConfig struct {
+	// Enabled reads the profiles below on an interval; providers without an
+	// account are skipped
+	Enabled bool
+
+	GitHubUser  string
+	GitHubToken string
+
+	// StackOverflowUserID is the numeric ID in the profile URL
+	StackOverflowUserID string
+	StackExchangeKey    string
+
+	NPMPackages []string
+
+	// Tenant is the slug of the tenant the profiles belong to, empty for the default tenant
+	Tenant string
+
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+func loadProfileStatsConfig() ProfileStatsConfig {
+	var packages []string
+	for _, name := range getEnvAsStringSlice("PROFILE_STATS_NPM_PACKAGES", nil) {
+		if name = strings.TrimSpace(name); name != "" {
+			packages = append(packages, name)
+		}
+	}
+
+	return ProfileStatsConfig{
+		Enabled:             getEnvAsBool("PROFILE_STATS_ENABLED", false),
+		GitHubUser:          getEnv("PROFILE_STATS_GITHUB_USER", ""),
+		GitHubToken:         getEnv("PROFILE_STATS_GITHUB_TOKEN", ""),
+		StackOverflowUserID: getEnv("PROFILE_STATS_STACKOVERFLOW_USER_ID", ""),
+		StackExchangeKey:    getEnv("PROFILE_STATS_STACKEXCHANGE_KEY", ""),
+		NPMPackages:         packages,
+		Tenant:              getEnv("PROFILE_STATS_TENANT", ""),
+		Interval:            time.Duration(getEnvAsInt("PROFILE_STATS_INTERVAL_HOURS", 12)) * time.Hour,
+		Timeout:             time.Duration(getEnvAsInt("PROFILE_STATS_TIMEOUT_SECONDS", 15)) * time.Second,
+	}
+}
@@ -0,0 +1,24 @@
+package configs
+
+import "time"
+
+type ProjectShareConfig struct {
+	// Secret signs project share links. Links stop working on restart when
+	// it is left empty.
+	Secret string
+
+	// TTL is how long a share link works when no expiry is given
+	TTL time.Duration
+
+	// BaseURL is where share links point. The path
+	// /projects/{id}/shared?token=... is appended to it.
+	BaseURL string
+}
+
+func loadProjectShareConfig() ProjectShareConfig {
+	return ProjectShareConfig{
+		Secret:  getEnv("PROJECT_SHARE_SECRET", ""),
+		TTL:     time.Duration(getEnvAsInt("PROJECT_SHARE_TTL_HOURS", 72)) * time.Hour,
+		BaseURL: getEnv("PROJECT_SHARE_URL", "http://localhost:8080/api/v1"),
+	}
+}
@@ -0,0 +1,17 @@
+package configs
+
+import "time"
+
+type RecruiterConfig struct {
+	// JobMatchRateLimit is the number of job descriptions an IP can match
+	// per JobMatchRateWindow
+	JobMatchRateLimit  int
+	JobMatchRateWindow time.Duration
+}
+
+func loadRecruiterConfig() RecruiterConfig {
+	return RecruiterConfig{
+		JobMatchRateLimit:  getEnvAsInt("JOB_MATCH_RATE_LIMIT", 30),
+		JobMatchRateWindow: time.Duration(getEnvAsInt("JOB_MATCH_RATE_WINDOW_MINUTES", 60)) * time.Minute,
+	}
+}
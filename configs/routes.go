@@ -0,0 +1,51 @@
+package configs
+
+type RoutesConfig struct {
+	// RestrictedEnvironments are the APP_ENV values in which tagged route
+	// groups are hidden or gated
+	RestrictedEnvironments []string
+
+	// HiddenTags are route tags not served at all, answering 404. Profiling
+	// and runtime inspection are tagged admin, so they stay available to
+	// admins unless admin is hidden or gated here.
+	HiddenTags []string
+
+	// GatedTags are route tags served only to clients in
+	// GateAllowedNetworks, on top of their usual authentication
+	GatedTags           []string
+	GateAllowedNetworks []string
+
+	// Deprecated lists deprecated routes as
+	// "[METHOD ]PREFIX|DEPRECATED_AT[|SUNSET_AT[|LINK]]", dates as YYYY-MM-DD
+	Deprecated []string
+
+	// SunsetEnforced answers 410 Gone on deprecated routes past their sunset
+	SunsetEnforced bool
+
+	// DeprecationQuietDays without traffic mark a deprecated route safe to
+	// remove
+	DeprecationQuietDays int
+}
+
+func loadRoutesConfig() RoutesConfig {
+	return RoutesConfig{
+		RestrictedEnvironments: getEnvAsStringSlice("ROUTES_RESTRICTED_ENVIRONMENTS", []string{"production"}),
+		HiddenTags:             getEnvAsStringSlice("ROUTES_HIDDEN_TAGS", []string{"debug"}),
+		GatedTags:              getEnvAsStringSlice("ROUTES_GATED_TAGS", []string{}),
+		GateAllowedNetworks:    getEnvAsStringSlice("ROUTES_GATE_ALLOWED_NETWORKS", []string{"127.0.0.1/32", "::1/128"}),
+		Deprecated:             getEnvAsStringSlice("ROUTES_DEPRECATED", []string{}),
+		SunsetEnforced:         getEnvAsBool("ROUTES_SUNSET_ENFORCED", true),
+		DeprecationQuietDays:   getEnvAsInt("ROUTES_DEPRECATION_QUIET_DAYS", 14),
+	}
+}
+
+// Restricted reports whether tagged route groups are hidden or gated in
+// the given environment
+func (c RoutesConfig) Restricted(environment string) bool {
+	for _, restricted := range c.RestrictedEnvironments {
+		if restricted == environment {
+			return true
+		}
+	}
+	return false
+}
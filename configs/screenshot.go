@@ -0,0 +1,29 @@
+package configs
+
+import "time"
+
+type ScreenshotConfig struct {
+	// Provider is "api", "chrome" or "none"
+	Provider string
+
+	// APIURL is the screenshot API endpoint with {url}, {width} and {height} placeholders
+	APIURL string
+
+	// ChromePath is the headless Chrome binary used by the chrome provider
+	ChromePath string
+
+	Width   int
+	Height  int
+	Timeout time.Duration
+}
+
+func loadScreenshotConfig() ScreenshotConfig {
+	return ScreenshotConfig{
+		Provider:   getEnv("SCREENSHOT_PROVIDER", "none"),
+		APIURL:     getEnv("SCREENSHOT_API_URL", ""),
+		ChromePath: getEnv("SCREENSHOT_CHROME_PATH", "chromium"),
+		Width:      getEnvAsInt("SCREENSHOT_WIDTH", 1280),
+		Height:     getEnvAsInt("SCREENSHOT_HEIGHT", 800),
+		Timeout:    time.Duration(getEnvAsInt("SCREENSHOT_TIMEOUT_SECONDS", 30)) * time.Second,
+	}
+}
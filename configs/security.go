@@ -0,0 +1,48 @@
+package configs
+
+type SecurityConfig struct {
+	HeadersEnabled bool
+
+	HSTSMaxAge            int
+	HSTSIncludeSubdomains bool
+	HSTSPreload           bool
+
+	FrameOptions      string
+	ReferrerPolicy    string
+	PermissionsPolicy string
+
+	// Content security policies for the JSON API, the Swagger UI, generated OG
+	// images and printable case studies
+	ContentSecurityPolicy string
+	SwaggerCSP            string
+	OGImageCSP            string
+	PrintCSP              string
+}
+
+func loadSecurityConfig() SecurityConfig {
+	// HSTS is only sent by default in production, where TLS is terminated in front of the API
+	hstsMaxAge := 0
+	if getEnv("APP_ENV", "development") == "production" {
+		hstsMaxAge = 31536000
+	}
+
+	return SecurityConfig{
+		HeadersEnabled: getEnvAsBool("SECURITY_HEADERS_ENABLED", true),
+
+		HSTSMaxAge:            getEnvAsInt("SECURITY_HSTS_MAX_AGE", hstsMaxAge),
+		HSTSIncludeSubdomains: getEnvAsBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", true),
+		HSTSPreload:           getEnvAsBool("SECURITY_HSTS_PRELOAD", false),
+
+		FrameOptions:      getEnv("SECURITY_FRAME_OPTIONS", "DENY"),
+		ReferrerPolicy:    getEnv("SECURITY_REFERRER_POLICY", "strict-origin-when-cross-origin"),
+		PermissionsPolicy: getEnv("SECURITY_PERMISSIONS_POLICY", "camera=(), microphone=(), geolocation=()"),
+
+		ContentSecurityPolicy: getEnv("SECURITY_CSP", "default-src 'none'; frame-ancestors 'none'"),
+		SwaggerCSP: getEnv("SECURITY_CSP_SWAGGER",
+			"default-src 'self'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; script-src 'self' 'unsafe-inline'; frame-ancestors 'none'"),
+		OGImageCSP: getEnv("SECURITY_CSP_OG_IMAGE",
+			"default-src 'none'; img-src 'self' data:; style-src 'unsafe-inline'"),
+		PrintCSP: getEnv("SECURITY_CSP_PRINT",
+			"default-src 'none'; img-src https: data:; style-src 'unsafe-inline'; frame-ancestors 'none'"),
+	}
+}
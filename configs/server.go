@@ -6,6 +6,8 @@ type ServerConfig struct {
 	ReadTimeout     int
 	WriteTimeout    int
 	ShutdownTimeout int
+	// Seconds each component may take to stop within ShutdownTimeout
+	ShutdownComponentTimeout int
 }
 
 type CORSConfig struct {
@@ -21,11 +23,12 @@ type CORSConfig struct {
 
 func loadServerConfig() ServerConfig {
 	return ServerConfig{
-		Host:            getEnv("SERVER_HOST", "0.0.0.0"),
-		Port:            getEnvAsInt("SERVER_PORT", 8080),
-		ReadTimeout:     getEnvAsInt("SERVER_READ_TIMEOUT", 15),
-		WriteTimeout:    getEnvAsInt("SERVER_WRITE_TIMEOUT", 15),
-		ShutdownTimeout: getEnvAsInt("SERVER_SHUTDOWN_TIMEOUT", 30),
+		Host:                     getEnv("SERVER_HOST", "0.0.0.0"),
+		Port:                     getEnvAsInt("SERVER_PORT", 8080),
+		ReadTimeout:              getEnvAsInt("SERVER_READ_TIMEOUT", 15),
+		WriteTimeout:             getEnvAsInt("SERVER_WRITE_TIMEOUT", 15),
+		ShutdownTimeout:          getEnvAsInt("SERVER_SHUTDOWN_TIMEOUT", 30),
+		ShutdownComponentTimeout: getEnvAsInt("SERVER_SHUTDOWN_COMPONENT_TIMEOUT", 10),
 	}
 }
 
@@ -35,7 +38,7 @@ func loadCORSConfig() CORSConfig {
 		Domain:           getEnv("CORS_DOMAIN", ""),
 		AllowedOrigins:   getEnvAsStringSlice("CORS_ALLOWED_ORIGINS", []string{"*"}),
 		AllowedMethods:   getEnvAsStringSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"}),
-		AllowedHeaders:   getEnvAsStringSlice("CORS_ALLOWED_HEADERS", []string{"Origin", "Content-Type", "Accept", "Authorization"}),
+		AllowedHeaders:   getEnvAsStringSlice("CORS_ALLOWED_HEADERS", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Visitor-ID"}),
 		ExposedHeaders:   getEnvAsStringSlice("CORS_EXPOSED_HEADERS", []string{}),
 		AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", false),
 		MaxAge:           getEnvAsInt("CORS_MAX_AGE", 0),
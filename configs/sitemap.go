@@ -0,0 +1,16 @@
+package configs
+
+type SitemapConfig struct {
+	// ProjectPath and PagePath are the paths of project and content pages on
+	// the site, below the canonical URL of the site config. {slug} is
+	// replaced by the slug of the project or page.
+	ProjectPath string
+	PagePath    string
+}
+
+func loadSitemapConfig() SitemapConfig {
+	return SitemapConfig{
+		ProjectPath: getEnv("SITEMAP_PROJECT_PATH", "/projects/{slug}"),
+		PagePath:    getEnv("SITEMAP_PAGE_PATH", "/{slug}"),
+	}
+}
@@ -0,0 +1,25 @@
+package configs
+
+import "time"
+
+type SnippetConfig struct {
+	// Enabled turns on embedding code from GitHub gists and repository files
+	// in case studies
+	Enabled bool
+
+	// GitHubToken is optional and only raises the GitHub API rate limit
+	GitHubToken string
+
+	// TTL is how long a fetched snippet is served before it is refetched
+	TTL     time.Duration
+	Timeout time.Duration
+}
+
+func loadSnippetConfig() SnippetConfig {
+	return SnippetConfig{
+		Enabled:     getEnvAsBool("SNIPPET_ENABLED", true),
+		GitHubToken: getEnv("SNIPPET_GITHUB_TOKEN", ""),
+		TTL:         time.Duration(getEnvAsInt("SNIPPET_CACHE_TTL_MINUTES", 1440)) * time.Minute,
+		Timeout:     time.Duration(getEnvAsInt("SNIPPET_TIMEOUT_SECONDS", 10)) * time.Second,
+	}
+}
@@ -0,0 +1,30 @@
+package configs
+
+import "time"
+
+type SpotifyConfig struct {
+	// Enabled exposes the listening activity of the account below on /now-playing
+	Enabled      bool
+	ClientID     string
+	ClientSecret string
+
+	// RefreshToken is issued once through the authorization code flow with
+	// the user-read-currently-playing and user-read-recently-played scopes
+	RefreshToken string
+
+	// CacheTTL is how long a fetched track is served before asking Spotify again
+	CacheTTL time.Duration
+
+	Timeout time.Duration
+}
+
+func loadSpotifyConfig() SpotifyConfig {
+	return SpotifyConfig{
+		Enabled:      getEnvAsBool("SPOTIFY_ENABLED", false),
+		ClientID:     getEnv("SPOTIFY_CLIENT_ID", ""),
+		ClientSecret: getEnv("SPOTIFY_CLIENT_SECRET", ""),
+		RefreshToken: getEnv("SPOTIFY_REFRESH_TOKEN", ""),
+		CacheTTL:     time.Duration(getEnvAsInt("SPOTIFY_CACHE_SECONDS", 30)) * time.Second,
+		Timeout:      time.Duration(getEnvAsInt("SPOTIFY_TIMEOUT_SECONDS", 10)) * time.Second,
+	}
+}
@@ -0,0 +1,45 @@
+package configs
+
+import "time"
+
+type StorageConfig struct {
+	// Backend is "supabase", "s3" for S3-compatible stores such as
+	// Cloudflare R2, or "local" for the local disk during development. The
+	// folder, size, type and cache settings of SupabaseConfig apply to all
+	// backends.
+	Backend string
+
+	// LocalDir is the directory the local backend writes to. It is served
+	// by the API under /uploads, and LocalURL is the public URL of that path.
+	LocalDir string
+	LocalURL string
+
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	// S3PublicURL is where the bucket is publicly served from
+	S3PublicURL string
+	S3Timeout   time.Duration
+
+	// QuotaBytes caps the bytes kept in storage, rejecting uploads that
+	// would go over it. 0 disables the quota.
+	QuotaBytes int64
+}
+
+func loadStorageConfig() StorageConfig {
+	return StorageConfig{
+		Backend:           getEnv("STORAGE_BACKEND", "supabase"),
+		LocalDir:          getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+		LocalURL:          getEnv("STORAGE_LOCAL_URL", "http://localhost:8080/uploads"),
+		S3Endpoint:        getEnv("STORAGE_S3_ENDPOINT", ""),
+		S3Region:          getEnv("STORAGE_S3_REGION", "auto"),
+		S3Bucket:          getEnv("STORAGE_S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("STORAGE_S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("STORAGE_S3_SECRET_ACCESS_KEY", ""),
+		S3PublicURL:       getEnv("STORAGE_S3_PUBLIC_URL", ""),
+		S3Timeout:         time.Duration(getEnvAsInt("STORAGE_S3_TIMEOUT_SECONDS", 30)) * time.Second,
+		QuotaBytes:        int64(getEnvAsInt("STORAGE_QUOTA_BYTES", 0)),
+	}
+}
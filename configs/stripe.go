@@ -0,0 +1,32 @@
+package configs
+
+import "time"
+
+type StripeConfig struct {
+	Enabled       bool
+	SecretKey     string
+	WebhookSecret string
+
+	// DepositPercent is the share of an accepted proposal's total charged
+	// as a deposit when no other share is given
+	DepositPercent int
+
+	// SuccessURL and CancelURL are where Checkout sends the client back.
+	// SuccessURL may contain {CHECKOUT_SESSION_ID}.
+	SuccessURL string
+	CancelURL  string
+
+	Timeout time.Duration
+}
+
+func loadStripeConfig() StripeConfig {
+	return StripeConfig{
+		Enabled:        getEnvAsBool("STRIPE_ENABLED", false),
+		SecretKey:      getEnv("STRIPE_SECRET_KEY", ""),
+		WebhookSecret:  getEnv("STRIPE_WEBHOOK_SECRET", ""),
+		DepositPercent: getEnvAsInt("STRIPE_DEPOSIT_PERCENT", 50),
+		SuccessURL:     getEnv("STRIPE_SUCCESS_URL", "http://localhost:3000/payments/success?session_id={CHECKOUT_SESSION_ID}"),
+		CancelURL:      getEnv("STRIPE_CANCEL_URL", "http://localhost:3000/payments/cancelled"),
+		Timeout:        time.Duration(getEnvAsInt("STRIPE_TIMEOUT_SECONDS", 15)) * time.Second,
+	}
+}
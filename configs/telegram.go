@@ -0,0 +1,33 @@
+package configs
+
+import (
+	"strconv"
+	"strings"
+)
+
+type TelegramBotConfig struct {
+	Enabled  bool
+	BotToken string
+
+	// AllowedChatIDs are the only chats the bot answers
+	AllowedChatIDs []int64
+
+	// Tenant is the slug of the tenant managed by the bot, empty for the default tenant
+	Tenant string
+}
+
+func loadTelegramBotConfig() TelegramBotConfig {
+	var chatIDs []int64
+	for _, raw := range getEnvAsStringSlice("TELEGRAM_ALLOWED_CHAT_IDS", nil) {
+		if id, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64); err == nil {
+			chatIDs = append(chatIDs, id)
+		}
+	}
+
+	return TelegramBotConfig{
+		Enabled:        getEnvAsBool("TELEGRAM_BOT_ENABLED", false),
+		BotToken:       getEnv("TELEGRAM_BOT_TOKEN", ""),
+		AllowedChatIDs: chatIDs,
+		Tenant:         getEnv("TELEGRAM_BOT_TENANT", ""),
+	}
+}
@@ -0,0 +1,22 @@
+package configs
+
+import "time"
+
+type TenantConfig struct {
+	Header     string
+	BaseDomain string
+	CacheTTL   time.Duration
+
+	// DomainVerifyPrefix is the DNS label holding custom domain TXT challenges
+	DomainVerifyPrefix string
+}
+
+func loadTenantConfig() TenantConfig {
+	return TenantConfig{
+		Header:     getEnv("TENANT_HEADER", "X-Tenant"),
+		BaseDomain: getEnv("TENANT_BASE_DOMAIN", ""),
+		CacheTTL:   time.Duration(getEnvAsInt("TENANT_CACHE_TTL_SECONDS", 60)) * time.Second,
+
+		DomainVerifyPrefix: getEnv("TENANT_DOMAIN_VERIFY_PREFIX", "_itsrama-verify"),
+	}
+}
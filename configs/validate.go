@@ -0,0 +1,124 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Validate checks the settings the server cannot start without and
+// reports every problem at once
+func (c *Config) Validate() error {
+	var problems []error
+	require := func(value, name string) {
+		if value == "" {
+			problems = append(problems, fmt.Errorf("%s is required", name))
+		}
+	}
+
+	if c.Server.Port <= 0 || c.Server.Port > 65535 {
+		problems = append(problems, fmt.Errorf("SERVER_PORT %d is not a valid port", c.Server.Port))
+	}
+
+	// Development mode lets every admin request through unauthenticated
+	if c.Dev.Enabled && c.Environment == "production" {
+		problems = append(problems, errors.New("DEV_MODE and --dev cannot be used when APP_ENV is production"))
+	}
+
+	// Signed links and visitor hashes must outlive restarts, so only
+	// development may fall back to random secrets
+	if c.Environment != "development" && !c.Dev.Enabled {
+		require(c.PagePreview.Secret, "PAGE_PREVIEW_SECRET")
+		require(c.Inquiry.LinkSecret, "INQUIRY_LINK_SECRET")
+		require(c.Endorsement.Secret, "ENDORSEMENT_SECRET")
+		require(c.ProjectShare.Secret, "PROJECT_SHARE_SECRET")
+		require(c.BulkDelete.ConfirmSecret, "BULK_DELETE_CONFIRM_SECRET")
+	}
+
+	// Development mode does without Supabase
+	if !c.Dev.Enabled {
+		require(c.Supabase.ApiSecretKey, "SUPABASE_API_SECRET_KEY")
+		require(c.Supabase.ProjectID, "SUPABASE_PROJECT_ID")
+	}
+
+	switch c.Database.Driver {
+	case "", "supabase":
+	case "postgres":
+		// Only projects and tech stacks have Postgres repositories so far;
+		// tenants, experiences and the rest of the request path still need
+		// Supabase, so the driver cannot stand on its own yet
+		problems = append(problems, errors.New("DB_DRIVER postgres is not supported yet: only projects and tech stacks have Postgres repositories, use supabase or DEV_MODE"))
+		require(c.Database.Host, "DB_HOST")
+		require(c.Database.User, "DB_USER")
+		require(c.Database.DatabaseName, "DB_NAME")
+	default:
+		problems = append(problems, fmt.Errorf("DB_DRIVER %q is not one of supabase, postgres", c.Database.Driver))
+	}
+
+	switch c.Storage.Backend {
+	case "", "supabase":
+		require(c.Supabase.JWTSecret, "SUPABASE_JWT_API_SECRET_KEY")
+		require(c.Supabase.StorageBucketID, "SUPABASE_STORAGE_BUCKET_ID")
+	case "s3":
+		require(c.Storage.S3Endpoint, "STORAGE_S3_ENDPOINT")
+		require(c.Storage.S3Bucket, "STORAGE_S3_BUCKET")
+		require(c.Storage.S3AccessKeyID, "STORAGE_S3_ACCESS_KEY_ID")
+		require(c.Storage.S3SecretAccessKey, "STORAGE_S3_SECRET_ACCESS_KEY")
+		require(c.Storage.S3PublicURL, "STORAGE_S3_PUBLIC_URL")
+	case "local":
+		require(c.Storage.LocalURL, "STORAGE_LOCAL_URL")
+	default:
+		problems = append(problems, fmt.Errorf("STORAGE_BACKEND %q is not one of supabase, s3, local", c.Storage.Backend))
+	}
+
+	switch c.Dedup.Mode {
+	case "", "off", "warn", "block":
+	default:
+		problems = append(problems, fmt.Errorf("DEDUP_MODE %q is not one of off, warn, block", c.Dedup.Mode))
+	}
+
+	// Logging
+	validLevel := func(level string) bool {
+		switch strings.ToLower(strings.TrimSpace(level)) {
+		case "", "debug", "info", "warn", "warning", "error":
+			return true
+		}
+		return false
+	}
+	if !validLevel(c.Logging.Level) {
+		problems = append(problems, fmt.Errorf("LOG_LEVEL %q is not one of debug, info, warn, error", c.Logging.Level))
+	}
+	for _, pair := range c.Logging.ModuleLevels {
+		module, level, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(module) == "" || !validLevel(level) {
+			problems = append(problems, fmt.Errorf("LOG_MODULE_LEVELS entry %q is not a module=level pair with a level of debug, info, warn, error", pair))
+		}
+	}
+	validateSinks := func(sinks []string, name string) {
+		for _, sink := range sinks {
+			switch strings.ToLower(strings.TrimSpace(sink)) {
+			case "console", "file", "json", "syslog":
+			case "loki":
+				require(c.Logging.LokiURL, "LOG_LOKI_URL")
+			default:
+				problems = append(problems, fmt.Errorf("%s entry %q is not one of console, file, json, loki, syslog", name, sink))
+			}
+		}
+	}
+	validateSinks(c.Logging.Sinks, "LOG_SINKS")
+	validateSinks(c.Logging.AccessSinks, "LOG_ACCESS_SINKS")
+	if c.Logging.AccessSampleRate < 0 || c.Logging.AccessSampleRate > 1 {
+		problems = append(problems, fmt.Errorf("LOG_ACCESS_SAMPLE_RATE %v is not between 0 and 1", c.Logging.AccessSampleRate))
+	}
+	if c.BulkDelete.ConfirmThreshold < 0 {
+		problems = append(problems, fmt.Errorf("BULK_DELETE_CONFIRM_THRESHOLD %d is negative", c.BulkDelete.ConfirmThreshold))
+	}
+	if c.BulkDelete.ConfirmThreshold > 0 && c.BulkDelete.ConfirmTTL <= 0 {
+		problems = append(problems, fmt.Errorf("BULK_DELETE_CONFIRM_TTL_SECONDS must be positive"))
+	}
+	if c.Routes.DeprecationQuietDays <= 0 {
+		problems = append(problems, fmt.Errorf("ROUTES_DEPRECATION_QUIET_DAYS must be positive"))
+	}
+
+	return errors.Join(problems...)
+}
@@ -0,0 +1,39 @@
+package configs
+
+import "time"
+
+type WakaTimeConfig struct {
+	// Enabled pulls coding activity from WakaTime on an interval
+	Enabled bool
+	APIKey  string
+
+	// APIURL points at WakaTime or a compatible server such as Wakapi
+	APIURL string
+
+	// Tenant is the slug of the tenant the activity belongs to, empty for the default tenant
+	Tenant string
+
+	Interval time.Duration
+
+	// SyncDays is how many past days each sync pulls; days are overwritten
+	// because WakaTime keeps adding heartbeats after the fact
+	SyncDays int
+
+	// CacheTTL is how long aggregates are served before reading snapshots again
+	CacheTTL time.Duration
+
+	Timeout time.Duration
+}
+
+func loadWakaTimeConfig() WakaTimeConfig {
+	return WakaTimeConfig{
+		Enabled:  getEnvAsBool("WAKATIME_ENABLED", false),
+		APIKey:   getEnv("WAKATIME_API_KEY", ""),
+		APIURL:   getEnv("WAKATIME_API_URL", "https://wakatime.com/api/v1"),
+		Tenant:   getEnv("WAKATIME_TENANT", ""),
+		Interval: time.Duration(getEnvAsInt("WAKATIME_SYNC_INTERVAL_HOURS", 6)) * time.Hour,
+		SyncDays: getEnvAsInt("WAKATIME_SYNC_DAYS", 7),
+		CacheTTL: time.Duration(getEnvAsInt("WAKATIME_CACHE_MINUTES", 10)) * time.Minute,
+		Timeout:  time.Duration(getEnvAsInt("WAKATIME_TIMEOUT_SECONDS", 30)) * time.Second,
+	}
+}
@@ -0,0 +1,41 @@
+package configs
+
+import "time"
+
+type WebmentionConfig struct {
+	Enabled bool
+
+	// ProjectPath is the path of project pages on the site, below the
+	// canonical URL of the site config. {slug} is replaced by the project slug.
+	ProjectPath string
+
+	Timeout   time.Duration
+	UserAgent string
+
+	// VerifyInterval is how often received mentions are verified
+	VerifyInterval time.Duration
+
+	// SendInterval is how often the links of every project are checked for
+	// mentions to send, and SendDelay how long to wait after a project
+	// changes before doing so
+	SendInterval time.Duration
+	SendDelay    time.Duration
+
+	// RateLimit is the number of mentions an IP can send per RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func loadWebmentionConfig() WebmentionConfig {
+	return WebmentionConfig{
+		Enabled:        getEnvAsBool("WEBMENTION_ENABLED", false),
+		ProjectPath:    getEnv("WEBMENTION_PROJECT_PATH", "/projects/{slug}"),
+		Timeout:        time.Duration(getEnvAsInt("WEBMENTION_TIMEOUT_SECONDS", 10)) * time.Second,
+		UserAgent:      getEnv("WEBMENTION_USER_AGENT", "itsrama-webmention/1.0"),
+		VerifyInterval: time.Duration(getEnvAsInt("WEBMENTION_VERIFY_INTERVAL_SECONDS", 30)) * time.Second,
+		SendInterval:   time.Duration(getEnvAsInt("WEBMENTION_SEND_INTERVAL_HOURS", 24)) * time.Hour,
+		SendDelay:      time.Duration(getEnvAsInt("WEBMENTION_SEND_DELAY_SECONDS", 60)) * time.Second,
+		RateLimit:      getEnvAsInt("WEBMENTION_RATE_LIMIT", 30),
+		RateWindow:     time.Duration(getEnvAsInt("WEBMENTION_RATE_WINDOW_MINUTES", 60)) * time.Minute,
+	}
+}
@@ -0,0 +1,27 @@
+-- Drop tenant indexes
+DROP INDEX IF EXISTS itsrama.idx_tech_stack_tenant;
+DROP INDEX IF EXISTS itsrama.idx_experience_tenant;
+DROP INDEX IF EXISTS itsrama.idx_project_tenant;
+
+-- Restore global uniqueness
+ALTER TABLE itsrama.project DROP CONSTRAINT IF EXISTS project_tenant_slug_key;
+ALTER TABLE itsrama.project ADD CONSTRAINT project_slug_key UNIQUE (slug);
+ALTER TABLE itsrama.tech_stack DROP CONSTRAINT IF EXISTS tech_stack_tenant_name_key;
+ALTER TABLE itsrama.tech_stack ADD CONSTRAINT tech_stack_name_key UNIQUE (name);
+
+-- Drop tenant columns
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE itsrama.experience DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE itsrama.tech_stack DROP COLUMN IF EXISTS tenant_id;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_tenant_modtime ON itsrama.tenant;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_tenant_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_tenant_default;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.tenant;
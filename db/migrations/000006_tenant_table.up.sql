@@ -0,0 +1,68 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.tenant (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    storage_folder VARCHAR(255),
+    is_active BOOLEAN DEFAULT TRUE,
+    is_default BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only one tenant can be the default tenant
+CREATE UNIQUE INDEX idx_tenant_default ON itsrama.tenant(is_default) WHERE is_default;
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.tenant ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.tenant TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_tenant_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_tenant_modtime
+BEFORE UPDATE ON itsrama.tenant
+FOR EACH ROW
+EXECUTE FUNCTION update_tenant_modified_column();
+
+-- Seed the default tenant owning all existing content
+INSERT INTO itsrama.tenant (slug, name, storage_folder, is_default)
+VALUES ('itsrama', 'Itsrama', NULL, TRUE);
+
+-- Scope content tables to a tenant
+ALTER TABLE itsrama.tech_stack ADD COLUMN tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE;
+ALTER TABLE itsrama.experience ADD COLUMN tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE;
+ALTER TABLE itsrama.project ADD COLUMN tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE;
+
+-- Backfill existing rows to the default tenant
+UPDATE itsrama.tech_stack SET tenant_id = (SELECT id FROM itsrama.tenant WHERE is_default);
+UPDATE itsrama.experience SET tenant_id = (SELECT id FROM itsrama.tenant WHERE is_default);
+UPDATE itsrama.project SET tenant_id = (SELECT id FROM itsrama.tenant WHERE is_default);
+
+ALTER TABLE itsrama.tech_stack ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE itsrama.experience ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE itsrama.project ALTER COLUMN tenant_id SET NOT NULL;
+
+-- Uniqueness is now per tenant
+ALTER TABLE itsrama.tech_stack DROP CONSTRAINT IF EXISTS tech_stack_name_key;
+ALTER TABLE itsrama.tech_stack ADD CONSTRAINT tech_stack_tenant_name_key UNIQUE (tenant_id, name);
+ALTER TABLE itsrama.project DROP CONSTRAINT IF EXISTS project_slug_key;
+ALTER TABLE itsrama.project ADD CONSTRAINT project_tenant_slug_key UNIQUE (tenant_id, slug);
+
+-- Create indexes for faster querying
+CREATE INDEX idx_tech_stack_tenant ON itsrama.tech_stack(tenant_id);
+CREATE INDEX idx_experience_tenant ON itsrama.experience(tenant_id);
+CREATE INDEX idx_project_tenant ON itsrama.project(tenant_id);
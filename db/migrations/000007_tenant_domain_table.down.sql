@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_tenant_domain_modtime ON itsrama.tenant_domain;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_tenant_domain_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_tenant_domain_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.tenant_domain;
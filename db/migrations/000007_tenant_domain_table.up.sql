@@ -0,0 +1,39 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.tenant_domain (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL UNIQUE,
+    verification_token VARCHAR(64) NOT NULL,
+    is_verified BOOLEAN DEFAULT FALSE,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing domains of a tenant
+CREATE INDEX idx_tenant_domain_tenant ON itsrama.tenant_domain(tenant_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.tenant_domain ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.tenant_domain TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_tenant_domain_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_tenant_domain_modtime
+BEFORE UPDATE ON itsrama.tenant_domain
+FOR EACH ROW
+EXECUTE FUNCTION update_tenant_domain_modified_column();
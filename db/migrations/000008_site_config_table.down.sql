@@ -0,0 +1,8 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_site_config_modtime ON itsrama.site_config;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_site_config_modified_column();
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.site_config;
@@ -0,0 +1,36 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.site_config (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL UNIQUE REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    theme JSONB NOT NULL DEFAULT '{}'::jsonb,
+    navigation JSONB NOT NULL DEFAULT '[]'::jsonb,
+    sections JSONB NOT NULL DEFAULT '{}'::jsonb,
+    seo JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.site_config ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.site_config TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_site_config_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_site_config_modtime
+BEFORE UPDATE ON itsrama.site_config
+FOR EACH ROW
+EXECUTE FUNCTION update_site_config_modified_column();
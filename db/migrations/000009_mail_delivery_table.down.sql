@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_mail_delivery_modtime ON itsrama.mail_delivery;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_mail_delivery_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_mail_delivery_due;
+DROP INDEX IF EXISTS itsrama.idx_mail_delivery_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.mail_delivery;
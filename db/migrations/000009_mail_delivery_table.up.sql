@@ -0,0 +1,49 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.mail_delivery (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    template VARCHAR(100),
+    recipients TEXT[] NOT NULL,
+    reply_to VARCHAR(255),
+    subject VARCHAR(998) NOT NULL,
+    html_body TEXT,
+    text_body TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    provider VARCHAR(50),
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL DEFAULT 5,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for the retry queue
+CREATE INDEX idx_mail_delivery_due ON itsrama.mail_delivery(status, next_attempt_at);
+CREATE INDEX idx_mail_delivery_tenant ON itsrama.mail_delivery(tenant_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.mail_delivery ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.mail_delivery TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_mail_delivery_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_mail_delivery_modtime
+BEFORE UPDATE ON itsrama.mail_delivery
+FOR EACH ROW
+EXECUTE FUNCTION update_mail_delivery_modified_column();
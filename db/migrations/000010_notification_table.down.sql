@@ -0,0 +1,13 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_notification_channel_modtime ON itsrama.notification_channel;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_notification_channel_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_notification_log_tenant_created;
+DROP INDEX IF EXISTS itsrama.idx_notification_channel_tenant;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.notification_log;
+DROP TABLE IF EXISTS itsrama.notification_channel;
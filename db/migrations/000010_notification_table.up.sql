@@ -0,0 +1,57 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.notification_channel (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('email', 'telegram', 'discord', 'slack')),
+    config JSONB NOT NULL DEFAULT '{}'::jsonb,
+    events TEXT[] NOT NULL DEFAULT ARRAY['*'],
+    min_level VARCHAR(20) NOT NULL DEFAULT 'info' CHECK (min_level IN ('info', 'warning', 'error')),
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE itsrama.notification_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    channel_id UUID REFERENCES itsrama.notification_channel(id) ON DELETE SET NULL,
+    channel_type VARCHAR(20) NOT NULL,
+    event VARCHAR(100) NOT NULL,
+    level VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('sent', 'failed')),
+    error TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for channel lookups and log listing
+CREATE INDEX idx_notification_channel_tenant ON itsrama.notification_channel(tenant_id);
+CREATE INDEX idx_notification_log_tenant_created ON itsrama.notification_log(tenant_id, created_at DESC);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.notification_channel ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.notification_log ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.notification_channel TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.notification_log TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_notification_channel_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_notification_channel_modtime
+BEFORE UPDATE ON itsrama.notification_channel
+FOR EACH ROW
+EXECUTE FUNCTION update_notification_channel_modified_column();
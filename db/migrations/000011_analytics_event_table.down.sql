@@ -0,0 +1,9 @@
+-- Drop function
+DROP FUNCTION IF EXISTS itsrama.analytics_geo_summary(UUID, TIMESTAMPTZ, TIMESTAMPTZ, TEXT);
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_analytics_event_country;
+DROP INDEX IF EXISTS itsrama.idx_analytics_event_tenant_created;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.analytics_event;
@@ -0,0 +1,49 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+CREATE TABLE itsrama.analytics_event (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('pageview', 'event')),
+    name VARCHAR(100),
+    path VARCHAR(2048) NOT NULL,
+    referrer VARCHAR(2048),
+    country_code CHAR(2),
+    region VARCHAR(100),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for time range aggregation
+CREATE INDEX idx_analytics_event_tenant_created ON itsrama.analytics_event(tenant_id, created_at DESC);
+CREATE INDEX idx_analytics_event_country ON itsrama.analytics_event(tenant_id, country_code);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.analytics_event ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.analytics_event TO service_role;
+
+-- Aggregate events by country, or by country and region, within a time range
+CREATE OR REPLACE FUNCTION itsrama.analytics_geo_summary(
+    p_tenant_id UUID,
+    p_from TIMESTAMPTZ,
+    p_to TIMESTAMPTZ,
+    p_group_by TEXT DEFAULT 'country'
+)
+RETURNS TABLE (country_code TEXT, region TEXT, visits BIGINT) AS $$
+    SELECT
+        COALESCE(e.country_code, '')::TEXT AS country_code,
+        CASE WHEN p_group_by = 'region' THEN COALESCE(e.region, '') ELSE '' END::TEXT AS region,
+        COUNT(*) AS visits
+    FROM itsrama.analytics_event e
+    WHERE (p_tenant_id IS NULL OR e.tenant_id = p_tenant_id)
+        AND e.created_at >= p_from
+        AND e.created_at < p_to
+    GROUP BY 1, 2
+    ORDER BY visits DESC
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.analytics_geo_summary(UUID, TIMESTAMPTZ, TIMESTAMPTZ, TEXT) TO service_role;
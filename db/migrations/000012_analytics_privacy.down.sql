@@ -0,0 +1,9 @@
+-- Drop table
+DROP TABLE IF EXISTS itsrama.analytics_salt;
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_analytics_event_created;
+DROP INDEX IF EXISTS itsrama.idx_analytics_event_visitor;
+
+-- Drop column
+ALTER TABLE itsrama.analytics_event DROP COLUMN IF EXISTS visitor_id;
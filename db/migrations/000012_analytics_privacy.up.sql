@@ -0,0 +1,24 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Anonymous visitor identifier, a salted hash that changes every day
+ALTER TABLE itsrama.analytics_event ADD COLUMN visitor_id VARCHAR(64);
+
+CREATE INDEX idx_analytics_event_visitor ON itsrama.analytics_event(tenant_id, visitor_id);
+CREATE INDEX idx_analytics_event_created ON itsrama.analytics_event(created_at);
+
+-- Daily salts for visitor hashing; old salts are deleted so hashes cannot be linked across days
+CREATE TABLE itsrama.analytics_salt (
+    day DATE PRIMARY KEY,
+    salt VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.analytics_salt ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.analytics_salt TO service_role;
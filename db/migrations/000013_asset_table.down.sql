@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_asset_modtime ON itsrama.asset;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_asset_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_asset_tenant_logical_path;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.asset;
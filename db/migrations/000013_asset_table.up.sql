@@ -0,0 +1,43 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Maps logical asset paths to their content-fingerprinted storage paths
+CREATE TABLE itsrama.asset (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    logical_path VARCHAR(1024) NOT NULL,
+    path VARCHAR(1024) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    url TEXT NOT NULL,
+    content_type VARCHAR(100),
+    size BIGINT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tenant_id, logical_path)
+);
+
+-- Index for manifest prefix lookups
+CREATE INDEX idx_asset_tenant_logical_path ON itsrama.asset(tenant_id, logical_path);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.asset ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.asset TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_asset_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_asset_modtime
+BEFORE UPDATE ON itsrama.asset
+FOR EACH ROW
+EXECUTE FUNCTION update_asset_modified_column();
@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_broken_link_tenant_entity;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.broken_link;
@@ -0,0 +1,29 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Links found unreachable by the last link check
+CREATE TABLE itsrama.broken_link (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    entity_name VARCHAR(255),
+    field VARCHAR(50) NOT NULL,
+    url TEXT NOT NULL,
+    status_code INTEGER,
+    error TEXT,
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the broken links of a tenant
+CREATE INDEX idx_broken_link_tenant_entity ON itsrama.broken_link(tenant_id, entity_type, entity_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.broken_link ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.broken_link TO service_role;
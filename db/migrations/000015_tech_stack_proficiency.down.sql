@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_tech_stack_history_tenant;
+DROP INDEX IF EXISTS itsrama.idx_tech_stack_history_tech_stack;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.tech_stack_history;
+
+-- Drop proficiency columns
+ALTER TABLE itsrama.tech_stack
+    DROP COLUMN IF EXISTS years_of_experience,
+    DROP COLUMN IF EXISTS proficiency_level;
@@ -0,0 +1,30 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Track how well and how long each skill has been used
+ALTER TABLE itsrama.tech_stack
+    ADD COLUMN proficiency_level SMALLINT CHECK (proficiency_level BETWEEN 1 AND 5),
+    ADD COLUMN years_of_experience NUMERIC(4, 1) CHECK (years_of_experience >= 0);
+
+-- Snapshots of skill proficiency recorded whenever it changes
+CREATE TABLE itsrama.tech_stack_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    tech_stack_id UUID NOT NULL REFERENCES itsrama.tech_stack(id) ON DELETE CASCADE,
+    proficiency_level SMALLINT,
+    years_of_experience NUMERIC(4, 1),
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for building time series per skill
+CREATE INDEX idx_tech_stack_history_tech_stack ON itsrama.tech_stack_history(tech_stack_id, recorded_at);
+CREATE INDEX idx_tech_stack_history_tenant ON itsrama.tech_stack_history(tenant_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.tech_stack_history ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.tech_stack_history TO service_role;
@@ -0,0 +1,16 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS refresh_tech_stack_endorsement_count ON itsrama.tech_stack_endorsement;
+DROP TRIGGER IF EXISTS update_tech_stack_endorsement_modtime ON itsrama.tech_stack_endorsement;
+
+-- Drop functions
+DROP FUNCTION IF EXISTS itsrama.refresh_tech_stack_endorsement_count();
+DROP FUNCTION IF EXISTS update_tech_stack_endorsement_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_tech_stack_endorsement_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.tech_stack_endorsement;
+
+-- Drop endorsement count
+ALTER TABLE itsrama.tech_stack DROP COLUMN IF EXISTS endorsement_count;
@@ -0,0 +1,65 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Number of visible endorsements, maintained by trigger
+ALTER TABLE itsrama.tech_stack ADD COLUMN endorsement_count INTEGER NOT NULL DEFAULT 0;
+
+-- Visitor endorsements of skills, one per visitor and tech stack
+CREATE TABLE itsrama.tech_stack_endorsement (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    tech_stack_id UUID NOT NULL REFERENCES itsrama.tech_stack(id) ON DELETE CASCADE,
+    visitor_hash VARCHAR(64) NOT NULL,
+    name VARCHAR(100),
+    is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tech_stack_id, visitor_hash)
+);
+
+-- Index for the moderation view
+CREATE INDEX idx_tech_stack_endorsement_tenant ON itsrama.tech_stack_endorsement(tenant_id, created_at);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.tech_stack_endorsement ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.tech_stack_endorsement TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_tech_stack_endorsement_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_tech_stack_endorsement_modtime
+BEFORE UPDATE ON itsrama.tech_stack_endorsement
+FOR EACH ROW
+EXECUTE FUNCTION update_tech_stack_endorsement_modified_column();
+
+-- Keep tech_stack.endorsement_count in sync with visible endorsements
+CREATE OR REPLACE FUNCTION itsrama.refresh_tech_stack_endorsement_count()
+RETURNS TRIGGER AS $$
+DECLARE
+    target UUID := COALESCE(NEW.tech_stack_id, OLD.tech_stack_id);
+BEGIN
+    UPDATE itsrama.tech_stack
+    SET endorsement_count = (
+        SELECT COUNT(*) FROM itsrama.tech_stack_endorsement
+        WHERE tech_stack_id = target AND NOT is_hidden
+    )
+    WHERE id = target;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER refresh_tech_stack_endorsement_count
+AFTER INSERT OR UPDATE OF is_hidden OR DELETE ON itsrama.tech_stack_endorsement
+FOR EACH ROW
+EXECUTE FUNCTION itsrama.refresh_tech_stack_endorsement_count();
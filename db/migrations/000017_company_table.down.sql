@@ -0,0 +1,15 @@
+-- Drop experience reference
+DROP INDEX IF EXISTS itsrama.idx_experience_company;
+ALTER TABLE itsrama.experience DROP COLUMN IF EXISTS company_id;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_company_modtime ON itsrama.company;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_company_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_company_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.company;
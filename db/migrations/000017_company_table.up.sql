@@ -0,0 +1,77 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Companies referenced by experiences, one row per normalized name
+CREATE TABLE itsrama.company (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    logo_url TEXT,
+    website TEXT,
+    industry VARCHAR(100),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tenant_id, slug)
+);
+
+-- Index for tenant lookups
+CREATE INDEX idx_company_tenant ON itsrama.company(tenant_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.company ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.company TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_company_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_company_modtime
+BEFORE UPDATE ON itsrama.company
+FOR EACH ROW
+EXECUTE FUNCTION update_company_modified_column();
+
+-- Reference the company from experiences
+ALTER TABLE itsrama.experience
+    ADD COLUMN company_id UUID REFERENCES itsrama.company(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_experience_company ON itsrama.experience(company_id);
+
+-- Backfill companies from the names already stored on experiences,
+-- keeping the most recently updated logo of each company
+INSERT INTO itsrama.company (tenant_id, name, slug, logo_url)
+SELECT DISTINCT ON (tenant_id, slug) tenant_id, name, slug, logo_url
+FROM (
+    SELECT
+        tenant_id,
+        trim(company) AS name,
+        COALESCE(
+            NULLIF(trim(BOTH '-' FROM regexp_replace(lower(company), '[^a-z0-9]+', '-', 'g')), ''),
+            lower(trim(company))
+        ) AS slug,
+        NULLIF(logo_url, '') AS logo_url,
+        updated_at
+    FROM itsrama.experience
+    WHERE trim(company) <> ''
+) AS named
+ORDER BY tenant_id, slug, logo_url IS NULL, updated_at DESC
+ON CONFLICT (tenant_id, slug) DO NOTHING;
+
+UPDATE itsrama.experience AS e
+SET company_id = c.id
+FROM itsrama.company AS c
+WHERE c.tenant_id IS NOT DISTINCT FROM e.tenant_id
+  AND c.slug = COALESCE(
+        NULLIF(trim(BOTH '-' FROM regexp_replace(lower(e.company), '[^a-z0-9]+', '-', 'g')), ''),
+        lower(trim(e.company))
+      );
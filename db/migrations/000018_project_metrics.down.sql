@@ -0,0 +1,2 @@
+-- Drop project metrics
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS metrics;
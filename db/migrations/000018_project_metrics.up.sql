@@ -0,0 +1,10 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Typed key/value metrics such as users, stars or revenue impact
+ALTER TABLE itsrama.project
+    ADD COLUMN metrics JSONB NOT NULL DEFAULT '[]'::jsonb
+    CHECK (jsonb_typeof(metrics) = 'array');
@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_changelog_entry_modtime ON itsrama.changelog_entry;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_changelog_entry_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_changelog_entry_entity;
+DROP INDEX IF EXISTS itsrama.idx_changelog_entry_tenant_published;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.changelog_entry;
@@ -0,0 +1,44 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Public changelog entries, recorded from domain events or written by hand
+CREATE TABLE itsrama.changelog_entry (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    body TEXT,
+    event_type VARCHAR(50),
+    entity VARCHAR(50),
+    entity_id VARCHAR(100),
+    is_manual BOOLEAN NOT NULL DEFAULT FALSE,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for the public feed and for coalescing repeated updates
+CREATE INDEX idx_changelog_entry_tenant_published ON itsrama.changelog_entry(tenant_id, published_at DESC);
+CREATE INDEX idx_changelog_entry_entity ON itsrama.changelog_entry(entity_id, event_type);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.changelog_entry ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.changelog_entry TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_changelog_entry_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_changelog_entry_modtime
+BEFORE UPDATE ON itsrama.changelog_entry
+FOR EACH ROW
+EXECUTE FUNCTION update_changelog_entry_modified_column();
@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_now_entry_modtime ON itsrama.now_entry;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_now_entry_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_now_entry_tenant_published;
+DROP INDEX IF EXISTS itsrama.idx_now_entry_current;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.now_entry;
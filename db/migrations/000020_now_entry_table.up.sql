@@ -0,0 +1,49 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Entries of the /now page; the current one is shown, older ones form the history
+CREATE TABLE itsrama.now_entry (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    content_html TEXT NOT NULL,
+    working_on TEXT[],
+    reading TEXT[],
+    learning TEXT[],
+    location VARCHAR(255),
+    is_current BOOLEAN NOT NULL DEFAULT FALSE,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one current entry per tenant
+CREATE UNIQUE INDEX idx_now_entry_current
+    ON itsrama.now_entry(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid))
+    WHERE is_current;
+
+-- Index for the history, newest first
+CREATE INDEX idx_now_entry_tenant_published ON itsrama.now_entry(tenant_id, published_at DESC);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.now_entry ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.now_entry TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_now_entry_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_now_entry_modtime
+BEFORE UPDATE ON itsrama.now_entry
+FOR EACH ROW
+EXECUTE FUNCTION update_now_entry_modified_column();
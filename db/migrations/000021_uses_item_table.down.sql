@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_uses_item_modtime ON itsrama.uses_item;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_uses_item_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_uses_item_tenant_position;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.uses_item;
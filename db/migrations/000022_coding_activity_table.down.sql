@@ -0,0 +1,8 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_coding_activity_modtime ON itsrama.coding_activity;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_coding_activity_modified_column();
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.coding_activity;
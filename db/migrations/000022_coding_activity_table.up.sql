@@ -0,0 +1,38 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Daily coding activity snapshots pulled from WakaTime
+CREATE TABLE itsrama.coding_activity (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    total_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    languages JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tenant_id, date),
+    CONSTRAINT coding_activity_languages_is_array CHECK (jsonb_typeof(languages) = 'array')
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.coding_activity ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.coding_activity TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_coding_activity_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_coding_activity_modtime
+BEFORE UPDATE ON itsrama.coding_activity
+FOR EACH ROW
+EXECUTE FUNCTION update_coding_activity_modified_column();
@@ -0,0 +1,8 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_profile_stat_modtime ON itsrama.profile_stat;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_profile_stat_modified_column();
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.profile_stat;
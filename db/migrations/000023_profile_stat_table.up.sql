@@ -0,0 +1,41 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Latest statistics read from external developer profiles
+CREATE TABLE itsrama.profile_stat (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    account VARCHAR(255) NOT NULL,
+    profile_url TEXT,
+    metrics JSONB NOT NULL DEFAULT '{}'::jsonb,
+    last_error TEXT,
+    fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tenant_id, provider),
+    CONSTRAINT profile_stat_metrics_is_object CHECK (jsonb_typeof(metrics) = 'object')
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.profile_stat ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.profile_stat TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_profile_stat_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_profile_stat_modtime
+BEFORE UPDATE ON itsrama.profile_stat
+FOR EACH ROW
+EXECUTE FUNCTION update_profile_stat_modified_column();
@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_offering_modtime ON itsrama.offering;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_offering_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_offering_tenant_position;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.exchange_rate;
+DROP TABLE IF EXISTS itsrama.offering;
@@ -0,0 +1,55 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Services offered to clients with price ranges in one or more currencies
+CREATE TABLE itsrama.offering (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    features TEXT[] DEFAULT '{}',
+    prices JSONB NOT NULL DEFAULT '[]'::jsonb,
+    price_unit VARCHAR(20) NOT NULL DEFAULT 'project',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT offering_prices_is_array CHECK (jsonb_typeof(prices) = 'array')
+);
+
+-- Index for listing offerings in display order
+CREATE INDEX idx_offering_tenant_position ON itsrama.offering(tenant_id, position);
+
+-- Exchange rates shared by every tenant, quoted against a base currency
+CREATE TABLE itsrama.exchange_rate (
+    base CHAR(3) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (base, currency)
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.offering ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.exchange_rate ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.offering TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.exchange_rate TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_offering_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_offering_modtime
+BEFORE UPDATE ON itsrama.offering
+FOR EACH ROW
+EXECUTE FUNCTION update_offering_modified_column();
@@ -0,0 +1,14 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_proposal_modtime ON itsrama.proposal;
+DROP TRIGGER IF EXISTS update_inquiry_modtime ON itsrama.inquiry;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_inquiry_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_proposal_inquiry;
+DROP INDEX IF EXISTS itsrama.idx_inquiry_tenant_status;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.proposal;
+DROP TABLE IF EXISTS itsrama.inquiry;
@@ -0,0 +1,72 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Project inquiries from prospects and their place in the sales pipeline
+CREATE TABLE itsrama.inquiry (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    company VARCHAR(255),
+    message TEXT NOT NULL,
+    budget VARCHAR(100),
+    status VARCHAR(20) NOT NULL DEFAULT 'new',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the pipeline by status
+CREATE INDEX idx_inquiry_tenant_status ON itsrama.inquiry(tenant_id, status, created_at DESC);
+
+-- Proposals quoted to an inquiry, rendered to a PDF in storage
+CREATE TABLE itsrama.proposal (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    inquiry_id UUID NOT NULL REFERENCES itsrama.inquiry(id) ON DELETE CASCADE,
+    number VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    intro TEXT,
+    currency CHAR(3) NOT NULL,
+    line_items JSONB NOT NULL DEFAULT '[]'::jsonb,
+    total NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    terms TEXT,
+    valid_until TIMESTAMPTZ,
+    file_path TEXT,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT proposal_line_items_is_array CHECK (jsonb_typeof(line_items) = 'array')
+);
+
+-- Index for listing the proposals of an inquiry
+CREATE INDEX idx_proposal_inquiry ON itsrama.proposal(inquiry_id, created_at DESC);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.inquiry ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.proposal ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.inquiry TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.proposal TO service_role;
+
+-- Add triggers to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_inquiry_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_inquiry_modtime
+BEFORE UPDATE ON itsrama.inquiry
+FOR EACH ROW
+EXECUTE FUNCTION update_inquiry_modified_column();
+
+CREATE TRIGGER update_proposal_modtime
+BEFORE UPDATE ON itsrama.proposal
+FOR EACH ROW
+EXECUTE FUNCTION update_inquiry_modified_column();
@@ -0,0 +1,5 @@
+-- Drop columns
+ALTER TABLE itsrama.proposal DROP COLUMN IF EXISTS accepted_user_agent;
+ALTER TABLE itsrama.proposal DROP COLUMN IF EXISTS accepted_ip;
+ALTER TABLE itsrama.proposal DROP COLUMN IF EXISTS accepted_name;
+ALTER TABLE itsrama.proposal DROP COLUMN IF EXISTS accepted_at;
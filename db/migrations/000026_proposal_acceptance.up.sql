@@ -0,0 +1,11 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Record of the prospect accepting a proposal through its signed link
+ALTER TABLE itsrama.proposal ADD COLUMN accepted_at TIMESTAMPTZ;
+ALTER TABLE itsrama.proposal ADD COLUMN accepted_name VARCHAR(255);
+ALTER TABLE itsrama.proposal ADD COLUMN accepted_ip VARCHAR(45);
+ALTER TABLE itsrama.proposal ADD COLUMN accepted_user_agent TEXT;
@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_payment_modtime ON itsrama.payment;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_payment_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_payment_inquiry;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.payment;
@@ -0,0 +1,49 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Payments collected for inquiries, one row per checkout
+CREATE TABLE itsrama.payment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    inquiry_id UUID NOT NULL REFERENCES itsrama.inquiry(id) ON DELETE CASCADE,
+    proposal_id UUID REFERENCES itsrama.proposal(id) ON DELETE SET NULL,
+    kind VARCHAR(20) NOT NULL DEFAULT 'deposit',
+    provider VARCHAR(20) NOT NULL,
+    provider_session_id VARCHAR(255) NOT NULL,
+    provider_payment_id VARCHAR(255),
+    amount NUMERIC(14, 2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    checkout_url TEXT,
+    last_event_id VARCHAR(255),
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(provider, provider_session_id)
+);
+
+-- Index for listing the payments of an inquiry
+CREATE INDEX idx_payment_inquiry ON itsrama.payment(inquiry_id, created_at DESC);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.payment ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.payment TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_payment_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_payment_modtime
+BEFORE UPDATE ON itsrama.payment
+FOR EACH ROW
+EXECUTE FUNCTION update_payment_modified_column();
@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_portal_token_modtime ON itsrama.portal_token;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_portal_token_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_portal_token_expires_at;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.portal_token;
@@ -0,0 +1,2 @@
+-- Drop column
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS visibility;
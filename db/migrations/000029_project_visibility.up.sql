@@ -0,0 +1,9 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Unlisted and draft projects are hidden from public endpoints and shared
+-- through signed links
+ALTER TABLE itsrama.project ADD COLUMN visibility VARCHAR(20) NOT NULL DEFAULT 'public';
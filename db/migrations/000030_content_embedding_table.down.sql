@@ -0,0 +1,15 @@
+-- Drop search function
+DROP FUNCTION IF EXISTS itsrama.match_content_embedding(UUID, extensions.vector, INT, FLOAT, TEXT);
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_content_embedding_modtime ON itsrama.content_embedding;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_content_embedding_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_content_embedding_embedding;
+DROP INDEX IF EXISTS itsrama.idx_content_embedding_tenant_id;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.content_embedding;
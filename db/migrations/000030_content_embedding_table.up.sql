@@ -0,0 +1,75 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- pgvector stores the embeddings used by semantic search
+CREATE EXTENSION IF NOT EXISTS vector WITH SCHEMA extensions;
+
+-- One embedding per indexed project or experience. The dimensions must match
+-- EMBEDDING_DIMENSIONS.
+CREATE TABLE itsrama.content_embedding (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    source_type VARCHAR(20) NOT NULL,
+    source_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    snippet TEXT NOT NULL DEFAULT '',
+    content_hash CHAR(64) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    embedding extensions.vector(768) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (source_type, source_id)
+);
+
+-- Indexes for listing a tenant's embeddings and nearest neighbour search
+CREATE INDEX idx_content_embedding_tenant_id ON itsrama.content_embedding(tenant_id);
+CREATE INDEX idx_content_embedding_embedding ON itsrama.content_embedding
+    USING hnsw (embedding extensions.vector_cosine_ops);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.content_embedding ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.content_embedding TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_content_embedding_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_content_embedding_modtime
+BEFORE UPDATE ON itsrama.content_embedding
+FOR EACH ROW
+EXECUTE FUNCTION update_content_embedding_modified_column();
+
+-- Return the embeddings closest to a query embedding by cosine similarity
+CREATE OR REPLACE FUNCTION itsrama.match_content_embedding(
+    p_tenant_id UUID,
+    p_embedding extensions.vector(768),
+    p_match_count INT,
+    p_min_similarity FLOAT DEFAULT 0,
+    p_source_type TEXT DEFAULT NULL
+)
+RETURNS TABLE (source_type TEXT, source_id UUID, title TEXT, snippet TEXT, similarity FLOAT) AS $$
+    SELECT
+        e.source_type::TEXT,
+        e.source_id,
+        e.title::TEXT,
+        e.snippet,
+        1 - (e.embedding OPERATOR(extensions.<=>) p_embedding) AS similarity
+    FROM itsrama.content_embedding e
+    WHERE (p_tenant_id IS NULL OR e.tenant_id = p_tenant_id)
+        AND (p_source_type IS NULL OR e.source_type = p_source_type)
+        AND 1 - (e.embedding OPERATOR(extensions.<=>) p_embedding) >= p_min_similarity
+    ORDER BY e.embedding OPERATOR(extensions.<=>) p_embedding
+    LIMIT p_match_count
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.match_content_embedding(UUID, extensions.vector, INT, FLOAT, TEXT) TO service_role;
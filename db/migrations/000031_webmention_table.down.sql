@@ -0,0 +1,15 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_webmention_sent_modtime ON itsrama.webmention_sent;
+DROP TRIGGER IF EXISTS update_webmention_modtime ON itsrama.webmention;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_webmention_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_webmention_sent_project_id;
+DROP INDEX IF EXISTS itsrama.idx_webmention_project_id;
+DROP INDEX IF EXISTS itsrama.idx_webmention_pending;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.webmention_sent;
+DROP TABLE IF EXISTS itsrama.webmention;
@@ -0,0 +1,80 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Webmentions received for project pages. A mention is pending until its
+-- source has been fetched and found to link to the target.
+CREATE TABLE itsrama.webmention (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES itsrama.project(id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    target TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'new',
+    pending BOOLEAN NOT NULL DEFAULT TRUE,
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    author_name VARCHAR(255) NOT NULL DEFAULT '',
+    author_url TEXT NOT NULL DEFAULT '',
+    excerpt TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    received_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (source, target)
+);
+
+-- Indexes for verification and listing mentions of a project
+CREATE INDEX idx_webmention_pending ON itsrama.webmention(received_at) WHERE pending;
+CREATE INDEX idx_webmention_project_id ON itsrama.webmention(project_id, status);
+
+-- Webmentions sent for the links of project pages, used to only notify
+-- again when the links change
+CREATE TABLE itsrama.webmention_sent (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES itsrama.project(id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    target TEXT NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    endpoint TEXT NOT NULL DEFAULT '',
+    status_code INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (source, target)
+);
+
+-- Index for listing what was sent for a project
+CREATE INDEX idx_webmention_sent_project_id ON itsrama.webmention_sent(project_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.webmention ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.webmention_sent ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.webmention TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.webmention_sent TO service_role;
+
+-- Add triggers to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_webmention_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_webmention_modtime
+BEFORE UPDATE ON itsrama.webmention
+FOR EACH ROW
+EXECUTE FUNCTION update_webmention_modified_column();
+
+CREATE TRIGGER update_webmention_sent_modtime
+BEFORE UPDATE ON itsrama.webmention_sent
+FOR EACH ROW
+EXECUTE FUNCTION update_webmention_modified_column();
@@ -0,0 +1,16 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_activitypub_post_modtime ON itsrama.activitypub_post;
+DROP TRIGGER IF EXISTS update_activitypub_follower_modtime ON itsrama.activitypub_follower;
+DROP TRIGGER IF EXISTS update_activitypub_actor_modtime ON itsrama.activitypub_actor;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_activitypub_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_activitypub_post_tenant_id;
+DROP INDEX IF EXISTS itsrama.idx_activitypub_follower_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.activitypub_post;
+DROP TABLE IF EXISTS itsrama.activitypub_follower;
+DROP TABLE IF EXISTS itsrama.activitypub_actor;
@@ -0,0 +1,79 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Key pair each tenant's ActivityPub actor signs its requests with
+CREATE TABLE itsrama.activitypub_actor (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID UNIQUE REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    public_key TEXT NOT NULL,
+    private_key TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Remote accounts following a tenant's actor
+CREATE TABLE itsrama.activitypub_follower (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    actor_id TEXT NOT NULL,
+    inbox TEXT NOT NULL,
+    shared_inbox TEXT NOT NULL DEFAULT '',
+    username VARCHAR(255) NOT NULL DEFAULT '',
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    url TEXT NOT NULL DEFAULT '',
+    followed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tenant_id, actor_id)
+);
+
+-- Projects already announced to followers, so each is only published once
+CREATE TABLE itsrama.activitypub_post (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL UNIQUE REFERENCES itsrama.project(id) ON DELETE CASCADE,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for listing followers and posts of a tenant
+CREATE INDEX idx_activitypub_follower_tenant_id ON itsrama.activitypub_follower(tenant_id, followed_at);
+CREATE INDEX idx_activitypub_post_tenant_id ON itsrama.activitypub_post(tenant_id, published_at);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.activitypub_actor ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.activitypub_follower ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.activitypub_post ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.activitypub_actor TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.activitypub_follower TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.activitypub_post TO service_role;
+
+-- Add triggers to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_activitypub_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_activitypub_actor_modtime
+BEFORE UPDATE ON itsrama.activitypub_actor
+FOR EACH ROW
+EXECUTE FUNCTION update_activitypub_modified_column();
+
+CREATE TRIGGER update_activitypub_follower_modtime
+BEFORE UPDATE ON itsrama.activitypub_follower
+FOR EACH ROW
+EXECUTE FUNCTION update_activitypub_modified_column();
+
+CREATE TRIGGER update_activitypub_post_modtime
+BEFORE UPDATE ON itsrama.activitypub_post
+FOR EACH ROW
+EXECUTE FUNCTION update_activitypub_modified_column();
@@ -0,0 +1,14 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_indieauth_authorization_modtime ON itsrama.indieauth_authorization;
+DROP TRIGGER IF EXISTS update_indieauth_code_modtime ON itsrama.indieauth_code;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_indieauth_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_indieauth_authorization_client_id;
+DROP INDEX IF EXISTS itsrama.idx_indieauth_code_expires_at;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.indieauth_authorization;
+DROP TABLE IF EXISTS itsrama.indieauth_code;
@@ -0,0 +1,66 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- IndieAuth authorization codes. Only code hashes are stored.
+CREATE TABLE itsrama.indieauth_code (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL UNIQUE,
+    client_id VARCHAR(2048) NOT NULL,
+    redirect_uri VARCHAR(2048) NOT NULL,
+    code_challenge VARCHAR(128) NOT NULL,
+    scope VARCHAR(1024) NOT NULL DEFAULT '',
+    me VARCHAR(2048) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Access tokens granted to IndieAuth clients. Only token hashes are stored.
+CREATE TABLE itsrama.indieauth_authorization (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    client_id VARCHAR(2048) NOT NULL,
+    scope VARCHAR(1024) NOT NULL,
+    me VARCHAR(2048) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for pruning expired codes and listing a client's authorizations
+CREATE INDEX idx_indieauth_code_expires_at ON itsrama.indieauth_code(expires_at);
+CREATE INDEX idx_indieauth_authorization_client_id ON itsrama.indieauth_authorization(tenant_id, client_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.indieauth_code ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.indieauth_authorization ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.indieauth_code TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.indieauth_authorization TO service_role;
+
+-- Add triggers to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_indieauth_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_indieauth_code_modtime
+BEFORE UPDATE ON itsrama.indieauth_code
+FOR EACH ROW
+EXECUTE FUNCTION update_indieauth_modified_column();
+
+CREATE TRIGGER update_indieauth_authorization_modtime
+BEFORE UPDATE ON itsrama.indieauth_authorization
+FOR EACH ROW
+EXECUTE FUNCTION update_indieauth_modified_column();
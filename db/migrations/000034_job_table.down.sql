@@ -0,0 +1,13 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_job_modtime ON itsrama.job;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_job_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_job_due;
+DROP INDEX IF EXISTS itsrama.idx_job_lease;
+DROP INDEX IF EXISTS itsrama.idx_job_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.job;
@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_stored_file_module;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.stored_file;
@@ -0,0 +1,24 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Size of every file written to the storage bucket, for usage reporting and
+-- the storage quota. The bucket is shared by every tenant.
+CREATE TABLE itsrama.stored_file (
+    path VARCHAR(1024) PRIMARY KEY,
+    module VARCHAR(1024) NOT NULL,
+    size BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for usage per module
+CREATE INDEX idx_stored_file_module ON itsrama.stored_file(module);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.stored_file ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.stored_file TO service_role;
@@ -0,0 +1,3 @@
+-- Drop columns
+ALTER TABLE itsrama.experience DROP COLUMN IF EXISTS image_placeholders;
+ALTER TABLE itsrama.asset DROP COLUMN IF EXISTS placeholder;
@@ -0,0 +1,10 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Tiny copies of uploaded images as data URIs, shown while the images load.
+-- Project images keep theirs in the images column.
+ALTER TABLE itsrama.asset ADD COLUMN placeholder TEXT;
+ALTER TABLE itsrama.experience ADD COLUMN image_placeholders JSONB;
@@ -0,0 +1,2 @@
+-- Drop column
+ALTER TABLE itsrama.asset DROP COLUMN IF EXISTS dominant_color;
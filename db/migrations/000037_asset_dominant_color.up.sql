@@ -0,0 +1,9 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Most common color of uploaded images, used to tint backgrounds while they
+-- load. Project images keep theirs in the images column.
+ALTER TABLE itsrama.asset ADD COLUMN dominant_color VARCHAR(7);
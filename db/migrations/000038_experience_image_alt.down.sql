@@ -0,0 +1,2 @@
+-- Drop column
+ALTER TABLE itsrama.experience DROP COLUMN IF EXISTS image_alts;
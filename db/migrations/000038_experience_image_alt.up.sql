@@ -0,0 +1,9 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Alt text of experience images by image URL. Project images keep theirs in
+-- the images column.
+ALTER TABLE itsrama.experience ADD COLUMN image_alts JSONB;
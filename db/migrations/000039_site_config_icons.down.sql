@@ -0,0 +1,2 @@
+-- Drop column
+ALTER TABLE itsrama.site_config DROP COLUMN IF EXISTS icons;
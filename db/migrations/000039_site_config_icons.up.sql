@@ -0,0 +1,8 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Favicon, app icons and web app manifest generated from the profile avatar
+ALTER TABLE itsrama.site_config ADD COLUMN icons JSONB;
@@ -0,0 +1,10 @@
+-- Drop columns
+ALTER TABLE itsrama.company
+    DROP COLUMN IF EXISTS logo_preference,
+    DROP COLUMN IF EXISTS logo_url_dark,
+    DROP COLUMN IF EXISTS logo_url_light;
+
+ALTER TABLE itsrama.tech_stack
+    DROP COLUMN IF EXISTS logo_preference,
+    DROP COLUMN IF EXISTS image_url_dark,
+    DROP COLUMN IF EXISTS image_url_light;
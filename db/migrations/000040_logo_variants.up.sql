@@ -0,0 +1,16 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Logo variants for light and dark backgrounds and the variant to show
+ALTER TABLE itsrama.tech_stack
+    ADD COLUMN image_url_light TEXT,
+    ADD COLUMN image_url_dark TEXT,
+    ADD COLUMN logo_preference VARCHAR(10) NOT NULL DEFAULT 'auto' CHECK (logo_preference IN ('auto', 'light', 'dark'));
+
+ALTER TABLE itsrama.company
+    ADD COLUMN logo_url_light TEXT,
+    ADD COLUMN logo_url_dark TEXT,
+    ADD COLUMN logo_preference VARCHAR(10) NOT NULL DEFAULT 'auto' CHECK (logo_preference IN ('auto', 'light', 'dark'));
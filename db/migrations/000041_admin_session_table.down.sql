@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_admin_session_modtime ON itsrama.admin_session;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_admin_session_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_admin_session_flagged;
+DROP INDEX IF EXISTS itsrama.idx_admin_session_email;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.admin_session;
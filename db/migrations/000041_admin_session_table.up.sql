@@ -0,0 +1,50 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Admin sign-ins, tracked from the requests made with their tokens
+CREATE TABLE itsrama.admin_session (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    session_key VARCHAR(255) NOT NULL UNIQUE,
+    user_id VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    last_ip_address VARCHAR(45) NOT NULL,
+    last_user_agent TEXT NOT NULL DEFAULT '',
+    signed_in_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    request_count INTEGER NOT NULL DEFAULT 0,
+    flagged BOOLEAN NOT NULL DEFAULT FALSE,
+    flag_reason TEXT NOT NULL DEFAULT '',
+    flagged_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for listing sessions and the addresses an admin used
+CREATE INDEX idx_admin_session_email ON itsrama.admin_session(tenant_id, email);
+CREATE INDEX idx_admin_session_flagged ON itsrama.admin_session(tenant_id, flagged);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.admin_session ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.admin_session TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_admin_session_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_admin_session_modtime
+BEFORE UPDATE ON itsrama.admin_session
+FOR EACH ROW
+EXECUTE FUNCTION update_admin_session_modified_column();
@@ -0,0 +1,10 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_page_modtime ON itsrama.page;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_page_modified_column();
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.page_revision;
+DROP INDEX IF EXISTS itsrama.idx_page_tenant_slug;
+DROP TABLE IF EXISTS itsrama.page;
@@ -0,0 +1,61 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Standalone pages such as the privacy policy, terms and imprint
+CREATE TABLE itsrama.page (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    slug VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    body_html TEXT NOT NULL,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+    revision INTEGER NOT NULL DEFAULT 1,
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Slugs are unique per tenant
+CREATE UNIQUE INDEX idx_page_tenant_slug
+    ON itsrama.page(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), slug);
+
+-- Saved versions of pages, one per edit
+CREATE TABLE itsrama.page_revision (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    page_id UUID NOT NULL REFERENCES itsrama.page(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+    note VARCHAR(500) NOT NULL DEFAULT '',
+    edited_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (page_id, revision)
+);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.page ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.page_revision ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.page TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.page_revision TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_page_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_page_modtime
+BEFORE UPDATE ON itsrama.page
+FOR EACH ROW
+EXECUTE FUNCTION update_page_modified_column();
@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_redirect_modtime ON itsrama.redirect;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_redirect_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_redirect_tenant_from_path;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.redirect;
@@ -0,0 +1,40 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Redirects of old frontend paths, resolved by the edge middleware
+CREATE TABLE itsrama.redirect (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    from_path VARCHAR(2048) NOT NULL,
+    to_url VARCHAR(2048) NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 301 CHECK (status_code IN (301, 302, 307, 308)),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A path redirects to one place per tenant
+CREATE UNIQUE INDEX idx_redirect_tenant_from_path
+    ON itsrama.redirect(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), from_path);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.redirect ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.redirect TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_redirect_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_redirect_modtime
+BEFORE UPDATE ON itsrama.redirect
+FOR EACH ROW
+EXECUTE FUNCTION update_redirect_modified_column();
@@ -0,0 +1,3 @@
+-- Drop search engine directives
+ALTER TABLE itsrama.page DROP COLUMN IF EXISTS seo;
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS seo;
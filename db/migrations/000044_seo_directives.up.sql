@@ -0,0 +1,14 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Search engine directives: noindex, canonical URL and meta description
+ALTER TABLE itsrama.project
+    ADD COLUMN seo JSONB NOT NULL DEFAULT '{}'::jsonb
+    CHECK (jsonb_typeof(seo) = 'object');
+
+ALTER TABLE itsrama.page
+    ADD COLUMN seo JSONB NOT NULL DEFAULT '{}'::jsonb
+    CHECK (jsonb_typeof(seo) = 'object');
@@ -0,0 +1,15 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_experiment_modtime ON itsrama.experiment;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_experiment_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_experiment_event_variant;
+DROP INDEX IF EXISTS itsrama.idx_experiment_event_visitor;
+DROP INDEX IF EXISTS itsrama.idx_experiment_tenant_active_field;
+DROP INDEX IF EXISTS itsrama.idx_experiment_tenant_key;
+
+-- Drop tables
+DROP TABLE IF EXISTS itsrama.experiment_event;
+DROP TABLE IF EXISTS itsrama.experiment;
@@ -0,0 +1,67 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Experiments splitting visitors between variants of a site field
+CREATE TABLE itsrama.experiment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    key VARCHAR(100) NOT NULL,
+    field VARCHAR(50) NOT NULL CHECK (field IN ('headline', 'featured_order')),
+    description TEXT,
+    variants JSONB NOT NULL DEFAULT '[]'::jsonb,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A key names one experiment per tenant
+CREATE UNIQUE INDEX idx_experiment_tenant_key
+    ON itsrama.experiment(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), key);
+
+-- Only one active experiment varies a field per tenant
+CREATE UNIQUE INDEX idx_experiment_tenant_active_field
+    ON itsrama.experiment(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), field)
+    WHERE active;
+
+-- Exposures and conversions of visitors, one of each per visitor and experiment
+CREATE TABLE itsrama.experiment_event (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    experiment_id UUID NOT NULL REFERENCES itsrama.experiment(id) ON DELETE CASCADE,
+    variant VARCHAR(100) NOT NULL,
+    visitor_id VARCHAR(64) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('exposure', 'conversion')),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_experiment_event_visitor
+    ON itsrama.experiment_event(experiment_id, visitor_id, type);
+
+-- Results count events per variant
+CREATE INDEX idx_experiment_event_variant
+    ON itsrama.experiment_event(experiment_id, variant, type);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.experiment ENABLE ROW LEVEL SECURITY;
+ALTER TABLE itsrama.experiment_event ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on tables to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.experiment TO service_role;
+GRANT ALL PRIVILEGES ON TABLE itsrama.experiment_event TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_experiment_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_experiment_modtime
+BEFORE UPDATE ON itsrama.experiment
+FOR EACH ROW
+EXECUTE FUNCTION update_experiment_modified_column();
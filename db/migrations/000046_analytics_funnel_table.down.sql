@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_analytics_funnel_modtime ON itsrama.analytics_funnel;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_analytics_funnel_modified_column();
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_analytics_funnel_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.analytics_funnel;
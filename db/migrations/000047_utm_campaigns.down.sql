@@ -0,0 +1,16 @@
+-- Drop function
+DROP FUNCTION IF EXISTS itsrama.analytics_campaign_summary(UUID, TIMESTAMPTZ, TIMESTAMPTZ);
+
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_analytics_event_campaign;
+
+-- Drop columns
+ALTER TABLE itsrama.inquiry
+    DROP COLUMN IF EXISTS utm_campaign,
+    DROP COLUMN IF EXISTS utm_medium,
+    DROP COLUMN IF EXISTS utm_source;
+
+ALTER TABLE itsrama.analytics_event
+    DROP COLUMN IF EXISTS utm_campaign,
+    DROP COLUMN IF EXISTS utm_medium,
+    DROP COLUMN IF EXISTS utm_source;
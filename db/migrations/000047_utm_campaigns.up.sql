@@ -0,0 +1,77 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Campaign parameters visitors arrived with
+ALTER TABLE itsrama.analytics_event
+    ADD COLUMN utm_source VARCHAR(100),
+    ADD COLUMN utm_medium VARCHAR(100),
+    ADD COLUMN utm_campaign VARCHAR(100);
+
+ALTER TABLE itsrama.inquiry
+    ADD COLUMN utm_source VARCHAR(100),
+    ADD COLUMN utm_medium VARCHAR(100),
+    ADD COLUMN utm_campaign VARCHAR(100);
+
+-- Only events arriving with a campaign are aggregated by campaign
+CREATE INDEX idx_analytics_event_campaign ON itsrama.analytics_event(tenant_id, created_at)
+    WHERE COALESCE(utm_source, '') <> '' OR COALESCE(utm_medium, '') <> '' OR COALESCE(utm_campaign, '') <> '';
+
+-- Aggregate page views and inquiries by campaign within a time range
+CREATE OR REPLACE FUNCTION itsrama.analytics_campaign_summary(
+    p_tenant_id UUID,
+    p_from TIMESTAMPTZ,
+    p_to TIMESTAMPTZ
+)
+RETURNS TABLE (
+    utm_source TEXT,
+    utm_medium TEXT,
+    utm_campaign TEXT,
+    visits BIGINT,
+    visitors BIGINT,
+    conversions BIGINT
+) AS $$
+    WITH visits AS (
+        SELECT
+            COALESCE(e.utm_source, '')::TEXT AS source,
+            COALESCE(e.utm_medium, '')::TEXT AS medium,
+            COALESCE(e.utm_campaign, '')::TEXT AS campaign,
+            COUNT(*) AS visits,
+            COUNT(DISTINCT e.visitor_id) AS visitors
+        FROM itsrama.analytics_event e
+        WHERE (p_tenant_id IS NULL OR e.tenant_id = p_tenant_id)
+            AND e.type = 'pageview'
+            AND e.created_at >= p_from
+            AND e.created_at < p_to
+            AND (COALESCE(e.utm_source, '') <> '' OR COALESCE(e.utm_medium, '') <> '' OR COALESCE(e.utm_campaign, '') <> '')
+        GROUP BY 1, 2, 3
+    ),
+    conversions AS (
+        SELECT
+            COALESCE(i.utm_source, '')::TEXT AS source,
+            COALESCE(i.utm_medium, '')::TEXT AS medium,
+            COALESCE(i.utm_campaign, '')::TEXT AS campaign,
+            COUNT(*) AS conversions
+        FROM itsrama.inquiry i
+        WHERE (p_tenant_id IS NULL OR i.tenant_id = p_tenant_id)
+            AND i.created_at >= p_from
+            AND i.created_at < p_to
+            AND (COALESCE(i.utm_source, '') <> '' OR COALESCE(i.utm_medium, '') <> '' OR COALESCE(i.utm_campaign, '') <> '')
+        GROUP BY 1, 2, 3
+    )
+    SELECT
+        COALESCE(v.source, c.source) AS utm_source,
+        COALESCE(v.medium, c.medium) AS utm_medium,
+        COALESCE(v.campaign, c.campaign) AS utm_campaign,
+        COALESCE(v.visits, 0) AS visits,
+        COALESCE(v.visitors, 0) AS visitors,
+        COALESCE(c.conversions, 0) AS conversions
+    FROM visits v
+    FULL OUTER JOIN conversions c
+        ON c.source = v.source AND c.medium = v.medium AND c.campaign = v.campaign
+    ORDER BY visits DESC, conversions DESC
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.analytics_campaign_summary(UUID, TIMESTAMPTZ, TIMESTAMPTZ) TO service_role;
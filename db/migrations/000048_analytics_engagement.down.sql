@@ -0,0 +1,10 @@
+-- Drop functions
+DROP FUNCTION IF EXISTS itsrama.analytics_engagement_summary(UUID, DATE, DATE);
+DROP FUNCTION IF EXISTS itsrama.analytics_add_engagement(UUID, DATE, JSONB);
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_analytics_engagement_tenant_day;
+DROP INDEX IF EXISTS itsrama.idx_analytics_engagement_counter;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.analytics_engagement;
@@ -0,0 +1,71 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Daily counters of the case study sections visitors reached, one row per
+-- project, section and day rather than one per event
+CREATE TABLE itsrama.analytics_engagement (
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES itsrama.project(id) ON DELETE CASCADE,
+    section VARCHAR(50) NOT NULL,
+    day DATE NOT NULL,
+    reached INTEGER NOT NULL DEFAULT 0,
+    visible_ms BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_analytics_engagement_counter
+    ON itsrama.analytics_engagement(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), project_id, section, day);
+
+CREATE INDEX idx_analytics_engagement_tenant_day ON itsrama.analytics_engagement(tenant_id, day);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.analytics_engagement ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.analytics_engagement TO service_role;
+
+-- Add the sections reached in a visit to the counters of a day, returning
+-- the number of counters written
+CREATE OR REPLACE FUNCTION itsrama.analytics_add_engagement(
+    p_tenant_id UUID,
+    p_day DATE,
+    p_counts JSONB
+)
+RETURNS INTEGER AS $$
+    WITH written AS (
+        INSERT INTO itsrama.analytics_engagement (tenant_id, project_id, section, day, reached, visible_ms)
+        SELECT p_tenant_id, c.project_id, c.section, p_day, c.reached, c.visible_ms
+        FROM jsonb_to_recordset(p_counts) AS c(project_id UUID, section VARCHAR(50), reached INTEGER, visible_ms BIGINT)
+        ON CONFLICT (COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), project_id, section, day)
+        DO UPDATE SET
+            reached = itsrama.analytics_engagement.reached + EXCLUDED.reached,
+            visible_ms = itsrama.analytics_engagement.visible_ms + EXCLUDED.visible_ms
+        RETURNING 1
+    )
+    SELECT COUNT(*)::INTEGER FROM written
+$$ LANGUAGE sql;
+
+GRANT EXECUTE ON FUNCTION itsrama.analytics_add_engagement(UUID, DATE, JSONB) TO service_role;
+
+-- Sum the counters of every project and section between two days, both included
+CREATE OR REPLACE FUNCTION itsrama.analytics_engagement_summary(
+    p_tenant_id UUID,
+    p_from DATE,
+    p_to DATE
+)
+RETURNS TABLE (project_id UUID, section TEXT, reached BIGINT, visible_ms BIGINT) AS $$
+    SELECT
+        e.project_id,
+        e.section::TEXT,
+        SUM(e.reached)::BIGINT AS reached,
+        SUM(e.visible_ms)::BIGINT AS visible_ms
+    FROM itsrama.analytics_engagement e
+    WHERE (p_tenant_id IS NULL OR e.tenant_id = p_tenant_id)
+        AND e.day >= p_from
+        AND e.day <= p_to
+    GROUP BY 1, 2
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.analytics_engagement_summary(UUID, DATE, DATE) TO service_role;
@@ -0,0 +1,10 @@
+-- Drop functions and triggers
+DROP FUNCTION IF EXISTS itsrama.analytics_total_views(UUID);
+DROP TRIGGER IF EXISTS archive_analytics_event_views ON itsrama.analytics_event;
+DROP FUNCTION IF EXISTS itsrama.archive_analytics_views();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_analytics_view_total_tenant;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.analytics_view_total;
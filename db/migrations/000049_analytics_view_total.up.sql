@@ -0,0 +1,63 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Page views of raw events removed by the retention job, so the all-time
+-- total keeps growing after old events are pruned
+CREATE TABLE itsrama.analytics_view_total (
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    views BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_analytics_view_total_tenant
+    ON itsrama.analytics_view_total(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid));
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.analytics_view_total ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.analytics_view_total TO service_role;
+
+-- Add the page views of deleted events to the totals of their tenants
+CREATE OR REPLACE FUNCTION itsrama.archive_analytics_views()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO itsrama.analytics_view_total (tenant_id, views)
+    SELECT d.tenant_id, COUNT(*)
+    FROM deleted d
+    WHERE d.type = 'pageview'
+    GROUP BY d.tenant_id
+    ON CONFLICT (COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid))
+    DO UPDATE SET views = itsrama.analytics_view_total.views + EXCLUDED.views;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER archive_analytics_event_views
+    AFTER DELETE ON itsrama.analytics_event
+    REFERENCING OLD TABLE AS deleted
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION itsrama.archive_analytics_views();
+
+-- Count every page view ever recorded, pruned ones included
+CREATE OR REPLACE FUNCTION itsrama.analytics_total_views(
+    p_tenant_id UUID
+)
+RETURNS BIGINT AS $$
+    SELECT
+        COALESCE((
+            SELECT SUM(t.views)
+            FROM itsrama.analytics_view_total t
+            WHERE p_tenant_id IS NULL OR t.tenant_id = p_tenant_id
+        ), 0)::BIGINT
+        + (
+            SELECT COUNT(*)
+            FROM itsrama.analytics_event e
+            WHERE (p_tenant_id IS NULL OR e.tenant_id = p_tenant_id)
+                AND e.type = 'pageview'
+        )::BIGINT
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.analytics_total_views(UUID) TO service_role;
@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS itsrama.idx_accessibility_issue_tenant_entity;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.accessibility_issue;
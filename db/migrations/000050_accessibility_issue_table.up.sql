@@ -0,0 +1,30 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Accessibility problems found in content by the last audit of each entity
+CREATE TABLE itsrama.accessibility_issue (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    rule VARCHAR(50) NOT NULL,
+    severity VARCHAR(20) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    entity_name VARCHAR(255),
+    field VARCHAR(50) NOT NULL,
+    value TEXT,
+    message TEXT NOT NULL,
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the issues of a tenant and replacing those of an entity
+CREATE INDEX idx_accessibility_issue_tenant_entity ON itsrama.accessibility_issue(tenant_id, entity_type, entity_id);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.accessibility_issue ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.accessibility_issue TO service_role;
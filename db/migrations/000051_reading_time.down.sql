@@ -0,0 +1,3 @@
+-- Drop word count and reading time
+ALTER TABLE itsrama.page DROP COLUMN IF EXISTS reading_time, DROP COLUMN IF EXISTS word_count;
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS reading_time, DROP COLUMN IF EXISTS word_count;
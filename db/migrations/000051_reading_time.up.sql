@@ -0,0 +1,14 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Word count and reading time in minutes, computed when content is saved
+ALTER TABLE itsrama.project
+    ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0 CHECK (word_count >= 0),
+    ADD COLUMN reading_time INTEGER NOT NULL DEFAULT 0 CHECK (reading_time >= 0);
+
+ALTER TABLE itsrama.page
+    ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0 CHECK (word_count >= 0),
+    ADD COLUMN reading_time INTEGER NOT NULL DEFAULT 0 CHECK (reading_time >= 0);
@@ -0,0 +1,3 @@
+-- Drop tables of contents
+ALTER TABLE itsrama.page DROP COLUMN IF EXISTS toc;
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS toc;
@@ -0,0 +1,10 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Heading tree of the Markdown content, built when content is saved
+ALTER TABLE itsrama.project ADD COLUMN toc JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE itsrama.page ADD COLUMN toc JSONB NOT NULL DEFAULT '[]'::jsonb;
@@ -0,0 +1,2 @@
+-- Drop related content function
+DROP FUNCTION IF EXISTS itsrama.related_content_embedding(UUID, TEXT, UUID, INT);
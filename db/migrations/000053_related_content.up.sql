@@ -0,0 +1,34 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Return the embeddings of the same type closest to the stored embedding of
+-- a source, excluding the source itself
+CREATE OR REPLACE FUNCTION itsrama.related_content_embedding(
+    p_tenant_id UUID,
+    p_source_type TEXT,
+    p_source_id UUID,
+    p_match_count INT
+)
+RETURNS TABLE (source_type TEXT, source_id UUID, title TEXT, snippet TEXT, similarity FLOAT) AS $$
+    SELECT
+        e.source_type::TEXT,
+        e.source_id,
+        e.title::TEXT,
+        e.snippet,
+        1 - (e.embedding OPERATOR(extensions.<=>) s.embedding) AS similarity
+    FROM itsrama.content_embedding s
+    JOIN itsrama.content_embedding e
+        ON e.source_type = s.source_type
+        AND e.source_id <> s.source_id
+        AND e.tenant_id IS NOT DISTINCT FROM s.tenant_id
+    WHERE s.source_type = p_source_type
+        AND s.source_id = p_source_id
+        AND (p_tenant_id IS NULL OR s.tenant_id = p_tenant_id)
+    ORDER BY e.embedding OPERATOR(extensions.<=>) s.embedding
+    LIMIT p_match_count
+$$ LANGUAGE sql STABLE;
+
+GRANT EXECUTE ON FUNCTION itsrama.related_content_embedding(UUID, TEXT, UUID, INT) TO service_role;
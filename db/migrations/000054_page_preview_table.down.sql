@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_page_preview_page_id;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.page_preview;
@@ -0,0 +1,27 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Signed links showing a page, even a draft, until they expire or are
+-- revoked. Tokens are not stored; they are signed from the ID and expiry.
+CREATE TABLE itsrama.page_preview (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    page_id UUID NOT NULL REFERENCES itsrama.page(id) ON DELETE CASCADE,
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the previews of a page
+CREATE INDEX idx_page_preview_page_id ON itsrama.page_preview(page_id, created_at DESC);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.page_preview ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.page_preview TO service_role;
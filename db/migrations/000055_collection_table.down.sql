@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_collection_modtime ON itsrama.collection;
+
+-- Drop function
+DROP FUNCTION IF EXISTS update_collection_modified_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS itsrama.idx_collection_project_ids;
+DROP INDEX IF EXISTS itsrama.idx_collection_tenant_slug;
+
+-- Drop table
+DROP TABLE IF EXISTS itsrama.collection;
@@ -0,0 +1,45 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Series of projects, read in the order of project_ids. Deleted projects
+-- are removed from the array by the application.
+CREATE TABLE itsrama.collection (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID REFERENCES itsrama.tenant(id) ON DELETE CASCADE,
+    slug VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    project_ids UUID[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A slug names one collection per tenant
+CREATE UNIQUE INDEX idx_collection_tenant_slug
+    ON itsrama.collection(COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid), slug);
+
+-- Index for finding the collections of a project
+CREATE INDEX idx_collection_project_ids ON itsrama.collection USING GIN (project_ids);
+
+-- Enable Row Level Security
+ALTER TABLE itsrama.collection ENABLE ROW LEVEL SECURITY;
+
+-- Grant all permissions on table to service_role
+GRANT ALL PRIVILEGES ON TABLE itsrama.collection TO service_role;
+
+-- Add trigger to automatically update updated_at timestamp
+CREATE OR REPLACE FUNCTION update_collection_modified_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_collection_modtime
+BEFORE UPDATE ON itsrama.collection
+FOR EACH ROW
+EXECUTE FUNCTION update_collection_modified_column();
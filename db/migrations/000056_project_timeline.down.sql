@@ -0,0 +1,2 @@
+-- Drop project timeline
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS timeline;
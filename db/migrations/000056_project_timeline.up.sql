@@ -0,0 +1,10 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Dated design, build and launch phases with the effort spent on them
+ALTER TABLE itsrama.project
+    ADD COLUMN timeline JSONB NOT NULL DEFAULT '[]'::jsonb
+    CHECK (jsonb_typeof(timeline) = 'array');
@@ -0,0 +1,2 @@
+-- Drop project client
+ALTER TABLE itsrama.project DROP COLUMN IF EXISTS client;
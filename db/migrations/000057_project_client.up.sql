@@ -0,0 +1,11 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Client a project was built for, optionally linked to a company. Details
+-- of confidential clients are redacted from public responses.
+ALTER TABLE itsrama.project
+    ADD COLUMN client JSONB
+    CHECK (client IS NULL OR jsonb_typeof(client) = 'object');
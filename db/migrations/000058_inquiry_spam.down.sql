@@ -0,0 +1,4 @@
+-- Drop inquiry spam verdict
+ALTER TABLE itsrama.inquiry
+    DROP COLUMN IF EXISTS spam_score,
+    DROP COLUMN IF EXISTS spam_reasons;
@@ -0,0 +1,10 @@
+-- Ensure itsrama schema exists
+CREATE SCHEMA IF NOT EXISTS itsrama;
+
+-- Grant usage and create permissions on schema to service_role
+GRANT USAGE, CREATE ON SCHEMA itsrama TO service_role;
+
+-- Spam filter verdict of inquiries flagged into the spam stage
+ALTER TABLE itsrama.inquiry
+    ADD COLUMN spam_score NUMERIC(4, 3),
+    ADD COLUMN spam_reasons TEXT[];
@@ -19,47 +19,54 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/experiences": {
+        "/.well-known/webfinger": {
             "get": {
-                "description": "Retrieve a paginated list of experiences with optional filtering",
+                "description": "Resolve an acct: resource such as acct:rama@example.com to its ActivityPub actor, as done by Mastodon when searching for the account",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "ActivityPub"
                 ],
-                "summary": "List experiences",
+                "summary": "WebFinger lookup",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Filter by company name",
-                        "name": "company",
-                        "in": "query"
+                        "description": "Account, e.g. acct:rama@example.com",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Resource Descriptor",
+                        "schema": {
+                            "$ref": "#/definitions/activitypub.WebFinger"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Filter by featured status",
-                        "name": "is_featured",
-                        "in": "query"
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
+                }
+            }
+        },
+        "/_fixtures": {
+            "get": {
+                "description": "List the entities canonical example payloads are served for. Hidden like other debug routes in restricted environments, such as production.",
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Fixtures"
+                ],
+                "summary": "List fixture entities",
                 "responses": {
                     "200": {
-                        "description": "Experiences retrieved successfully",
+                        "description": "Fixture entities retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -71,94 +78,57 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/experience.Experience"
+                                                "$ref": "#/definitions/contract_fixtures.Entity"
                                             }
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
-                        }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new experience with logo and images",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+            }
+        },
+        "/_fixtures/{entity}": {
+            "get": {
+                "description": "Serve a canonical example payload of an entity, generated from its DTO and rendered through the same view and envelope as real responses, so mock servers and contract tests match the actual serialization. Every field is filled from its example, or a stable placeholder. With list=true the payload is a one-item list with pagination. Hidden like other debug routes in restricted environments, such as production.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "Fixtures"
                 ],
-                "summary": "Create a new experience",
+                "summary": "Get an entity fixture",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Logo Image",
-                        "name": "logo_image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "Experience Images",
-                        "name": "images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Experience Details in JSON format (See ExperienceCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Entity name, see GET /_fixtures",
+                        "name": "entity",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Serve a paginated list",
+                        "name": "list",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Experience created successfully",
+                        "description": "Fixture retrieved successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/experience.Experience"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/experience.ExperienceCreate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Unknown entity",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -166,213 +136,154 @@ const docTemplate = `{
                 }
             }
         },
-        "/experiences/bulk": {
-            "put": {
-                "description": "Bulk update experiences with logo and images",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/activitypub/users/{username}": {
+            "get": {
+                "description": "Retrieve the ActivityPub actor of the portfolio, which fediverse accounts can follow",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "ActivityPub"
                 ],
+                "summary": "Get ActivityPub actor",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Logo Image",
-                        "name": "logo_image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "Experience Images",
-                        "name": "images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Experience Details in JSON array format (See ExperienceUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Tenant slug",
+                        "name": "username",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Experiences updated successfully",
+                        "description": "Actor",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/experience.Experience"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/activitypub.Actor"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Actor not found",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/experience.ExperienceUpdate"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/activitypub/users/{username}/followers": {
+            "get": {
+                "description": "Retrieve the number of followers. The followers themselves are not listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ActivityPub"
+                ],
+                "summary": "Get ActivityPub followers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant slug",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Followers",
+                        "schema": {
+                            "$ref": "#/definitions/activitypub.OrderedCollection"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Actor not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/activitypub/users/{username}/inbox": {
             "post": {
-                "description": "Create multiple experiences in a single request",
+                "description": "Receive an activity signed with an HTTP signature. Follow, Undo of a Follow and Delete of an account are handled; other activities are accepted and ignored.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "ActivityPub"
                 ],
-                "summary": "Bulk create experiences",
+                "summary": "ActivityPub inbox",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Logo Image",
-                        "name": "logo_image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Experience Images",
-                        "name": "images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Experience Details in JSON array format (See ExperienceCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Tenant slug",
+                        "name": "username",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Experiences created successfully",
+                    "202": {
+                        "description": "Activity accepted"
+                    },
+                    "400": {
+                        "description": "Invalid activity",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/experience.Experience"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Invalid HTTP signature",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/experience.ExperienceCreate"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "429": {
+                        "description": "Too many requests",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete multiple experiences in a single request",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/activitypub/users/{username}/notes/{id}": {
+            "get": {
+                "description": "Retrieve the note announcing a public project",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "ActivityPub"
                 ],
-                "summary": "Bulk delete experiences",
+                "summary": "Get ActivityPub note",
                 "parameters": [
                     {
-                        "description": "Experience IDs to delete",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "Tenant slug",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Experiences deleted successfully",
+                        "description": "Note",
                         "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
+                            "$ref": "#/definitions/activitypub.Note"
                         }
                     },
                     "400": {
@@ -380,46 +291,81 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Note not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/experiences/search": {
+        "/activitypub/users/{username}/outbox": {
             "get": {
-                "description": "Perform a full-text search on experiences with pagination",
+                "description": "Retrieve the public projects as notes, newest first. Without a page only the collection and its size are returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "ActivityPub"
                 ],
-                "summary": "Search experiences",
+                "summary": "Get ActivityPub outbox",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
+                        "description": "Tenant slug",
+                        "name": "username",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "default": 1,
                         "description": "Page number",
                         "name": "page",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Outbox",
+                        "schema": {
+                            "$ref": "#/definitions/activitypub.OrderedCollection"
+                        }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Actor not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accessibility": {
+            "get": {
+                "security": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Retrieve the accessibility issues recorded by the last audit, with their number per rule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get the accessibility report",
                 "responses": {
                     "200": {
-                        "description": "Experiences search completed successfully",
+                        "description": "Accessibility report retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -429,47 +375,38 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/experience.Experience"
-                                            }
+                                            "$ref": "#/definitions/accessibility.Report"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            }
-        },
-        "/experiences/{id}": {
-            "get": {
-                "description": "Retrieve a specific experience using its unique identifier",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check the images, Markdown headings and theme colors of all content and record the accessibility issues found",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
-                ],
-                "summary": "Get an experience by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Experience ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "Run an accessibility audit",
                 "responses": {
                     "200": {
-                        "description": "Experience retrieved successfully",
+                        "description": "Accessibility audit completed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -479,70 +416,68 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/experience.Experience"
+                                            "$ref": "#/definitions/accessibility.Report"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Experience not found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing experience with new details and optional files",
-                "consumes": [
-                    "multipart/form-data"
+            }
+        },
+        "/admin/accessibility/{entity}/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Check a project, experience, page or the site config without recording the issues, e.g. before publishing it. The id is ignored for the site config.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "Admin"
                 ],
-                "summary": "Update an experience",
+                "summary": "Check the accessibility of an entity",
                 "parameters": [
                     {
+                        "enum": [
+                            "project",
+                            "experience",
+                            "page",
+                            "site_config"
+                        ],
                         "type": "string",
-                        "description": "Experience ID",
-                        "name": "id",
+                        "description": "Entity type",
+                        "name": "entity",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "file",
-                        "description": "Logo Image",
-                        "name": "logo_image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "Experience Images",
-                        "name": "images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Experience Details in JSON format (See ExperienceUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Experience updated successfully",
+                        "description": "Accessibility check completed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -552,7 +487,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/experience.Experience"
+                                            "$ref": "#/definitions/accessibility.Report"
                                         }
                                     }
                                 }
@@ -560,7 +495,60 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entity not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/activitypub/followers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of fediverse accounts following the portfolio, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List followers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Followers retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -570,34 +558,50 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/experience.ExperienceUpdate"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/activitypub.Follower"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Experience not found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/activitypub/followers/{id}": {
             "delete": {
-                "description": "Delete an experience by its unique identifier",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop delivering to a follower and ask its server to drop the follow",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Experiences"
+                    "Admin"
                 ],
-                "summary": "Delete an experience",
+                "summary": "Remove a follower",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Experience ID",
+                        "description": "Follower ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -605,7 +609,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Experience deleted successfully",
+                        "description": "Follower removed successfully",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -616,8 +620,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Experience not found",
+                        "description": "Follower not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -625,82 +635,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
-            "get": {
-                "description": "Performs a health check on the service and its dependencies",
-                "consumes": [
-                    "application/json"
+        "/admin/activitypub/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Announce the public projects not announced yet to followers. Projects are also published automatically after they change.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "Admin"
                 ],
-                "summary": "Check Health Status",
+                "summary": "Publish to followers",
                 "responses": {
                     "200": {
-                        "description": "Service is healthy",
-                        "schema": {
-                            "$ref": "#/definitions/health.HealthStatus"
-                        }
-                    },
-                    "206": {
-                        "description": "Service is degraded",
+                        "description": "Projects published successfully",
                         "schema": {
-                            "$ref": "#/definitions/health.HealthStatus"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/activitypub.PublishReport"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/health.HealthStatus"
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service is unavailable",
+                    "500": {
+                        "description": "ActivityPub is not enabled",
                         "schema": {
-                            "$ref": "#/definitions/health.HealthStatus"
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/projects": {
-            "get": {
-                "description": "Retrieve a paginated list of projects with optional filtering",
+        "/admin/changelog": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Write a manual changelog entry, optionally backdated",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Changelog"
                 ],
-                "summary": "List projects",
+                "summary": "Create a changelog entry",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by project category",
-                        "name": "category",
-                        "in": "query"
+                        "description": "Changelog Entry Details",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/changelog.EntryCreate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Projects retrieved successfully",
+                        "description": "Changelog entry created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -710,10 +725,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.Project"
-                                            }
+                                            "$ref": "#/definitions/changelog.Entry"
                                         }
                                     }
                                 }
@@ -727,41 +739,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new project with details and optional images",
+            }
+        },
+        "/admin/changelog/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit the title, body or publish date of a changelog entry",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Changelog"
                 ],
-                "summary": "Create a new project",
+                "summary": "Update a changelog entry",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Project Images",
-                        "name": "uploaded_images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Project Details in JSON format (See ProjectCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Changelog Entry ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Changelog Entry Update Details",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/changelog.EntryUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Project created successfully",
+                        "description": "Changelog entry updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -771,7 +789,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/project.Project"
+                                            "$ref": "#/definitions/changelog.Entry"
                                         }
                                     }
                                 }
@@ -781,141 +799,88 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/project.ProjectCreate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Changelog entry not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            }
-        },
-        "/projects/bulk": {
-            "put": {
-                "description": "Update multiple projects in a single request",
-                "consumes": [
-                    "multipart/form-data"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Remove an entry from the changelog",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Changelog"
                 ],
-                "summary": "Bulk update projects",
+                "summary": "Delete a changelog entry",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Project Images",
-                        "name": "uploaded_images",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "Project Update Details in JSON array format (See ProjectUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
+                        "description": "Changelog Entry ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Projects updated successfully",
+                        "description": "Changelog entry deleted successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.Project"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.ProjectUpdate"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Changelog entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/coding-activity/refresh": {
             "post": {
-                "description": "Create multiple projects in a single request",
-                "consumes": [
-                    "multipart/form-data"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Pull the last days of coding activity from WakaTime now, overwriting their snapshots and clearing cached aggregates",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Admin"
                 ],
-                "summary": "Bulk create projects",
+                "summary": "Refresh coding activity",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Project Images",
-                        "name": "uploaded_images",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Project Details in JSON array format (See ProjectCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Number of days including today (1-365), defaults to WAKATIME_SYNC_DAYS",
+                        "name": "days",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Projects created successfully",
+                        "description": "Coding activity refreshed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -925,10 +890,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.Project"
-                                            }
+                                            "$ref": "#/definitions/coding_activity.SyncResult"
                                         }
                                     }
                                 }
@@ -938,34 +900,32 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.ProjectCreate"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "WakaTime is not configured or unreachable",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete multiple projects in a single request",
+            }
+        },
+        "/admin/collections": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Group projects into a series read in the given order",
                 "consumes": [
                     "application/json"
                 ],
@@ -973,75 +933,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Collections"
                 ],
-                "summary": "Bulk delete projects",
+                "summary": "Create a collection",
                 "parameters": [
                     {
-                        "description": "Project IDs to delete",
-                        "name": "ids",
+                        "description": "Collection Details",
+                        "name": "collection",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/collection.CollectionCreate"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Projects deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/projects/search": {
-            "get": {
-                "description": "Perform a full-text search on projects with pagination",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Projects"
-                ],
-                "summary": "Search projects",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Projects search completed successfully",
+                        "description": "Collection created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1051,10 +959,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/project.Project"
-                                            }
+                                            "$ref": "#/definitions/collection.Collection"
                                         }
                                     }
                                 }
@@ -1066,24 +971,35 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    },
+                    "409": {
+                        "description": "A collection with this slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/projects/{id}": {
+        "/admin/collections/{id}": {
             "get": {
-                "description": "Retrieve a project using its unique identifier",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a collection by its ID, including projects that are not public",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Collections"
                 ],
-                "summary": "Get a project by ID",
+                "summary": "Get a collection by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1091,7 +1007,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Project retrieved successfully",
+                        "description": "Collection retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1101,7 +1017,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/project.Project"
+                                            "$ref": "#/definitions/collection.Collection"
                                         }
                                     }
                                 }
@@ -1115,7 +1031,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Collection not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1123,46 +1039,43 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing project with new details and optional images",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the slug, title or description of a collection, or reorder its projects",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Collections"
                 ],
-                "summary": "Update a project",
+                "summary": "Update a collection",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Project Images",
-                        "name": "uploaded_images",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Project Update Details in JSON format (See ProjectUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
+                        "description": "Collection Update Details",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Project updated successfully",
+                        "description": "Collection updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1172,7 +1085,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/project.Project"
+                                            "$ref": "#/definitions/collection.Collection"
                                         }
                                     }
                                 }
@@ -1182,23 +1095,17 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/project.ProjectUpdate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A collection with this slug already exists",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1206,18 +1113,23 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a project by its unique identifier",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a collection, leaving its projects untouched",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Collections"
                 ],
-                "summary": "Delete a project",
+                "summary": "Delete a collection",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1225,7 +1137,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Project deleted successfully",
+                        "description": "Collection deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1237,7 +1149,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Collection not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1245,41 +1157,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/tech-stacks": {
+        "/admin/cors": {
             "get": {
-                "description": "Retrieve a paginated list of tech stacks with optional filtering",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the origins currently allowed to make cross-origin requests",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
-                ],
-                "summary": "List tech stacks",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by category",
-                        "name": "category",
-                        "in": "query"
-                    }
+                    "Admin"
                 ],
+                "summary": "Get the CORS policy",
                 "responses": {
                     "200": {
-                        "description": "Tech stacks retrieved successfully",
+                        "description": "CORS policy retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1289,54 +1184,52 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tech_stack.TechStack"
-                                            }
+                                            "$ref": "#/definitions/cors_policy.Policy"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create a new tech stack with image upload",
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the origins allowed to make cross-origin requests, taking effect immediately without a restart. Origins are a scheme and host such as https://example.com, or the lone wildcard \"*\". Changes last until the server restarts.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
+                    "Admin"
                 ],
-                "summary": "Create a new tech stack",
+                "summary": "Update the allowed CORS origins",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Tech Stack Image",
-                        "name": "image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tech Stack Details in JSON format (See TechStackCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
+                        "description": "Allowed origins",
+                        "name": "origins",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cors_policy.OriginsUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tech stack created successfully",
+                        "description": "CORS policy updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1346,7 +1239,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tech_stack.TechStack"
+                                            "$ref": "#/definitions/cors_policy.Policy"
                                         }
                                     }
                                 }
@@ -1355,6 +1248,37 @@ const docTemplate = `{
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/deprecations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every registered endpoint flagged as deprecated, with its deprecation and sunset dates and the traffic it received over the last 30 days. An endpoint is safe to remove once it went without traffic for the quiet period. Traffic is counted in memory and starts over when the server restarts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get the deprecated endpoints report",
+                "responses": {
+                    "200": {
+                        "description": "Deprecation report retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1364,15 +1288,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tech_stack.TechStackCreate"
+                                            "$ref": "#/definitions/deprecation.Report"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1380,41 +1304,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/tech-stacks/bulk": {
-            "put": {
-                "description": "Update multiple tech stacks in a single request",
-                "consumes": [
-                    "multipart/form-data"
+        "/admin/diagnostics/slow-calls": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Count the database calls and handlers that exceeded their latency thresholds, grouped by operation, with the details of the latest occurrence such as the query filters involved",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
-                ],
-                "summary": "Bulk update tech stacks",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Tech Stack Images",
-                        "name": "images",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tech Stack Update Details in JSON array format (See TechStackUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Diagnostics"
                 ],
+                "summary": "Get slow calls",
                 "responses": {
                     "200": {
-                        "description": "Tech stacks updated successfully",
+                        "description": "Slow calls retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1424,73 +1331,69 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tech_stack.TechStack"
-                                            }
+                                            "$ref": "#/definitions/diagnostics.SlowCallReport"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tech_stack.TechStackUpdate"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create multiple tech stacks in a single request",
-                "consumes": [
-                    "multipart/form-data"
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Clear the slow call counts, e.g. after fixing a hotspot",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
+                    "Diagnostics"
                 ],
-                "summary": "Bulk create tech stacks",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Tech Stack Images",
-                        "name": "image",
-                        "in": "formData"
+                "summary": "Reset slow calls",
+                "responses": {
+                    "200": {
+                        "description": "Slow calls reset successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/duplicates": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Tech Stack Details in JSON array format (See TechStackCreate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Group existing projects sharing a normalized title, and experiences with the same role at the same company over overlapping dates. The report runs whatever the configured dedup mode.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Duplicates"
+                ],
+                "summary": "Report probable duplicates",
                 "responses": {
                     "200": {
-                        "description": "Tech stacks created successfully",
+                        "description": "Duplicates retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1500,18 +1403,68 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tech_stack.TechStack"
-                                            }
+                                            "$ref": "#/definitions/duplicates.Report"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/endorsements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of endorsements, newest first, for moderation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endorsements"
+                ],
+                "summary": "List endorsements",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tech stack",
+                        "name": "tech_stack_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by visibility",
+                        "name": "is_hidden",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Endorsements retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1523,45 +1476,50 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/tech_stack.TechStackCreate"
+                                                "$ref": "#/definitions/endorsement.Endorsement"
                                             }
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/admin/endorsements/{id}": {
             "delete": {
-                "description": "Delete multiple tech stacks in a single request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Permanently remove an endorsement",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
+                    "Endorsements"
                 ],
-                "summary": "Bulk delete tech stacks",
+                "summary": "Delete an endorsement",
                 "parameters": [
                     {
-                        "description": "Tech Stack IDs to delete",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "Endorsement ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tech stacks deleted successfully",
+                        "description": "Endorsement deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -1571,32 +1529,53 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Endorsement not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/tech-stacks/{id}": {
-            "get": {
-                "description": "Retrieve a specific tech stack using its unique identifier",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hide an endorsement from public counts or show it again",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
+                    "Endorsements"
                 ],
-                "summary": "Get a tech stack by ID",
+                "summary": "Moderate an endorsement",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Tech Stack ID",
+                        "description": "Endorsement ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Moderation Details",
+                        "name": "moderation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endorsement.EndorsementModerate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tech stack retrieved successfully",
+                        "description": "Endorsement moderated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1606,7 +1585,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tech_stack.TechStack"
+                                            "$ref": "#/definitions/endorsement.Endorsement"
                                         }
                                     }
                                 }
@@ -1620,50 +1599,32 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Tech stack not found",
+                        "description": "Endorsement not found",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing tech stack with new details and optional image",
-                "consumes": [
-                    "multipart/form-data"
+            }
+        },
+        "/admin/exchange-rates/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Fetch the latest exchange rates now instead of waiting for the scheduled refresh",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
-                ],
-                "summary": "Update a tech stack",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Tech Stack ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Tech Stack Image",
-                        "name": "image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tech Stack Update Details in JSON format (See TechStackUpdate Model)",
-                        "name": "payload",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "Refresh exchange rates",
                 "responses": {
                     "200": {
-                        "description": "Tech stack updated successfully",
+                        "description": "Exchange rates refreshed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1673,983 +1634,21156 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tech_stack.TechStack"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/offering.ExchangeRate"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/tech_stack.TechStackUpdate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Tech stack not found",
+                    "500": {
+                        "description": "Rate provider is not configured or unreachable",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a tech stack by its unique identifier",
+            }
+        },
+        "/admin/experiments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of experiments, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tech Stacks"
+                    "Experiments"
                 ],
-                "summary": "Delete a tech stack",
+                "summary": "List experiments",
                 "parameters": [
                     {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "headline",
+                            "featured_order"
+                        ],
                         "type": "string",
-                        "description": "Tech Stack ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Field varied",
+                        "name": "field",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tech stack deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "Experiments retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
-                        }
-                    },
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experiment.Experiment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define variants of the profile headline or of the featured project order. Only one active experiment may vary a field.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Create an experiment",
+                "parameters": [
+                    {
+                        "description": "Experiment Details",
+                        "name": "experiment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/experiment.ExperimentCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiment created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experiment.Experiment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Key taken or field already varied by an active experiment",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/experiments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an experiment by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Get an experiment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experiment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiment retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experiment.Experiment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Tech stack not found",
+                        "description": "Experiment not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start or stop an experiment or change its description. Variants can only change until visitors were assigned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Update an experiment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experiment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Experiment Update Details",
+                        "name": "experiment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/experiment.ExperimentUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiment updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experiment.Experiment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Experiment not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Variants already assigned or field already varied by an active experiment",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an experiment along with its recorded exposures and conversions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Delete an experiment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experiment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Experiment not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/experiments/{id}/results": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Count the visitors shown each variant and those of them who then submitted the contact form",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Get experiment results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experiment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiment results retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experiment.Results"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
-                    }
+                    },
+                    "404": {
+                        "description": "Experiment not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/import/{entity}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a background job creating every item of a seed file, such as example_input_projects.json, one at a time. Failing items are recorded and skipped. Poll GET /jobs/{id} for the per-item report, or follow job.progress events on /events/stream.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import content in bulk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kind of content (projects, experiences, tech_stacks)",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Items in the create format of the entity",
+                        "name": "items",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the items, including slug conflicts and missing references, and report the would-be changes without queueing the import",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/jobs.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/indieauth/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issue an authorization code for a request shown on the consent page. The scope may be narrowed before approving. The browser is then sent to the returned redirect URL.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Approve an IndieAuth request",
+                "parameters": [
+                    {
+                        "description": "Authorization Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/indieauth.AuthorizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authorization approved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/indieauth.Approval"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid authorization request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "IndieAuth is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/indieauth/authorizations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of access tokens granted to IndieAuth clients, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List IndieAuth authorizations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authorizations retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/indieauth.Authorization"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/indieauth/authorizations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke the access token granted to a client",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Revoke an IndieAuth authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authorization revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Authorization not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/inquiries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of inquiries, newest first, optionally filtered by status. Inquiries flagged as spam are only listed when filtering by the spam status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "List inquiries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "qualified",
+                            "proposal_sent",
+                            "accepted",
+                            "declined",
+                            "paid",
+                            "spam"
+                        ],
+                        "type": "string",
+                        "description": "Pipeline stage",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiries retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/inquiry.Inquiry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/inquiries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific inquiry using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Get an inquiry by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiry retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.Inquiry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently remove an inquiry along with its proposals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Delete an inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiry deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/inquiries/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the payment links and transactions of an inquiry, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "List payments of an inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payments retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/inquiry.Payment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/inquiries/{id}/proposals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the proposals quoted to an inquiry, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "List proposals of an inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposals retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/inquiry.Proposal"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render a branded proposal PDF from line items, store it and optionally email signed download and acceptance links to the prospect",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Create a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proposal Details",
+                        "name": "proposal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/inquiry.ProposalCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposal created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.ProposalLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/inquiries/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move an inquiry to another stage of the pipeline",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Update the status of an inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New Status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/inquiry.InquiryStatusUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiry status updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.Inquiry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve background jobs with optional status and kind filtering; dead jobs are the dead letters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "List background jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (queued, running, succeeded, dead)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by kind",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jobs retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/jobs.Job"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Requeue a job that failed every attempt with a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Retry a dead job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job requeued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/jobs.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Only dead jobs can be retried",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/linkcheck": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the links found unreachable by the last link check",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List broken links",
+                "responses": {
+                    "200": {
+                        "description": "Broken links retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/linkcheck.BrokenLink"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check every project and experience URL and record the ones not answering with a 2xx status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Run a link check",
+                "responses": {
+                    "200": {
+                        "description": "Link check completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/linkcheck.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/logging/levels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the default log level, the level of every module that logged so far and the sinks logs are written to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get the log levels",
+                "responses": {
+                    "200": {
+                        "description": "Log levels retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/log_level.Levels"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the default log level and the levels of some modules, taking effect immediately without a restart, e.g. to turn on debug logs of one module while investigating it. Levels are debug, info, warn or error. Changes last until the server restarts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Update the log levels",
+                "parameters": [
+                    {
+                        "description": "Log levels",
+                        "name": "levels",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/log_level.LevelsUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Log levels updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/log_level.Levels"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/logging/levels/{module}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the level of a module so it follows the default log level again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Reset the log level of a module",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Module name, e.g. tech_stack",
+                        "name": "module",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Module log level reset successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/log_level.Levels"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/maintenance/reading-time": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recount the words and reading time of every project case study and page, e.g. after the counting rules change. Statistics are otherwise computed on save; only content whose statistics changed is written.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Recompute reading times",
+                "responses": {
+                    "200": {
+                        "description": "Reading times recomputed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/maintenance.ReadingTimeReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/maintenance/toc": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rebuild the table of contents of every project case study and page, e.g. after the anchor rules change. Tables of contents are otherwise built on save; only content whose table of contents changed is written.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Rebuild tables of contents",
+                "responses": {
+                    "200": {
+                        "description": "Tables of contents rebuilt successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/maintenance.TOCReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/media": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the images of every project and experience with their alt text and what is wrong with it, if anything",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "List images",
+                "responses": {
+                    "200": {
+                        "description": "Images retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/media.Image"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/media/alt": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the alt text of the image stored at src on a project or an experience",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Update alt text",
+                "parameters": [
+                    {
+                        "description": "Alt text",
+                        "name": "alt",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/media.AltUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alt text updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/media.Image"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/media/alt-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the images whose alt text is missing, a file name, generic, too short or too long",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Report missing alt text",
+                "responses": {
+                    "200": {
+                        "description": "Alt text report generated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/media.AltReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/media/alt/suggest": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generate alt text describing an image with Gemini, storing it when apply is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Suggest alt text",
+                "parameters": [
+                    {
+                        "description": "Image to describe",
+                        "name": "suggestion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/media.AltSuggestionCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alt text suggested successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/media.AltSuggestion"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Alt text suggestions are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Failed to suggest alt text",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/now": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publish a new now entry written in Markdown. It becomes current and the previous entry moves to the history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now"
+                ],
+                "summary": "Publish a now entry",
+                "parameters": [
+                    {
+                        "description": "Now Entry Details",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/now.EntryCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Now entry published successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/now.Entry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/now/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Correct the current or a previous now entry without changing which one is current",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now"
+                ],
+                "summary": "Update a now entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Now Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Now Entry Update Details",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/now.EntryUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Now entry updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/now.Entry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Now entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a now entry. Deleting the current entry makes the newest remaining one current.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now"
+                ],
+                "summary": "Delete a now entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Now Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Now entry deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Now entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/offerings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of active and inactive offerings with prices in the requested currency",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List all offerings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "EUR",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offerings retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/offering.OfferingDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of published and draft pages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "List pages for editing",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only published or draft pages",
+                        "name": "published",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pages retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/page.Page"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a page written in Markdown, such as the privacy policy, terms or imprint. The page is saved as its first revision.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Create a page",
+                "parameters": [
+                    {
+                        "description": "Page Details",
+                        "name": "page",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/page.PageCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.Page"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A page with this slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a published or draft page by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Get a page for editing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.Page"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit, publish or unpublish a page. Every edit is saved as a new revision.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Update a page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Page Update Details",
+                        "name": "page",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/page.PageUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.Page"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A page with this slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a page and its revision history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Delete a page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages/{id}/previews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the preview links of a page, newest first, with the links of those still working",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "List page preview links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page previews retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/page.PreviewLink"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sign a link showing a page, even a draft, to anyone holding it, e.g. to have it reviewed before publishing. The link works until it expires or is revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Create a page preview link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expiry of the link",
+                        "name": "preview",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/page.PreviewCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page preview created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.PreviewLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages/{id}/previews/{preview}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop a preview link from working before it expires",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Revoke a page preview link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preview ID",
+                        "name": "preview",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page preview revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page preview not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of the saved revisions of a page, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "List page revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page revisions retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/page.Revision"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/pages/{id}/revisions/{revision}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bring back the title and body of an earlier revision, saved as a new revision. Whether the page is published is left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Restore a page revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Restore Details",
+                        "name": "restore",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/page.RevisionRestore"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page revision restored successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.Page"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page or revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/projects": {
+            "get": {
+                "description": "Retrieve a paginated list of projects with optional filtering. The admin route returns every field of the projects instead of their public view.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "List projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by project category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "public",
+                            "unlisted",
+                            "draft"
+                        ],
+                        "type": "string",
+                        "description": "Filter by visibility on the admin route",
+                        "name": "visibility",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.PublicProject"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/projects/{id}": {
+            "get": {
+                "description": "Retrieve a project using its unique identifier. The admin route returns every field of the project instead of its public view.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a project by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.PublicProject"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/projects/{id}/timeline": {
+            "get": {
+                "description": "Retrieve the design, build and launch phases of a project with their offsets and durations in days from the first phase, and their share of the total effort. Ongoing phases end today.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a project timeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project timeline retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ProjectTimeline"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/proposals/{id}/deposit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a Stripe Checkout link charging a share of an accepted proposal's total. The inquiry moves to paid once Stripe confirms the payment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Create a deposit payment link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deposit Details",
+                        "name": "deposit",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/inquiry.DepositCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deposit link created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.Payment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Proposal not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Proposal has not been accepted yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/proposals/{id}/send": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Email fresh signed download and acceptance links of a proposal to the prospect of its inquiry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Send a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposal sent successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.ProposalLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Proposal not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/redirects": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of redirects, by path",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "List redirects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in paths and targets",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirects retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/redirect.Redirect"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send requests for an old path to a new path or URL. A path ending in /* matches every path below it, and a target ending in /* receives the rest of the matched path.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Create a redirect",
+                "parameters": [
+                    {
+                        "description": "Redirect Details",
+                        "name": "redirect",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/redirect.RedirectCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/redirect.Redirect"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A redirect for this path already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/redirects/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a redirect by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Get a redirect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Redirect ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/redirect.Redirect"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Redirect not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the path, target or status code of a redirect",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Update a redirect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Redirect ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Redirect Update Details",
+                        "name": "redirect",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/redirect.RedirectUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/redirect.Redirect"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Redirect not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A redirect for this path already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a redirect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Delete a redirect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Redirect ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Redirect not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/runtime": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the goroutine count, heap and garbage collector statistics and build information of the running server, e.g. to follow memory growth between heap profiles taken from /debug/pprof",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Diagnostics"
+                ],
+                "summary": "Get runtime statistics",
+                "responses": {
+                    "200": {
+                        "description": "Runtime statistics retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/diagnostics.RuntimeReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/search/reindex": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Embed the projects and experiences changed since they were last embedded and remove deleted or hidden ones. Content is also reindexed automatically after changes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Reindex semantic search",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Re-embed all content, e.g. after changing the embedding model",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Content reindexed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/search.IndexReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of admin sign-ins with the address and browser they were used from, newest first. Sessions are flagged automatically when used from an IP address the admin never signed in from, or when their address or browser changes midway.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List admin sessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only flagged or unflagged sessions",
+                        "name": "flagged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sessions of this admin",
+                        "name": "email",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sessions retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/admin_session.Session"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sessions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an admin sign-in by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get an admin session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Session retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin_session.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sessions/{id}/flag": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Flag an admin sign-in as suspicious, e.g. one made from an unknown device, or clear the flag of a session that turned out legitimate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Flag an admin session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Flag",
+                        "name": "flag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin_session.FlagUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Session flag updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin_session.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/stats/profiles/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Read every configured profile now. Providers that fail keep their previous stats and are reported in the results.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Refresh external profile stats",
+                "responses": {
+                    "200": {
+                        "description": "Profile stats refreshed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/profile_stats.RefreshResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "No providers are configured",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/storage/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report the bytes stored per module and the room left under the storage quota. Files stored before usage tracking started are not counted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get storage usage",
+                "responses": {
+                    "200": {
+                        "description": "Storage usage retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/storage_usage.Usage"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/validate": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check all content for broken image URLs, tech stack links to deleted tech stacks, projects without a thumbnail and experiences ending before they start",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Validate stored content",
+                "responses": {
+                    "200": {
+                        "description": "Validation completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/integrity.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webmentions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of received Webmentions, newest first, optionally filtered by status or project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List Webmentions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "verified",
+                            "rejected",
+                            "deleted"
+                        ],
+                        "type": "string",
+                        "description": "Verification status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webmentions retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/webmention.Mention"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webmentions/send": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Notify the pages linked from public projects whose content changed since they were last notified, and pages no longer linked. Mentions are also sent automatically after projects change.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Send Webmentions",
+                "responses": {
+                    "200": {
+                        "description": "Webmentions sent successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/webmention.SendReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Site canonical URL is not set",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webmentions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a received Webmention, e.g. spam. Deleted mentions are accepted again if their source sends them again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete a Webmention",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webmention ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webmention deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webmention not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ai/match-jd": {
+            "post": {
+                "description": "Recognize the skills a pasted job description asks for, split them into the ones matching the portfolio's tech stacks and the missing ones, and suggest the projects and experiences to highlight when applying",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "Match a job description",
+                "parameters": [
+                    {
+                        "description": "Job Description",
+                        "name": "job",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/recruiter.JobDescriptionMatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job description matched successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/recruiter.PublicJobMatch"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/campaigns": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the page views, visitors and inquiries of every utm_source, utm_medium and utm_campaign combination within a time range, most visited first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get visits and inquiries by campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end (RFC3339 or YYYY-MM-DD), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Campaigns retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/analytics.CampaignStat"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/engagement": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve per project the visits to its case study, the time sections stayed on screen per visit and how often each section was reached, within a time range, most visited first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get the engagement report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end (RFC3339 or YYYY-MM-DD), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Engagement retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/analytics.ProjectEngagement"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record in one request the case study sections a visitor reached during a visit and how long each was on screen, typically sent with fetch and keepalive when the page is hidden, after solving a challenge from GET /challenge. Events are added to daily counters per project and section rather than stored one by one; events of unknown projects are dropped. Requests with DNT: 1 or Sec-GPC: 1 are not recorded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Record section engagement",
+                "parameters": [
+                    {
+                        "description": "Sections reached",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.EngagementBatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Engagement recorded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/events": {
+            "post": {
+                "description": "Record a page view or custom event with the campaign the visitor arrived with, read from the utm_ parameters of the path when not given. The visitor location is resolved from the client IP using a local GeoIP database and visitors are identified by a daily-rotating salted hash. Requests with DNT: 1 or Sec-GPC: 1 are not recorded. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Record an analytics event",
+                "parameters": [
+                    {
+                        "description": "Event Details",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.AnalyticsEventCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event recorded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/funnels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of funnels, by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "List funnels",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnels retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/analytics.Funnel"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define the steps visitors go through on their way to converting, e.g. viewing a project, then the contact page, then submitting the form. Page view steps match a path, ending in /* to match every path below it; custom event steps match an event name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Create a funnel",
+                "parameters": [
+                    {
+                        "description": "Funnel Details",
+                        "name": "funnel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.FunnelCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnel created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/analytics.Funnel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/funnels/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a funnel by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get a funnel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Funnel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnel retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/analytics.Funnel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Funnel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the name, description or steps of a funnel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Update a funnel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Funnel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Funnel Update Details",
+                        "name": "funnel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.FunnelUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnel updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/analytics.Funnel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Funnel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a funnel; the events it counted are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Delete a funnel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Funnel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnel deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Funnel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/funnels/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Count the visitors reaching each step of a funnel in order within a time range of at most 92 days, with the share of the previous step and of the first step reaching it. Visitor IDs rotate daily, so a visitor is followed through the steps they took on one day.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get a funnel report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Funnel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end (RFC3339 or YYYY-MM-DD), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Funnel report retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/analytics.FunnelReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Funnel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/geo": {
+            "get": {
+                "description": "Retrieve the number of visits per country, or per country and region, within a time range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get visitors by location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end (RFC3339 or YYYY-MM-DD), defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "country",
+                            "region"
+                        ],
+                        "type": "string",
+                        "default": "country",
+                        "description": "Aggregation level",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Visitor locations retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/analytics.GeoStat"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets": {
+            "get": {
+                "description": "Retrieve the uploaded assets with their fingerprinted paths and content hashes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "List assets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only include logical paths starting with this prefix",
+                        "name": "prefix",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Assets retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/asset.Asset"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/manifest": {
+            "get": {
+                "description": "Retrieve a map of logical asset paths to the URLs of their current content-fingerprinted files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Get the asset manifest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only include logical paths starting with this prefix",
+                        "name": "prefix",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Asset manifest retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/challenge": {
+            "get": {
+                "description": "Issue a signed challenge that must be solved before calling protected public write endpoints. Find a solution whose sha256(challenge + \":\" + solution) has the required number of leading zero bits, then send the challenge and solution in the X-Challenge and X-Challenge-Solution headers.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Issue a proof-of-work challenge",
+                "responses": {
+                    "200": {
+                        "description": "Challenge issued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/middleware.Challenge"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/changelog": {
+            "get": {
+                "description": "Retrieve a paginated changelog, newest first. Entries are recorded automatically when content changes and can also be written by hand.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Changelog"
+                ],
+                "summary": "Get the changelog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by entity (project, experience, tech_stack)",
+                        "name": "entity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changelog retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/changelog.Entry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/chat": {
+            "post": {
+                "description": "Answer a visitor's question about the projects and experience in the portfolio, citing the content used. Questions unrelated to the portfolio are declined. Pass the returned session_id to ask follow-up questions; conversations expire when idle.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat"
+                ],
+                "summary": "Ask the portfolio chatbot",
+                "parameters": [
+                    {
+                        "description": "Question",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/chat.MessageCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Question answered successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/chat.Reply"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conversation not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The previous question is still being answered",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Chat is not configured or unreachable",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/chat/{session_id}": {
+            "delete": {
+                "description": "Forget the questions and answers of a conversation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat"
+                ],
+                "summary": "End a chatbot conversation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conversation ended successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conversation not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/coding-activity": {
+            "get": {
+                "description": "Retrieve total and daily average hours coded over the last days with the time spent per language",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coding Activity"
+                ],
+                "summary": "Get coding activity summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Number of days including today (1-365)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Coding activity summary retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/coding_activity.Summary"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/coding-activity/weekly": {
+            "get": {
+                "description": "Retrieve the hours coded in each of the last weeks, oldest first. Weeks start on Monday and the current week is included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coding Activity"
+                ],
+                "summary": "Get weekly coding activity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 12,
+                        "description": "Number of weeks (1-52)",
+                        "name": "weeks",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Weekly coding activity retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/coding_activity.WeeklyActivity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/collections": {
+            "get": {
+                "description": "Retrieve a paginated list of project series, by title",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "List collections",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in titles",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collections retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/collection.PublicCollection"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/collections/{slug}": {
+            "get": {
+                "description": "Retrieve a project series by its slug, with its public projects in reading order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collections"
+                ],
+                "summary": "Get a collection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collection retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/collection.PublicCollectionDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/command-index": {
+            "get": {
+                "description": "Retrieve the titles, slugs, types and routes of every public project, experience, tech stack and published page. The index is generated again after content changes. Send the ETag back in If-None-Match to get 304 Not Modified while it is unchanged.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Get the command palette index",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of the index the client has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Command index retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/command_index.Index"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "304": {
+                        "description": "The index is unchanged"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies": {
+            "get": {
+                "description": "Retrieve a paginated list of companies with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "List companies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by industry",
+                        "name": "industry",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Companies retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/company.PublicCompany"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new company with optional logo upload",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Create a new company",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Company Logo",
+                        "name": "logo",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Company Logo for light backgrounds, generated from the logo when omitted",
+                        "name": "logo_light",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Company Logo for dark backgrounds, generated from the logo when omitted",
+                        "name": "logo_dark",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Company Details in JSON format (See CompanyCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/company.Company"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Company already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}": {
+            "get": {
+                "description": "Retrieve a specific company using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Get a company by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/company.PublicCompany"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing company with new details and optional logo. The logo is shared by every experience at the company.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Update a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Company Logo",
+                        "name": "logo",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Company Logo for light backgrounds, generated from the logo when omitted",
+                        "name": "logo_light",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Company Logo for dark backgrounds, generated from the logo when omitted",
+                        "name": "logo_dark",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Company Update Details in JSON format (See CompanyUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/company.Company"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Company already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a company. Its experiences are kept and lose the company reference.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Delete a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/experiences": {
+            "get": {
+                "description": "Retrieve a paginated list of the experiences linked to a company",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "List experiences at a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company experiences retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.PublicExperience"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/stream": {
+            "get": {
+                "description": "Stream the content activity events of the current tenant using Server-Sent Events. Supports resuming via the Last-Event-ID header or last_event_id query parameter. Clients that cannot set the Authorization header, such as EventSource, may send the access token in the access_token query parameter instead, if it expires within EVENTS_QUERY_TOKEN_MAX_LIFETIME_SECONDS.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Stream activity events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last event received",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of event types to receive",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "$ref": "#/definitions/events.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many connections",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rates": {
+            "get": {
+                "description": "Retrieve the exchange rates used to convert offering prices, quoted against the base currency",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "List exchange rates",
+                "responses": {
+                    "200": {
+                        "description": "Exchange rates retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/offering.ExchangeRate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences": {
+            "get": {
+                "description": "Retrieve a paginated list of experiences with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "List experiences",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by company name",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by featured status",
+                        "name": "is_featured",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiences retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.PublicExperience"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new experience with logo and images",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Create a new experience",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Logo Image",
+                        "name": "logo_image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Experience Images",
+                        "name": "images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience Details in JSON format (See ExperienceCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experience created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.Experience"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.ExperienceCreate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Probable duplicate of an existing experience",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences/bulk": {
+            "put": {
+                "description": "Bulk update experiences with logo and images",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Logo Image",
+                        "name": "logo_image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Experience Images",
+                        "name": "images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience Details in JSON array format (See ExperienceUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiences updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.Experience"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Experiences processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.ExperienceUpdate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create multiple experiences in a single request",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Bulk create experiences",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Logo Image",
+                        "name": "logo_image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Experience Images",
+                        "name": "images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience Details in JSON array format (See ExperienceCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiences created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.Experience"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Experiences processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.ExperienceCreate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete multiple experiences in a single request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Bulk delete experiences",
+                "parameters": [
+                    {
+                        "description": "Experience IDs to delete",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiences deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Experiences processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences/search": {
+            "get": {
+                "description": "Perform a full-text search on experiences with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Search experiences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experiences search completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experience.PublicExperience"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences/summary": {
+            "get": {
+                "description": "Retrieve the total time worked along with the time spent at every company and with every tech stack, counting overlapping roles once and ongoing roles until today",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Get the experience summary",
+                "responses": {
+                    "200": {
+                        "description": "Experience summary retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.Summary"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences/timeline": {
+            "get": {
+                "description": "Retrieve experiences grouped by company with computed durations, overlapping roles and gaps between roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Get the experience timeline",
+                "responses": {
+                    "200": {
+                        "description": "Experience timeline retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.Timeline"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiences/{id}": {
+            "get": {
+                "description": "Retrieve a specific experience using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Get an experience by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experience retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.PublicExperience"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing experience with new details and optional files",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Update an experience",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Logo Image",
+                        "name": "logo_image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Experience Images",
+                        "name": "images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Experience Details in JSON format (See ExperienceUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experience updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.Experience"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/experience.ExperienceUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an experience by its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiences"
+                ],
+                "summary": "Delete an experience",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Experience deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/experiments/assignments": {
+            "get": {
+                "description": "Retrieve the variant of every active experiment shown to the visitor and record that they saw it. Visitors are assigned by hashing the X-Visitor-ID header, or their address and browser without it, so they keep their variants across visits.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Experiments"
+                ],
+                "summary": "Get the variants of a visitor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Random ID the frontend keeps for the visitor",
+                        "name": "X-Visitor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Variants retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/experiment.Assignment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Performs a health check on the service and its dependencies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Check Health Status",
+                "responses": {
+                    "200": {
+                        "description": "Service is healthy",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthStatus"
+                        }
+                    },
+                    "206": {
+                        "description": "Service is degraded",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthStatus"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthStatus"
+                        }
+                    },
+                    "503": {
+                        "description": "Service is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/img/{path}": {
+            "get": {
+                "description": "Fetch an image from storage, resize it to fit within the requested width and height without upscaling, and transcode it. Transformed variants are cached and served with long-lived cache headers.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Get a transformed image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Storage path of the image",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum width in pixels",
+                        "name": "w",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum height in pixels",
+                        "name": "h",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "jpeg",
+                            "png"
+                        ],
+                        "type": "string",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "JPEG quality (1-100)",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transformed image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/indieauth/auth": {
+            "get": {
+                "description": "Validate an authorization request from a client and redirect the browser to the consent page of the site, where the signed-in owner approves it",
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "IndieAuth authorization endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Must be code",
+                        "name": "response_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client URL",
+                        "name": "client_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Redirect URI on the client host",
+                        "name": "redirect_uri",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client state",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PKCE code challenge",
+                        "name": "code_challenge",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Must be S256",
+                        "name": "code_challenge_method",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Space-separated scopes",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Profile URL hint",
+                        "name": "me",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the consent page"
+                    },
+                    "400": {
+                        "description": "Invalid authorization request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "IndieAuth is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Confirm the owner's profile URL to a client signing the owner in. The code is checked against the client ID, redirect URI and PKCE verifier and can be redeemed once.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "Redeem an authorization code for the profile URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "https://quill.p3k.io/",
+                        "name": "client_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h",
+                        "name": "code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 128,
+                        "minLength": 43,
+                        "type": "string",
+                        "example": "a6128783714cfda1d388e2e98b6ae8221ac31aca31959e59512c59f5",
+                        "name": "code_verifier",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "authorization_code",
+                        "name": "grant_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "https://quill.p3k.io/auth/callback",
+                        "name": "redirect_uri",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile URL",
+                        "schema": {
+                            "$ref": "#/definitions/indieauth.Profile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization code is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/indieauth/metadata": {
+            "get": {
+                "description": "Retrieve the IndieAuth endpoints. The site links this endpoint with rel=\"indieauth-metadata\" so that IndieWeb tools can discover it from the site URL.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "IndieAuth server metadata",
+                "responses": {
+                    "200": {
+                        "description": "Server metadata",
+                        "schema": {
+                            "$ref": "#/definitions/indieauth.Metadata"
+                        }
+                    },
+                    "500": {
+                        "description": "IndieAuth is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/indieauth/revoke": {
+            "post": {
+                "description": "Revoke an access token, as clients do when signing out. Unknown tokens are accepted as well.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "Revoke an access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token revoked"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/indieauth/token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Describe the access token sent as a bearer token, for resource servers checking the tokens presented to them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "Verify an access token",
+                "responses": {
+                    "200": {
+                        "description": "Token details",
+                        "schema": {
+                            "$ref": "#/definitions/indieauth.TokenInfo"
+                        }
+                    },
+                    "401": {
+                        "description": "Access token is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Issue an access token for a code approved with a scope. The code is checked against the client ID, redirect URI and PKCE verifier and can be redeemed once.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IndieAuth"
+                ],
+                "summary": "Redeem an authorization code for an access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "https://quill.p3k.io/",
+                        "name": "client_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h",
+                        "name": "code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 128,
+                        "minLength": 43,
+                        "type": "string",
+                        "example": "a6128783714cfda1d388e2e98b6ae8221ac31aca31959e59512c59f5",
+                        "name": "code_verifier",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "authorization_code",
+                        "name": "grant_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "https://quill.p3k.io/auth/callback",
+                        "name": "redirect_uri",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Access token",
+                        "schema": {
+                            "$ref": "#/definitions/indieauth.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization code is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inquiries": {
+            "post": {
+                "description": "Send a project inquiry, which starts in the \"new\" stage of the pipeline. It counts as a conversion in the experiments the visitor was shown variants of. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers. Submissions the spam filter flags are kept in the \"spam\" stage instead, answered the same way.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Submit an inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Random ID the frontend keeps for the visitor",
+                        "name": "X-Visitor-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Inquiry Details",
+                        "name": "inquiry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/inquiry.InquiryCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiry submitted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.Inquiry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many inquiries",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the status, progress and result of a background job, such as a screenshot capture",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Get a background job by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/jobs.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mail/deliveries": {
+            "get": {
+                "description": "Retrieve the email delivery log with optional status filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mail"
+                ],
+                "summary": "List email deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, sent, failed)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mail deliveries retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/mail.MailDelivery"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mail/deliveries/{id}": {
+            "get": {
+                "description": "Retrieve a specific email delivery including its rendered content",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mail"
+                ],
+                "summary": "Get an email delivery by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mail Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mail delivery retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mail.MailDelivery"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Mail delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mail/deliveries/{id}/retry": {
+            "post": {
+                "description": "Requeue a pending or failed email delivery with a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mail"
+                ],
+                "summary": "Retry an email delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mail Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mail delivery requeued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/mail.MailDelivery"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Mail delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Mail delivery was already sent",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/channels": {
+            "get": {
+                "description": "Retrieve a paginated list of notification channels with optional type filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List notification channels",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by channel type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification channels retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/notification.NotificationChannel"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create an email, Telegram, Discord or Slack channel with the rules routing events to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Create a notification channel",
+                "parameters": [
+                    {
+                        "description": "Notification Channel Details",
+                        "name": "channel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.ChannelCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification channel created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.NotificationChannel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.ChannelCreate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/channels/{id}": {
+            "get": {
+                "description": "Retrieve a notification channel with its secrets masked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get a notification channel by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification channel retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.NotificationChannel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification channel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a notification channel; masked secret values are kept unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update a notification channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notification Channel Update Details",
+                        "name": "channel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.ChannelUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification channel updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.NotificationChannel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.ChannelUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Notification channel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a notification channel by its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Delete a notification channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification channel deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification channel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/channels/{id}/test": {
+            "post": {
+                "description": "Send a test notification to a channel and return the resulting log entry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Test a notification channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Test notification processed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/notification.NotificationLog"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification channel not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/logs": {
+            "get": {
+                "description": "Retrieve the notification delivery log with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List notification logs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (sent, failed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by event",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification logs retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/notification.NotificationLog"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/now": {
+            "get": {
+                "description": "Retrieve what I'm currently working on, reading and learning",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now"
+                ],
+                "summary": "Get the now page",
+                "responses": {
+                    "200": {
+                        "description": "Now entry retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/now.Entry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "No now entry has been published yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/now-playing": {
+            "get": {
+                "description": "Retrieve the track currently playing on Spotify, or the last played one when nothing is playing. Refreshed at most every 30 seconds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now Playing"
+                ],
+                "summary": "Get now playing",
+                "responses": {
+                    "200": {
+                        "description": "Now playing retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/now_playing.NowPlaying"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Spotify is not configured or unreachable",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/now/history": {
+            "get": {
+                "description": "Retrieve a paginated list of current and previous now entries, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Now"
+                ],
+                "summary": "Get the now page history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Now history retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/now.Entry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oembed": {
+            "get": {
+                "description": "Describe the public project published at a URL of the site as an oEmbed link with its title, description and link preview image, so editors and chat apps render the URL as a card. Only the json format is served. The thumbnail is left out when it exceeds maxwidth or maxheight.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Embeds"
+                ],
+                "summary": "Get the oEmbed description of a project page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "URL of a project page on the site",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum width of the thumbnail",
+                        "name": "maxwidth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum height of the thumbnail",
+                        "name": "maxheight",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "oEmbed response",
+                        "schema": {
+                            "$ref": "#/definitions/embed.OEmbed"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No public project is published at this URL",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "The site has no canonical URL to embed projects from",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/offerings": {
+            "get": {
+                "description": "Retrieve a paginated list of active offerings with prices in the requested currency. Without ?currency= the currency is taken from the Accept-Language region, falling back to each offering's first price.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "List offerings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "EUR",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offerings retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/offering.OfferingDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a service offering with price ranges in one or more currencies. The first price is converted for other currencies.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "Create an offering",
+                "parameters": [
+                    {
+                        "description": "Offering Details",
+                        "name": "offering",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/offering.OfferingCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offering created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/offering.Offering"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/offerings/{id}": {
+            "get": {
+                "description": "Retrieve an offering with its price in the requested currency. Without ?currency= the currency is taken from the Accept-Language region, falling back to the offering's first price.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "Get an offering by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offering ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "EUR",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offering retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/offering.OfferingDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Offering not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an offering. Omitted prices and features are kept; an empty list clears them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "Update an offering",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offering ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Offering Update Details",
+                        "name": "offering",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/offering.OfferingUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offering updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/offering.Offering"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Offering not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a service offering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Offerings"
+                ],
+                "summary": "Delete an offering",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offering ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offering deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Offering not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/og/projects/{slug}": {
+            "get": {
+                "description": "Render a 1200x630 PNG card with the title, description and category of a public project, tinted with the dominant color of its thumbnail. Cards are cached until the project changes.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "Embeds"
+                ],
+                "summary": "Get the link preview image of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link preview image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/page-previews": {
+            "get": {
+                "description": "Retrieve the page a preview link was created for, including drafts, without signing in. Links stop working once they expire or are revoked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Get a page preview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Preview token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.PublicPage"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Preview link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages": {
+            "get": {
+                "description": "Retrieve a paginated list of published pages such as the privacy policy, terms and imprint, by slug",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "List pages",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pages retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/page.PublicPage"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/{slug}": {
+            "get": {
+                "description": "Retrieve a published page by its slug",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Get a page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/page.PublicPage"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/inquiries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the inquiries sent from the signed-in client's email address, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "List my inquiries",
+                "responses": {
+                    "200": {
+                        "description": "Inquiries retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/inquiry.Inquiry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/inquiries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an inquiry of the signed-in client with its proposals, including fresh download and acceptance links, and its payments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Get my inquiry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Inquiry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inquiry retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/portal.InquiryDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inquiry not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/login": {
+            "post": {
+                "description": "Email a single-use sign-in link to the address an inquiry was sent from. The response is the same whether or not the address is known.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Request a portal sign-in link",
+                "parameters": [
+                    {
+                        "description": "Client Email",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/portal.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sign-in link sent if the email is known",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/session": {
+            "post": {
+                "description": "Exchange the token of a sign-in link for a session token, sent as a bearer token to the other portal endpoints. Each link can be used once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Sign in to the portal",
+                "parameters": [
+                    {
+                        "description": "Sign-in Token",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/portal.SessionCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Signed in successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/portal.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Sign-in link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "End the current portal session",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Sign out of the portal",
+                "responses": {
+                    "200": {
+                        "description": "Signed out successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/recruiter": {
+            "get": {
+                "description": "Retrieve the projects, experiences and skills matching a role keyword, ranked by relevance, for generating resume pages tailored to an application. Roles such as backend, frontend, fullstack, devops, mobile, data, bot and design are expanded to related terms; other words are matched as given.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "Get a recruiter profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role keyword, e.g. backend or \\",
+                        "name": "role",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 20,
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Items per section",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recruiter profile retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/recruiter.PublicProfile"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "Retrieve a paginated list of projects with optional filtering. The admin route returns every field of the projects instead of their public view.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "List projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by project category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "public",
+                            "unlisted",
+                            "draft"
+                        ],
+                        "type": "string",
+                        "description": "Filter by visibility on the admin route",
+                        "name": "visibility",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.PublicProject"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new project with details and optional images",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Create a new project",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Project Images",
+                        "name": "uploaded_images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project Details in JSON format (See ProjectCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.Project"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ProjectCreate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Probable duplicate of an existing project",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/bulk": {
+            "put": {
+                "description": "Update multiple projects in a single request",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Bulk update projects",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Project Images",
+                        "name": "uploaded_images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project Update Details in JSON array format (See ProjectUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.Project"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Projects processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.ProjectUpdate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create multiple projects in a single request",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Bulk create projects",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Project Images",
+                        "name": "uploaded_images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project Details in JSON array format (See ProjectCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.Project"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Projects processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.ProjectCreate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete multiple projects in a single request. Deleting more projects than the configured threshold answers 428 with a confirmation_token in the metadata, and only a repeat of the request with the same IDs echoing the token back before it expires deletes them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Bulk delete projects",
+                "parameters": [
+                    {
+                        "description": "Project IDs to delete",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirmation token issued for the same IDs",
+                        "name": "X-Confirmation-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirmation token, for clients that cannot set headers",
+                        "name": "confirmation_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Projects processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "The deletion must be confirmed by another admin",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "The deletion must be confirmed",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/impact": {
+            "get": {
+                "description": "Aggregate the metrics of featured projects for the homepage impact section. Counts and currencies are summed, other units keep the highest value.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get the project impact summary",
+                "responses": {
+                    "200": {
+                        "description": "Impact summary retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ImpactSummary"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/search": {
+            "get": {
+                "description": "Perform a full-text search on projects with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Search projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects search completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/project.PublicProject"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "description": "Retrieve a project using its unique identifier. The admin route returns every field of the project instead of its public view.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a project by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.PublicProject"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing project with new details and optional images",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Update a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Project Images",
+                        "name": "uploaded_images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project Update Details in JSON format (See ProjectUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.Project"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ProjectUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a project by its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Delete a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/print": {
+            "get": {
+                "description": "Render the case study of a public project as a standalone HTML page laid out for printing or saving as PDF, with its overview, impact metrics, features, tech stack and links, without the frontend. Mermaid and PlantUML code blocks are rendered as diagram images when a diagram renderer is configured, and snippet code blocks as the highlighted code they link to.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get the printable case study of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Printable case study",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/related": {
+            "get": {
+                "description": "Retrieve public projects related to a project, ranked by the tech stacks and category they share and by how close they are in meaning, with newer projects ranked higher. Results are cached until projects change.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Related projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "type": "integer",
+                        "default": 3,
+                        "description": "Number of results",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Related projects retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/search.Result"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/screenshot": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a background job capturing a screenshot of the project's web URL and storing it as the project thumbnail. Poll GET /jobs/{id} for its progress.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Capture a project screenshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Project screenshot queued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/jobs.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/share": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sign an expiring link giving read access to a project, so that unlisted and draft case studies can be shared before they are published",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Create a project share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share Link Details",
+                        "name": "share",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/project.ShareLinkCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Share link created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ShareLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/shared": {
+            "get": {
+                "description": "Retrieve a project, including unlisted and draft projects, using a signed share link",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a shared project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.PublicProject"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Share link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/timeline": {
+            "get": {
+                "description": "Retrieve the design, build and launch phases of a project with their offsets and durations in days from the first phase, and their share of the total effort. Ongoing phases end today.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a project timeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project timeline retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/project.ProjectTimeline"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/webmentions": {
+            "get": {
+                "description": "Retrieve the verified Webmentions of a public project, newest first, for display on its page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webmentions"
+                ],
+                "summary": "List project Webmentions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webmentions retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/webmention.PublicMention"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{id}/accept": {
+            "get": {
+                "description": "Retrieve a proposal and its terms using the signed acceptance link emailed to the prospect, with a fresh download link",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Review a proposal before accepting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link expiry as a Unix timestamp",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposal retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.ProposalLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Proposal not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Accept a proposal and its terms using the signed acceptance link. The name typed, time, IP address and user agent are recorded and the inquiry moves to accepted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Accept a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link expiry as a Unix timestamp",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Acceptance Details",
+                        "name": "acceptance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/inquiry.ProposalAcceptance"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposal accepted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/inquiry.Proposal"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Proposal not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Proposal has already been accepted",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{id}/download": {
+            "get": {
+                "description": "Download a proposal PDF using the signed link emailed to the prospect",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Download a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link expiry as a Unix timestamp",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proposal PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Link is invalid or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Proposal not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/redirects/resolve": {
+            "get": {
+                "description": "Look up where a frontend path redirects to, for the edge middleware to answer old URLs with. A query string on the path is passed on to the target.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Resolve a redirect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "/blog/hello-world",
+                        "description": "Requested path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect resolved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/redirect.Resolution"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No redirect for this path",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/semantic": {
+            "get": {
+                "description": "Search public projects and experiences by meaning rather than exact words, e.g. \"payment integrations\" also finds a project built on Stripe. Results are ordered by similarity.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Semantic search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "project",
+                            "experience"
+                        ],
+                        "type": "string",
+                        "description": "Only return this kind of content",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of results",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/search.Result"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Semantic search is not configured or unreachable",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/site-config": {
+            "get": {
+                "description": "Retrieve theme colors, navigation, section toggles and SEO defaults of the current site",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site Config"
+                ],
+                "summary": "Get the site config",
+                "responses": {
+                    "200": {
+                        "description": "Site config retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/site_config.SiteConfig"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace theme colors, navigation, section toggles and SEO defaults of the current site",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site Config"
+                ],
+                "summary": "Update the site config",
+                "parameters": [
+                    {
+                        "description": "Site Config",
+                        "name": "siteConfig",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/site_config.SiteConfigUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Site config updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/site_config.SiteConfig"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/site_config.SiteConfigUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Restore the default theme, navigation, section toggles and SEO defaults of the current site",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site Config"
+                ],
+                "summary": "Reset the site config",
+                "responses": {
+                    "200": {
+                        "description": "Site config reset successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/site_config.SiteConfig"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/site-config/icons": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload the profile avatar and generate the favicon, app icons and web app manifest of the current site from it, stored under stable paths",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site Config"
+                ],
+                "summary": "Generate the site icons",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Profile avatar (JPEG, PNG or WebP)",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Site icons generated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/site_config.IconSet"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Retrieve the sitemap of the site in the sitemaps.org XML format, listing public projects and published pages. Projects and pages marked noindex are left out, and those with a canonical URL are listed at it.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "SEO"
+                ],
+                "summary": "Get the sitemap",
+                "responses": {
+                    "200": {
+                        "description": "Sitemap XML",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "The site has no canonical URL to build the sitemap from",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippets": {
+            "get": {
+                "description": "Fetch the code of a GitHub gist or of lines of a repository file, highlighted for display. Snippets are cached and refetched after a while, so pages do not run into GitHub rate limits.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get a code snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gist URL, optionally with a #file- anchor, or repository file URL, optionally with a #L10-L20 line range",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Snippet retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/case_study.Snippet"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid URL",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "GitHub could not be reached",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/profiles": {
+            "get": {
+                "description": "Retrieve the latest statistics read from GitHub, Stack Overflow, npm and the other configured profiles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get external profile stats",
+                "responses": {
+                    "200": {
+                        "description": "Profile stats retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/profile_stats.ProfileStat"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/public": {
+            "get": {
+                "description": "Retrieve the projects shipped, years of experience, technologies used and total page views, computed from live data at most once an hour",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get public stats",
+                "responses": {
+                    "200": {
+                        "description": "Public stats retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/public_stats.PublicStats"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks": {
+            "get": {
+                "description": "Retrieve a paginated list of tech stacks with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "List tech stacks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stacks retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.PublicTechStack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new tech stack with image upload",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Create a new tech stack",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image for light backgrounds, generated from the image when omitted",
+                        "name": "image_light",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image for dark backgrounds, generated from the image when omitted",
+                        "name": "image_dark",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tech Stack Details in JSON format (See TechStackCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tech_stack.TechStack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tech_stack.TechStackCreate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/bulk": {
+            "put": {
+                "description": "Update multiple tech stacks in a single request",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Bulk update tech stacks",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Tech Stack Images",
+                        "name": "images",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tech Stack Update Details in JSON array format (See TechStackUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stacks updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.TechStack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Tech stacks processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.TechStackUpdate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create multiple tech stacks in a single request",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Bulk create tech stacks",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Tech Stack Images",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tech Stack Details in JSON array format (See TechStackCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stacks created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.TechStack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Tech stacks processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.TechStackCreate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete multiple tech stacks in a single request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Bulk delete tech stacks",
+                "parameters": [
+                    {
+                        "description": "Tech Stack IDs to delete",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item",
+                        "name": "atomic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the links of projects and experiences to the tech stacks along with them, instead of refusing the delete",
+                        "name": "cascade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stacks deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Tech stacks processed, see each item for its outcome",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/base.BulkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/history": {
+            "get": {
+                "description": "Retrieve the proficiency level and years of experience of skills over time, one series per tech stack, for skill growth charts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Get skill proficiency history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tech stack IDs, defaults to every rated tech stack",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include changes since this time (RFC3339 or YYYY-MM-DD)",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proficiency history retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.ProficiencySeries"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/orphans": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the links of projects and experiences to tech stacks that no longer exist",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Clean up orphaned tech stack links",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Report the orphaned links without removing them",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orphaned tech stack links removed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.Reference"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/{id}": {
+            "get": {
+                "description": "Retrieve a specific tech stack using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Get a tech stack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tech Stack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tech_stack.PublicTechStack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tech stack not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing tech stack with new details and optional image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Update a tech stack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tech Stack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image for light backgrounds, generated from the image when omitted",
+                        "name": "image_light",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Tech Stack Image for dark backgrounds, generated from the image when omitted",
+                        "name": "image_dark",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tech Stack Update Details in JSON format (See TechStackUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tech_stack.TechStack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tech_stack.TechStackUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Tech stack not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tech stack by its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "Delete a tech stack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tech Stack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report the changes without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the links of projects and experiences to the tech stack along with it, instead of refusing the delete",
+                        "name": "cascade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tech stack not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tech stack is still linked to other content",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/{id}/endorsements": {
+            "post": {
+                "description": "Endorse a skill. Each visitor can endorse a tech stack once and requests are rate limited per IP. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers. Endorsements whose name the spam filter flags are hidden from public counts, answered the same way.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endorsements"
+                ],
+                "summary": "Endorse a tech stack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tech Stack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Endorsement Details",
+                        "name": "endorsement",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/endorsement.EndorsementCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack endorsed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endorsement.Endorsement"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tech stack not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tech stack already endorsed",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tech-stacks/{id}/references": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the projects and experiences linking to a tech stack, which keep it from being deleted without cascade",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tech Stacks"
+                ],
+                "summary": "List tech stack references",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tech Stack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tech stack references retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tech_stack.Reference"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants": {
+            "get": {
+                "description": "Retrieve a paginated list of tenants",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "List tenants",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by slug or name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tenants retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tenant.Tenant"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new portfolio tenant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Create a new tenant",
+                "parameters": [
+                    {
+                        "description": "Tenant Details",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tenant created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.Tenant"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.TenantCreate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Tenant slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/current": {
+            "get": {
+                "description": "Retrieve the tenant resolved from the request host or tenant header",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Get the current tenant",
+                "responses": {
+                    "200": {
+                        "description": "Tenant retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.Tenant"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}": {
+            "get": {
+                "description": "Retrieve a specific tenant using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Get a tenant by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tenant retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.Tenant"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing tenant's name, storage folder or status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Update a tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tenant Update Details",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tenant updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.Tenant"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.TenantUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tenant and all of its content",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Delete a tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tenant deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The default tenant cannot be deleted",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/domains": {
+            "get": {
+                "description": "Retrieve the custom domains registered for a tenant",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "List custom domains",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domains retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tenant.TenantDomain"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a custom domain for a tenant and return the DNS TXT challenge proving ownership",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Register a custom domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Domain Details",
+                        "name": "domain",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantDomainCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domain registered successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.DomainChallenge"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Domain is already registered",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/domains/{domainId}": {
+            "delete": {
+                "description": "Remove a custom domain from a tenant",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Remove a custom domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Domain ID",
+                        "name": "domainId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domain removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/domains/{domainId}/verify": {
+            "post": {
+                "description": "Look up the DNS TXT challenge record and mark the domain as verified when it matches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenants"
+                ],
+                "summary": "Verify a custom domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Domain ID",
+                        "name": "domainId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domain verified successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tenant.TenantDomain"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "DNS TXT record does not contain the verification token",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses": {
+            "get": {
+                "description": "Retrieve the hardware, software and tools I use, grouped by category in page order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Get the uses page",
+                "responses": {
+                    "200": {
+                        "description": "Uses page retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/uses.Category"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/uses/items": {
+            "get": {
+                "description": "Retrieve a paginated list of uses items in page order with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "List uses items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses items retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/uses.Item"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add an item to the end of the uses page with an optional image upload",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Create a uses item",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Item Image",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item Details in JSON format (See UsesItemCreate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses item created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/uses.Item"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses/items/{id}": {
+            "get": {
+                "description": "Retrieve a specific uses item using its unique identifier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Get a uses item by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Uses Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses item retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/uses.Item"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing uses item with new details and optional image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Update a uses item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Uses Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Item Image",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item Update Details in JSON format (See UsesItemUpdate Model)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses item updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/uses.Item"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an item from the uses page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Delete a uses item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Uses Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses item deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move the listed items to the top of the uses page in the given order. Items left out keep their relative order after them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Reorder the uses page",
+                "parameters": [
+                    {
+                        "description": "New Item Order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/uses.ItemOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses page reordered successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/uses.Category"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/stripe": {
+            "post": {
+                "description": "Endpoint for Stripe webhook events. The Stripe-Signature header is verified against the webhook secret before completed, failed and expired checkouts are recorded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inquiries"
+                ],
+                "summary": "Receive Stripe webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stripe webhook signature",
+                        "name": "Stripe-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook received",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webmention": {
+            "post": {
+                "description": "Webmention endpoint for project pages. The mention is accepted right away and displayed once its source has been fetched and found to link to the target. Sending the same mention again re-verifies it, removing it when the source no longer links to the target. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers.",
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webmentions"
+                ],
+                "summary": "Receive a Webmention",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "URL of the page mentioning the target",
+                        "name": "source",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL of the mentioned project page",
+                        "name": "target",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Webmention accepted for verification",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/webmention.MentionReceipt"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid source or target does not accept Webmentions",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "accessibility.Audited": {
+            "description": "Content checked by an accessibility audit",
+            "type": "object",
+            "properties": {
+                "experiences": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "accessibility.Issue": {
+            "description": "Accessibility problem found in content",
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "entity_name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "entity_type": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "images"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "image has no alt text"
+                },
+                "rule": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/accessibility.Rule"
+                        }
+                    ],
+                    "example": "missing_alt_text"
+                },
+                "severity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/accessibility.Severity"
+                        }
+                    ],
+                    "example": "error"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "https://example.com/screenshot.png"
+                }
+            }
+        },
+        "accessibility.Report": {
+            "description": "Accessibility issues found in content, with their number per rule",
+            "type": "object",
+            "properties": {
+                "audited": {
+                    "description": "Audited and CheckedAt are only set on reports of a full audit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/accessibility.Audited"
+                        }
+                    ]
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/accessibility.Issue"
+                    }
+                },
+                "passed": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "accessibility.Rule": {
+            "type": "string",
+            "enum": [
+                "missing_alt_text",
+                "low_contrast",
+                "top_level_heading",
+                "skipped_heading_level"
+            ],
+            "x-enum-varnames": [
+                "RuleMissingAltText",
+                "RuleLowContrast",
+                "RuleTopLevelHeading",
+                "RuleSkippedHeadingLevel"
+            ]
+        },
+        "accessibility.Severity": {
+            "type": "string",
+            "enum": [
+                "error",
+                "warning"
+            ],
+            "x-enum-varnames": [
+                "SeverityError",
+                "SeverityWarning"
+            ]
+        },
+        "activitypub.Actor": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "endpoints": {
+                    "$ref": "#/definitions/activitypub.Endpoints"
+                },
+                "followers": {
+                    "type": "string"
+                },
+                "icon": {
+                    "$ref": "#/definitions/activitypub.Image"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inbox": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "outbox": {
+                    "type": "string"
+                },
+                "preferredUsername": {
+                    "type": "string"
+                },
+                "publicKey": {
+                    "$ref": "#/definitions/activitypub.PublicKey"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Endpoints": {
+            "type": "object",
+            "properties": {
+                "sharedInbox": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Follower": {
+            "description": "Fediverse account following the portfolio",
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string",
+                    "example": "https://mastodon.social/users/jane"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "followed_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "inbox": {
+                    "type": "string",
+                    "example": "https://mastodon.social/users/jane/inbox"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "shared_inbox": {
+                    "type": "string",
+                    "example": "https://mastodon.social/inbox"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://mastodon.social/@jane"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "jane@mastodon.social"
+                }
+            }
+        },
+        "activitypub.Image": {
+            "type": "object",
+            "properties": {
+                "mediaType": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Note": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "attachment": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/activitypub.Image"
+                    }
+                },
+                "attributedTo": {
+                    "type": "string"
+                },
+                "cc": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.OrderedCollection": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "first": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "orderedItems": {
+                    "type": "array",
+                    "items": {}
+                },
+                "partOf": {
+                    "type": "string"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.PublicKey": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "publicKeyPem": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.PublishReport": {
+            "description": "Summary of a run of announcing new public projects to followers",
+            "type": "object",
+            "properties": {
+                "delivered": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "published": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "activitypub.WebFinger": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/activitypub.WebFingerLink"
+                    }
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.WebFingerLink": {
+            "type": "object",
+            "properties": {
+                "href": {
+                    "type": "string"
+                },
+                "rel": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin_session.FlagUpdate": {
+            "type": "object",
+            "properties": {
+                "flagged": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Not me, signed in from an unknown device"
+                }
+            }
+        },
+        "admin_session.Session": {
+            "description": "Admin sign-in tracked from the requests made with its tokens",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "flag_reason": {
+                    "type": "string",
+                    "example": "IP address changed from 203.0.113.7 to 198.51.100.23 during the session"
+                },
+                "flagged": {
+                    "description": "Flagged sessions are suspected of being used by someone else than\nthe admin, either automatically or by an admin",
+                    "type": "boolean",
+                    "example": false
+                },
+                "flagged_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "ip_address": {
+                    "description": "IPAddress and UserAgent are those of the first request of the\nsession, LastIPAddress and LastUserAgent those of the latest",
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "last_ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "last_user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4)"
+                },
+                "request_count": {
+                    "description": "RequestCount is updated at most once a minute",
+                    "type": "integer",
+                    "example": 42
+                },
+                "session_key": {
+                    "type": "string",
+                    "example": "8f1c2b3a-4d5e-6f70-8192-a3b4c5d6e7f8"
+                },
+                "signed_in_at": {
+                    "description": "SignedInAt is when the earliest token seen of the session was issued",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4)"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "3c9e6a1f-2b4d-4e8a-9f7c-1d2e3f4a5b6c"
+                }
+            }
+        },
+        "analytics.AnalyticsEventCreate": {
+            "type": "object",
+            "required": [
+                "path",
+                "type"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "cta_click"
+                },
+                "path": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/projects/portfolio-website"
+                },
+                "referrer": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://www.google.com/"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/analytics.EventType"
+                        }
+                    ],
+                    "example": "pageview"
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "launch-2026"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "newsletter"
+                }
+            }
+        },
+        "analytics.CampaignStat": {
+            "description": "Visits and inquiries of a campaign",
+            "type": "object",
+            "properties": {
+                "conversion_rate": {
+                    "description": "ConversionRate is conversions per visitor",
+                    "type": "number",
+                    "example": 0.0202
+                },
+                "conversions": {
+                    "description": "Conversions counts the inquiries submitted with the campaign",
+                    "type": "integer",
+                    "example": 4
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "launch-2026"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "newsletter"
+                },
+                "visitors": {
+                    "description": "Visitors counts the distinct visitors of those page views, per day",
+                    "type": "integer",
+                    "example": 198
+                },
+                "visits": {
+                    "description": "Visits counts the page views that arrived with the campaign",
+                    "type": "integer",
+                    "example": 240
+                }
+            }
+        },
+        "analytics.EngagementBatch": {
+            "type": "object",
+            "required": [
+                "events"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/analytics.EngagementEvent"
+                    }
+                }
+            }
+        },
+        "analytics.EngagementEvent": {
+            "description": "Section of a case study reached by a visitor",
+            "type": "object",
+            "required": [
+                "project_id",
+                "section"
+            ],
+            "properties": {
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "section": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "results"
+                },
+                "visible_ms": {
+                    "description": "VisibleMs is how long the section was on screen, in milliseconds",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 12500
+                }
+            }
+        },
+        "analytics.EventType": {
+            "description": "Kind of analytics event",
+            "type": "string",
+            "enum": [
+                "pageview",
+                "event"
+            ],
+            "x-enum-varnames": [
+                "EventPageView",
+                "EventCustom"
+            ]
+        },
+        "analytics.Funnel": {
+            "description": "Named sequence of steps visitors go through",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Whether case studies lead to inquiries"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Case study to inquiry"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.FunnelStep"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "analytics.FunnelCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "steps"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Whether case studies lead to inquiries"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Case study to inquiry"
+                },
+                "steps": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/analytics.FunnelStep"
+                    }
+                }
+            }
+        },
+        "analytics.FunnelReport": {
+            "description": "Visitors reaching each step of a funnel within a time range",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "funnel_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Case study to inquiry"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.FunnelStepResult"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "analytics.FunnelStep": {
+            "description": "Event visitors go through on their way to converting",
+            "type": "object",
+            "properties": {
+                "event_name": {
+                    "type": "string",
+                    "example": "contact_submit"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "View a project"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/projects/*"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/analytics.EventType"
+                        }
+                    ],
+                    "example": "pageview"
+                }
+            }
+        },
+        "analytics.FunnelStepResult": {
+            "description": "Visitors who reached a step of a funnel",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "View a project"
+                },
+                "overall_rate": {
+                    "description": "OverallRate is the share of the visitors of the first step who\nreached this one",
+                    "type": "number",
+                    "example": 0.05
+                },
+                "step_rate": {
+                    "description": "StepRate is the share of the visitors of the previous step who\nreached this one, 1 for the first step",
+                    "type": "number",
+                    "example": 0.25
+                },
+                "visitors": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "analytics.FunnelUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Whether case studies lead to inquiries"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Case study to inquiry"
+                },
+                "steps": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/analytics.FunnelStep"
+                    }
+                }
+            }
+        },
+        "analytics.GeoStat": {
+            "description": "Number of visits from a country or region",
+            "type": "object",
+            "properties": {
+                "country_code": {
+                    "type": "string",
+                    "example": "ID"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "West Java"
+                },
+                "visits": {
+                    "type": "integer",
+                    "example": 128
+                }
+            }
+        },
+        "analytics.ProjectEngagement": {
+            "description": "How visitors read the case study of a project",
+            "type": "object",
+            "properties": {
+                "avg_time_ms": {
+                    "description": "AvgTimeMs is the time sections stayed on screen per visit, in\nmilliseconds",
+                    "type": "integer",
+                    "example": 48200
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.SectionEngagement"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "visits": {
+                    "description": "Visits is the number of times the most reached section was reached",
+                    "type": "integer",
+                    "example": 135
+                }
+            }
+        },
+        "analytics.SectionEngagement": {
+            "description": "How often a section of a case study was reached and read",
+            "type": "object",
+            "properties": {
+                "avg_visible_ms": {
+                    "description": "AvgVisibleMs is how long the section stayed on screen per visit\nreaching it, in milliseconds",
+                    "type": "integer",
+                    "example": 9800
+                },
+                "reach_rate": {
+                    "description": "ReachRate is the share of the visits reaching the section",
+                    "type": "number",
+                    "example": 0.62
+                },
+                "reached": {
+                    "type": "integer",
+                    "example": 84
+                },
+                "section": {
+                    "type": "string",
+                    "example": "results"
+                }
+            }
+        },
+        "asset.Asset": {
+            "description": "Uploaded file whose stored path carries a hash of its content",
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "image/png"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dominant_color": {
+                    "description": "DominantColor is the most common color of an image as #rrggbb",
+                    "type": "string",
+                    "example": "#1e293b"
+                },
+                "hash": {
+                    "type": "string",
+                    "example": "3f2a9c1b7e4d8a60"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "logical_path": {
+                    "description": "LogicalPath is the stable path callers upload to",
+                    "type": "string",
+                    "example": "images/project/550e8400-e29b-41d4-a716-446655440000/0.png"
+                },
+                "path": {
+                    "description": "Path is the stored path including the content hash",
+                    "type": "string",
+                    "example": "itsrama/images/project/550e8400-e29b-41d4-a716-446655440000/0.3f2a9c1b7e4d8a60.png"
+                },
+                "placeholder": {
+                    "description": "Placeholder is a tiny copy of an image as a data URI, shown while the\nimage loads",
+                    "type": "string",
+                    "example": "data:image/png;base64,iVBORw0KGgo="
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 102400
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://project.supabase.co/storage/v1/object/public/bucket/itsrama/images/project/550e8400-e29b-41d4-a716-446655440000/0.3f2a9c1b7e4d8a60.png"
+                }
+            }
+        },
+        "asset.LogoPreference": {
+            "type": "string",
+            "enum": [
+                "auto",
+                "light",
+                "dark"
+            ],
+            "x-enum-varnames": [
+                "LogoAuto",
+                "LogoLight",
+                "LogoDark"
+            ]
+        },
+        "base.BulkItemResult": {
+            "description": "Outcome of one item of a non-atomic bulk request",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "NOT_FOUND: Project not found"
+                },
+                "error_code": {
+                    "type": "string",
+                    "example": "NOT_FOUND"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                }
+            }
+        },
+        "base.BulkResult": {
+            "description": "Per-item outcome of a non-atomic bulk request",
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/base.BulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "base.DuplicateGroup": {
+            "description": "Existing records that probably duplicate each other",
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/base.DuplicateMatch"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "same title"
+                }
+            }
+        },
+        "base.DuplicateMatch": {
+            "description": "Existing record that probably duplicates another",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "same title"
+                }
+            }
+        },
+        "base.SEO": {
+            "description": "Search engine directives of an entity",
+            "type": "object",
+            "properties": {
+                "canonical_url": {
+                    "description": "CanonicalURL is the preferred URL of the page, when it differs from\nthe one it is served at",
+                    "type": "string",
+                    "example": "https://itsrama.dev/projects/portfolio-website"
+                },
+                "meta_description": {
+                    "description": "MetaDescription replaces the description search engines show",
+                    "type": "string",
+                    "example": "How I built my portfolio with Go and Next.js"
+                },
+                "noindex": {
+                    "description": "NoIndex asks search engines not to index the page and leaves it out of\nthe sitemap",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "case_study.Snippet": {
+            "description": "Code of a gist or repository file with highlighting markup",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "func main() {}"
+                },
+                "fetched_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "main.go"
+                },
+                "html": {
+                    "description": "HTML is the escaped code with tokens wrapped in spans of the classes\nhl-c (comments), hl-s (strings), hl-n (numbers) and hl-k (keywords)",
+                    "type": "string",
+                    "example": "\u003cspan class=\"hl-k\"\u003efunc\u003c/span\u003e main() {}"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "start_line": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://github.com/holycann/itsrama-portfolio-backend/blob/main/cmd/main.go#L10-L20"
+                }
+            }
+        },
+        "changelog.Entry": {
+            "description": "Changelog entry recorded from a content change or written by hand",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Rewrote the backend in Go."
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "event_type": {
+                    "description": "EventType, Entity and EntityID describe the change an automatic entry was recorded from",
+                    "type": "string",
+                    "example": "project.created"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_manual": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Added project Portfolio Website"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "changelog.EntryCreate": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "New layout with a project impact section."
+                },
+                "entity": {
+                    "description": "Entity optionally links the entry to a project, experience or tech stack",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "published_at": {
+                    "description": "PublishedAt defaults to now; set it to backdate an entry",
+                    "type": "string",
+                    "example": "2024-05-01T10:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Redesigned the homepage"
+                }
+            }
+        },
+        "changelog.EntryUpdate": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "New layout with a project impact section."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "published_at": {
+                    "type": "string",
+                    "example": "2024-05-01T10:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Redesigned the homepage"
+                }
+            }
+        },
+        "chat.Citation": {
+            "description": "Content cited by an answer",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "index": {
+                    "description": "Index is the number of the marker citing the content in the answer",
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Booking Platform"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/search.SourceType"
+                        }
+                    ],
+                    "example": "project"
+                }
+            }
+        },
+        "chat.MessageCreate": {
+            "description": "Question about the portfolio, optionally continuing a conversation",
+            "type": "object",
+            "required": [
+                "message"
+            ],
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Which projects used Go?"
+                },
+                "session_id": {
+                    "description": "SessionID continues a conversation; leave it empty to start one",
+                    "type": "string",
+                    "example": "3f1c2b6e-8a4d-4e7b-9c2a-1d5e6f7a8b9c"
+                }
+            }
+        },
+        "chat.Reply": {
+            "description": "Answer to a question about the portfolio with the content it cites",
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "description": "Answer cites content with markers such as [1], matching Citations",
+                    "type": "string",
+                    "example": "The booking platform was built with Go and PostgreSQL [1]."
+                },
+                "citations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/chat.Citation"
+                    }
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "off_topic": {
+                    "description": "OffTopic is set when the question was declined as unrelated to the\nportfolio",
+                    "type": "boolean",
+                    "example": false
+                },
+                "remaining_messages": {
+                    "description": "RemainingMessages is the number of questions left in the conversation",
+                    "type": "integer",
+                    "example": 19
+                },
+                "session_id": {
+                    "type": "string",
+                    "example": "3f1c2b6e-8a4d-4e7b-9c2a-1d5e6f7a8b9c"
+                }
+            }
+        },
+        "coding_activity.LanguageShare": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "type": "number",
+                    "example": 61.2
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 48.3
+                }
+            }
+        },
+        "coding_activity.Summary": {
+            "description": "Coding activity totals over a window of days",
+            "type": "object",
+            "properties": {
+                "daily_average_hours": {
+                    "type": "number",
+                    "example": 4.2
+                },
+                "days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/coding_activity.LanguageShare"
+                    }
+                },
+                "last_synced_at": {
+                    "description": "LastSyncedAt is when the newest snapshot was pulled from WakaTime",
+                    "type": "string"
+                },
+                "total_hours": {
+                    "type": "number",
+                    "example": 126.7
+                }
+            }
+        },
+        "coding_activity.SyncResult": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-05-07"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-05-13"
+                }
+            }
+        },
+        "coding_activity.WeeklyActivity": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "type": "number",
+                    "example": 24.5
+                },
+                "week_start": {
+                    "type": "string",
+                    "example": "2024-05-13"
+                }
+            }
+        },
+        "collection.Collection": {
+            "description": "Ordered series of projects",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "How the site and its backend came together"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "project_ids": {
+                    "description": "ProjectIDs are the projects of the series in reading order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building this portfolio"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "collection.CollectionCreate": {
+            "type": "object",
+            "required": [
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "How the site and its backend came together"
+                },
+                "project_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Building this portfolio"
+                }
+            }
+        },
+        "collection.CollectionUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "How the site and its backend came together"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "project_ids": {
+                    "description": "ProjectIDs replaces the projects of the series when present",
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Building this portfolio"
+                }
+            }
+        },
+        "collection.Item": {
+            "description": "Project of a collection",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "position": {
+                    "description": "Position is the 1-based place of the project in the series",
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Personal portfolio and blog"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                }
+            }
+        },
+        "collection.PublicCollection": {
+            "description": "Collection as shown publicly",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "How the site and its backend came together"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building this portfolio"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "collection.PublicCollectionDetail": {
+            "description": "Collection with its public projects, as shown publicly",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "How the site and its backend came together"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/collection.Item"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building this portfolio"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "command_index.Entry": {
+            "description": "Public content listed in the command palette",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "route": {
+                    "description": "Route is the path of the content on the site",
+                    "type": "string",
+                    "example": "/projects/portfolio-website"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "description": "Subtitle is the category of projects and tech stacks and the company\nof experiences",
+                    "type": "string",
+                    "example": "Web Development"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/command_index.EntryType"
+                        }
+                    ],
+                    "example": "project"
+                }
+            }
+        },
+        "command_index.EntryType": {
+            "description": "Kind of content an index entry points to",
+            "type": "string",
+            "enum": [
+                "project",
+                "experience",
+                "tech_stack",
+                "page"
+            ],
+            "x-enum-varnames": [
+                "EntryProject",
+                "EntryExperience",
+                "EntryTechStack",
+                "EntryPage"
+            ]
+        },
+        "command_index.Index": {
+            "description": "Compact index of the public content for the command palette",
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/command_index.Entry"
+                    }
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2026-01-15T10:00:00Z"
+                }
+            }
+        },
+        "company.Company": {
+            "description": "Company information shared by every experience at that company",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "industry": {
+                    "type": "string",
+                    "example": "Software"
+                },
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "logo_url_dark": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo-dark.png"
+                },
+                "logo_url_light": {
+                    "description": "LogoUrlLight and LogoUrlDark are the logo variants for light and dark\nbackgrounds, and LogoPreference the variant frontends should show",
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "slug": {
+                    "description": "Slug is the normalized name companies are deduplicated by",
+                    "type": "string",
+                    "example": "tech-innovations-inc"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://techinnovations.example.com"
+                }
+            }
+        },
+        "company.PublicCompany": {
+            "description": "Company as shown publicly",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "industry": {
+                    "type": "string",
+                    "example": "Software"
+                },
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "logo_url_dark": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo-dark.png"
+                },
+                "logo_url_light": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "tech-innovations-inc"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://techinnovations.example.com"
+                }
+            }
+        },
+        "contract_fixtures.Entity": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "project.ProjectDTO"
+                }
+            }
+        },
+        "cors_policy.OriginsUpdate": {
+            "type": "object",
+            "required": [
+                "allowed_origins"
+            ],
+            "properties": {
+                "allowed_origins": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cors_policy.Policy": {
+            "type": "object",
+            "properties": {
+                "allowed_origins": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "deprecation.DailyHits": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2026-10-16"
+                },
+                "hits": {
+                    "type": "integer"
+                }
+            }
+        },
+        "deprecation.Endpoint": {
+            "type": "object",
+            "properties": {
+                "deprecated_at": {
+                    "type": "string"
+                },
+                "hits": {
+                    "type": "integer"
+                },
+                "hits_by_day": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deprecation.DailyHits"
+                    }
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "last_user_agent": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "GET"
+                },
+                "route": {
+                    "type": "string",
+                    "example": "/api/v1/projects/:id"
+                },
+                "safe_to_remove": {
+                    "description": "SafeToRemove reports that the endpoint went without traffic for the\nconfigured quiet period",
+                    "type": "boolean"
+                },
+                "sunset_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "deprecation.Report": {
+            "type": "object",
+            "properties": {
+                "endpoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deprecation.Endpoint"
+                    }
+                },
+                "sunset_enforced": {
+                    "type": "boolean"
+                },
+                "tracking_since": {
+                    "description": "TrackingSince is when traffic started being counted; counts start\nover when the server restarts",
+                    "type": "string"
+                }
+            }
+        },
+        "diagnostics.BuildDetails": {
+            "type": "object",
+            "properties": {
+                "commit_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "go_version": {
+                    "type": "string",
+                    "example": "go1.24.1"
+                },
+                "modified": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "module": {
+                    "type": "string",
+                    "example": "github.com/holycann/itsrama-portfolio-backend"
+                },
+                "revision": {
+                    "type": "string",
+                    "example": "3f2a9c1b7e4d8a60"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "(devel)"
+                }
+            }
+        },
+        "diagnostics.GCStats": {
+            "type": "object",
+            "properties": {
+                "cpu_fraction": {
+                    "type": "number",
+                    "example": 0.0012
+                },
+                "last_gc": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "last_pause_ns": {
+                    "type": "integer",
+                    "example": 85000
+                },
+                "next_gc": {
+                    "type": "integer",
+                    "example": 16777216
+                },
+                "num_forced_gc": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "num_gc": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "total_pause_ns": {
+                    "type": "integer",
+                    "example": 9600000
+                }
+            }
+        },
+        "diagnostics.HeapStats": {
+            "type": "object",
+            "properties": {
+                "alloc": {
+                    "type": "integer",
+                    "example": 12582912
+                },
+                "idle": {
+                    "type": "integer",
+                    "example": 10485760
+                },
+                "in_use": {
+                    "type": "integer",
+                    "example": 14680064
+                },
+                "objects": {
+                    "type": "integer",
+                    "example": 51234
+                },
+                "released": {
+                    "type": "integer",
+                    "example": 8388608
+                },
+                "sys": {
+                    "type": "integer",
+                    "example": 33554432
+                },
+                "total_alloc": {
+                    "type": "integer",
+                    "example": 734003200
+                }
+            }
+        },
+        "diagnostics.RuntimeReport": {
+            "description": "Goroutines, heap and garbage collector statistics and build information of the running server",
+            "type": "object",
+            "properties": {
+                "build": {
+                    "$ref": "#/definitions/diagnostics.BuildDetails"
+                },
+                "gc": {
+                    "$ref": "#/definitions/diagnostics.GCStats"
+                },
+                "gomaxprocs": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "goroutines": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "heap": {
+                    "$ref": "#/definitions/diagnostics.HeapStats"
+                },
+                "num_cpu": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "uptime_seconds": {
+                    "type": "integer",
+                    "example": 86400
+                }
+            }
+        },
+        "diagnostics.SlowCallReport": {
+            "description": "Slow database calls and handlers grouped by operation, most frequent first",
+            "type": "object",
+            "properties": {
+                "queries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/slowcall.Stat"
+                    }
+                },
+                "query_threshold_ms": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "request_threshold_ms": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/slowcall.Stat"
+                    }
+                }
+            }
+        },
+        "duplicates.Report": {
+            "description": "Probable duplicates among existing projects and experiences",
+            "type": "object",
+            "properties": {
+                "experiences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/base.DuplicateGroup"
+                    }
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/base.DuplicateGroup"
+                    }
+                }
+            }
+        },
+        "embed.OEmbed": {
+            "description": "oEmbed link response describing a project page",
+            "type": "object",
+            "properties": {
+                "cache_age": {
+                    "type": "integer",
+                    "example": 3600
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "provider_name": {
+                    "type": "string",
+                    "example": "Itsrama Portfolio"
+                },
+                "provider_url": {
+                    "type": "string",
+                    "example": "https://itsrama.dev"
+                },
+                "thumbnail_height": {
+                    "type": "integer",
+                    "example": 630
+                },
+                "thumbnail_url": {
+                    "description": "The thumbnail is the link preview image of the page, left out when it\nis larger than the requested maximum size",
+                    "type": "string",
+                    "example": "https://api.itsrama.dev/api/v1/og/projects/portfolio-website?v=1736935200"
+                },
+                "thumbnail_width": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "link"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://itsrama.dev/projects/portfolio-website"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.0"
+                }
+            }
+        },
+        "endorsement.Endorsement": {
+            "description": "Visitor endorsement of a tech stack",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_hidden": {
+                    "description": "IsHidden excludes the endorsement from public counts",
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "visitor_hash": {
+                    "description": "VisitorHash identifies the endorsing visitor without storing their address",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                }
+            }
+        },
+        "endorsement.EndorsementCreate": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name optionally credits the endorser",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jane Doe"
+                }
+            }
+        },
+        "endorsement.EndorsementModerate": {
+            "type": "object",
+            "properties": {
+                "is_hidden": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "events.Event": {
+            "description": "Domain event streamed to the admin activity feed",
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "payload": {},
+                "summary": {
+                    "type": "string",
+                    "example": "Updated project Portfolio Website"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/events.EventType"
+                        }
+                    ],
+                    "example": "project.updated"
+                }
+            }
+        },
+        "events.EventType": {
+            "type": "string",
+            "enum": [
+                "project.created",
+                "project.updated",
+                "project.deleted",
+                "experience.created",
+                "experience.updated",
+                "experience.deleted",
+                "tech_stack.created",
+                "tech_stack.updated",
+                "tech_stack.deleted",
+                "page.created",
+                "page.updated",
+                "page.deleted",
+                "job.progress",
+                "job.succeeded",
+                "job.dead"
+            ],
+            "x-enum-varnames": [
+                "ProjectCreated",
+                "ProjectUpdated",
+                "ProjectDeleted",
+                "ExperienceCreated",
+                "ExperienceUpdated",
+                "ExperienceDeleted",
+                "TechStackCreated",
+                "TechStackUpdated",
+                "TechStackDeleted",
+                "PageCreated",
+                "PageUpdated",
+                "PageDeleted",
+                "JobProgressed",
+                "JobSucceeded",
+                "JobDead"
+            ]
+        },
+        "experience.CompanySummary": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "3 yrs 6 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "Jan 2020 - Jun 2023"
+                },
+                "roles": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                }
+            }
+        },
+        "experience.Experience": {
+            "description": "Detailed information about a professional work experience",
+            "type": "object",
+            "required": [
+                "company",
+                "role",
+                "start_date"
+            ],
+            "properties": {
+                "arrangement": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "description": "@Description Company the experience belongs to",
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "id": {
+                    "description": "Identification\n@Description Unique identifier for the experience",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "image_alts": {
+                    "description": "ImageAlts maps image URLs to the text describing the images to people\nwho cannot see them",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "image_placeholders": {
+                    "description": "ImagePlaceholders maps image URLs to tiny copies of the images as data\nURIs, shown while the images load",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "images_url": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://example.com/project1.png"
+                    ]
+                },
+                "impact": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Increased system performance by 40%"
+                    ]
+                },
+                "is_featured": {
+                    "description": "Metadata\n@Description Additional metadata for the experience",
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "role": {
+                    "description": "Job Details\n@Description Job role and company information",
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "start_date": {
+                    "description": "Timing and Location\n@Description Job timing and location details",
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_description": {
+                    "description": "Job Description\n@Description Detailed description of work and achievements",
+                    "type": "string",
+                    "example": "Led development of scalable web applications"
+                }
+            }
+        },
+        "experience.ExperienceCreate": {
+            "description": "Input model for creating a new experience",
+            "type": "object",
+            "properties": {
+                "allow_duplicate": {
+                    "description": "@Description Create the experience even when an overlapping one with the same role and company exists and duplicates are blocked",
+                    "type": "boolean",
+                    "example": false
+                },
+                "arrangement": {
+                    "description": "@Description Work arrangement\n@Enums [\"Remote\", \"Hybrid\", \"On-site\"]",
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "company": {
+                    "description": "@Description Company name\n@Format string",
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "description": "@Description ID of an existing company, takes precedence over the company name",
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "end_date": {
+                    "description": "@Description End date of the job (optional)\n@Format date",
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "impact": {
+                    "description": "@Description Key impacts or achievements",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Increased system performance by 40%"
+                    ]
+                },
+                "is_featured": {
+                    "description": "@Description Flag to mark as featured experience",
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_type": {
+                    "description": "@Description Job type\n@Enums [\"Full-time\", \"Part-time\", \"Contract\", \"Freelance\"]",
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "description": "@Description Job location\n@Format string",
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "role": {
+                    "description": "@Description Job role\n@Format string",
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "start_date": {
+                    "description": "@Description Start date of the job\n@Format date",
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "tech_stack_ids": {
+                    "description": "@Description IDs of associated tech stacks\n@Enums [\"550e8400-e29b-41d4-a716-446655440000\", \"650f9500-f39c-52d5-b827-557766550001\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "work_description": {
+                    "description": "@Description Work description\n@Format string",
+                    "type": "string",
+                    "example": "Led development of scalable web applications"
+                }
+            }
+        },
+        "experience.ExperienceUpdate": {
+            "description": "Input model for updating an existing experience",
+            "type": "object",
+            "properties": {
+                "arrangement": {
+                    "description": "@Description Work arrangement\n@Enums [\"Remote\", \"Hybrid\", \"On-site\"]",
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "company": {
+                    "description": "@Description Company name\n@Format string",
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "description": "@Description ID of an existing company, takes precedence over the company name",
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "end_date": {
+                    "description": "@Description End date of the job (optional)\n@Format date",
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "id": {
+                    "description": "@Description Unique identifier of the experience to update",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "impact": {
+                    "description": "@Description Key impacts or achievements",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Increased system performance by 40%"
+                    ]
+                },
+                "is_featured": {
+                    "description": "@Description Flag to mark as featured experience",
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_type": {
+                    "description": "@Description Job type\n@Enums [\"Full-time\", \"Part-time\", \"Contract\", \"Freelance\"]",
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "description": "@Description Job location\n@Format string",
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "present": {
+                    "description": "@Description Mark the job as ongoing, clearing its end date",
+                    "type": "boolean",
+                    "example": false
+                },
+                "role": {
+                    "description": "@Description Job role\n@Format string",
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "start_date": {
+                    "description": "@Description Start date of the job\n@Format date",
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "tech_stack_ids": {
+                    "description": "@Description IDs of associated tech stacks\n@Enums [\"550e8400-e29b-41d4-a716-446655440000\", \"650f9500-f39c-52d5-b827-557766550001\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "work_description": {
+                    "description": "@Description Work description\n@Format string",
+                    "type": "string",
+                    "example": "Led development of scalable web applications"
+                }
+            }
+        },
+        "experience.PublicExperience": {
+            "description": "Experience as shown publicly",
+            "type": "object",
+            "properties": {
+                "arrangement": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "experience_tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.PublicExperienceTechStack"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "image_alts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "image_placeholders": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "images_url": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://example.com/project1.png"
+                    ]
+                },
+                "impact": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Increased system performance by 40%"
+                    ]
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_description": {
+                    "type": "string",
+                    "example": "Led development of scalable web applications"
+                }
+            }
+        },
+        "experience.PublicExperienceTechStack": {
+            "description": "Tech stack of an experience as shown publicly",
+            "type": "object",
+            "properties": {
+                "experience_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "tech_stack": {
+                    "$ref": "#/definitions/tech_stack.PublicTechStack"
+                },
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                }
+            }
+        },
+        "experience.Summary": {
+            "description": "Total time worked, per company and per tech stack, counting overlapping roles once",
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.CompanySummary"
+                    }
+                },
+                "current_roles": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "experiences": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "tech_stacks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.TechStackSummary"
+                    }
+                },
+                "total_duration": {
+                    "type": "string",
+                    "example": "4 yrs 4 mos"
+                },
+                "total_months": {
+                    "description": "Total time worked, counting overlapping roles once",
+                    "type": "integer",
+                    "example": 52
+                }
+            }
+        },
+        "experience.TechStackSummary": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
+                        }
+                    ],
+                    "example": "Backend"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "3 yrs 6 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "experiences": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "Jan 2020 - Present"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                }
+            }
+        },
+        "experience.Timeline": {
+            "description": "Experiences grouped by company with computed durations, overlaps and gaps",
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.TimelineCompany"
+                    }
+                },
+                "gaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.TimelineGap"
+                    }
+                },
+                "overlaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.TimelineOverlap"
+                    }
+                },
+                "total_duration": {
+                    "type": "string",
+                    "example": "4 yrs 4 mos"
+                },
+                "total_months": {
+                    "description": "Total time worked, counting overlapping roles once",
+                    "type": "integer",
+                    "example": 52
+                }
+            }
+        },
+        "experience.TimelineCompany": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "3 yrs 6 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "Jan 2020 - Jun 2023"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.TimelineRole"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                }
+            }
+        },
+        "experience.TimelineGap": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string",
+                    "example": "4 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-10-31"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2023-07-01"
+                }
+            }
+        },
+        "experience.TimelineOverlap": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string",
+                    "example": "6 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2022-08-31"
+                },
+                "experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2022-03-01"
+                }
+            }
+        },
+        "experience.TimelineRole": {
+            "type": "object",
+            "properties": {
+                "arrangement": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "3 yrs 6 mos"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "overlaps_with": {
+                    "description": "OverlapsWith lists the IDs of roles held at the same time",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "period": {
+                    "type": "string",
+                    "example": "Jan 2020 - Jun 2023"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                }
+            }
+        },
+        "experiment.Assignment": {
+            "description": "Variant of an active experiment shown to a visitor",
+            "type": "object",
+            "properties": {
+                "experiment": {
+                    "type": "string",
+                    "example": "headline-2026-10"
+                },
+                "field": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/experiment.Field"
+                        }
+                    ],
+                    "example": "headline"
+                },
+                "headline": {
+                    "type": "string",
+                    "example": "I build APIs that stay up"
+                },
+                "project_order": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "variant": {
+                    "type": "string",
+                    "example": "builder"
+                }
+            }
+        },
+        "experiment.Experiment": {
+            "description": "Split of visitors between variants of a field",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active experiments assign visitors; only one per field may be active",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Outcome focused headline against the current one"
+                },
+                "field": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/experiment.Field"
+                        }
+                    ],
+                    "example": "headline"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "headline-2026-10"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experiment.Variant"
+                    }
+                }
+            }
+        },
+        "experiment.ExperimentCreate": {
+            "type": "object",
+            "required": [
+                "field",
+                "key",
+                "variants"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Outcome focused headline against the current one"
+                },
+                "field": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/experiment.Field"
+                        }
+                    ],
+                    "example": "headline"
+                },
+                "key": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "headline-2026-10"
+                },
+                "variants": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/experiment.Variant"
+                    }
+                }
+            }
+        },
+        "experiment.ExperimentUpdate": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Outcome focused headline against the current one"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "variants": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/experiment.Variant"
+                    }
+                }
+            }
+        },
+        "experiment.Field": {
+            "description": "Part of the site an experiment varies",
+            "type": "string",
+            "enum": [
+                "headline",
+                "featured_order"
+            ],
+            "x-enum-varnames": [
+                "FieldHeadline",
+                "FieldFeaturedOrder"
+            ]
+        },
+        "experiment.Results": {
+            "description": "Exposed and converted visitors per variant of an experiment",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "experiment_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "field": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/experiment.Field"
+                        }
+                    ],
+                    "example": "headline"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "headline-2026-10"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experiment.VariantResult"
+                    }
+                }
+            }
+        },
+        "experiment.Variant": {
+            "description": "Version of a field shown to a share of the visitors",
+            "type": "object",
+            "properties": {
+                "headline": {
+                    "description": "Headline is shown by variants of a headline experiment",
+                    "type": "string",
+                    "example": "I build APIs that stay up"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "builder"
+                },
+                "project_order": {
+                    "description": "ProjectOrder lists the featured projects in the order variants of a\nfeatured order experiment show them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weight": {
+                    "description": "Weight is the share of visitors assigned to the variant relative to\nthe other ones, 1 when left empty",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "experiment.VariantResult": {
+            "description": "Exposed and converted visitors of a variant",
+            "type": "object",
+            "properties": {
+                "conversion_rate": {
+                    "description": "ConversionRate is the share of exposed visitors who converted",
+                    "type": "number",
+                    "example": 0.0218
+                },
+                "conversions": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "exposures": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "variant": {
+                    "type": "string",
+                    "example": "builder"
+                }
+            }
+        },
+        "health.CPUHealth": {
+            "type": "object",
+            "properties": {
+                "cores": {
+                    "type": "integer"
+                },
+                "loadAverage": {
+                    "type": "number"
+                }
+            }
+        },
+        "health.DatabaseHealth": {
+            "type": "object",
+            "properties": {
+                "connected": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.HealthStatus": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/health.CPUHealth"
+                },
+                "database": {
+                    "$ref": "#/definitions/health.DatabaseHealth"
+                },
+                "jwks": {
+                    "$ref": "#/definitions/health.JWKSHealth"
+                },
+                "memory": {
+                    "$ref": "#/definitions/health.MemoryHealth"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "system": {
+                    "$ref": "#/definitions/health.SystemInfo"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.JWKSHealth": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "loaded": {
+                    "type": "boolean"
+                },
+                "loaded_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.MemoryHealth": {
+            "type": "object",
+            "properties": {
+                "free": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "used": {
+                    "type": "integer"
+                },
+                "usedPercent": {
+                    "type": "number"
+                }
+            }
+        },
+        "health.SystemInfo": {
+            "type": "object",
+            "properties": {
+                "goVersion": {
+                    "type": "string"
+                },
+                "numCPU": {
+                    "type": "integer"
+                },
+                "numGoroutine": {
+                    "type": "integer"
+                }
+            }
+        },
+        "indieauth.Approval": {
+            "description": "Redirect back to the client carrying the authorization code",
+            "type": "object",
+            "properties": {
+                "redirect_url": {
+                    "type": "string",
+                    "example": "https://quill.p3k.io/auth/callback?code=Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h\u0026iss=https%3A%2F%2Fapi.itsrama.com%2Fapi%2Fv1%2Findieauth\u0026state=1234567890"
+                }
+            }
+        },
+        "indieauth.Authorization": {
+            "description": "Access token granted to an IndieAuth client",
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string",
+                    "example": "https://quill.p3k.io/"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "me": {
+                    "type": "string",
+                    "example": "https://itsrama.com/"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "create media"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "indieauth.AuthorizationRequest": {
+            "type": "object",
+            "required": [
+                "client_id",
+                "code_challenge",
+                "code_challenge_method",
+                "redirect_uri",
+                "response_type",
+                "state"
+            ],
+            "properties": {
+                "client_id": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://quill.p3k.io/"
+                },
+                "code_challenge": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 43,
+                    "example": "OfYAxt8zU2dAPDWQxTAUIteRzMsoj9QBdMIVEDOErUo"
+                },
+                "code_challenge_method": {
+                    "type": "string",
+                    "example": "S256"
+                },
+                "me": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://itsrama.com/"
+                },
+                "redirect_uri": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://quill.p3k.io/auth/callback"
+                },
+                "response_type": {
+                    "type": "string",
+                    "example": "code"
+                },
+                "scope": {
+                    "type": "string",
+                    "maxLength": 1024,
+                    "example": "create media"
+                },
+                "state": {
+                    "type": "string",
+                    "maxLength": 1024,
+                    "example": "1234567890"
+                }
+            }
+        },
+        "indieauth.Metadata": {
+            "description": "IndieAuth server metadata",
+            "type": "object",
+            "properties": {
+                "authorization_endpoint": {
+                    "type": "string",
+                    "example": "https://api.itsrama.com/api/v1/indieauth/auth"
+                },
+                "authorization_response_iss_parameter_supported": {
+                    "type": "boolean"
+                },
+                "code_challenge_methods_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "grant_types_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "issuer": {
+                    "type": "string",
+                    "example": "https://api.itsrama.com/api/v1/indieauth"
+                },
+                "response_types_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "revocation_endpoint": {
+                    "type": "string",
+                    "example": "https://api.itsrama.com/api/v1/indieauth/revoke"
+                },
+                "token_endpoint": {
+                    "type": "string",
+                    "example": "https://api.itsrama.com/api/v1/indieauth/token"
+                }
+            }
+        },
+        "indieauth.Profile": {
+            "description": "Owner profile URL confirmed by the authorization endpoint",
+            "type": "object",
+            "properties": {
+                "me": {
+                    "type": "string",
+                    "example": "https://itsrama.com/"
+                }
+            }
+        },
+        "indieauth.Token": {
+            "description": "Access token issued to an IndieAuth client",
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "example": 7776000
+                },
+                "me": {
+                    "type": "string",
+                    "example": "https://itsrama.com/"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "create media"
+                },
+                "token_type": {
+                    "type": "string",
+                    "example": "Bearer"
+                }
+            }
+        },
+        "indieauth.TokenInfo": {
+            "description": "Details of a valid IndieAuth access token",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "client_id": {
+                    "type": "string",
+                    "example": "https://quill.p3k.io/"
+                },
+                "exp": {
+                    "type": "integer",
+                    "example": 1767225600
+                },
+                "iat": {
+                    "type": "integer",
+                    "example": 1759449600
+                },
+                "me": {
+                    "type": "string",
+                    "example": "https://itsrama.com/"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "create media"
+                }
+            }
+        },
+        "inquiry.DepositCreate": {
+            "type": "object",
+            "properties": {
+                "percent": {
+                    "description": "Percent of the proposal total to charge; the configured share when 0",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 50
+                }
+            }
+        },
+        "inquiry.Inquiry": {
+            "description": "Project inquiry and its stage in the pipeline",
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "type": "string",
+                    "example": "$5k - $10k"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Acme Inc."
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "We need a new booking API."
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "spam_reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "heuristic: too many links"
+                    ]
+                },
+                "spam_score": {
+                    "description": "Spam filter verdict, recorded when the submission was flagged",
+                    "type": "number",
+                    "example": 0.8
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/inquiry.Status"
+                        }
+                    ],
+                    "example": "new"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "launch-2026"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "newsletter"
+                }
+            }
+        },
+        "inquiry.InquiryCreate": {
+            "type": "object",
+            "required": [
+                "email",
+                "message",
+                "name"
+            ],
+            "properties": {
+                "budget": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "$5k - $10k"
+                },
+                "company": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Acme Inc."
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "jane@example.com"
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "We need a new booking API."
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Jane Doe"
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "launch-2026"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "newsletter"
+                }
+            }
+        },
+        "inquiry.InquiryStatusUpdate": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/inquiry.Status"
+                        }
+                    ],
+                    "example": "qualified"
+                }
+            }
+        },
+        "inquiry.LineItem": {
+            "type": "object",
+            "required": [
+                "description"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "API design and implementation"
+                },
+                "quantity": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "unit_price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 4500
+                }
+            }
+        },
+        "inquiry.Payment": {
+            "description": "Payment collected for an inquiry through a payment provider",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 2250
+                },
+                "checkout_url": {
+                    "type": "string",
+                    "example": "https://checkout.stripe.com/c/pay/cs_test_a1b2c3"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "inquiry_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/inquiry.PaymentKind"
+                        }
+                    ],
+                    "example": "deposit"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "proposal_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "stripe"
+                },
+                "provider_payment_id": {
+                    "type": "string",
+                    "example": "pi_3N1b2c3"
+                },
+                "provider_session_id": {
+                    "type": "string",
+                    "example": "cs_test_a1b2c3"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/inquiry.PaymentStatus"
+                        }
+                    ],
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "inquiry.PaymentKind": {
+            "type": "string",
+            "enum": [
+                "deposit"
+            ],
+            "x-enum-varnames": [
+                "PaymentDeposit"
+            ]
+        },
+        "inquiry.PaymentStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "paid",
+                "failed",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "PaymentPending",
+                "PaymentPaid",
+                "PaymentFailed",
+                "PaymentExpired"
+            ]
+        },
+        "inquiry.Proposal": {
+            "description": "Quote for an inquiry, rendered to a PDF",
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "description": "Acceptance is recorded when the prospect accepts through a signed link",
+                    "type": "string"
+                },
+                "accepted_ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "accepted_name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "accepted_user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "inquiry_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "intro": {
+                    "type": "string",
+                    "example": "Thanks for reaching out, here is what I propose."
+                },
+                "line_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/inquiry.LineItem"
+                    }
+                },
+                "number": {
+                    "type": "string",
+                    "example": "P-20260101-1A2B3C"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "terms": {
+                    "type": "string",
+                    "example": "50% deposit before work starts."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Booking API"
+                },
+                "total": {
+                    "type": "number",
+                    "example": 4500
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "valid_until": {
+                    "type": "string"
+                }
+            }
+        },
+        "inquiry.ProposalAcceptance": {
+            "type": "object",
+            "required": [
+                "agree_to_terms",
+                "name"
+            ],
+            "properties": {
+                "agree_to_terms": {
+                    "description": "AgreeToTerms must be true to accept",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "Name is typed by the prospect as their signature",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Jane Doe"
+                }
+            }
+        },
+        "inquiry.ProposalCreate": {
+            "type": "object",
+            "required": [
+                "line_items",
+                "title"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "intro": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Thanks for reaching out, here is what I propose."
+                },
+                "line_items": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/inquiry.LineItem"
+                    }
+                },
+                "send": {
+                    "description": "Send emails the download and acceptance links to the prospect right away",
+                    "type": "boolean",
+                    "example": true
+                },
+                "terms": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "50% deposit before work starts."
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Booking API"
+                },
+                "valid_days": {
+                    "description": "ValidDays overrides how many days the proposal is valid",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0,
+                    "example": 30
+                }
+            }
+        },
+        "inquiry.ProposalLink": {
+            "type": "object",
+            "properties": {
+                "accept_url": {
+                    "type": "string",
+                    "example": "https://example.com/proposals/550e8400-e29b-41d4-a716-446655440000/accept?expires=1767225600\u0026signature=..."
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "proposal": {
+                    "$ref": "#/definitions/inquiry.Proposal"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://api.example.com/api/v1/proposals/550e8400-e29b-41d4-a716-446655440000/download?expires=1767225600\u0026signature=..."
+                }
+            }
+        },
+        "inquiry.Status": {
+            "type": "string",
+            "enum": [
+                "new",
+                "qualified",
+                "proposal_sent",
+                "accepted",
+                "declined",
+                "paid",
+                "spam"
+            ],
+            "x-enum-varnames": [
+                "StatusNew",
+                "StatusQualified",
+                "StatusProposalSent",
+                "StatusAccepted",
+                "StatusDeclined",
+                "StatusPaid",
+                "StatusSpam"
+            ]
+        },
+        "integrity.Check": {
+            "type": "string",
+            "enum": [
+                "broken_image",
+                "dangling_tech_stack",
+                "missing_thumbnail",
+                "invalid_date_range"
+            ],
+            "x-enum-varnames": [
+                "CheckBrokenImage",
+                "CheckDanglingTechStack",
+                "CheckMissingThumbnail",
+                "CheckInvalidDateRange"
+            ]
+        },
+        "integrity.Checked": {
+            "description": "Content checked by an integrity run",
+            "type": "object",
+            "properties": {
+                "experiences": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "images": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "tech_stacks": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "integrity.Issue": {
+            "description": "Problem found by an integrity check",
+            "type": "object",
+            "properties": {
+                "check": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/integrity.Check"
+                        }
+                    ],
+                    "example": "broken_image"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "entity_name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "entity_type": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "images"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "image answered with status 404"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "https://example.com/missing.png"
+                }
+            }
+        },
+        "integrity.Report": {
+            "description": "Result of running every integrity check",
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "$ref": "#/definitions/integrity.Checked"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/integrity.Issue"
+                    }
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "jobs.Job": {
+            "description": "Background job with its progress and outcome",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "project.screenshot"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "locked_until": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "progress": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "progress_message": {
+                    "type": "string",
+                    "example": "Capturing screenshot"
+                },
+                "result": {
+                    "type": "object"
+                },
+                "run_at": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/jobs.JobStatus"
+                        }
+                    ],
+                    "example": "running"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "jobs.JobStatus": {
+            "description": "State of a background job",
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "succeeded",
+                "dead"
+            ],
+            "x-enum-varnames": [
+                "JobQueued",
+                "JobRunning",
+                "JobSucceeded",
+                "JobDead"
+            ]
+        },
+        "linkcheck.BrokenLink": {
+            "description": "Link found unreachable by the last link check",
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                },
+                "entity_name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "entity_type": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "dial tcp: lookup myportfolio.com: no such host"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "web_url"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status_code": {
+                    "description": "StatusCode is the HTTP status received, or 0 when the request failed",
+                    "type": "integer",
+                    "example": 404
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://myportfolio.com"
+                }
+            }
+        },
+        "linkcheck.Report": {
+            "description": "Result of a link check run",
+            "type": "object",
+            "properties": {
+                "broken": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "broken_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/linkcheck.BrokenLink"
+                    }
+                },
+                "checked": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "checked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "log_level.Levels": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "description": "Level applies to every module without a level of its own",
+                    "type": "string",
+                    "example": "info"
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/log_level.ModuleLevel"
+                    }
+                },
+                "sinks": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "console",
+                        "file"
+                    ]
+                }
+            }
+        },
+        "log_level.LevelsUpdate": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "debug",
+                        "info",
+                        "warn",
+                        "error"
+                    ],
+                    "example": "info"
+                },
+                "modules": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "log_level.ModuleLevel": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "string",
+                    "example": "debug"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "tech_stack"
+                },
+                "overridden": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "mail.DeliveryStatus": {
+            "description": "Delivery state of an email",
+            "type": "string",
+            "enum": [
+                "pending",
+                "sent",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "DeliveryPending",
+                "DeliverySent",
+                "DeliveryFailed"
+            ]
+        },
+        "mail.MailDelivery": {
+            "description": "Email delivery log entry",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "html_body": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "smtp"
+                },
+                "recipients": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "someone@example.com"
+                    ]
+                },
+                "reply_to": {
+                    "type": "string",
+                    "example": "visitor@example.com"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mail.DeliveryStatus"
+                        }
+                    ],
+                    "example": "pending"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "New message from Jane"
+                },
+                "template": {
+                    "type": "string",
+                    "example": "contact_notification"
+                },
+                "text_body": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "maintenance.ReadingTimeReport": {
+            "description": "Number of projects and pages whose word count or reading time was recomputed",
+            "type": "object",
+            "properties": {
+                "pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "maintenance.TOCReport": {
+            "description": "Number of projects and pages whose table of contents was rebuilt",
+            "type": "object",
+            "properties": {
+                "pages": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "markdown.Heading": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "description": "Anchor is the id of the rendered heading, linked to as \"#\" + Anchor",
+                    "type": "string",
+                    "example": "architecture"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Architecture"
+                }
+            }
+        },
+        "media.AltProblem": {
+            "description": "Reason the alt text of an image is not meaningful",
+            "type": "string",
+            "enum": [
+                "missing",
+                "filename",
+                "generic",
+                "too_short",
+                "too_long"
+            ],
+            "x-enum-varnames": [
+                "AltMissing",
+                "AltFilename",
+                "AltGeneric",
+                "AltTooShort",
+                "AltTooLong"
+            ]
+        },
+        "media.AltReport": {
+            "description": "Images whose alt text is missing or does not describe them",
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/media.Image"
+                    }
+                }
+            }
+        },
+        "media.AltSuggestion": {
+            "description": "Alt text generated for an image and whether it was applied",
+            "type": "object",
+            "properties": {
+                "alt": {
+                    "type": "string",
+                    "example": "Dashboard listing projects by category"
+                },
+                "applied": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "src": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                }
+            }
+        },
+        "media.AltSuggestionCreate": {
+            "description": "Image to suggest alt text for, applying it when apply is set",
+            "type": "object",
+            "required": [
+                "entity",
+                "entity_id",
+                "src"
+            ],
+            "properties": {
+                "apply": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "entity": {
+                    "type": "string",
+                    "enum": [
+                        "project",
+                        "experience"
+                    ],
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "src": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                }
+            }
+        },
+        "media.AltUpdate": {
+            "description": "Alt text of the image stored at src on a project or an experience",
+            "type": "object",
+            "required": [
+                "alt",
+                "entity",
+                "entity_id",
+                "src"
+            ],
+            "properties": {
+                "alt": {
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Dashboard listing projects by category"
+                },
+                "entity": {
+                    "type": "string",
+                    "enum": [
+                        "project",
+                        "experience"
+                    ],
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "src": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                }
+            }
+        },
+        "media.Image": {
+            "description": "Image of a project or an experience with its alt text",
+            "type": "object",
+            "properties": {
+                "alt": {
+                    "type": "string",
+                    "example": "Dashboard listing projects by category"
+                },
+                "alt_problem": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/media.AltProblem"
+                        }
+                    ],
+                    "example": "filename"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "project"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "entity_name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "src": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                }
+            }
+        },
+        "middleware.Challenge": {
+            "type": "object",
+            "properties": {
+                "challenge": {
+                    "description": "Challenge is the opaque signed token to echo back in the X-Challenge header",
+                    "type": "string",
+                    "example": "1718000000.9f86d081884c7d65.5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+                },
+                "difficulty": {
+                    "description": "Difficulty is the number of leading zero bits required in\nsha256(challenge + \":\" + solution)",
+                    "type": "integer",
+                    "example": 18
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "issued_at": {
+                    "type": "string"
+                },
+                "min_solve_time": {
+                    "description": "MinSolveTime is the minimum number of seconds before a solution is accepted",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "notification.ChannelCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact.*",
+                        "backup.completed"
+                    ]
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "min_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notifier.Level"
+                        }
+                    ],
+                    "example": "info"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Ops Telegram"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notification.ChannelType"
+                        }
+                    ],
+                    "example": "telegram"
+                }
+            }
+        },
+        "notification.ChannelType": {
+            "description": "Delivery service of a notification channel",
+            "type": "string",
+            "enum": [
+                "email",
+                "telegram",
+                "discord",
+                "slack"
+            ],
+            "x-enum-varnames": [
+                "ChannelEmail",
+                "ChannelTelegram",
+                "ChannelDiscord",
+                "ChannelSlack"
+            ]
+        },
+        "notification.ChannelUpdate": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact.*",
+                        "backup.completed"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "min_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notifier.Level"
+                        }
+                    ],
+                    "example": "warning"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ops Telegram"
+                }
+            }
+        },
+        "notification.LogStatus": {
+            "description": "Outcome of a notification delivery",
+            "type": "string",
+            "enum": [
+                "sent",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "LogSent",
+                "LogFailed"
+            ]
+        },
+        "notification.NotificationChannel": {
+            "description": "Notification channel with its routing rules",
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Rules",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact.*",
+                        "backup.completed"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "min_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notifier.Level"
+                        }
+                    ],
+                    "example": "info"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ops Telegram"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notification.ChannelType"
+                        }
+                    ],
+                    "example": "telegram"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.NotificationLog": {
+            "description": "Notification delivery log entry",
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "channel_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notification.ChannelType"
+                        }
+                    ],
+                    "example": "telegram"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "contact.created"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notifier.Level"
+                        }
+                    ],
+                    "example": "info"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notification.LogStatus"
+                        }
+                    ],
+                    "example": "sent"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "New contact message"
+                }
+            }
+        },
+        "notifier.Level": {
+            "type": "string",
+            "enum": [
+                "info",
+                "warning",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "LevelInfo",
+                "LevelWarning",
+                "LevelError"
+            ]
+        },
+        "now.Entry": {
+            "description": "What I'm currently working on, reading and learning",
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "Content is Markdown; ContentHTML is rendered from it on save",
+                    "type": "string",
+                    "example": "Settling into a new role and **shipping** the portfolio redesign."
+                },
+                "content_html": {
+                    "type": "string",
+                    "example": "\u003cp\u003eSettling into a new role and \u003cstrong\u003eshipping\u003c/strong\u003e the portfolio redesign.\u003c/p\u003e"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_current": {
+                    "description": "IsCurrent marks the entry shown on /now; older entries form the history",
+                    "type": "boolean",
+                    "example": true
+                },
+                "learning": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Rust"
+                    ]
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Jakarta, Indonesia"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Designing Data-Intensive Applications"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "working_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Portfolio redesign"
+                    ]
+                }
+            }
+        },
+        "now.EntryCreate": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Settling into a new role and **shipping** the portfolio redesign."
+                },
+                "learning": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Rust"
+                    ]
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Jakarta, Indonesia"
+                },
+                "reading": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Designing Data-Intensive Applications"
+                    ]
+                },
+                "working_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Portfolio redesign"
+                    ]
+                }
+            }
+        },
+        "now.EntryUpdate": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Settling into a new role and **shipping** the portfolio redesign."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "learning": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Rust"
+                    ]
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Jakarta, Indonesia"
+                },
+                "reading": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Designing Data-Intensive Applications"
+                    ]
+                },
+                "working_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Portfolio redesign"
+                    ]
+                }
+            }
+        },
+        "now_playing.NowPlaying": {
+            "description": "Currently playing or last played track on Spotify",
+            "type": "object",
+            "properties": {
+                "album": {
+                    "type": "string",
+                    "example": "Hurry Up, We're Dreaming"
+                },
+                "album_image_url": {
+                    "type": "string",
+                    "example": "https://i.scdn.co/image/ab67616d0000b273"
+                },
+                "artist": {
+                    "type": "string",
+                    "example": "M83"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 243000
+                },
+                "fetched_at": {
+                    "description": "FetchedAt is when the track was read from Spotify",
+                    "type": "string"
+                },
+                "is_playing": {
+                    "description": "IsPlaying is false when the track below is the last one played",
+                    "type": "boolean",
+                    "example": true
+                },
+                "played_at": {
+                    "description": "PlayedAt is when the last played track finished",
+                    "type": "string"
+                },
+                "progress_ms": {
+                    "type": "integer",
+                    "example": 83000
+                },
+                "song_url": {
+                    "type": "string",
+                    "example": "https://open.spotify.com/track/1eyzqe2QqGZUmfcPZtrIyt"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Midnight City"
+                }
+            }
+        },
+        "offering.ExchangeRate": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.92
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "offering.LocalizedPrice": {
+            "description": "Price range of an offering, converted when not set in the requested currency",
+            "type": "object",
+            "properties": {
+                "converted": {
+                    "description": "Converted is true when the price was converted from another currency",
+                    "type": "boolean",
+                    "example": true
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 3680
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1380
+                },
+                "rate_updated_at": {
+                    "description": "RateUpdatedAt is when the exchange rate used was published",
+                    "type": "string"
+                },
+                "unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/offering.PriceUnit"
+                        }
+                    ],
+                    "example": "project"
+                }
+            }
+        },
+        "offering.Offering": {
+            "description": "Service offering with price ranges in one or more currencies",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Design and build a production-ready REST API"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "OpenAPI docs",
+                        "CI/CD setup"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "position": {
+                    "description": "Position orders offerings, lowest first",
+                    "type": "integer",
+                    "example": 0
+                },
+                "price_unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/offering.PriceUnit"
+                        }
+                    ],
+                    "example": "project"
+                },
+                "prices": {
+                    "description": "Prices lists the price range in each currency set explicitly; the\nfirst one is converted for any other currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/offering.PriceRange"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Backend API development"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "offering.OfferingCreate": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Design and build a production-ready REST API"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "OpenAPI docs",
+                        "CI/CD setup"
+                    ]
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "price_unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/offering.PriceUnit"
+                        }
+                    ],
+                    "example": "project"
+                },
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/offering.PriceRange"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Backend API development"
+                }
+            }
+        },
+        "offering.OfferingDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Design and build a production-ready REST API"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "OpenAPI docs",
+                        "CI/CD setup"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "position": {
+                    "description": "Position orders offerings, lowest first",
+                    "type": "integer",
+                    "example": 0
+                },
+                "price": {
+                    "$ref": "#/definitions/offering.LocalizedPrice"
+                },
+                "price_unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/offering.PriceUnit"
+                        }
+                    ],
+                    "example": "project"
+                },
+                "prices": {
+                    "description": "Prices lists the price range in each currency set explicitly; the\nfirst one is converted for any other currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/offering.PriceRange"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Backend API development"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "offering.OfferingUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Design and build a production-ready REST API"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "OpenAPI docs",
+                        "CI/CD setup"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price_unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/offering.PriceUnit"
+                        }
+                    ],
+                    "example": "hour"
+                },
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/offering.PriceRange"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Backend API development"
+                }
+            }
+        },
+        "offering.PriceRange": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 4000
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1500
+                }
+            }
+        },
+        "offering.PriceUnit": {
+            "type": "string",
+            "enum": [
+                "project",
+                "hour",
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "PerProject",
+                "PerHour",
+                "PerDay",
+                "PerWeek",
+                "PerMonth"
+            ]
+        },
+        "page.Page": {
+            "description": "Standalone content page such as the privacy policy, terms or imprint",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is Markdown; BodyHTML is rendered from it on save",
+                    "type": "string",
+                    "example": "We only collect what is needed to answer your **inquiry**."
+                },
+                "body_html": {
+                    "type": "string",
+                    "example": "\u003cp\u003eWe only collect what is needed to answer your \u003cstrong\u003einquiry\u003c/strong\u003e.\u003c/p\u003e"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "published": {
+                    "description": "Published pages are served publicly; drafts only to admins",
+                    "type": "boolean",
+                    "example": true
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "revision": {
+                    "description": "Revision is the number of the latest revision of the page",
+                    "type": "integer",
+                    "example": 3
+                },
+                "seo": {
+                    "description": "Search engine directives",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/base.SEO"
+                        }
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "privacy-policy"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Privacy Policy"
+                },
+                "toc": {
+                    "description": "Table of contents of the body, computed on save",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "word_count": {
+                    "description": "Reading statistics of the body, computed on save. ReadingTime is in\nminutes.",
+                    "type": "integer",
+                    "example": 420
+                }
+            }
+        },
+        "page.PageCreate": {
+            "type": "object",
+            "required": [
+                "body",
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 100000,
+                    "example": "We only collect what is needed to answer your **inquiry**."
+                },
+                "published": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "seo": {
+                    "$ref": "#/definitions/base.SEO"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "privacy-policy"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Privacy Policy"
+                }
+            }
+        },
+        "page.PageUpdate": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 100000,
+                    "example": "We only collect what is needed to answer your **inquiry**."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "note": {
+                    "description": "Note describes the change in the revision history",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Mention the analytics provider"
+                },
+                "published": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "seo": {
+                    "description": "SEO replaces the search engine directives when present",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/base.SEO"
+                        }
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "privacy-policy"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Privacy Policy"
+                }
+            }
+        },
+        "page.PreviewCreate": {
+            "type": "object",
+            "properties": {
+                "expires_in_hours": {
+                    "description": "ExpiresInHours is how long the link works; the configured default when 0",
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 0,
+                    "example": 72
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "For review by the legal team"
+                }
+            }
+        },
+        "page.PreviewLink": {
+            "description": "Page preview with its signed link",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "For review by the legal team"
+                },
+                "page_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000.1735689600.9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://itsrama.dev/preview?token=550e8400-e29b-41d4-a716-446655440000.1735689600.9f86d0"
+                }
+            }
+        },
+        "page.PublicPage": {
+            "description": "Page as shown publicly",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "We only collect what is needed to answer your **inquiry**."
+                },
+                "body_html": {
+                    "type": "string",
+                    "example": "\u003cp\u003eWe only collect what is needed to answer your \u003cstrong\u003einquiry\u003c/strong\u003e.\u003c/p\u003e"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "reading_time": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "seo": {
+                    "$ref": "#/definitions/base.SEO"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "privacy-policy"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Privacy Policy"
+                },
+                "toc": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "word_count": {
+                    "type": "integer",
+                    "example": 420
+                }
+            }
+        },
+        "page.Revision": {
+            "description": "Saved version of a page",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "We only collect what is needed to answer your **inquiry**."
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_by": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Mention the analytics provider"
+                },
+                "page_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "published": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Privacy Policy"
+                }
+            }
+        },
+        "page.RevisionRestore": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Undo the analytics change"
+                }
+            }
+        },
+        "portal.InquiryDetail": {
+            "description": "Client portal view of an inquiry",
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "type": "string",
+                    "example": "$5k - $10k"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Acme Inc."
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "We need a new booking API."
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/inquiry.Payment"
+                    }
+                },
+                "proposals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/inquiry.ProposalLink"
+                    }
+                },
+                "spam_reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "heuristic: too many links"
+                    ]
+                },
+                "spam_score": {
+                    "description": "Spam filter verdict, recorded when the submission was flagged",
+                    "type": "number",
+                    "example": 0.8
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/inquiry.Status"
+                        }
+                    ],
+                    "example": "new"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "utm_campaign": {
+                    "type": "string",
+                    "example": "launch-2026"
+                },
+                "utm_medium": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "utm_source": {
+                    "type": "string",
+                    "example": "newsletter"
+                }
+            }
+        },
+        "portal.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                }
+            }
+        },
+        "portal.Session": {
+            "description": "Client portal session",
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"
+                }
+            }
+        },
+        "portal.SessionCreate": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"
+                }
+            }
+        },
+        "profile_stats.ProfileStat": {
+            "description": "Public statistics of a developer profile such as GitHub or Stack Overflow",
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string",
+                    "example": "holycann"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fetched_at": {
+                    "description": "FetchedAt is when Metrics were last read successfully",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "metrics": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "profile_url": {
+                    "type": "string",
+                    "example": "https://github.com/holycann"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "github"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "profile_stats.RefreshResult": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string",
+                    "example": "holycann"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "github: unexpected status 403"
+                },
+                "ok": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "github"
+                }
+            }
+        },
+        "project.DevelopmentStatus": {
+            "description": "Development status of a project",
+            "type": "string",
+            "enum": [
+                "Alpha",
+                "Beta",
+                "MVP"
+            ],
+            "x-enum-varnames": [
+                "Alpha",
+                "Beta",
+                "MVP"
+            ]
+        },
+        "project.GanttPhase": {
+            "description": "Phase of a project timeline positioned for a Gantt chart",
+            "type": "object",
+            "properties": {
+                "duration_days": {
+                    "type": "integer",
+                    "example": 44
+                },
+                "effort_hours": {
+                    "type": "number",
+                    "example": 120
+                },
+                "effort_share": {
+                    "description": "EffortShare is the fraction of the total effort spent on the phase",
+                    "type": "number",
+                    "example": 0.46
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-03-15"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.PhaseKind"
+                        }
+                    ],
+                    "example": "build"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Backend API"
+                },
+                "offset_days": {
+                    "description": "OffsetDays is how many days after the start of the timeline the\nphase starts",
+                    "type": "integer",
+                    "example": 24
+                },
+                "ongoing": {
+                    "description": "Ongoing phases end today until they are given an end date",
+                    "type": "boolean",
+                    "example": false
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-02-01"
+                }
+            }
+        },
+        "project.ImpactMetric": {
+            "description": "Metric summarized across featured projects",
+            "type": "object",
+            "properties": {
+                "aggregation": {
+                    "description": "Aggregation is \"sum\" for counts and currencies and \"max\" otherwise",
+                    "type": "string",
+                    "example": "sum"
+                },
+                "key": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.MetricKey"
+                        }
+                    ],
+                    "example": "users"
+                },
+                "project_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "project_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.MetricUnit"
+                        }
+                    ],
+                    "example": "count"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 48000
+                }
+            }
+        },
+        "project.ImpactSummary": {
+            "description": "Metrics summarized across featured projects",
+            "type": "object",
+            "properties": {
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ImpactMetric"
+                    }
+                },
+                "project_count": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "project.MetricKey": {
+            "description": "Kind of project metric",
+            "type": "string",
+            "enum": [
+                "users",
+                "stars",
+                "downloads",
+                "revenue_impact",
+                "cost_savings",
+                "performance",
+                "uptime"
+            ],
+            "x-enum-varnames": [
+                "MetricUsers",
+                "MetricStars",
+                "MetricDownloads",
+                "MetricRevenueImpact",
+                "MetricCostSavings",
+                "MetricPerformance",
+                "MetricUptime"
+            ]
+        },
+        "project.MetricUnit": {
+            "description": "Unit of a project metric value",
+            "type": "string",
+            "enum": [
+                "count",
+                "percent",
+                "ms",
+                "s",
+                "rps",
+                "x",
+                "usd",
+                "eur",
+                "idr"
+            ],
+            "x-enum-varnames": [
+                "UnitCount",
+                "UnitPercent",
+                "UnitMilliseconds",
+                "UnitSeconds",
+                "UnitRequests",
+                "UnitMultiplier",
+                "UnitUSD",
+                "UnitEUR",
+                "UnitIDR"
+            ]
+        },
+        "project.PhaseKind": {
+            "description": "Stage of a project timeline phase",
+            "type": "string",
+            "enum": [
+                "design",
+                "build",
+                "launch"
+            ],
+            "x-enum-varnames": [
+                "PhaseDesign",
+                "PhaseBuild",
+                "PhaseLaunch"
+            ]
+        },
+        "project.ProgressStatus": {
+            "description": "Current progress status of a project",
+            "type": "string",
+            "enum": [
+                "In Progress",
+                "In Revision",
+                "On Hold",
+                "Completed"
+            ],
+            "x-enum-varnames": [
+                "InProgress",
+                "InRevision",
+                "OnHold",
+                "Completed"
+            ]
+        },
+        "project.Project": {
+            "description": "Detailed information about a project",
+            "type": "object",
+            "required": [
+                "description",
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectCategory"
+                        }
+                    ],
+                    "example": "Web Development"
+                },
+                "client": {
+                    "$ref": "#/definitions/project.ProjectClient"
+                },
+                "created_at": {
+                    "description": "Metadata",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "development_status": {
+                    "description": "Status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.DevelopmentStatus"
+                        }
+                    ],
+                    "example": "Beta"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Responsive Design",
+                        "Dark Mode"
+                    ]
+                },
+                "github_url": {
+                    "description": "URLs",
+                    "type": "string",
+                    "example": "https://github.com/username/project"
+                },
+                "id": {
+                    "description": "Identification",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "images": {
+                    "description": "Project Content",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectImage"
+                    }
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectMetric"
+                    }
+                },
+                "my_role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Full-stack Developer",
+                        "UI/UX Designer"
+                    ]
+                },
+                "progress_percentage": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "progress_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProgressStatus"
+                        }
+                    ],
+                    "example": "In Progress"
+                },
+                "reading_time": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "seo": {
+                    "description": "Search engine directives",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/base.SEO"
+                        }
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Personal portfolio showcasing projects"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.TimelinePhase"
+                    }
+                },
+                "title": {
+                    "description": "Project Details",
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "toc": {
+                    "description": "Table of contents of the description, computed on save",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.Visibility"
+                        }
+                    ],
+                    "example": "public"
+                },
+                "web_url": {
+                    "type": "string",
+                    "example": "https://myportfolio.com"
+                },
+                "word_count": {
+                    "description": "Reading statistics of the case study, computed on save. ReadingTime\nis in minutes.",
+                    "type": "integer",
+                    "example": 840
+                }
+            }
+        },
+        "project.ProjectCategory": {
+            "description": "Category of the project",
+            "type": "string",
+            "enum": [
+                "Web Development",
+                "API Development",
+                "Bot Development",
+                "Mobile App",
+                "Desktop App",
+                "UI/UX Design",
+                "Other"
+            ],
+            "x-enum-varnames": [
+                "WebDevelopment",
+                "ApiDevelopment",
+                "BotDevelopment",
+                "MobileApp",
+                "DesktopApp",
+                "UIUX",
+                "Other"
+            ]
+        },
+        "project.ProjectClient": {
+            "description": "Client a project was built for",
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "description": "CompanyID links the client to a company, whose name, logo and\nindustry take precedence over the ones given here",
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "confidential": {
+                    "description": "Confidential hides everything but the industry of the client from\npublic responses",
+                    "type": "boolean",
+                    "example": false
+                },
+                "industry": {
+                    "type": "string",
+                    "example": "Fintech"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/acme-logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acme Corp"
+                },
+                "testimonial_url": {
+                    "description": "TestimonialURL links to what the client said about the project",
+                    "type": "string",
+                    "example": "https://www.linkedin.com/posts/acme-testimonial"
+                }
+            }
+        },
+        "project.ProjectCreate": {
+            "description": "Input model for creating a new project",
+            "type": "object",
+            "required": [
+                "description",
+                "slug",
+                "tech_stack_ids",
+                "title"
+            ],
+            "properties": {
+                "allow_duplicate": {
+                    "description": "AllowDuplicate creates the project even when one with the same title\nexists and duplicates are blocked",
+                    "type": "boolean",
+                    "example": false
+                },
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectCategory"
+                        }
+                    ],
+                    "example": "Web Development"
+                },
+                "client": {
+                    "description": "Client optionally credits who the project was built for",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectClient"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "development_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.DevelopmentStatus"
+                        }
+                    ],
+                    "example": "Beta"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Responsive Design",
+                        "Dark Mode"
+                    ]
+                },
+                "github_url": {
+                    "type": "string",
+                    "example": "https://github.com/username/project"
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectMetric"
+                    }
+                },
+                "my_role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Full-stack Developer",
+                        "UI/UX Designer"
+                    ]
+                },
+                "progress_percentage": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "progress_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProgressStatus"
+                        }
+                    ],
+                    "example": "In Progress"
+                },
+                "seo": {
+                    "$ref": "#/definitions/base.SEO"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Personal portfolio showcasing projects"
+                },
+                "tech_stack_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"550e8400-e29b-41d4-a716-446655440000\"]"
+                    ]
+                },
+                "timeline": {
+                    "description": "Timeline lists the design, build and launch phases in order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.TimelinePhase"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "visibility": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.Visibility"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "web_url": {
+                    "type": "string",
+                    "example": "https://myportfolio.com"
+                }
+            }
+        },
+        "project.ProjectImage": {
+            "description": "Image details for a project",
+            "type": "object",
+            "properties": {
+                "alt": {
+                    "type": "string",
+                    "example": "Project screenshot"
+                },
+                "dominant_color": {
+                    "description": "DominantColor is the most common color of the image as #rrggbb, used\nto tint the background while the image loads",
+                    "type": "string",
+                    "example": "#1e293b"
+                },
+                "is_thumbnail": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "placeholder": {
+                    "description": "Placeholder is a tiny copy of the image as a data URI, shown while the\nimage loads",
+                    "type": "string",
+                    "example": "data:image/png;base64,iVBORw0KGgo="
+                },
+                "src": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                }
+            }
+        },
+        "project.ProjectMetric": {
+            "description": "Measured impact of a project, such as users or stars",
+            "type": "object",
+            "properties": {
+                "key": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.MetricKey"
+                        }
+                    ],
+                    "example": "users"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Monthly active users"
+                },
+                "unit": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.MetricUnit"
+                        }
+                    ],
+                    "example": "count"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 12000
+                }
+            }
+        },
+        "project.ProjectTimeline": {
+            "description": "Phases of a project laid out for a Gantt chart",
+            "type": "object",
+            "properties": {
+                "duration_days": {
+                    "description": "DurationDays spans from the first to the last day of the timeline",
+                    "type": "integer",
+                    "example": 114
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-04-30"
+                },
+                "phases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.GanttPhase"
+                    }
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-01-08"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "total_effort_hours": {
+                    "type": "number",
+                    "example": 260
+                }
+            }
+        },
+        "project.ProjectUpdate": {
+            "description": "Input model for updating an existing project",
+            "type": "object",
+            "required": [
+                "id",
+                "slug",
+                "tech_stack_ids"
+            ],
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectCategory"
+                        }
+                    ],
+                    "example": "Web Development"
+                },
+                "client": {
+                    "description": "Client replaces the project client when present; send one without a name or company to remove it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectClient"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "An improved responsive website to display my professional projects and skills"
+                },
+                "development_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.DevelopmentStatus"
+                        }
+                    ],
+                    "example": "Beta"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Responsive Design",
+                        "Dark Mode",
+                        "Performance Optimization"
+                    ]
+                },
+                "github_url": {
+                    "type": "string",
+                    "example": "https://github.com/username/updated-project"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "metrics": {
+                    "description": "Metrics replaces the project metrics when present; send an empty list to clear them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectMetric"
+                    }
+                },
+                "my_role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Full-stack Developer",
+                        "DevOps Engineer"
+                    ]
+                },
+                "progress_percentage": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "progress_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProgressStatus"
+                        }
+                    ],
+                    "example": "Completed"
+                },
+                "seo": {
+                    "description": "SEO replaces the search engine directives when present",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/base.SEO"
+                        }
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Updated personal portfolio showcasing projects"
+                },
+                "tech_stack_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"550e8400-e29b-41d4-a716-446655440000\"]"
+                    ]
+                },
+                "timeline": {
+                    "description": "Timeline replaces the project timeline when present; send an empty list to clear it",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.TimelinePhase"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Updated Portfolio Website"
+                },
+                "visibility": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.Visibility"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "web_url": {
+                    "type": "string",
+                    "example": "https://updated-myportfolio.com"
+                }
+            }
+        },
+        "project.PublicProject": {
+            "description": "Project as shown publicly",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectCategory"
+                        }
+                    ],
+                    "example": "Web Development"
+                },
+                "client": {
+                    "$ref": "#/definitions/project.ProjectClient"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "development_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.DevelopmentStatus"
+                        }
+                    ],
+                    "example": "Beta"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Responsive Design",
+                        "Dark Mode"
+                    ]
+                },
+                "github_url": {
+                    "type": "string",
+                    "example": "https://github.com/username/project"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectImage"
+                    }
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectMetric"
+                    }
+                },
+                "my_role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Full-stack Developer",
+                        "UI/UX Designer"
+                    ]
+                },
+                "progress_percentage": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "progress_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProgressStatus"
+                        }
+                    ],
+                    "example": "In Progress"
+                },
+                "project_tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.PublicProjectTechStack"
+                    }
+                },
+                "reading_time": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "seo": {
+                    "$ref": "#/definitions/base.SEO"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.SeriesNav"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Personal portfolio showcasing projects"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.TimelinePhase"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "toc": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "web_url": {
+                    "type": "string",
+                    "example": "https://myportfolio.com"
+                },
+                "word_count": {
+                    "type": "integer",
+                    "example": 840
+                }
+            }
+        },
+        "project.PublicProjectTechStack": {
+            "description": "Tech stack of a project as shown publicly",
+            "type": "object",
+            "properties": {
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "tech_stack": {
+                    "$ref": "#/definitions/tech_stack.PublicTechStack"
+                },
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
+                }
+            }
+        },
+        "project.SeriesItem": {
+            "description": "Neighbour of a project in a series",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                }
+            }
+        },
+        "project.SeriesNav": {
+            "description": "Place of a project within a series, with its neighbours",
+            "type": "object",
+            "properties": {
+                "collection_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "next": {
+                    "$ref": "#/definitions/project.SeriesItem"
+                },
+                "position": {
+                    "description": "Position is the 1-based place of the project among Total projects",
+                    "type": "integer",
+                    "example": 2
+                },
+                "prev": {
+                    "description": "Prev and Next are the nearest public projects before and after it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.SeriesItem"
+                        }
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-this-portfolio"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building this portfolio"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "project.ShareLink": {
+            "description": "Signed link giving read access to a project",
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "1735689600.9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://itsrama.dev/projects/550e8400-e29b-41d4-a716-446655440000/preview?token=1735689600.9f86d0"
+                }
+            }
+        },
+        "project.ShareLinkCreate": {
+            "description": "Input model for creating a project share link",
+            "type": "object",
+            "properties": {
+                "expires_in_hours": {
+                    "description": "ExpiresInHours is how long the link works; the configured default when 0",
+                    "type": "integer",
+                    "maximum": 2160,
+                    "minimum": 0,
+                    "example": 72
+                }
+            }
+        },
+        "project.TimelinePhase": {
+            "description": "Dated stage of a project with the effort spent on it",
+            "type": "object",
+            "properties": {
+                "effort_hours": {
+                    "type": "number",
+                    "example": 120
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-03-15"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.PhaseKind"
+                        }
+                    ],
+                    "example": "build"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Backend API"
+                },
+                "start_date": {
+                    "description": "StartDate and EndDate are inclusive. Only the last phase may leave\nEndDate out while it is still ongoing.",
+                    "type": "string",
+                    "example": "2024-02-01"
+                }
+            }
+        },
+        "project.Visibility": {
+            "description": "Visibility of a project",
+            "type": "string",
+            "enum": [
+                "public",
+                "unlisted",
+                "draft"
+            ],
+            "x-enum-varnames": [
+                "VisibilityPublic",
+                "VisibilityUnlisted",
+                "VisibilityDraft"
+            ]
+        },
+        "public_stats.PublicStats": {
+            "description": "Curated numbers computed from the portfolio content and its traffic",
+            "type": "object",
+            "properties": {
+                "computed_at": {
+                    "type": "string",
+                    "example": "2026-01-15T10:00:00Z"
+                },
+                "projects_shipped": {
+                    "description": "ProjectsShipped counts the public projects marked completed",
+                    "type": "integer",
+                    "example": 24
+                },
+                "technologies_used": {
+                    "description": "TechnologiesUsed counts the distinct tech stacks of public projects\nand experiences",
+                    "type": "integer",
+                    "example": 32
+                },
+                "total_views": {
+                    "description": "TotalViews counts every page view ever recorded",
+                    "type": "integer",
+                    "example": 58210
+                },
+                "years_of_experience": {
+                    "description": "YearsOfExperience is the time worked in whole years, counting\noverlapping roles once",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "recruiter.JobDescriptionMatch": {
+            "type": "object",
+            "required": [
+                "description"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 20000,
+                    "minLength": 20,
+                    "example": "We are looking for a backend engineer with Go, PostgreSQL and Kubernetes experience."
+                },
+                "limit": {
+                    "description": "Limit is the number of projects and experiences suggested, 5 when empty",
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 0,
+                    "example": 5
+                }
+            }
+        },
+        "recruiter.PublicJobMatch": {
+            "type": "object",
+            "properties": {
+                "coverage": {
+                    "type": "number",
+                    "example": 66.7
+                },
+                "experiences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicRankedExperience"
+                    }
+                },
+                "matched": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicMatchedSkill"
+                    }
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Kubernetes"
+                    ]
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicRankedProject"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Go",
+                        "PostgreSQL",
+                        "Kubernetes"
+                    ]
+                }
+            }
+        },
+        "recruiter.PublicMatchedSkill": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
+                        }
+                    ],
+                    "example": "Backend"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "endorsement_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
+                },
+                "image_url_dark": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo-dark.png"
+                },
+                "image_url_light": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
+                },
+                "is_core_skill": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Backend Development"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "used_in": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.20"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
+                }
+            }
+        },
+        "recruiter.PublicProfile": {
+            "type": "object",
+            "properties": {
+                "experiences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicRankedExperience"
+                    }
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "backend",
+                        "api",
+                        "go",
+                        "postgresql"
+                    ]
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicRankedProject"
+                    }
+                },
+                "role": {
+                    "type": "string",
+                    "example": "backend"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/recruiter.PublicRankedSkill"
+                    }
+                }
+            }
+        },
+        "recruiter.PublicRankedExperience": {
+            "type": "object",
+            "properties": {
+                "arrangement": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Tech Innovations Inc."
+                },
+                "company_id": {
+                    "type": "string",
+                    "example": "750e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2023-06-30"
+                },
+                "experience_tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/experience.PublicExperienceTechStack"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "image_alts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "image_placeholders": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "images_url": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://example.com/project1.png"
+                    ]
+                },
+                "impact": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Increased system performance by 40%"
+                    ]
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "Full-time"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "San Francisco, CA"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "example": "https://example.com/company-logo.png"
+                },
+                "matched": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "api",
+                        "go",
+                        "postgresql"
+                    ]
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2020-01-15"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_description": {
+                    "type": "string",
+                    "example": "Led development of scalable web applications"
+                }
+            }
+        },
+        "recruiter.PublicRankedProject": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProjectCategory"
+                        }
+                    ],
+                    "example": "Web Development"
+                },
+                "client": {
+                    "$ref": "#/definitions/project.ProjectClient"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "development_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.DevelopmentStatus"
+                        }
+                    ],
+                    "example": "Beta"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Responsive Design",
+                        "Dark Mode"
+                    ]
+                },
+                "github_url": {
+                    "type": "string",
+                    "example": "https://github.com/username/project"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectImage"
+                    }
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "matched": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "api",
+                        "go",
+                        "postgresql"
+                    ]
+                },
+                "metrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.ProjectMetric"
+                    }
+                },
+                "my_role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Full-stack Developer",
+                        "UI/UX Designer"
+                    ]
+                },
+                "progress_percentage": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "progress_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/project.ProgressStatus"
+                        }
+                    ],
+                    "example": "In Progress"
+                },
+                "project_tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.PublicProjectTechStack"
+                    }
+                },
+                "reading_time": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "score": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "seo": {
+                    "$ref": "#/definitions/base.SEO"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.SeriesNav"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "portfolio-website"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "Personal portfolio showcasing projects"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/project.TimelinePhase"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "toc": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markdown.Heading"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "web_url": {
+                    "type": "string",
+                    "example": "https://myportfolio.com"
+                },
+                "word_count": {
+                    "type": "integer",
+                    "example": 840
+                }
+            }
+        },
+        "recruiter.PublicRankedSkill": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
+                        }
+                    ],
+                    "example": "Backend"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "endorsement_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
+                },
+                "image_url_dark": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo-dark.png"
+                },
+                "image_url_light": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
+                },
+                "is_core_skill": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
+                },
+                "matched": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "api",
+                        "go",
+                        "postgresql"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Backend Development"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.20"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
                 }
             }
-        }
-    },
-    "definitions": {
-        "experience.Experience": {
-            "description": "Detailed information about a professional work experience",
+        },
+        "redirect.Redirect": {
+            "description": "Rule sending requests for an old path to a new URL",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "from_path": {
+                    "type": "string",
+                    "example": "/blog/*"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 301
+                },
+                "to_url": {
+                    "type": "string",
+                    "example": "/articles/*"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "redirect.RedirectCreate": {
             "type": "object",
             "required": [
-                "company",
-                "role",
-                "start_date"
+                "from_path",
+                "to_url"
             ],
             "properties": {
-                "arrangement": {
+                "from_path": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/blog/*"
+                },
+                "status_code": {
+                    "description": "StatusCode is 301, 302, 307 or 308, 301 when left empty",
+                    "type": "integer",
+                    "example": 301
+                },
+                "to_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/articles/*"
+                }
+            }
+        },
+        "redirect.RedirectUpdate": {
+            "type": "object",
+            "properties": {
+                "from_path": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/blog/*"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 308
+                },
+                "to_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/articles/*"
+                }
+            }
+        },
+        "redirect.Resolution": {
+            "description": "Where a path redirects to",
+            "type": "object",
+            "properties": {
+                "location": {
+                    "type": "string",
+                    "example": "/articles/hello-world"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/blog/hello-world"
+                },
+                "redirect_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 301
+                }
+            }
+        },
+        "response.APIResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Actual response data"
+                },
+                "error": {
+                    "description": "Detailed error information (only populated for error responses)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.ErrorDetails"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Human-readable message",
+                    "type": "string"
+                },
+                "metadata": {
+                    "description": "Additional metadata",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "pagination": {
+                    "description": "Pagination information (optional)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.Pagination"
+                        }
+                    ]
+                },
+                "request_id": {
+                    "description": "Unique request identifier for tracing",
+                    "type": "string"
+                },
+                "success": {
+                    "description": "Status of the response (success/error)",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "description": "Timestamp of the response",
+                    "type": "string"
+                }
+            }
+        },
+        "response.ErrorDetails": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Machine-readable error code",
+                    "type": "string"
+                },
+                "details": {
+                    "description": "Detailed error description",
+                    "type": "string"
+                },
+                "recoverable": {
+                    "description": "Indicates if the error is potentially recoverable",
+                    "type": "boolean"
+                },
+                "trace": {
+                    "description": "Trace information for debugging",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "response.Pagination": {
+            "type": "object",
+            "properties": {
+                "has_next_page": {
+                    "type": "boolean"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "per_page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "search.IndexReport": {
+            "description": "Result of re-embedding the searchable content",
+            "type": "object",
+            "properties": {
+                "embedded": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "indexed": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "indexed_at": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "gemini/text-embedding-004"
+                },
+                "removed": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "search.Result": {
+            "description": "Content matching a semantic search, most similar first",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "score": {
+                    "description": "Score is the cosine similarity between the query and the content",
+                    "type": "number",
+                    "example": 0.82
+                },
+                "snippet": {
+                    "type": "string",
+                    "example": "A responsive website to display my professional projects and skills"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/search.SourceType"
+                        }
+                    ],
+                    "example": "project"
+                }
+            }
+        },
+        "search.SourceType": {
+            "type": "string",
+            "enum": [
+                "project",
+                "experience"
+            ],
+            "x-enum-varnames": [
+                "SourceProject",
+                "SourceExperience"
+            ]
+        },
+        "site_config.Icon": {
+            "description": "Icon of the site",
+            "type": "object",
+            "properties": {
+                "sizes": {
                     "type": "string",
-                    "example": "Remote"
+                    "example": "32x32"
                 },
-                "company": {
+                "src": {
                     "type": "string",
-                    "example": "Tech Innovations Inc."
+                    "example": "https://example.com/site/icons/favicon-32x32.png"
                 },
-                "created_at": {
-                    "type": "string"
-                },
-                "end_date": {
+                "type": {
                     "type": "string",
-                    "example": "2023-06-30"
+                    "example": "image/png"
+                }
+            }
+        },
+        "site_config.IconSet": {
+            "description": "Favicon, app icons and web app manifest of the site",
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string",
+                    "example": "https://example.com/site/avatar.png"
                 },
-                "id": {
-                    "description": "Identification\n@Description Unique identifier for the experience",
+                "favicon_url": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "https://example.com/site/icons/favicon.ico"
                 },
-                "images_url": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "https://example.com/project1.png"
-                    ]
+                "generated_at": {
+                    "type": "string"
                 },
-                "impact": {
+                "icons": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Increased system performance by 40%"
-                    ]
-                },
-                "is_featured": {
-                    "description": "Metadata\n@Description Additional metadata for the experience",
-                    "type": "boolean",
-                    "example": true
-                },
-                "job_type": {
-                    "type": "string",
-                    "example": "Full-time"
+                        "$ref": "#/definitions/site_config.Icon"
+                    }
                 },
-                "location": {
+                "manifest_url": {
                     "type": "string",
-                    "example": "San Francisco, CA"
+                    "example": "https://example.com/site/icons/manifest.json"
                 },
-                "logo_url": {
+                "version": {
                     "type": "string",
-                    "example": "https://example.com/company-logo.png"
+                    "example": "3f2a9c1b7e4d8a60"
+                }
+            }
+        },
+        "site_config.NavItem": {
+            "description": "Navigation entry, optionally with nested children",
+            "type": "object",
+            "required": [
+                "href",
+                "label"
+            ],
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/site_config.NavItem"
+                    }
                 },
-                "role": {
-                    "description": "Job Details\n@Description Job role and company information",
-                    "type": "string",
-                    "example": "Senior Software Engineer"
+                "external": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "start_date": {
-                    "description": "Timing and Location\n@Description Job timing and location details",
+                "href": {
                     "type": "string",
-                    "example": "2020-01-15"
-                },
-                "updated_at": {
-                    "type": "string"
+                    "example": "/projects"
                 },
-                "work_description": {
-                    "description": "Job Description\n@Description Detailed description of work and achievements",
+                "label": {
                     "type": "string",
-                    "example": "Led development of scalable web applications"
+                    "example": "Projects"
                 }
             }
         },
-        "experience.ExperienceCreate": {
-            "description": "Input model for creating a new experience",
+        "site_config.SEODefaults": {
+            "description": "Fallback SEO metadata of a site",
             "type": "object",
             "properties": {
-                "arrangement": {
-                    "description": "@Description Work arrangement\n@Enums [\"Remote\", \"Hybrid\", \"On-site\"]",
-                    "type": "string",
-                    "example": "Remote"
-                },
-                "company": {
-                    "description": "@Description Company name\n@Format string",
+                "canonical_url": {
                     "type": "string",
-                    "example": "Tech Innovations Inc."
+                    "example": "https://itsrama.kawasan.digital"
                 },
-                "end_date": {
-                    "description": "@Description End date of the job (optional)\n@Format date",
+                "description": {
                     "type": "string",
-                    "example": "2023-06-30"
+                    "example": "Portfolio of Muhamad Ramadhan"
                 },
-                "impact": {
-                    "description": "@Description Key impacts or achievements",
+                "keywords": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "Increased system performance by 40%"
+                        "portfolio",
+                        "developer"
                     ]
                 },
-                "is_featured": {
-                    "description": "@Description Flag to mark as featured experience",
-                    "type": "boolean",
-                    "example": true
+                "og_image": {
+                    "type": "string",
+                    "example": "https://example.com/og.png"
                 },
-                "job_type": {
-                    "description": "@Description Job type\n@Enums [\"Full-time\", \"Part-time\", \"Contract\", \"Freelance\"]",
+                "title": {
                     "type": "string",
-                    "example": "Full-time"
+                    "example": "Itsrama Portfolio"
                 },
-                "location": {
-                    "description": "@Description Job location\n@Format string",
+                "title_format": {
                     "type": "string",
-                    "example": "San Francisco, CA"
+                    "example": "%s | Itsrama"
                 },
-                "role": {
-                    "description": "@Description Job role\n@Format string",
+                "twitter_card": {
                     "type": "string",
-                    "example": "Senior Software Engineer"
+                    "example": "summary_large_image"
+                }
+            }
+        },
+        "site_config.SiteConfig": {
+            "description": "Theme, navigation, section toggles and SEO defaults of a site",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "start_date": {
-                    "description": "@Description Start date of the job\n@Format date",
+                "icons": {
+                    "$ref": "#/definitions/site_config.IconSet"
+                },
+                "id": {
                     "type": "string",
-                    "example": "2020-01-15"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "tech_stack_ids": {
-                    "description": "@Description IDs of associated tech stacks\n@Enums [\"550e8400-e29b-41d4-a716-446655440000\", \"650f9500-f39c-52d5-b827-557766550001\"]",
+                "navigation": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/site_config.NavItem"
                     }
                 },
-                "work_description": {
-                    "description": "@Description Work description\n@Format string",
-                    "type": "string",
-                    "example": "Led development of scalable web applications"
+                "sections": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "seo": {
+                    "$ref": "#/definitions/site_config.SEODefaults"
+                },
+                "theme": {
+                    "$ref": "#/definitions/site_config.ThemeConfig"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "experience.ExperienceUpdate": {
-            "description": "Input model for updating an existing experience",
+        "site_config.SiteConfigUpdate": {
             "type": "object",
             "properties": {
-                "arrangement": {
-                    "description": "@Description Work arrangement\n@Enums [\"Remote\", \"Hybrid\", \"On-site\"]",
+                "navigation": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/site_config.NavItem"
+                    }
+                },
+                "sections": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "seo": {
+                    "$ref": "#/definitions/site_config.SEODefaults"
+                },
+                "theme": {
+                    "$ref": "#/definitions/site_config.ThemeConfig"
+                }
+            }
+        },
+        "site_config.ThemeConfig": {
+            "description": "Theme colors and typography of a site",
+            "type": "object",
+            "properties": {
+                "accent_color": {
                     "type": "string",
-                    "example": "Remote"
+                    "example": "#f97316"
                 },
-                "company": {
-                    "description": "@Description Company name\n@Format string",
+                "background_color": {
                     "type": "string",
-                    "example": "Tech Innovations Inc."
+                    "example": "#ffffff"
                 },
-                "end_date": {
-                    "description": "@Description End date of the job (optional)\n@Format date",
+                "default_mode": {
                     "type": "string",
-                    "example": "2023-06-30"
+                    "example": "system"
                 },
-                "id": {
-                    "description": "@Description Unique identifier of the experience to update",
+                "font_family": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "Inter"
                 },
-                "impact": {
-                    "description": "@Description Key impacts or achievements",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Increased system performance by 40%"
-                    ]
+                "primary_color": {
+                    "type": "string",
+                    "example": "#0f172a"
                 },
-                "is_featured": {
-                    "description": "@Description Flag to mark as featured experience",
-                    "type": "boolean",
-                    "example": true
+                "secondary_color": {
+                    "type": "string",
+                    "example": "#38bdf8"
                 },
-                "job_type": {
-                    "description": "@Description Job type\n@Enums [\"Full-time\", \"Part-time\", \"Contract\", \"Freelance\"]",
+                "text_color": {
                     "type": "string",
-                    "example": "Full-time"
+                    "example": "#111827"
+                }
+            }
+        },
+        "slowcall.Stat": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "location": {
-                    "description": "@Description Job location\n@Format string",
+                "kind": {
                     "type": "string",
-                    "example": "San Francisco, CA"
+                    "example": "query"
                 },
-                "role": {
-                    "description": "@Description Job role\n@Format string",
+                "last_details": {
+                    "description": "LastDetails are the details of the most recent slow call, such as the\nquery filters involved",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "operation": {
                     "type": "string",
-                    "example": "Senior Software Engineer"
+                    "example": "GET tech_stack"
                 },
-                "start_date": {
-                    "description": "@Description Start date of the job\n@Format date",
+                "slowest_ns": {
+                    "type": "integer",
+                    "example": 812000000
+                },
+                "total_ns": {
+                    "type": "integer",
+                    "example": 5400000000
+                }
+            }
+        },
+        "storage_usage.ModuleUsage": {
+            "description": "Storage used by the files of one module",
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "type": "integer",
+                    "example": 73400320
+                },
+                "files": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "module": {
                     "type": "string",
-                    "example": "2020-01-15"
+                    "example": "itsrama/images/project"
+                }
+            }
+        },
+        "storage_usage.Usage": {
+            "description": "Storage used per module and against the quota",
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "integer",
+                    "example": 64
                 },
-                "tech_stack_ids": {
-                    "description": "@Description IDs of associated tech stacks\n@Enums [\"550e8400-e29b-41d4-a716-446655440000\", \"650f9500-f39c-52d5-b827-557766550001\"]",
+                "modules": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/storage_usage.ModuleUsage"
                     }
                 },
-                "work_description": {
-                    "description": "@Description Work description\n@Format string",
-                    "type": "string",
-                    "example": "Led development of scalable web applications"
+                "quota_bytes": {
+                    "description": "QuotaBytes is 0 when no quota is enforced",
+                    "type": "integer",
+                    "example": 1073741824
+                },
+                "remaining_bytes": {
+                    "type": "integer",
+                    "example": 968884224
+                },
+                "total_bytes": {
+                    "type": "integer",
+                    "example": 104857600
+                },
+                "used_percent": {
+                    "type": "number",
+                    "example": 9.77
                 }
             }
         },
-        "health.CPUHealth": {
+        "tech_stack.ProficiencyPoint": {
             "type": "object",
             "properties": {
-                "cores": {
-                    "type": "integer"
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "recorded_at": {
+                    "type": "string"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
+                }
+            }
+        },
+        "tech_stack.ProficiencySeries": {
+            "description": "Time series of a skill's proficiency for growth charts",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
+                        }
+                    ],
+                    "example": "Backend"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tech_stack.ProficiencyPoint"
+                    }
                 },
-                "loadAverage": {
-                    "type": "number"
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
                 }
             }
         },
-        "health.DatabaseHealth": {
+        "tech_stack.PublicTechStack": {
+            "description": "Tech stack as shown publicly",
             "type": "object",
             "properties": {
-                "connected": {
-                    "type": "boolean"
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
+                        }
+                    ],
+                    "example": "Backend"
                 },
-                "error": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "health.HealthStatus": {
-            "type": "object",
-            "properties": {
-                "cpu": {
-                    "$ref": "#/definitions/health.CPUHealth"
                 },
-                "database": {
-                    "$ref": "#/definitions/health.DatabaseHealth"
+                "endorsement_count": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "memory": {
-                    "$ref": "#/definitions/health.MemoryHealth"
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "status": {
-                    "type": "string"
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
                 },
-                "system": {
-                    "$ref": "#/definitions/health.SystemInfo"
+                "image_url_dark": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo-dark.png"
                 },
-                "timestamp": {
-                    "type": "string"
-                }
-            }
-        },
-        "health.MemoryHealth": {
-            "type": "object",
-            "properties": {
-                "free": {
-                    "type": "integer"
+                "image_url_light": {
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
                 },
-                "total": {
-                    "type": "integer"
+                "is_core_skill": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "used": {
-                    "type": "integer"
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
                 },
-                "usedPercent": {
-                    "type": "number"
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "role": {
+                    "type": "string",
+                    "example": "Backend Development"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.20"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
                 }
             }
         },
-        "health.SystemInfo": {
+        "tech_stack.Reference": {
+            "description": "Link from content to a tech stack",
             "type": "object",
             "properties": {
-                "goVersion": {
-                    "type": "string"
+                "entity": {
+                    "type": "string",
+                    "example": "project"
                 },
-                "numCPU": {
-                    "type": "integer"
+                "entity_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "numGoroutine": {
-                    "type": "integer"
+                "entity_name": {
+                    "type": "string",
+                    "example": "Portfolio Website"
+                },
+                "tech_stack_id": {
+                    "type": "string",
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
                 }
             }
         },
-        "project.DevelopmentStatus": {
-            "description": "Development status of a project",
-            "type": "string",
-            "enum": [
-                "Alpha",
-                "Beta",
-                "MVP"
-            ],
-            "x-enum-varnames": [
-                "Alpha",
-                "Beta",
-                "MVP"
-            ]
-        },
-        "project.ProgressStatus": {
-            "description": "Current progress status of a project",
-            "type": "string",
-            "enum": [
-                "In Progress",
-                "In Revision",
-                "On Hold",
-                "Completed"
-            ],
-            "x-enum-varnames": [
-                "InProgress",
-                "InRevision",
-                "OnHold",
-                "Completed"
-            ]
-        },
-        "project.Project": {
-            "description": "Detailed information about a project",
+        "tech_stack.TechStack": {
+            "description": "Technology stack information with details about skills and technologies",
             "type": "object",
             "required": [
-                "description",
-                "slug",
-                "title"
+                "name"
             ],
             "properties": {
                 "category": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/project.ProjectCategory"
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
                         }
                     ],
-                    "example": "Web Development"
+                    "example": "Backend"
                 },
                 "created_at": {
-                    "description": "Metadata",
                     "type": "string"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "A responsive website to display my professional projects and skills"
-                },
-                "development_status": {
-                    "description": "Status",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.DevelopmentStatus"
-                        }
-                    ],
-                    "example": "Beta"
+                "endorsement_count": {
+                    "description": "EndorsementCount is maintained by the database and never written by the API",
+                    "type": "integer",
+                    "example": 12
                 },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Responsive Design",
-                        "Dark Mode"
-                    ]
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "github_url": {
-                    "description": "URLs",
+                "image_url": {
                     "type": "string",
-                    "example": "https://github.com/username/project"
+                    "example": "https://example.com/go-logo.png"
                 },
-                "id": {
-                    "description": "Identification",
+                "image_url_dark": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "https://example.com/go-logo-dark.png"
                 },
-                "images": {
-                    "description": "Project Content",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/project.ProjectImage"
-                    }
+                "image_url_light": {
+                    "description": "ImageUrlLight and ImageUrlDark are the logo variants for light and dark\nbackgrounds, and LogoPreference the variant frontends should show",
+                    "type": "string",
+                    "example": "https://example.com/go-logo.png"
                 },
-                "is_featured": {
+                "is_core_skill": {
                     "type": "boolean",
                     "example": true
                 },
-                "my_role": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Full-stack Developer",
-                        "UI/UX Designer"
-                    ]
+                "logo_preference": {
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/asset.LogoPreference"
+                        }
+                    ],
+                    "example": "auto"
                 },
-                "progress_percentage": {
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "proficiency_level": {
+                    "description": "Proficiency from 1 (beginner) to 5 (expert), 0 when not rated",
                     "type": "integer",
-                    "example": 75
+                    "example": 4
                 },
-                "progress_status": {
+                "role": {
+                    "type": "string",
+                    "example": "Backend Development"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.20"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
+                }
+            }
+        },
+        "tech_stack.TechStackCategory": {
+            "type": "string",
+            "enum": [
+                "Backend",
+                "Frontend",
+                "Frameworks",
+                "Version Control",
+                "Database",
+                "DevOps",
+                "Tools",
+                "CMS \u0026 Platforms"
+            ],
+            "x-enum-varnames": [
+                "CategoryBackend",
+                "CategoryFrontend",
+                "CategoryFrameworks",
+                "CategoryVersionControl",
+                "CategoryDatabase",
+                "CategoryDevOps",
+                "CategoryTools",
+                "CategoryCMSPlatforms"
+            ]
+        },
+        "tech_stack.TechStackCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "category": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/project.ProgressStatus"
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
                         }
                     ],
-                    "example": "In Progress"
+                    "example": "Backend"
+                },
+                "icon_slug": {
+                    "description": "IconSlug overrides the icon slug derived from the name when no image is uploaded",
+                    "type": "string",
+                    "example": "python"
+                },
+                "is_core_skill": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "slug": {
+                "logo_preference": {
                     "type": "string",
-                    "example": "portfolio-website"
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "example": "auto"
                 },
-                "subtitle": {
+                "name": {
                     "type": "string",
-                    "example": "Personal portfolio showcasing projects"
+                    "example": "Python"
                 },
-                "title": {
-                    "description": "Project Details",
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "role": {
                     "type": "string",
-                    "example": "Portfolio Website"
+                    "example": "Data Science"
                 },
-                "updated_at": {
-                    "type": "string"
+                "skip_icon_fetch": {
+                    "description": "SkipIconFetch disables fetching a logo when no image is uploaded",
+                    "type": "boolean",
+                    "example": false
                 },
-                "web_url": {
+                "version": {
                     "type": "string",
-                    "example": "https://myportfolio.com"
+                    "example": "3.9"
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 2
                 }
             }
         },
-        "project.ProjectCategory": {
-            "description": "Category of the project",
-            "type": "string",
-            "enum": [
-                "Web Development",
-                "API Development",
-                "Bot Development",
-                "Mobile App",
-                "Desktop App",
-                "UI/UX Design",
-                "Other"
-            ],
-            "x-enum-varnames": [
-                "WebDevelopment",
-                "ApiDevelopment",
-                "BotDevelopment",
-                "MobileApp",
-                "DesktopApp",
-                "UIUX",
-                "Other"
-            ]
-        },
-        "project.ProjectCreate": {
-            "description": "Input model for creating a new project",
+        "tech_stack.TechStackUpdate": {
             "type": "object",
             "required": [
-                "description",
-                "slug",
-                "tech_stack_ids",
-                "title"
+                "id"
             ],
             "properties": {
                 "category": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/project.ProjectCategory"
-                        }
-                    ],
-                    "example": "Web Development"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "A responsive website to display my professional projects and skills"
-                },
-                "development_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.DevelopmentStatus"
+                            "$ref": "#/definitions/tech_stack.TechStackCategory"
                         }
                     ],
-                    "example": "Beta"
-                },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Responsive Design",
-                        "Dark Mode"
-                    ]
+                    "example": "Backend"
                 },
-                "github_url": {
+                "id": {
                     "type": "string",
-                    "example": "https://github.com/username/project"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "is_featured": {
+                "is_core_skill": {
                     "type": "boolean",
                     "example": true
                 },
-                "my_role": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Full-stack Developer",
-                        "UI/UX Designer"
-                    ]
+                "logo_preference": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "light",
+                        "dark"
+                    ],
+                    "example": "dark"
                 },
-                "progress_percentage": {
+                "name": {
+                    "type": "string",
+                    "example": "Rust"
+                },
+                "proficiency_level": {
                     "type": "integer",
-                    "example": 75
+                    "example": 5
                 },
-                "progress_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.ProgressStatus"
-                        }
-                    ],
-                    "example": "In Progress"
+                "role": {
+                    "type": "string",
+                    "example": "Systems Programming"
                 },
-                "slug": {
+                "version": {
                     "type": "string",
-                    "example": "portfolio-website"
+                    "example": "1.65"
                 },
-                "subtitle": {
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "tenant.DomainChallenge": {
+            "type": "object",
+            "properties": {
+                "domain": {
                     "type": "string",
-                    "example": "Personal portfolio showcasing projects"
+                    "example": "portfolio.example.com"
                 },
-                "tech_stack_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"550e8400-e29b-41d4-a716-446655440000\"]"
-                    ]
+                "is_verified": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "title": {
+                "record_name": {
                     "type": "string",
-                    "example": "Portfolio Website"
+                    "example": "_itsrama-verify.portfolio.example.com"
                 },
-                "web_url": {
+                "record_type": {
                     "type": "string",
-                    "example": "https://myportfolio.com"
+                    "example": "TXT"
+                },
+                "record_value": {
+                    "type": "string",
+                    "example": "itsrama-verification=3f1c9a0b7e2d4c6a8b5e1f0d2c4a6b8e"
                 }
             }
         },
-        "project.ProjectImage": {
-            "description": "Image details for a project",
+        "tenant.Tenant": {
+            "description": "Portfolio site (tenant) hosted by the backend",
             "type": "object",
+            "required": [
+                "name",
+                "slug"
+            ],
             "properties": {
-                "alt": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string",
-                    "example": "Project screenshot"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "is_thumbnail": {
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "is_default": {
                     "type": "boolean",
                     "example": false
                 },
-                "src": {
+                "name": {
                     "type": "string",
-                    "example": "https://example.com/image.jpg"
+                    "example": "Itsrama"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "itsrama"
+                },
+                "storage_folder": {
+                    "type": "string",
+                    "example": "tenants/itsrama"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "project.ProjectUpdate": {
-            "description": "Input model for updating an existing project",
+        "tenant.TenantCreate": {
             "type": "object",
             "required": [
-                "id",
-                "slug",
-                "tech_stack_ids"
+                "name",
+                "slug"
             ],
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.ProjectCategory"
-                        }
-                    ],
-                    "example": "Web Development"
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "description": {
+                "name": {
                     "type": "string",
-                    "example": "An improved responsive website to display my professional projects and skills"
+                    "example": "Friend Portfolio"
                 },
-                "development_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.DevelopmentStatus"
-                        }
-                    ],
-                    "example": "Beta"
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "friend-portfolio"
                 },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Responsive Design",
-                        "Dark Mode",
-                        "Performance Optimization"
-                    ]
+                "storage_folder": {
+                    "type": "string",
+                    "example": "tenants/friend-portfolio"
+                }
+            }
+        },
+        "tenant.TenantDomain": {
+            "description": "Custom domain serving a tenant's portfolio",
+            "type": "object",
+            "required": [
+                "domain",
+                "tenant_id"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "github_url": {
+                "domain": {
                     "type": "string",
-                    "example": "https://github.com/username/updated-project"
+                    "example": "portfolio.example.com"
                 },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "is_featured": {
+                "is_verified": {
                     "type": "boolean",
-                    "example": true
+                    "example": false
                 },
-                "my_role": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Full-stack Developer",
-                        "DevOps Engineer"
-                    ]
+                "tenant_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "progress_percentage": {
-                    "type": "integer",
-                    "example": 100
+                "updated_at": {
+                    "type": "string"
+                },
+                "verification_token": {
+                    "type": "string",
+                    "example": "3f1c9a0b7e2d4c6a8b5e1f0d2c4a6b8e"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "tenant.TenantDomainCreate": {
+            "type": "object",
+            "required": [
+                "domain"
+            ],
+            "properties": {
+                "domain": {
+                    "type": "string",
+                    "maxLength": 253,
+                    "example": "portfolio.example.com"
+                }
+            }
+        },
+        "tenant.TenantUpdate": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "progress_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/project.ProgressStatus"
-                        }
-                    ],
-                    "example": "Completed"
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "slug": {
+                "name": {
                     "type": "string",
-                    "example": "portfolio-website"
+                    "example": "Friend Portfolio"
                 },
-                "subtitle": {
+                "storage_folder": {
                     "type": "string",
-                    "example": "Updated personal portfolio showcasing projects"
-                },
-                "tech_stack_ids": {
+                    "example": "tenants/friend-portfolio"
+                }
+            }
+        },
+        "uses.Category": {
+            "description": "Items of a /uses category",
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"550e8400-e29b-41d4-a716-446655440000\"]"
-                    ]
-                },
-                "title": {
-                    "type": "string",
-                    "example": "Updated Portfolio Website"
+                        "$ref": "#/definitions/uses.Item"
+                    }
                 },
-                "web_url": {
+                "name": {
                     "type": "string",
-                    "example": "https://updated-myportfolio.com"
+                    "example": "Hardware"
                 }
             }
         },
-        "response.APIResponse": {
+        "uses.Item": {
+            "description": "Gear, software or tool I use",
             "type": "object",
             "properties": {
-                "data": {
-                    "description": "Actual response data"
-                },
-                "error": {
-                    "description": "Detailed error information (only populated for error responses)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/response.ErrorDetails"
-                        }
-                    ]
+                "category": {
+                    "type": "string",
+                    "example": "Hardware"
                 },
-                "message": {
-                    "description": "Human-readable message",
+                "created_at": {
                     "type": "string"
                 },
-                "metadata": {
-                    "description": "Additional metadata",
-                    "type": "object",
-                    "additionalProperties": true
+                "description": {
+                    "type": "string",
+                    "example": "M3 Pro, 36 GB. My daily driver."
                 },
-                "pagination": {
-                    "description": "Pagination information (optional)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/response.Pagination"
-                        }
-                    ]
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "request_id": {
-                    "description": "Unique request identifier for tracing",
-                    "type": "string"
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/macbook.png"
                 },
-                "success": {
-                    "description": "Status of the response (success/error)",
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "example": "MacBook Pro 14\""
                 },
-                "timestamp": {
-                    "description": "Timestamp of the response",
+                "position": {
+                    "description": "Position orders items on the page, lowest first",
+                    "type": "integer",
+                    "example": 0
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://www.apple.com/macbook-pro/"
                 }
             }
         },
-        "response.ErrorDetails": {
+        "uses.ItemOrder": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "Machine-readable error code",
-                    "type": "string"
-                },
-                "details": {
-                    "description": "Detailed error description",
-                    "type": "string"
-                },
-                "recoverable": {
-                    "description": "Indicates if the error is potentially recoverable",
-                    "type": "boolean"
-                },
-                "trace": {
-                    "description": "Trace information for debugging",
+                "ids": {
+                    "description": "IDs lists items in their new order; items left out keep their position after them",
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
                 }
             }
         },
-        "response.Pagination": {
+        "webmention.Mention": {
+            "description": "Webmention received for a project page",
             "type": "object",
             "properties": {
-                "has_next_page": {
-                    "type": "boolean"
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "per_page": {
-                    "type": "integer"
-                },
-                "total": {
-                    "type": "integer"
+                "author_name": {
+                    "type": "string",
+                    "example": "Jane Doe"
                 },
-                "total_pages": {
-                    "type": "integer"
-                }
-            }
-        },
-        "tech_stack.TechStack": {
-            "description": "Technology stack information with details about skills and technologies",
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tech_stack.TechStackCategory"
-                        }
-                    ],
-                    "example": "Backend"
+                "author_url": {
+                    "type": "string",
+                    "example": "https://blog.example.com"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "One of the cleanest portfolios I have seen this year."
+                },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "image_url": {
+                "pending": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "project_id": {
                     "type": "string",
-                    "example": "https://example.com/go-logo.png"
+                    "example": "650f9500-f39c-52d5-b827-557766550001"
                 },
-                "is_core_skill": {
-                    "type": "boolean",
-                    "example": true
+                "received_at": {
+                    "type": "string"
                 },
-                "name": {
+                "source": {
                     "type": "string",
-                    "example": "Go"
+                    "example": "https://blog.example.com/posts/portfolio-review"
                 },
-                "role": {
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/webmention.MentionStatus"
+                        }
+                    ],
+                    "example": "verified"
+                },
+                "target": {
                     "type": "string",
-                    "example": "Backend Development"
+                    "example": "https://itsrama.kawasan.digital/projects/portfolio-website"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Reviewing developer portfolios"
                 },
                 "updated_at": {
                     "type": "string"
                 },
-                "version": {
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "webmention.MentionReceipt": {
+            "description": "Acknowledgement of a Webmention queued for verification",
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string",
-                    "example": "1.20"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/webmention.MentionStatus"
+                        }
+                    ],
+                    "example": "new"
                 }
             }
         },
-        "tech_stack.TechStackCategory": {
+        "webmention.MentionStatus": {
+            "description": "Verification state of a received Webmention",
             "type": "string",
             "enum": [
-                "Backend",
-                "Frontend",
-                "Frameworks",
-                "Version Control",
-                "Database",
-                "DevOps",
-                "Tools",
-                "CMS \u0026 Platforms"
+                "new",
+                "verified",
+                "rejected",
+                "deleted"
             ],
             "x-enum-varnames": [
-                "CategoryBackend",
-                "CategoryFrontend",
-                "CategoryFrameworks",
-                "CategoryVersionControl",
-                "CategoryDatabase",
-                "CategoryDevOps",
-                "CategoryTools",
-                "CategoryCMSPlatforms"
+                "MentionNew",
+                "MentionVerified",
+                "MentionRejected",
+                "MentionDeleted"
             ]
         },
-        "tech_stack.TechStackCreate": {
+        "webmention.PublicMention": {
+            "description": "Verified Webmention of a project",
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tech_stack.TechStackCategory"
-                        }
-                    ],
-                    "example": "Backend"
+                "author_name": {
+                    "type": "string",
+                    "example": "Jane Doe"
                 },
-                "is_core_skill": {
-                    "type": "boolean",
-                    "example": true
+                "author_url": {
+                    "type": "string",
+                    "example": "https://blog.example.com"
                 },
-                "name": {
+                "excerpt": {
                     "type": "string",
-                    "example": "Python"
+                    "example": "One of the cleanest portfolios I have seen this year."
                 },
-                "role": {
+                "id": {
                     "type": "string",
-                    "example": "Data Science"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "version": {
+                "source": {
                     "type": "string",
-                    "example": "3.9"
+                    "example": "https://blog.example.com/posts/portfolio-review"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Reviewing developer portfolios"
+                },
+                "verified_at": {
+                    "type": "string"
                 }
             }
         },
-        "tech_stack.TechStackUpdate": {
+        "webmention.SendReport": {
+            "description": "Summary of a run of sending Webmentions for project links",
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tech_stack.TechStackCategory"
-                        }
-                    ],
-                    "example": "Backend"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "failed": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "is_core_skill": {
-                    "type": "boolean",
-                    "example": true
+                "no_endpoint": {
+                    "type": "integer",
+                    "example": 8
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Rust"
+                "projects": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "role": {
-                    "type": "string",
-                    "example": "Systems Programming"
+                "sent": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "version": {
-                    "type": "string",
-                    "example": "1.65"
+                "unchanged": {
+                    "type": "integer",
+                    "example": 40
                 }
             }
         }
@@ -0,0 +1,81 @@
+package accessibility
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type AccessibilityHandler struct {
+	base.BaseHandler
+	accessibilityService AccessibilityService
+}
+
+func NewAccessibilityHandler(accessibilityService AccessibilityService, logger *logger.Logger) *AccessibilityHandler {
+	return &AccessibilityHandler{
+		BaseHandler:          *base.NewBaseHandler(logger),
+		accessibilityService: accessibilityService,
+	}
+}
+
+// Audit runs an accessibility audit
+// @Summary Run an accessibility audit
+// @Description Check the images, Markdown headings and theme colors of all content and record the accessibility issues found
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Accessibility audit completed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/accessibility [post]
+func (h *AccessibilityHandler) Audit(c *gin.Context) {
+	report, err := h.accessibilityService.Audit(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Accessibility audit completed successfully")
+}
+
+// GetReport retrieves the issues found by the last accessibility audit
+// @Summary Get the accessibility report
+// @Description Retrieve the accessibility issues recorded by the last audit, with their number per rule
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Accessibility report retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/accessibility [get]
+func (h *AccessibilityHandler) GetReport(c *gin.Context) {
+	report, err := h.accessibilityService.GetReport(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Accessibility report retrieved successfully")
+}
+
+// CheckEntity checks the accessibility of a single entity
+// @Summary Check the accessibility of an entity
+// @Description Check a project, experience, page or the site config without recording the issues, e.g. before publishing it. The id is ignored for the site config.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Entity type" Enums(project, experience, page, site_config)
+// @Param id path string true "Entity ID"
+// @Success 200 {object} response.APIResponse{data=Report} "Accessibility check completed successfully"
+// @Failure 400 {object} response.APIResponse "Invalid entity"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Entity not found"
+// @Router /admin/accessibility/{entity}/{id} [get]
+func (h *AccessibilityHandler) CheckEntity(c *gin.Context) {
+	report, err := h.accessibilityService.CheckEntity(c.Request.Context(), c.Param("entity"), c.Param("id"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Accessibility check completed successfully")
+}
@@ -0,0 +1,145 @@
+package accessibility
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job audits the content of every tenant shortly after projects,
+// experiences or pages are published or changed, and on a fixed interval,
+// which also catches theme changes and changes whose events were dropped
+type Job struct {
+	accessibilityService AccessibilityService
+	tenantService        tenant.TenantService
+	bus                  *events.Bus
+	interval             time.Duration
+	delay                time.Duration
+	logger               *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates an audit job running every interval and delay after
+// content change events published on bus
+func NewJob(accessibilityService AccessibilityService, tenantService tenant.TenantService, bus *events.Bus, interval, delay time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	if delay <= 0 {
+		delay = 30 * time.Second
+	}
+
+	return &Job{
+		accessibilityService: accessibilityService,
+		tenantService:        tenantService,
+		bus:                  bus,
+		interval:             interval,
+		delay:                delay,
+		logger:               logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// audit runs one delay after start, so that the report is filled in without
+// waiting a whole interval.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	// Without a subscription the job still audits on the interval
+	var changes <-chan events.Event
+	sub, err := j.bus.SubscribeInternal()
+	if err != nil {
+		j.logger.Warn("Failed to subscribe to content changes for accessibility audits", "error", err)
+	} else {
+		changes = sub.Events()
+	}
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		if sub != nil {
+			defer sub.Close()
+		}
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		// pending fires once content changes have settled for delay
+		pending := time.NewTimer(j.delay)
+		defer pending.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				if isContentChange(event) {
+					pending.Reset(j.delay)
+				}
+			case <-pending.C:
+				j.run(ctx)
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run audits each tenant in turn
+func (j *Job) run(ctx context.Context) {
+	for page := 1; ; page++ {
+		tenants, err := j.tenantService.ListTenants(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			if ctx.Err() == nil {
+				j.logger.Error("Failed to list tenants for accessibility audit", "error", err)
+			}
+			return
+		}
+
+		for _, t := range tenants {
+			report, err := j.accessibilityService.Audit(base.WithTenant(ctx, t.Scope()))
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				j.logger.Error("Failed to audit accessibility", "tenant", t.Slug, "error", err)
+				continue
+			}
+
+			if !report.Passed {
+				j.logger.Warn("Accessibility issues found", "tenant", t.Slug, "issues", len(report.Issues))
+			}
+		}
+
+		if len(tenants) < pageSize {
+			return
+		}
+	}
+}
+
+// isContentChange reports whether an event changes audited content
+func isContentChange(event events.Event) bool {
+	return strings.HasPrefix(string(event.Type), "project.") ||
+		strings.HasPrefix(string(event.Type), "experience.") ||
+		strings.HasPrefix(string(event.Type), "page.")
+}
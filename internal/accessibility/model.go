@@ -0,0 +1,99 @@
+package accessibility
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rule names an accessibility rule
+type Rule string
+
+const (
+	// RuleMissingAltText flags images without a text alternative
+	RuleMissingAltText Rule = "missing_alt_text"
+	// RuleLowContrast flags theme colors too close to the background to read
+	RuleLowContrast Rule = "low_contrast"
+	// RuleTopLevelHeading flags level 1 headings in content, where the title
+	// is already the only one of the page
+	RuleTopLevelHeading Rule = "top_level_heading"
+	// RuleSkippedHeadingLevel flags headings more than one level below the
+	// heading before them
+	RuleSkippedHeadingLevel Rule = "skipped_heading_level"
+)
+
+// Severity tells whether an issue fails WCAG or only makes content harder
+// to use
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Entity types whose content is audited
+const (
+	EntityProject    = "project"
+	EntityExperience = "experience"
+	EntityPage       = "page"
+	EntitySiteConfig = "site_config"
+)
+
+// Issue is an accessibility problem found in content
+// @Description Accessibility problem found in content
+// @Name AccessibilityIssue
+type Issue struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Rule       Rule       `json:"rule" db:"rule" example:"missing_alt_text"`
+	Severity   Severity   `json:"severity" db:"severity" example:"error"`
+	EntityType string     `json:"entity_type" db:"entity_type" example:"project"`
+	EntityID   uuid.UUID  `json:"entity_id" db:"entity_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	EntityName string     `json:"entity_name" db:"entity_name" example:"Portfolio Website"`
+	Field      string     `json:"field" db:"field" example:"images"`
+	Value      string     `json:"value,omitempty" db:"value" example:"https://example.com/screenshot.png"`
+	Message    string     `json:"message" db:"message" example:"image has no alt text"`
+	CheckedAt  time.Time  `json:"checked_at" db:"checked_at"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" db:"created_at"`
+}
+
+// Audited counts the content an audit went through
+// @Description Content checked by an accessibility audit
+// @Name AccessibilityAudited
+type Audited struct {
+	Projects    int `json:"projects" example:"12"`
+	Experiences int `json:"experiences" example:"5"`
+	Pages       int `json:"pages" example:"3"`
+}
+
+// Report lists the accessibility issues of the content of a tenant
+// @Description Accessibility issues found in content, with their number per rule
+// @Name AccessibilityReport
+type Report struct {
+	Passed bool         `json:"passed" example:"false"`
+	Counts map[Rule]int `json:"counts"`
+	Issues []Issue      `json:"issues"`
+
+	// Audited and CheckedAt are only set on reports of a full audit
+	Audited   *Audited   `json:"audited,omitempty"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// newReport summarizes issues
+func newReport(issues []Issue) *Report {
+	report := &Report{
+		Passed: true,
+		Counts: map[Rule]int{},
+		Issues: issues,
+	}
+	for _, issue := range issues {
+		report.Counts[issue.Rule]++
+		if issue.Severity == SeverityError {
+			report.Passed = false
+		}
+	}
+	if report.Issues == nil {
+		report.Issues = []Issue{}
+	}
+	return report
+}
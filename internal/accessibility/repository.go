@@ -0,0 +1,74 @@
+package accessibility
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type IssueRepository interface {
+	List(ctx context.Context) ([]Issue, error)
+	Replace(ctx context.Context, issues []Issue) error
+}
+
+type issueRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewIssueRepository(supabaseClient *supabase.SupabaseClient) IssueRepository {
+	return &issueRepository{
+		supabaseClient: supabaseClient,
+		table:          "accessibility_issue",
+	}
+}
+
+func (r *issueRepository) List(ctx context.Context) ([]Issue, error) {
+	var issues []Issue
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("entity_type", &postgrest.OrderOpts{Ascending: true}).
+		Order("entity_name", &postgrest.OrderOpts{Ascending: true}).
+		Order("field", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&issues)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list accessibility issues")
+	}
+	return issues, nil
+}
+
+// Replace swaps the accessibility issues of the tenant in ctx for the given
+// ones
+func (r *issueRepository) Replace(ctx context.Context, issues []Issue) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "")
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to clear accessibility issues")
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	tenantID := base.TenantIDFromContext(ctx)
+	for i := range issues {
+		issues[i].TenantID = tenantID
+	}
+
+	_, _, err = r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(issues, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record accessibility issues")
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package accessibility
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+)
+
+// Minimum contrast ratios of WCAG 2.1 level AA, for body text and for
+// large text and interface components such as buttons and links
+const (
+	minTextContrast = 4.5
+	minUIContrast   = 3.0
+)
+
+var (
+	// headingPattern and imagePattern match the headings and images of HTML
+	// rendered from Markdown, which never carry other attributes
+	headingPattern = regexp.MustCompile(`(?s)<h([1-6])(?:\s[^>]*)?>(.*?)</h[1-6]>`)
+	imagePattern   = regexp.MustCompile(`<img src="([^"]*)" alt="([^"]*)">`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+)
+
+// finding is an issue before it is attributed to an entity
+type finding struct {
+	rule     Rule
+	severity Severity
+	field    string
+	value    string
+	message  string
+}
+
+// checkContent checks the heading structure and images of HTML rendered
+// from the Markdown of field. The page title is its level 1 heading, so
+// content starts at level 2.
+func checkContent(field, body string) []finding {
+	var findings []finding
+
+	previous := 1
+	for _, match := range headingPattern.FindAllStringSubmatch(body, -1) {
+		level, _ := strconv.Atoi(match[1])
+		text := html.UnescapeString(tagPattern.ReplaceAllString(match[2], ""))
+
+		switch {
+		case level == 1:
+			findings = append(findings, finding{
+				rule:     RuleTopLevelHeading,
+				severity: SeverityWarning,
+				field:    field,
+				value:    text,
+				message:  "level 1 heading repeats the title, sections start at level 2",
+			})
+		case level > previous+1:
+			findings = append(findings, finding{
+				rule:     RuleSkippedHeadingLevel,
+				severity: SeverityWarning,
+				field:    field,
+				value:    text,
+				message:  fmt.Sprintf("level %d heading follows a level %d heading", level, previous),
+			})
+		}
+		previous = level
+	}
+
+	for _, match := range imagePattern.FindAllStringSubmatch(body, -1) {
+		if isBlank(html.UnescapeString(match[2])) {
+			findings = append(findings, missingAltText(field, html.UnescapeString(match[1])))
+		}
+	}
+
+	return findings
+}
+
+// isBlank reports whether alt text is empty or only whitespace
+func isBlank(alt string) bool {
+	return strings.TrimSpace(alt) == ""
+}
+
+// missingAltText flags the image at src
+func missingAltText(field, src string) finding {
+	return finding{
+		rule:     RuleMissingAltText,
+		severity: SeverityError,
+		field:    field,
+		value:    src,
+		message:  "image has no alt text",
+	}
+}
+
+// checkTheme flags theme colors without enough contrast against the
+// background color
+func checkTheme(theme site_config.ThemeConfig) []finding {
+	white := rgb{255, 255, 255}
+	background, ok := parseColor(theme.BackgroundColor, white)
+	if !ok {
+		return nil
+	}
+
+	pairs := []struct {
+		field string
+		color string
+		min   float64
+	}{
+		{"theme.text_color", theme.TextColor, minTextContrast},
+		{"theme.primary_color", theme.PrimaryColor, minUIContrast},
+		{"theme.secondary_color", theme.SecondaryColor, minUIContrast},
+		{"theme.accent_color", theme.AccentColor, minUIContrast},
+	}
+
+	var findings []finding
+	for _, pair := range pairs {
+		color, ok := parseColor(pair.color, background)
+		if !ok {
+			continue
+		}
+
+		if ratio := contrastRatio(color, background); ratio < pair.min {
+			findings = append(findings, finding{
+				rule:     RuleLowContrast,
+				severity: SeverityError,
+				field:    pair.field,
+				value:    pair.color + " on " + theme.BackgroundColor,
+				message:  fmt.Sprintf("contrast ratio %.2f:1 is below %.1f:1", ratio, pair.min),
+			})
+		}
+	}
+	return findings
+}
+
+// rgb is a color with channels between 0 and 255
+type rgb [3]float64
+
+// parseColor parses a #rgb, #rrggbb or #rrggbbaa color. Translucent colors
+// are blended over under, as they would be drawn.
+func parseColor(hex string, under rgb) (rgb, bool) {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 && len(hex) != 8 {
+		return rgb{}, false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return rgb{}, false
+	}
+
+	alpha := 1.0
+	if len(hex) == 8 {
+		alpha = float64(value&0xff) / 255
+		value >>= 8
+	}
+
+	var c rgb
+	for i := range c {
+		channel := float64((value >> (16 - 8*i)) & 0xff)
+		c[i] = channel*alpha + under[i]*(1-alpha)
+	}
+	return c, true
+}
+
+// relativeLuminance is the luminance of c as WCAG defines it, between 0
+// for black and 1 for white
+func relativeLuminance(c rgb) float64 {
+	var linear [3]float64
+	for i, channel := range c {
+		v := channel / 255
+		if v <= 0.03928 {
+			linear[i] = v / 12.92
+		} else {
+			linear[i] = math.Pow((v+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*linear[0] + 0.7152*linear[1] + 0.0722*linear[2]
+}
+
+// contrastRatio is the WCAG contrast ratio of two colors, between 1 and 21
+func contrastRatio(a, b rgb) float64 {
+	lighter, darker := relativeLuminance(a), relativeLuminance(b)
+	if darker > lighter {
+		lighter, darker = darker, lighter
+	}
+	return (lighter + 0.05) / (darker + 0.05)
+}
@@ -0,0 +1,222 @@
+package accessibility
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// pageSize is the number of entities loaded per page while auditing
+const pageSize = 100
+
+type AccessibilityService interface {
+	// Audit checks all content of the tenant in ctx and replaces the
+	// recorded issues with the ones found
+	Audit(ctx context.Context) (*Report, error)
+	// GetReport reports the issues recorded by the last audit
+	GetReport(ctx context.Context) (*Report, error)
+	// CheckEntity checks a single project, experience, page or the site
+	// config without recording the issues found, e.g. before publishing it
+	CheckEntity(ctx context.Context, entityType, id string) (*Report, error)
+}
+
+type accessibilityService struct {
+	issueRepo         IssueRepository
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	pageService       page.PageService
+	siteConfigService site_config.SiteConfigService
+}
+
+func NewAccessibilityService(issueRepo IssueRepository, projectService project.ProjectService, experienceService experience.ExperienceService, pageService page.PageService, siteConfigService site_config.SiteConfigService) AccessibilityService {
+	return &accessibilityService{
+		issueRepo:         issueRepo,
+		projectService:    projectService,
+		experienceService: experienceService,
+		pageService:       pageService,
+		siteConfigService: siteConfigService,
+	}
+}
+
+func (s *accessibilityService) Audit(ctx context.Context) (*Report, error) {
+	checkedAt := time.Now().UTC()
+	audited := &Audited{}
+	var issues []Issue
+
+	for p := 1; ; p++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: p, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range projects {
+			issues = append(issues, projectIssues(&projects[i], checkedAt)...)
+		}
+		audited.Projects += len(projects)
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	for p := 1; ; p++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: p, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range experiences {
+			issues = append(issues, experienceIssues(&experiences[i], checkedAt)...)
+		}
+		audited.Experiences += len(experiences)
+		if len(experiences) < pageSize {
+			break
+		}
+	}
+
+	for p := 1; ; p++ {
+		pages, err := s.pageService.ListPages(ctx, base.ListOptions{Page: p, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range pages {
+			issues = append(issues, pageIssues(&pages[i], checkedAt)...)
+		}
+		audited.Pages += len(pages)
+		if len(pages) < pageSize {
+			break
+		}
+	}
+
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, siteConfigIssues(siteConfig, checkedAt)...)
+
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrCanceled, "Accessibility audit was canceled")
+	}
+
+	if err := s.issueRepo.Replace(ctx, issues); err != nil {
+		return nil, err
+	}
+
+	report := newReport(issues)
+	report.Audited = audited
+	report.CheckedAt = &checkedAt
+	return report, nil
+}
+
+func (s *accessibilityService) GetReport(ctx context.Context) (*Report, error) {
+	issues, err := s.issueRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newReport(issues), nil
+}
+
+func (s *accessibilityService) CheckEntity(ctx context.Context, entityType, id string) (*Report, error) {
+	checkedAt := time.Now().UTC()
+
+	switch entityType {
+	case EntityProject:
+		p, err := s.projectService.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return newReport(projectIssues(p, checkedAt)), nil
+
+	case EntityExperience:
+		e, err := s.experienceService.GetExperienceByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return newReport(experienceIssues(e, checkedAt)), nil
+
+	case EntityPage:
+		pg, err := s.pageService.GetPage(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return newReport(pageIssues(pg, checkedAt)), nil
+
+	case EntitySiteConfig:
+		siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return newReport(siteConfigIssues(siteConfig, checkedAt)), nil
+
+	default:
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Entity must be one of project, experience, page or site_config",
+			nil,
+			errors.WithContext("entity", entityType),
+		)
+	}
+}
+
+// projectIssues checks the images and the Markdown description of a project
+func projectIssues(p *project.ProjectDTO, checkedAt time.Time) []Issue {
+	var findings []finding
+	for _, image := range p.Images {
+		if image.Src != "" && isBlank(image.Alt) {
+			findings = append(findings, missingAltText("images", image.Src))
+		}
+	}
+	findings = append(findings, checkContent("description", markdown.Render(p.Description))...)
+
+	return attribute(findings, EntityProject, p.ID, p.Title, checkedAt)
+}
+
+// experienceIssues checks the images of an experience
+func experienceIssues(e *experience.ExperienceDTO, checkedAt time.Time) []Issue {
+	var findings []finding
+	for _, src := range e.ImagesUrl {
+		if src != "" && isBlank(e.ImageAlts[src]) {
+			findings = append(findings, missingAltText("images_url", src))
+		}
+	}
+
+	return attribute(findings, EntityExperience, e.ID, fmt.Sprintf("%s at %s", e.Role, e.Company), checkedAt)
+}
+
+// pageIssues checks the body of a page
+func pageIssues(pg *page.Page, checkedAt time.Time) []Issue {
+	findings := checkContent("body", pg.BodyHTML)
+	return attribute(findings, EntityPage, pg.ID, pg.Title, checkedAt)
+}
+
+// siteConfigIssues checks the theme colors of the site config
+func siteConfigIssues(siteConfig *site_config.SiteConfig, checkedAt time.Time) []Issue {
+	findings := checkTheme(siteConfig.Theme)
+	return attribute(findings, EntitySiteConfig, siteConfig.ID, "Site config", checkedAt)
+}
+
+// attribute turns the findings of an entity into issues
+func attribute(findings []finding, entityType string, entityID uuid.UUID, entityName string, checkedAt time.Time) []Issue {
+	issues := make([]Issue, 0, len(findings))
+	for _, f := range findings {
+		issues = append(issues, Issue{
+			ID:         uuid.New(),
+			Rule:       f.rule,
+			Severity:   f.severity,
+			EntityType: entityType,
+			EntityID:   entityID,
+			EntityName: entityName,
+			Field:      f.field,
+			Value:      f.value,
+			Message:    f.message,
+			CheckedAt:  checkedAt,
+		})
+	}
+	return issues
+}
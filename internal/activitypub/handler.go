@@ -0,0 +1,313 @@
+package activitypub
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/activitypub"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ActivityPubHandler struct {
+	base.BaseHandler
+	activityPubService ActivityPubService
+	tenantResolver     *tenant.Resolver
+}
+
+func NewActivityPubHandler(activityPubService ActivityPubService, tenantResolver *tenant.Resolver, logger *logger.Logger) *ActivityPubHandler {
+	return &ActivityPubHandler{
+		BaseHandler:        *base.NewBaseHandler(logger),
+		activityPubService: activityPubService,
+		tenantResolver:     tenantResolver,
+	}
+}
+
+// ResolveActor scopes requests to the tenant whose slug is the username
+// path parameter, since remote servers address actors by URL rather than
+// by host
+func (h *ActivityPubHandler) ResolveActor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.withTenant(c, c.Param("username")) {
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// WebFinger finds the actor of an account
+// @Summary WebFinger lookup
+// @Description Resolve an acct: resource such as acct:rama@example.com to its ActivityPub actor, as done by Mastodon when searching for the account
+// @Tags ActivityPub
+// @Produce json
+// @Param resource query string true "Account, e.g. acct:rama@example.com"
+// @Success 200 {object} activitypub.WebFinger "JSON Resource Descriptor"
+// @Failure 404 {object} response.APIResponse "Resource not found"
+// @Router /.well-known/webfinger [get]
+func (h *ActivityPubHandler) WebFinger(c *gin.Context) {
+	username, err := h.activityPubService.ParseResource(c.Query("resource"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !h.withTenant(c, username) {
+		return
+	}
+
+	webFinger, err := h.activityPubService.WebFinger(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/jrd+json; charset=utf-8")
+	c.Header("Access-Control-Allow-Origin", "*")
+	c.JSON(http.StatusOK, webFinger)
+}
+
+// GetActor retrieves the actor
+// @Summary Get ActivityPub actor
+// @Description Retrieve the ActivityPub actor of the portfolio, which fediverse accounts can follow
+// @Tags ActivityPub
+// @Produce json
+// @Param username path string true "Tenant slug"
+// @Success 200 {object} activitypub.Actor "Actor"
+// @Failure 404 {object} response.APIResponse "Actor not found"
+// @Router /activitypub/users/{username} [get]
+func (h *ActivityPubHandler) GetActor(c *gin.Context) {
+	actor, err := h.activityPubService.Actor(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.activity(c, actor)
+}
+
+// GetOutbox retrieves the published notes
+// @Summary Get ActivityPub outbox
+// @Description Retrieve the public projects as notes, newest first. Without a page only the collection and its size are returned.
+// @Tags ActivityPub
+// @Produce json
+// @Param username path string true "Tenant slug"
+// @Param page query int false "Page number"
+// @Success 200 {object} activitypub.OrderedCollection "Outbox"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Actor not found"
+// @Router /activitypub/users/{username}/outbox [get]
+func (h *ActivityPubHandler) GetOutbox(c *gin.Context) {
+	var page int
+	if value := c.Query("page"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Page must be a positive number",
+				err,
+				errors.WithContext("page", value),
+			))
+			return
+		}
+		page = parsed
+	}
+
+	outbox, err := h.activityPubService.Outbox(c.Request.Context(), page)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.activity(c, outbox)
+}
+
+// GetFollowers retrieves the follower count
+// @Summary Get ActivityPub followers
+// @Description Retrieve the number of followers. The followers themselves are not listed.
+// @Tags ActivityPub
+// @Produce json
+// @Param username path string true "Tenant slug"
+// @Success 200 {object} activitypub.OrderedCollection "Followers"
+// @Failure 404 {object} response.APIResponse "Actor not found"
+// @Router /activitypub/users/{username}/followers [get]
+func (h *ActivityPubHandler) GetFollowers(c *gin.Context) {
+	followers, err := h.activityPubService.Followers(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.activity(c, followers)
+}
+
+// GetNote retrieves a published note
+// @Summary Get ActivityPub note
+// @Description Retrieve the note announcing a public project
+// @Tags ActivityPub
+// @Produce json
+// @Param username path string true "Tenant slug"
+// @Param id path string true "Project ID"
+// @Success 200 {object} activitypub.Note "Note"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Note not found"
+// @Router /activitypub/users/{username}/notes/{id} [get]
+func (h *ActivityPubHandler) GetNote(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	note, err := h.activityPubService.Note(c.Request.Context(), projectID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.activity(c, note)
+}
+
+// PostInbox receives an activity
+// @Summary ActivityPub inbox
+// @Description Receive an activity signed with an HTTP signature. Follow, Undo of a Follow and Delete of an account are handled; other activities are accepted and ignored.
+// @Tags ActivityPub
+// @Accept json
+// @Produce json
+// @Param username path string true "Tenant slug"
+// @Success 202 "Activity accepted"
+// @Failure 400 {object} response.APIResponse "Invalid activity"
+// @Failure 401 {object} response.APIResponse "Invalid HTTP signature"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /activitypub/users/{username}/inbox [post]
+func (h *ActivityPubHandler) PostInbox(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		h.HandleError(c, errors.New(errors.ErrBadRequest, "Failed to read activity", err))
+		return
+	}
+
+	if err := h.activityPubService.Receive(c.Request.Context(), c.Request, body); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// ListFollowers retrieves the followers
+// @Summary List followers
+// @Description Retrieve a paginated list of fediverse accounts following the portfolio, newest first
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]Follower} "Followers retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/activitypub/followers [get]
+func (h *ActivityPubHandler) ListFollowers(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	followers, err := h.activityPubService.ListFollowers(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total followers for pagination
+	total, err := h.activityPubService.CountFollowers(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandlePagination(c, followers, total, opts)
+}
+
+// RemoveFollower removes a follower
+// @Summary Remove a follower
+// @Description Stop delivering to a follower and ask its server to drop the follow
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Follower ID"
+// @Success 200 {object} response.APIResponse "Follower removed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Follower not found"
+// @Router /admin/activitypub/followers/{id} [delete]
+func (h *ActivityPubHandler) RemoveFollower(c *gin.Context) {
+	followerID := c.Param("id")
+	if _, err := h.ValidateUUID(followerID, "Follower ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.activityPubService.RemoveFollower(c.Request.Context(), followerID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Follower removed successfully")
+}
+
+// Publish announces new projects
+// @Summary Publish to followers
+// @Description Announce the public projects not announced yet to followers. Projects are also published automatically after they change.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=PublishReport} "Projects published successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "ActivityPub is not enabled"
+// @Router /admin/activitypub/publish [post]
+func (h *ActivityPubHandler) Publish(c *gin.Context) {
+	report, err := h.activityPubService.Publish(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Projects published successfully")
+}
+
+// withTenant scopes the request to the tenant with the given slug and
+// reports whether it exists
+func (h *ActivityPubHandler) withTenant(c *gin.Context, slug string) bool {
+	t, err := h.tenantResolver.Resolve(c.Request.Context(), slug, "")
+	if err != nil || !t.IsActive {
+		if err != nil && errors.Is(err, errors.ErrDatabase) {
+			h.HandleError(c, err)
+			return false
+		}
+		h.HandleError(c, errors.New(
+			errors.ErrNotFound,
+			"Actor not found",
+			err,
+			errors.WithContext("username", slug),
+		))
+		return false
+	}
+
+	c.Request = c.Request.WithContext(base.WithTenant(c.Request.Context(), t.Scope()))
+	return true
+}
+
+// activity writes an ActivityPub document
+func (h *ActivityPubHandler) activity(c *gin.Context, document interface{}) {
+	c.Header("Content-Type", activitypub.ContentType+"; charset=utf-8")
+	c.JSON(http.StatusOK, document)
+}
@@ -0,0 +1,144 @@
+package activitypub
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job announces new public projects of every tenant to its followers. It
+// publishes shortly after projects change and on a fixed interval, which
+// also catches changes whose events were dropped.
+type Job struct {
+	activityPubService ActivityPubService
+	tenantService      tenant.TenantService
+	bus                *events.Bus
+	interval           time.Duration
+	delay              time.Duration
+	logger             *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a publish job running every interval and delay after
+// project change events published on bus
+func NewJob(activityPubService ActivityPubService, tenantService tenant.TenantService, bus *events.Bus, interval, delay time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	if delay <= 0 {
+		delay = time.Minute
+	}
+
+	return &Job{
+		activityPubService: activityPubService,
+		tenantService:      tenantService,
+		bus:                bus,
+		interval:           interval,
+		delay:              delay,
+		logger:             logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// run happens right away, so that projects created before ActivityPub was
+// enabled are recorded as published rather than announced to the first
+// followers.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	// Without a subscription the job still publishes on the interval
+	var changes <-chan events.Event
+	sub, err := j.bus.SubscribeInternal()
+	if err != nil {
+		j.logger.Warn("Failed to subscribe to project changes for publishing", "error", err)
+	} else {
+		changes = sub.Events()
+	}
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		if sub != nil {
+			defer sub.Close()
+		}
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		// pending fires once project changes have settled for delay
+		pending := time.NewTimer(0)
+		defer pending.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				if strings.HasPrefix(string(event.Type), "project.") {
+					pending.Reset(j.delay)
+				}
+			case <-pending.C:
+				j.run(ctx)
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run publishes for each tenant in turn
+func (j *Job) run(ctx context.Context) {
+	for page := 1; ; page++ {
+		tenants, err := j.tenantService.ListTenants(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			if ctx.Err() == nil {
+				j.logger.Error("Failed to list tenants for publishing", "error", err)
+			}
+			return
+		}
+
+		for _, t := range tenants {
+			if !t.IsActive {
+				continue
+			}
+
+			report, err := j.activityPubService.Publish(base.WithTenant(ctx, t.Scope()))
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				j.logger.Error("Failed to publish projects to followers", "tenant", t.Slug, "error", err)
+				continue
+			}
+
+			if report.Delivered > 0 || report.Failed > 0 {
+				j.logger.Info("Projects published to followers", "tenant", t.Slug, "published", report.Published,
+					"delivered", report.Delivered, "failed", report.Failed)
+			}
+		}
+
+		if len(tenants) < pageSize {
+			return
+		}
+	}
+}
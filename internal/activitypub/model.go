@@ -0,0 +1,51 @@
+package activitypub
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Key is the key pair a tenant's actor signs its requests with
+type Key struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	PublicKey  string     `json:"public_key" db:"public_key"`
+	PrivateKey string     `json:"private_key" db:"private_key"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Follower is a remote account following the portfolio
+// @Description Fediverse account following the portfolio
+// @Name Follower
+type Follower struct {
+	ID          uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	ActorID     string     `json:"actor_id" db:"actor_id" example:"https://mastodon.social/users/jane"`
+	Inbox       string     `json:"inbox" db:"inbox" example:"https://mastodon.social/users/jane/inbox"`
+	SharedInbox string     `json:"shared_inbox" db:"shared_inbox" example:"https://mastodon.social/inbox"`
+	Username    string     `json:"username" db:"username" example:"jane@mastodon.social"`
+	Name        string     `json:"name" db:"name" example:"Jane Doe"`
+	URL         string     `json:"url" db:"url" example:"https://mastodon.social/@jane"`
+	FollowedAt  time.Time  `json:"followed_at" db:"followed_at"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Post records a project announced to followers
+type Post struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	ProjectID   uuid.UUID  `json:"project_id" db:"project_id"`
+	PublishedAt time.Time  `json:"published_at" db:"published_at"`
+}
+
+// PublishReport summarizes a run of announcing new projects
+// @Description Summary of a run of announcing new public projects to followers
+// @Name PublishReport
+type PublishReport struct {
+	Published int `json:"published" example:"1"`
+	Delivered int `json:"delivered" example:"12"`
+	Failed    int `json:"failed" example:"0"`
+}
@@ -0,0 +1,254 @@
+package activitypub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type KeyRepository interface {
+	// Find returns the key pair of the tenant in ctx, or nil if none
+	Find(ctx context.Context) (*Key, error)
+	Create(ctx context.Context, key *Key) (*Key, error)
+}
+
+type keyRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewKeyRepository(supabaseClient *supabase.SupabaseClient) KeyRepository {
+	return &keyRepository{
+		supabaseClient: supabaseClient,
+		table:          "activitypub_actor",
+	}
+}
+
+func (r *keyRepository) Find(ctx context.Context) (*Key, error) {
+	var keys []Key
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&keys)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find actor key")
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return &keys[0], nil
+}
+
+func (r *keyRepository) Create(ctx context.Context, key *Key) (*Key, error) {
+	key.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(key, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create actor key")
+	}
+	return key, nil
+}
+
+type FollowerRepository interface {
+	// Upsert stores a follower, replacing the previous record of the same actor
+	Upsert(ctx context.Context, follower *Follower) error
+	Delete(ctx context.Context, id string) error
+	// DeleteByActor removes the follower with the given actor ID, if any
+	DeleteByActor(ctx context.Context, actorID string) error
+	// FindByID returns the follower with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Follower, error)
+	// FindAll returns every follower of the tenant in ctx
+	FindAll(ctx context.Context) ([]Follower, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Follower, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type followerRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewFollowerRepository(supabaseClient *supabase.SupabaseClient) FollowerRepository {
+	return &followerRepository{
+		supabaseClient: supabaseClient,
+		table:          "activitypub_follower",
+	}
+}
+
+func (r *followerRepository) Upsert(ctx context.Context, follower *Follower) error {
+	follower.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(follower, "tenant_id,actor_id", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save follower")
+	}
+	return nil
+}
+
+func (r *followerRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete follower")
+	}
+	return nil
+}
+
+func (r *followerRepository) DeleteByActor(ctx context.Context, actorID string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("actor_id", actorID)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete follower")
+	}
+	return nil
+}
+
+func (r *followerRepository) FindByID(ctx context.Context, id string) (*Follower, error) {
+	var followers []Follower
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&followers)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find follower")
+	}
+
+	if len(followers) == 0 {
+		return nil, nil
+	}
+	return &followers[0], nil
+}
+
+func (r *followerRepository) FindAll(ctx context.Context) ([]Follower, error) {
+	var followers []Follower
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&followers)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list followers")
+	}
+
+	return followers, nil
+}
+
+func (r *followerRepository) List(ctx context.Context, opts base.ListOptions) ([]Follower, error) {
+	var followers []Follower
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: opts.SortOrder == base.SortAscending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&followers)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list followers")
+	}
+
+	return followers, nil
+}
+
+func (r *followerRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count followers")
+	}
+
+	return int(count), nil
+}
+
+type PostRepository interface {
+	// FindAll returns the projects of the tenant in ctx announced so far
+	FindAll(ctx context.Context) ([]Post, error)
+	Create(ctx context.Context, post *Post) error
+}
+
+type postRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewPostRepository(supabaseClient *supabase.SupabaseClient) PostRepository {
+	return &postRepository{
+		supabaseClient: supabaseClient,
+		table:          "activitypub_post",
+	}
+}
+
+func (r *postRepository) FindAll(ctx context.Context) ([]Post, error) {
+	var posts []Post
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id,tenant_id,project_id,published_at", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&posts)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list published posts")
+	}
+
+	return posts, nil
+}
+
+func (r *postRepository) Create(ctx context.Context, post *Post) error {
+	post.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(post, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record published post")
+	}
+	return nil
+}
@@ -0,0 +1,760 @@
+package activitypub
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/activitypub"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// pageSize is the page size used to read every public project
+	pageSize = 100
+
+	// outboxPageSize is the number of notes per outbox page
+	outboxPageSize = 20
+
+	// slugPlaceholder is replaced by the project slug in the project path
+	slugPlaceholder = "{slug}"
+)
+
+// Options configures where the actors are published
+type Options struct {
+	// BaseURL is the public URL of the API
+	BaseURL string
+	// Domain is the domain of actor handles, defaulting to the host of BaseURL
+	Domain string
+	// ProjectPath is the path of project pages below the site canonical URL
+	ProjectPath string
+}
+
+type ActivityPubService interface {
+	// Actor returns the actor of the tenant in ctx
+	Actor(ctx context.Context) (*activitypub.Actor, error)
+	// ParseResource returns the username a WebFinger resource refers to
+	ParseResource(resource string) (string, error)
+	// WebFinger describes the actor of the tenant in ctx
+	WebFinger(ctx context.Context) (*activitypub.WebFinger, error)
+	// Outbox returns the public projects of the tenant in ctx as notes, the
+	// collection itself when page is zero
+	Outbox(ctx context.Context, page int) (*activitypub.OrderedCollection, error)
+	// Followers returns the follower count of the tenant in ctx
+	Followers(ctx context.Context) (*activitypub.OrderedCollection, error)
+	// Note returns a public project as a note
+	Note(ctx context.Context, projectID string) (*activitypub.Note, error)
+	// Receive handles an activity delivered to the inbox of the tenant in ctx
+	Receive(ctx context.Context, req *http.Request, body []byte) error
+	// Publish announces the public projects of the tenant in ctx that were
+	// not announced yet to its followers
+	Publish(ctx context.Context) (*PublishReport, error)
+	ListFollowers(ctx context.Context, opts base.ListOptions) ([]Follower, error)
+	CountFollowers(ctx context.Context, filters []base.FilterOption) (int, error)
+	// RemoveFollower stops delivering to a follower and asks its server to
+	// drop the follow
+	RemoveFollower(ctx context.Context, id string) error
+}
+
+type activityPubService struct {
+	keyRepo           KeyRepository
+	followerRepo      FollowerRepository
+	postRepo          PostRepository
+	projectService    project.ProjectService
+	siteConfigService site_config.SiteConfigService
+	client            *activitypub.Client
+	baseURL           string
+	domain            string
+	projectPath       string
+
+	// keys caches the parsed signing key of each tenant
+	keysMu sync.Mutex
+	keys   map[uuid.UUID]*signingKey
+
+	// mu serializes publish runs started by the job and by admins
+	mu sync.Mutex
+}
+
+// signingKey is a tenant's key pair ready for signing
+type signingKey struct {
+	publicKeyPem string
+	private      *rsa.PrivateKey
+}
+
+func NewActivityPubService(keyRepo KeyRepository, followerRepo FollowerRepository, postRepo PostRepository, projectService project.ProjectService, siteConfigService site_config.SiteConfigService, client *activitypub.Client, opts Options) ActivityPubService {
+	baseURL := strings.TrimRight(opts.BaseURL, "/")
+
+	domain := opts.Domain
+	if domain == "" {
+		if u, err := url.Parse(baseURL); err == nil {
+			domain = u.Host
+		}
+	}
+
+	projectPath := opts.ProjectPath
+	if !strings.Contains(projectPath, slugPlaceholder) {
+		projectPath = "/projects/" + slugPlaceholder
+	}
+
+	return &activityPubService{
+		keyRepo:           keyRepo,
+		followerRepo:      followerRepo,
+		postRepo:          postRepo,
+		projectService:    projectService,
+		siteConfigService: siteConfigService,
+		client:            client,
+		baseURL:           baseURL,
+		domain:            strings.ToLower(domain),
+		projectPath:       "/" + strings.TrimLeft(projectPath, "/"),
+		keys:              make(map[uuid.UUID]*signingKey),
+	}
+}
+
+func (s *activityPubService) Actor(ctx context.Context) (*activitypub.Actor, error) {
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.signingKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, _ := base.TenantFromContext(ctx)
+	name := siteConfig.SEO.Title
+	if name == "" {
+		name = scope.Slug
+	}
+
+	actor := &activitypub.Actor{
+		Context:           activitypub.Context,
+		ID:                actorID,
+		Type:              "Person",
+		PreferredUsername: scope.Slug,
+		Name:              name,
+		Inbox:             actorID + "/inbox",
+		Outbox:            actorID + "/outbox",
+		Followers:         actorID + "/followers",
+		PublicKey: &activitypub.PublicKey{
+			ID:           actorID + "#main-key",
+			Owner:        actorID,
+			PublicKeyPem: key.publicKeyPem,
+		},
+	}
+	if siteConfig.SEO.Description != "" {
+		actor.Summary = "<p>" + html.EscapeString(siteConfig.SEO.Description) + "</p>"
+	}
+	if canonical := parseCanonicalURL(siteConfig); canonical != nil {
+		actor.URL = canonical.String()
+	}
+
+	return actor, nil
+}
+
+func (s *activityPubService) ParseResource(resource string) (string, error) {
+	notFound := errors.New(
+		errors.ErrNotFound,
+		"Resource not found",
+		nil,
+		errors.WithContext("resource", resource),
+	)
+
+	account, ok := strings.CutPrefix(resource, "acct:")
+	if !ok {
+		return "", notFound
+	}
+
+	username, domain, ok := strings.Cut(strings.TrimPrefix(account, "@"), "@")
+	if !ok || username == "" || !strings.EqualFold(domain, s.domain) {
+		return "", notFound
+	}
+	return strings.ToLower(username), nil
+}
+
+func (s *activityPubService) WebFinger(ctx context.Context) (*activitypub.WebFinger, error) {
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, _ := base.TenantFromContext(ctx)
+	webFinger := &activitypub.WebFinger{
+		Subject: "acct:" + scope.Slug + "@" + s.domain,
+		Aliases: []string{actorID},
+		Links: []activitypub.WebFingerLink{{
+			Rel:  "self",
+			Type: activitypub.ContentType,
+			Href: actorID,
+		}},
+	}
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if canonical != nil {
+		webFinger.Links = append(webFinger.Links, activitypub.WebFingerLink{
+			Rel:  "http://webfinger.net/rel/profile-page",
+			Type: "text/html",
+			Href: canonical.String(),
+		})
+	}
+
+	return webFinger, nil
+}
+
+func (s *activityPubService) Outbox(ctx context.Context, page int) (*activitypub.OrderedCollection, error) {
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []base.FilterOption{{
+		Field:    "visibility",
+		Operator: base.OperatorEqual,
+		Value:    project.VisibilityPublic,
+	}}
+	total, err := s.projectService.CountProjects(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	outboxID := actorID + "/outbox"
+	if page < 1 {
+		return &activitypub.OrderedCollection{
+			Context:    activitypub.Context,
+			ID:         outboxID,
+			Type:       "OrderedCollection",
+			TotalItems: total,
+			First:      outboxID + "?page=1",
+		}, nil
+	}
+
+	projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+		Page:      page,
+		PerPage:   outboxPageSize,
+		SortBy:    "created_at",
+		SortOrder: base.SortDescending,
+		Filters:   filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &activitypub.OrderedCollection{
+		Context:      activitypub.Context,
+		ID:           fmt.Sprintf("%s?page=%d", outboxID, page),
+		Type:         "OrderedCollectionPage",
+		TotalItems:   total,
+		PartOf:       outboxID,
+		OrderedItems: make([]interface{}, 0, len(projects)),
+	}
+	for _, p := range projects {
+		collection.OrderedItems = append(collection.OrderedItems, create(actorID, s.note(actorID, canonical, p)))
+	}
+	if len(projects) == outboxPageSize {
+		collection.Next = fmt.Sprintf("%s?page=%d", outboxID, page+1)
+	}
+
+	return collection, nil
+}
+
+func (s *activityPubService) Followers(ctx context.Context) (*activitypub.OrderedCollection, error) {
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.followerRepo.Count(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the count is shown, so followers are not exposed to scrapers
+	return &activitypub.OrderedCollection{
+		Context:    activitypub.Context,
+		ID:         actorID + "/followers",
+		Type:       "OrderedCollection",
+		TotalItems: total,
+	}, nil
+}
+
+func (s *activityPubService) Note(ctx context.Context, projectID string) (*activitypub.Note, error) {
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.projectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, errors.ErrDatabase) {
+			return nil, err
+		}
+		p = nil
+	}
+	if p == nil || !p.IsPublic() {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Note not found",
+			nil,
+			errors.WithContext("project_id", projectID),
+		)
+	}
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	note := s.note(actorID, canonical, *p)
+	note.Context = activitypub.Context
+	return &note, nil
+}
+
+func (s *activityPubService) Receive(ctx context.Context, req *http.Request, body []byte) error {
+	if s.client == nil {
+		return errors.New(errors.ErrConfiguration, "ActivityPub is not enabled", nil)
+	}
+
+	var activity activitypub.Activity
+	if err := json.Unmarshal(body, &activity); err != nil || activity.Type == "" || activity.Actor == "" {
+		return errors.New(errors.ErrBadRequest, "Invalid activity", err)
+	}
+
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return err
+	}
+	key, err := s.signingKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The sender is fetched to get its key, which also proves the activity
+	// was sent by the actor it names
+	var sender *activitypub.Actor
+	_, err = activitypub.Verify(req, body, func(keyID string) (*rsa.PublicKey, error) {
+		remote, err := s.client.FetchActor(ctx, keyID, actorID+"#main-key", key.private)
+		if err != nil {
+			return nil, err
+		}
+		if remote.ID != activity.Actor || remote.PublicKey == nil ||
+			remote.PublicKey.ID != keyID || remote.PublicKey.Owner != remote.ID {
+			return nil, activitypub.ErrInvalidSignature
+		}
+		sender = remote
+		return activitypub.ParsePublicKey(remote.PublicKey.PublicKeyPem)
+	})
+	if err != nil {
+		// Accounts deleted elsewhere can no longer be fetched to verify their
+		// Delete, which is harmless to ignore
+		if activity.Type == "Delete" {
+			return nil
+		}
+		return errors.New(errors.ErrUnauthorized, "Invalid HTTP signature", err)
+	}
+
+	switch activity.Type {
+	case "Follow":
+		if activity.ObjectID() != actorID {
+			return errors.New(errors.ErrValidation, "Follow does not target this actor", nil)
+		}
+		return s.follow(ctx, actorID, key, sender, body)
+	case "Undo":
+		if undone, ok := activity.ObjectActivity(); ok && undone.Type == "Follow" && undone.Actor == activity.Actor {
+			return s.followerRepo.DeleteByActor(ctx, activity.Actor)
+		}
+	case "Delete":
+		if activity.ObjectID() == activity.Actor {
+			return s.followerRepo.DeleteByActor(ctx, activity.Actor)
+		}
+	}
+
+	// Other activities, such as replies and boosts, are not kept
+	return nil
+}
+
+// follow stores a new follower and accepts its follow
+func (s *activityPubService) follow(ctx context.Context, actorID string, key *signingKey, sender *activitypub.Actor, followActivity []byte) error {
+	follower := &Follower{
+		ID:         uuid.New(),
+		ActorID:    sender.ID,
+		Inbox:      sender.Inbox,
+		Username:   truncate(handle(sender), 255),
+		Name:       truncate(sender.Name, 255),
+		URL:        sender.URL,
+		FollowedAt: time.Now().UTC(),
+	}
+	if sender.Endpoints != nil {
+		follower.SharedInbox = sender.Endpoints.SharedInbox
+	}
+	if err := s.followerRepo.Upsert(ctx, follower); err != nil {
+		return err
+	}
+
+	accept := &activitypub.Activity{
+		Context: activitypub.Context,
+		ID:      actorID + "#accepts/" + uuid.NewString(),
+		Type:    "Accept",
+		Actor:   actorID,
+		Object:  followActivity,
+		To:      []string{sender.ID},
+	}
+	if err := s.client.Deliver(ctx, sender.Inbox, accept, actorID+"#main-key", key.private); err != nil {
+		return errors.New(
+			errors.ErrNetwork,
+			"Failed to accept follow",
+			err,
+			errors.WithContext("follower", sender.ID),
+		)
+	}
+	return nil
+}
+
+func (s *activityPubService) Publish(ctx context.Context) (*PublishReport, error) {
+	if s.client == nil {
+		return nil, errors.New(errors.ErrConfiguration, "ActivityPub is not enabled", nil)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	actorID, err := s.actorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.signingKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.postRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	published := make(map[uuid.UUID]bool, len(posts))
+	for _, post := range posts {
+		published[post.ProjectID] = true
+	}
+
+	followers, err := s.followerRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inboxes := deliveryInboxes(followers)
+
+	report := &PublishReport{}
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+			Page:      page,
+			PerPage:   pageSize,
+			SortBy:    "created_at",
+			SortOrder: base.SortAscending,
+			Filters: []base.FilterOption{{
+				Field:    "visibility",
+				Operator: base.OperatorEqual,
+				Value:    project.VisibilityPublic,
+			}},
+		})
+		if err != nil {
+			return report, err
+		}
+
+		for _, p := range projects {
+			if published[p.ID] {
+				continue
+			}
+
+			activity := create(actorID, s.note(actorID, canonical, p))
+			activity.Context = activitypub.Context
+			for _, inbox := range inboxes {
+				if ctx.Err() != nil {
+					return report, ctx.Err()
+				}
+				if err := s.client.Deliver(ctx, inbox, activity, actorID+"#main-key", key.private); err != nil {
+					report.Failed++
+					continue
+				}
+				report.Delivered++
+			}
+
+			// Posts are not retried, so an unreachable server misses them
+			// like it would miss any other post
+			if err := s.postRepo.Create(ctx, &Post{
+				ID:          uuid.New(),
+				ProjectID:   p.ID,
+				PublishedAt: time.Now().UTC(),
+			}); err != nil {
+				return report, err
+			}
+			report.Published++
+		}
+
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+func (s *activityPubService) ListFollowers(ctx context.Context, opts base.ListOptions) ([]Follower, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.New(errors.ErrValidation, "Invalid list options", err)
+	}
+
+	if opts.SortBy == "" {
+		opts.SortBy = "followed_at"
+		opts.SortOrder = base.SortDescending
+	}
+
+	return s.followerRepo.List(ctx, opts)
+}
+
+func (s *activityPubService) CountFollowers(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.followerRepo.Count(ctx, filters)
+}
+
+func (s *activityPubService) RemoveFollower(ctx context.Context, id string) error {
+	follower, err := s.followerRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if follower == nil {
+		return errors.New(
+			errors.ErrNotFound,
+			"Follower not found",
+			nil,
+			errors.WithContext("follower_id", id),
+		)
+	}
+
+	// Rejecting the follow makes the follower's server drop it too. This is
+	// best effort, since deliveries stop either way.
+	if s.client != nil {
+		if actorID, err := s.actorID(ctx); err == nil {
+			if key, err := s.signingKey(ctx); err == nil {
+				follow, _ := json.Marshal(&activitypub.Activity{
+					Type:   "Follow",
+					Actor:  follower.ActorID,
+					Object: json.RawMessage(fmt.Sprintf("%q", actorID)),
+				})
+				_ = s.client.Deliver(ctx, follower.Inbox, &activitypub.Activity{
+					Context: activitypub.Context,
+					ID:      actorID + "#rejects/" + uuid.NewString(),
+					Type:    "Reject",
+					Actor:   actorID,
+					Object:  follow,
+					To:      []string{follower.ActorID},
+				}, actorID+"#main-key", key.private)
+			}
+		}
+	}
+
+	return s.followerRepo.Delete(ctx, id)
+}
+
+// actorID returns the ID of the actor of the tenant in ctx
+func (s *activityPubService) actorID(ctx context.Context) (string, error) {
+	scope, ok := base.TenantFromContext(ctx)
+	if !ok || scope.Slug == "" {
+		return "", errors.New(errors.ErrNotFound, "Actor not found", nil)
+	}
+	return s.baseURL + "/activitypub/users/" + url.PathEscape(scope.Slug), nil
+}
+
+// signingKey returns the key pair of the tenant in ctx, creating it the
+// first time it is needed
+func (s *activityPubService) signingKey(ctx context.Context) (*signingKey, error) {
+	scope, ok := base.TenantFromContext(ctx)
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "Actor not found", nil)
+	}
+
+	s.keysMu.Lock()
+	defer s.keysMu.Unlock()
+
+	if key, ok := s.keys[scope.ID]; ok {
+		return key, nil
+	}
+
+	record, err := s.keyRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		privateKeyPem, publicKeyPem, err := activitypub.GenerateKey()
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to generate actor key")
+		}
+
+		record, err = s.keyRepo.Create(ctx, &Key{
+			ID:         uuid.New(),
+			PublicKey:  publicKeyPem,
+			PrivateKey: privateKeyPem,
+		})
+		if err != nil {
+			// Another instance may have created the key in the meantime
+			if record, _ = s.keyRepo.Find(ctx); record == nil {
+				return nil, err
+			}
+		}
+	}
+
+	private, err := activitypub.ParsePrivateKey(record.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to read actor key")
+	}
+
+	key := &signingKey{publicKeyPem: record.PublicKey, private: private}
+	s.keys[scope.ID] = key
+	return key, nil
+}
+
+// canonicalURL returns the canonical URL of the site of the tenant in ctx,
+// or nil if it is not set
+func (s *activityPubService) canonicalURL(ctx context.Context) (*url.URL, error) {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseCanonicalURL(siteConfig), nil
+}
+
+// note returns a project as a note linking to its page, or to the project
+// itself when the site has no canonical URL
+func (s *activityPubService) note(actorID string, canonical *url.URL, p project.ProjectDTO) activitypub.Note {
+	link := p.WebUrl
+	if canonical != nil {
+		link = strings.TrimRight(canonical.String(), "/") +
+			strings.ReplaceAll(s.projectPath, slugPlaceholder, url.PathEscape(p.Slug))
+	}
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "<p><strong>%s</strong></p>", html.EscapeString(p.Title))
+	if p.Subtitle != "" {
+		fmt.Fprintf(&content, "<p>%s</p>", html.EscapeString(p.Subtitle))
+	}
+	if link != "" {
+		fmt.Fprintf(&content, `<p><a href="%s">%s</a></p>`, html.EscapeString(link), html.EscapeString(link))
+	}
+
+	note := activitypub.Note{
+		ID:           actorID + "/notes/" + p.ID.String(),
+		Type:         "Note",
+		AttributedTo: actorID,
+		Content:      content.String(),
+		URL:          link,
+		To:           []string{activitypub.PublicCollection},
+		Cc:           []string{actorID + "/followers"},
+	}
+	if p.CreatedAt != nil {
+		note.Published = p.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if p.UpdatedAt != nil {
+		note.Updated = p.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	if image, ok := thumbnail(p); ok {
+		note.Attachment = []activitypub.Image{{Type: "Image", URL: image.Src, Name: image.Alt}}
+	}
+
+	return note
+}
+
+// create wraps a note in the activity announcing it
+func create(actorID string, note activitypub.Note) *activitypub.Activity {
+	object, _ := json.Marshal(note)
+	return &activitypub.Activity{
+		ID:        note.ID + "/activity",
+		Type:      "Create",
+		Actor:     actorID,
+		Object:    object,
+		To:        note.To,
+		Cc:        note.Cc,
+		Published: note.Published,
+	}
+}
+
+// thumbnail returns the image shown with a project
+func thumbnail(p project.ProjectDTO) (project.ProjectImage, bool) {
+	for _, image := range p.Images {
+		if image.IsThumbnail {
+			return image, true
+		}
+	}
+	if len(p.Images) > 0 {
+		return p.Images[0], true
+	}
+	return project.ProjectImage{}, false
+}
+
+// deliveryInboxes returns the inboxes to deliver to, using the shared inbox
+// of a server once instead of the inbox of each follower on it
+func deliveryInboxes(followers []Follower) []string {
+	var inboxes []string
+	seen := make(map[string]bool)
+	for _, follower := range followers {
+		inbox := follower.SharedInbox
+		if inbox == "" {
+			inbox = follower.Inbox
+		}
+		if !seen[inbox] {
+			seen[inbox] = true
+			inboxes = append(inboxes, inbox)
+		}
+	}
+	return inboxes
+}
+
+// handle returns the user@host handle of a remote actor
+func handle(actor *activitypub.Actor) string {
+	u, err := url.Parse(actor.ID)
+	if err != nil || actor.PreferredUsername == "" {
+		return actor.ID
+	}
+	return actor.PreferredUsername + "@" + u.Host
+}
+
+func parseCanonicalURL(siteConfig *site_config.SiteConfig) *url.URL {
+	canonical, err := url.Parse(siteConfig.SEO.CanonicalURL)
+	if err != nil || (canonical.Scheme != "http" && canonical.Scheme != "https") || canonical.Host == "" {
+		return nil
+	}
+	canonical.RawQuery = ""
+	canonical.Fragment = ""
+	return canonical
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,152 @@
+package admin_session
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type SessionHandler struct {
+	base.BaseHandler
+	sessionService SessionService
+	logger         *logger.Logger
+}
+
+func NewSessionHandler(sessionService SessionService, logger *logger.Logger) *SessionHandler {
+	return &SessionHandler{
+		BaseHandler:    *base.NewBaseHandler(logger),
+		sessionService: sessionService,
+		logger:         logger,
+	}
+}
+
+// ListSessions retrieves the admin sessions
+// @Summary List admin sessions
+// @Description Retrieve a paginated list of admin sign-ins with the address and browser they were used from, newest first. Sessions are flagged automatically when used from an IP address the admin never signed in from, or when their address or browser changes midway.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param flagged query bool false "Only flagged or unflagged sessions"
+// @Param email query string false "Only sessions of this admin"
+// @Success 200 {object} response.APIResponse{data=[]Session} "Sessions retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/sessions [get]
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if value := c.Query("flagged"); value != "" {
+		flagged, err := strconv.ParseBool(value)
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Invalid flagged filter",
+				err,
+				errors.WithContext("flagged", value),
+			))
+			return
+		}
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "flagged", Operator: base.OperatorEqual, Value: flagged})
+	}
+	if email := c.Query("email"); email != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "email", Operator: base.OperatorEqual, Value: email})
+	}
+
+	sessions, err := h.sessionService.ListSessions(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total sessions for pagination
+	total, err := h.sessionService.CountSessions(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandlePagination(c, sessions, total, opts)
+}
+
+// GetSession retrieves an admin session
+// @Summary Get an admin session
+// @Description Retrieve an admin sign-in by ID
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 200 {object} response.APIResponse{data=Session} "Session retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Session not found"
+// @Router /admin/sessions/{id} [get]
+func (h *SessionHandler) GetSession(c *gin.Context) {
+	sessionID := c.Param("id")
+	if _, err := h.ValidateUUID(sessionID, "Session ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	session, err := h.sessionService.GetSession(c.Request.Context(), sessionID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, session, "Session retrieved successfully")
+}
+
+// FlagSession flags an admin session as suspicious or clears the flag
+// @Summary Flag an admin session
+// @Description Flag an admin sign-in as suspicious, e.g. one made from an unknown device, or clear the flag of a session that turned out legitimate
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Param flag body FlagUpdate true "Flag"
+// @Success 200 {object} response.APIResponse{data=Session} "Session flag updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Session not found"
+// @Router /admin/sessions/{id}/flag [put]
+func (h *SessionHandler) FlagSession(c *gin.Context) {
+	sessionID := c.Param("id")
+	if _, err := h.ValidateUUID(sessionID, "Session ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var update FlagUpdate
+	if err := h.ValidateRequest(c, &update); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	session, err := h.sessionService.Flag(c.Request.Context(), sessionID, &update)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.logger.Info("Admin session flag updated",
+		"session_id", sessionID,
+		"flagged", session.Flagged,
+		"by", c.GetString("email"),
+	)
+
+	h.HandleSuccess(c, session, "Session flag updated successfully")
+}
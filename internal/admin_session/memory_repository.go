@@ -0,0 +1,52 @@
+package admin_session
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+type memorySessionRepository struct {
+	*base.MemoryRepository[Session, Session]
+}
+
+// NewMemorySessionRepository creates a session repository kept in memory,
+// for development without a database
+func NewMemorySessionRepository() SessionRepository {
+	return &memorySessionRepository{
+		MemoryRepository: base.NewMemoryRepository([]Session{},
+			func(s *Session) string { return s.ID.String() },
+			func(s Session) Session { return s },
+			"email", "ip_address", "last_ip_address",
+		),
+	}
+}
+
+func (r *memorySessionRepository) FindByKey(ctx context.Context, sessionKey string) (*Session, error) {
+	return r.findOne(ctx, "session_key", sessionKey)
+}
+
+func (r *memorySessionRepository) FindByID(ctx context.Context, id string) (*Session, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *memorySessionRepository) IPAddressesOf(ctx context.Context, email string) ([]string, error) {
+	sessions, err := r.FindByField(ctx, "email", email)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(sessions)*2)
+	for _, session := range sessions {
+		addresses = append(addresses, session.IPAddress, session.LastIPAddress)
+	}
+	return addresses, nil
+}
+
+func (r *memorySessionRepository) findOne(ctx context.Context, field, value string) (*Session, error) {
+	sessions, err := r.FindByField(ctx, field, value)
+	if err != nil || len(sessions) == 0 {
+		return nil, err
+	}
+	return &sessions[0], nil
+}
@@ -0,0 +1,60 @@
+package admin_session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a sign-in of an admin, tracked from the requests made with its
+// tokens. Supabase keeps the session ID across token refreshes, so one
+// session spans every token issued from a sign-in.
+// @Description Admin sign-in tracked from the requests made with its tokens
+// @Name AdminSession
+type Session struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	SessionKey string     `json:"session_key" db:"session_key" example:"8f1c2b3a-4d5e-6f70-8192-a3b4c5d6e7f8"`
+	UserID     string     `json:"user_id" db:"user_id" example:"3c9e6a1f-2b4d-4e8a-9f7c-1d2e3f4a5b6c"`
+	Email      string     `json:"email" db:"email" example:"admin@example.com"`
+
+	// IPAddress and UserAgent are those of the first request of the
+	// session, LastIPAddress and LastUserAgent those of the latest
+	IPAddress     string `json:"ip_address" db:"ip_address" example:"203.0.113.7"`
+	UserAgent     string `json:"user_agent" db:"user_agent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4)"`
+	LastIPAddress string `json:"last_ip_address" db:"last_ip_address" example:"203.0.113.7"`
+	LastUserAgent string `json:"last_user_agent" db:"last_user_agent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4)"`
+
+	// SignedInAt is when the earliest token seen of the session was issued
+	SignedInAt time.Time `json:"signed_in_at" db:"signed_in_at"`
+	LastSeenAt time.Time `json:"last_seen_at" db:"last_seen_at"`
+	// RequestCount is updated at most once a minute
+	RequestCount int `json:"request_count" db:"request_count" example:"42"`
+
+	// Flagged sessions are suspected of being used by someone else than
+	// the admin, either automatically or by an admin
+	Flagged    bool       `json:"flagged" db:"flagged" example:"false"`
+	FlagReason string     `json:"flag_reason,omitempty" db:"flag_reason" example:"IP address changed from 203.0.113.7 to 198.51.100.23 during the session"`
+	FlaggedAt  *time.Time `json:"flagged_at,omitempty" db:"flagged_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Usage is an authenticated admin request
+type Usage struct {
+	SessionKey string
+	UserID     string
+	Email      string
+	IPAddress  string
+	UserAgent  string
+	IssuedAt   time.Time
+	At         time.Time
+}
+
+// FlagUpdate flags a session as suspicious or clears the flag
+// @Name AdminSessionFlagUpdate
+type FlagUpdate struct {
+	Flagged bool   `json:"flagged" example:"true"`
+	Reason  string `json:"reason,omitempty" validate:"max=500" example:"Not me, signed in from an unknown device"`
+}
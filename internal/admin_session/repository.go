@@ -0,0 +1,174 @@
+package admin_session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *Session) (*Session, error)
+	Update(ctx context.Context, session *Session) (*Session, error)
+	// FindByKey returns the session with the given session key, or nil if
+	// none
+	FindByKey(ctx context.Context, sessionKey string) (*Session, error)
+	// FindByID returns the session with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Session, error)
+	// IPAddressesOf lists the addresses email signed in from before
+	IPAddressesOf(ctx context.Context, email string) ([]string, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Session, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type sessionRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewSessionRepository(supabaseClient *supabase.SupabaseClient) SessionRepository {
+	return &sessionRepository{
+		supabaseClient: supabaseClient,
+		table:          "admin_session",
+	}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *Session) (*Session, error) {
+	session.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(session, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create admin session")
+	}
+	return session, nil
+}
+
+func (r *sessionRepository) Update(ctx context.Context, session *Session) (*Session, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"last_ip_address": session.LastIPAddress,
+			"last_user_agent": session.LastUserAgent,
+			"signed_in_at":    session.SignedInAt,
+			"last_seen_at":    session.LastSeenAt,
+			"request_count":   session.RequestCount,
+			"flagged":         session.Flagged,
+			"flag_reason":     session.FlagReason,
+			"flagged_at":      session.FlaggedAt,
+		}, "minimal", "").
+		Eq("id", session.ID.String())
+
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update admin session")
+	}
+	return session, nil
+}
+
+func (r *sessionRepository) FindByKey(ctx context.Context, sessionKey string) (*Session, error) {
+	return r.findOne(ctx, "session_key", sessionKey)
+}
+
+func (r *sessionRepository) FindByID(ctx context.Context, id string) (*Session, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *sessionRepository) IPAddressesOf(ctx context.Context, email string) ([]string, error) {
+	var sessions []Session
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("ip_address,last_ip_address", "", false).
+		Eq("email", email)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&sessions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list admin session addresses")
+	}
+
+	addresses := make([]string, 0, len(sessions)*2)
+	for _, session := range sessions {
+		addresses = append(addresses, session.IPAddress, session.LastIPAddress)
+	}
+	return addresses, nil
+}
+
+func (r *sessionRepository) List(ctx context.Context, opts base.ListOptions) ([]Session, error) {
+	var sessions []Session
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: opts.SortOrder == base.SortAscending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&sessions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list admin sessions")
+	}
+
+	return sessions, nil
+}
+
+func (r *sessionRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count admin sessions")
+	}
+
+	return int(count), nil
+}
+
+func (r *sessionRepository) findOne(ctx context.Context, field, value string) (*Session, error) {
+	var sessions []Session
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&sessions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find admin session")
+	}
+
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	return &sessions[0], nil
+}
@@ -0,0 +1,243 @@
+package admin_session
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+)
+
+// touchInterval is how often the last use of an unchanged session is
+// written, so busy sessions cost one write a minute rather than one per
+// request
+const touchInterval = time.Minute
+
+type SessionService interface {
+	// Record attributes an authenticated request to its session, flagging
+	// the session when it is used from an unexpected address or browser
+	Record(ctx context.Context, usage Usage) error
+	GetSession(ctx context.Context, id string) (*Session, error)
+	ListSessions(ctx context.Context, opts base.ListOptions) ([]Session, error)
+	CountSessions(ctx context.Context, filters []base.FilterOption) (int, error)
+	// Flag flags a session as suspicious or clears the flag
+	Flag(ctx context.Context, id string, update *FlagUpdate) (*Session, error)
+}
+
+// seenSession is what is remembered of a session between writes
+type seenSession struct {
+	session   Session
+	pending   int
+	writtenAt time.Time
+}
+
+type sessionService struct {
+	sessionRepo SessionRepository
+	notifier    notification.Notifier
+
+	// Requests are recorded one at a time so concurrent first requests of
+	// a session create it once
+	mu   sync.Mutex
+	seen map[string]*seenSession
+}
+
+func NewSessionService(sessionRepo SessionRepository, notifier notification.Notifier) SessionService {
+	return &sessionService{
+		sessionRepo: sessionRepo,
+		notifier:    notifier,
+		seen:        map[string]*seenSession{},
+	}
+}
+
+func (s *sessionService) Record(ctx context.Context, usage Usage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen, ok := s.seen[usage.SessionKey]
+	if ok && seen.session.LastIPAddress == usage.IPAddress && seen.session.LastUserAgent == usage.UserAgent &&
+		usage.At.Sub(seen.writtenAt) < touchInterval {
+		seen.pending++
+		return nil
+	}
+
+	if !ok {
+		session, err := s.sessionRepo.FindByKey(ctx, usage.SessionKey)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return s.create(ctx, usage)
+		}
+		seen = &seenSession{session: *session}
+		s.seen[usage.SessionKey] = seen
+	}
+
+	session := &seen.session
+	wasFlagged := session.Flagged
+
+	var reasons []string
+	if session.LastIPAddress != usage.IPAddress {
+		reasons = append(reasons, fmt.Sprintf("IP address changed from %s to %s during the session", session.LastIPAddress, usage.IPAddress))
+	}
+	if browser(session.LastUserAgent) != browser(usage.UserAgent) {
+		reasons = append(reasons, "Browser changed during the session")
+	}
+	if len(reasons) > 0 && !session.Flagged {
+		flag(session, strings.Join(reasons, "; "), usage.At)
+	}
+
+	session.LastIPAddress = usage.IPAddress
+	session.LastUserAgent = usage.UserAgent
+	session.LastSeenAt = usage.At
+	session.RequestCount += seen.pending + 1
+	if !usage.IssuedAt.IsZero() && usage.IssuedAt.Before(session.SignedInAt) {
+		session.SignedInAt = usage.IssuedAt
+	}
+
+	if _, err := s.sessionRepo.Update(ctx, session); err != nil {
+		return err
+	}
+	seen.pending = 0
+	seen.writtenAt = usage.At
+
+	if session.Flagged && !wasFlagged {
+		s.notify(ctx, session)
+	}
+	return nil
+}
+
+// create records the first request of a session, flagging it when the
+// admin never signed in from its address before
+func (s *sessionService) create(ctx context.Context, usage Usage) error {
+	signedInAt := usage.IssuedAt
+	if signedInAt.IsZero() {
+		signedInAt = usage.At
+	}
+
+	session := &Session{
+		ID:            uuid.New(),
+		SessionKey:    usage.SessionKey,
+		UserID:        usage.UserID,
+		Email:         usage.Email,
+		IPAddress:     usage.IPAddress,
+		UserAgent:     usage.UserAgent,
+		LastIPAddress: usage.IPAddress,
+		LastUserAgent: usage.UserAgent,
+		SignedInAt:    signedInAt,
+		LastSeenAt:    usage.At,
+		RequestCount:  1,
+	}
+
+	// The first sign-in ever has no known addresses to compare against
+	addresses, err := s.sessionRepo.IPAddressesOf(ctx, usage.Email)
+	if err != nil {
+		return err
+	}
+	if len(addresses) > 0 && !slices.Contains(addresses, usage.IPAddress) {
+		flag(session, fmt.Sprintf("Signed in from %s, an IP address not used before", usage.IPAddress), usage.At)
+	}
+
+	if _, err := s.sessionRepo.Create(ctx, session); err != nil {
+		return err
+	}
+	s.seen[usage.SessionKey] = &seenSession{session: *session, writtenAt: usage.At}
+
+	if session.Flagged {
+		s.notify(ctx, session)
+	}
+	return nil
+}
+
+func (s *sessionService) GetSession(ctx context.Context, id string) (*Session, error) {
+	session, err := s.sessionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Session not found",
+			nil,
+			errors.WithContext("session_id", id),
+		)
+	}
+	return session, nil
+}
+
+func (s *sessionService) ListSessions(ctx context.Context, opts base.ListOptions) ([]Session, error) {
+	return s.sessionRepo.List(ctx, opts)
+}
+
+func (s *sessionService) CountSessions(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.sessionRepo.Count(ctx, filters)
+}
+
+func (s *sessionService) Flag(ctx context.Context, id string, update *FlagUpdate) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Later writes of the session start from the stored one
+	if seen, ok := s.seen[session.SessionKey]; ok {
+		session.RequestCount += seen.pending
+		delete(s.seen, session.SessionKey)
+	}
+
+	if update.Flagged {
+		reason := strings.TrimSpace(update.Reason)
+		if reason == "" {
+			reason = "Flagged by an admin"
+		}
+		flag(session, reason, time.Now().UTC())
+	} else {
+		session.Flagged = false
+		session.FlagReason = ""
+		session.FlaggedAt = nil
+	}
+
+	return s.sessionRepo.Update(ctx, session)
+}
+
+// notify alerts about a session flagged automatically
+func (s *sessionService) notify(ctx context.Context, session *Session) {
+	s.notifier.Notify(ctx, notifier.Notification{
+		Event: notification.EventSessionFlagged,
+		Level: notifier.LevelWarning,
+		Title: "Suspicious admin session",
+		Body:  session.FlagReason,
+		Fields: map[string]string{
+			"Email":      session.Email,
+			"IP address": session.LastIPAddress,
+			"Browser":    session.LastUserAgent,
+			"Signed in":  session.SignedInAt.Format(time.RFC1123),
+		},
+	})
+}
+
+func flag(session *Session, reason string, at time.Time) {
+	session.Flagged = true
+	session.FlagReason = reason
+	session.FlaggedAt = &at
+}
+
+// browser reduces a user agent to the browser and platform it names,
+// dropping version numbers so that browser updates go unnoticed
+func browser(userAgent string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '.' || r == '_' {
+			return -1
+		}
+		return r
+	}, userAgent)
+}
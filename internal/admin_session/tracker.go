@@ -0,0 +1,45 @@
+package admin_session
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// recordTimeout bounds recording a request in the background
+const recordTimeout = 10 * time.Second
+
+// Track records the requests authenticated by the JWT middleware in their
+// session. Recording happens in the background so it never slows down or
+// fails admin requests.
+func Track(sessionService SessionService, logger *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		sessionKey := c.GetString("session_id")
+		if sessionKey == "" {
+			return
+		}
+
+		usage := Usage{
+			SessionKey: sessionKey,
+			UserID:     c.GetString("user_id"),
+			Email:      c.GetString("email"),
+			IPAddress:  c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+			IssuedAt:   c.GetTime("token_issued_at"),
+			At:         time.Now().UTC(),
+		}
+
+		// Keep the tenant of the request but not its cancellation
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), recordTimeout)
+		go func() {
+			defer cancel()
+			if err := sessionService.Record(ctx, usage); err != nil {
+				logger.Warn("Failed to record admin session", "error", err, "email", usage.Email)
+			}
+		}()
+	}
+}
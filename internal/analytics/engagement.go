@@ -0,0 +1,60 @@
+package analytics
+
+import (
+	"github.com/google/uuid"
+)
+
+// EngagementEvent records that a visitor reached a section of a case study
+// and how long it was visible
+// @Description Section of a case study reached by a visitor
+// @Name EngagementEvent
+type EngagementEvent struct {
+	ProjectID uuid.UUID `json:"project_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Section   string    `json:"section" validate:"required,max=50" example:"results"`
+	// VisibleMs is how long the section was on screen, in milliseconds
+	VisibleMs int `json:"visible_ms" validate:"min=0" example:"12500"`
+}
+
+// EngagementBatch is the input for recording the sections a visitor
+// reached, sent in one request when the page is hidden
+// @Name EngagementBatch
+type EngagementBatch struct {
+	Events []EngagementEvent `json:"events" validate:"required,min=1,max=50"`
+}
+
+// EngagementCount is the number of times a section of a project was
+// reached on a day and how long it was visible in total. Events are added
+// to these counters rather than stored one by one.
+type EngagementCount struct {
+	ProjectID uuid.UUID `json:"project_id" db:"project_id"`
+	Section   string    `json:"section" db:"section"`
+	Reached   int       `json:"reached" db:"reached"`
+	VisibleMs int64     `json:"visible_ms" db:"visible_ms"`
+}
+
+// SectionEngagement is how far visitors read into a section of a project
+// @Description How often a section of a case study was reached and read
+// @Name SectionEngagement
+type SectionEngagement struct {
+	Section string `json:"section" example:"results"`
+	Reached int    `json:"reached" example:"84"`
+	// ReachRate is the share of the visits reaching the section
+	ReachRate float64 `json:"reach_rate" example:"0.62"`
+	// AvgVisibleMs is how long the section stayed on screen per visit
+	// reaching it, in milliseconds
+	AvgVisibleMs int64 `json:"avg_visible_ms" example:"9800"`
+}
+
+// ProjectEngagement is how visitors read the case study of a project
+// @Description How visitors read the case study of a project
+// @Name ProjectEngagement
+type ProjectEngagement struct {
+	ProjectID uuid.UUID `json:"project_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title     string    `json:"title,omitempty" example:"Portfolio Website"`
+	// Visits is the number of times the most reached section was reached
+	Visits int `json:"visits" example:"135"`
+	// AvgTimeMs is the time sections stayed on screen per visit, in
+	// milliseconds
+	AvgTimeMs int64               `json:"avg_time_ms" example:"48200"`
+	Sections  []SectionEngagement `json:"sections"`
+}
@@ -0,0 +1,70 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type EngagementRepository interface {
+	// Add adds counts to the counters of a day
+	Add(ctx context.Context, day string, counts []EngagementCount) error
+	// Summary sums the counters of every project and section between two
+	// days, both included
+	Summary(ctx context.Context, fromDay, toDay string) ([]EngagementCount, error)
+}
+
+type engagementRepository struct {
+	supabaseClient *supabase.SupabaseClient
+}
+
+func NewEngagementRepository(supabaseClient *supabase.SupabaseClient) EngagementRepository {
+	return &engagementRepository{
+		supabaseClient: supabaseClient,
+	}
+}
+
+func (r *engagementRepository) Add(ctx context.Context, day string, counts []EngagementCount) error {
+	result := r.supabaseClient.GetClient().Rpc("analytics_add_engagement", "", map[string]interface{}{
+		"p_tenant_id": base.TenantIDFromContext(ctx),
+		"p_day":       day,
+		"p_counts":    counts,
+	})
+
+	// The function returns the number of counters written
+	if _, err := strconv.Atoi(result); err != nil {
+		return errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to record engagement",
+		)
+	}
+	return nil
+}
+
+func (r *engagementRepository) Summary(ctx context.Context, fromDay, toDay string) ([]EngagementCount, error) {
+	result := r.supabaseClient.GetClient().Rpc("analytics_engagement_summary", "", map[string]interface{}{
+		"p_tenant_id": base.TenantIDFromContext(ctx),
+		"p_from":      fromDay,
+		"p_to":        toDay,
+	})
+	if result == "" {
+		return nil, errors.New(errors.ErrDatabase, "failed to aggregate engagement", nil)
+	}
+
+	var counts []EngagementCount
+	if err := json.Unmarshal([]byte(result), &counts); err != nil {
+		return nil, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to aggregate engagement",
+		)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// maxVisibleMs caps the time a section is counted as visible in one visit,
+// so a tab left open overnight does not skew averages
+const maxVisibleMs = 30 * 60 * 1000
+
+// sectionPattern is the form of section names, e.g. "results" or
+// "tech_stack"
+var sectionPattern = regexp.MustCompile(`^[a-z0-9]+([_-][a-z0-9]+)*$`)
+
+type EngagementService interface {
+	// RecordEngagement adds the sections a visitor reached in one visit to
+	// the counters of the day
+	RecordEngagement(ctx context.Context, batch *EngagementBatch, meta RequestMeta) error
+	// Report sums how far visitors read into every case study within a time
+	// range, most visited first
+	Report(ctx context.Context, from, to time.Time) ([]ProjectEngagement, error)
+}
+
+type engagementService struct {
+	engagementRepo EngagementRepository
+	projectService project.ProjectService
+	respectDNT     bool
+}
+
+func NewEngagementService(engagementRepo EngagementRepository, projectService project.ProjectService, respectDNT bool) EngagementService {
+	return &engagementService{
+		engagementRepo: engagementRepo,
+		projectService: projectService,
+		respectDNT:     respectDNT,
+	}
+}
+
+// engagementKey identifies the counter of a section of a project
+type engagementKey struct {
+	projectID uuid.UUID
+	section   string
+}
+
+func (s *engagementService) RecordEngagement(ctx context.Context, batch *EngagementBatch, meta RequestMeta) error {
+	// Validate input
+	if err := validator.ValidateModel(batch); err != nil {
+		return err
+	}
+
+	// Visitors opting out with Do-Not-Track or Global Privacy Control are not recorded
+	if meta.DoNotTrack && s.respectDNT {
+		return nil
+	}
+
+	// A batch is one visit, so a section reached twice counts once while
+	// the time it was visible adds up
+	counts := map[engagementKey]*EngagementCount{}
+	var order []engagementKey
+	for _, event := range batch.Events {
+		section := strings.ToLower(strings.TrimSpace(event.Section))
+		if event.ProjectID == uuid.Nil || !sectionPattern.MatchString(section) {
+			return errors.New(
+				errors.ErrValidation,
+				"Events need a project ID and a section of lowercase letters, digits, dashes and underscores",
+				nil,
+				errors.WithContext("section", event.Section),
+			)
+		}
+
+		key := engagementKey{projectID: event.ProjectID, section: section}
+		count, ok := counts[key]
+		if !ok {
+			count = &EngagementCount{ProjectID: event.ProjectID, Section: section, Reached: 1}
+			counts[key] = count
+			order = append(order, key)
+		}
+		count.VisibleMs = min(count.VisibleMs+int64(max(event.VisibleMs, 0)), maxVisibleMs)
+	}
+
+	// Events of unknown projects are dropped rather than counted forever
+	known := map[uuid.UUID]bool{}
+	rows := make([]EngagementCount, 0, len(order))
+	for _, key := range order {
+		exists, ok := known[key.projectID]
+		if !ok {
+			_, err := s.projectService.GetProjectByID(ctx, key.projectID.String())
+			if err != nil && !errors.Is(err, errors.ErrNotFound) {
+				return err
+			}
+			exists = err == nil
+			known[key.projectID] = exists
+		}
+		if exists {
+			rows = append(rows, *counts[key])
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return s.engagementRepo.Add(ctx, time.Now().UTC().Format(time.DateOnly), rows)
+}
+
+func (s *engagementService) Report(ctx context.Context, from, to time.Time) ([]ProjectEngagement, error) {
+	if err := checkSummaryRange(from, to); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.engagementRepo.Summary(ctx, from.UTC().Format(time.DateOnly), to.UTC().Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	byProject := map[uuid.UUID]*ProjectEngagement{}
+	totals := map[uuid.UUID]int64{}
+	var report []*ProjectEngagement
+	for _, count := range counts {
+		engagement, ok := byProject[count.ProjectID]
+		if !ok {
+			engagement = &ProjectEngagement{ProjectID: count.ProjectID}
+			byProject[count.ProjectID] = engagement
+			report = append(report, engagement)
+		}
+		engagement.Visits = max(engagement.Visits, count.Reached)
+		totals[count.ProjectID] += count.VisibleMs
+
+		section := SectionEngagement{Section: count.Section, Reached: count.Reached}
+		if count.Reached > 0 {
+			section.AvgVisibleMs = count.VisibleMs / int64(count.Reached)
+		}
+		engagement.Sections = append(engagement.Sections, section)
+	}
+
+	result := make([]ProjectEngagement, 0, len(report))
+	for _, engagement := range report {
+		if engagement.Visits > 0 {
+			engagement.AvgTimeMs = totals[engagement.ProjectID] / int64(engagement.Visits)
+			for i := range engagement.Sections {
+				engagement.Sections[i].ReachRate = float64(engagement.Sections[i].Reached) / float64(engagement.Visits)
+			}
+		}
+		sort.Slice(engagement.Sections, func(i, j int) bool {
+			return engagement.Sections[i].Reached > engagement.Sections[j].Reached
+		})
+
+		// Projects deleted since keep their numbers without a title
+		if dto, err := s.projectService.GetProjectByID(ctx, engagement.ProjectID.String()); err == nil {
+			engagement.Title = dto.Title
+		} else if !errors.Is(err, errors.ErrNotFound) {
+			return nil, err
+		}
+
+		result = append(result, *engagement)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Visits > result[j].Visits })
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package analytics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FunnelStep is an event visitors go through on their way to converting.
+// Page view steps match a path, a path ending in /* matching every path
+// below it; custom event steps match an event name and optionally a path.
+// @Description Event visitors go through on their way to converting
+// @Name FunnelStep
+type FunnelStep struct {
+	Name      string    `json:"name" example:"View a project"`
+	Type      EventType `json:"type" example:"pageview"`
+	Path      string    `json:"path,omitempty" example:"/projects/*"`
+	EventName string    `json:"event_name,omitempty" example:"contact_submit"`
+}
+
+// Funnel is a named sequence of steps whose step-through rates are reported
+// @Description Named sequence of steps visitors go through
+// @Name Funnel
+type Funnel struct {
+	ID          uuid.UUID    `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID   `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Name        string       `json:"name" db:"name" example:"Case study to inquiry"`
+	Description string       `json:"description,omitempty" db:"description" example:"Whether case studies lead to inquiries"`
+	Steps       []FunnelStep `json:"steps" db:"steps"`
+	CreatedAt   *time.Time   `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time   `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// FunnelCreate is the input for defining a funnel
+// @Name FunnelCreate
+type FunnelCreate struct {
+	Name        string       `json:"name" validate:"required,max=100" example:"Case study to inquiry"`
+	Description string       `json:"description" validate:"max=500" example:"Whether case studies lead to inquiries"`
+	Steps       []FunnelStep `json:"steps" validate:"required,min=2,max=10"`
+}
+
+// FunnelUpdate is the input for changing a funnel
+// @Name FunnelUpdate
+type FunnelUpdate struct {
+	ID          uuid.UUID    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string       `json:"name" validate:"max=100" example:"Case study to inquiry"`
+	Description *string      `json:"description" validate:"omitempty,max=500" example:"Whether case studies lead to inquiries"`
+	Steps       []FunnelStep `json:"steps" validate:"omitempty,min=2,max=10"`
+}
+
+// FunnelStepResult is how many visitors reached a step
+// @Description Visitors who reached a step of a funnel
+// @Name FunnelStepResult
+type FunnelStepResult struct {
+	Name     string `json:"name" example:"View a project"`
+	Visitors int    `json:"visitors" example:"120"`
+	// StepRate is the share of the visitors of the previous step who
+	// reached this one, 1 for the first step
+	StepRate float64 `json:"step_rate" example:"0.25"`
+	// OverallRate is the share of the visitors of the first step who
+	// reached this one
+	OverallRate float64 `json:"overall_rate" example:"0.05"`
+}
+
+// FunnelReport is how visitors went through a funnel within a time range
+// @Description Visitors reaching each step of a funnel within a time range
+// @Name FunnelReport
+type FunnelReport struct {
+	FunnelID uuid.UUID          `json:"funnel_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string             `json:"name" example:"Case study to inquiry"`
+	From     time.Time          `json:"from"`
+	To       time.Time          `json:"to"`
+	Steps    []FunnelStepResult `json:"steps"`
+}
+
+// matches reports whether an event is the step
+func (st FunnelStep) matches(event *AnalyticsEvent) bool {
+	if event.Type != st.Type {
+		return false
+	}
+	if st.Type == EventCustom && event.Name != st.EventName {
+		return false
+	}
+	if st.Path == "" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(st.Path, "/*"); ok {
+		return event.Path == prefix || strings.HasPrefix(event.Path, prefix+"/")
+	}
+	return event.Path == st.Path
+}
+
+// funnelCounter follows visitors through the steps of a funnel, counting
+// a step for a visitor only after they reached the previous ones
+type funnelCounter struct {
+	steps   []FunnelStep
+	reached map[string]int
+}
+
+func newFunnelCounter(steps []FunnelStep) *funnelCounter {
+	return &funnelCounter{steps: steps, reached: map[string]int{}}
+}
+
+// add moves the visitor of an event one step further when it is their
+// next step. Events must be added in the order they happened.
+func (f *funnelCounter) add(event *AnalyticsEvent) {
+	if event.VisitorID == "" {
+		return
+	}
+
+	next := f.reached[event.VisitorID]
+	if next < len(f.steps) && f.steps[next].matches(event) {
+		f.reached[event.VisitorID] = next + 1
+	}
+}
+
+// results counts the visitors reaching every step
+func (f *funnelCounter) results() []FunnelStepResult {
+	visitors := make([]int, len(f.steps))
+	for _, reached := range f.reached {
+		for i := range reached {
+			visitors[i]++
+		}
+	}
+
+	results := make([]FunnelStepResult, len(f.steps))
+	for i, step := range f.steps {
+		results[i] = FunnelStepResult{Name: step.Name, Visitors: visitors[i]}
+		if i == 0 {
+			if visitors[0] > 0 {
+				results[i].StepRate, results[i].OverallRate = 1, 1
+			}
+			continue
+		}
+		if visitors[i-1] > 0 {
+			results[i].StepRate = float64(visitors[i]) / float64(visitors[i-1])
+		}
+		if visitors[0] > 0 {
+			results[i].OverallRate = float64(visitors[i]) / float64(visitors[0])
+		}
+	}
+	return results
+}
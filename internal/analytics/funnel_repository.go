@@ -0,0 +1,152 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type FunnelRepository interface {
+	Create(ctx context.Context, funnel *Funnel) (*Funnel, error)
+	Update(ctx context.Context, funnel *Funnel) (*Funnel, error)
+	Delete(ctx context.Context, id string) error
+	// FindByID returns the funnel with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Funnel, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Funnel, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type funnelRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewFunnelRepository(supabaseClient *supabase.SupabaseClient) FunnelRepository {
+	return &funnelRepository{
+		supabaseClient: supabaseClient,
+		table:          "analytics_funnel",
+	}
+}
+
+func (r *funnelRepository) Create(ctx context.Context, funnel *Funnel) (*Funnel, error) {
+	funnel.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(funnel, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create funnel")
+	}
+	return funnel, nil
+}
+
+func (r *funnelRepository) Update(ctx context.Context, funnel *Funnel) (*Funnel, error) {
+	funnel.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(funnel, "minimal", "").
+		Eq("id", funnel.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update funnel")
+	}
+	return funnel, nil
+}
+
+func (r *funnelRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete funnel")
+	}
+	return nil
+}
+
+func (r *funnelRepository) FindByID(ctx context.Context, id string) (*Funnel, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *funnelRepository) List(ctx context.Context, opts base.ListOptions) ([]Funnel, error) {
+	var funnels []Funnel
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, by name by default
+	sortBy, ascending := "name", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&funnels)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list funnels")
+	}
+
+	return funnels, nil
+}
+
+func (r *funnelRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count funnels")
+	}
+
+	return int(count), nil
+}
+
+func (r *funnelRepository) findOne(ctx context.Context, field, value string) (*Funnel, error) {
+	var funnels []Funnel
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&funnels)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find funnel")
+	}
+
+	if len(funnels) == 0 {
+		return nil, nil
+	}
+	return &funnels[0], nil
+}
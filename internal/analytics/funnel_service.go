@@ -0,0 +1,241 @@
+package analytics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// maxFunnelRange caps the time range of funnel reports, which read every
+	// event of the range
+	maxFunnelRange = 92 * 24 * time.Hour
+
+	// funnelBatchSize is the number of events read at once for a report
+	funnelBatchSize = 1000
+)
+
+type FunnelService interface {
+	CreateFunnel(ctx context.Context, funnelCreate *FunnelCreate) (*Funnel, error)
+	UpdateFunnel(ctx context.Context, funnelUpdate *FunnelUpdate) (*Funnel, error)
+	DeleteFunnel(ctx context.Context, id string) error
+	GetFunnel(ctx context.Context, id string) (*Funnel, error)
+	ListFunnels(ctx context.Context, opts base.ListOptions) ([]Funnel, error)
+	CountFunnels(ctx context.Context, filters []base.FilterOption) (int, error)
+	// Report counts the visitors reaching each step of a funnel, in order,
+	// within a time range. Visitor IDs change every day, so a visitor is
+	// followed through the steps they took on one day.
+	Report(ctx context.Context, id string, from, to time.Time) (*FunnelReport, error)
+}
+
+type funnelService struct {
+	funnelRepo    FunnelRepository
+	analyticsRepo AnalyticsRepository
+}
+
+func NewFunnelService(funnelRepo FunnelRepository, analyticsRepo AnalyticsRepository) FunnelService {
+	return &funnelService{
+		funnelRepo:    funnelRepo,
+		analyticsRepo: analyticsRepo,
+	}
+}
+
+func (s *funnelService) CreateFunnel(ctx context.Context, funnelCreate *FunnelCreate) (*Funnel, error) {
+	// Validate input
+	if err := validator.ValidateModel(funnelCreate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	funnel := &Funnel{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(funnelCreate.Name),
+		Description: strings.TrimSpace(funnelCreate.Description),
+		Steps:       funnelCreate.Steps,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	if err := checkSteps(funnel.Steps); err != nil {
+		return nil, err
+	}
+
+	return s.funnelRepo.Create(ctx, funnel)
+}
+
+func (s *funnelService) UpdateFunnel(ctx context.Context, funnelUpdate *FunnelUpdate) (*Funnel, error) {
+	// Validate input
+	if err := validator.ValidateModel(funnelUpdate); err != nil {
+		return nil, err
+	}
+
+	funnel, err := s.GetFunnel(ctx, funnelUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if name := strings.TrimSpace(funnelUpdate.Name); name != "" {
+		funnel.Name = name
+	}
+	if funnelUpdate.Description != nil {
+		funnel.Description = strings.TrimSpace(*funnelUpdate.Description)
+	}
+	if funnelUpdate.Steps != nil {
+		if err := checkSteps(funnelUpdate.Steps); err != nil {
+			return nil, err
+		}
+		funnel.Steps = funnelUpdate.Steps
+	}
+
+	now := time.Now().UTC()
+	funnel.UpdatedAt = &now
+
+	return s.funnelRepo.Update(ctx, funnel)
+}
+
+func (s *funnelService) DeleteFunnel(ctx context.Context, id string) error {
+	if _, err := s.GetFunnel(ctx, id); err != nil {
+		return err
+	}
+
+	return s.funnelRepo.Delete(ctx, id)
+}
+
+func (s *funnelService) GetFunnel(ctx context.Context, id string) (*Funnel, error) {
+	funnel, err := s.funnelRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if funnel == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Funnel not found",
+			nil,
+			errors.WithContext("funnel_id", id),
+		)
+	}
+	return funnel, nil
+}
+
+func (s *funnelService) ListFunnels(ctx context.Context, opts base.ListOptions) ([]Funnel, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.funnelRepo.List(ctx, opts)
+}
+
+func (s *funnelService) CountFunnels(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.funnelRepo.Count(ctx, filters)
+}
+
+func (s *funnelService) Report(ctx context.Context, id string, from, to time.Time) (*FunnelReport, error) {
+	if !from.Before(to) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"From must be before to",
+			nil,
+		)
+	}
+	if to.Sub(from) > maxFunnelRange {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Time range must not exceed 92 days",
+			nil,
+		)
+	}
+
+	funnel, err := s.GetFunnel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := newFunnelCounter(funnel.Steps)
+	for offset := 0; ; offset += funnelBatchSize {
+		events, err := s.analyticsRepo.ListBetween(ctx, from, to, offset, funnelBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for i := range events {
+			counter.add(&events[i])
+		}
+		if len(events) < funnelBatchSize {
+			break
+		}
+	}
+
+	return &FunnelReport{
+		FunnelID: funnel.ID,
+		Name:     funnel.Name,
+		From:     from.UTC(),
+		To:       to.UTC(),
+		Steps:    counter.results(),
+	}, nil
+}
+
+// checkSteps makes sure every step says which events it matches
+func checkSteps(steps []FunnelStep) error {
+	for i := range steps {
+		step := &steps[i]
+		step.Name = strings.TrimSpace(step.Name)
+		step.Path = strings.TrimSpace(step.Path)
+		step.EventName = strings.TrimSpace(step.EventName)
+
+		if step.Name == "" {
+			return errors.New(
+				errors.ErrValidation,
+				"Step name is required",
+				nil,
+				errors.WithContext("step", i+1),
+			)
+		}
+
+		switch step.Type {
+		case EventPageView:
+			step.EventName = ""
+			if step.Path == "" {
+				return errors.New(
+					errors.ErrValidation,
+					"Page view steps need a path",
+					nil,
+					errors.WithContext("step", step.Name),
+				)
+			}
+		case EventCustom:
+			if step.EventName == "" {
+				return errors.New(
+					errors.ErrValidation,
+					"Custom event steps need an event name",
+					nil,
+					errors.WithContext("step", step.Name),
+				)
+			}
+		default:
+			return errors.New(
+				errors.ErrValidation,
+				"Step type must be pageview or event",
+				nil,
+				errors.WithContext("step", step.Name),
+			)
+		}
+
+		if step.Path != "" && !strings.HasPrefix(step.Path, "/") {
+			return errors.New(
+				errors.ErrValidation,
+				"Step path must start with a slash",
+				nil,
+				errors.WithContext("step", step.Name),
+			)
+		}
+	}
+	return nil
+}
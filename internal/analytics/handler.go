@@ -0,0 +1,418 @@
+package analytics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+const (
+	// defaultSummaryRange is the time range of aggregations when none is
+	// requested
+	defaultSummaryRange = 30 * 24 * time.Hour
+
+	// defaultFunnelRange is the time range of funnel reports when none is
+	// requested
+	defaultFunnelRange = 30 * 24 * time.Hour
+)
+
+type AnalyticsHandler struct {
+	base.BaseHandler
+	analyticsService  AnalyticsService
+	funnelService     FunnelService
+	engagementService EngagementService
+}
+
+func NewAnalyticsHandler(analyticsService AnalyticsService, funnelService FunnelService, engagementService EngagementService, logger *logger.Logger) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		analyticsService:  analyticsService,
+		funnelService:     funnelService,
+		engagementService: engagementService,
+	}
+}
+
+// TrackEvent records a visitor event
+// @Summary Record an analytics event
+// @Description Record a page view or custom event with the campaign the visitor arrived with, read from the utm_ parameters of the path when not given. The visitor location is resolved from the client IP using a local GeoIP database and visitors are identified by a daily-rotating salted hash. Requests with DNT: 1 or Sec-GPC: 1 are not recorded. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers.
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param event body AnalyticsEventCreate true "Event Details"
+// @Success 200 {object} response.APIResponse "Event recorded successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "Missing or invalid challenge"
+// @Router /analytics/events [post]
+func (h *AnalyticsHandler) TrackEvent(c *gin.Context) {
+	var eventInput AnalyticsEventCreate
+
+	if err := h.ValidateRequest(c, &eventInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.analyticsService.TrackEvent(c.Request.Context(), &eventInput, requestMeta(c)); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Event recorded successfully")
+}
+
+// RecordEngagement records the case study sections a visitor reached
+// @Summary Record section engagement
+// @Description Record in one request the case study sections a visitor reached during a visit and how long each was on screen, typically sent with fetch and keepalive when the page is hidden, after solving a challenge from GET /challenge. Events are added to daily counters per project and section rather than stored one by one; events of unknown projects are dropped. Requests with DNT: 1 or Sec-GPC: 1 are not recorded.
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param batch body EngagementBatch true "Sections reached"
+// @Success 200 {object} response.APIResponse "Engagement recorded successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "Missing or invalid challenge"
+// @Router /analytics/engagement [post]
+func (h *AnalyticsHandler) RecordEngagement(c *gin.Context) {
+	var batchInput EngagementBatch
+	if err := h.ValidateRequest(c, &batchInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.engagementService.RecordEngagement(c.Request.Context(), &batchInput, requestMeta(c)); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Engagement recorded successfully")
+}
+
+// GetEngagementReport reports how far visitors read into case studies
+// @Summary Get the engagement report
+// @Description Retrieve per project the visits to its case study, the time sections stayed on screen per visit and how often each section was reached, within a time range, most visited first
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "Range end (RFC3339 or YYYY-MM-DD), defaults to now"
+// @Success 200 {object} response.APIResponse{data=[]ProjectEngagement} "Engagement retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /analytics/engagement [get]
+func (h *AnalyticsHandler) GetEngagementReport(c *gin.Context) {
+	from, to, err := parseTimeRange(c, defaultSummaryRange)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	report, err := h.engagementService.Report(c.Request.Context(), from, to)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Engagement retrieved successfully")
+}
+
+// GetGeoStats retrieves visits grouped by location
+// @Summary Get visitors by location
+// @Description Retrieve the number of visits per country, or per country and region, within a time range
+// @Tags Analytics
+// @Produce json
+// @Param from query string false "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "Range end (RFC3339 or YYYY-MM-DD), defaults to now"
+// @Param group_by query string false "Aggregation level" Enums(country, region) default(country)
+// @Success 200 {object} response.APIResponse{data=[]GeoStat} "Visitor locations retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /analytics/geo [get]
+func (h *AnalyticsHandler) GetGeoStats(c *gin.Context) {
+	from, to, err := parseTimeRange(c, defaultSummaryRange)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	stats, err := h.analyticsService.GeoSummary(c.Request.Context(), from, to, GeoGroup(c.Query("group_by")))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, stats, "Visitor locations retrieved successfully")
+}
+
+// GetCampaignStats retrieves visits and inquiries grouped by campaign
+// @Summary Get visits and inquiries by campaign
+// @Description Retrieve the page views, visitors and inquiries of every utm_source, utm_medium and utm_campaign combination within a time range, most visited first
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "Range end (RFC3339 or YYYY-MM-DD), defaults to now"
+// @Success 200 {object} response.APIResponse{data=[]CampaignStat} "Campaigns retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /analytics/campaigns [get]
+func (h *AnalyticsHandler) GetCampaignStats(c *gin.Context) {
+	from, to, err := parseTimeRange(c, defaultSummaryRange)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	stats, err := h.analyticsService.CampaignSummary(c.Request.Context(), from, to)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, stats, "Campaigns retrieved successfully")
+}
+
+// ListFunnels retrieves the funnels
+// @Summary List funnels
+// @Description Retrieve a paginated list of funnels, by name
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]Funnel} "Funnels retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /analytics/funnels [get]
+func (h *AnalyticsHandler) ListFunnels(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	funnels, err := h.funnelService.ListFunnels(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.funnelService.CountFunnels(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, funnels, "Funnels retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetFunnel retrieves a funnel
+// @Summary Get a funnel
+// @Description Retrieve a funnel by its ID
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Funnel ID"
+// @Success 200 {object} response.APIResponse{data=Funnel} "Funnel retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Funnel not found"
+// @Router /analytics/funnels/{id} [get]
+func (h *AnalyticsHandler) GetFunnel(c *gin.Context) {
+	funnelID := c.Param("id")
+	if _, err := h.ValidateUUID(funnelID, "Funnel ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	funnel, err := h.funnelService.GetFunnel(c.Request.Context(), funnelID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, funnel, "Funnel retrieved successfully")
+}
+
+// CreateFunnel defines a funnel
+// @Summary Create a funnel
+// @Description Define the steps visitors go through on their way to converting, e.g. viewing a project, then the contact page, then submitting the form. Page view steps match a path, ending in /* to match every path below it; custom event steps match an event name.
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param funnel body FunnelCreate true "Funnel Details"
+// @Success 200 {object} response.APIResponse{data=Funnel} "Funnel created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /analytics/funnels [post]
+func (h *AnalyticsHandler) CreateFunnel(c *gin.Context) {
+	var funnelInput FunnelCreate
+	if err := h.ValidateRequest(c, &funnelInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	funnel, err := h.funnelService.CreateFunnel(c.Request.Context(), &funnelInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, funnel, "Funnel created successfully")
+}
+
+// UpdateFunnel changes a funnel
+// @Summary Update a funnel
+// @Description Change the name, description or steps of a funnel
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Funnel ID"
+// @Param funnel body FunnelUpdate true "Funnel Update Details"
+// @Success 200 {object} response.APIResponse{data=Funnel} "Funnel updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Funnel not found"
+// @Router /analytics/funnels/{id} [put]
+func (h *AnalyticsHandler) UpdateFunnel(c *gin.Context) {
+	funnelID, err := h.ValidateUUID(c.Param("id"), "Funnel ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var funnelInput FunnelUpdate
+	if err := h.ValidateRequest(c, &funnelInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	funnelInput.ID = funnelID
+
+	funnel, err := h.funnelService.UpdateFunnel(c.Request.Context(), &funnelInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, funnel, "Funnel updated successfully")
+}
+
+// DeleteFunnel removes a funnel
+// @Summary Delete a funnel
+// @Description Remove a funnel; the events it counted are kept
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Funnel ID"
+// @Success 200 {object} response.APIResponse "Funnel deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Funnel not found"
+// @Router /analytics/funnels/{id} [delete]
+func (h *AnalyticsHandler) DeleteFunnel(c *gin.Context) {
+	funnelID := c.Param("id")
+	if _, err := h.ValidateUUID(funnelID, "Funnel ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.funnelService.DeleteFunnel(c.Request.Context(), funnelID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Funnel deleted successfully")
+}
+
+// GetFunnelReport computes the step-through rates of a funnel
+// @Summary Get a funnel report
+// @Description Count the visitors reaching each step of a funnel in order within a time range of at most 92 days, with the share of the previous step and of the first step reaching it. Visitor IDs rotate daily, so a visitor is followed through the steps they took on one day.
+// @Tags Analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Funnel ID"
+// @Param from query string false "Range start (RFC3339 or YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "Range end (RFC3339 or YYYY-MM-DD), defaults to now"
+// @Success 200 {object} response.APIResponse{data=FunnelReport} "Funnel report retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Funnel not found"
+// @Router /analytics/funnels/{id}/report [get]
+func (h *AnalyticsHandler) GetFunnelReport(c *gin.Context) {
+	funnelID := c.Param("id")
+	if _, err := h.ValidateUUID(funnelID, "Funnel ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	from, to, err := parseTimeRange(c, defaultFunnelRange)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	report, err := h.funnelService.Report(c.Request.Context(), funnelID, from, to)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Funnel report retrieved successfully")
+}
+
+// requestMeta reads the request details events are enriched with
+func requestMeta(c *gin.Context) RequestMeta {
+	return RequestMeta{
+		IP:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+		DoNotTrack: c.GetHeader("DNT") == "1" || c.GetHeader("Sec-GPC") == "1",
+	}
+}
+
+// parseTimeRange reads the from and to query parameters, defaulting to the
+// given range ending now
+func parseTimeRange(c *gin.Context, defaultRange time.Duration) (time.Time, time.Time, error) {
+	to := time.Now().UTC()
+	if value := c.Query("to"); value != "" {
+		parsed, err := parseTime(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to: %w", err)
+		}
+		to = parsed
+	}
+
+	from := to.Add(-defaultRange)
+	if value := c.Query("from"); value != "" {
+		parsed, err := parseTime(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from: %w", err)
+		}
+		from = parsed
+	}
+
+	return from, to, nil
+}
+
+// parseTime parses an RFC3339 timestamp or a YYYY-MM-DD date
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
@@ -0,0 +1,116 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+// EventType represents the kind of analytics event
+// @Description Kind of analytics event
+// @Name EventType
+type EventType string
+
+// GeoGroup represents the granularity of geographic aggregation
+// @Description Granularity of geographic aggregation
+// @Name GeoGroup
+type GeoGroup string
+
+const (
+	EventPageView EventType = "pageview"
+	EventCustom   EventType = "event"
+
+	GeoByCountry GeoGroup = "country"
+	GeoByRegion  GeoGroup = "region"
+)
+
+// AnalyticsEvent represents a recorded visitor event
+// @Description Recorded visitor event enriched with its location
+// @Name AnalyticsEvent
+type AnalyticsEvent struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Type     EventType  `json:"type" db:"type" example:"pageview"`
+	Name     string     `json:"name,omitempty" db:"name" example:"cta_click"`
+	Path     string     `json:"path" db:"path" example:"/projects/portfolio-website"`
+	Referrer string     `json:"referrer,omitempty" db:"referrer" example:"https://www.google.com/"`
+
+	// Campaign the visitor arrived with
+	base.UTM
+
+	// VisitorID is a salted hash of the visitor that rotates daily
+	VisitorID string `json:"visitor_id,omitempty" db:"visitor_id" example:"9f86d081884c7d659a2feaa0c55ad015"`
+
+	// Location
+	CountryCode string `json:"country_code,omitempty" db:"country_code" example:"ID"`
+	Region      string `json:"region,omitempty" db:"region" example:"West Java"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+}
+
+// AnalyticsEventCreate represents the input for recording an analytics event
+// @Name AnalyticsEventCreate
+type AnalyticsEventCreate struct {
+	Type     EventType `json:"type" validate:"required" example:"pageview"`
+	Name     string    `json:"name" validate:"max=100" example:"cta_click"`
+	Path     string    `json:"path" validate:"required,max=2048" example:"/projects/portfolio-website"`
+	Referrer string    `json:"referrer" validate:"max=2048" example:"https://www.google.com/"`
+
+	// Campaign the visitor arrived with, read from the query string of the
+	// path when left empty
+	base.UTM
+}
+
+// RequestMeta carries request details used to enrich an event
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+
+	// DoNotTrack is set when the client sent DNT: 1 or Sec-GPC: 1
+	DoNotTrack bool
+}
+
+// DailySalt is the secret mixed into visitor hashes for a single day
+type DailySalt struct {
+	Day       string     `json:"day" db:"day"`
+	Salt      string     `json:"salt" db:"salt"`
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+}
+
+// GeoStat represents the number of visits from a location
+// @Description Number of visits from a country or region
+// @Name GeoStat
+type GeoStat struct {
+	CountryCode string `json:"country_code" example:"ID"`
+	Region      string `json:"region,omitempty" example:"West Java"`
+	Visits      int    `json:"visits" example:"128"`
+}
+
+// CampaignStat represents the visits and inquiries of a campaign
+// @Description Visits and inquiries of a campaign
+// @Name CampaignStat
+type CampaignStat struct {
+	Source   string `json:"utm_source" example:"newsletter"`
+	Medium   string `json:"utm_medium" example:"email"`
+	Campaign string `json:"utm_campaign" example:"launch-2026"`
+	// Visits counts the page views that arrived with the campaign
+	Visits int `json:"visits" example:"240"`
+	// Visitors counts the distinct visitors of those page views, per day
+	Visitors int `json:"visitors" example:"198"`
+	// Conversions counts the inquiries submitted with the campaign
+	Conversions int `json:"conversions" example:"4"`
+	// ConversionRate is conversions per visitor
+	ConversionRate float64 `json:"conversion_rate" example:"0.0202"`
+}
+
+// ToAnalyticsEvent converts AnalyticsEventCreate to AnalyticsEvent
+func (ec *AnalyticsEventCreate) ToAnalyticsEvent() AnalyticsEvent {
+	return AnalyticsEvent{
+		Type:     ec.Type,
+		Name:     ec.Name,
+		Path:     ec.Path,
+		Referrer: ec.Referrer,
+		UTM:      ec.UTM,
+	}
+}
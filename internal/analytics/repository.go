@@ -0,0 +1,187 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type AnalyticsRepository interface {
+	Create(ctx context.Context, event *AnalyticsEvent) (*AnalyticsEvent, error)
+	GeoSummary(ctx context.Context, from, to time.Time, groupBy GeoGroup) ([]GeoStat, error)
+	CampaignSummary(ctx context.Context, from, to time.Time) ([]CampaignStat, error)
+	// TotalViews counts every page view ever recorded, including those of
+	// pruned events
+	TotalViews(ctx context.Context) (int64, error)
+	// ListBetween returns a page of the events recorded within a time range,
+	// oldest first
+	ListBetween(ctx context.Context, from, to time.Time, offset, limit int) ([]AnalyticsEvent, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+	FindSalt(ctx context.Context, day string) (*DailySalt, error)
+	CreateSalt(ctx context.Context, salt *DailySalt) error
+	DeleteSaltsBefore(ctx context.Context, day string) error
+}
+
+type analyticsRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+	saltTable      string
+}
+
+func NewAnalyticsRepository(supabaseClient *supabase.SupabaseClient) AnalyticsRepository {
+	return &analyticsRepository{
+		supabaseClient: supabaseClient,
+		table:          "analytics_event",
+		saltTable:      "analytics_salt",
+	}
+}
+
+func (r *analyticsRepository) Create(ctx context.Context, event *AnalyticsEvent) (*AnalyticsEvent, error) {
+	event.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(event, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create analytics event")
+	}
+	return event, nil
+}
+
+func (r *analyticsRepository) GeoSummary(ctx context.Context, from, to time.Time, groupBy GeoGroup) ([]GeoStat, error) {
+	result := r.supabaseClient.GetClient().Rpc("analytics_geo_summary", "", map[string]interface{}{
+		"p_tenant_id": base.TenantIDFromContext(ctx),
+		"p_from":      from.UTC(),
+		"p_to":        to.UTC(),
+		"p_group_by":  string(groupBy),
+	})
+	if result == "" {
+		return nil, errors.New(errors.ErrDatabase, "failed to aggregate analytics by location", nil)
+	}
+
+	var stats []GeoStat
+	if err := json.Unmarshal([]byte(result), &stats); err != nil {
+		return nil, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to aggregate analytics by location",
+		)
+	}
+
+	return stats, nil
+}
+
+func (r *analyticsRepository) CampaignSummary(ctx context.Context, from, to time.Time) ([]CampaignStat, error) {
+	result := r.supabaseClient.GetClient().Rpc("analytics_campaign_summary", "", map[string]interface{}{
+		"p_tenant_id": base.TenantIDFromContext(ctx),
+		"p_from":      from.UTC(),
+		"p_to":        to.UTC(),
+	})
+	if result == "" {
+		return nil, errors.New(errors.ErrDatabase, "failed to aggregate analytics by campaign", nil)
+	}
+
+	var stats []CampaignStat
+	if err := json.Unmarshal([]byte(result), &stats); err != nil {
+		return nil, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to aggregate analytics by campaign",
+		)
+	}
+
+	return stats, nil
+}
+
+func (r *analyticsRepository) TotalViews(ctx context.Context) (int64, error) {
+	result := r.supabaseClient.GetClient().Rpc("analytics_total_views", "", map[string]interface{}{
+		"p_tenant_id": base.TenantIDFromContext(ctx),
+	})
+
+	views, err := strconv.ParseInt(result, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to count page views",
+		)
+	}
+	return views, nil
+}
+
+func (r *analyticsRepository) ListBetween(ctx context.Context, from, to time.Time, offset, limit int) ([]AnalyticsEvent, error) {
+	var events []AnalyticsEvent
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id,type,name,path,visitor_id,created_at", "", false).
+		Gte("created_at", from.UTC().Format(time.RFC3339)).
+		Lt("created_at", to.UTC().Format(time.RFC3339))
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true}).
+		Range(offset, offset+limit-1, "").
+		ExecuteTo(&events)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list analytics events")
+	}
+	return events, nil
+}
+
+// DeleteBefore removes raw events of every tenant recorded before the given time
+func (r *analyticsRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	_, count, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "exact").
+		Lt("created_at", before.UTC().Format(time.RFC3339)).
+		Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to prune analytics events")
+	}
+	return int(count), nil
+}
+
+func (r *analyticsRepository) FindSalt(ctx context.Context, day string) (*DailySalt, error) {
+	var salts []DailySalt
+	_, err := r.supabaseClient.GetClient().
+		From(r.saltTable).
+		Select("*", "", false).
+		Eq("day", day).
+		ExecuteTo(&salts)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find analytics salt")
+	}
+	if len(salts) == 0 {
+		return nil, nil
+	}
+	return &salts[0], nil
+}
+
+func (r *analyticsRepository) CreateSalt(ctx context.Context, salt *DailySalt) error {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.saltTable).
+		Insert(salt, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to create analytics salt")
+	}
+	return nil
+}
+
+func (r *analyticsRepository) DeleteSaltsBefore(ctx context.Context, day string) error {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.saltTable).
+		Delete("minimal", "").
+		Lt("day", day).
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete analytics salts")
+	}
+	return nil
+}
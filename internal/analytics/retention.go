@@ -0,0 +1,78 @@
+package analytics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// RetentionJob periodically prunes raw analytics events past the retention window
+type RetentionJob struct {
+	analyticsService AnalyticsService
+	retention        time.Duration
+	interval         time.Duration
+	logger           *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRetentionJob creates a retention job running every interval
+func NewRetentionJob(analyticsService AnalyticsService, retention, interval time.Duration, logger *logger.Logger) *RetentionJob {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	return &RetentionJob{
+		analyticsService: analyticsService,
+		retention:        retention,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called
+func (j *RetentionJob) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.prune(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *RetentionJob) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// prune deletes expired events and salts
+func (j *RetentionJob) prune(ctx context.Context) {
+	pruned, err := j.analyticsService.PruneEvents(ctx, j.retention)
+	if err != nil && ctx.Err() == nil {
+		j.logger.Error("Failed to prune analytics events", "error", err)
+		return
+	}
+
+	if pruned > 0 {
+		j.logger.Info("Pruned analytics events", "events", pruned)
+	}
+}
@@ -0,0 +1,257 @@
+package analytics
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/geoip"
+)
+
+// maxSummaryRange caps the time range of geographic and campaign
+// aggregations
+const maxSummaryRange = 366 * 24 * time.Hour
+
+type AnalyticsService interface {
+	TrackEvent(ctx context.Context, eventCreate *AnalyticsEventCreate, meta RequestMeta) error
+	GeoSummary(ctx context.Context, from, to time.Time, groupBy GeoGroup) ([]GeoStat, error)
+	// CampaignSummary returns the visits and inquiries of every campaign
+	// within a time range
+	CampaignSummary(ctx context.Context, from, to time.Time) ([]CampaignStat, error)
+	// TotalViews counts every page view ever recorded
+	TotalViews(ctx context.Context) (int64, error)
+	PruneEvents(ctx context.Context, retention time.Duration) (int, error)
+}
+
+type analyticsService struct {
+	analyticsRepo AnalyticsRepository
+	locator       geoip.Locator
+	respectDNT    bool
+
+	// Cached salt of the current day
+	mu      sync.Mutex
+	saltDay string
+	salt    string
+}
+
+func NewAnalyticsService(analyticsRepo AnalyticsRepository, locator geoip.Locator, respectDNT bool) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+		locator:       locator,
+		respectDNT:    respectDNT,
+	}
+}
+
+// TrackEvent records a visitor event enriched with the location of its IP
+func (s *analyticsService) TrackEvent(ctx context.Context, eventCreate *AnalyticsEventCreate, meta RequestMeta) error {
+	// Validate input
+	if err := validator.ValidateModel(eventCreate); err != nil {
+		return err
+	}
+
+	switch eventCreate.Type {
+	case EventPageView:
+	case EventCustom:
+		if eventCreate.Name == "" {
+			return errors.New(
+				errors.ErrValidation,
+				"Event name is required for custom events",
+				nil,
+			)
+		}
+	default:
+		return errors.New(
+			errors.ErrValidation,
+			"Invalid event type",
+			nil,
+			errors.WithContext("type", eventCreate.Type),
+		)
+	}
+
+	// Visitors opting out with Do-Not-Track or Global Privacy Control are not recorded
+	if meta.DoNotTrack && s.respectDNT {
+		return nil
+	}
+
+	event := eventCreate.ToAnalyticsEvent()
+
+	// Beacons may leave campaign parameters in the landing path
+	if event.UTM.IsZero() {
+		event.UTM = base.UTMFromURL(event.Path)
+	} else {
+		event.UTM.Normalize()
+	}
+
+	if meta.IP != "" {
+		visitorID, err := s.visitorID(ctx, meta)
+		if err != nil {
+			return err
+		}
+		event.VisitorID = visitorID
+	}
+
+	// Location is best effort; unknown addresses are recorded without one
+	if meta.IP != "" {
+		if location, err := s.locator.Lookup(meta.IP); err == nil {
+			event.CountryCode = location.CountryCode
+			event.Region = location.Region
+		}
+	}
+
+	if _, err := s.analyticsRepo.Create(ctx, &event); err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to record analytics event",
+		)
+	}
+
+	return nil
+}
+
+// GeoSummary returns visits grouped by country or region within a time range
+func (s *analyticsService) GeoSummary(ctx context.Context, from, to time.Time, groupBy GeoGroup) ([]GeoStat, error) {
+	if groupBy == "" {
+		groupBy = GeoByCountry
+	}
+	if groupBy != GeoByCountry && groupBy != GeoByRegion {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Group by must be country or region",
+			nil,
+			errors.WithContext("group_by", groupBy),
+		)
+	}
+
+	if err := checkSummaryRange(from, to); err != nil {
+		return nil, err
+	}
+
+	return s.analyticsRepo.GeoSummary(ctx, from, to, groupBy)
+}
+
+// CampaignSummary returns the visits and inquiries of every campaign within
+// a time range, most visited first
+func (s *analyticsService) CampaignSummary(ctx context.Context, from, to time.Time) ([]CampaignStat, error) {
+	if err := checkSummaryRange(from, to); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.analyticsRepo.CampaignSummary(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stats {
+		if stats[i].Visitors > 0 {
+			stats[i].ConversionRate = float64(stats[i].Conversions) / float64(stats[i].Visitors)
+		}
+	}
+	return stats, nil
+}
+
+// TotalViews counts every page view ever recorded, pruned events included
+func (s *analyticsService) TotalViews(ctx context.Context) (int64, error) {
+	return s.analyticsRepo.TotalViews(ctx)
+}
+
+// PruneEvents deletes raw events older than the retention window along with
+// the salts of previous days
+func (s *analyticsService) PruneEvents(ctx context.Context, retention time.Duration) (int, error) {
+	now := time.Now().UTC()
+
+	if err := s.analyticsRepo.DeleteSaltsBefore(ctx, now.Format(time.DateOnly)); err != nil {
+		return 0, err
+	}
+
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	return s.analyticsRepo.DeleteBefore(ctx, now.Add(-retention))
+}
+
+// checkSummaryRange makes sure an aggregation covers a valid time range of
+// at most a year
+func checkSummaryRange(from, to time.Time) error {
+	if !from.Before(to) {
+		return errors.New(
+			errors.ErrValidation,
+			"From must be before to",
+			nil,
+		)
+	}
+	if to.Sub(from) > maxSummaryRange {
+		return errors.New(
+			errors.ErrValidation,
+			"Time range must not exceed one year",
+			nil,
+		)
+	}
+	return nil
+}
+
+// visitorID hashes the visitor IP and user agent with the salt of the day,
+// so visitors can be counted without storing their address and cannot be
+// followed from one day to the next
+func (s *analyticsService) visitorID(ctx context.Context, meta RequestMeta) (string, error) {
+	salt, err := s.dailySalt(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tenantID := ""
+	if id := base.TenantIDFromContext(ctx); id != nil {
+		tenantID = id.String()
+	}
+
+	sum := sha256.Sum256([]byte(salt + "|" + tenantID + "|" + meta.IP + "|" + meta.UserAgent))
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// dailySalt returns the salt of the current UTC day, creating it if needed
+func (s *analyticsService) dailySalt(ctx context.Context) (string, error) {
+	day := time.Now().UTC().Format(time.DateOnly)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.saltDay == day {
+		return s.salt, nil
+	}
+
+	existing, err := s.analyticsRepo.FindSalt(ctx, day)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return "", errors.Wrap(err, errors.ErrInternal, "Failed to generate analytics salt")
+		}
+
+		created := &DailySalt{Day: day, Salt: hex.EncodeToString(buf)}
+		if err := s.analyticsRepo.CreateSalt(ctx, created); err != nil {
+			// Another instance may have created the salt concurrently
+			existing, err = s.analyticsRepo.FindSalt(ctx, day)
+			if err != nil {
+				return "", err
+			}
+			if existing == nil {
+				return "", errors.New(errors.ErrDatabase, "Failed to create analytics salt", nil)
+			}
+		} else {
+			existing = created
+		}
+	}
+
+	s.saltDay = day
+	s.salt = existing.Salt
+	return s.salt, nil
+}
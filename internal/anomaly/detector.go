@@ -0,0 +1,285 @@
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+)
+
+// minBaselineWindows is the number of past windows needed before traffic
+// volume is compared against the baseline
+const minBaselineWindows = 5
+
+// Kind identifies what deviated from the baseline
+type Kind string
+
+const (
+	KindErrorRate    Kind = "error_rate"
+	KindAuthFailures Kind = "auth_failures"
+	KindTrafficSpike Kind = "traffic_spike"
+	KindTrafficDrop  Kind = "traffic_drop"
+)
+
+// Thresholds configure when a window of traffic counts as an anomaly
+type Thresholds struct {
+	// Window is the period requests are counted over, and BaselineWindows
+	// the number of past windows the rolling baseline averages
+	Window          time.Duration
+	BaselineWindows int
+
+	// MinRequests is the request count below which a window is too quiet to
+	// judge error rates or spikes
+	MinRequests int
+
+	// DeviationFactor is how many times the baseline a window must reach,
+	// or fall below for volume drops, to raise an alert
+	DeviationFactor float64
+
+	// ErrorRate and AuthFailureRate are the shares of 5xx responses and
+	// rejected credentials that always count as anomalies
+	ErrorRate       float64
+	AuthFailureRate float64
+
+	// Cooldown is the shortest time between two alerts of the same kind
+	Cooldown time.Duration
+}
+
+// Anomaly is a window of traffic that deviated from the baseline
+type Anomaly struct {
+	Kind     Kind
+	Current  float64
+	Baseline float64
+	Requests int
+}
+
+// window counts the requests answered during one period
+type window struct {
+	requests     int
+	serverErrors int
+	authFailures int
+}
+
+// baseline averages the windows preceding the current one
+type baseline struct {
+	windows         int
+	requests        float64
+	errorRate       float64
+	authFailureRate float64
+}
+
+// Detector counts the responses of every request and alerts when the 5xx
+// rate, the rate of rejected credentials or the request volume of a window
+// deviates sharply from the rolling baseline of the previous windows
+type Detector struct {
+	thresholds Thresholds
+	notifier   notification.Notifier
+	logger     *logger.Logger
+
+	mu        sync.Mutex
+	current   window
+	history   []window
+	lastAlert map[Kind]time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDetector creates a detector alerting through notifier
+func NewDetector(thresholds Thresholds, notifier notification.Notifier, logger *logger.Logger) *Detector {
+	if thresholds.Window <= 0 {
+		thresholds.Window = time.Minute
+	}
+	if thresholds.BaselineWindows < minBaselineWindows {
+		thresholds.BaselineWindows = minBaselineWindows
+	}
+	if thresholds.DeviationFactor <= 1 {
+		thresholds.DeviationFactor = 3
+	}
+
+	return &Detector{
+		thresholds: thresholds,
+		notifier:   notifier,
+		logger:     logger,
+		lastAlert:  map[Kind]time.Time{},
+	}
+}
+
+// Middleware counts the response of every request in the current window
+func (d *Detector) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		status := c.Writer.Status()
+
+		d.mu.Lock()
+		d.current.requests++
+		if status >= http.StatusInternalServerError {
+			d.current.serverErrors++
+		}
+		if status == http.StatusUnauthorized || status == http.StatusForbidden {
+			d.current.authFailures++
+		}
+		d.mu.Unlock()
+	}
+}
+
+// Start closes a window every period until ctx is cancelled or Stop is called
+func (d *Detector) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		ticker := time.NewTicker(d.thresholds.Window)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				for _, anomaly := range d.closeWindow() {
+					d.alert(ctx, anomaly, now)
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts the detector
+func (d *Detector) Stop() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.wg.Wait()
+}
+
+// closeWindow compares the current window against the baseline of the
+// previous ones, then starts a new window
+func (d *Detector) closeWindow() []Anomaly {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	current := d.current
+	anomalies := d.detect(current, d.baseline())
+
+	d.current = window{}
+	d.history = append(d.history, current)
+	if len(d.history) > d.thresholds.BaselineWindows {
+		d.history = d.history[len(d.history)-d.thresholds.BaselineWindows:]
+	}
+
+	return anomalies
+}
+
+// baseline averages the windows kept in the history
+func (d *Detector) baseline() baseline {
+	var requests, serverErrors, authFailures int
+	for _, w := range d.history {
+		requests += w.requests
+		serverErrors += w.serverErrors
+		authFailures += w.authFailures
+	}
+
+	b := baseline{windows: len(d.history)}
+	if b.windows > 0 {
+		b.requests = float64(requests) / float64(b.windows)
+	}
+	if requests > 0 {
+		b.errorRate = float64(serverErrors) / float64(requests)
+		b.authFailureRate = float64(authFailures) / float64(requests)
+	}
+	return b
+}
+
+// detect lists the ways a window deviates from the baseline
+func (d *Detector) detect(w window, b baseline) []Anomaly {
+	t := d.thresholds
+	var anomalies []Anomaly
+
+	// Volume drops are judged against busy baselines only, since a quiet
+	// window is normal for a quiet site
+	if b.windows >= minBaselineWindows && b.requests >= float64(t.MinRequests) &&
+		float64(w.requests)*t.DeviationFactor <= b.requests {
+		anomalies = append(anomalies, Anomaly{Kind: KindTrafficDrop, Current: float64(w.requests), Baseline: b.requests, Requests: w.requests})
+	}
+
+	if w.requests < t.MinRequests {
+		return anomalies
+	}
+
+	if b.windows >= minBaselineWindows && float64(w.requests) >= b.requests*t.DeviationFactor {
+		anomalies = append(anomalies, Anomaly{Kind: KindTrafficSpike, Current: float64(w.requests), Baseline: b.requests, Requests: w.requests})
+	}
+
+	errorRate := float64(w.serverErrors) / float64(w.requests)
+	if errorRate >= t.ErrorRate && errorRate >= b.errorRate*t.DeviationFactor {
+		anomalies = append(anomalies, Anomaly{Kind: KindErrorRate, Current: errorRate, Baseline: b.errorRate, Requests: w.requests})
+	}
+
+	authFailureRate := float64(w.authFailures) / float64(w.requests)
+	if authFailureRate >= t.AuthFailureRate && authFailureRate >= b.authFailureRate*t.DeviationFactor {
+		anomalies = append(anomalies, Anomaly{Kind: KindAuthFailures, Current: authFailureRate, Baseline: b.authFailureRate, Requests: w.requests})
+	}
+
+	return anomalies
+}
+
+// alert notifies about an anomaly unless the same kind was reported within
+// the cooldown
+func (d *Detector) alert(ctx context.Context, anomaly Anomaly, now time.Time) {
+	d.mu.Lock()
+	last, seen := d.lastAlert[anomaly.Kind]
+	if seen && now.Sub(last) < d.thresholds.Cooldown {
+		d.mu.Unlock()
+		return
+	}
+	d.lastAlert[anomaly.Kind] = now
+	d.mu.Unlock()
+
+	n := notifier.Notification{
+		Event: notification.EventTrafficAnomaly,
+		Level: notifier.LevelWarning,
+		Fields: map[string]string{
+			"Window":   d.thresholds.Window.String(),
+			"Requests": strconv.Itoa(anomaly.Requests),
+		},
+	}
+
+	switch anomaly.Kind {
+	case KindErrorRate:
+		n.Level = notifier.LevelError
+		n.Title = "Server error burst"
+		n.Body = fmt.Sprintf("%.1f%% of requests failed with a server error in the last %s, against %.1f%% usually",
+			anomaly.Current*100, d.thresholds.Window, anomaly.Baseline*100)
+	case KindAuthFailures:
+		n.Title = "Authentication failure burst"
+		n.Body = fmt.Sprintf("%.1f%% of requests were rejected as unauthorized in the last %s, against %.1f%% usually",
+			anomaly.Current*100, d.thresholds.Window, anomaly.Baseline*100)
+	case KindTrafficSpike:
+		n.Title = "Traffic spike"
+		n.Body = fmt.Sprintf("%d requests in the last %s, against %.0f usually",
+			anomaly.Requests, d.thresholds.Window, anomaly.Baseline)
+	case KindTrafficDrop:
+		n.Title = "Traffic drop"
+		n.Body = fmt.Sprintf("%d requests in the last %s, against %.0f usually",
+			anomaly.Requests, d.thresholds.Window, anomaly.Baseline)
+	}
+
+	d.logger.Warn("Traffic anomaly detected",
+		"kind", anomaly.Kind,
+		"current", anomaly.Current,
+		"baseline", anomaly.Baseline,
+		"requests", anomaly.Requests,
+	)
+	d.notifier.Notify(ctx, n)
+}
@@ -0,0 +1,55 @@
+package asset
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type AssetHandler struct {
+	base.BaseHandler
+	assetService AssetService
+}
+
+func NewAssetHandler(assetService AssetService, logger *logger.Logger) *AssetHandler {
+	return &AssetHandler{
+		BaseHandler:  *base.NewBaseHandler(logger),
+		assetService: assetService,
+	}
+}
+
+// GetManifest retrieves the asset manifest
+// @Summary Get the asset manifest
+// @Description Retrieve a map of logical asset paths to the URLs of their current content-fingerprinted files
+// @Tags Assets
+// @Produce json
+// @Param prefix query string false "Only include logical paths starting with this prefix"
+// @Success 200 {object} response.APIResponse{data=map[string]string} "Asset manifest retrieved successfully"
+// @Router /assets/manifest [get]
+func (h *AssetHandler) GetManifest(c *gin.Context) {
+	manifest, err := h.assetService.Manifest(c.Request.Context(), c.Query("prefix"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, manifest, "Asset manifest retrieved successfully")
+}
+
+// ListAssets retrieves the stored assets
+// @Summary List assets
+// @Description Retrieve the uploaded assets with their fingerprinted paths and content hashes
+// @Tags Assets
+// @Produce json
+// @Param prefix query string false "Only include logical paths starting with this prefix"
+// @Success 200 {object} response.APIResponse{data=[]Asset} "Assets retrieved successfully"
+// @Router /assets [get]
+func (h *AssetHandler) ListAssets(c *gin.Context) {
+	assets, err := h.assetService.ListAssets(c.Request.Context(), c.Query("prefix"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, assets, "Assets retrieved successfully")
+}
@@ -0,0 +1,126 @@
+package asset
+
+import (
+	"context"
+	"mime/multipart"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+)
+
+// LogoPreference tells frontends which variant of a logo to show
+type LogoPreference string
+
+const (
+	// LogoAuto follows the color scheme of the visitor
+	LogoAuto LogoPreference = "auto"
+	// LogoLight always shows the variant made for light backgrounds
+	LogoLight LogoPreference = "light"
+	// LogoDark always shows the variant made for dark backgrounds
+	LogoDark LogoPreference = "dark"
+)
+
+// ParseLogoPreference validates a logo preference, defaulting to fallback
+// when it is empty
+func ParseLogoPreference(value string, fallback LogoPreference) (LogoPreference, error) {
+	switch preference := LogoPreference(strings.ToLower(strings.TrimSpace(value))); preference {
+	case "":
+		if fallback == "" {
+			return LogoAuto, nil
+		}
+		return fallback, nil
+	case LogoAuto, LogoLight, LogoDark:
+		return preference, nil
+	default:
+		return "", errors.New(
+			errors.ErrValidation,
+			"Logo preference must be auto, light or dark",
+			nil,
+			errors.WithContext("logo_preference", value),
+		)
+	}
+}
+
+// LogoVariants are the URLs of a logo for light and dark backgrounds
+type LogoVariants struct {
+	Light string
+	Dark  string
+}
+
+// LogoUpload is a newly stored logo and the variants uploaded along with it
+type LogoUpload struct {
+	// Original is the content of the logo stored at OriginalURL, or nil when
+	// the logo is unchanged
+	Original    []byte
+	OriginalURL string
+	Light       *multipart.FileHeader
+	Dark        *multipart.FileHeader
+}
+
+// UploadLogoVariants stores the variants of a logo next to logicalPath, e.g.
+// images/company/<id>-dark.png, and returns variants updated with their URLs.
+// When a new original is given, the variant it is not legible on is
+// generated by inverting its lightness; logos legible on both backgrounds
+// use the original for both. Uploaded variants always take precedence.
+func UploadLogoVariants(ctx context.Context, assets AssetService, logicalPath string, variants LogoVariants, logo LogoUpload) (LogoVariants, error) {
+	if logo.Original != nil {
+		variants = LogoVariants{Light: logo.OriginalURL, Dark: logo.OriginalURL}
+
+		// A logo that cannot be inverted keeps the original for both
+		inverted, contentType, tone, err := imageproc.InvertLogo(logo.Original)
+		if err == nil && inverted != nil {
+			variant := string(LogoDark)
+			if tone == imageproc.ToneLight {
+				variant = string(LogoLight)
+			}
+
+			ext := ".png"
+			if contentType == "image/svg+xml" {
+				ext = ".svg"
+			}
+
+			uploaded, err := assets.UploadBytes(ctx, inverted, variantPath(logicalPath, variant, ext), contentType)
+			if err != nil {
+				return variants, err
+			}
+
+			if tone == imageproc.ToneLight {
+				variants.Light = uploaded.URL
+			} else {
+				variants.Dark = uploaded.URL
+			}
+		}
+	}
+
+	for variant, file := range map[LogoPreference]*multipart.FileHeader{LogoLight: logo.Light, LogoDark: logo.Dark} {
+		if file == nil {
+			continue
+		}
+
+		ext := strings.ToLower(filepath.Ext(file.Filename))
+		uploaded, err := assets.Upload(ctx, file, variantPath(logicalPath, string(variant), ext))
+		if err != nil {
+			return variants, errors.Wrap(err,
+				errors.ErrFileUpload,
+				"Failed to upload logo variant",
+				errors.WithContext("variant", variant),
+			)
+		}
+
+		if variant == LogoLight {
+			variants.Light = uploaded.URL
+		} else {
+			variants.Dark = uploaded.URL
+		}
+	}
+
+	return variants, nil
+}
+
+// variantPath names a variant of the logo stored at logicalPath
+func variantPath(logicalPath, variant, ext string) string {
+	return strings.TrimSuffix(logicalPath, path.Ext(logicalPath)) + "-" + variant + ext
+}
@@ -0,0 +1,34 @@
+package asset
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Asset maps a logical storage path to its content-fingerprinted file
+// @Description Uploaded file whose stored path carries a hash of its content
+// @Name Asset
+type Asset struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+
+	// LogicalPath is the stable path callers upload to
+	LogicalPath string `json:"logical_path" db:"logical_path" example:"images/project/550e8400-e29b-41d4-a716-446655440000/0.png"`
+
+	// Path is the stored path including the content hash
+	Path        string `json:"path" db:"path" example:"itsrama/images/project/550e8400-e29b-41d4-a716-446655440000/0.3f2a9c1b7e4d8a60.png"`
+	Hash        string `json:"hash" db:"hash" example:"3f2a9c1b7e4d8a60"`
+	URL         string `json:"url" db:"url" example:"https://project.supabase.co/storage/v1/object/public/bucket/itsrama/images/project/550e8400-e29b-41d4-a716-446655440000/0.3f2a9c1b7e4d8a60.png"`
+	ContentType string `json:"content_type,omitempty" db:"content_type" example:"image/png"`
+	Size        int64  `json:"size,omitempty" db:"size" example:"102400"`
+
+	// Placeholder is a tiny copy of an image as a data URI, shown while the
+	// image loads
+	Placeholder string `json:"placeholder,omitempty" db:"placeholder" example:"data:image/png;base64,iVBORw0KGgo="`
+	// DominantColor is the most common color of an image as #rrggbb
+	DominantColor string `json:"dominant_color,omitempty" db:"dominant_color" example:"#1e293b"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
@@ -0,0 +1,82 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type AssetRepository interface {
+	FindByLogicalPath(ctx context.Context, logicalPath string) (*Asset, error)
+	Upsert(ctx context.Context, asset *Asset) (*Asset, error)
+	List(ctx context.Context, prefix string) ([]Asset, error)
+}
+
+type assetRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewAssetRepository(supabaseClient *supabase.SupabaseClient) AssetRepository {
+	return &assetRepository{
+		supabaseClient: supabaseClient,
+		table:          "asset",
+	}
+}
+
+// FindByLogicalPath returns the asset stored under logicalPath, or nil if none
+func (r *assetRepository) FindByLogicalPath(ctx context.Context, logicalPath string) (*Asset, error) {
+	var assets []Asset
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("logical_path", logicalPath)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&assets)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find asset")
+	}
+
+	if len(assets) == 0 {
+		return nil, nil
+	}
+	return &assets[0], nil
+}
+
+// Upsert stores the asset of the tenant in ctx, one row per logical path
+func (r *assetRepository) Upsert(ctx context.Context, asset *Asset) (*Asset, error) {
+	asset.TenantID = base.TenantIDFromContext(ctx)
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(asset, "tenant_id,logical_path", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to save asset")
+	}
+	return asset, nil
+}
+
+// List returns the assets whose logical path starts with prefix
+func (r *assetRepository) List(ctx context.Context, prefix string) ([]Asset, error) {
+	var assets []Asset
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	if prefix != "" {
+		query = query.Like("logical_path", prefix+"%")
+	}
+
+	_, err := query.
+		Order("logical_path", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&assets)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list assets")
+	}
+	return assets, nil
+}
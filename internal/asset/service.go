@@ -0,0 +1,191 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime/multipart"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// hashLength is the number of hex characters of the content hash kept in paths
+const hashLength = 16
+
+type AssetService interface {
+	Upload(ctx context.Context, file *multipart.FileHeader, logicalPath string, opts ...storage.FileOptions) (*Asset, error)
+	UploadBytes(ctx context.Context, data []byte, logicalPath string, contentType string) (*Asset, error)
+	ListAssets(ctx context.Context, prefix string) ([]Asset, error)
+	Manifest(ctx context.Context, prefix string) (map[string]string, error)
+}
+
+type assetService struct {
+	assetRepo AssetRepository
+	storage   storage.Storage
+}
+
+func NewAssetService(assetRepo AssetRepository, storage storage.Storage) AssetService {
+	return &assetService{
+		assetRepo: assetRepo,
+		storage:   storage,
+	}
+}
+
+// Upload stores file under logicalPath with a hash of its content inserted
+// before the extension, so a replaced file always gets a new URL and caches
+// never serve stale content. The file previously stored under the same
+// logical path is removed.
+func (s *assetService) Upload(ctx context.Context, file *multipart.FileHeader, logicalPath string, opts ...storage.FileOptions) (*Asset, error) {
+	hash, err := contentHash(file)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrFileUpload,
+			"Failed to read uploaded file",
+			errors.WithContext("logical_path", logicalPath),
+		)
+	}
+
+	storedPath, err := s.storage.Upload(ctx, file, FingerprintPath(logicalPath, hash), opts...)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to upload file",
+			errors.WithContext("logical_path", logicalPath),
+		)
+	}
+
+	asset := &Asset{
+		LogicalPath: logicalPath,
+		Path:        storedPath,
+		Hash:        hash,
+		ContentType: file.Header.Get("Content-Type"),
+		Size:        file.Size,
+	}
+	if data, err := storage.ReadUpload(file); err == nil {
+		asset.describe(data)
+	}
+
+	return s.record(ctx, asset)
+}
+
+// UploadBytes stores generated content under logicalPath like Upload
+func (s *assetService) UploadBytes(ctx context.Context, data []byte, logicalPath string, contentType string) (*Asset, error) {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+
+	storedPath, err := s.storage.UploadBytes(ctx, data, FingerprintPath(logicalPath, hash), contentType)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to upload file",
+			errors.WithContext("logical_path", logicalPath),
+		)
+	}
+
+	asset := &Asset{
+		LogicalPath: logicalPath,
+		Path:        storedPath,
+		Hash:        hash,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+	asset.describe(data)
+
+	return s.record(ctx, asset)
+}
+
+// record maps the logical path of asset to its newly stored file and removes
+// the file it replaces
+func (s *assetService) record(ctx context.Context, asset *Asset) (*Asset, error) {
+	url, err := s.storage.GetPublicURL(asset.Path)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to get public URL for file",
+			errors.WithContext("path", asset.Path),
+		)
+	}
+	asset.URL = url
+
+	previous, err := s.assetRepo.FindByLogicalPath(ctx, asset.LogicalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil {
+		asset.ID = previous.ID
+	} else {
+		asset.ID = uuid.New()
+	}
+
+	if _, err := s.assetRepo.Upsert(ctx, asset); err != nil {
+		return nil, err
+	}
+
+	// Remove the replaced file; a failure only leaves an unreferenced object
+	if previous != nil && previous.Path != asset.Path {
+		_ = s.storage.Delete(ctx, previous.Path)
+	}
+
+	return asset, nil
+}
+
+func (s *assetService) ListAssets(ctx context.Context, prefix string) ([]Asset, error) {
+	return s.assetRepo.List(ctx, prefix)
+}
+
+// Manifest maps logical paths to the URLs of their current fingerprinted files
+func (s *assetService) Manifest(ctx context.Context, prefix string) (map[string]string, error) {
+	assets, err := s.assetRepo.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make(map[string]string, len(assets))
+	for _, asset := range assets {
+		manifest[asset.LogicalPath] = asset.URL
+	}
+	return manifest, nil
+}
+
+// FingerprintPath inserts hash before the extension of logicalPath, e.g.
+// images/logo.png becomes images/logo.3f2a9c1b7e4d8a60.png
+func FingerprintPath(logicalPath, hash string) string {
+	ext := path.Ext(logicalPath)
+	return strings.TrimSuffix(logicalPath, ext) + "." + hash + ext
+}
+
+// describe sets the preview of an image asset from its content. Other
+// content and images that cannot be decoded are left without one.
+func (a *Asset) describe(data []byte) {
+	if !strings.HasPrefix(a.ContentType, "image/") {
+		return
+	}
+	preview, err := imageproc.NewPreview(data)
+	if err != nil {
+		return
+	}
+	a.Placeholder = preview.Placeholder
+	a.DominantColor = preview.DominantColor
+}
+
+// contentHash returns the truncated SHA-256 of the file content
+func contentHash(file *multipart.FileHeader) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, src); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil))[:hashLength], nil
+}
@@ -1,8 +1,12 @@
 package base
 
 import (
+	"bytes"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"reflect"
 
 	"github.com/gin-gonic/gin"
@@ -28,6 +32,14 @@ func NewBaseHandler(logger *logger.Logger) *BaseHandler {
 // ValidateRequest validates the input request struct
 func (h *BaseHandler) ValidateRequest(c *gin.Context, request interface{}) error {
 	// Bind JSON/form data
+	if err := snakeBody(c, request); err != nil {
+		return errors.New(
+			errors.ErrValidation,
+			"Invalid request data",
+			err,
+			errors.WithContext("binding_error", err.Error()),
+		)
+	}
 	if err := c.ShouldBind(request); err != nil {
 		return errors.New(
 			errors.ErrValidation,
@@ -50,6 +62,35 @@ func (h *BaseHandler) ValidateRequest(c *gin.Context, request interface{}) error
 	return nil
 }
 
+// BindJSON binds a JSON body without validating it, accepting the camelCase
+// keys of v2 clients
+func (h *BaseHandler) BindJSON(c *gin.Context, request interface{}) error {
+	if err := snakeBody(c, request); err != nil {
+		return err
+	}
+	return c.ShouldBindJSON(request)
+}
+
+// snakeBody rewrites the camelCase keys of a v2 JSON body to the names of
+// the fields of request, so it binds like a v1 body
+func snakeBody(c *gin.Context, request interface{}) error {
+	if response.ProfileOf(c) != response.ProfileV2 || c.Request.Body == nil || c.ContentType() != gin.MIMEJSON {
+		return nil
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+
+	// A malformed body is left for the binding to report
+	if converted, err := response.SnakeJSON(body, request); err == nil {
+		body = converted
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
+
 // ValidateUUID checks if a UUID is valid
 func (h *BaseHandler) ValidateUUID(id string, fieldName string) (uuid.UUID, error) {
 	parsedUUID, err := uuid.Parse(id)
@@ -83,6 +124,7 @@ func (h *BaseHandler) HandleError(c *gin.Context, err error) {
 	)
 
 	var customErr *errors.CustomError
+	var maxBytesErr *http.MaxBytesError
 	switch e := err.(type) {
 	case *errors.CustomError:
 		customErr = e
@@ -95,6 +137,16 @@ func (h *BaseHandler) HandleError(c *gin.Context, err error) {
 		)
 	}
 
+	// Reading past the body limit surfaces as a bind or parse error
+	if stderrors.As(err, &maxBytesErr) {
+		customErr = errors.New(
+			errors.ErrPayloadTooLarge,
+			"Request body too large",
+			err,
+			errors.WithContext("limit_bytes", maxBytesErr.Limit),
+		)
+	}
+
 	// Send error response
 	response.Error(c, customErr)
 }
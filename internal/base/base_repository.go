@@ -3,12 +3,15 @@ package base
 import (
 	"context"
 	"fmt"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -113,6 +116,80 @@ type BaseRepository[T any, R any] interface {
 	Search(ctx context.Context, opts ListOptions) ([]R, int, error)
 }
 
+// TenantScope identifies the tenant a request operates on
+type TenantScope struct {
+	ID            uuid.UUID
+	Slug          string
+	StorageFolder string
+}
+
+// tenantContextKey is the context key holding the TenantScope
+type tenantContextKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the given tenant
+func WithTenant(ctx context.Context, tenant TenantScope) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant scope carried by ctx, if any
+func TenantFromContext(ctx context.Context) (TenantScope, bool) {
+	tenant, ok := ctx.Value(tenantContextKey{}).(TenantScope)
+	return tenant, ok && tenant.ID != uuid.Nil
+}
+
+// TenantIDFromContext returns the tenant ID carried by ctx, or nil when the
+// context is not tenant scoped (e.g. system jobs)
+func TenantIDFromContext(ctx context.Context) *uuid.UUID {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return &tenant.ID
+}
+
+// ScopeToTenant restricts a query to the rows owned by the tenant in ctx.
+// Queries issued without a tenant scope are left untouched.
+func ScopeToTenant(ctx context.Context, query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return query
+	}
+	return query.Eq("tenant_id", tenant.ID.String())
+}
+
+// ScopeFilterToTenant is ScopeToTenant for queries issued through the
+// Postgres driver
+func ScopeFilterToTenant(ctx context.Context, filter *database.Filter) *database.Filter {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return filter
+	}
+	return filter.Eq("tenant_id", tenant.ID.String())
+}
+
+// ApplySQLFilters adds the equality and pattern filters of a list request
+// to a Postgres query, as the REST repositories do
+func ApplySQLFilters(filter *database.Filter, filters []FilterOption) *database.Filter {
+	for _, option := range filters {
+		switch option.Operator {
+		case OperatorEqual:
+			filter = filter.Eq(option.Field, option.Value)
+		case OperatorLike:
+			filter = filter.Like(option.Field, fmt.Sprintf("%%%v%%", option.Value))
+		}
+	}
+	return filter
+}
+
+// TenantStoragePath prefixes a storage path with the tenant storage folder
+func TenantStoragePath(ctx context.Context, storagePath string) string {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok || tenant.StorageFolder == "" {
+		return storagePath
+	}
+	return path.Join(tenant.StorageFolder, storagePath)
+}
+
 // RepositoryOption allows for flexible configuration of repositories
 type RepositoryOption[T any] func(interface{}) error
 
@@ -233,7 +310,7 @@ func PaginateResults[T any](
 	}
 }
 
-// ParsePaginationParams supports both page/per_page and limit/offset styles and normalizes to ListOptions
+// ParsePaginationParams supports page/per_page, limit/offset and limit/page_token styles and normalizes to ListOptions
 func ParsePaginationParams(c *gin.Context) (ListOptions, error) {
 	// Prefer page/per_page if present
 	pageStr := c.Query("page")
@@ -261,7 +338,7 @@ func ParsePaginationParams(c *gin.Context) (ListOptions, error) {
 			}
 		}
 	} else {
-		// Fallback to limit/offset
+		// Fallback to limit/offset, or limit/page_token
 		limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
 		if err != nil || limit <= 0 {
 			return ListOptions{}, fmt.Errorf("invalid limit")
@@ -271,11 +348,22 @@ func ParsePaginationParams(c *gin.Context) (ListOptions, error) {
 		if err != nil || offset < 0 {
 			return ListOptions{}, fmt.Errorf("invalid offset")
 		}
+		if token := c.Query("page_token"); token != "" {
+			// Page tokens point at offsets; a limit changed between
+			// requests resumes from the start of the page holding the offset
+			if offset, err = response.DecodePageToken(token); err != nil {
+				return ListOptions{}, err
+			}
+		}
 		perPage = limit
 		page = (offset / limit) + 1
 	}
 
 	sortBy := c.DefaultQuery("sort_by", "created_at")
+	if response.ProfileOf(c) == response.ProfileV2 {
+		// v2 clients sort by the camelCase names they are answered with
+		sortBy = response.SnakeKey(sortBy)
+	}
 	sortOrder := c.DefaultQuery("sort_order", "desc")
 
 	opts := ListOptions{
@@ -0,0 +1,86 @@
+package base
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// BulkItemResult is the outcome of one item of a bulk request that carries
+// on past failures, by its position in the request
+// @Description Outcome of one item of a non-atomic bulk request
+// @Name BulkItemResult
+type BulkItemResult struct {
+	Index     int    `json:"index" example:"1"`
+	Status    int    `json:"status" example:"404"`
+	ID        string `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ErrorCode string `json:"error_code,omitempty" example:"NOT_FOUND"`
+	Error     string `json:"error,omitempty" example:"NOT_FOUND: Project not found"`
+}
+
+// BulkResult lists the outcome of every item of a non-atomic bulk request
+// @Description Per-item outcome of a non-atomic bulk request
+// @Name BulkResult
+type BulkResult struct {
+	Succeeded int              `json:"succeeded" example:"2"`
+	Failed    int              `json:"failed" example:"1"`
+	Items     []BulkItemResult `json:"items"`
+}
+
+// NewBulkResult creates an empty bulk result
+func NewBulkResult() *BulkResult {
+	return &BulkResult{Items: []BulkItemResult{}}
+}
+
+// Succeed records an item written with the given HTTP status
+func (r *BulkResult) Succeed(index int, status int, id string) {
+	r.Succeeded++
+	r.Items = append(r.Items, BulkItemResult{Index: index, Status: status, ID: id})
+}
+
+// Fail records an item that failed, with the status and code its error
+// would have answered on its own
+func (r *BulkResult) Fail(index int, id string, err error) {
+	errorType := errors.ErrInternal
+	if customErr, ok := err.(*errors.CustomError); ok {
+		errorType = customErr.Type
+	}
+
+	r.Failed++
+	r.Items = append(r.Items, BulkItemResult{
+		Index:     index,
+		Status:    response.StatusCode(errorType),
+		ID:        id,
+		ErrorCode: string(errorType),
+		Error:     err.Error(),
+	})
+}
+
+// IsAtomic reports whether a bulk request stops at the first failing item,
+// the default, or carries on when it asks for ?atomic=false
+func (h *BaseHandler) IsAtomic(c *gin.Context) (bool, error) {
+	value := c.Query("atomic")
+	if value == "" {
+		return true, nil
+	}
+
+	atomic, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New(
+			errors.ErrValidation,
+			"Invalid atomic value",
+			err,
+			errors.WithContext("atomic", value),
+		)
+	}
+	return atomic, nil
+}
+
+// HandleMultiStatus sends the per-item outcome of a non-atomic bulk request
+// with 207 Multi-Status
+func (h *BaseHandler) HandleMultiStatus(c *gin.Context, result *BulkResult, message string) {
+	response.Success(c, http.StatusMultiStatus, result, message)
+}
@@ -0,0 +1,135 @@
+package base
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/signedlink"
+)
+
+// ConfirmationHeader carries the confirmation token echoed back by a
+// destructive bulk request. The confirmation_token query parameter works
+// too, for clients that cannot set headers.
+const ConfirmationHeader = "X-Confirmation-Token"
+
+// BulkConfirmer guards destructive bulk requests touching many items. The
+// first request is refused with a token bound to its exact items, and only
+// a repeat of it echoing the token back before it expires goes ahead, so a
+// mass deletion is never a single accidental click.
+type BulkConfirmer struct {
+	signer    *signedlink.Signer
+	threshold int
+	ttl       time.Duration
+	// secondAdmin requires the token to be echoed back by another admin
+	// than the one it was issued to
+	secondAdmin bool
+}
+
+// NewBulkConfirmer creates a confirmer for requests with more than
+// threshold items, whose tokens stay valid for ttl. A zero threshold
+// confirms nothing.
+func NewBulkConfirmer(secret []byte, threshold int, ttl time.Duration, secondAdmin bool) *BulkConfirmer {
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+
+	return &BulkConfirmer{
+		signer:      signedlink.New(secret, "bulk-confirm"),
+		threshold:   threshold,
+		ttl:         ttl,
+		secondAdmin: secondAdmin,
+	}
+}
+
+// Confirm returns nil when a request doing action to ids may go ahead:
+// it has no more items than the threshold, or it echoes back a valid token
+// for the same items. Otherwise it returns a confirmation error carrying a
+// new token in its context.
+func (b *BulkConfirmer) Confirm(c *gin.Context, action string, ids []string) error {
+	if b == nil || b.threshold <= 0 || len(ids) <= b.threshold {
+		return nil
+	}
+
+	email := c.GetString("email")
+	digest := digestIDs(ids)
+
+	token := c.GetHeader(ConfirmationHeader)
+	if token == "" {
+		token = c.Query("confirmation_token")
+	}
+	if token == "" {
+		return b.require(action, email, digest, len(ids), "This request affects many items and must be confirmed")
+	}
+
+	requester, ok := b.verify(token, action, digest)
+	if !ok {
+		return b.require(action, email, digest, len(ids), "Confirmation token is invalid, has expired or was issued for other items")
+	}
+	if b.secondAdmin && strings.EqualFold(requester, email) {
+		return errors.New(
+			errors.ErrForbidden,
+			"This request must be confirmed by another admin",
+			nil,
+			errors.WithContext("requested_by", requester),
+		)
+	}
+	return nil
+}
+
+// require refuses a request with a token confirming it
+func (b *BulkConfirmer) require(action, email, digest string, items int, message string) error {
+	expiresAt := time.Now().UTC().Add(b.ttl).Truncate(time.Second)
+	requester := base64.RawURLEncoding.EncodeToString([]byte(email))
+	expires, signature := b.signer.Sign(expiresAt, action, requester, digest)
+	token := expires + "." + requester + "." + signature
+
+	return errors.New(
+		errors.ErrConfirmation,
+		message,
+		nil,
+		errors.WithContext("confirmation_token", token),
+		errors.WithContext("confirmation_expires_at", expiresAt),
+		errors.WithContext("items", items),
+		errors.WithContext("second_admin_required", b.secondAdmin),
+	)
+}
+
+// verify reports who asked for the request a token confirms, and whether
+// the token is valid for action on the items digested
+func (b *BulkConfirmer) verify(token, action, digest string) (string, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", false
+	}
+	expires, requester, signature := parts[0], parts[1], parts[2]
+
+	if !b.signer.Verify(expires, signature, action, requester, digest) {
+		return "", false
+	}
+
+	email, err := base64.RawURLEncoding.DecodeString(requester)
+	if err != nil {
+		return "", false
+	}
+	return string(email), true
+}
+
+// digestIDs hashes a set of IDs regardless of their order, case or
+// repetitions
+func digestIDs(ids []string) string {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		normalized = append(normalized, strings.ToLower(strings.TrimSpace(id)))
+	}
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+
+	sum := sha256.Sum256([]byte(strings.Join(normalized, ",")))
+	return hex.EncodeToString(sum[:])
+}
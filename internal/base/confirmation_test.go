@@ -0,0 +1,50 @@
+package base
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBulkConfirmerVerify(t *testing.T) {
+	confirmer := NewBulkConfirmer([]byte("secret"), 10, time.Minute, false)
+	digest := digestIDs([]string{"a", "b"})
+	requester := base64.RawURLEncoding.EncodeToString([]byte("admin@example.com"))
+
+	expires, signature := confirmer.signer.Sign(time.Now().Add(time.Minute), "delete", requester, digest)
+	token := expires + "." + requester + "." + signature
+	expiredAt, expiredSignature := confirmer.signer.Sign(time.Now().Add(-time.Minute), "delete", requester, digest)
+
+	tests := []struct {
+		name      string
+		confirmer *BulkConfirmer
+		token     string
+		action    string
+		digest    string
+		wantOK    bool
+	}{
+		{"valid", confirmer, token, "delete", digest, true},
+		{"same items in another order", confirmer, token, "delete", digestIDs([]string{"B", "a", "a"}), true},
+		{"expired", confirmer, expiredAt + "." + requester + "." + expiredSignature, "delete", digest, false},
+		{"other action", confirmer, token, "archive", digest, false},
+		{"other items", confirmer, token, "delete", digestIDs([]string{"a", "c"}), false},
+		{"other requester", confirmer, expires + "." + base64.RawURLEncoding.EncodeToString([]byte("other@example.com")) + "." + signature, "delete", digest, false},
+		{"tampered signature", confirmer, expires + "." + requester + "." + strings.Repeat("0", len(signature)), "delete", digest, false},
+		{"extended expiry", confirmer, "99999999999." + requester + "." + signature, "delete", digest, false},
+		{"missing part", confirmer, expires + "." + signature, "delete", digest, false},
+		{"other secret", NewBulkConfirmer([]byte("other"), 10, time.Minute, false), token, "delete", digest, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email, ok := tt.confirmer.verify(tt.token, tt.action, tt.digest)
+			if ok != tt.wantOK {
+				t.Fatalf("verify() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && email != "admin@example.com" {
+				t.Errorf("verify() email = %q, want %q", email, "admin@example.com")
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package base
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// ChangeAction is the kind of write a dry run found
+// @Description Kind of write a dry run found
+// @Name ChangeAction
+type ChangeAction string
+
+const (
+	ActionCreate ChangeAction = "create"
+	ActionUpdate ChangeAction = "update"
+	ActionDelete ChangeAction = "delete"
+)
+
+// PlannedChange is one write a request would make, by its position in the
+// request, with every problem that would make it fail
+// @Description Write a request would make, and why it would fail
+// @Name PlannedChange
+type PlannedChange struct {
+	Index    int          `json:"index" example:"0"`
+	Action   ChangeAction `json:"action" example:"create"`
+	ID       string       `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string       `json:"name,omitempty" example:"portfolio-website"`
+	Problems []string     `json:"problems,omitempty" example:"slug \"portfolio-website\" is already taken"`
+}
+
+// DryRun reports the changes a write request would make without making
+// them. Valid is false when any change has problems.
+// @Description Changes a write request would make, validated but not applied
+// @Name DryRun
+type DryRun struct {
+	Valid   bool            `json:"valid" example:"false"`
+	Changes []PlannedChange `json:"changes"`
+}
+
+// NewDryRun creates an empty, valid dry run
+func NewDryRun() *DryRun {
+	return &DryRun{Valid: true, Changes: []PlannedChange{}}
+}
+
+// Add records a planned change
+func (d *DryRun) Add(change PlannedChange) {
+	if len(change.Problems) > 0 {
+		d.Valid = false
+	}
+	d.Changes = append(d.Changes, change)
+}
+
+// IsDryRun reports whether the request asks, with ?dry_run=true, to be
+// validated without writing anything
+func (h *BaseHandler) IsDryRun(c *gin.Context) (bool, error) {
+	value := c.Query("dry_run")
+	if value == "" {
+		return false, nil
+	}
+
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New(
+			errors.ErrValidation,
+			"Invalid dry_run value",
+			err,
+			errors.WithContext("dry_run", value),
+		)
+	}
+	return dryRun, nil
+}
@@ -0,0 +1,75 @@
+package base
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+)
+
+// DedupMode decides what creating probable duplicate content does
+type DedupMode string
+
+const (
+	// DedupOff skips duplicate checks
+	DedupOff DedupMode = "off"
+	// DedupWarn creates the content and reports the probable duplicates
+	DedupWarn DedupMode = "warn"
+	// DedupBlock rejects the content unless the request overrides the check
+	DedupBlock DedupMode = "block"
+)
+
+// ParseDedupMode parses a configured dedup mode
+func ParseDedupMode(mode string) (DedupMode, error) {
+	switch DedupMode(strings.ToLower(strings.TrimSpace(mode))) {
+	case DedupOff:
+		return DedupOff, nil
+	case DedupWarn, "":
+		return DedupWarn, nil
+	case DedupBlock:
+		return DedupBlock, nil
+	}
+	return "", fmt.Errorf("unknown dedup mode %q, expected off, warn or block", mode)
+}
+
+// DuplicateMatch is existing content that probably duplicates other content
+// @Description Existing record that probably duplicates another
+// @Name DuplicateMatch
+type DuplicateMatch struct {
+	ID     string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name   string `json:"name" example:"Portfolio Website"`
+	Reason string `json:"reason" example:"same title"`
+}
+
+// DuplicateGroup is a set of existing records that probably duplicate each
+// other
+// @Description Existing records that probably duplicate each other
+// @Name DuplicateGroup
+type DuplicateGroup struct {
+	Reason string           `json:"reason" example:"same title"`
+	Items  []DuplicateMatch `json:"items"`
+}
+
+// NormalizeTitle reduces a title to lowercase letters and digits separated
+// by single spaces, so that "Portfolio-Website " matches "portfolio website"
+func NormalizeTitle(title string) string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// DuplicateWarning reports probable duplicates of newly created content in
+// the response metadata. Failing to look for them does not fail the request
+// that already created the content.
+func (h *BaseHandler) DuplicateWarning(duplicates []DuplicateMatch, err error) []response.ResponseOption {
+	if err != nil {
+		h.logger.Warn("Failed to look for duplicates", "error", err.Error())
+		return nil
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return []response.ResponseOption{response.WithMetadata("possible_duplicates", duplicates)}
+}
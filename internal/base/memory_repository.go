@@ -0,0 +1,237 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemoryRepository keeps rows in memory, for running the API without a
+// database. Rows are matched on their JSON fields the way the REST API
+// matches columns, and rows without a tenant are visible to every tenant.
+type MemoryRepository[T any, R any] struct {
+	mu           sync.RWMutex
+	rows         []T
+	id           func(*T) string
+	toDTO        func(T) R
+	searchFields []string
+}
+
+// NewMemoryRepository creates an in-memory repository. id returns the
+// primary key of a row, toDTO converts rows to the type reads return and
+// searchFields are the fields matched by a search term.
+func NewMemoryRepository[T any, R any](rows []T, id func(*T) string, toDTO func(T) R, searchFields ...string) *MemoryRepository[T, R] {
+	return &MemoryRepository[T, R]{
+		rows:         append([]T(nil), rows...),
+		id:           id,
+		toDTO:        toDTO,
+		searchFields: searchFields,
+	}
+}
+
+func (r *MemoryRepository[T, R]) Create(ctx context.Context, value *T) (*T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rows = append(r.rows, *value)
+	return value, nil
+}
+
+func (r *MemoryRepository[T, R]) Update(ctx context.Context, value *T) (*T, error) {
+	r.Modify(ctx, r.id(value), func(row *T) {
+		*row = *value
+	})
+	return value, nil
+}
+
+func (r *MemoryRepository[T, R]) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.rows[:0]
+	for _, row := range r.rows {
+		if r.id(&row) == id && visibleToTenant(ctx, fields(row)) {
+			continue
+		}
+		kept = append(kept, row)
+	}
+	r.rows = kept
+	return nil
+}
+
+// Modify applies fn to the row with the given id, reporting whether it
+// was found
+func (r *MemoryRepository[T, R]) Modify(ctx context.Context, id string, fn func(row *T)) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.rows {
+		if r.id(&r.rows[i]) == id && visibleToTenant(ctx, fields(r.rows[i])) {
+			fn(&r.rows[i])
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryRepository[T, R]) FindByField(ctx context.Context, field string, value interface{}) ([]R, error) {
+	rows := r.match(ctx, []FilterOption{{Field: field, Operator: OperatorEqual, Value: value}}, "")
+	return r.dtos(rows), nil
+}
+
+func (r *MemoryRepository[T, R]) List(ctx context.Context, opts ListOptions) ([]R, error) {
+	rows := r.match(ctx, opts.Filters, opts.Search)
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == SortAscending
+		sort.SliceStable(rows, func(i, j int) bool {
+			less, ok := compareFields(fields(rows[i])[opts.SortBy], fields(rows[j])[opts.SortBy])
+			if !ok {
+				return false
+			}
+			return less == ascending
+		})
+	}
+
+	// Apply pagination
+	limit, offset := opts.LimitOffset()
+	if offset >= len(rows) {
+		return []R{}, nil
+	}
+	rows = rows[offset:min(offset+limit, len(rows))]
+
+	return r.dtos(rows), nil
+}
+
+func (r *MemoryRepository[T, R]) Count(ctx context.Context, filters []FilterOption) (int, error) {
+	return len(r.match(ctx, filters, "")), nil
+}
+
+func (r *MemoryRepository[T, R]) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, row := range r.rows {
+		if r.id(&row) == id && visibleToTenant(ctx, fields(row)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *MemoryRepository[T, R]) Search(ctx context.Context, opts ListOptions) ([]R, int, error) {
+	rows, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rows, count, nil
+}
+
+// match returns the rows visible to the tenant in ctx that pass the
+// filters and contain the search term in one of the search fields
+func (r *MemoryRepository[T, R]) match(ctx context.Context, filters []FilterOption, search string) []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	search = strings.ToLower(search)
+	var matched []T
+rows:
+	for _, row := range r.rows {
+		values := fields(row)
+		if !visibleToTenant(ctx, values) {
+			continue
+		}
+
+		for _, filter := range filters {
+			value := fmt.Sprintf("%v", values[filter.Field])
+			switch filter.Operator {
+			case OperatorEqual:
+				if value != fmt.Sprintf("%v", filter.Value) {
+					continue rows
+				}
+			case OperatorNotEqual:
+				if value == fmt.Sprintf("%v", filter.Value) {
+					continue rows
+				}
+			case OperatorLike:
+				if !strings.Contains(value, fmt.Sprintf("%v", filter.Value)) {
+					continue rows
+				}
+			}
+		}
+
+		if search != "" && !r.contains(values, search) {
+			continue
+		}
+
+		matched = append(matched, row)
+	}
+	return matched
+}
+
+// contains reports whether a search field contains the lowercase term
+func (r *MemoryRepository[T, R]) contains(values map[string]interface{}, term string) bool {
+	for _, field := range r.searchFields {
+		if value, ok := values[field]; ok && strings.Contains(strings.ToLower(fmt.Sprintf("%v", value)), term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryRepository[T, R]) dtos(rows []T) []R {
+	result := make([]R, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, r.toDTO(row))
+	}
+	return result
+}
+
+// fields returns the JSON fields of a row keyed by name
+func fields(row interface{}) map[string]interface{} {
+	values := map[string]interface{}{}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
+// visibleToTenant reports whether a row belongs to the tenant in ctx or to
+// no tenant at all
+func visibleToTenant(ctx context.Context, values map[string]interface{}) bool {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return true
+	}
+	owner, ok := values["tenant_id"].(string)
+	return !ok || owner == tenant.ID.String()
+}
+
+// compareFields reports whether a sorts before b. Numbers compare
+// numerically and other values by their text; ok is false when either
+// value is missing.
+func compareFields(a, b interface{}) (less bool, ok bool) {
+	if a == nil || b == nil {
+		return false, false
+	}
+	if x, isNumber := a.(float64); isNumber {
+		if y, isNumber := b.(float64); isNumber {
+			return x < y, x != y
+		}
+	}
+	x, y := fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)
+	return x < y, x != y
+}
@@ -0,0 +1,57 @@
+package base
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// maxMetaDescription is the longest meta description accepted; search
+// engines cut descriptions off well before it
+const maxMetaDescription = 320
+
+// SEO holds the search engine directives of an entity with a page of its
+// own on the site, stored with the entity
+// @Description Search engine directives of an entity
+// @Name SEO
+type SEO struct {
+	// NoIndex asks search engines not to index the page and leaves it out of
+	// the sitemap
+	NoIndex bool `json:"noindex" example:"false"`
+	// CanonicalURL is the preferred URL of the page, when it differs from
+	// the one it is served at
+	CanonicalURL string `json:"canonical_url,omitempty" example:"https://itsrama.dev/projects/portfolio-website"`
+	// MetaDescription replaces the description search engines show
+	MetaDescription string `json:"meta_description,omitempty" example:"How I built my portfolio with Go and Next.js"`
+}
+
+// Normalize trims the directives and checks that the canonical URL is an
+// absolute http(s) URL and the description is not too long
+func (s *SEO) Normalize() error {
+	s.CanonicalURL = strings.TrimSpace(s.CanonicalURL)
+	s.MetaDescription = strings.Join(strings.Fields(s.MetaDescription), " ")
+
+	if s.CanonicalURL != "" {
+		canonical, err := url.Parse(s.CanonicalURL)
+		if err != nil || (canonical.Scheme != "http" && canonical.Scheme != "https") || canonical.Host == "" {
+			return errors.New(
+				errors.ErrValidation,
+				"Canonical URL must be an absolute http(s) URL",
+				err,
+				errors.WithContext("canonical_url", s.CanonicalURL),
+			)
+		}
+	}
+
+	if utf8.RuneCountInString(s.MetaDescription) > maxMetaDescription {
+		return errors.New(
+			errors.ErrValidation,
+			"Meta description is too long",
+			nil,
+			errors.WithContext("max_length", maxMetaDescription),
+		)
+	}
+	return nil
+}
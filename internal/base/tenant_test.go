@@ -0,0 +1,59 @@
+package base
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
+)
+
+func TestScopeFilterToTenant(t *testing.T) {
+	tenantID := uuid.New()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		filter   *database.Filter
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:   "no tenant",
+			ctx:    context.Background(),
+			filter: database.NewFilter(),
+		},
+		{
+			name:   "nil tenant ID",
+			ctx:    WithTenant(context.Background(), TenantScope{Slug: "acme"}),
+			filter: database.NewFilter(),
+		},
+		{
+			name:     "tenant",
+			ctx:      WithTenant(context.Background(), TenantScope{ID: tenantID}),
+			filter:   database.NewFilter(),
+			wantSQL:  `WHERE ("tenant_id"::text = $1)`,
+			wantArgs: []any{tenantID.String()},
+		},
+		{
+			name:     "tenant after other conditions",
+			ctx:      WithTenant(context.Background(), TenantScope{ID: tenantID}),
+			filter:   database.NewFilter().Eq("id", "a"),
+			wantSQL:  `WHERE ("id"::text = $1) AND ("tenant_id"::text = $2)`,
+			wantArgs: []any{"a", tenantID.String()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := ScopeFilterToTenant(tt.ctx, tt.filter).SQL(0)
+			if sql != tt.wantSQL {
+				t.Errorf("SQL() = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("SQL() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
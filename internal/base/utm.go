@@ -0,0 +1,53 @@
+package base
+
+import (
+	"net/url"
+	"strings"
+)
+
+// maxUTMLength is the longest UTM parameter kept; longer ones are cut
+const maxUTMLength = 100
+
+// UTM holds the campaign parameters a visitor arrived with, stored with
+// the analytics events and inquiries they lead to
+// @Description Campaign parameters a visitor arrived with
+// @Name UTM
+type UTM struct {
+	Source   string `json:"utm_source,omitempty" db:"utm_source" example:"newsletter"`
+	Medium   string `json:"utm_medium,omitempty" db:"utm_medium" example:"email"`
+	Campaign string `json:"utm_campaign,omitempty" db:"utm_campaign" example:"launch-2026"`
+}
+
+// UTMFromURL reads the campaign parameters of a URL or path with a query
+// string, returning an empty UTM when it has none
+func UTMFromURL(raw string) UTM {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return UTM{}
+	}
+
+	query := parsed.Query()
+	utm := UTM{
+		Source:   query.Get("utm_source"),
+		Medium:   query.Get("utm_medium"),
+		Campaign: query.Get("utm_campaign"),
+	}
+	utm.Normalize()
+	return utm
+}
+
+// IsZero reports whether no parameter is set
+func (u UTM) IsZero() bool {
+	return u.Source == "" && u.Medium == "" && u.Campaign == ""
+}
+
+// Normalize trims and lowercases the parameters so "Newsletter" and
+// "newsletter " count as one source, and cuts overly long ones
+func (u *UTM) Normalize() {
+	for _, value := range []*string{&u.Source, &u.Medium, &u.Campaign} {
+		*value = strings.ToLower(strings.TrimSpace(*value))
+		if len(*value) > maxUTMLength {
+			*value = strings.ToValidUTF8((*value)[:maxUTMLength], "")
+		}
+	}
+}
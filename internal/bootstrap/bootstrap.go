@@ -0,0 +1,113 @@
+// Package bootstrap verifies the external dependencies of the server at
+// startup and reports every failure at once
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Check verifies a dependency the server needs
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Result is the outcome of a check
+type Result struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// Report holds the results of a bootstrap run, in the order the checks
+// were given
+type Report struct {
+	Results []Result
+}
+
+// Run runs the checks concurrently, failing those that take longer than
+// timeout
+func Run(ctx context.Context, timeout time.Duration, checks ...Check) Report {
+	results := make([]Result, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			// Clients that ignore the context must not hold up startup
+			done := make(chan error, 1)
+			start := time.Now()
+			go func() { done <- check.Run(checkCtx) }()
+
+			var err error
+			select {
+			case err = <-done:
+			case <-checkCtx.Done():
+				err = fmt.Errorf("timed out after %s", timeout)
+			}
+			results[i] = Result{Name: check.Name, Err: err, Duration: time.Since(start)}
+		}(i, check)
+	}
+	wg.Wait()
+
+	return Report{Results: results}
+}
+
+// Failed reports whether any check failed
+func (r Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns all failures as one error, or nil
+func (r Report) Err() error {
+	var failures []string
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", result.Name, result.Err))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d startup check(s) failed:\n  - %s", len(failures), strings.Join(failures, "\n  - "))
+}
+
+// String renders one line per check
+func (r Report) String() string {
+	var b strings.Builder
+	for _, result := range r.Results {
+		status := "ok"
+		if result.Err != nil {
+			status = "FAILED: " + result.Err.Error()
+		}
+		fmt.Fprintf(&b, "%-10s %-8s %s\n", result.Name, result.Duration.Round(time.Millisecond), status)
+	}
+	return b.String()
+}
+
+// Log writes each result to log, failures as warnings
+func (r Report) Log(log *logger.Logger) {
+	for _, result := range r.Results {
+		if result.Err != nil {
+			log.Warn("Startup check failed", "check", result.Name, "error", result.Err, "duration", result.Duration.String())
+			continue
+		}
+		log.Info("Startup check passed", "check", result.Name, "duration", result.Duration.String())
+	}
+}
@@ -0,0 +1,185 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/telegram"
+)
+
+// pollTimeout is how long a single getUpdates long poll is held open
+const pollTimeout = 50 * time.Second
+
+// HandlerFunc runs a bot command and returns the HTML reply to send back
+type HandlerFunc func(ctx context.Context, args []string) (string, error)
+
+// Command is a registered bot command
+type Command struct {
+	Name        string
+	Usage       string
+	Description string
+	Handler     HandlerFunc
+}
+
+// Bot is a Telegram bot for managing content from an allowed chat
+type Bot struct {
+	client         *telegram.Client
+	allowedChatIDs map[int64]bool
+	resolver       *tenant.Resolver
+	tenantSlug     string
+	logger         *logger.Logger
+
+	commands map[string]Command
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewBot creates a bot answering only the allowed chat IDs. Commands run in
+// the scope of tenantSlug, or the default tenant when it is empty.
+func NewBot(client *telegram.Client, allowedChatIDs []int64, resolver *tenant.Resolver, tenantSlug string, logger *logger.Logger) *Bot {
+	allowed := make(map[int64]bool, len(allowedChatIDs))
+	for _, id := range allowedChatIDs {
+		allowed[id] = true
+	}
+
+	b := &Bot{
+		client:         client,
+		allowedChatIDs: allowed,
+		resolver:       resolver,
+		tenantSlug:     tenantSlug,
+		logger:         logger,
+		commands:       make(map[string]Command),
+	}
+
+	b.Register(Command{
+		Name:        "help",
+		Description: "List available commands",
+		Handler:     b.help,
+	})
+
+	return b
+}
+
+// Register adds a command to the bot, replacing any command of the same name
+func (b *Bot) Register(cmd Command) {
+	b.commands[strings.ToLower(cmd.Name)] = cmd
+}
+
+// Start polls for updates until ctx is cancelled or Stop is called
+func (b *Bot) Start(ctx context.Context) {
+	ctx, b.cancel = context.WithCancel(ctx)
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+
+		var offset int64
+		for {
+			updates, err := b.client.GetUpdates(ctx, offset, pollTimeout)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				b.logger.Error("Failed to fetch telegram updates", "error", err)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(5 * time.Second):
+				}
+				continue
+			}
+
+			for _, update := range updates {
+				offset = update.UpdateID + 1
+				if update.Message != nil {
+					b.handleMessage(ctx, update.Message)
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts polling and waits for the current command to finish
+func (b *Bot) Stop() {
+	if b.cancel != nil {
+		b.cancel()
+	}
+	b.wg.Wait()
+}
+
+// handleMessage dispatches a command message from an allowed chat
+func (b *Bot) handleMessage(ctx context.Context, msg *telegram.Message) {
+	if !b.allowedChatIDs[msg.Chat.ID] {
+		b.logger.Warn("Ignoring telegram message from unauthorized chat", "chat_id", msg.Chat.ID)
+		return
+	}
+
+	fields := strings.Fields(msg.Text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return
+	}
+
+	// Strip the leading slash and any @botname suffix
+	name := strings.ToLower(strings.TrimPrefix(fields[0], "/"))
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+
+	reply := b.execute(ctx, name, fields[1:])
+	if err := b.client.SendMessage(ctx, msg.Chat.ID, reply); err != nil && ctx.Err() == nil {
+		b.logger.Error("Failed to send telegram reply", "chat_id", msg.Chat.ID, "error", err)
+	}
+}
+
+// execute runs a command in the bot's tenant scope and returns its reply
+func (b *Bot) execute(ctx context.Context, name string, args []string) string {
+	cmd, ok := b.commands[name]
+	if !ok {
+		return fmt.Sprintf("Unknown command /%s. Send /help for the list of commands.", html.EscapeString(name))
+	}
+
+	t, err := b.resolver.Resolve(ctx, b.tenantSlug, "")
+	if err != nil {
+		b.logger.Error("Failed to resolve bot tenant", "tenant", b.tenantSlug, "error", err)
+		return "Failed to resolve tenant."
+	}
+	ctx = base.WithTenant(ctx, t.Scope())
+
+	reply, err := cmd.Handler(ctx, args)
+	if err != nil {
+		b.logger.Error("Telegram command failed", "command", name, "error", err)
+		return html.EscapeString(err.Error())
+	}
+	return reply
+}
+
+// help lists the registered commands
+func (b *Bot) help(ctx context.Context, args []string) (string, error) {
+	names := make([]string, 0, len(b.commands))
+	for name := range b.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString("<b>Available commands</b>\n")
+	for _, name := range names {
+		cmd := b.commands[name]
+		usage := "/" + cmd.Name
+		if cmd.Usage != "" {
+			usage += " " + cmd.Usage
+		}
+		fmt.Fprintf(&sb, "\n%s - %s", html.EscapeString(usage), html.EscapeString(cmd.Description))
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,125 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// listLimit caps the number of items returned by list commands
+const listLimit = 10
+
+// RegisterProjectCommands adds commands for listing and featuring projects
+func RegisterProjectCommands(b *Bot, projectService project.ProjectService) {
+	b.Register(Command{
+		Name:        "projects",
+		Description: "List recently updated projects",
+		Handler: func(ctx context.Context, args []string) (string, error) {
+			projects, err := projectService.ListProjects(ctx, base.ListOptions{
+				Page:      1,
+				PerPage:   listLimit,
+				SortBy:    "updated_at",
+				SortOrder: base.SortDescending,
+			})
+			if err != nil {
+				return "", err
+			}
+			if len(projects) == 0 {
+				return "No projects yet.", nil
+			}
+
+			var sb strings.Builder
+			sb.WriteString("<b>Recent projects</b>\n")
+			for _, p := range projects {
+				star := ""
+				if p.IsFeatured {
+					star = " ★"
+				}
+				fmt.Fprintf(&sb, "\n%s <code>%s</code>%s", html.EscapeString(p.Title), html.EscapeString(p.Slug), star)
+			}
+			return sb.String(), nil
+		},
+	})
+
+	setFeatured := func(featured bool) HandlerFunc {
+		return func(ctx context.Context, args []string) (string, error) {
+			if len(args) != 1 {
+				return "", errors.New(errors.ErrValidation, "Project slug is required", nil)
+			}
+
+			projects, err := projectService.ListProjects(ctx, base.ListOptions{
+				Page:    1,
+				PerPage: 1,
+				Filters: []base.FilterOption{
+					{Field: "slug", Operator: base.OperatorEqual, Value: strings.ToLower(args[0])},
+				},
+			})
+			if err != nil {
+				return "", err
+			}
+			if len(projects) == 0 {
+				return "", errors.New(errors.ErrNotFound, "Project not found", nil,
+					errors.WithContext("slug", args[0]))
+			}
+
+			updated, err := projectService.SetProjectFeatured(ctx, projects[0].ID.String(), featured)
+			if err != nil {
+				return "", err
+			}
+
+			if featured {
+				return fmt.Sprintf("Featured <b>%s</b>.", html.EscapeString(updated.Title)), nil
+			}
+			return fmt.Sprintf("Unfeatured <b>%s</b>.", html.EscapeString(updated.Title)), nil
+		}
+	}
+
+	b.Register(Command{
+		Name:        "feature",
+		Usage:       "<slug>",
+		Description: "Mark a project as featured",
+		Handler:     setFeatured(true),
+	})
+
+	b.Register(Command{
+		Name:        "unfeature",
+		Usage:       "<slug>",
+		Description: "Remove a project from the featured list",
+		Handler:     setFeatured(false),
+	})
+}
+
+// RegisterExperienceCommands adds commands for listing experiences
+func RegisterExperienceCommands(b *Bot, experienceService experience.ExperienceService) {
+	b.Register(Command{
+		Name:        "experiences",
+		Description: "List recently updated experiences",
+		Handler: func(ctx context.Context, args []string) (string, error) {
+			experiences, err := experienceService.ListExperiences(ctx, base.ListOptions{
+				Page:      1,
+				PerPage:   listLimit,
+				SortBy:    "updated_at",
+				SortOrder: base.SortDescending,
+			})
+			if err != nil {
+				return "", err
+			}
+			if len(experiences) == 0 {
+				return "No experiences yet.", nil
+			}
+
+			var sb strings.Builder
+			sb.WriteString("<b>Recent experiences</b>\n")
+			for _, e := range experiences {
+				fmt.Fprintf(&sb, "\n%s at %s", html.EscapeString(e.Role), html.EscapeString(e.Company))
+			}
+			return sb.String(), nil
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package case_study
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"path"
+	"sync"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/diagram"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// diagramFolder is where rendered diagrams are stored
+const diagramFolder = "_cache/diagrams"
+
+// Diagrams renders the Mermaid and PlantUML code blocks of case studies to
+// SVG images kept in storage. Diagrams are stored by a hash of their source,
+// so each one is only rendered once and edited diagrams get a new URL.
+type Diagrams struct {
+	client  *diagram.Client
+	storage storage.Storage
+	logger  *logger.Logger
+
+	mu   sync.RWMutex
+	urls map[string]string
+}
+
+// NewDiagrams creates a diagram renderer storing the diagrams rendered by
+// client in storage
+func NewDiagrams(client *diagram.Client, storage storage.Storage, logger *logger.Logger) *Diagrams {
+	return &Diagrams{
+		client:  client,
+		storage: storage,
+		logger:  logger,
+		urls:    make(map[string]string),
+	}
+}
+
+// Block renders a diagram code block as an image. A nil renderer, or a
+// diagram failing to render, leaves the code block as it is.
+func (d *Diagrams) Block(ctx context.Context, language, code string) (string, bool) {
+	kind, ok := diagram.Kind(language)
+	if d == nil || !ok {
+		return "", false
+	}
+
+	url, err := d.url(ctx, language, code)
+	if err != nil {
+		d.logger.Warn("Failed to render diagram", "language", language, "error", err)
+		return "", false
+	}
+	return `<figure class="diagram"><img src="` + html.EscapeString(url) + `" alt="` + kind + ` diagram"></figure>`, true
+}
+
+// url returns the URL of the stored diagram, rendering and storing it first
+// when needed
+func (d *Diagrams) url(ctx context.Context, language, code string) (string, error) {
+	sum := sha256.Sum256([]byte(language + "\n" + code))
+	key := hex.EncodeToString(sum[:])
+
+	d.mu.RLock()
+	url, ok := d.urls[key]
+	d.mu.RUnlock()
+	if ok {
+		return url, nil
+	}
+
+	name := path.Join(diagramFolder, key+".svg")
+	storedPath := name
+	if data, err := d.storage.Download(ctx, name); err != nil || len(data) == 0 {
+		svg, err := d.client.Render(ctx, language, code)
+		if err != nil {
+			return "", err
+		}
+		if storedPath, err = d.storage.UploadBytes(ctx, svg, name, "image/svg+xml"); err != nil {
+			return "", err
+		}
+	}
+
+	url, err := d.storage.GetPublicURL(storedPath)
+	if err != nil {
+		return "", err
+	}
+
+	d.mu.Lock()
+	d.urls[key] = url
+	d.mu.Unlock()
+	return url, nil
+}
@@ -0,0 +1,78 @@
+package case_study
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CaseStudyHandler struct {
+	base.BaseHandler
+	caseStudyService CaseStudyService
+	printCSP         string
+}
+
+func NewCaseStudyHandler(caseStudyService CaseStudyService, printCSP string, logger *logger.Logger) *CaseStudyHandler {
+	return &CaseStudyHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		caseStudyService: caseStudyService,
+		printCSP:         printCSP,
+	}
+}
+
+// GetPrintView renders the printable case study of a project
+// @Summary Get the printable case study of a project
+// @Description Render the case study of a public project as a standalone HTML page laid out for printing or saving as PDF, with its overview, impact metrics, features, tech stack and links, without the frontend. Mermaid and PlantUML code blocks are rendered as diagram images when a diagram renderer is configured, and snippet code blocks as the highlighted code they link to.
+// @Tags Projects
+// @Produce html
+// @Param id path string true "Project ID"
+// @Success 200 {string} string "Printable case study"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /projects/{id}/print [get]
+func (h *CaseStudyHandler) GetPrintView(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	page, err := h.caseStudyService.RenderPrint(c.Request.Context(), projectID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// The page loads project images and its own styles, which the policy of
+	// the JSON API forbids
+	if h.printCSP != "" {
+		c.Header("Content-Security-Policy", h.printCSP)
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Header("X-Robots-Tag", "noindex")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+}
+
+// GetSnippet retrieves the highlighted code of a gist or repository file
+// @Summary Get a code snippet
+// @Description Fetch the code of a GitHub gist or of lines of a repository file, highlighted for display. Snippets are cached and refetched after a while, so pages do not run into GitHub rate limits.
+// @Tags Projects
+// @Produce json
+// @Param url query string true "Gist URL, optionally with a #file- anchor, or repository file URL, optionally with a #L10-L20 line range"
+// @Success 200 {object} response.APIResponse{data=Snippet} "Snippet retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Invalid URL"
+// @Failure 502 {object} response.APIResponse "GitHub could not be reached"
+// @Router /snippets [get]
+func (h *CaseStudyHandler) GetSnippet(c *gin.Context) {
+	snippet, err := h.caseStudyService.GetSnippet(c.Request.Context(), c.Query("url"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	h.HandleSuccess(c, snippet, "Snippet retrieved successfully")
+}
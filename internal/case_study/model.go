@@ -0,0 +1,50 @@
+package case_study
+
+import (
+	"html/template"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+)
+
+// printView is the data of the print template
+type printView struct {
+	SiteName     string
+	CanonicalURL string
+	PrintedAt    string
+
+	Title       string
+	Subtitle    string
+	Category    string
+	Roles       []string
+	Status      string
+	Updated     string
+	Description template.HTML
+	Thumbnail   *project.ProjectImage
+	Features    []string
+	Metrics     []printMetric
+	TechStack   []string
+	WebURL      string
+	GithubURL   string
+}
+
+// printMetric is a project metric formatted for reading
+type printMetric struct {
+	Label string
+	Value string
+}
+
+// Snippet is code from a gist or a repository file, highlighted for display
+// @Description Code of a gist or repository file with highlighting markup
+// @Name CodeSnippet
+type Snippet struct {
+	URL       string `json:"url" example:"https://github.com/holycann/itsrama-portfolio-backend/blob/main/cmd/main.go#L10-L20"`
+	Filename  string `json:"filename" example:"main.go"`
+	Language  string `json:"language" example:"go"`
+	StartLine int    `json:"start_line" example:"10"`
+	Code      string `json:"code" example:"func main() {}"`
+	// HTML is the escaped code with tokens wrapped in spans of the classes
+	// hl-c (comments), hl-s (strings), hl-n (numbers) and hl-k (keywords)
+	HTML      string    `json:"html" example:"<span class=\"hl-k\">func</span> main() {}"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
@@ -0,0 +1,232 @@
+package case_study
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// slugPlaceholder is replaced by the slug in the project path
+const slugPlaceholder = "{slug}"
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// printTemplate lays out a case study for printing or saving as PDF
+var printTemplate = template.Must(template.New("print.html").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).ParseFS(templateFS, "templates/print.html"))
+
+type CaseStudyService interface {
+	// RenderPrint renders the case study of a public project as a standalone
+	// HTML page laid out for printing
+	RenderPrint(ctx context.Context, id string) ([]byte, error)
+	// GetSnippet returns the highlighted code of a gist or repository file,
+	// cached so that pages do not run into GitHub rate limits
+	GetSnippet(ctx context.Context, url string) (*Snippet, error)
+}
+
+type caseStudyService struct {
+	projectService    project.ProjectService
+	siteConfigService site_config.SiteConfigService
+	diagrams          *Diagrams
+	snippets          *Snippets
+	projectPath       string
+}
+
+// NewCaseStudyService creates the case study service. A nil diagrams or
+// snippets leaves diagram or snippet code blocks as code.
+func NewCaseStudyService(projectService project.ProjectService, siteConfigService site_config.SiteConfigService, diagrams *Diagrams, snippets *Snippets, projectPath string) CaseStudyService {
+	if !strings.Contains(projectPath, slugPlaceholder) {
+		projectPath = "/projects/" + slugPlaceholder
+	}
+
+	return &caseStudyService{
+		projectService:    projectService,
+		siteConfigService: siteConfigService,
+		diagrams:          diagrams,
+		snippets:          snippets,
+		projectPath:       "/" + strings.TrimLeft(projectPath, "/"),
+	}
+}
+
+func (s *caseStudyService) RenderPrint(ctx context.Context, id string) ([]byte, error) {
+	p, err := s.projectService.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlisted and draft projects are only shared through share links
+	if !p.IsPublic() {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	view := printView{
+		CanonicalURL: p.SEO.CanonicalURL,
+		PrintedAt:    time.Now().UTC().Format("January 2, 2006"),
+		Title:        p.Title,
+		Subtitle:     p.Subtitle,
+		Category:     string(p.Category),
+		Roles:        p.MyRole,
+		Status:       status(p),
+		Description:  template.HTML(s.renderMarkdown(ctx, p.Description)),
+		Features:     p.Features,
+		WebURL:       p.WebUrl,
+		GithubURL:    p.GithubUrl,
+	}
+	if p.UpdatedAt != nil {
+		view.Updated = p.UpdatedAt.UTC().Format("January 2006")
+	}
+
+	// The page still prints without the name and URL of the site
+	if siteConfig, err := s.siteConfigService.GetSiteConfig(ctx); err == nil {
+		view.SiteName = siteConfig.SEO.Title
+		if view.CanonicalURL == "" {
+			view.CanonicalURL = s.projectURL(siteConfig.SEO.CanonicalURL, p.Slug)
+		}
+	}
+
+	// The thumbnail leads the page, or the first image when none is marked
+	for i := range p.Images {
+		if p.Images[i].IsThumbnail {
+			view.Thumbnail = &p.Images[i]
+			break
+		}
+	}
+	if view.Thumbnail == nil && len(p.Images) > 0 {
+		view.Thumbnail = &p.Images[0]
+	}
+
+	for _, metric := range p.Metrics {
+		view.Metrics = append(view.Metrics, printMetric{Label: metricLabel(metric), Value: metricValue(metric)})
+	}
+	for _, stack := range p.ProjectTechStack {
+		if stack.TechStack.Name != "" {
+			view.TechStack = append(view.TechStack, stack.TechStack.Name)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := printTemplate.Execute(&buf, view); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "Failed to render case study")
+	}
+	return buf.Bytes(), nil
+}
+
+// GetSnippet returns the highlighted code of a gist or repository file
+func (s *caseStudyService) GetSnippet(ctx context.Context, url string) (*Snippet, error) {
+	if s.snippets == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Code snippets are not enabled", nil)
+	}
+	return s.snippets.Get(ctx, url)
+}
+
+// renderMarkdown renders Markdown, showing snippet code blocks as the code
+// they reference and diagram code blocks as images
+func (s *caseStudyService) renderMarkdown(ctx context.Context, source string) string {
+	return markdown.RenderWith(source, markdown.Options{
+		CodeBlock: func(language, code string) (string, bool) {
+			if rendered, ok := s.snippets.Block(ctx, language, code); ok {
+				return rendered, true
+			}
+			return s.diagrams.Block(ctx, language, code)
+		},
+	})
+}
+
+// projectURL is the URL of the page of a project below the canonical URL of
+// the site, empty when the site has none
+func (s *caseStudyService) projectURL(canonicalURL, slug string) string {
+	canonical, err := url.Parse(canonicalURL)
+	if err != nil || (canonical.Scheme != "http" && canonical.Scheme != "https") || canonical.Host == "" {
+		return ""
+	}
+	site := strings.TrimRight(canonical.Scheme+"://"+canonical.Host+canonical.Path, "/")
+	return site + strings.ReplaceAll(s.projectPath, slugPlaceholder, url.PathEscape(slug))
+}
+
+// status describes the progress of a project, with its percentage while it
+// is unfinished
+func status(p *project.ProjectDTO) string {
+	if p.ProgressStatus == "" || p.ProgressStatus == project.Completed || p.ProgressPercentage <= 0 {
+		return string(p.ProgressStatus)
+	}
+	return fmt.Sprintf("%s (%d%%)", p.ProgressStatus, p.ProgressPercentage)
+}
+
+// metricLabel is the label of a metric, or its key in words when it has none
+func metricLabel(metric project.ProjectMetric) string {
+	if metric.Label != "" {
+		return metric.Label
+	}
+	label := strings.ReplaceAll(string(metric.Key), "_", " ")
+	if label == "" {
+		return ""
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// metricValue formats the value of a metric in its unit
+func metricValue(metric project.ProjectMetric) string {
+	value := formatNumber(metric.Value)
+
+	switch metric.Unit {
+	case project.UnitCount:
+		return value
+	case project.UnitPercent:
+		return value + "%"
+	case project.UnitRequests:
+		return value + " req/s"
+	case project.UnitMultiplier:
+		return value + "x"
+	case project.UnitUSD:
+		return "$" + value
+	case project.UnitEUR:
+		return "€" + value
+	case project.UnitIDR:
+		return "Rp " + value
+	default:
+		return value + " " + string(metric.Unit)
+	}
+}
+
+// formatNumber rounds v to two decimals and groups the thousands of its
+// integer part
+func formatNumber(v float64) string {
+	formatted := strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(formatted, ".")
+
+	var b strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	if hasFraction {
+		b.WriteString("." + fraction)
+	}
+	return sign + b.String()
+}
@@ -0,0 +1,141 @@
+package case_study
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/highlight"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/snippet"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// snippetLanguage is the code block language of snippet references
+	snippetLanguage = "snippet"
+
+	// maxSnippets bounds the number of cached snippets
+	maxSnippets = 500
+)
+
+type snippetEntry struct {
+	snippet   *Snippet
+	expiresAt time.Time
+}
+
+// Snippets fetches and highlights code referenced by case studies. Snippets
+// are refetched once they are older than the TTL; while GitHub cannot be
+// reached, the expired snippet is served instead.
+type Snippets struct {
+	client *snippet.Client
+	ttl    time.Duration
+	logger *logger.Logger
+
+	mu    sync.Mutex
+	cache map[string]snippetEntry
+	group singleflight.Group
+}
+
+// NewSnippets creates a snippet cache refetching snippets from client
+// after ttl
+func NewSnippets(client *snippet.Client, ttl time.Duration, logger *logger.Logger) *Snippets {
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	return &Snippets{
+		client: client,
+		ttl:    ttl,
+		logger: logger,
+		cache:  make(map[string]snippetEntry),
+	}
+}
+
+// Get returns the snippet of the gist or repository file at rawURL
+func (s *Snippets) Get(ctx context.Context, rawURL string) (*Snippet, error) {
+	ref, err := snippet.Parse(rawURL)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"URL must link to a gist or to a file of a GitHub repository",
+			err,
+			errors.WithContext("url", rawURL),
+		)
+	}
+
+	s.mu.Lock()
+	entry, cached := s.cache[ref.URL]
+	s.mu.Unlock()
+	if cached && time.Now().Before(entry.expiresAt) {
+		return entry.snippet, nil
+	}
+
+	result, err, _ := s.group.Do(ref.URL, func() (interface{}, error) {
+		fetched, err := s.client.Fetch(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		language := highlight.Language(fetched.Filename)
+		result := &Snippet{
+			URL:       fetched.URL,
+			Filename:  fetched.Filename,
+			Language:  language,
+			StartLine: fetched.StartLine,
+			Code:      fetched.Code,
+			HTML:      highlight.HTML(language, fetched.Code),
+			FetchedAt: time.Now().UTC(),
+		}
+
+		s.mu.Lock()
+		if _, ok := s.cache[ref.URL]; !ok && len(s.cache) >= maxSnippets {
+			for key := range s.cache {
+				delete(s.cache, key)
+				break
+			}
+		}
+		s.cache[ref.URL] = snippetEntry{snippet: result, expiresAt: time.Now().Add(s.ttl)}
+		s.mu.Unlock()
+		return result, nil
+	})
+	if err != nil {
+		if cached {
+			s.logger.Warn("Failed to refresh snippet, serving the expired one", "url", ref.URL, "error", err)
+			return entry.snippet, nil
+		}
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to fetch snippet from GitHub",
+			errors.WithContext("url", ref.URL),
+		)
+	}
+	return result.(*Snippet), nil
+}
+
+// Block renders a snippet code block, whose content is the URL of a gist or
+// repository file, as the highlighted code it references. A nil cache, or a
+// snippet failing to load, leaves the code block as it is.
+func (s *Snippets) Block(ctx context.Context, language, code string) (string, bool) {
+	if s == nil || !strings.EqualFold(language, snippetLanguage) {
+		return "", false
+	}
+
+	result, err := s.Get(ctx, code)
+	if err != nil {
+		s.logger.Warn("Failed to embed snippet", "url", strings.TrimSpace(code), "error", err)
+		return "", false
+	}
+
+	caption := html.EscapeString(result.Filename)
+	if result.StartLine > 1 || strings.Contains(result.URL, "#L") {
+		lines := strings.Count(result.Code, "\n")
+		caption += fmt.Sprintf(", lines %d–%d", result.StartLine, result.StartLine+lines)
+	}
+	return `<figure class="snippet"><pre><code class="language-` + html.EscapeString(result.Language) + `">` + result.HTML +
+		`</code></pre><figcaption><a href="` + html.EscapeString(result.URL) + `">` + caption + `</a></figcaption></figure>`, true
+}
@@ -0,0 +1,156 @@
+package changelog
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ChangelogHandler struct {
+	base.BaseHandler
+	changelogService ChangelogService
+}
+
+func NewChangelogHandler(changelogService ChangelogService, logger *logger.Logger) *ChangelogHandler {
+	return &ChangelogHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		changelogService: changelogService,
+	}
+}
+
+// ListEntries retrieves the public changelog feed
+// @Summary Get the changelog
+// @Description Retrieve a paginated changelog, newest first. Entries are recorded automatically when content changes and can also be written by hand.
+// @Tags Changelog
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param entity query string false "Filter by entity (project, experience, tech_stack)"
+// @Success 200 {object} response.APIResponse{data=[]Entry} "Changelog retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /changelog [get]
+func (h *ChangelogHandler) ListEntries(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if entity := c.Query("entity"); entity != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "entity",
+			Operator: base.OperatorEqual,
+			Value:    entity,
+		})
+	}
+
+	entries, err := h.changelogService.ListEntries(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.changelogService.CountEntries(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entries, "Changelog retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// CreateEntry writes a changelog entry by hand
+// @Summary Create a changelog entry
+// @Description Write a manual changelog entry, optionally backdated
+// @Tags Changelog
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entry body EntryCreate true "Changelog Entry Details"
+// @Success 200 {object} response.APIResponse{data=Entry} "Changelog entry created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/changelog [post]
+func (h *ChangelogHandler) CreateEntry(c *gin.Context) {
+	var entryInput EntryCreate
+	if err := h.ValidateRequest(c, &entryInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	entry, err := h.changelogService.CreateEntry(c.Request.Context(), &entryInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entry, "Changelog entry created successfully")
+}
+
+// UpdateEntry edits a changelog entry
+// @Summary Update a changelog entry
+// @Description Edit the title, body or publish date of a changelog entry
+// @Tags Changelog
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changelog Entry ID"
+// @Param entry body EntryUpdate true "Changelog Entry Update Details"
+// @Success 200 {object} response.APIResponse{data=Entry} "Changelog entry updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Changelog entry not found"
+// @Router /admin/changelog/{id} [put]
+func (h *ChangelogHandler) UpdateEntry(c *gin.Context) {
+	entryID, err := h.ValidateUUID(c.Param("id"), "Changelog Entry ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var entryInput EntryUpdate
+	if err := h.ValidateRequest(c, &entryInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	entryInput.ID = entryID
+
+	entry, err := h.changelogService.UpdateEntry(c.Request.Context(), &entryInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entry, "Changelog entry updated successfully")
+}
+
+// DeleteEntry removes a changelog entry
+// @Summary Delete a changelog entry
+// @Description Remove an entry from the changelog
+// @Tags Changelog
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changelog Entry ID"
+// @Success 200 {object} response.APIResponse "Changelog entry deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Changelog entry not found"
+// @Router /admin/changelog/{id} [delete]
+func (h *ChangelogHandler) DeleteEntry(c *gin.Context) {
+	entryID := c.Param("id")
+	if _, err := h.ValidateUUID(entryID, "Changelog Entry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.changelogService.DeleteEntry(c.Request.Context(), entryID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Changelog entry deleted successfully")
+}
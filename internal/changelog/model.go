@@ -0,0 +1,50 @@
+package changelog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entry is a single line of the public changelog
+// @Description Changelog entry recorded from a content change or written by hand
+// @Name ChangelogEntry
+type Entry struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Title    string     `json:"title" db:"title" example:"Added project Portfolio Website"`
+	Body     string     `json:"body,omitempty" db:"body" example:"Rewrote the backend in Go."`
+
+	// EventType, Entity and EntityID describe the change an automatic entry was recorded from
+	EventType string `json:"event_type,omitempty" db:"event_type" example:"project.created"`
+	Entity    string `json:"entity,omitempty" db:"entity" example:"project"`
+	EntityID  string `json:"entity_id,omitempty" db:"entity_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	IsManual  bool   `json:"is_manual" db:"is_manual" example:"false"`
+
+	PublishedAt time.Time  `json:"published_at" db:"published_at"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// EntryCreate is the input for writing a changelog entry by hand
+// @Name ChangelogEntryCreate
+type EntryCreate struct {
+	Title string `json:"title" validate:"required,max=255" example:"Redesigned the homepage"`
+	Body  string `json:"body" validate:"max=5000" example:"New layout with a project impact section."`
+
+	// Entity optionally links the entry to a project, experience or tech stack
+	Entity   string `json:"entity" validate:"max=50" example:"project"`
+	EntityID string `json:"entity_id" validate:"max=100" example:"650f9500-f39c-52d5-b827-557766550001"`
+
+	// PublishedAt defaults to now; set it to backdate an entry
+	PublishedAt *time.Time `json:"published_at" example:"2024-05-01T10:00:00Z"`
+}
+
+// EntryUpdate is the input for editing a changelog entry
+// @Name ChangelogEntryUpdate
+type EntryUpdate struct {
+	ID          uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string     `json:"title" validate:"max=255" example:"Redesigned the homepage"`
+	Body        string     `json:"body" validate:"max=5000" example:"New layout with a project impact section."`
+	PublishedAt *time.Time `json:"published_at" example:"2024-05-01T10:00:00Z"`
+}
@@ -0,0 +1,38 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+)
+
+// Publisher forwards domain events to the next publisher and records them
+// in the changelog of the tenant that published them
+type Publisher struct {
+	next    events.Publisher
+	service ChangelogService
+}
+
+func NewPublisher(next events.Publisher, service ChangelogService) *Publisher {
+	return &Publisher{
+		next:    next,
+		service: service,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event events.Event) {
+	p.next.Publish(ctx, event)
+
+	if !recordedEvents[event.Type] {
+		return
+	}
+
+	// Record in the background so a slow insert never delays the response.
+	// The context keeps its tenant scope but not the request cancellation.
+	go func(ctx context.Context) {
+		if _, err := p.service.Record(ctx, event); err != nil {
+			fmt.Printf("Failed to record changelog entry: %v\n", err)
+		}
+	}(context.WithoutCancel(ctx))
+}
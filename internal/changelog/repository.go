@@ -0,0 +1,176 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type ChangelogRepository interface {
+	Create(ctx context.Context, entry *Entry) (*Entry, error)
+	Update(ctx context.Context, entry *Entry) (*Entry, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Entry, error)
+	FindRecent(ctx context.Context, eventType, entityID string, since time.Time) (*Entry, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Entry, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type changelogRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewChangelogRepository(supabaseClient *supabase.SupabaseClient) ChangelogRepository {
+	return &changelogRepository{
+		supabaseClient: supabaseClient,
+		table:          "changelog_entry",
+	}
+}
+
+func (r *changelogRepository) Create(ctx context.Context, entry *Entry) (*Entry, error) {
+	entry.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(entry, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create changelog entry")
+	}
+	return entry, nil
+}
+
+func (r *changelogRepository) Update(ctx context.Context, entry *Entry) (*Entry, error) {
+	entry.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(entry, "minimal", "").
+		Eq("id", entry.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update changelog entry")
+	}
+	return entry, nil
+}
+
+func (r *changelogRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete changelog entry")
+	}
+	return nil
+}
+
+// FindByID returns the entry with the given ID, or nil if none
+func (r *changelogRepository) FindByID(ctx context.Context, id string) (*Entry, error) {
+	var entries []Entry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find changelog entry")
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// FindRecent returns the newest automatic entry recorded for the same event
+// on the same entity since the given time, or nil if none
+func (r *changelogRepository) FindRecent(ctx context.Context, eventType, entityID string, since time.Time) (*Entry, error) {
+	var entries []Entry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("event_type", eventType).
+		Eq("entity_id", entityID).
+		Eq("is_manual", "false").
+		Gte("published_at", since.UTC().Format(time.RFC3339))
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("published_at", &postgrest.OrderOpts{Ascending: false}).
+		Limit(1, "").
+		ExecuteTo(&entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find recent changelog entry")
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+func (r *changelogRepository) List(ctx context.Context, opts base.ListOptions) ([]Entry, error) {
+	var entries []Entry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, newest first by default
+	sortBy, ascending := "published_at", false
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list changelog entries")
+	}
+
+	return entries, nil
+}
+
+func (r *changelogRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count changelog entries")
+	}
+
+	return int(count), nil
+}
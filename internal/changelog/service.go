@@ -0,0 +1,190 @@
+package changelog
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// recordedEvents are the domain events that append to the changelog
+var recordedEvents = map[events.EventType]bool{
+	events.ProjectCreated:    true,
+	events.ProjectUpdated:    true,
+	events.ProjectDeleted:    true,
+	events.ExperienceCreated: true,
+	events.ExperienceUpdated: true,
+	events.ExperienceDeleted: true,
+	events.TechStackCreated:  true,
+	events.TechStackUpdated:  true,
+	events.TechStackDeleted:  true,
+}
+
+// coalescedEvents update the previous entry for the same entity when they
+// repeat within the coalesce window instead of appending a new one
+var coalescedEvents = map[events.EventType]bool{
+	events.ProjectUpdated:    true,
+	events.ExperienceUpdated: true,
+	events.TechStackUpdated:  true,
+}
+
+type ChangelogService interface {
+	Record(ctx context.Context, event events.Event) (*Entry, error)
+	CreateEntry(ctx context.Context, entryCreate *EntryCreate) (*Entry, error)
+	UpdateEntry(ctx context.Context, entryUpdate *EntryUpdate) (*Entry, error)
+	DeleteEntry(ctx context.Context, id string) error
+	ListEntries(ctx context.Context, opts base.ListOptions) ([]Entry, error)
+	CountEntries(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type changelogService struct {
+	changelogRepo  ChangelogRepository
+	coalesceWindow time.Duration
+}
+
+// NewChangelogService creates a changelog service. Repeated updates of an
+// entity within coalesceWindow share a single entry; zero disables this.
+func NewChangelogService(changelogRepo ChangelogRepository, coalesceWindow time.Duration) ChangelogService {
+	return &changelogService{
+		changelogRepo:  changelogRepo,
+		coalesceWindow: coalesceWindow,
+	}
+}
+
+// Record appends an entry for a domain event, or returns nil if the event
+// is not part of the changelog
+func (s *changelogService) Record(ctx context.Context, event events.Event) (*Entry, error) {
+	if !recordedEvents[event.Type] || event.Summary == "" {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	publishedAt := event.Timestamp
+	if publishedAt.IsZero() {
+		publishedAt = now
+	}
+
+	if coalescedEvents[event.Type] && s.coalesceWindow > 0 && event.EntityID != "" {
+		previous, err := s.changelogRepo.FindRecent(ctx, string(event.Type), event.EntityID, publishedAt.Add(-s.coalesceWindow))
+		if err != nil {
+			return nil, err
+		}
+		if previous != nil {
+			previous.Title = event.Summary
+			previous.PublishedAt = publishedAt
+			previous.UpdatedAt = &now
+			return s.changelogRepo.Update(ctx, previous)
+		}
+	}
+
+	entry := &Entry{
+		ID:          uuid.New(),
+		Title:       event.Summary,
+		EventType:   string(event.Type),
+		Entity:      event.Entity,
+		EntityID:    event.EntityID,
+		PublishedAt: publishedAt,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	return s.changelogRepo.Create(ctx, entry)
+}
+
+func (s *changelogService) CreateEntry(ctx context.Context, entryCreate *EntryCreate) (*Entry, error) {
+	// Validate input
+	if err := validator.ValidateModel(entryCreate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	entry := &Entry{
+		ID:          uuid.New(),
+		Title:       strings.TrimSpace(entryCreate.Title),
+		Body:        strings.TrimSpace(entryCreate.Body),
+		Entity:      strings.TrimSpace(entryCreate.Entity),
+		EntityID:    strings.TrimSpace(entryCreate.EntityID),
+		IsManual:    true,
+		PublishedAt: now,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	if entryCreate.PublishedAt != nil {
+		entry.PublishedAt = entryCreate.PublishedAt.UTC()
+	}
+
+	return s.changelogRepo.Create(ctx, entry)
+}
+
+func (s *changelogService) UpdateEntry(ctx context.Context, entryUpdate *EntryUpdate) (*Entry, error) {
+	// Validate input
+	if err := validator.ValidateModel(entryUpdate); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.findEntry(ctx, entryUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if title := strings.TrimSpace(entryUpdate.Title); title != "" {
+		entry.Title = title
+	}
+	if body := strings.TrimSpace(entryUpdate.Body); body != "" {
+		entry.Body = body
+	}
+	if entryUpdate.PublishedAt != nil {
+		entry.PublishedAt = entryUpdate.PublishedAt.UTC()
+	}
+
+	now := time.Now().UTC()
+	entry.UpdatedAt = &now
+
+	return s.changelogRepo.Update(ctx, entry)
+}
+
+func (s *changelogService) DeleteEntry(ctx context.Context, id string) error {
+	if _, err := s.findEntry(ctx, id); err != nil {
+		return err
+	}
+
+	return s.changelogRepo.Delete(ctx, id)
+}
+
+func (s *changelogService) ListEntries(ctx context.Context, opts base.ListOptions) ([]Entry, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.changelogRepo.List(ctx, opts)
+}
+
+func (s *changelogService) CountEntries(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.changelogRepo.Count(ctx, filters)
+}
+
+// findEntry returns the entry with the given ID or a not found error
+func (s *changelogService) findEntry(ctx context.Context, id string) (*Entry, error) {
+	entry, err := s.changelogRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Changelog entry not found",
+			nil,
+			errors.WithContext("entry_id", id),
+		)
+	}
+	return entry, nil
+}
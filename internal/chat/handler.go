@@ -0,0 +1,74 @@
+package chat
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ChatHandler struct {
+	base.BaseHandler
+	chatService ChatService
+}
+
+func NewChatHandler(chatService ChatService, logger *logger.Logger) *ChatHandler {
+	return &ChatHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		chatService: chatService,
+	}
+}
+
+// Ask answers a question about the portfolio
+// @Summary Ask the portfolio chatbot
+// @Description Answer a visitor's question about the projects and experience in the portfolio, citing the content used. Questions unrelated to the portfolio are declined. Pass the returned session_id to ask follow-up questions; conversations expire when idle.
+// @Tags Chat
+// @Accept json
+// @Produce json
+// @Param message body MessageCreate true "Question"
+// @Success 200 {object} response.APIResponse{data=Reply} "Question answered successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Conversation not found or expired"
+// @Failure 409 {object} response.APIResponse "The previous question is still being answered"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Failure 500 {object} response.APIResponse "Chat is not configured or unreachable"
+// @Router /chat [post]
+func (h *ChatHandler) Ask(c *gin.Context) {
+	var messageInput MessageCreate
+	if err := h.ValidateRequest(c, &messageInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	reply, err := h.chatService.Ask(c.Request.Context(), &messageInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, reply, "Question answered successfully")
+}
+
+// EndSession ends a conversation
+// @Summary End a chatbot conversation
+// @Description Forget the questions and answers of a conversation
+// @Tags Chat
+// @Produce json
+// @Param session_id path string true "Session ID"
+// @Success 200 {object} response.APIResponse "Conversation ended successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Conversation not found or expired"
+// @Router /chat/{session_id} [delete]
+func (h *ChatHandler) EndSession(c *gin.Context) {
+	sessionID := c.Param("session_id")
+	if _, err := h.ValidateUUID(sessionID, "Session ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.chatService.EndSession(c.Request.Context(), sessionID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Conversation ended successfully")
+}
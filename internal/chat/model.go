@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/search"
+)
+
+// MessageCreate is a visitor's question
+// @Description Question about the portfolio, optionally continuing a conversation
+// @Name ChatMessageCreate
+type MessageCreate struct {
+	// SessionID continues a conversation; leave it empty to start one
+	SessionID string `json:"session_id,omitempty" example:"3f1c2b6e-8a4d-4e7b-9c2a-1d5e6f7a8b9c"`
+	Message   string `json:"message" validate:"required,max=500" example:"Which projects used Go?"`
+}
+
+// Reply is the chatbot's answer to a question
+// @Description Answer to a question about the portfolio with the content it cites
+// @Name ChatReply
+type Reply struct {
+	SessionID string `json:"session_id" example:"3f1c2b6e-8a4d-4e7b-9c2a-1d5e6f7a8b9c"`
+
+	// Answer cites content with markers such as [1], matching Citations
+	Answer    string     `json:"answer" example:"The booking platform was built with Go and PostgreSQL [1]."`
+	Citations []Citation `json:"citations"`
+
+	// OffTopic is set when the question was declined as unrelated to the
+	// portfolio
+	OffTopic bool `json:"off_topic" example:"false"`
+
+	// RemainingMessages is the number of questions left in the conversation
+	RemainingMessages int       `json:"remaining_messages" example:"19"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+// Citation is a project or experience an answer is based on
+// @Description Content cited by an answer
+// @Name ChatCitation
+type Citation struct {
+	// Index is the number of the marker citing the content in the answer
+	Index int               `json:"index" example:"1"`
+	Type  search.SourceType `json:"type" example:"project"`
+	ID    uuid.UUID         `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title string            `json:"title" example:"Booking Platform"`
+}
@@ -0,0 +1,185 @@
+package chat
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/search"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/gemini"
+)
+
+// Generator produces the model's reply to a conversation
+type Generator interface {
+	Generate(ctx context.Context, systemInstruction string, messages []gemini.Message) (string, error)
+}
+
+type ChatService interface {
+	// Ask answers a question about the portfolio from the indexed projects
+	// and experiences, continuing the conversation in messageCreate if any
+	Ask(ctx context.Context, messageCreate *MessageCreate) (*Reply, error)
+	// EndSession forgets a conversation
+	EndSession(ctx context.Context, sessionID string) error
+}
+
+// Options tunes conversations and retrieval
+type Options struct {
+	SessionTTL  time.Duration
+	MaxTurns    int
+	MaxMessages int
+	MaxSessions int
+	Passages    int
+}
+
+type chatService struct {
+	searchService     search.SearchService
+	siteConfigService site_config.SiteConfigService
+	generator         Generator
+	sessions          *sessionStore
+	opts              Options
+}
+
+// NewChatService creates the chatbot. A nil generator disables it.
+func NewChatService(searchService search.SearchService, siteConfigService site_config.SiteConfigService, generator Generator, opts Options) ChatService {
+	if opts.MaxTurns <= 0 {
+		opts.MaxTurns = 4
+	}
+	if opts.MaxMessages <= 0 {
+		opts.MaxMessages = 20
+	}
+	if opts.Passages <= 0 {
+		opts.Passages = 5
+	}
+
+	return &chatService{
+		searchService:     searchService,
+		siteConfigService: siteConfigService,
+		generator:         generator,
+		sessions:          newSessionStore(opts.SessionTTL, opts.MaxSessions),
+		opts:              opts,
+	}
+}
+
+func (s *chatService) Ask(ctx context.Context, messageCreate *MessageCreate) (*Reply, error) {
+	if s.generator == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Chat is not configured", nil)
+	}
+
+	messageCreate.Message = strings.TrimSpace(messageCreate.Message)
+
+	// Validate input
+	if err := validator.ValidateModel(messageCreate); err != nil {
+		return nil, err
+	}
+
+	tenantID := tenantKey(ctx)
+	sess, history, err := s.sessions.acquire(messageCreate.SessionID, tenantID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	question := messageCreate.Message
+	var answer string
+	defer func() {
+		s.sessions.release(sess, question, answer, s.opts.MaxTurns, time.Now())
+	}()
+
+	if sess.messages >= s.opts.MaxMessages {
+		return nil, errors.New(
+			errors.ErrTooManyRequests,
+			"This conversation has reached its message limit, please start a new one",
+			nil,
+			errors.WithContext("max_messages", s.opts.MaxMessages),
+		)
+	}
+
+	reply, err := s.answer(ctx, question, history)
+	if err != nil {
+		return nil, err
+	}
+
+	answer = reply.Answer
+	reply.SessionID = sess.id
+	reply.RemainingMessages = s.opts.MaxMessages - sess.messages - 1
+	reply.ExpiresAt = time.Now().UTC().Add(s.sessions.ttl)
+	return reply, nil
+}
+
+// answer retrieves the passages relevant to a question and asks the model
+// to answer from them
+func (s *chatService) answer(ctx context.Context, question string, history []gemini.Message) (*Reply, error) {
+	if isInjection(question) {
+		return &Reply{Answer: offTopicReply, Citations: []Citation{}, OffTopic: true}, nil
+	}
+
+	// Follow-up questions such as "which stack did it use?" are retrieved
+	// together with the previous question
+	query := question
+	if len(history) >= 2 {
+		query = history[len(history)-2].Text + "\n" + question
+	}
+
+	passages, err := s.searchService.Retrieve(ctx, query, s.opts.Passages)
+	if err != nil {
+		return nil, err
+	}
+	if len(passages) == 0 {
+		return &Reply{Answer: noContextReply, Citations: []Citation{}}, nil
+	}
+
+	messages := append(history, gemini.Message{
+		Role: gemini.RoleUser,
+		Text: buildPrompt(question, passages),
+	})
+
+	text, err := s.generator.Generate(ctx, fmt.Sprintf(systemInstruction, s.siteName(ctx)), messages)
+	if stderrors.Is(err, gemini.ErrBlocked) {
+		return &Reply{Answer: offTopicReply, Citations: []Citation{}, OffTopic: true}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrNetwork, "Failed to generate an answer")
+	}
+
+	if text == "" || isOffTopic(text) {
+		return &Reply{Answer: offTopicReply, Citations: []Citation{}, OffTopic: true}, nil
+	}
+
+	text, cited := citations(text, passages)
+	return &Reply{Answer: text, Citations: cited}, nil
+}
+
+func (s *chatService) EndSession(ctx context.Context, sessionID string) error {
+	if !s.sessions.delete(sessionID, tenantKey(ctx)) {
+		return errors.New(
+			errors.ErrNotFound,
+			"Conversation not found or expired",
+			nil,
+			errors.WithContext("session_id", sessionID),
+		)
+	}
+	return nil
+}
+
+func (s *chatService) siteName(ctx context.Context) string {
+	if siteConfig, err := s.siteConfigService.GetSiteConfig(ctx); err == nil && siteConfig.SEO.Title != "" {
+		return siteConfig.SEO.Title
+	}
+	if title := site_config.DefaultSiteConfig().SEO.Title; title != "" {
+		return title
+	}
+	return "Portfolio"
+}
+
+// tenantKey returns the ID of the tenant in ctx, which conversations are
+// bound to, or the nil UUID outside of a tenant
+func tenantKey(ctx context.Context) uuid.UUID {
+	tenant, _ := base.TenantFromContext(ctx)
+	return tenant.ID
+}
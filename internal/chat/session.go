@@ -0,0 +1,152 @@
+package chat
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/gemini"
+)
+
+// session is a conversation kept in memory
+type session struct {
+	id       string
+	tenantID uuid.UUID
+	history  []gemini.Message
+	messages int
+	lastSeen time.Time
+
+	// busy is set while a question is being answered, so that a visitor
+	// cannot run several answers of one conversation in parallel
+	busy bool
+}
+
+// sessionStore keeps conversations in memory until they have been idle for
+// ttl. Conversations do not survive restarts.
+type sessionStore struct {
+	ttl         time.Duration
+	maxSessions int
+
+	mu        sync.Mutex
+	sessions  map[string]*session
+	lastSweep time.Time
+}
+
+func newSessionStore(ttl time.Duration, maxSessions int) *sessionStore {
+	if ttl <= 0 {
+		ttl = 30 * time.Minute
+	}
+	if maxSessions <= 0 {
+		maxSessions = 1000
+	}
+
+	return &sessionStore{
+		ttl:         ttl,
+		maxSessions: maxSessions,
+		sessions:    make(map[string]*session),
+	}
+}
+
+// acquire returns the conversation with id, or a new one when id is empty,
+// and marks it busy until release is called. The returned copy of the
+// history may be used without holding the lock.
+func (s *sessionStore) acquire(id string, tenantID uuid.UUID, now time.Time) (*session, []gemini.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	if id == "" {
+		if len(s.sessions) >= s.maxSessions {
+			return nil, nil, errors.New(
+				errors.ErrTooManyRequests,
+				"Too many conversations, please try again later",
+				nil,
+			)
+		}
+
+		sess := &session{
+			id:       uuid.NewString(),
+			tenantID: tenantID,
+			lastSeen: now,
+			busy:     true,
+		}
+		s.sessions[sess.id] = sess
+		return sess, nil, nil
+	}
+
+	// Conversations of other tenants are reported as missing
+	sess, ok := s.sessions[id]
+	if !ok || sess.tenantID != tenantID || (!sess.busy && now.Sub(sess.lastSeen) >= s.ttl) {
+		return nil, nil, errors.New(
+			errors.ErrNotFound,
+			"Conversation not found or expired",
+			nil,
+			errors.WithContext("session_id", id),
+		)
+	}
+	if sess.busy {
+		return nil, nil, errors.New(
+			errors.ErrConflict,
+			"The previous question is still being answered",
+			nil,
+		)
+	}
+
+	sess.busy = true
+	sess.lastSeen = now
+	history := make([]gemini.Message, len(sess.history))
+	copy(history, sess.history)
+	return sess, history, nil
+}
+
+// release stores a question and its answer, keeping the last maxTurns
+// exchanges, and frees the conversation for the next question. An empty
+// answer releases it without recording the question.
+func (s *sessionStore) release(sess *session, question, answer string, maxTurns int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess.busy = false
+	sess.lastSeen = now
+	if answer == "" {
+		return
+	}
+
+	sess.messages++
+	sess.history = append(sess.history,
+		gemini.Message{Role: gemini.RoleUser, Text: question},
+		gemini.Message{Role: gemini.RoleModel, Text: answer},
+	)
+	if limit := maxTurns * 2; len(sess.history) > limit {
+		sess.history = sess.history[len(sess.history)-limit:]
+	}
+}
+
+// delete ends a conversation of the tenant
+func (s *sessionStore) delete(id string, tenantID uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[id]
+	if !ok || sess.tenantID != tenantID {
+		return false
+	}
+	delete(s.sessions, id)
+	return true
+}
+
+// sweep drops idle conversations at most once per ttl
+func (s *sessionStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.ttl && len(s.sessions) < s.maxSessions {
+		return
+	}
+
+	for id, sess := range s.sessions {
+		if !sess.busy && now.Sub(sess.lastSeen) >= s.ttl {
+			delete(s.sessions, id)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,110 @@
+package coding_activity
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CodingActivityHandler struct {
+	base.BaseHandler
+	codingActivityService CodingActivityService
+}
+
+func NewCodingActivityHandler(codingActivityService CodingActivityService, logger *logger.Logger) *CodingActivityHandler {
+	return &CodingActivityHandler{
+		BaseHandler:           *base.NewBaseHandler(logger),
+		codingActivityService: codingActivityService,
+	}
+}
+
+// GetSummary retrieves the coding activity totals
+// @Summary Get coding activity summary
+// @Description Retrieve total and daily average hours coded over the last days with the time spent per language
+// @Tags Coding Activity
+// @Produce json
+// @Param days query int false "Number of days including today (1-365)" default(30)
+// @Success 200 {object} response.APIResponse{data=Summary} "Coding activity summary retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /coding-activity [get]
+func (h *CodingActivityHandler) GetSummary(c *gin.Context) {
+	query, ok := h.bindQuery(c)
+	if !ok {
+		return
+	}
+
+	summary, err := h.codingActivityService.GetSummary(c.Request.Context(), query.Days)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, summary, "Coding activity summary retrieved successfully")
+}
+
+// GetWeeklyActivity retrieves the hours coded per week
+// @Summary Get weekly coding activity
+// @Description Retrieve the hours coded in each of the last weeks, oldest first. Weeks start on Monday and the current week is included.
+// @Tags Coding Activity
+// @Produce json
+// @Param weeks query int false "Number of weeks (1-52)" default(12)
+// @Success 200 {object} response.APIResponse{data=[]WeeklyActivity} "Weekly coding activity retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /coding-activity/weekly [get]
+func (h *CodingActivityHandler) GetWeeklyActivity(c *gin.Context) {
+	query, ok := h.bindQuery(c)
+	if !ok {
+		return
+	}
+
+	activity, err := h.codingActivityService.GetWeeklyActivity(c.Request.Context(), query.Weeks)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, activity, "Weekly coding activity retrieved successfully")
+}
+
+// Sync pulls coding activity from WakaTime
+// @Summary Refresh coding activity
+// @Description Pull the last days of coding activity from WakaTime now, overwriting their snapshots and clearing cached aggregates
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Number of days including today (1-365), defaults to WAKATIME_SYNC_DAYS"
+// @Success 200 {object} response.APIResponse{data=SyncResult} "Coding activity refreshed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "WakaTime is not configured or unreachable"
+// @Router /admin/coding-activity/refresh [post]
+func (h *CodingActivityHandler) Sync(c *gin.Context) {
+	query, ok := h.bindQuery(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.codingActivityService.Sync(c.Request.Context(), query.Days)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, result, "Coding activity refreshed successfully")
+}
+
+// bindQuery parses the window query parameters, writing the error response
+// when they are invalid
+func (h *CodingActivityHandler) bindQuery(c *gin.Context) (ActivityQuery, bool) {
+	var query ActivityQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return query, false
+	}
+	return query, true
+}
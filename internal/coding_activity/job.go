@@ -0,0 +1,94 @@
+package coding_activity
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job periodically pulls coding activity for the tenant owning the
+// WakaTime account
+type Job struct {
+	codingActivityService CodingActivityService
+	resolver              *tenant.Resolver
+	tenantSlug            string
+	interval              time.Duration
+	logger                *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a sync job running every interval for the tenant with
+// tenantSlug, or the default tenant when empty
+func NewJob(codingActivityService CodingActivityService, resolver *tenant.Resolver, tenantSlug string, interval time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = 6 * time.Hour
+	}
+
+	return &Job{
+		codingActivityService: codingActivityService,
+		resolver:              resolver,
+		tenantSlug:            tenantSlug,
+		interval:              interval,
+		logger:                logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// sync runs right away in the background so the chart has data after a
+// fresh deploy.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.run(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run syncs the configured number of days
+func (j *Job) run(ctx context.Context) {
+	t, err := j.resolver.Resolve(ctx, j.tenantSlug, "")
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to resolve coding activity tenant", "tenant", j.tenantSlug, "error", err)
+		}
+		return
+	}
+
+	result, err := j.codingActivityService.Sync(base.WithTenant(ctx, t.Scope()), 0)
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to sync coding activity", "tenant", t.Slug, "error", err)
+		}
+		return
+	}
+
+	j.logger.Info("Coding activity synced", "tenant", t.Slug, "from", result.From, "to", result.To, "days", result.Days)
+}
@@ -0,0 +1,73 @@
+package coding_activity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Day is the coding activity snapshot of a single day
+// @Description Coding activity of a day as reported by WakaTime
+// @Name CodingActivityDay
+type Day struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+
+	// Date is the day in YYYY-MM-DD format
+	Date         string         `json:"date" db:"date" example:"2024-05-13"`
+	TotalSeconds float64        `json:"total_seconds" db:"total_seconds" example:"18342.5"`
+	Languages    []LanguageTime `json:"languages" db:"languages"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// LanguageTime is the time spent in a language on a day
+// @Name CodingActivityLanguageTime
+type LanguageTime struct {
+	Name         string  `json:"name" example:"Go"`
+	TotalSeconds float64 `json:"total_seconds" example:"9120.3"`
+}
+
+// ActivityQuery represents the window requested through query parameters
+// @Name CodingActivityQuery
+type ActivityQuery struct {
+	Weeks int `form:"weeks" example:"12"`
+	Days  int `form:"days" example:"30"`
+}
+
+// WeeklyActivity is the time spent coding in a week starting on Monday
+// @Name CodingActivityWeek
+type WeeklyActivity struct {
+	WeekStart string  `json:"week_start" example:"2024-05-13"`
+	Hours     float64 `json:"hours" example:"24.5"`
+}
+
+// LanguageShare is the time spent in a language over a window
+// @Name CodingActivityLanguage
+type LanguageShare struct {
+	Name    string  `json:"name" example:"Go"`
+	Hours   float64 `json:"hours" example:"61.2"`
+	Percent float64 `json:"percent" example:"48.3"`
+}
+
+// Summary aggregates the coding activity over the last days
+// @Description Coding activity totals over a window of days
+// @Name CodingActivitySummary
+type Summary struct {
+	Days              int             `json:"days" example:"30"`
+	TotalHours        float64         `json:"total_hours" example:"126.7"`
+	DailyAverageHours float64         `json:"daily_average_hours" example:"4.2"`
+	Languages         []LanguageShare `json:"languages"`
+
+	// LastSyncedAt is when the newest snapshot was pulled from WakaTime
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+}
+
+// SyncResult reports a pull from WakaTime
+// @Name CodingActivitySyncResult
+type SyncResult struct {
+	From string `json:"from" example:"2024-05-07"`
+	To   string `json:"to" example:"2024-05-13"`
+	Days int    `json:"days" example:"7"`
+}
@@ -0,0 +1,77 @@
+package coding_activity
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+// pageSize bounds the rows read per request when loading a date range
+const pageSize = 100
+
+type CodingActivityRepository interface {
+	UpsertDays(ctx context.Context, days []Day) error
+	ListRange(ctx context.Context, from, to string) ([]Day, error)
+}
+
+type codingActivityRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewCodingActivityRepository(supabaseClient *supabase.SupabaseClient) CodingActivityRepository {
+	return &codingActivityRepository{
+		supabaseClient: supabaseClient,
+		table:          "coding_activity",
+	}
+}
+
+// UpsertDays stores the snapshots of the tenant in ctx, one row per date
+func (r *codingActivityRepository) UpsertDays(ctx context.Context, days []Day) error {
+	if len(days) == 0 {
+		return nil
+	}
+
+	tenantID := base.TenantIDFromContext(ctx)
+	for i := range days {
+		days[i].TenantID = tenantID
+	}
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(days, "tenant_id,date", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save coding activity")
+	}
+	return nil
+}
+
+// ListRange returns the snapshots dated from through to, inclusive, oldest first
+func (r *codingActivityRepository) ListRange(ctx context.Context, from, to string) ([]Day, error) {
+	var all []Day
+	for offset := 0; ; offset += pageSize {
+		var days []Day
+		query := r.supabaseClient.GetClient().
+			From(r.table).
+			Select("*", "", false).
+			Gte("date", from).
+			Lte("date", to)
+
+		_, err := base.ScopeToTenant(ctx, query).
+			Order("date", &postgrest.OrderOpts{Ascending: true}).
+			Range(offset, offset+pageSize-1, "").
+			ExecuteTo(&days)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list coding activity")
+		}
+
+		all = append(all, days...)
+		if len(days) < pageSize {
+			return all, nil
+		}
+	}
+}
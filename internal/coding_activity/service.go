@@ -0,0 +1,321 @@
+package coding_activity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/wakatime"
+)
+
+// dateLayout is the format of Day.Date
+const dateLayout = "2006-01-02"
+
+const (
+	defaultWeeks = 12
+	maxWeeks     = 52
+	defaultDays  = 30
+	maxDays      = 365
+)
+
+type CodingActivityService interface {
+	Sync(ctx context.Context, days int) (*SyncResult, error)
+	GetWeeklyActivity(ctx context.Context, weeks int) ([]WeeklyActivity, error)
+	GetSummary(ctx context.Context, days int) (*Summary, error)
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+type codingActivityService struct {
+	codingActivityRepo CodingActivityRepository
+	client             *wakatime.Client
+	syncDays           int
+	cacheTTL           time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewCodingActivityService creates the service; a nil client disables
+// syncing while stored snapshots stay readable
+func NewCodingActivityService(codingActivityRepo CodingActivityRepository, client *wakatime.Client, syncDays int, cacheTTL time.Duration) CodingActivityService {
+	if syncDays <= 0 {
+		syncDays = 7
+	}
+
+	return &codingActivityService{
+		codingActivityRepo: codingActivityRepo,
+		client:             client,
+		syncDays:           syncDays,
+		cacheTTL:           cacheTTL,
+		cache:              make(map[string]cacheEntry),
+	}
+}
+
+// Sync pulls the last days of activity from WakaTime and overwrites their
+// snapshots. Zero days pulls the configured default.
+func (s *codingActivityService) Sync(ctx context.Context, days int) (*SyncResult, error) {
+	if s.client == nil {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"WakaTime integration is not configured",
+			nil,
+		)
+	}
+
+	if days == 0 {
+		days = s.syncDays
+	}
+	if days < 1 || days > maxDays {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("Days must be between 1 and %d", maxDays),
+			nil,
+			errors.WithContext("days", days),
+		)
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -(days - 1))
+
+	summaries, err := s.client.Summaries(ctx, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to fetch coding activity from WakaTime",
+		)
+	}
+
+	existing, err := s.codingActivityRepo.ListRange(ctx, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]Day, len(existing))
+	for _, day := range existing {
+		byDate[day.Date] = day
+	}
+
+	now := time.Now().UTC()
+	snapshots := make([]Day, 0, len(summaries))
+	for _, summary := range summaries {
+		if summary.Range.Date == "" {
+			continue
+		}
+
+		day := Day{
+			ID:           uuid.New(),
+			Date:         summary.Range.Date,
+			TotalSeconds: summary.GrandTotal.TotalSeconds,
+			Languages:    make([]LanguageTime, 0, len(summary.Languages)),
+			CreatedAt:    &now,
+			UpdatedAt:    &now,
+		}
+		if previous, ok := byDate[day.Date]; ok {
+			day.ID = previous.ID
+			day.CreatedAt = previous.CreatedAt
+		}
+		for _, language := range summary.Languages {
+			day.Languages = append(day.Languages, LanguageTime{
+				Name:         language.Name,
+				TotalSeconds: language.TotalSeconds,
+			})
+		}
+		snapshots = append(snapshots, day)
+	}
+
+	if err := s.codingActivityRepo.UpsertDays(ctx, snapshots); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+
+	return &SyncResult{
+		From: start.Format(dateLayout),
+		To:   end.Format(dateLayout),
+		Days: len(snapshots),
+	}, nil
+}
+
+// GetWeeklyActivity returns the hours coded in each of the last weeks,
+// oldest first and including the current week. Zero weeks returns 12.
+func (s *codingActivityService) GetWeeklyActivity(ctx context.Context, weeks int) ([]WeeklyActivity, error) {
+	if weeks == 0 {
+		weeks = defaultWeeks
+	}
+	if weeks < 1 || weeks > maxWeeks {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("Weeks must be between 1 and %d", maxWeeks),
+			nil,
+			errors.WithContext("weeks", weeks),
+		)
+	}
+
+	value, err := s.cached(ctx, fmt.Sprintf("weekly:%d", weeks), func() (interface{}, error) {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		currentWeek := today.AddDate(0, 0, -weekdayOffset(today))
+		firstWeek := currentWeek.AddDate(0, 0, -7*(weeks-1))
+
+		days, err := s.codingActivityRepo.ListRange(ctx, firstWeek.Format(dateLayout), today.Format(dateLayout))
+		if err != nil {
+			return nil, err
+		}
+
+		activity := make([]WeeklyActivity, weeks)
+		for i := range activity {
+			activity[i].WeekStart = firstWeek.AddDate(0, 0, 7*i).Format(dateLayout)
+		}
+
+		seconds := make([]float64, weeks)
+		for _, day := range days {
+			date, err := time.Parse(dateLayout, day.Date)
+			if err != nil {
+				continue
+			}
+			week := int(date.Sub(firstWeek).Hours()/24) / 7
+			if week >= 0 && week < weeks {
+				seconds[week] += day.TotalSeconds
+			}
+		}
+		for i := range activity {
+			activity[i].Hours = toHours(seconds[i])
+		}
+
+		return activity, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.([]WeeklyActivity), nil
+}
+
+// GetSummary returns the totals and language breakdown of the last days,
+// including today. Zero days returns 30.
+func (s *codingActivityService) GetSummary(ctx context.Context, days int) (*Summary, error) {
+	if days == 0 {
+		days = defaultDays
+	}
+	if days < 1 || days > maxDays {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("Days must be between 1 and %d", maxDays),
+			nil,
+			errors.WithContext("days", days),
+		)
+	}
+
+	value, err := s.cached(ctx, fmt.Sprintf("summary:%d", days), func() (interface{}, error) {
+		today := time.Now().UTC()
+		snapshots, err := s.codingActivityRepo.ListRange(ctx, today.AddDate(0, 0, -(days-1)).Format(dateLayout), today.Format(dateLayout))
+		if err != nil {
+			return nil, err
+		}
+
+		return summarize(snapshots, days), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*Summary), nil
+}
+
+// summarize totals snapshots covering a window of days
+func summarize(snapshots []Day, days int) *Summary {
+	var total float64
+	perLanguage := make(map[string]float64)
+	summary := &Summary{Days: days, Languages: []LanguageShare{}}
+
+	for _, day := range snapshots {
+		total += day.TotalSeconds
+		for _, language := range day.Languages {
+			perLanguage[language.Name] += language.TotalSeconds
+		}
+		if day.UpdatedAt != nil && (summary.LastSyncedAt == nil || day.UpdatedAt.After(*summary.LastSyncedAt)) {
+			summary.LastSyncedAt = day.UpdatedAt
+		}
+	}
+
+	summary.TotalHours = toHours(total)
+	summary.DailyAverageHours = toHours(total / float64(days))
+
+	for name, seconds := range perLanguage {
+		share := LanguageShare{Name: name, Hours: toHours(seconds)}
+		if total > 0 {
+			share.Percent = math.Round(seconds/total*1000) / 10
+		}
+		summary.Languages = append(summary.Languages, share)
+	}
+	sort.Slice(summary.Languages, func(i, j int) bool {
+		if summary.Languages[i].Hours != summary.Languages[j].Hours {
+			return summary.Languages[i].Hours > summary.Languages[j].Hours
+		}
+		return summary.Languages[i].Name < summary.Languages[j].Name
+	})
+
+	return summary
+}
+
+// cached returns the value stored under key for the tenant in ctx, loading
+// it when missing or expired
+func (s *codingActivityService) cached(ctx context.Context, key string, load func() (interface{}, error)) (interface{}, error) {
+	key = tenantCacheKey(ctx) + ":" + key
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cache[key] = cacheEntry{value: value, expiresAt: time.Now().Add(s.cacheTTL)}
+	s.mu.Unlock()
+
+	return value, nil
+}
+
+// invalidate drops the cached aggregates of the tenant in ctx
+func (s *codingActivityService) invalidate(ctx context.Context) {
+	prefix := tenantCacheKey(ctx) + ":"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.cache {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.cache, key)
+		}
+	}
+}
+
+func tenantCacheKey(ctx context.Context) string {
+	if tenantID := base.TenantIDFromContext(ctx); tenantID != nil {
+		return tenantID.String()
+	}
+	return "default"
+}
+
+// weekdayOffset returns the days since the last Monday
+func weekdayOffset(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+// toHours converts seconds to hours rounded to two decimals
+func toHours(seconds float64) float64 {
+	return math.Round(seconds/3600*100) / 100
+}
@@ -0,0 +1,200 @@
+package collection
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CollectionHandler struct {
+	base.BaseHandler
+	collectionService CollectionService
+}
+
+func NewCollectionHandler(collectionService CollectionService, logger *logger.Logger) *CollectionHandler {
+	return &CollectionHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		collectionService: collectionService,
+	}
+}
+
+// ListCollections retrieves the collections
+// @Summary List collections
+// @Description Retrieve a paginated list of project series, by title
+// @Tags Collections
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param search query string false "Search in titles"
+// @Success 200 {object} response.APIResponse{data=[]PublicCollection} "Collections retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /collections [get]
+func (h *CollectionHandler) ListCollections(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if search := c.Query("search"); search != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "title", Operator: base.OperatorLike, Value: search})
+	}
+
+	collections, err := h.collectionService.ListCollections(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.collectionService.CountCollections(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, collections, "Collections retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetCollectionDetail retrieves a collection with its projects
+// @Summary Get a collection
+// @Description Retrieve a project series by its slug, with its public projects in reading order
+// @Tags Collections
+// @Produce json
+// @Param slug path string true "Collection slug"
+// @Success 200 {object} response.APIResponse{data=PublicCollectionDetail} "Collection retrieved successfully"
+// @Failure 404 {object} response.APIResponse "Collection not found"
+// @Router /collections/{slug} [get]
+func (h *CollectionHandler) GetCollectionDetail(c *gin.Context) {
+	detail, err := h.collectionService.GetCollectionDetail(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, detail, "Collection retrieved successfully")
+}
+
+// GetCollection retrieves a collection
+// @Summary Get a collection by ID
+// @Description Retrieve a collection by its ID, including projects that are not public
+// @Tags Collections
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Collection ID"
+// @Success 200 {object} response.APIResponse{data=Collection} "Collection retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Collection not found"
+// @Router /admin/collections/{id} [get]
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	collectionID := c.Param("id")
+	if _, err := h.ValidateUUID(collectionID, "Collection ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.GetCollection(c.Request.Context(), collectionID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, collection, "Collection retrieved successfully")
+}
+
+// CreateCollection adds a collection
+// @Summary Create a collection
+// @Description Group projects into a series read in the given order
+// @Tags Collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param collection body CollectionCreate true "Collection Details"
+// @Success 200 {object} response.APIResponse{data=Collection} "Collection created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 409 {object} response.APIResponse "A collection with this slug already exists"
+// @Router /admin/collections [post]
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	var collectionInput CollectionCreate
+	if err := h.ValidateRequest(c, &collectionInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.CreateCollection(c.Request.Context(), &collectionInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, collection, "Collection created successfully")
+}
+
+// UpdateCollection changes a collection
+// @Summary Update a collection
+// @Description Change the slug, title or description of a collection, or reorder its projects
+// @Tags Collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Collection ID"
+// @Param collection body CollectionUpdate true "Collection Update Details"
+// @Success 200 {object} response.APIResponse{data=Collection} "Collection updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Collection not found"
+// @Failure 409 {object} response.APIResponse "A collection with this slug already exists"
+// @Router /admin/collections/{id} [put]
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	collectionID, err := h.ValidateUUID(c.Param("id"), "Collection ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var collectionInput CollectionUpdate
+	if err := h.ValidateRequest(c, &collectionInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	collectionInput.ID = collectionID
+
+	collection, err := h.collectionService.UpdateCollection(c.Request.Context(), &collectionInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, collection, "Collection updated successfully")
+}
+
+// DeleteCollection removes a collection
+// @Summary Delete a collection
+// @Description Remove a collection, leaving its projects untouched
+// @Tags Collections
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Collection ID"
+// @Success 200 {object} response.APIResponse "Collection deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Collection not found"
+// @Router /admin/collections/{id} [delete]
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	collectionID := c.Param("id")
+	if _, err := h.ValidateUUID(collectionID, "Collection ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.collectionService.DeleteCollection(c.Request.Context(), collectionID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Collection deleted successfully")
+}
@@ -0,0 +1,83 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+)
+
+type seriesLookup struct {
+	collectionRepo CollectionRepository
+	projectRepo    project.ProjectRepository
+}
+
+// NewSeriesLookup lets projects find the collections they belong to
+func NewSeriesLookup(collectionRepo CollectionRepository, projectRepo project.ProjectRepository) project.SeriesLookup {
+	return &seriesLookup{
+		collectionRepo: collectionRepo,
+		projectRepo:    projectRepo,
+	}
+}
+
+func (l *seriesLookup) SeriesOf(ctx context.Context, projectID uuid.UUID) ([]project.SeriesNav, error) {
+	collections, err := l.collectionRepo.FindByProject(ctx, projectID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var series []project.SeriesNav
+	for _, collection := range collections {
+		position := indexOf(collection.ProjectIDs, projectID)
+		if position < 0 {
+			continue
+		}
+
+		nav := project.SeriesNav{
+			CollectionID: collection.ID,
+			Slug:         collection.Slug,
+			Title:        collection.Title,
+			Position:     position + 1,
+			Total:        len(collection.ProjectIDs),
+		}
+		// Neighbours that are drafts or private are skipped, so readers are
+		// never linked to a project they cannot open
+		for i := position - 1; i >= 0 && nav.Prev == nil; i-- {
+			if nav.Prev, err = l.publicItem(ctx, collection.ProjectIDs[i]); err != nil {
+				return nil, err
+			}
+		}
+		for i := position + 1; i < len(collection.ProjectIDs) && nav.Next == nil; i++ {
+			if nav.Next, err = l.publicItem(ctx, collection.ProjectIDs[i]); err != nil {
+				return nil, err
+			}
+		}
+		series = append(series, nav)
+	}
+	return series, nil
+}
+
+// publicItem returns the project id when it exists and is public, or nil
+func (l *seriesLookup) publicItem(ctx context.Context, id uuid.UUID) (*project.SeriesItem, error) {
+	projects, err := l.projectRepo.FindByField(ctx, "id", id.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 || !projects[0].IsPublic() {
+		return nil, nil
+	}
+	return &project.SeriesItem{
+		ID:    projects[0].ID,
+		Slug:  projects[0].Slug,
+		Title: projects[0].Title,
+	}, nil
+}
+
+func indexOf(ids []uuid.UUID, id uuid.UUID) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
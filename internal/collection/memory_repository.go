@@ -0,0 +1,68 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+type memoryCollectionRepository struct {
+	*base.MemoryRepository[Collection, Collection]
+}
+
+// NewMemoryCollectionRepository creates a collection repository kept in
+// memory, for development without a database
+func NewMemoryCollectionRepository() CollectionRepository {
+	return &memoryCollectionRepository{
+		MemoryRepository: base.NewMemoryRepository([]Collection{},
+			func(c *Collection) string { return c.ID.String() },
+			func(c Collection) Collection { return c },
+			"slug", "title",
+		),
+	}
+}
+
+func (r *memoryCollectionRepository) FindByID(ctx context.Context, id string) (*Collection, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *memoryCollectionRepository) FindBySlug(ctx context.Context, slug string) (*Collection, error) {
+	return r.findOne(ctx, "slug", slug)
+}
+
+func (r *memoryCollectionRepository) FindByProject(ctx context.Context, projectID string) ([]Collection, error) {
+	count, err := r.Count(ctx, nil)
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	collections, err := r.List(ctx, base.ListOptions{Page: 1, PerPage: count})
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Collection
+	for _, collection := range collections {
+		for _, id := range collection.ProjectIDs {
+			if id.String() == projectID {
+				found = append(found, collection)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+func (r *memoryCollectionRepository) List(ctx context.Context, opts base.ListOptions) ([]Collection, error) {
+	if opts.SortBy == "" {
+		opts.SortBy, opts.SortOrder = "title", base.SortAscending
+	}
+	return r.MemoryRepository.List(ctx, opts)
+}
+
+func (r *memoryCollectionRepository) findOne(ctx context.Context, field, value string) (*Collection, error) {
+	collections, err := r.FindByField(ctx, field, value)
+	if err != nil || len(collections) == 0 {
+		return nil, err
+	}
+	return &collections[0], nil
+}
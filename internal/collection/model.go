@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Collection groups projects into an ordered series, e.g. "Building this
+// portfolio, part 1–4"
+// @Description Ordered series of projects
+// @Name Collection
+type Collection struct {
+	ID          uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Slug        string     `json:"slug" db:"slug" example:"building-this-portfolio"`
+	Title       string     `json:"title" db:"title" example:"Building this portfolio"`
+	Description string     `json:"description" db:"description" example:"How the site and its backend came together"`
+	// ProjectIDs are the projects of the series in reading order
+	ProjectIDs []uuid.UUID `json:"project_ids" db:"project_ids"`
+	CreatedAt  *time.Time  `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time  `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// CollectionCreate is the input for creating a collection
+// @Name CollectionCreate
+type CollectionCreate struct {
+	Slug        string      `json:"slug" validate:"required,max=100" example:"building-this-portfolio"`
+	Title       string      `json:"title" validate:"required,max=255" example:"Building this portfolio"`
+	Description string      `json:"description" validate:"max=2000" example:"How the site and its backend came together"`
+	ProjectIDs  []uuid.UUID `json:"project_ids" validate:"max=100"`
+}
+
+// CollectionUpdate is the input for editing a collection
+// @Name CollectionUpdate
+type CollectionUpdate struct {
+	ID          uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug        string    `json:"slug" validate:"max=100" example:"building-this-portfolio"`
+	Title       string    `json:"title" validate:"max=255" example:"Building this portfolio"`
+	Description *string   `json:"description" validate:"omitempty,max=2000" example:"How the site and its backend came together"`
+	// ProjectIDs replaces the projects of the series when present
+	ProjectIDs []uuid.UUID `json:"project_ids" validate:"max=100"`
+}
+
+// CollectionDetail is a collection with its public projects
+// @Description Collection with its public projects in reading order
+// @Name CollectionDetail
+type CollectionDetail struct {
+	Collection
+	Items []Item `json:"items"`
+}
+
+// Item is a project of a collection
+// @Description Project of a collection
+// @Name CollectionItem
+type Item struct {
+	// Position is the 1-based place of the project in the series
+	Position int       `json:"position" example:"1"`
+	ID       uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug     string    `json:"slug" example:"portfolio-website"`
+	Title    string    `json:"title" example:"Portfolio Website"`
+	Subtitle string    `json:"subtitle,omitempty" example:"Personal portfolio and blog"`
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+)
+
+// Publisher forwards domain events to the next publisher and drops deleted
+// projects from the collections of the tenant that deleted them
+type Publisher struct {
+	next           events.Publisher
+	collectionRepo CollectionRepository
+}
+
+func NewPublisher(next events.Publisher, collectionRepo CollectionRepository) *Publisher {
+	return &Publisher{
+		next:           next,
+		collectionRepo: collectionRepo,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event events.Event) {
+	if event.Type == events.ProjectDeleted {
+		if projectID, err := uuid.Parse(event.EntityID); err == nil {
+			if err := removeProject(ctx, p.collectionRepo, projectID); err != nil {
+				fmt.Printf("Failed to remove deleted project from collections: %v\n", err)
+			}
+		}
+	}
+
+	p.next.Publish(ctx, event)
+}
+
+// removeProject drops a project from every collection holding it
+func removeProject(ctx context.Context, collectionRepo CollectionRepository, projectID uuid.UUID) error {
+	collections, err := collectionRepo.FindByProject(ctx, projectID.String())
+	if err != nil {
+		return err
+	}
+
+	for i := range collections {
+		collection := &collections[i]
+		kept := make([]uuid.UUID, 0, len(collection.ProjectIDs))
+		for _, id := range collection.ProjectIDs {
+			if id != projectID {
+				kept = append(kept, id)
+			}
+		}
+		collection.ProjectIDs = kept
+
+		now := time.Now().UTC()
+		collection.UpdatedAt = &now
+		if _, err := collectionRepo.Update(ctx, collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
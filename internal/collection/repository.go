@@ -0,0 +1,176 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *Collection) (*Collection, error)
+	Update(ctx context.Context, collection *Collection) (*Collection, error)
+	Delete(ctx context.Context, id string) error
+	// FindByID returns the collection with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Collection, error)
+	// FindBySlug returns the collection with the given slug, or nil if none
+	FindBySlug(ctx context.Context, slug string) (*Collection, error)
+	// FindByProject returns the collections containing a project
+	FindByProject(ctx context.Context, projectID string) ([]Collection, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Collection, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type collectionRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewCollectionRepository(supabaseClient *supabase.SupabaseClient) CollectionRepository {
+	return &collectionRepository{
+		supabaseClient: supabaseClient,
+		table:          "collection",
+	}
+}
+
+func (r *collectionRepository) Create(ctx context.Context, collection *Collection) (*Collection, error) {
+	collection.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(collection, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create collection")
+	}
+	return collection, nil
+}
+
+func (r *collectionRepository) Update(ctx context.Context, collection *Collection) (*Collection, error) {
+	collection.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(collection, "minimal", "").
+		Eq("id", collection.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update collection")
+	}
+	return collection, nil
+}
+
+func (r *collectionRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete collection")
+	}
+	return nil
+}
+
+func (r *collectionRepository) FindByID(ctx context.Context, id string) (*Collection, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *collectionRepository) FindBySlug(ctx context.Context, slug string) (*Collection, error) {
+	return r.findOne(ctx, "slug", slug)
+}
+
+func (r *collectionRepository) FindByProject(ctx context.Context, projectID string) ([]Collection, error) {
+	var collections []Collection
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Contains("project_ids", []string{projectID})
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("title", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&collections)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find collections of project")
+	}
+	return collections, nil
+}
+
+func (r *collectionRepository) List(ctx context.Context, opts base.ListOptions) ([]Collection, error) {
+	var collections []Collection
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, by title by default
+	sortBy, ascending := "title", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&collections)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list collections")
+	}
+
+	return collections, nil
+}
+
+func (r *collectionRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count collections")
+	}
+
+	return int(count), nil
+}
+
+func (r *collectionRepository) findOne(ctx context.Context, field, value string) (*Collection, error) {
+	var collections []Collection
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&collections)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find collection")
+	}
+
+	if len(collections) == 0 {
+		return nil, nil
+	}
+	return &collections[0], nil
+}
@@ -0,0 +1,243 @@
+package collection
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// slugPattern is what a collection slug may look like, e.g.
+// "building-this-portfolio"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type CollectionService interface {
+	CreateCollection(ctx context.Context, collectionCreate *CollectionCreate) (*Collection, error)
+	UpdateCollection(ctx context.Context, collectionUpdate *CollectionUpdate) (*Collection, error)
+	DeleteCollection(ctx context.Context, id string) error
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	// GetCollectionDetail returns the collection with the given slug along
+	// with its public projects
+	GetCollectionDetail(ctx context.Context, slug string) (*CollectionDetail, error)
+	ListCollections(ctx context.Context, opts base.ListOptions) ([]Collection, error)
+	CountCollections(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type collectionService struct {
+	collectionRepo CollectionRepository
+	projectRepo    project.ProjectRepository
+}
+
+func NewCollectionService(collectionRepo CollectionRepository, projectRepo project.ProjectRepository) CollectionService {
+	return &collectionService{
+		collectionRepo: collectionRepo,
+		projectRepo:    projectRepo,
+	}
+}
+
+func (s *collectionService) CreateCollection(ctx context.Context, collectionCreate *CollectionCreate) (*Collection, error) {
+	// Validate input
+	if err := validator.ValidateModel(collectionCreate); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.checkSlug(ctx, collectionCreate.Slug, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjects(ctx, collectionCreate.ProjectIDs); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	collection := &Collection{
+		ID:          uuid.New(),
+		Slug:        slug,
+		Title:       strings.TrimSpace(collectionCreate.Title),
+		Description: strings.TrimSpace(collectionCreate.Description),
+		ProjectIDs:  collectionCreate.ProjectIDs,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	if collection.ProjectIDs == nil {
+		collection.ProjectIDs = []uuid.UUID{}
+	}
+
+	return s.collectionRepo.Create(ctx, collection)
+}
+
+func (s *collectionService) UpdateCollection(ctx context.Context, collectionUpdate *CollectionUpdate) (*Collection, error) {
+	// Validate input
+	if err := validator.ValidateModel(collectionUpdate); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.GetCollection(ctx, collectionUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(collectionUpdate.Slug) != "" {
+		if collection.Slug, err = s.checkSlug(ctx, collectionUpdate.Slug, collection.ID); err != nil {
+			return nil, err
+		}
+	}
+	if title := strings.TrimSpace(collectionUpdate.Title); title != "" {
+		collection.Title = title
+	}
+	if collectionUpdate.Description != nil {
+		collection.Description = strings.TrimSpace(*collectionUpdate.Description)
+	}
+	if collectionUpdate.ProjectIDs != nil {
+		if err := s.checkProjects(ctx, collectionUpdate.ProjectIDs); err != nil {
+			return nil, err
+		}
+		collection.ProjectIDs = collectionUpdate.ProjectIDs
+	}
+
+	now := time.Now().UTC()
+	collection.UpdatedAt = &now
+
+	return s.collectionRepo.Update(ctx, collection)
+}
+
+func (s *collectionService) DeleteCollection(ctx context.Context, id string) error {
+	if _, err := s.GetCollection(ctx, id); err != nil {
+		return err
+	}
+
+	return s.collectionRepo.Delete(ctx, id)
+}
+
+func (s *collectionService) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	collection, err := s.collectionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Collection not found",
+			nil,
+			errors.WithContext("collection_id", id),
+		)
+	}
+	return collection, nil
+}
+
+func (s *collectionService) GetCollectionDetail(ctx context.Context, slug string) (*CollectionDetail, error) {
+	collection, err := s.collectionRepo.FindBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Collection not found",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+
+	// Positions keep counting projects that are not public, so they match
+	// the place shown on every project of the series
+	detail := &CollectionDetail{Collection: *collection, Items: []Item{}}
+	for i, id := range collection.ProjectIDs {
+		projects, err := s.projectRepo.FindByField(ctx, "id", id.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(projects) == 0 || !projects[0].IsPublic() {
+			continue
+		}
+		detail.Items = append(detail.Items, Item{
+			Position: i + 1,
+			ID:       projects[0].ID,
+			Slug:     projects[0].Slug,
+			Title:    projects[0].Title,
+			Subtitle: projects[0].Subtitle,
+		})
+	}
+	return detail, nil
+}
+
+func (s *collectionService) ListCollections(ctx context.Context, opts base.ListOptions) ([]Collection, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.collectionRepo.List(ctx, opts)
+}
+
+func (s *collectionService) CountCollections(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.collectionRepo.Count(ctx, filters)
+}
+
+// checkSlug normalizes a slug and makes sure no other collection than self
+// uses it
+func (s *collectionService) checkSlug(ctx context.Context, slug string, self uuid.UUID) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if !slugPattern.MatchString(slug) {
+		return "", errors.New(
+			errors.ErrValidation,
+			"Slug may only contain lowercase letters, digits and single dashes",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+
+	existing, err := s.collectionRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && existing.ID != self {
+		return "", errors.New(
+			errors.ErrConflict,
+			"A collection with this slug already exists",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+	return slug, nil
+}
+
+// checkProjects makes sure every project of a series exists and appears
+// only once
+func (s *collectionService) checkProjects(ctx context.Context, ids []uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return errors.New(
+				errors.ErrValidation,
+				"A project may only appear once in a collection",
+				nil,
+				errors.WithContext("project_id", id),
+			)
+		}
+		seen[id] = true
+
+		projects, err := s.projectRepo.FindByField(ctx, "id", id.String())
+		if err != nil {
+			return err
+		}
+		if len(projects) == 0 {
+			return errors.New(
+				errors.ErrValidation,
+				"Project not found",
+				nil,
+				errors.WithContext("project_id", id),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package collection
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublicCollection is a collection as visitors see it. Projects that are
+// not public are only listed through CollectionDetail, which skips them.
+// @Description Collection as shown publicly
+// @Name PublicCollection
+type PublicCollection struct {
+	ID          uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug        string     `json:"slug" example:"building-this-portfolio"`
+	Title       string     `json:"title" example:"Building this portfolio"`
+	Description string     `json:"description" example:"How the site and its backend came together"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// PublicCollectionDetail is a collection with its public projects as
+// visitors see it
+// @Description Collection with its public projects, as shown publicly
+// @Name PublicCollectionDetail
+type PublicCollectionDetail struct {
+	PublicCollection
+	Items []Item `json:"items"`
+}
+
+// Public maps a collection to what visitors see
+func (c Collection) Public() PublicCollection {
+	return PublicCollection{
+		ID:          c.ID,
+		Slug:        c.Slug,
+		Title:       c.Title,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// PublicView and AdminView render collections in responses, see
+// response.View
+func (c Collection) PublicView() interface{} {
+	return c.Public()
+}
+
+func (c Collection) AdminView() interface{} {
+	return c
+}
+
+// PublicView and AdminView are implemented again for details, which would
+// otherwise be rendered as the collection they embed
+func (d CollectionDetail) PublicView() interface{} {
+	return PublicCollectionDetail{
+		PublicCollection: d.Collection.Public(),
+		Items:            d.Items,
+	}
+}
+
+func (d CollectionDetail) AdminView() interface{} {
+	return d
+}
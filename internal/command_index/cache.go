@@ -0,0 +1,70 @@
+package command_index
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+// Cache keeps the generated index of every tenant until their content
+// changes
+type Cache struct {
+	mu      sync.Mutex
+	indexes map[string]*Index
+	// versions count the invalidations of every tenant, so an index
+	// generated while content changed is not stored
+	versions map[string]int
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		indexes:  make(map[string]*Index),
+		versions: make(map[string]int),
+	}
+}
+
+// Get returns the index of the tenant in ctx, or nil when it is missing or
+// older than maxAge, along with the version to store a new index under
+func (c *Cache) Get(ctx context.Context, maxAge time.Duration) (*Index, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	index, ok := c.indexes[key]
+	if !ok || time.Since(index.GeneratedAt) >= maxAge {
+		return nil, c.versions[key]
+	}
+	return index, c.versions[key]
+}
+
+// Set stores the index of the tenant in ctx unless it was invalidated since
+// version was read
+func (c *Cache) Set(ctx context.Context, index *Index, version int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	if c.versions[key] == version {
+		c.indexes[key] = index
+	}
+}
+
+// Invalidate drops the index of the tenant in ctx so the next request
+// generates it again
+func (c *Cache) Invalidate(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	delete(c.indexes, key)
+	c.versions[key]++
+}
+
+func tenantCacheKey(ctx context.Context) string {
+	if tenantID := base.TenantIDFromContext(ctx); tenantID != nil {
+		return tenantID.String()
+	}
+	return "default"
+}
@@ -0,0 +1,51 @@
+package command_index
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CommandIndexHandler struct {
+	base.BaseHandler
+	commandIndexService CommandIndexService
+}
+
+func NewCommandIndexHandler(commandIndexService CommandIndexService, logger *logger.Logger) *CommandIndexHandler {
+	return &CommandIndexHandler{
+		BaseHandler:         *base.NewBaseHandler(logger),
+		commandIndexService: commandIndexService,
+	}
+}
+
+// GetCommandIndex retrieves the index of the command palette
+// @Summary Get the command palette index
+// @Description Retrieve the titles, slugs, types and routes of every public project, experience, tech stack and published page. The index is generated again after content changes. Send the ETag back in If-None-Match to get 304 Not Modified while it is unchanged.
+// @Tags Search
+// @Produce json
+// @Param If-None-Match header string false "ETag of the index the client has"
+// @Success 200 {object} response.APIResponse{data=Index} "Command index retrieved successfully"
+// @Success 304 "The index is unchanged"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /command-index [get]
+func (h *CommandIndexHandler) GetCommandIndex(c *gin.Context) {
+	index, err := h.commandIndexService.GetIndex(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Clients keep the index but check it is current before using it
+	etag := `"` + index.ETag + `"`
+	c.Header("Cache-Control", "public, no-cache")
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	h.HandleSuccess(c, index, "Command index retrieved successfully")
+}
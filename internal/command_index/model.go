@@ -0,0 +1,46 @@
+package command_index
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntryType is the kind of content an index entry points to
+// @Description Kind of content an index entry points to
+// @Name CommandIndexEntryType
+type EntryType string
+
+const (
+	EntryProject    EntryType = "project"
+	EntryExperience EntryType = "experience"
+	EntryTechStack  EntryType = "tech_stack"
+	EntryPage       EntryType = "page"
+)
+
+// Entry is one item of the command palette
+// @Description Public content listed in the command palette
+// @Name CommandIndexEntry
+type Entry struct {
+	Type  EntryType `json:"type" example:"project"`
+	ID    uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title string    `json:"title" example:"Portfolio Website"`
+	// Subtitle is the category of projects and tech stacks and the company
+	// of experiences
+	Subtitle string `json:"subtitle,omitempty" example:"Web Development"`
+	Slug     string `json:"slug,omitempty" example:"portfolio-website"`
+	// Route is the path of the content on the site
+	Route string `json:"route" example:"/projects/portfolio-website"`
+}
+
+// Index lists every public project, experience, tech stack and published
+// page of a tenant
+// @Description Compact index of the public content for the command palette
+// @Name CommandIndex
+type Index struct {
+	// ETag identifies the content of the index, changing whenever an entry
+	// does
+	ETag        string    `json:"-"`
+	GeneratedAt time.Time `json:"generated_at" example:"2026-01-15T10:00:00Z"`
+	Entries     []Entry   `json:"entries"`
+}
@@ -0,0 +1,45 @@
+package command_index
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+)
+
+// invalidatingEvents are the domain events that change the index
+var invalidatingEvents = map[events.EventType]bool{
+	events.ProjectCreated:    true,
+	events.ProjectUpdated:    true,
+	events.ProjectDeleted:    true,
+	events.ExperienceCreated: true,
+	events.ExperienceUpdated: true,
+	events.ExperienceDeleted: true,
+	events.TechStackCreated:  true,
+	events.TechStackUpdated:  true,
+	events.TechStackDeleted:  true,
+	events.PageCreated:       true,
+	events.PageUpdated:       true,
+	events.PageDeleted:       true,
+}
+
+// Publisher forwards domain events to the next publisher and drops the
+// index of the tenant whose content changed
+type Publisher struct {
+	next  events.Publisher
+	cache *Cache
+}
+
+func NewPublisher(next events.Publisher, cache *Cache) *Publisher {
+	return &Publisher{
+		next:  next,
+		cache: cache,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event events.Event) {
+	if invalidatingEvents[event.Type] {
+		p.cache.Invalidate(ctx)
+	}
+
+	p.next.Publish(ctx, event)
+}
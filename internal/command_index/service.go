@@ -0,0 +1,198 @@
+package command_index
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// batchSize is how many items of a kind are read at a time
+	batchSize = 100
+
+	// maxIndexAge is how long an index is served without content change
+	// events. It catches changes that publish none, such as a project being
+	// hidden from the public.
+	maxIndexAge = 15 * time.Minute
+
+	slugPlaceholder = "{slug}"
+	idPlaceholder   = "{id}"
+)
+
+// Paths are the routes of the content on the site; {slug} or {id} is
+// replaced by the slug or ID of the entry
+type Paths struct {
+	Project    string
+	Page       string
+	Experience string
+	TechStack  string
+}
+
+type CommandIndexService interface {
+	// GetIndex returns the index of the tenant in ctx, generating it again
+	// after its content changed
+	GetIndex(ctx context.Context) (*Index, error)
+}
+
+type commandIndexService struct {
+	cache             *Cache
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	techStackService  tech_stack.TechStackService
+	pageService       page.PageService
+	paths             Paths
+}
+
+func NewCommandIndexService(cache *Cache, projectService project.ProjectService, experienceService experience.ExperienceService, techStackService tech_stack.TechStackService, pageService page.PageService, paths Paths) CommandIndexService {
+	return &commandIndexService{
+		cache:             cache,
+		projectService:    projectService,
+		experienceService: experienceService,
+		techStackService:  techStackService,
+		pageService:       pageService,
+		paths: Paths{
+			Project:    normalizePath(paths.Project, "/projects/"+slugPlaceholder),
+			Page:       normalizePath(paths.Page, "/"+slugPlaceholder),
+			Experience: normalizePath(paths.Experience, "/experience#"+idPlaceholder),
+			TechStack:  normalizePath(paths.TechStack, "/tech-stack#"+idPlaceholder),
+		},
+	}
+}
+
+func (s *commandIndexService) GetIndex(ctx context.Context) (*Index, error) {
+	index, version := s.cache.Get(ctx, maxIndexAge)
+	if index != nil {
+		return index, nil
+	}
+
+	index, err := s.generate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(ctx, index, version)
+	return index, nil
+}
+
+// generate lists the public content of the tenant in ctx
+func (s *commandIndexService) generate(ctx context.Context) (*Index, error) {
+	entries := []Entry{}
+
+	publicProjects := []base.FilterOption{{Field: "visibility", Operator: base.OperatorEqual, Value: project.VisibilityPublic}}
+	for pageNumber := 1; ; pageNumber++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize, Filters: publicProjects})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			entries = append(entries, Entry{
+				Type:     EntryProject,
+				ID:       p.ID,
+				Title:    p.Title,
+				Subtitle: string(p.Category),
+				Slug:     p.Slug,
+				Route:    route(s.paths.Project, slugPlaceholder, p.Slug),
+			})
+		}
+		if len(projects) < batchSize {
+			break
+		}
+	}
+
+	for pageNumber := 1; ; pageNumber++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range experiences {
+			entries = append(entries, Entry{
+				Type:     EntryExperience,
+				ID:       e.ID,
+				Title:    e.Role,
+				Subtitle: e.Company,
+				Route:    route(s.paths.Experience, idPlaceholder, e.ID.String()),
+			})
+		}
+		if len(experiences) < batchSize {
+			break
+		}
+	}
+
+	for pageNumber := 1; ; pageNumber++ {
+		techStacks, err := s.techStackService.ListTechStacks(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range techStacks {
+			entries = append(entries, Entry{
+				Type:     EntryTechStack,
+				ID:       t.ID,
+				Title:    t.Name,
+				Subtitle: string(t.Category),
+				Route:    route(s.paths.TechStack, idPlaceholder, t.ID.String()),
+			})
+		}
+		if len(techStacks) < batchSize {
+			break
+		}
+	}
+
+	publishedPages := []base.FilterOption{{Field: "published", Operator: base.OperatorEqual, Value: true}}
+	for pageNumber := 1; ; pageNumber++ {
+		pages, err := s.pageService.ListPages(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize, Filters: publishedPages})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pages {
+			entries = append(entries, Entry{
+				Type:  EntryPage,
+				ID:    p.ID,
+				Title: p.Title,
+				Slug:  p.Slug,
+				Route: route(s.paths.Page, slugPlaceholder, p.Slug),
+			})
+		}
+		if len(pages) < batchSize {
+			break
+		}
+	}
+
+	// The ETag covers the entries only, so regenerating unchanged content
+	// keeps clients' copies valid
+	body, err := json.Marshal(entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "Failed to encode command index")
+	}
+	sum := sha256.Sum256(body)
+
+	return &Index{
+		ETag:        hex.EncodeToString(sum[:16]),
+		GeneratedAt: time.Now().UTC(),
+		Entries:     entries,
+	}, nil
+}
+
+// route fills the placeholder of path with value
+func route(path, placeholder, value string) string {
+	return strings.ReplaceAll(path, placeholder, url.PathEscape(value))
+}
+
+// normalizePath falls back to fallback for paths without a placeholder and
+// gives paths a leading slash
+func normalizePath(path, fallback string) string {
+	if !strings.Contains(path, slugPlaceholder) && !strings.Contains(path, idPlaceholder) {
+		path = fallback
+	}
+	return "/" + strings.TrimLeft(path, "/")
+}
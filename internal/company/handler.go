@@ -0,0 +1,272 @@
+package company
+
+import (
+	"mime/multipart"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CompanyHandler struct {
+	base.BaseHandler
+	companyService CompanyService
+}
+
+func NewCompanyHandler(companyService CompanyService, logger *logger.Logger) *CompanyHandler {
+	return &CompanyHandler{
+		BaseHandler:    *base.NewBaseHandler(logger),
+		companyService: companyService,
+	}
+}
+
+// CreateCompany creates a new company
+// @Summary Create a new company
+// @Description Create a new company with optional logo upload
+// @Tags Companies
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param logo formData file false "Company Logo"
+// @Param logo_light formData file false "Company Logo for light backgrounds, generated from the logo when omitted"
+// @Param logo_dark formData file false "Company Logo for dark backgrounds, generated from the logo when omitted"
+// @Param payload formData string true "Company Details in JSON format (See CompanyCreate Model)"
+// @Success 200 {object} response.APIResponse{data=Company} "Company created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 409 {object} response.APIResponse "Company already exists"
+// @Router /companies [post]
+func (h *CompanyHandler) CreateCompany(c *gin.Context) {
+	var companyInput CompanyCreate
+
+	// Parse multipart form data
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrBadRequest,
+			"Failed to parse multipart form",
+			err,
+		))
+		return
+	}
+
+	// Extract and validate form fields
+	if err := utils.ExtractFormDataPayload(c, &companyInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Get logo file
+	logoFileHeaders, err := utils.ExtractFileHeaders(c, "logo", 2)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if len(logoFileHeaders) > 0 {
+		companyInput.Logo = logoFileHeaders[0]
+	}
+
+	// Get logo variant files
+	if companyInput.LogoLight, companyInput.LogoDark, err = extractLogoVariants(c); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	company, err := h.companyService.CreateCompany(c.Request.Context(), &companyInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, company, "Company created successfully")
+}
+
+// GetCompanyByID retrieves a specific company
+// @Summary Get a company by ID
+// @Description Retrieve a specific company using its unique identifier
+// @Tags Companies
+// @Produce json
+// @Param id path string true "Company ID"
+// @Success 200 {object} response.APIResponse{data=PublicCompany} "Company retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Company not found"
+// @Router /companies/{id} [get]
+func (h *CompanyHandler) GetCompanyByID(c *gin.Context) {
+	companyID := c.Param("id")
+	if _, err := h.ValidateUUID(companyID, "Company ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	company, err := h.companyService.GetCompanyByID(c.Request.Context(), companyID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, company, "Company retrieved successfully")
+}
+
+// UpdateCompany updates an existing company
+// @Summary Update a company
+// @Description Update an existing company with new details and optional logo. The logo is shared by every experience at the company.
+// @Tags Companies
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param logo formData file false "Company Logo"
+// @Param logo_light formData file false "Company Logo for light backgrounds, generated from the logo when omitted"
+// @Param logo_dark formData file false "Company Logo for dark backgrounds, generated from the logo when omitted"
+// @Param payload formData string true "Company Update Details in JSON format (See CompanyUpdate Model)"
+// @Success 200 {object} response.APIResponse{data=Company} "Company updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Company not found"
+// @Failure 409 {object} response.APIResponse "Company already exists"
+// @Router /companies/{id} [put]
+func (h *CompanyHandler) UpdateCompany(c *gin.Context) {
+	var companyInput CompanyUpdate
+
+	companyID, err := h.ValidateUUID(c.Param("id"), "Company ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Parse multipart form data
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrBadRequest,
+			"Failed to parse multipart form",
+			err,
+		))
+		return
+	}
+
+	// Extract and validate form fields
+	if err := utils.ExtractFormDataPayload(c, &companyInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Set the ID from path
+	companyInput.ID = companyID
+
+	// Get logo file
+	logoFileHeaders, err := utils.ExtractFileHeaders(c, "logo", 2)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if len(logoFileHeaders) > 0 {
+		companyInput.Logo = logoFileHeaders[0]
+	}
+
+	// Get logo variant files
+	if companyInput.LogoLight, companyInput.LogoDark, err = extractLogoVariants(c); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	company, err := h.companyService.UpdateCompany(c.Request.Context(), &companyInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, company, "Company updated successfully")
+}
+
+// DeleteCompany deletes an existing company
+// @Summary Delete a company
+// @Description Delete a company. Its experiences are kept and lose the company reference.
+// @Tags Companies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {object} response.APIResponse "Company deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Company not found"
+// @Router /companies/{id} [delete]
+func (h *CompanyHandler) DeleteCompany(c *gin.Context) {
+	companyID := c.Param("id")
+	if _, err := h.ValidateUUID(companyID, "Company ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.companyService.DeleteCompany(c.Request.Context(), companyID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Company deleted successfully")
+}
+
+// ListCompanies retrieves a paginated list of companies
+// @Summary List companies
+// @Description Retrieve a paginated list of companies with optional filtering
+// @Tags Companies
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param industry query string false "Filter by industry"
+// @Success 200 {object} response.APIResponse{data=[]PublicCompany} "Companies retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /companies [get]
+func (h *CompanyHandler) ListCompanies(c *gin.Context) {
+	// Parse pagination and filtering options
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// Optional industry filter
+	if industry := c.Query("industry"); industry != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "industry",
+			Operator: base.OperatorEqual,
+			Value:    industry,
+		})
+	}
+
+	companies, err := h.companyService.ListCompanies(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total companies for pagination
+	total, err := h.companyService.CountCompanies(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, companies, "Companies retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// extractLogoVariants returns the uploaded logo variants for light and dark
+// backgrounds, if any
+func extractLogoVariants(c *gin.Context) (*multipart.FileHeader, *multipart.FileHeader, error) {
+	var variants [2]*multipart.FileHeader
+	for i, field := range []string{"logo_light", "logo_dark"} {
+		fileHeaders, err := utils.ExtractFileHeaders(c, field, 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(fileHeaders) > 0 {
+			variants[i] = fileHeaders[0]
+		}
+	}
+	return variants[0], variants[1], nil
+}
@@ -0,0 +1,99 @@
+package company
+
+import (
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+)
+
+// Company represents an organization referenced by experiences
+// @Description Company information shared by every experience at that company
+// @Name Company
+type Company struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Name     string     `json:"name" db:"name" validate:"required" example:"Tech Innovations Inc."`
+
+	// Slug is the normalized name companies are deduplicated by
+	Slug     string `json:"slug" db:"slug" example:"tech-innovations-inc"`
+	LogoUrl  string `json:"logo_url" db:"logo_url" example:"https://example.com/company-logo.png"`
+	Website  string `json:"website" db:"website" example:"https://techinnovations.example.com"`
+	Industry string `json:"industry" db:"industry" example:"Software"`
+
+	// LogoUrlLight and LogoUrlDark are the logo variants for light and dark
+	// backgrounds, and LogoPreference the variant frontends should show
+	LogoUrlLight   string               `json:"logo_url_light,omitempty" db:"logo_url_light" example:"https://example.com/company-logo.png"`
+	LogoUrlDark    string               `json:"logo_url_dark,omitempty" db:"logo_url_dark" example:"https://example.com/company-logo-dark.png"`
+	LogoPreference asset.LogoPreference `json:"logo_preference,omitempty" db:"logo_preference" enums:"auto,light,dark" example:"auto"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// CompanyCreate represents the input for creating a new company
+// @Name CompanyCreate
+type CompanyCreate struct {
+	Name     string                `json:"name" validate:"required,max=255" example:"Tech Innovations Inc."`
+	Website  string                `json:"website" example:"https://techinnovations.example.com"`
+	Industry string                `json:"industry" validate:"max=100" example:"Software"`
+	Logo     *multipart.FileHeader `json:"logo" swaggerignore:"true"`
+
+	// LogoLight and LogoDark replace the logo variants generated from the logo
+	LogoLight      *multipart.FileHeader `json:"logo_light" swaggerignore:"true"`
+	LogoDark       *multipart.FileHeader `json:"logo_dark" swaggerignore:"true"`
+	LogoPreference string                `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"auto"`
+}
+
+// CompanyUpdate represents the input for updating an existing company
+// @Name CompanyUpdate
+type CompanyUpdate struct {
+	ID       uuid.UUID             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string                `json:"name" validate:"max=255" example:"Tech Innovations Inc."`
+	Website  string                `json:"website" example:"https://techinnovations.example.com"`
+	Industry string                `json:"industry" validate:"max=100" example:"Software"`
+	Logo     *multipart.FileHeader `json:"logo" swaggerignore:"true"`
+
+	// LogoLight and LogoDark replace the logo variants generated from the logo
+	LogoLight      *multipart.FileHeader `json:"logo_light" swaggerignore:"true"`
+	LogoDark       *multipart.FileHeader `json:"logo_dark" swaggerignore:"true"`
+	LogoPreference string                `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"dark"`
+}
+
+// ToCompany converts CompanyCreate to Company
+func (cc *CompanyCreate) ToCompany() Company {
+	name := strings.TrimSpace(cc.Name)
+	return Company{
+		Name:     name,
+		Slug:     Slugify(name),
+		Website:  strings.TrimSpace(cc.Website),
+		Industry: strings.TrimSpace(cc.Industry),
+	}
+}
+
+// Slugify normalizes a company name so that spelling variants such as
+// "Acme, Inc." and "acme inc" resolve to the same company
+func Slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		// Names without ASCII letters or digits are matched as typed
+		return strings.ToLower(strings.TrimSpace(name))
+	}
+	return slug
+}
@@ -0,0 +1,149 @@
+package company
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type CompanyRepository interface {
+	Create(ctx context.Context, company *Company) (*Company, error)
+	Update(ctx context.Context, company *Company) (*Company, error)
+	Delete(ctx context.Context, id string) error
+	FindByField(ctx context.Context, field string, value interface{}) ([]Company, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Company, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type companyRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewCompanyRepository(supabaseClient *supabase.SupabaseClient) CompanyRepository {
+	return &companyRepository{
+		supabaseClient: supabaseClient,
+		table:          "company",
+	}
+}
+
+func (r *companyRepository) Create(ctx context.Context, company *Company) (*Company, error) {
+	company.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(company, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create company")
+	}
+	return company, nil
+}
+
+func (r *companyRepository) Update(ctx context.Context, company *Company) (*Company, error) {
+	company.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(company, "minimal", "").
+		Eq("id", company.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update company")
+	}
+	return company, nil
+}
+
+func (r *companyRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete company")
+	}
+	return nil
+}
+
+func (r *companyRepository) FindByField(ctx context.Context, field string, value interface{}) ([]Company, error) {
+	var companies []Company
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&companies)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find companies by field")
+	}
+	return companies, nil
+}
+
+func (r *companyRepository) List(ctx context.Context, opts base.ListOptions) ([]Company, error) {
+	var companies []Company
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("name.ilike.%%%s%%", opts.Search),
+			fmt.Sprintf("industry.ilike.%%%s%%", opts.Search),
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&companies)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list companies")
+	}
+
+	return companies, nil
+}
+
+func (r *companyRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count companies")
+	}
+
+	return int(count), nil
+}
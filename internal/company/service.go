@@ -0,0 +1,335 @@
+package company
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+type CompanyService interface {
+	CreateCompany(ctx context.Context, companyCreate *CompanyCreate) (*Company, error)
+	GetCompanyByID(ctx context.Context, id string) (*Company, error)
+	UpdateCompany(ctx context.Context, companyUpdate *CompanyUpdate) (*Company, error)
+	DeleteCompany(ctx context.Context, id string) error
+	ListCompanies(ctx context.Context, opts base.ListOptions) ([]Company, error)
+	CountCompanies(ctx context.Context, filters []base.FilterOption) (int, error)
+	ResolveCompany(ctx context.Context, name string) (*Company, error)
+	SetCompanyLogo(ctx context.Context, id string, file *multipart.FileHeader) (*Company, error)
+}
+
+type companyService struct {
+	companyRepo CompanyRepository
+	assets      asset.AssetService
+}
+
+func NewCompanyService(companyRepo CompanyRepository, assets asset.AssetService) CompanyService {
+	return &companyService{
+		companyRepo: companyRepo,
+		assets:      assets,
+	}
+}
+
+func (s *companyService) CreateCompany(ctx context.Context, companyCreate *CompanyCreate) (*Company, error) {
+	// Validate input
+	if err := validator.ValidateModel(companyCreate); err != nil {
+		return nil, err
+	}
+
+	company := companyCreate.ToCompany()
+
+	existing, err := s.findBySlug(ctx, company.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Company already exists",
+			nil,
+			errors.WithContext("company_id", existing.ID),
+		)
+	}
+
+	if company.LogoPreference, err = asset.ParseLogoPreference(companyCreate.LogoPreference, asset.LogoAuto); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	company.ID = uuid.New()
+	company.CreatedAt = &now
+	company.UpdatedAt = &now
+
+	// Upload logo if provided
+	if err := s.setLogo(ctx, &company, companyCreate.Logo, companyCreate.LogoLight, companyCreate.LogoDark); err != nil {
+		return nil, err
+	}
+
+	createdCompany, err := s.companyRepo.Create(ctx, &company)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create company",
+			errors.WithContext("company_name", company.Name),
+		)
+	}
+
+	return createdCompany, nil
+}
+
+func (s *companyService) GetCompanyByID(ctx context.Context, id string) (*Company, error) {
+	if id == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Company ID cannot be empty",
+			nil,
+		)
+	}
+
+	companies, err := s.companyRepo.FindByField(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(companies) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Company not found",
+			nil,
+			errors.WithContext("company_id", id),
+		)
+	}
+
+	return &companies[0], nil
+}
+
+func (s *companyService) UpdateCompany(ctx context.Context, companyUpdate *CompanyUpdate) (*Company, error) {
+	// Validate input
+	if err := validator.ValidateModel(companyUpdate); err != nil {
+		return nil, err
+	}
+
+	company, err := s.GetCompanyByID(ctx, companyUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Renaming must not merge two companies silently
+	if name := strings.TrimSpace(companyUpdate.Name); name != "" && name != company.Name {
+		slug := Slugify(name)
+		if slug != company.Slug {
+			existing, err := s.findBySlug(ctx, slug)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return nil, errors.New(
+					errors.ErrConflict,
+					"Company already exists",
+					nil,
+					errors.WithContext("company_id", existing.ID),
+				)
+			}
+		}
+		company.Name = name
+		company.Slug = slug
+	}
+	if website := strings.TrimSpace(companyUpdate.Website); website != "" {
+		company.Website = website
+	}
+	if industry := strings.TrimSpace(companyUpdate.Industry); industry != "" {
+		company.Industry = industry
+	}
+
+	if company.LogoPreference, err = asset.ParseLogoPreference(companyUpdate.LogoPreference, company.LogoPreference); err != nil {
+		return nil, err
+	}
+
+	// Upload logo if provided
+	if err := s.setLogo(ctx, company, companyUpdate.Logo, companyUpdate.LogoLight, companyUpdate.LogoDark); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	company.UpdatedAt = &now
+
+	updatedCompany, err := s.companyRepo.Update(ctx, company)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update company",
+			errors.WithContext("company_id", company.ID),
+		)
+	}
+
+	return updatedCompany, nil
+}
+
+// DeleteCompany removes the company; its experiences keep their company name
+// and logo but lose the reference. The logo file is kept for that reason.
+func (s *companyService) DeleteCompany(ctx context.Context, id string) error {
+	if _, err := s.GetCompanyByID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.companyRepo.Delete(ctx, id)
+}
+
+func (s *companyService) ListCompanies(ctx context.Context, opts base.ListOptions) ([]Company, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.companyRepo.List(ctx, opts)
+}
+
+func (s *companyService) CountCompanies(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.companyRepo.Count(ctx, filters)
+}
+
+// ResolveCompany returns the company matching name, creating it on first use
+func (s *companyService) ResolveCompany(ctx context.Context, name string) (*Company, error) {
+	companyCreate := &CompanyCreate{Name: name}
+	if err := validator.ValidateModel(companyCreate); err != nil {
+		return nil, err
+	}
+
+	company := companyCreate.ToCompany()
+
+	existing, err := s.findBySlug(ctx, company.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	now := time.Now().UTC()
+	company.ID = uuid.New()
+	company.CreatedAt = &now
+	company.UpdatedAt = &now
+
+	createdCompany, err := s.companyRepo.Create(ctx, &company)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create company",
+			errors.WithContext("company_name", company.Name),
+		)
+	}
+
+	return createdCompany, nil
+}
+
+// SetCompanyLogo stores file as the logo shared by every experience at the company
+func (s *companyService) SetCompanyLogo(ctx context.Context, id string, file *multipart.FileHeader) (*Company, error) {
+	company, err := s.GetCompanyByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousURL := company.LogoUrl
+	if err := s.setLogo(ctx, company, file, nil, nil); err != nil {
+		return nil, err
+	}
+	if company.LogoUrl == previousURL {
+		return company, nil
+	}
+
+	now := time.Now().UTC()
+	company.UpdatedAt = &now
+
+	updatedCompany, err := s.companyRepo.Update(ctx, company)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update company logo",
+			errors.WithContext("company_id", company.ID),
+		)
+	}
+
+	return updatedCompany, nil
+}
+
+// findBySlug returns the company with the given slug, or nil if none
+func (s *companyService) findBySlug(ctx context.Context, slug string) (*Company, error) {
+	companies, err := s.companyRepo.FindByField(ctx, "slug", slug)
+	if err != nil {
+		return nil, err
+	}
+	if len(companies) == 0 {
+		return nil, nil
+	}
+	return &companies[0], nil
+}
+
+// setLogo stores the logo of a company along with its variants for light
+// and dark backgrounds, generating the variants that are not uploaded
+func (s *companyService) setLogo(ctx context.Context, company *Company, logo, light, dark *multipart.FileHeader) error {
+	var original []byte
+	if logo != nil {
+		logoURL, err := s.uploadCompanyLogo(ctx, company.ID.String(), logo)
+		if err != nil {
+			return err
+		}
+		if logoURL != company.LogoUrl {
+			company.LogoUrl = logoURL
+			original, _ = storage.ReadUpload(logo)
+		}
+	}
+
+	variants, err := asset.UploadLogoVariants(ctx, s.assets,
+		base.TenantStoragePath(ctx, fmt.Sprintf("images/company/%s", company.ID)),
+		asset.LogoVariants{Light: company.LogoUrlLight, Dark: company.LogoUrlDark},
+		asset.LogoUpload{Original: original, OriginalURL: company.LogoUrl, Light: light, Dark: dark},
+	)
+	if err != nil {
+		return errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to upload company logo variants",
+			errors.WithContext("company_id", company.ID),
+		)
+	}
+
+	company.LogoUrlLight = variants.Light
+	company.LogoUrlDark = variants.Dark
+	return nil
+}
+
+func (s *companyService) uploadCompanyLogo(ctx context.Context, companyID string, file *multipart.FileHeader) (string, error) {
+	if companyID == "" {
+		return "", fmt.Errorf("company ID cannot be empty")
+	}
+	if file == nil {
+		return "", fmt.Errorf("file is required")
+	}
+
+	destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/company/%s%s", companyID, filepath.Ext(file.Filename)))
+
+	uploaded, err := s.assets.Upload(ctx, file, destPath, storage.FileOptions{
+		ContentType: func(s string) *string { return &s }("image"),
+		Upsert:      func(b bool) *bool { return &b }(true),
+	})
+	if err != nil {
+		return "", errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to upload company logo",
+			errors.WithContext("company_id", companyID),
+		)
+	}
+
+	return uploaded.URL, nil
+}
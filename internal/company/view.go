@@ -0,0 +1,52 @@
+package company
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+)
+
+// PublicCompany is a company as visitors see it
+// @Description Company as shown publicly
+// @Name PublicCompany
+type PublicCompany struct {
+	ID             uuid.UUID            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name           string               `json:"name" example:"Tech Innovations Inc."`
+	Slug           string               `json:"slug" example:"tech-innovations-inc"`
+	LogoUrl        string               `json:"logo_url" example:"https://example.com/company-logo.png"`
+	Website        string               `json:"website" example:"https://techinnovations.example.com"`
+	Industry       string               `json:"industry" example:"Software"`
+	LogoUrlLight   string               `json:"logo_url_light,omitempty" example:"https://example.com/company-logo.png"`
+	LogoUrlDark    string               `json:"logo_url_dark,omitempty" example:"https://example.com/company-logo-dark.png"`
+	LogoPreference asset.LogoPreference `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"auto"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+}
+
+// Public maps a company to what visitors see
+func (c Company) Public() PublicCompany {
+	return PublicCompany{
+		ID:             c.ID,
+		Name:           c.Name,
+		Slug:           c.Slug,
+		LogoUrl:        c.LogoUrl,
+		Website:        c.Website,
+		Industry:       c.Industry,
+		LogoUrlLight:   c.LogoUrlLight,
+		LogoUrlDark:    c.LogoUrlDark,
+		LogoPreference: c.LogoPreference,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
+
+// PublicView and AdminView render companies in responses, see
+// response.View
+func (c Company) PublicView() interface{} {
+	return c.Public()
+}
+
+func (c Company) AdminView() interface{} {
+	return c
+}
@@ -0,0 +1,175 @@
+package contract_fixtures
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fixtureTime is the time every generated timestamp is set to, so fixtures
+// are stable across runs
+var fixtureTime = time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC)
+
+// fixtureNamespace seeds the UUIDs of fields without an example
+var fixtureNamespace = uuid.MustParse("6f1c2a52-8f3e-4d0b-9a51-2c7e0d4b9a10")
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+)
+
+// maxTypeDepth bounds how often a type nests inside itself, e.g. in trees
+const maxTypeDepth = 1
+
+// Generate returns a value of type t with every field filled: fields take
+// their example tag when they have one and a deterministic placeholder
+// otherwise, so optional fields show up in the payload too
+func Generate(t reflect.Type) reflect.Value {
+	g := &generator{depth: map[reflect.Type]int{}}
+	value := reflect.New(t).Elem()
+	g.fill(value, t.Name(), "")
+	return value
+}
+
+type generator struct {
+	depth map[reflect.Type]int
+}
+
+// fill sets value from its example, or a placeholder derived from path
+func (g *generator) fill(value reflect.Value, path, example string) {
+	t := value.Type()
+
+	switch t {
+	case timeType:
+		value.Set(reflect.ValueOf(fixtureTime))
+		return
+	case uuidType:
+		id, err := uuid.Parse(example)
+		if err != nil {
+			id = uuid.NewSHA1(fixtureNamespace, []byte(path))
+		}
+		value.Set(reflect.ValueOf(id))
+		return
+	}
+
+	// Types decoding themselves, e.g. dates, take the example as JSON
+	if example != "" && t.Kind() != reflect.Pointer && g.decode(value, example) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if example == "" {
+			example = path[strings.LastIndex(path, ".")+1:]
+		}
+		value.SetString(example)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(example)
+		if err != nil {
+			parsed = true
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(example, 10, 64)
+		if err != nil {
+			parsed = 1
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(example, 10, 64)
+		if err != nil {
+			parsed = 1
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(example, 64)
+		if err != nil {
+			parsed = 1
+		}
+		value.SetFloat(parsed)
+	case reflect.Pointer:
+		if g.depth[t.Elem()] > maxTypeDepth {
+			return
+		}
+		elem := reflect.New(t.Elem())
+		g.fill(elem.Elem(), path, example)
+		value.Set(elem)
+	case reflect.Slice:
+		// Raw bytes have no meaningful placeholder
+		if t.Elem().Kind() == reflect.Uint8 || g.depth[t.Elem()] > maxTypeDepth {
+			return
+		}
+		examples := []string{""}
+		if example != "" && t.Elem().Kind() == reflect.String {
+			examples = strings.Split(example, ",")
+		}
+		slice := reflect.MakeSlice(t, len(examples), len(examples))
+		for i, item := range examples {
+			g.fill(slice.Index(i), path, strings.TrimSpace(item))
+		}
+		value.Set(slice)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return
+		}
+		key := reflect.New(t.Key()).Elem()
+		key.SetString("key")
+		item := reflect.New(t.Elem()).Elem()
+		g.fill(item, path+".key", "")
+		value.Set(reflect.MakeMap(t))
+		value.SetMapIndex(key, item)
+	case reflect.Struct:
+		g.fillStruct(value, path)
+	}
+}
+
+// fillStruct fills the exported fields serialized to JSON
+func (g *generator) fillStruct(value reflect.Value, path string) {
+	t := value.Type()
+	g.depth[t]++
+	defer func() { g.depth[t]-- }()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			g.fill(value.Field(i), path, "")
+			continue
+		}
+
+		g.fill(value.Field(i), path+"."+name, field.Tag.Get("example"))
+	}
+}
+
+// decode sets value from an example through its own JSON or text decoding,
+// reporting whether the type decodes itself
+func (g *generator) decode(value reflect.Value, example string) bool {
+	target := value.Addr().Interface()
+
+	if unmarshaler, ok := target.(json.Unmarshaler); ok {
+		quoted, _ := json.Marshal(example)
+		if unmarshaler.UnmarshalJSON(quoted) == nil {
+			return true
+		}
+		return unmarshaler.UnmarshalJSON([]byte(example)) == nil
+	}
+	if unmarshaler, ok := target.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(example)) == nil
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package contract_fixtures
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type FixturesHandler struct {
+	base.BaseHandler
+	logger *logger.Logger
+}
+
+func NewFixturesHandler(logger *logger.Logger) *FixturesHandler {
+	return &FixturesHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		logger:      logger,
+	}
+}
+
+// ListEntities lists the entities fixtures are served for
+// @Summary List fixture entities
+// @Description List the entities canonical example payloads are served for. Hidden like other debug routes in restricted environments, such as production.
+// @Tags Fixtures
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]Entity} "Fixture entities retrieved successfully"
+// @Router /_fixtures [get]
+func (h *FixturesHandler) ListEntities(c *gin.Context) {
+	list := make([]Entity, 0, len(entities))
+	for name, t := range entities {
+		list = append(list, Entity{Name: name, Type: t.String()})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	h.HandleSuccess(c, list, "Fixture entities retrieved successfully")
+}
+
+// GetFixture serves the canonical example payload of an entity
+// @Summary Get an entity fixture
+// @Description Serve a canonical example payload of an entity, generated from its DTO and rendered through the same view and envelope as real responses, so mock servers and contract tests match the actual serialization. Every field is filled from its example, or a stable placeholder. With list=true the payload is a one-item list with pagination. Hidden like other debug routes in restricted environments, such as production.
+// @Tags Fixtures
+// @Produce json
+// @Param entity path string true "Entity name, see GET /_fixtures"
+// @Param list query bool false "Serve a paginated list"
+// @Success 200 {object} response.APIResponse "Fixture retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Unknown entity"
+// @Router /_fixtures/{entity} [get]
+func (h *FixturesHandler) GetFixture(c *gin.Context) {
+	name := c.Param("entity")
+	t, ok := entities[name]
+	if !ok {
+		h.HandleError(c, errors.New(
+			errors.ErrNotFound,
+			fmt.Sprintf("No fixture for entity %q", name),
+			nil,
+			errors.WithContext("entity", name),
+		))
+		return
+	}
+
+	list := false
+	if raw := c.Query("list"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Invalid list parameter",
+				err,
+				errors.WithContext("list", raw),
+			))
+			return
+		}
+		list = parsed
+	}
+
+	fixture := Generate(t)
+	if !list {
+		h.HandleSuccess(c, fixture.Interface(), "Fixture retrieved successfully")
+		return
+	}
+
+	items := reflect.MakeSlice(reflect.SliceOf(t), 0, 1)
+	items = reflect.Append(items, fixture)
+	h.HandleSuccess(c, items.Interface(), "Fixture retrieved successfully",
+		response.WithPagination(1, 1, 10))
+}
@@ -0,0 +1,49 @@
+package contract_fixtures
+
+import (
+	"reflect"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/changelog"
+	"github.com/holycann/itsrama-portfolio-backend/internal/collection"
+	"github.com/holycann/itsrama-portfolio-backend/internal/company"
+	"github.com/holycann/itsrama-portfolio-backend/internal/endorsement"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now_playing"
+	"github.com/holycann/itsrama-portfolio-backend/internal/offering"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/public_stats"
+	"github.com/holycann/itsrama-portfolio-backend/internal/recruiter"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/uses"
+)
+
+// entities maps fixture names to the DTOs the API answers with
+var entities = map[string]reflect.Type{
+	"project":             reflect.TypeOf(project.ProjectDTO{}),
+	"project-timeline":    reflect.TypeOf(project.ProjectTimeline{}),
+	"experience":          reflect.TypeOf(experience.ExperienceDTO{}),
+	"experience-timeline": reflect.TypeOf(experience.Timeline{}),
+	"tech-stack":          reflect.TypeOf(tech_stack.TechStack{}),
+	"company":             reflect.TypeOf(company.Company{}),
+	"page":                reflect.TypeOf(page.Page{}),
+	"collection":          reflect.TypeOf(collection.CollectionDetail{}),
+	"changelog-entry":     reflect.TypeOf(changelog.Entry{}),
+	"now-entry":           reflect.TypeOf(now.Entry{}),
+	"uses-category":       reflect.TypeOf(uses.Category{}),
+	"offering":            reflect.TypeOf(offering.OfferingDTO{}),
+	"endorsement":         reflect.TypeOf(endorsement.Endorsement{}),
+	"recruiter-profile":   reflect.TypeOf(recruiter.Profile{}),
+	"job-match":           reflect.TypeOf(recruiter.JobMatch{}),
+	"site-config":         reflect.TypeOf(site_config.SiteConfig{}),
+	"public-stats":        reflect.TypeOf(public_stats.PublicStats{}),
+	"now-playing":         reflect.TypeOf(now_playing.NowPlaying{}),
+}
+
+// Entity describes an entity fixtures are served for
+type Entity struct {
+	Name string `json:"name" example:"project"`
+	Type string `json:"type" example:"project.ProjectDTO"`
+}
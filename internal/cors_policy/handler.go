@@ -0,0 +1,82 @@
+package cors_policy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type CORSPolicyHandler struct {
+	base.BaseHandler
+	policy *middleware.CORSPolicy
+	logger *logger.Logger
+}
+
+func NewCORSPolicyHandler(policy *middleware.CORSPolicy, logger *logger.Logger) *CORSPolicyHandler {
+	return &CORSPolicyHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		policy:      policy,
+		logger:      logger,
+	}
+}
+
+// GetPolicy retrieves the CORS policy
+// @Summary Get the CORS policy
+// @Description Retrieve the origins currently allowed to make cross-origin requests
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Policy} "CORS policy retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/cors [get]
+func (h *CORSPolicyHandler) GetPolicy(c *gin.Context) {
+	h.HandleSuccess(c, h.current(), "CORS policy retrieved successfully")
+}
+
+// UpdateOrigins replaces the allowed origins
+// @Summary Update the allowed CORS origins
+// @Description Replace the origins allowed to make cross-origin requests, taking effect immediately without a restart. Origins are a scheme and host such as https://example.com, or the lone wildcard "*". Changes last until the server restarts.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param origins body OriginsUpdate true "Allowed origins"
+// @Success 200 {object} response.APIResponse{data=Policy} "CORS policy updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/cors [put]
+func (h *CORSPolicyHandler) UpdateOrigins(c *gin.Context) {
+	var input OriginsUpdate
+
+	if err := h.BindJSON(c, &input); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	previous := h.policy.AllowedOrigins()
+	if err := h.policy.SetAllowedOrigins(input.AllowedOrigins); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	policy := h.current()
+	h.logger.Info("CORS allowed origins updated",
+		"previous", previous,
+		"allowed_origins", policy.AllowedOrigins,
+	)
+
+	h.HandleSuccess(c, policy, "CORS policy updated successfully")
+}
+
+func (h *CORSPolicyHandler) current() Policy {
+	return Policy{
+		AllowedOrigins: h.policy.AllowedOrigins(),
+		UpdatedAt:      h.policy.UpdatedAt(),
+	}
+}
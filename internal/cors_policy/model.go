@@ -0,0 +1,15 @@
+package cors_policy
+
+import "time"
+
+// Policy is the CORS policy currently applied to requests
+type Policy struct {
+	AllowedOrigins []string   `json:"allowed_origins"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// OriginsUpdate replaces the allowed origins. Each origin is a scheme and
+// host such as https://example.com, or the lone wildcard "*".
+type OriginsUpdate struct {
+	AllowedOrigins []string `json:"allowed_origins" binding:"required,min=1"`
+}
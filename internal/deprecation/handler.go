@@ -0,0 +1,91 @@
+package deprecation
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type DeprecationHandler struct {
+	base.BaseHandler
+	deprecations *middleware.Deprecations
+	routes       func() gin.RoutesInfo
+	logger       *logger.Logger
+}
+
+// NewDeprecationHandler creates the handler reporting on deprecated
+// endpoints; routes lists the registered routes, e.g. gin.Engine.Routes
+func NewDeprecationHandler(deprecations *middleware.Deprecations, routes func() gin.RoutesInfo, logger *logger.Logger) *DeprecationHandler {
+	return &DeprecationHandler{
+		BaseHandler:  *base.NewBaseHandler(logger),
+		deprecations: deprecations,
+		routes:       routes,
+		logger:       logger,
+	}
+}
+
+// GetReport retrieves the deprecated endpoints and their traffic
+// @Summary Get the deprecated endpoints report
+// @Description Retrieve every registered endpoint flagged as deprecated, with its deprecation and sunset dates and the traffic it received over the last 30 days. An endpoint is safe to remove once it went without traffic for the quiet period. Traffic is counted in memory and starts over when the server restarts.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Deprecation report retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/deprecations [get]
+func (h *DeprecationHandler) GetReport(c *gin.Context) {
+	report := Report{
+		TrackingSince:  h.deprecations.TrackingSince(),
+		SunsetEnforced: h.deprecations.SunsetEnforced(),
+		Endpoints:      []Endpoint{},
+	}
+
+	for _, route := range h.routes() {
+		entry, ok := h.deprecations.Match(route.Method, route.Path)
+		if !ok {
+			continue
+		}
+
+		traffic := h.deprecations.Traffic(route.Method, route.Path)
+		endpoint := Endpoint{
+			Method:        route.Method,
+			Route:         route.Path,
+			DeprecatedAt:  entry.DeprecatedAt,
+			Link:          entry.Link,
+			Hits:          traffic.Hits,
+			LastSeenAt:    traffic.LastSeenAt,
+			LastUserAgent: traffic.LastUserAgent,
+			HitsByDay:     []DailyHits{},
+			SafeToRemove:  h.deprecations.SafeToRemove(traffic),
+		}
+		if !entry.SunsetAt.IsZero() {
+			sunsetAt := entry.SunsetAt
+			endpoint.SunsetAt = &sunsetAt
+		}
+		for day, hits := range traffic.HitsByDay {
+			endpoint.HitsByDay = append(endpoint.HitsByDay, DailyHits{Date: day, Hits: hits})
+		}
+		sort.Slice(endpoint.HitsByDay, func(i, j int) bool {
+			return endpoint.HitsByDay[i].Date < endpoint.HitsByDay[j].Date
+		})
+
+		report.Endpoints = append(report.Endpoints, endpoint)
+	}
+
+	// Busiest endpoints first
+	sort.SliceStable(report.Endpoints, func(i, j int) bool {
+		a, b := report.Endpoints[i], report.Endpoints[j]
+		if a.Hits != b.Hits {
+			return a.Hits > b.Hits
+		}
+		if a.Route != b.Route {
+			return a.Route < b.Route
+		}
+		return a.Method < b.Method
+	})
+
+	h.HandleSuccess(c, report, "Deprecation report retrieved successfully")
+}
@@ -0,0 +1,35 @@
+package deprecation
+
+import "time"
+
+// Report lists the deprecated endpoints and the traffic they still receive
+type Report struct {
+	// TrackingSince is when traffic started being counted; counts start
+	// over when the server restarts
+	TrackingSince  time.Time  `json:"tracking_since"`
+	SunsetEnforced bool       `json:"sunset_enforced"`
+	Endpoints      []Endpoint `json:"endpoints"`
+}
+
+// Endpoint is a deprecated endpoint and its traffic
+type Endpoint struct {
+	Method        string      `json:"method" example:"GET"`
+	Route         string      `json:"route" example:"/api/v1/projects/:id"`
+	DeprecatedAt  time.Time   `json:"deprecated_at"`
+	SunsetAt      *time.Time  `json:"sunset_at,omitempty"`
+	Link          string      `json:"link,omitempty"`
+	Hits          int64       `json:"hits"`
+	LastSeenAt    *time.Time  `json:"last_seen_at,omitempty"`
+	LastUserAgent string      `json:"last_user_agent,omitempty"`
+	HitsByDay     []DailyHits `json:"hits_by_day"`
+
+	// SafeToRemove reports that the endpoint went without traffic for the
+	// configured quiet period
+	SafeToRemove bool `json:"safe_to_remove"`
+}
+
+// DailyHits counts the requests of one day, in UTC
+type DailyHits struct {
+	Date string `json:"date" example:"2026-10-16"`
+	Hits int64  `json:"hits"`
+}
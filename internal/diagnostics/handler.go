@@ -0,0 +1,69 @@
+package diagnostics
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+)
+
+type DiagnosticsHandler struct {
+	base.BaseHandler
+	slowQueries  *slowcall.Tracker
+	slowRequests *slowcall.Tracker
+}
+
+func NewDiagnosticsHandler(slowQueries, slowRequests *slowcall.Tracker, logger *logger.Logger) *DiagnosticsHandler {
+	return &DiagnosticsHandler{
+		BaseHandler:  *base.NewBaseHandler(logger),
+		slowQueries:  slowQueries,
+		slowRequests: slowRequests,
+	}
+}
+
+// GetSlowCalls reports the slow database calls and requests
+// @Summary Get slow calls
+// @Description Count the database calls and handlers that exceeded their latency thresholds, grouped by operation, with the details of the latest occurrence such as the query filters involved
+// @Tags Diagnostics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=SlowCallReport} "Slow calls retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/diagnostics/slow-calls [get]
+func (h *DiagnosticsHandler) GetSlowCalls(c *gin.Context) {
+	h.HandleSuccess(c, SlowCallReport{
+		QueryThresholdMs:   h.slowQueries.Threshold().Milliseconds(),
+		RequestThresholdMs: h.slowRequests.Threshold().Milliseconds(),
+		Queries:            h.slowQueries.Stats(),
+		Requests:           h.slowRequests.Stats(),
+	}, "Slow calls retrieved successfully")
+}
+
+// ResetSlowCalls clears the slow call counts
+// @Summary Reset slow calls
+// @Description Clear the slow call counts, e.g. after fixing a hotspot
+// @Tags Diagnostics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Slow calls reset successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/diagnostics/slow-calls [delete]
+func (h *DiagnosticsHandler) ResetSlowCalls(c *gin.Context) {
+	h.slowQueries.Reset()
+	h.slowRequests.Reset()
+
+	h.HandleSuccess(c, nil, "Slow calls reset successfully")
+}
+
+// GetRuntime reports the state of the Go runtime
+// @Summary Get runtime statistics
+// @Description Get the goroutine count, heap and garbage collector statistics and build information of the running server, e.g. to follow memory growth between heap profiles taken from /debug/pprof
+// @Tags Diagnostics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=RuntimeReport} "Runtime statistics retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/runtime [get]
+func (h *DiagnosticsHandler) GetRuntime(c *gin.Context) {
+	h.HandleSuccess(c, CollectRuntime(), "Runtime statistics retrieved successfully")
+}
@@ -0,0 +1,14 @@
+package diagnostics
+
+import "github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+
+// SlowCallReport counts the database calls and requests that exceeded
+// their latency thresholds since the server started or was last reset
+// @Description Slow database calls and handlers grouped by operation, most frequent first
+// @Name SlowCallReport
+type SlowCallReport struct {
+	QueryThresholdMs   int64           `json:"query_threshold_ms" example:"500"`
+	RequestThresholdMs int64           `json:"request_threshold_ms" example:"2000"`
+	Queries            []slowcall.Stat `json:"queries"`
+	Requests           []slowcall.Stat `json:"requests"`
+}
@@ -0,0 +1,27 @@
+package diagnostics
+
+import (
+	"net/http/pprof"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Profile serves the profiles of net/http/pprof, such as /debug/pprof/heap
+// or /debug/pprof/profile?seconds=10. The CPU profile and the execution
+// trace must end within the server write timeout.
+func (h *DiagnosticsHandler) Profile(c *gin.Context) {
+	switch strings.Trim(c.Param("profile"), "/") {
+	case "cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		// The index also serves the named profiles, e.g. heap and goroutine
+		pprof.Index(c.Writer, c.Request)
+	}
+}
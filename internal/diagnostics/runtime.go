@@ -0,0 +1,115 @@
+package diagnostics
+
+import (
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// startedAt approximates the start of the process
+var startedAt = time.Now()
+
+// RuntimeReport describes the Go runtime of the running server
+// @Description Goroutines, heap and garbage collector statistics and build information of the running server
+// @Name RuntimeReport
+type RuntimeReport struct {
+	StartedAt     time.Time    `json:"started_at" example:"2024-01-15T10:30:00Z"`
+	UptimeSeconds int64        `json:"uptime_seconds" example:"86400"`
+	Goroutines    int          `json:"goroutines" example:"42"`
+	NumCPU        int          `json:"num_cpu" example:"4"`
+	GOMAXPROCS    int          `json:"gomaxprocs" example:"4"`
+	Heap          HeapStats    `json:"heap"`
+	GC            GCStats      `json:"gc"`
+	Build         BuildDetails `json:"build"`
+}
+
+// HeapStats are the memory statistics of the heap, in bytes
+// @Name RuntimeHeapStats
+type HeapStats struct {
+	Alloc      uint64 `json:"alloc" example:"12582912"`
+	TotalAlloc uint64 `json:"total_alloc" example:"734003200"`
+	Sys        uint64 `json:"sys" example:"33554432"`
+	InUse      uint64 `json:"in_use" example:"14680064"`
+	Idle       uint64 `json:"idle" example:"10485760"`
+	Released   uint64 `json:"released" example:"8388608"`
+	Objects    uint64 `json:"objects" example:"51234"`
+}
+
+// GCStats are the statistics of the garbage collector
+// @Name RuntimeGCStats
+type GCStats struct {
+	NumGC        uint32     `json:"num_gc" example:"128"`
+	NumForcedGC  uint32     `json:"num_forced_gc" example:"0"`
+	NextGC       uint64     `json:"next_gc" example:"16777216"`
+	LastGC       *time.Time `json:"last_gc,omitempty" example:"2024-01-15T10:30:00Z"`
+	LastPauseNs  uint64     `json:"last_pause_ns" example:"85000"`
+	TotalPauseNs uint64     `json:"total_pause_ns" example:"9600000"`
+	CPUFraction  float64    `json:"cpu_fraction" example:"0.0012"`
+}
+
+// BuildDetails identify the binary of the running server
+// @Name RuntimeBuildDetails
+type BuildDetails struct {
+	GoVersion string `json:"go_version" example:"go1.24.1"`
+	Module    string `json:"module,omitempty" example:"github.com/holycann/itsrama-portfolio-backend"`
+	Version   string `json:"version,omitempty" example:"(devel)"`
+	Revision  string `json:"revision,omitempty" example:"3f2a9c1b7e4d8a60"`
+	CommitAt  string `json:"commit_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	Modified  bool   `json:"modified" example:"false"`
+}
+
+// CollectRuntime reads the current statistics of the Go runtime. Reading
+// the memory statistics briefly stops the world, which is cheap enough for
+// an occasional admin request.
+func CollectRuntime() RuntimeReport {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	report := RuntimeReport{
+		StartedAt:     startedAt.UTC(),
+		UptimeSeconds: int64(time.Since(startedAt).Seconds()),
+		Goroutines:    runtime.NumGoroutine(),
+		NumCPU:        runtime.NumCPU(),
+		GOMAXPROCS:    runtime.GOMAXPROCS(0),
+		Heap: HeapStats{
+			Alloc:      mem.HeapAlloc,
+			TotalAlloc: mem.TotalAlloc,
+			Sys:        mem.HeapSys,
+			InUse:      mem.HeapInuse,
+			Idle:       mem.HeapIdle,
+			Released:   mem.HeapReleased,
+			Objects:    mem.HeapObjects,
+		},
+		GC: GCStats{
+			NumGC:        mem.NumGC,
+			NumForcedGC:  mem.NumForcedGC,
+			NextGC:       mem.NextGC,
+			TotalPauseNs: mem.PauseTotalNs,
+			CPUFraction:  mem.GCCPUFraction,
+		},
+		Build: BuildDetails{GoVersion: runtime.Version()},
+	}
+
+	if mem.NumGC > 0 {
+		lastGC := time.Unix(0, int64(mem.LastGC)).UTC()
+		report.GC.LastGC = &lastGC
+		report.GC.LastPauseNs = mem.PauseNs[(mem.NumGC+255)%256]
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		report.Build.Module = info.Main.Path
+		report.Build.Version = info.Main.Version
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				report.Build.Revision = setting.Value
+			case "vcs.time":
+				report.Build.CommitAt = setting.Value
+			case "vcs.modified":
+				report.Build.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,55 @@
+package duplicates
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type DuplicatesHandler struct {
+	base.BaseHandler
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+}
+
+func NewDuplicatesHandler(projectService project.ProjectService, experienceService experience.ExperienceService, logger *logger.Logger) *DuplicatesHandler {
+	return &DuplicatesHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		projectService:    projectService,
+		experienceService: experienceService,
+	}
+}
+
+// GetDuplicates reports probable duplicates among existing content
+// @Summary Report probable duplicates
+// @Description Group existing projects sharing a normalized title, and experiences with the same role at the same company over overlapping dates. The report runs whatever the configured dedup mode.
+// @Tags Duplicates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Duplicates retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/duplicates [get]
+func (h *DuplicatesHandler) GetDuplicates(c *gin.Context) {
+	projects, err := h.projectService.FindDuplicateGroups(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to find duplicate projects",
+		))
+		return
+	}
+
+	experiences, err := h.experienceService.FindDuplicateGroups(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to find duplicate experiences",
+		))
+		return
+	}
+
+	h.HandleSuccess(c, Report{Projects: projects, Experiences: experiences}, "Duplicates retrieved successfully")
+}
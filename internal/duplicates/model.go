@@ -0,0 +1,11 @@
+package duplicates
+
+import "github.com/holycann/itsrama-portfolio-backend/internal/base"
+
+// Report lists the existing content that probably duplicates each other
+// @Description Probable duplicates among existing projects and experiences
+// @Name DuplicatesReport
+type Report struct {
+	Projects    []base.DuplicateGroup `json:"projects"`
+	Experiences []base.DuplicateGroup `json:"experiences"`
+}
@@ -0,0 +1,96 @@
+package embed
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type EmbedHandler struct {
+	base.BaseHandler
+	embedService EmbedService
+	cacheTTL     time.Duration
+}
+
+func NewEmbedHandler(embedService EmbedService, cacheTTL time.Duration, logger *logger.Logger) *EmbedHandler {
+	return &EmbedHandler{
+		BaseHandler:  *base.NewBaseHandler(logger),
+		embedService: embedService,
+		cacheTTL:     cacheTTL,
+	}
+}
+
+// GetOEmbed describes a project page in the oEmbed format
+// @Summary Get the oEmbed description of a project page
+// @Description Describe the public project published at a URL of the site as an oEmbed link with its title, description and link preview image, so editors and chat apps render the URL as a card. Only the json format is served. The thumbnail is left out when it exceeds maxwidth or maxheight.
+// @Tags Embeds
+// @Produce json
+// @Param url query string true "URL of a project page on the site"
+// @Param format query string false "Response format" Enums(json)
+// @Param maxwidth query int false "Maximum width of the thumbnail"
+// @Param maxheight query int false "Maximum height of the thumbnail"
+// @Success 200 {object} OEmbed "oEmbed response"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "No public project is published at this URL"
+// @Failure 500 {object} response.APIResponse "The site has no canonical URL to embed projects from"
+// @Router /oembed [get]
+func (h *EmbedHandler) GetOEmbed(c *gin.Context) {
+	var query OEmbedQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	oEmbed, err := h.embedService.OEmbed(c.Request.Context(), &query)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.cacheTTL.Seconds())))
+	c.JSON(http.StatusOK, oEmbed)
+}
+
+// GetProjectCard serves the link preview image of a project
+// @Summary Get the link preview image of a project
+// @Description Render a 1200x630 PNG card with the title, description and category of a public project, tinted with the dominant color of its thumbnail. Cards are cached until the project changes.
+// @Tags Embeds
+// @Produce image/png
+// @Param slug path string true "Project slug"
+// @Success 200 {file} binary "Link preview image"
+// @Success 304 "Not Modified"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /og/projects/{slug} [get]
+func (h *EmbedHandler) GetProjectCard(c *gin.Context) {
+	card, err := h.embedService.ProjectCard(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if card.Cached {
+		middleware.MarkCacheHit(c)
+	}
+
+	etag := `"` + card.ETag + `"`
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.cacheTTL.Seconds())))
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, "image/png", card.Data)
+}
@@ -0,0 +1,41 @@
+package embed
+
+// OEmbedQuery represents the parameters of an oEmbed request
+// @Name OEmbedQuery
+type OEmbedQuery struct {
+	URL string `form:"url" validate:"required,max=2048" example:"https://itsrama.dev/projects/portfolio-website"`
+	// Format must be json when present, the only format served
+	Format    string `form:"format" example:"json"`
+	MaxWidth  int    `form:"maxwidth" validate:"min=0" example:"600"`
+	MaxHeight int    `form:"maxheight" validate:"min=0" example:"400"`
+}
+
+// OEmbed is the description of a page in the oEmbed format, which editors
+// and chat apps render as a card when its URL is pasted
+// @Description oEmbed link response describing a project page
+// @Name OEmbed
+type OEmbed struct {
+	Type         string `json:"type" example:"link"`
+	Version      string `json:"version" example:"1.0"`
+	Title        string `json:"title" example:"Portfolio Website"`
+	Description  string `json:"description,omitempty" example:"A responsive website to display my professional projects and skills"`
+	URL          string `json:"url" example:"https://itsrama.dev/projects/portfolio-website"`
+	ProviderName string `json:"provider_name,omitempty" example:"Itsrama Portfolio"`
+	ProviderURL  string `json:"provider_url" example:"https://itsrama.dev"`
+	CacheAge     int    `json:"cache_age" example:"3600"`
+
+	// The thumbnail is the link preview image of the page, left out when it
+	// is larger than the requested maximum size
+	ThumbnailURL    string `json:"thumbnail_url,omitempty" example:"https://api.itsrama.dev/api/v1/og/projects/portfolio-website?v=1736935200"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty" example:"1200"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty" example:"630"`
+}
+
+// Card is a rendered link preview image
+type Card struct {
+	Data []byte
+	// ETag identifies the content of the image
+	ETag string
+	// Cached is set when the image was served from the cache
+	Cached bool
+}
@@ -0,0 +1,290 @@
+package embed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/image_proxy"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+	"golang.org/x/sync/singleflight"
+)
+
+// slugPlaceholder is replaced by the slug in the project path
+const slugPlaceholder = "{slug}"
+
+// cardVersion changes the cache key of every card when their layout changes
+const cardVersion = "1"
+
+type EmbedService interface {
+	// OEmbed describes the public project whose page on the site is at the
+	// requested URL
+	OEmbed(ctx context.Context, query *OEmbedQuery) (*OEmbed, error)
+	// ProjectCard renders the link preview image of a public project
+	ProjectCard(ctx context.Context, slug string) (*Card, error)
+}
+
+type cacheEntry struct {
+	oEmbed    *OEmbed
+	expiresAt time.Time
+}
+
+type embedService struct {
+	projectService    project.ProjectService
+	siteConfigService site_config.SiteConfigService
+	imageCache        image_proxy.Cache
+	projectPath       string
+	baseURL           string
+	cacheTTL          time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+	group singleflight.Group
+}
+
+func NewEmbedService(projectService project.ProjectService, siteConfigService site_config.SiteConfigService, imageCache image_proxy.Cache, projectPath, baseURL string, cacheTTL time.Duration) EmbedService {
+	if !strings.Contains(projectPath, slugPlaceholder) {
+		projectPath = "/projects/" + slugPlaceholder
+	}
+
+	return &embedService{
+		projectService:    projectService,
+		siteConfigService: siteConfigService,
+		imageCache:        imageCache,
+		projectPath:       "/" + strings.TrimLeft(projectPath, "/"),
+		baseURL:           strings.TrimRight(baseURL, "/"),
+		cacheTTL:          cacheTTL,
+		cache:             make(map[string]cacheEntry),
+	}
+}
+
+func (s *embedService) OEmbed(ctx context.Context, query *OEmbedQuery) (*OEmbed, error) {
+	// Validate input
+	if err := validator.ValidateModel(query); err != nil {
+		return nil, err
+	}
+	if query.Format != "" && query.Format != "json" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Only the json format is supported",
+			nil,
+			errors.WithContext("format", query.Format),
+		)
+	}
+
+	siteConfig, canonical, err := s.site(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slug, ok := s.slugFromURL(canonical, query.URL)
+	if !ok {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"No public project is published at this URL",
+			nil,
+			errors.WithContext("url", query.URL),
+		)
+	}
+
+	// Entries are kept per project rather than per URL, so query strings
+	// cannot grow the cache
+	key := tenantCacheKey(ctx) + "|" + slug
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+
+	oEmbed := entry.oEmbed
+	if !ok || time.Now().After(entry.expiresAt) {
+		oEmbed, err = s.describe(ctx, siteConfig, canonical, slug)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		s.cache[key] = cacheEntry{oEmbed: oEmbed, expiresAt: time.Now().Add(s.cacheTTL)}
+		s.mu.Unlock()
+	}
+
+	// The size limits differ per consumer, so they apply to a copy
+	result := *oEmbed
+	if (query.MaxWidth > 0 && result.ThumbnailWidth > query.MaxWidth) || (query.MaxHeight > 0 && result.ThumbnailHeight > query.MaxHeight) {
+		result.ThumbnailURL = ""
+		result.ThumbnailWidth = 0
+		result.ThumbnailHeight = 0
+	}
+	return &result, nil
+}
+
+// describe builds the oEmbed response of the public project with slug
+func (s *embedService) describe(ctx context.Context, siteConfig *site_config.SiteConfig, canonical *url.URL, slug string) (*OEmbed, error) {
+	p, err := s.findProject(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	oEmbed := &OEmbed{
+		Type:            "link",
+		Version:         "1.0",
+		Title:           p.Title,
+		Description:     description(p),
+		URL:             strings.TrimRight(canonical.String(), "/") + strings.ReplaceAll(s.projectPath, slugPlaceholder, url.PathEscape(p.Slug)),
+		ProviderName:    siteConfig.SEO.Title,
+		ProviderURL:     canonical.String(),
+		CacheAge:        int(s.cacheTTL.Seconds()),
+		ThumbnailURL:    s.baseURL + "/og/projects/" + url.PathEscape(p.Slug),
+		ThumbnailWidth:  imageproc.CardWidth,
+		ThumbnailHeight: imageproc.CardHeight,
+	}
+	// Consumers cache thumbnails by URL, so edits change it
+	if p.UpdatedAt != nil {
+		oEmbed.ThumbnailURL += fmt.Sprintf("?v=%d", p.UpdatedAt.Unix())
+	}
+	return oEmbed, nil
+}
+
+func (s *embedService) ProjectCard(ctx context.Context, slug string) (*Card, error) {
+	p, err := s.findProject(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	_, canonical, err := s.site(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	card := imageproc.Card{
+		Title:       p.Title,
+		Description: description(p),
+		Footer:      canonical.Host,
+	}
+	if p.Category != "" {
+		card.Footer += " · " + string(p.Category)
+	}
+	for _, image := range p.Images {
+		if image.IsThumbnail {
+			card.Background = image.DominantColor
+		}
+	}
+
+	// Cards are keyed by what is drawn on them, so they are only rendered
+	// again once the project changes
+	sum := sha256.Sum256([]byte(strings.Join([]string{cardVersion, card.Title, card.Description, card.Footer, card.Background}, "\x00")))
+	key := hex.EncodeToString(sum[:16]) + ".png"
+
+	if data, ok := s.imageCache.Get(ctx, key); ok {
+		return &Card{Data: data, ETag: key, Cached: true}, nil
+	}
+
+	// Concurrent requests for the same card share a single render
+	result, err, _ := s.group.Do(key, func() (interface{}, error) {
+		data, err := imageproc.RenderCard(card)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "Failed to render link preview image")
+		}
+
+		// Caching is best effort; a failed write only costs a later render
+		_ = s.imageCache.Set(ctx, key, data, "image/png")
+
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Card{Data: result.([]byte), ETag: key}, nil
+}
+
+// findProject returns the public project with slug
+func (s *embedService) findProject(ctx context.Context, slug string) (*project.ProjectDTO, error) {
+	projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+		Page:    1,
+		PerPage: 1,
+		Filters: []base.FilterOption{
+			{Field: "slug", Operator: base.OperatorEqual, Value: slug},
+			{Field: "visibility", Operator: base.OperatorEqual, Value: project.VisibilityPublic},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+	return &projects[0], nil
+}
+
+// site returns the site config of the tenant in ctx and its canonical URL,
+// which project pages are published below
+func (s *embedService) site(ctx context.Context) (*site_config.SiteConfig, *url.URL, error) {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canonical, err := url.Parse(siteConfig.SEO.CanonicalURL)
+	if err != nil || (canonical.Scheme != "http" && canonical.Scheme != "https") || canonical.Host == "" {
+		return nil, nil, errors.New(
+			errors.ErrConfiguration,
+			"The site has no canonical URL to embed projects from",
+			err,
+		)
+	}
+	canonical.RawQuery = ""
+	canonical.Fragment = ""
+	return siteConfig, canonical, nil
+}
+
+// slugFromURL extracts the project slug from the URL of a project page on
+// the site
+func (s *embedService) slugFromURL(canonical *url.URL, rawURL string) (string, bool) {
+	target, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || !strings.EqualFold(target.Host, canonical.Host) {
+		return "", false
+	}
+
+	prefix, suffix, _ := strings.Cut(s.projectPath, slugPlaceholder)
+	prefix = strings.TrimRight(canonical.Path, "/") + prefix
+	suffix = strings.TrimRight(suffix, "/")
+
+	path := strings.TrimRight(target.Path, "/")
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) || len(path) < len(prefix)+len(suffix) {
+		return "", false
+	}
+
+	slug := path[len(prefix) : len(path)-len(suffix)]
+	if slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
+
+// description is the meta description of a project, or its description
+// when it has none
+func description(p *project.ProjectDTO) string {
+	if p.SEO.MetaDescription != "" {
+		return p.SEO.MetaDescription
+	}
+	return strings.Join(strings.Fields(p.Description), " ")
+}
+
+func tenantCacheKey(ctx context.Context) string {
+	if tenantID := base.TenantIDFromContext(ctx); tenantID != nil {
+		return tenantID.String()
+	}
+	return "default"
+}
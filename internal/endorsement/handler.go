@@ -0,0 +1,209 @@
+package endorsement
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type EndorsementHandler struct {
+	base.BaseHandler
+	endorsementService EndorsementService
+	logger             *logger.Logger
+}
+
+func NewEndorsementHandler(endorsementService EndorsementService, logger *logger.Logger) *EndorsementHandler {
+	return &EndorsementHandler{
+		BaseHandler:        *base.NewBaseHandler(logger),
+		endorsementService: endorsementService,
+		logger:             logger,
+	}
+}
+
+// Endorse records a visitor endorsement of a tech stack
+// @Summary Endorse a tech stack
+// @Description Endorse a skill. Each visitor can endorse a tech stack once and requests are rate limited per IP. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers. Endorsements whose name the spam filter flags are hidden from public counts, answered the same way.
+// @Tags Endorsements
+// @Accept json
+// @Produce json
+// @Param id path string true "Tech Stack ID"
+// @Param endorsement body EndorsementCreate false "Endorsement Details"
+// @Success 200 {object} response.APIResponse{data=Endorsement} "Tech stack endorsed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "Missing or invalid challenge"
+// @Failure 404 {object} response.APIResponse "Tech stack not found"
+// @Failure 409 {object} response.APIResponse "Tech stack already endorsed"
+// @Failure 429 {object} response.APIResponse "Too Many Requests"
+// @Router /tech-stacks/{id}/endorsements [post]
+func (h *EndorsementHandler) Endorse(c *gin.Context) {
+	techStackID := c.Param("id")
+	if _, err := h.ValidateUUID(techStackID, "Tech Stack ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// The body is optional; anonymous endorsements send none
+	var endorsementInput EndorsementCreate
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &endorsementInput); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+	}
+
+	meta := RequestMeta{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+
+	endorsement, err := h.endorsementService.Endorse(c.Request.Context(), techStackID, &endorsementInput, meta)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Spam is answered like any endorsement so senders cannot tell it was
+	// flagged
+	if endorsement.IsHidden {
+		h.logger.Info("Endorsement flagged as spam", "endorsement_id", endorsement.ID)
+		answered := *endorsement
+		answered.IsHidden = false
+		endorsement = &answered
+	}
+
+	h.HandleSuccess(c, endorsement, "Tech stack endorsed successfully")
+}
+
+// ListEndorsements retrieves endorsements for moderation
+// @Summary List endorsements
+// @Description Retrieve a paginated list of endorsements, newest first, for moderation
+// @Tags Endorsements
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param tech_stack_id query string false "Filter by tech stack"
+// @Param is_hidden query bool false "Filter by visibility"
+// @Success 200 {object} response.APIResponse{data=[]Endorsement} "Endorsements retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/endorsements [get]
+func (h *EndorsementHandler) ListEndorsements(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if techStackID := c.Query("tech_stack_id"); techStackID != "" {
+		if _, err := h.ValidateUUID(techStackID, "Tech Stack ID"); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "tech_stack_id",
+			Operator: base.OperatorEqual,
+			Value:    techStackID,
+		})
+	}
+
+	if value := c.Query("is_hidden"); value != "" {
+		hidden, err := strconv.ParseBool(value)
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Invalid is_hidden parameter",
+				err,
+			))
+			return
+		}
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "is_hidden",
+			Operator: base.OperatorEqual,
+			Value:    hidden,
+		})
+	}
+
+	endorsements, err := h.endorsementService.ListEndorsements(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.endorsementService.CountEndorsements(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, endorsements, "Endorsements retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// ModerateEndorsement hides or shows an endorsement
+// @Summary Moderate an endorsement
+// @Description Hide an endorsement from public counts or show it again
+// @Tags Endorsements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Endorsement ID"
+// @Param moderation body EndorsementModerate true "Moderation Details"
+// @Success 200 {object} response.APIResponse{data=Endorsement} "Endorsement moderated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Endorsement not found"
+// @Router /admin/endorsements/{id} [patch]
+func (h *EndorsementHandler) ModerateEndorsement(c *gin.Context) {
+	endorsementID := c.Param("id")
+	if _, err := h.ValidateUUID(endorsementID, "Endorsement ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var moderateInput EndorsementModerate
+	if err := h.ValidateRequest(c, &moderateInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	endorsement, err := h.endorsementService.ModerateEndorsement(c.Request.Context(), endorsementID, &moderateInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, endorsement, "Endorsement moderated successfully")
+}
+
+// DeleteEndorsement removes an endorsement
+// @Summary Delete an endorsement
+// @Description Permanently remove an endorsement
+// @Tags Endorsements
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Endorsement ID"
+// @Success 200 {object} response.APIResponse "Endorsement deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Endorsement not found"
+// @Router /admin/endorsements/{id} [delete]
+func (h *EndorsementHandler) DeleteEndorsement(c *gin.Context) {
+	endorsementID := c.Param("id")
+	if _, err := h.ValidateUUID(endorsementID, "Endorsement ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.endorsementService.DeleteEndorsement(c.Request.Context(), endorsementID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Endorsement deleted successfully")
+}
@@ -0,0 +1,45 @@
+package endorsement
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Endorsement is a visitor's endorsement of a skill
+// @Description Visitor endorsement of a tech stack
+// @Name Endorsement
+type Endorsement struct {
+	ID          uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	TechStackID uuid.UUID  `json:"tech_stack_id" db:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+
+	// VisitorHash identifies the endorsing visitor without storing their address
+	VisitorHash string `json:"visitor_hash" db:"visitor_hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Name        string `json:"name,omitempty" db:"name" example:"Jane Doe"`
+
+	// IsHidden excludes the endorsement from public counts
+	IsHidden bool `json:"is_hidden" db:"is_hidden" example:"false"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// EndorsementCreate is the input for endorsing a tech stack
+// @Name EndorsementCreate
+type EndorsementCreate struct {
+	// Name optionally credits the endorser
+	Name string `json:"name" validate:"max=100" example:"Jane Doe"`
+}
+
+// EndorsementModerate is the input for hiding or showing an endorsement
+// @Name EndorsementModerate
+type EndorsementModerate struct {
+	IsHidden bool `json:"is_hidden" example:"true"`
+}
+
+// RequestMeta identifies the visitor making an endorsement
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+}
@@ -0,0 +1,165 @@
+package endorsement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type EndorsementRepository interface {
+	Create(ctx context.Context, endorsement *Endorsement) (*Endorsement, error)
+	FindByVisitor(ctx context.Context, techStackID, visitorHash string) (*Endorsement, error)
+	FindByID(ctx context.Context, id string) (*Endorsement, error)
+	SetHidden(ctx context.Context, id string, hidden bool) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, opts base.ListOptions) ([]Endorsement, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type endorsementRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewEndorsementRepository(supabaseClient *supabase.SupabaseClient) EndorsementRepository {
+	return &endorsementRepository{
+		supabaseClient: supabaseClient,
+		table:          "tech_stack_endorsement",
+	}
+}
+
+func (r *endorsementRepository) Create(ctx context.Context, endorsement *Endorsement) (*Endorsement, error) {
+	endorsement.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(endorsement, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create endorsement")
+	}
+	return endorsement, nil
+}
+
+func (r *endorsementRepository) FindByVisitor(ctx context.Context, techStackID, visitorHash string) (*Endorsement, error) {
+	return r.findOne(ctx, map[string]string{
+		"tech_stack_id": techStackID,
+		"visitor_hash":  visitorHash,
+	})
+}
+
+func (r *endorsementRepository) FindByID(ctx context.Context, id string) (*Endorsement, error) {
+	return r.findOne(ctx, map[string]string{"id": id})
+}
+
+func (r *endorsementRepository) SetHidden(ctx context.Context, id string, hidden bool) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"is_hidden":  hidden,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update endorsement")
+	}
+	return nil
+}
+
+func (r *endorsementRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete endorsement")
+	}
+	return nil
+}
+
+func (r *endorsementRepository) List(ctx context.Context, opts base.ListOptions) ([]Endorsement, error) {
+	var endorsements []Endorsement
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, newest first by default
+	sortBy, ascending := "created_at", false
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&endorsements)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list endorsements")
+	}
+
+	return endorsements, nil
+}
+
+func (r *endorsementRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count endorsements")
+	}
+
+	return int(count), nil
+}
+
+// findOne returns the endorsement matching all fields, or nil if none
+func (r *endorsementRepository) findOne(ctx context.Context, fields map[string]string) (*Endorsement, error) {
+	var endorsements []Endorsement
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	for field, value := range fields {
+		query = query.Eq(field, value)
+	}
+
+	_, err := base.ScopeToTenant(ctx, query).Limit(1, "").ExecuteTo(&endorsements)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find endorsement")
+	}
+
+	if len(endorsements) == 0 {
+		return nil, nil
+	}
+	return &endorsements[0], nil
+}
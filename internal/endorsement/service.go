@@ -0,0 +1,169 @@
+package endorsement
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/antispam"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+type EndorsementService interface {
+	Endorse(ctx context.Context, techStackID string, endorsementCreate *EndorsementCreate, meta RequestMeta) (*Endorsement, error)
+	ListEndorsements(ctx context.Context, opts base.ListOptions) ([]Endorsement, error)
+	CountEndorsements(ctx context.Context, filters []base.FilterOption) (int, error)
+	ModerateEndorsement(ctx context.Context, id string, moderate *EndorsementModerate) (*Endorsement, error)
+	DeleteEndorsement(ctx context.Context, id string) error
+}
+
+type endorsementService struct {
+	endorsementRepo  EndorsementRepository
+	techStackService tech_stack.TechStackService
+	secret           []byte
+	spamFilter       *antispam.Filter
+}
+
+// NewEndorsementService creates an endorsement service. The secret keys the
+// visitor hashes used to deduplicate endorsements; spamFilter may be nil to
+// accept every endorser name.
+func NewEndorsementService(endorsementRepo EndorsementRepository, techStackService tech_stack.TechStackService, secret []byte, spamFilter *antispam.Filter) EndorsementService {
+	return &endorsementService{
+		endorsementRepo:  endorsementRepo,
+		techStackService: techStackService,
+		secret:           secret,
+		spamFilter:       spamFilter,
+	}
+}
+
+// Endorse records a visitor's endorsement of a tech stack, once per visitor
+func (s *endorsementService) Endorse(ctx context.Context, techStackID string, endorsementCreate *EndorsementCreate, meta RequestMeta) (*Endorsement, error) {
+	// Validate input
+	if err := validator.ValidateModel(endorsementCreate); err != nil {
+		return nil, err
+	}
+
+	techStack, err := s.techStackService.GetTechStackByID(ctx, techStackID)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Tech stack not found",
+			errors.WithContext("tech_stack_id", techStackID),
+		)
+	}
+
+	visitorHash := s.visitorHash(ctx, meta)
+
+	existing, err := s.endorsementRepo.FindByVisitor(ctx, techStack.ID.String(), visitorHash)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Tech stack already endorsed",
+			nil,
+			errors.WithContext("tech_stack_id", techStackID),
+		)
+	}
+
+	endorsement := &Endorsement{
+		ID:          uuid.New(),
+		TechStackID: techStack.ID,
+		VisitorHash: visitorHash,
+		Name:        strings.TrimSpace(endorsementCreate.Name),
+	}
+
+	// Only a name carries content worth filtering. Spam is kept hidden from
+	// public counts rather than rejected, and the filter failing keeps it
+	// visible.
+	if s.spamFilter != nil && endorsement.Name != "" {
+		verdict, err := s.spamFilter.Check(ctx, antispam.Submission{
+			Kind:      "endorsement",
+			Author:    endorsement.Name,
+			Content:   endorsement.Name,
+			IP:        meta.IP,
+			UserAgent: meta.UserAgent,
+		})
+		if err == nil && verdict.Spam {
+			endorsement.IsHidden = true
+		}
+	}
+
+	return s.endorsementRepo.Create(ctx, endorsement)
+}
+
+func (s *endorsementService) ListEndorsements(ctx context.Context, opts base.ListOptions) ([]Endorsement, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.endorsementRepo.List(ctx, opts)
+}
+
+func (s *endorsementService) CountEndorsements(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.endorsementRepo.Count(ctx, filters)
+}
+
+// ModerateEndorsement hides or shows an endorsement in public counts
+func (s *endorsementService) ModerateEndorsement(ctx context.Context, id string, moderate *EndorsementModerate) (*Endorsement, error) {
+	endorsement, err := s.findEndorsement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.endorsementRepo.SetHidden(ctx, id, moderate.IsHidden); err != nil {
+		return nil, err
+	}
+
+	endorsement.IsHidden = moderate.IsHidden
+	return endorsement, nil
+}
+
+func (s *endorsementService) DeleteEndorsement(ctx context.Context, id string) error {
+	if _, err := s.findEndorsement(ctx, id); err != nil {
+		return err
+	}
+
+	return s.endorsementRepo.Delete(ctx, id)
+}
+
+// findEndorsement returns the endorsement with the given ID or a not found error
+func (s *endorsementService) findEndorsement(ctx context.Context, id string) (*Endorsement, error) {
+	endorsement, err := s.endorsementRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if endorsement == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Endorsement not found",
+			nil,
+			errors.WithContext("endorsement_id", id),
+		)
+	}
+	return endorsement, nil
+}
+
+// visitorHash identifies a visitor by a keyed hash of their IP address and
+// user agent, so duplicates can be detected without storing either
+func (s *endorsementService) visitorHash(ctx context.Context, meta RequestMeta) string {
+	tenantID := ""
+	if id := base.TenantIDFromContext(ctx); id != nil {
+		tenantID = id.String()
+	}
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(tenantID + "|" + meta.IP + "|" + meta.UserAgent))
+	return hex.EncodeToString(mac.Sum(nil))
+}
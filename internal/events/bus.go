@@ -0,0 +1,229 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// subscriberBuffer is the number of events buffered per subscriber before
+// events are dropped for that subscriber
+const subscriberBuffer = 64
+
+// Publisher publishes domain events
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// Bus is an in-memory event bus that keeps a bounded history of published
+// events so that reconnecting subscribers can resume from a known event ID.
+// Only stream clients count toward the subscriber limit; the server's own
+// jobs subscribe internally.
+type Bus struct {
+	mu             sync.RWMutex
+	lastID         int64
+	history        []Event
+	historySize    int
+	subscribers    map[int64]*Subscription
+	internal       map[int64]*Subscription
+	maxSubscribers int
+	nextSubID      int64
+	streamsClosed  bool
+	closed         bool
+}
+
+// Subscription receives events published on the bus
+type Subscription struct {
+	id     int64
+	bus    *Bus
+	events chan Event
+	once   sync.Once
+}
+
+// NewBus creates a new event bus
+func NewBus(historySize, maxSubscribers int) *Bus {
+	if historySize <= 0 {
+		historySize = 500
+	}
+	if maxSubscribers <= 0 {
+		maxSubscribers = 10
+	}
+
+	return &Bus{
+		history:        make([]Event, 0, historySize),
+		historySize:    historySize,
+		subscribers:    make(map[int64]*Subscription),
+		internal:       make(map[int64]*Subscription),
+		maxSubscribers: maxSubscribers,
+	}
+}
+
+// Publish assigns an ID to the event, stores it in the history and fans it
+// out to all subscribers without blocking the caller. Events without a
+// tenant belong to the tenant the context is scoped to.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.lastID++
+	event.ID = b.lastID
+	if event.TenantID == nil {
+		event.TenantID = base.TenantIDFromContext(ctx)
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	if len(b.history) == b.historySize {
+		b.history = b.history[1:]
+	}
+	b.history = append(b.history, event)
+
+	for _, subscribers := range []map[int64]*Subscription{b.subscribers, b.internal} {
+		for _, sub := range subscribers {
+			select {
+			case sub.events <- event:
+			default:
+				// Slow subscriber, drop the event rather than blocking publishers
+			}
+		}
+	}
+}
+
+// Subscribe registers a new subscriber and returns the events published after
+// lastEventID that are still retained in the history
+func (b *Bus) Subscribe(lastEventID int64) (*Subscription, []Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed || b.streamsClosed {
+		return nil, nil, errors.New(
+			errors.ErrInternal,
+			"Event bus is closed",
+			nil,
+		)
+	}
+
+	if len(b.subscribers) >= b.maxSubscribers {
+		return nil, nil, errors.New(
+			errors.ErrTooManyRequests,
+			"Too many event stream connections",
+			nil,
+			errors.WithContext("max_connections", b.maxSubscribers),
+		)
+	}
+
+	var backlog []Event
+	if lastEventID > 0 {
+		for _, event := range b.history {
+			if event.ID > lastEventID {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := b.newSubscription()
+	b.subscribers[sub.id] = sub
+
+	return sub, backlog, nil
+}
+
+// SubscribeInternal registers a subscriber for the server's own background
+// jobs. Internal subscribers receive new events only and do not count toward
+// the stream connection limit.
+func (b *Bus) SubscribeInternal() (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, errors.New(
+			errors.ErrInternal,
+			"Event bus is closed",
+			nil,
+		)
+	}
+
+	sub := b.newSubscription()
+	b.internal[sub.id] = sub
+
+	return sub, nil
+}
+
+// newSubscription creates a subscription; the caller holds the lock and
+// registers it
+func (b *Bus) newSubscription() *Subscription {
+	b.nextSubID++
+	return &Subscription{
+		id:     b.nextSubID,
+		bus:    b,
+		events: make(chan Event, subscriberBuffer),
+	}
+}
+
+// Recent returns up to limit of the most recent events, oldest first
+func (b *Bus) Recent(limit int) []Event {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if limit <= 0 || limit > len(b.history) {
+		limit = len(b.history)
+	}
+
+	recent := make([]Event, limit)
+	copy(recent, b.history[len(b.history)-limit:])
+	return recent
+}
+
+// CloseStreams ends the subscriptions of stream clients, which never end on
+// their own, so the server can drain its connections on shutdown. Events
+// are still published to the internal subscribers until Close.
+func (b *Bus) CloseStreams() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.streamsClosed = true
+	for id, sub := range b.subscribers {
+		delete(b.subscribers, id)
+		sub.once.Do(func() { close(sub.events) })
+	}
+}
+
+// Close closes the bus and all active subscriptions
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+
+	for _, subscribers := range []map[int64]*Subscription{b.subscribers, b.internal} {
+		for id, sub := range subscribers {
+			delete(subscribers, id)
+			sub.once.Do(func() { close(sub.events) })
+		}
+	}
+}
+
+// Events returns the channel on which events are delivered
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close unregisters the subscription from the bus
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	delete(s.bus.subscribers, s.id)
+	delete(s.bus.internal, s.id)
+	s.once.Do(func() { close(s.events) })
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// EventHandler streams domain events to clients using Server-Sent Events
+type EventHandler struct {
+	base.BaseHandler
+	bus       *Bus
+	keepAlive time.Duration
+}
+
+// NewEventHandler creates a new event stream handler
+func NewEventHandler(bus *Bus, keepAlive time.Duration, logger *logger.Logger) *EventHandler {
+	if keepAlive <= 0 {
+		keepAlive = 15 * time.Second
+	}
+
+	return &EventHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		bus:         bus,
+		keepAlive:   keepAlive,
+	}
+}
+
+// StreamEvents streams audit/domain events in real time
+// @Summary Stream activity events
+// @Description Stream the content activity events of the current tenant using Server-Sent Events. Supports resuming via the Last-Event-ID header or last_event_id query parameter. Clients that cannot set the Authorization header, such as EventSource, may send the access token in the access_token query parameter instead, if it expires within EVENTS_QUERY_TOKEN_MAX_LIFETIME_SECONDS.
+// @Tags Events
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Param last_event_id query int false "ID of the last event received"
+// @Param types query string false "Comma separated list of event types to receive"
+// @Param access_token query string false "Access token, for clients that cannot set the Authorization header"
+// @Success 200 {object} Event "Event stream"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 429 {object} response.APIResponse "Too many connections"
+// @Router /events/stream [get]
+func (h *EventHandler) StreamEvents(c *gin.Context) {
+	lastEventID, err := parseLastEventID(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid Last-Event-ID",
+			err,
+		))
+		return
+	}
+
+	allowedTypes := parseEventTypes(c.Query("types"))
+
+	// Admins only receive the events of the tenant they are signed in to
+	tenantID := base.TenantIDFromContext(c.Request.Context())
+
+	sub, backlog, err := h.bus.Subscribe(lastEventID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	defer sub.Close()
+
+	// Streams outlive the server write timeout, so clear the deadline
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", h.keepAlive.Milliseconds())
+	for _, event := range backlog {
+		if matchesTenant(tenantID, event.TenantID) && matchesEventType(allowedTypes, event.Type) {
+			h.writeEvent(c, event)
+		}
+	}
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(h.keepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if !matchesTenant(tenantID, event.TenantID) || !matchesEventType(allowedTypes, event.Type) {
+				continue
+			}
+			h.writeEvent(c, event)
+			c.Writer.Flush()
+		case <-ticker.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent writes a single event in SSE wire format
+func (h *EventHandler) writeEvent(c *gin.Context, event Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err, errors.ErrInternal, "Failed to encode event"))
+		return
+	}
+
+	fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
+
+// parseLastEventID reads the resume position from the header or query string
+func parseLastEventID(c *gin.Context) (int64, error) {
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	if lastEventID == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(lastEventID, 10, 64)
+}
+
+// parseEventTypes parses a comma separated list of event types
+func parseEventTypes(types string) map[EventType]struct{} {
+	if types == "" {
+		return nil
+	}
+
+	allowed := make(map[EventType]struct{})
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			allowed[EventType(t)] = struct{}{}
+		}
+	}
+	return allowed
+}
+
+// matchesEventType reports whether the event type passes the filter
+func matchesEventType(allowed map[EventType]struct{}, eventType EventType) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	_, ok := allowed[eventType]
+	return ok
+}
+
+// matchesTenant reports whether an event belongs to the subscriber's tenant
+func matchesTenant(subscriber, event *uuid.UUID) bool {
+	if subscriber == nil || event == nil {
+		return subscriber == event
+	}
+	return *subscriber == *event
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies the kind of domain event being published
+type EventType string
+
+// Domain event types emitted by the content services
+const (
+	ProjectCreated EventType = "project.created"
+	ProjectUpdated EventType = "project.updated"
+	ProjectDeleted EventType = "project.deleted"
+
+	ExperienceCreated EventType = "experience.created"
+	ExperienceUpdated EventType = "experience.updated"
+	ExperienceDeleted EventType = "experience.deleted"
+
+	TechStackCreated EventType = "tech_stack.created"
+	TechStackUpdated EventType = "tech_stack.updated"
+	TechStackDeleted EventType = "tech_stack.deleted"
+
+	PageCreated EventType = "page.created"
+	PageUpdated EventType = "page.updated"
+	PageDeleted EventType = "page.deleted"
+)
+
+// Event types emitted by the background job queue
+const (
+	JobProgressed EventType = "job.progress"
+	JobSucceeded  EventType = "job.succeeded"
+	JobDead       EventType = "job.dead"
+)
+
+// Event represents a single audit/domain event
+// @Description Domain event streamed to the admin activity feed
+// @Name Event
+type Event struct {
+	ID        int64       `json:"id" example:"42"`
+	TenantID  *uuid.UUID  `json:"tenant_id,omitempty" swaggerignore:"true"`
+	Type      EventType   `json:"type" example:"project.updated"`
+	Entity    string      `json:"entity" example:"project"`
+	EntityID  string      `json:"entity_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Summary   string      `json:"summary,omitempty" example:"Updated project Portfolio Website"`
+	Payload   interface{} `json:"payload,omitempty"`
+	Timestamp time.Time   `json:"timestamp"`
+}
@@ -0,0 +1,145 @@
+package experience
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *experienceService) DryRunCreateExperiences(ctx context.Context, experiencesCreate []*ExperienceCreate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+
+	for i, experienceCreate := range experiencesCreate {
+		change := base.PlannedChange{Index: i, Action: base.ActionCreate, Name: experienceCreate.Company}
+
+		if err := validator.ValidateModel(experienceCreate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if err := validateDates(experienceCreate.StartDate, experienceCreate.EndDate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+
+		problems, err := s.referenceProblems(ctx, experienceCreate.CompanyID, experienceCreate.TechStackIds)
+		if err != nil {
+			return nil, err
+		}
+		change.Problems = append(change.Problems, problems...)
+
+		duplicates, err := s.blockedDuplicates(ctx, experienceCreate)
+		if err != nil {
+			return nil, err
+		}
+		for _, duplicate := range duplicates {
+			change.Problems = append(change.Problems, fmt.Sprintf("probable duplicate of experience %q (%s)", duplicate.Name, duplicate.ID))
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *experienceService) DryRunUpdateExperiences(ctx context.Context, experiencesUpdate []*ExperienceUpdate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+
+	for i, experienceUpdate := range experiencesUpdate {
+		change := base.PlannedChange{Index: i, Action: base.ActionUpdate, ID: experienceUpdate.ID.String(), Name: experienceUpdate.Company}
+
+		if err := validator.ValidateModel(experienceUpdate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+
+		existing, err := s.experienceRepo.FindByField(ctx, "id", experienceUpdate.ID.String())
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find experience",
+				errors.WithContext("experience_id", experienceUpdate.ID),
+			)
+		}
+		if len(existing) == 0 {
+			change.Problems = append(change.Problems, "experience does not exist")
+		} else if change.Name == "" {
+			change.Name = existing[0].Company
+		}
+
+		problems, err := s.referenceProblems(ctx, experienceUpdate.CompanyID, experienceUpdate.TechStackIds)
+		if err != nil {
+			return nil, err
+		}
+		change.Problems = append(change.Problems, problems...)
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *experienceService) DryRunDeleteExperiences(ctx context.Context, ids []string) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	seen := map[string]int{}
+
+	for i, id := range ids {
+		change := base.PlannedChange{Index: i, Action: base.ActionDelete, ID: id}
+
+		if first, ok := seen[id]; ok {
+			change.Problems = append(change.Problems, fmt.Sprintf("experience is already deleted by item %d", first))
+			dryRun.Add(change)
+			continue
+		}
+		seen[id] = i
+
+		if _, err := uuid.Parse(id); err != nil {
+			change.Problems = append(change.Problems, "invalid experience ID")
+			dryRun.Add(change)
+			continue
+		}
+
+		existing, err := s.experienceRepo.FindByField(ctx, "id", id)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find experience",
+				errors.WithContext("experience_id", id),
+			)
+		}
+		if len(existing) == 0 {
+			change.Problems = append(change.Problems, "experience does not exist")
+		} else {
+			change.Name = existing[0].Company
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+// referenceProblems reports a linked company or tech stacks that do not
+// exist
+func (s *experienceService) referenceProblems(ctx context.Context, companyID *uuid.UUID, techStackIDs []uuid.UUID) ([]string, error) {
+	var problems []string
+
+	if companyID != nil {
+		if _, err := s.companyService.GetCompanyByID(ctx, companyID.String()); err != nil {
+			if !errors.Is(err, errors.ErrNotFound) {
+				return nil, err
+			}
+			problems = append(problems, fmt.Sprintf("company %s does not exist", companyID))
+		}
+	}
+
+	missing, err := s.techStackService.MissingTechStackIDs(ctx, techStackIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, techStackID := range missing {
+		problems = append(problems, fmt.Sprintf("tech stack %s does not exist", techStackID))
+	}
+
+	return problems, nil
+}
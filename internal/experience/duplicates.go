@@ -0,0 +1,156 @@
+package experience
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// duplicateReason explains why experiences are considered duplicates
+const duplicateReason = "same role at the same company with overlapping dates"
+
+func (s *experienceService) FindDuplicateExperiences(ctx context.Context, experienceCreate *ExperienceCreate, excludeID uuid.UUID) ([]base.DuplicateMatch, error) {
+	if s.dedupMode == base.DedupOff {
+		return nil, nil
+	}
+
+	candidate := ExperienceDTO{
+		Role:      experienceCreate.Role,
+		Company:   experienceCreate.Company,
+		CompanyID: experienceCreate.CompanyID,
+		StartDate: experienceCreate.StartDate,
+		EndDate:   experienceCreate.EndDate,
+	}
+	if base.NormalizeTitle(candidate.Role) == "" {
+		return nil, nil
+	}
+
+	experiences, err := s.allExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []base.DuplicateMatch
+	for _, experience := range experiences {
+		if experience.ID != excludeID && duplicates(&candidate, &experience) {
+			matches = append(matches, duplicateMatch(&experience))
+		}
+	}
+
+	return matches, nil
+}
+
+func (s *experienceService) FindDuplicateGroups(ctx context.Context) ([]base.DuplicateGroup, error) {
+	experiences, err := s.allExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each experience joins the first group with a member it duplicates
+	groups := []base.DuplicateGroup{}
+	grouped := make([]bool, len(experiences))
+	for i := range experiences {
+		if grouped[i] {
+			continue
+		}
+
+		group := base.DuplicateGroup{Reason: duplicateReason, Items: []base.DuplicateMatch{duplicateMatch(&experiences[i])}}
+		for j := i + 1; j < len(experiences); j++ {
+			if !grouped[j] && duplicates(&experiences[i], &experiences[j]) {
+				grouped[j] = true
+				group.Items = append(group.Items, duplicateMatch(&experiences[j]))
+			}
+		}
+
+		if len(group.Items) > 1 {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+// blockedDuplicates returns the experiences that stop experienceCreate from
+// being created, when duplicates are blocked and not overridden
+func (s *experienceService) blockedDuplicates(ctx context.Context, experienceCreate *ExperienceCreate) ([]base.DuplicateMatch, error) {
+	if s.dedupMode != base.DedupBlock || experienceCreate.AllowDuplicate {
+		return nil, nil
+	}
+	return s.FindDuplicateExperiences(ctx, experienceCreate, uuid.Nil)
+}
+
+// checkDuplicates rejects an experience that duplicates an existing one
+func (s *experienceService) checkDuplicates(ctx context.Context, experienceCreate *ExperienceCreate) error {
+	duplicates, err := s.blockedDuplicates(ctx, experienceCreate)
+	if err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to check for duplicate experiences",
+		)
+	}
+
+	if len(duplicates) > 0 {
+		return errors.New(
+			errors.ErrConflict,
+			"An overlapping experience with the same role and company already exists, set allow_duplicate to create it anyway",
+			fmt.Errorf("%d probable duplicates", len(duplicates)),
+			errors.WithContext("duplicates", duplicates),
+		)
+	}
+	return nil
+}
+
+// duplicates reports whether two experiences have the same role at the
+// same company over overlapping dates
+func duplicates(a, b *ExperienceDTO) bool {
+	if base.NormalizeTitle(a.Role) != base.NormalizeTitle(b.Role) {
+		return false
+	}
+
+	sameCompany := a.CompanyID != nil && b.CompanyID != nil && *a.CompanyID == *b.CompanyID
+	if !sameCompany && base.NormalizeTitle(a.Company) != base.NormalizeTitle(b.Company) {
+		return false
+	}
+
+	return !a.StartDate.After(endOf(b.EndDate)) && !b.StartDate.After(endOf(a.EndDate))
+}
+
+// endOf returns the end of an experience, far in the future while it is
+// ongoing
+func endOf(endDate *utils.CustomDate) time.Time {
+	if endDate == nil || endDate.IsZero() {
+		return time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return endDate.Time
+}
+
+func duplicateMatch(experience *ExperienceDTO) base.DuplicateMatch {
+	return base.DuplicateMatch{
+		ID:     experience.ID.String(),
+		Name:   fmt.Sprintf("%s at %s", experience.Role, experience.Company),
+		Reason: duplicateReason,
+	}
+}
+
+// allExperiences loads every experience of the tenant
+func (s *experienceService) allExperiences(ctx context.Context) ([]ExperienceDTO, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	var all []ExperienceDTO
+	for {
+		experiences, err := s.ListExperiences(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, experiences...)
+		if len(experiences) < opts.PerPage {
+			return all, nil
+		}
+		opts.Page++
+	}
+}
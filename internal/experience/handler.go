@@ -35,6 +35,7 @@ func NewExperienceHandler(experienceService ExperienceService, logger *logger.Lo
 // @Param payload formData string true "Experience Details in JSON format (See ExperienceCreate Model)"
 // @Success 200 {object} response.APIResponse{data=Experience} "Experience created successfully"
 // @Failure 400 {object} response.APIResponse{data=ExperienceCreate} "Bad Request"
+// @Failure 409 {object} response.APIResponse "Probable duplicate of an existing experience"
 // @Failure 500 {object} response.APIResponse "Internal Server Error"
 // @Router /experiences [post]
 func (h *ExperienceHandler) CreateExperience(c *gin.Context) {
@@ -85,7 +86,8 @@ func (h *ExperienceHandler) CreateExperience(c *gin.Context) {
 		return
 	}
 
-	h.HandleSuccess(c, experience, "Experience created successfully")
+	duplicates, err := h.experienceService.FindDuplicateExperiences(c.Request.Context(), &experienceInput, experience.ID)
+	h.HandleSuccess(c, experience, "Experience created successfully", h.DuplicateWarning(duplicates, err)...)
 }
 
 // GetExperienceByID retrieves a specific experience
@@ -94,7 +96,7 @@ func (h *ExperienceHandler) CreateExperience(c *gin.Context) {
 // @Tags Experiences
 // @Produce json
 // @Param id path string true "Experience ID"
-// @Success 200 {object} response.APIResponse{data=Experience} "Experience retrieved successfully"
+// @Success 200 {object} response.APIResponse{data=PublicExperience} "Experience retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Experience not found"
 // @Router /experiences/{id} [get]
@@ -204,6 +206,7 @@ func (h *ExperienceHandler) UpdateExperience(c *gin.Context) {
 // @Tags Experiences
 // @Produce json
 // @Param id path string true "Experience ID"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
 // @Success 200 {object} response.APIResponse "Experience deleted successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Experience not found"
@@ -219,7 +222,22 @@ func (h *ExperienceHandler) DeleteExperience(c *gin.Context) {
 		return
 	}
 
-	err := h.experienceService.DeleteExperience(c.Request.Context(), experienceID)
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.experienceService.DryRunDeleteExperiences(c.Request.Context(), []string{experienceID})
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	err = h.experienceService.DeleteExperience(c.Request.Context(), experienceID)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -228,6 +246,42 @@ func (h *ExperienceHandler) DeleteExperience(c *gin.Context) {
 	h.HandleSuccess(c, nil, "Experience deleted successfully")
 }
 
+// GetTimeline retrieves the experience timeline
+// @Summary Get the experience timeline
+// @Description Retrieve experiences grouped by company with computed durations, overlapping roles and gaps between roles
+// @Tags Experiences
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=Timeline} "Experience timeline retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /experiences/timeline [get]
+func (h *ExperienceHandler) GetTimeline(c *gin.Context) {
+	timeline, err := h.experienceService.GetTimeline(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, timeline, "Experience timeline retrieved successfully")
+}
+
+// GetSummary retrieves the experience summary
+// @Summary Get the experience summary
+// @Description Retrieve the total time worked along with the time spent at every company and with every tech stack, counting overlapping roles once and ongoing roles until today
+// @Tags Experiences
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=Summary} "Experience summary retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /experiences/summary [get]
+func (h *ExperienceHandler) GetSummary(c *gin.Context) {
+	summary, err := h.experienceService.GetSummary(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, summary, "Experience summary retrieved successfully")
+}
+
 // ListExperiences retrieves a paginated list of experience
 // @Summary List experiences
 // @Description Retrieve a paginated list of experiences with optional filtering
@@ -237,7 +291,7 @@ func (h *ExperienceHandler) DeleteExperience(c *gin.Context) {
 // @Param per_page query int false "Items per page" default(10)
 // @Param company query string false "Filter by company name"
 // @Param is_featured query string false "Filter by featured status"
-// @Success 200 {object} response.APIResponse{data=[]Experience} "Experiences retrieved successfully"
+// @Success 200 {object} response.APIResponse{data=[]PublicExperience} "Experiences retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /experiences [get]
 func (h *ExperienceHandler) ListExperiences(c *gin.Context) {
@@ -290,6 +344,58 @@ func (h *ExperienceHandler) ListExperiences(c *gin.Context) {
 		response.WithPagination(total, opts.Page, opts.PerPage))
 }
 
+// ListCompanyExperiences retrieves the experiences at a company
+// @Summary List experiences at a company
+// @Description Retrieve a paginated list of the experiences linked to a company
+// @Tags Companies
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]PublicExperience} "Company experiences retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /companies/{id}/experiences [get]
+func (h *ExperienceHandler) ListCompanyExperiences(c *gin.Context) {
+	companyID := c.Param("id")
+	if _, err := h.ValidateUUID(companyID, "Company ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Parse pagination options
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	opts.Filters = append(opts.Filters, base.FilterOption{
+		Field:    "company_id",
+		Operator: base.OperatorEqual,
+		Value:    companyID,
+	})
+
+	experiences, err := h.experienceService.ListExperiences(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total experiences for pagination
+	total, err := h.experienceService.CountExperiences(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, experiences, "Company experiences retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
 // SearchExperiences performs a full-text search on experience
 // @Summary Search experiences
 // @Description Perform a full-text search on experiences with pagination
@@ -298,7 +404,7 @@ func (h *ExperienceHandler) ListExperiences(c *gin.Context) {
 // @Param query query string true "Search query"
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
-// @Success 200 {object} response.APIResponse{data=[]Experience} "Experiences search completed successfully"
+// @Success 200 {object} response.APIResponse{data=[]PublicExperience} "Experiences search completed successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /experiences/search [get]
 func (h *ExperienceHandler) SearchExperiences(c *gin.Context) {
@@ -335,7 +441,10 @@ func (h *ExperienceHandler) SearchExperiences(c *gin.Context) {
 // @Param logo_image formData []file false "Logo Image"
 // @Param images formData []file false "Experience Images"
 // @Param payload formData string true "Experience Details in JSON array format (See ExperienceCreate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]Experience} "Experiences created successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Experiences processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]ExperienceCreate} "Bad Request"
 // @Failure 500 {object} response.APIResponse "Internal Server Error"
 // @Router /experiences/bulk [post]
@@ -376,6 +485,32 @@ func (h *ExperienceHandler) BulkCreateExperiences(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.experienceService.DryRunCreateExperiences(c.Request.Context(), experiencesInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.experienceService.BulkCreateExperiencesPartial(c.Request.Context(), experiencesInput)
+		h.HandleMultiStatus(c, result, "Experiences processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk create experience
 	experience, err := h.experienceService.BulkCreateExperiences(c.Request.Context(), experiencesInput)
 	if err != nil {
@@ -393,7 +528,10 @@ func (h *ExperienceHandler) BulkCreateExperiences(c *gin.Context) {
 // @Param logo_image formData file false "Logo Image"
 // @Param images formData file false "Experience Images"
 // @Param payload formData string true "Experience Details in JSON array format (See ExperienceUpdate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]Experience} "Experiences updated successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Experiences processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]ExperienceUpdate} "Bad Request"
 // @Failure 500 {object} response.APIResponse "Internal Server Error"
 // @Router /experiences/bulk [put]
@@ -434,6 +572,32 @@ func (h *ExperienceHandler) BulkUpdateExperiences(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.experienceService.DryRunUpdateExperiences(c.Request.Context(), experiencesInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.experienceService.BulkUpdateExperiencesPartial(c.Request.Context(), experiencesInput)
+		h.HandleMultiStatus(c, result, "Experiences processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk update experience
 	updatedExperiences, err := h.experienceService.BulkUpdateExperiences(c.Request.Context(), experiencesInput)
 	if err != nil {
@@ -451,14 +615,17 @@ func (h *ExperienceHandler) BulkUpdateExperiences(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param ids body []string true "Experience IDs to delete"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse "Experiences deleted successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Experiences processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /experiences/bulk [delete]
 func (h *ExperienceHandler) BulkDeleteExperiences(c *gin.Context) {
 	var idsInput []string
 
 	// Bind input
-	if err := c.ShouldBindJSON(&idsInput); err != nil {
+	if err := h.BindJSON(c, &idsInput); err != nil {
 		h.HandleError(c, errors.New(
 			errors.ErrValidation,
 			"Invalid input",
@@ -467,8 +634,34 @@ func (h *ExperienceHandler) BulkDeleteExperiences(c *gin.Context) {
 		return
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.experienceService.DryRunDeleteExperiences(c.Request.Context(), idsInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.experienceService.BulkDeleteExperiencesPartial(c.Request.Context(), idsInput)
+		h.HandleMultiStatus(c, result, "Experiences processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk delete experience
-	err := h.experienceService.BulkDeleteExperiences(c.Request.Context(), idsInput)
+	err = h.experienceService.BulkDeleteExperiences(c.Request.Context(), idsInput)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -0,0 +1,57 @@
+package experience
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *experienceService) SetImageAlt(ctx context.Context, id string, src string, alt string) (*ExperienceDTO, error) {
+	experience, err := s.GetExperienceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, imageURL := range experience.ImagesUrl {
+		if imageURL == src {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Experience has no image with this source",
+			nil,
+			errors.WithContext("experience_id", id),
+			errors.WithContext("src", src),
+		)
+	}
+
+	alts := make(map[string]string, len(experience.ImageAlts)+1)
+	for imageURL, imageAlt := range experience.ImageAlts {
+		alts[imageURL] = imageAlt
+	}
+	alts[src] = alt
+
+	if err := s.experienceRepo.SetImageAlts(ctx, id, alts); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	experience.ImageAlts = alts
+	experience.UpdatedAt = &now
+
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.ExperienceUpdated,
+		Entity:   "experience",
+		EntityID: experience.ID.String(),
+		Summary:  fmt.Sprintf("Updated image alt text of experience at %s", experience.Company),
+	})
+
+	return experience, nil
+}
@@ -0,0 +1,87 @@
+package experience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+)
+
+type memoryExperienceRepository struct {
+	*base.MemoryRepository[Experience, ExperienceDTO]
+	mu         sync.RWMutex
+	links      []ExperienceTechStack
+	techStacks tech_stack.TechStackRepository
+}
+
+// NewMemoryExperienceRepository creates an experience repository kept in
+// memory, for development without a database. Tech stack names are looked
+// up in techStacks when experiences are read.
+func NewMemoryExperienceRepository(experiences []Experience, links []ExperienceTechStack, techStacks tech_stack.TechStackRepository) ExperienceRepository {
+	r := &memoryExperienceRepository{
+		links:      append([]ExperienceTechStack(nil), links...),
+		techStacks: techStacks,
+	}
+	r.MemoryRepository = base.NewMemoryRepository(experiences,
+		func(e *Experience) string { return e.ID.String() },
+		r.toDTO,
+		"role", "company",
+	)
+	return r
+}
+
+func (r *memoryExperienceRepository) CreateExperienceTechStack(ctx context.Context, experienceTechStack *ExperienceTechStack) (*ExperienceTechStack, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.links = append(r.links, *experienceTechStack)
+	return experienceTechStack, nil
+}
+
+func (r *memoryExperienceRepository) DeleteExperienceTechStack(ctx context.Context, experienceID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.links[:0]
+	for _, link := range r.links {
+		if link.ExperienceID.String() != experienceID {
+			kept = append(kept, link)
+		}
+	}
+	r.links = kept
+	return nil
+}
+
+func (r *memoryExperienceRepository) SetImageAlts(ctx context.Context, id string, alts map[string]string) error {
+	r.Modify(ctx, id, func(e *Experience) {
+		now := time.Now().UTC()
+		e.ImageAlts = alts
+		e.UpdatedAt = &now
+	})
+	return nil
+}
+
+// toDTO embeds the id and name of the experience tech stacks, as the REST
+// API does
+func (r *memoryExperienceRepository) toDTO(e Experience) ExperienceDTO {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	experienceTechStack := []ExperienceTechStackDTO{}
+	for _, link := range r.links {
+		if link.ExperienceID != e.ID {
+			continue
+		}
+
+		dto := ExperienceTechStackDTO{ExperienceID: link.ExperienceID, TechStackID: link.TechStackID}
+		techStacks, _ := r.techStacks.FindByField(context.Background(), "id", link.TechStackID)
+		if len(techStacks) > 0 {
+			dto.TechStack = tech_stack.TechStack{ID: techStacks[0].ID, Name: techStacks[0].Name}
+		}
+		experienceTechStack = append(experienceTechStack, dto)
+	}
+
+	return e.ToDTO(experienceTechStack)
+}
@@ -16,7 +16,8 @@ import (
 type Experience struct {
 	// Identification
 	// @Description Unique identifier for the experience
-	ID uuid.UUID `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
 
 	// Job Details
 	// @Description Job role and company information
@@ -25,6 +26,9 @@ type Experience struct {
 	LogoUrl string `json:"logo_url" db:"logo_url" example:"https://example.com/company-logo.png"`
 	JobType string `json:"job_type" db:"job_type" example:"Full-time"`
 
+	// @Description Company the experience belongs to
+	CompanyID *uuid.UUID `json:"company_id,omitempty" db:"company_id" example:"750e8400-e29b-41d4-a716-446655440000"`
+
 	// Timing and Location
 	// @Description Job timing and location details
 	StartDate   utils.CustomDate  `json:"start_date" db:"start_date" validate:"required" example:"2020-01-15" swaggertype:"string"`
@@ -37,6 +41,12 @@ type Experience struct {
 	WorkDescription string   `json:"work_description" db:"work_description" example:"Led development of scalable web applications"`
 	Impact          []string `json:"impact" db:"impact" pg:"array" example:"Increased system performance by 40%"`
 	ImagesUrl       []string `json:"images_url" db:"images_url" pg:"array" example:"https://example.com/project1.png"`
+	// ImagePlaceholders maps image URLs to tiny copies of the images as data
+	// URIs, shown while the images load
+	ImagePlaceholders map[string]string `json:"image_placeholders,omitempty" db:"image_placeholders"`
+	// ImageAlts maps image URLs to the text describing the images to people
+	// who cannot see them
+	ImageAlts map[string]string `json:"image_alts,omitempty" db:"image_alts"`
 
 	// Metadata
 	// @Description Additional metadata for the experience
@@ -88,6 +98,9 @@ type ExperienceDTO struct {
 	LogoUrl string `json:"logo_url" db:"logo_url" example:"https://example.com/company-logo.png"`
 	JobType string `json:"job_type" db:"job_type" example:"Full-time"`
 
+	// @Description Company the experience belongs to
+	CompanyID *uuid.UUID `json:"company_id,omitempty" db:"company_id" example:"750e8400-e29b-41d4-a716-446655440000"`
+
 	// Timing and Location
 	// @Description Job timing and location details
 	StartDate   utils.CustomDate  `json:"start_date" db:"start_date" validate:"required" example:"2020-01-15" swaggertype:"string"`
@@ -100,6 +113,12 @@ type ExperienceDTO struct {
 	WorkDescription string   `json:"work_description" db:"work_description" example:"Led development of scalable web applications"`
 	Impact          []string `json:"impact" db:"impact" pg:"array" example:"Increased system performance by 40%"`
 	ImagesUrl       []string `json:"images_url" db:"images_url" pg:"array" example:"https://example.com/project1.png"`
+	// ImagePlaceholders maps image URLs to tiny copies of the images as data
+	// URIs, shown while the images load
+	ImagePlaceholders map[string]string `json:"image_placeholders,omitempty" db:"image_placeholders"`
+	// ImageAlts maps image URLs to the text describing the images to people
+	// who cannot see them
+	ImageAlts map[string]string `json:"image_alts,omitempty" db:"image_alts"`
 
 	// Metadata
 	// @Description Additional metadata for the experience
@@ -129,6 +148,9 @@ type ExperienceCreate struct {
 	// @Format string
 	Company string `json:"company" example:"Tech Innovations Inc."`
 
+	// @Description ID of an existing company, takes precedence over the company name
+	CompanyID *uuid.UUID `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+
 	// @Description Logo image file
 	LogoImage *multipart.FileHeader `json:"logo_image" swaggerignore:"true"`
 
@@ -164,6 +186,9 @@ type ExperienceCreate struct {
 
 	// @Description Flag to mark as featured experience
 	IsFeatured bool `json:"is_featured" example:"true"`
+
+	// @Description Create the experience even when an overlapping one with the same role and company exists and duplicates are blocked
+	AllowDuplicate bool `json:"allow_duplicate,omitempty" example:"false"`
 }
 
 // ExperienceUpdate represents the input for updating an existing experience
@@ -186,6 +211,9 @@ type ExperienceUpdate struct {
 	// @Format string
 	Company string `json:"company" example:"Tech Innovations Inc."`
 
+	// @Description ID of an existing company, takes precedence over the company name
+	CompanyID *uuid.UUID `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+
 	// @Description Logo image file
 	LogoImage *multipart.FileHeader `json:"logo_image" swaggerignore:"true"`
 
@@ -201,6 +229,9 @@ type ExperienceUpdate struct {
 	// @Format date
 	EndDate *utils.CustomDate `json:"end_date" example:"2023-06-30" swaggertype:"string"`
 
+	// @Description Mark the job as ongoing, clearing its end date
+	Present bool `json:"present,omitempty" example:"false"`
+
 	// @Description Job location
 	// @Format string
 	Location string `json:"location" example:"San Francisco, CA"`
@@ -231,6 +262,7 @@ func (e *Experience) ToDTO(experienceTechStack []ExperienceTechStackDTO) Experie
 		ID:                  e.ID,
 		Role:                e.Role,
 		Company:             e.Company,
+		CompanyID:           e.CompanyID,
 		LogoUrl:             e.LogoUrl,
 		JobType:             e.JobType,
 		StartDate:           e.StartDate,
@@ -240,6 +272,8 @@ func (e *Experience) ToDTO(experienceTechStack []ExperienceTechStackDTO) Experie
 		WorkDescription:     e.WorkDescription,
 		Impact:              e.Impact,
 		ImagesUrl:           e.ImagesUrl,
+		ImagePlaceholders:   e.ImagePlaceholders,
+		ImageAlts:           e.ImageAlts,
 		IsFeatured:          e.IsFeatured,
 		CreatedAt:           e.CreatedAt,
 		UpdatedAt:           e.UpdatedAt,
@@ -254,6 +288,7 @@ func (ec *ExperienceCreate) ToExperience() Experience {
 	return Experience{
 		Role:            ec.Role,
 		Company:         ec.Company,
+		CompanyID:       ec.CompanyID,
 		LogoUrl:         "", // Will be set during file upload
 		JobType:         ec.JobType,
 		StartDate:       ec.StartDate,
@@ -275,6 +310,7 @@ func (eu *ExperienceUpdate) ToExperience() Experience {
 		ID:              eu.ID,
 		Role:            eu.Role,
 		Company:         eu.Company,
+		CompanyID:       eu.CompanyID,
 		LogoUrl:         "", // Will be set during file upload
 		JobType:         eu.JobType,
 		StartDate:       eu.StartDate,
@@ -0,0 +1,74 @@
+package experience
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *experienceService) TechStackReferences(ctx context.Context) ([]tech_stack.Reference, error) {
+	experiences, err := s.allExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []tech_stack.Reference
+	for _, experience := range experiences {
+		for _, link := range experience.ExperienceTechStack {
+			references = append(references, tech_stack.Reference{
+				Entity:      "experience",
+				EntityID:    experience.ID,
+				EntityName:  experience.Company,
+				TechStackID: link.TechStackID,
+			})
+		}
+	}
+	return references, nil
+}
+
+func (s *experienceService) UnlinkTechStacks(ctx context.Context, techStackIDs []uuid.UUID) error {
+	unlinked := make(map[uuid.UUID]bool, len(techStackIDs))
+	for _, id := range techStackIDs {
+		unlinked[id] = true
+	}
+
+	experiences, err := s.allExperiences(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, experience := range experiences {
+		var kept []uuid.UUID
+		for _, link := range experience.ExperienceTechStack {
+			if !unlinked[link.TechStackID] {
+				kept = append(kept, link.TechStackID)
+			}
+		}
+		if len(kept) == len(experience.ExperienceTechStack) {
+			continue
+		}
+
+		// Links are replaced as a whole, as updating an experience does
+		if err := s.experienceRepo.DeleteExperienceTechStack(ctx, experience.ID.String()); err != nil {
+			return errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to delete experience tech stack",
+				errors.WithContext("experience_id", experience.ID),
+			)
+		}
+		for _, techStackID := range kept {
+			if _, err := s.experienceRepo.CreateExperienceTechStack(ctx, &ExperienceTechStack{ExperienceID: experience.ID, TechStackID: techStackID}); err != nil {
+				return errors.Wrap(err,
+					errors.ErrDatabase,
+					"Failed to create experience tech stack",
+					errors.WithContext("experience_id", experience.ID),
+					errors.WithContext("tech_stack_id", techStackID),
+				)
+			}
+		}
+	}
+
+	return nil
+}
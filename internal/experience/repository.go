@@ -3,9 +3,11 @@ package experience
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
 	postgrest "github.com/supabase-community/postgrest-go"
 )
@@ -14,15 +16,16 @@ type ExperienceRepository interface {
 	base.BaseRepository[Experience, ExperienceDTO]
 	CreateExperienceTechStack(ctx context.Context, experienceTechStack *ExperienceTechStack) (*ExperienceTechStack, error)
 	DeleteExperienceTechStack(ctx context.Context, experienceID string) error
+	SetImageAlts(ctx context.Context, id string, alts map[string]string) error
 }
 
 type experienceRepository struct {
 	supabaseClient *supabase.SupabaseClient
-	storage        supabase.SupabaseStorage
+	storage        storage.Storage
 	table          string
 }
 
-func NewExperienceRepository(supabaseClient *supabase.SupabaseClient, storage supabase.SupabaseStorage) ExperienceRepository {
+func NewExperienceRepository(supabaseClient *supabase.SupabaseClient, storage storage.Storage) ExperienceRepository {
 	return &experienceRepository{
 		supabaseClient: supabaseClient,
 		storage:        storage,
@@ -31,6 +34,7 @@ func NewExperienceRepository(supabaseClient *supabase.SupabaseClient, storage su
 }
 
 func (r *experienceRepository) Create(ctx context.Context, experience *Experience) (*Experience, error) {
+	experience.TenantID = base.TenantIDFromContext(ctx)
 	_, _, err := r.supabaseClient.GetClient().
 		From(r.table).
 		Insert(experience, false, "", "minimal", "").
@@ -53,11 +57,12 @@ func (r *experienceRepository) CreateExperienceTechStack(ctx context.Context, ex
 }
 
 func (r *experienceRepository) Update(ctx context.Context, experience *Experience) (*Experience, error) {
-	_, _, err := r.supabaseClient.GetClient().
+	experience.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Update(experience, "minimal", "").
-		Eq("id", experience.ID.String()).
-		Execute()
+		Eq("id", experience.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update experience")
 	}
@@ -65,11 +70,11 @@ func (r *experienceRepository) Update(ctx context.Context, experience *Experienc
 }
 
 func (r *experienceRepository) Delete(ctx context.Context, id string) error {
-	_, _, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Delete("minimal", "").
-		Eq("id", id).
-		Execute()
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return errors.Wrap(err, errors.ErrDatabase, "failed to delete experience")
 	}
@@ -93,6 +98,7 @@ func (r *experienceRepository) List(ctx context.Context, opts base.ListOptions)
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, experience_tech_stack(tech_stack_id, tech_stack(id, name))", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -138,6 +144,7 @@ func (r *experienceRepository) Count(ctx context.Context, filters []base.FilterO
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range filters {
@@ -158,12 +165,12 @@ func (r *experienceRepository) Count(ctx context.Context, filters []base.FilterO
 }
 
 func (r *experienceRepository) Exists(ctx context.Context, id string) (bool, error) {
-	_, count, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true).
 		Eq("id", id).
-		Limit(1, "").
-		Execute()
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
 
 	if err != nil {
 		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check experience existence")
@@ -174,11 +181,11 @@ func (r *experienceRepository) Exists(ctx context.Context, id string) (bool, err
 
 func (r *experienceRepository) FindByField(ctx context.Context, field string, value interface{}) ([]ExperienceDTO, error) {
 	var experience []ExperienceDTO
-	_, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, experience_tech_stack(tech_stack_id, tech_stack(id, name))", "", false).
-		Eq(field, fmt.Sprintf("%v", value)).
-		ExecuteTo(&experience)
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&experience)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find experience by field")
 	}
@@ -190,6 +197,7 @@ func (r *experienceRepository) Search(ctx context.Context, opts base.ListOptions
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, experience_tech_stack(tech_stack_id, tech_stack(id, name))", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -236,3 +244,18 @@ func (r *experienceRepository) Search(ctx context.Context, opts base.ListOptions
 
 	return experience, count, nil
 }
+
+func (r *experienceRepository) SetImageAlts(ctx context.Context, id string, alts map[string]string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"image_alts": alts,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update experience image alt text")
+	}
+	return nil
+}
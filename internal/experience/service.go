@@ -4,16 +4,19 @@ import (
 	"context"
 	"fmt"
 	"mime/multipart"
+	"net/http"
 	"path/filepath"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/company"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
 	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
 	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
-	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
-	storage_go "github.com/supabase-community/storage-go"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 )
 
 type ExperienceService interface {
@@ -22,24 +25,57 @@ type ExperienceService interface {
 	UpdateExperience(ctx context.Context, experienceUpdate *ExperienceUpdate) (*ExperienceDTO, error)
 	DeleteExperience(ctx context.Context, id string) error
 	ListExperiences(ctx context.Context, opts base.ListOptions) ([]ExperienceDTO, error)
+	GetTimeline(ctx context.Context) (*Timeline, error)
+	GetSummary(ctx context.Context) (*Summary, error)
 	CountExperiences(ctx context.Context, filters []base.FilterOption) (int, error)
 	SearchExperiences(ctx context.Context, opts base.ListOptions) ([]ExperienceDTO, int, error)
 	BulkCreateExperiences(ctx context.Context, experiencesCreate []*ExperienceCreate) ([]ExperienceDTO, error)
 	BulkUpdateExperiences(ctx context.Context, experiencesUpdate []*ExperienceUpdate) ([]ExperienceDTO, error)
 	BulkDeleteExperiences(ctx context.Context, ids []string) error
+	// BulkCreateExperiencesPartial, BulkUpdateExperiencesPartial and BulkDeleteExperiencesPartial
+	// write every item independently, carrying on past failures and
+	// reporting the outcome of each
+	BulkCreateExperiencesPartial(ctx context.Context, experiencesCreate []*ExperienceCreate) *base.BulkResult
+	BulkUpdateExperiencesPartial(ctx context.Context, experiencesUpdate []*ExperienceUpdate) *base.BulkResult
+	BulkDeleteExperiencesPartial(ctx context.Context, ids []string) *base.BulkResult
+	// DryRunCreateExperiences, DryRunUpdateExperiences and
+	// DryRunDeleteExperiences validate a bulk write, including missing company
+	// and tech stack references, without writing anything
+	DryRunCreateExperiences(ctx context.Context, experiencesCreate []*ExperienceCreate) (*base.DryRun, error)
+	DryRunUpdateExperiences(ctx context.Context, experiencesUpdate []*ExperienceUpdate) (*base.DryRun, error)
+	DryRunDeleteExperiences(ctx context.Context, ids []string) (*base.DryRun, error)
+	// FindDuplicateExperiences returns the experiences other than excludeID
+	// with the same role at the same company over overlapping dates, or none
+	// when duplicate checks are off
+	FindDuplicateExperiences(ctx context.Context, experienceCreate *ExperienceCreate, excludeID uuid.UUID) ([]base.DuplicateMatch, error)
+	// FindDuplicateGroups groups existing experiences that duplicate each other
+	FindDuplicateGroups(ctx context.Context) ([]base.DuplicateGroup, error)
+	// Referrer lets tech stacks linked from here be checked and unlinked
+	// before they are deleted
+	tech_stack.Referrer
+	// SetImageAlt sets the alt text of the experience image stored at src
+	SetImageAlt(ctx context.Context, id string, src string, alt string) (*ExperienceDTO, error)
 }
 
 type experienceService struct {
 	experienceRepo   ExperienceRepository
 	techStackService tech_stack.TechStackService
-	storage          supabase.SupabaseStorage
+	companyService   company.CompanyService
+	storage          storage.Storage
+	assets           asset.AssetService
+	publisher        events.Publisher
+	dedupMode        base.DedupMode
 }
 
-func NewExperienceService(experienceRepo ExperienceRepository, techStackService tech_stack.TechStackService, storage supabase.SupabaseStorage) ExperienceService {
+func NewExperienceService(experienceRepo ExperienceRepository, techStackService tech_stack.TechStackService, companyService company.CompanyService, storage storage.Storage, assets asset.AssetService, publisher events.Publisher, dedupMode base.DedupMode) ExperienceService {
 	return &experienceService{
 		experienceRepo:   experienceRepo,
 		techStackService: techStackService,
+		companyService:   companyService,
 		storage:          storage,
+		assets:           assets,
+		publisher:        publisher,
+		dedupMode:        dedupMode,
 	}
 }
 
@@ -53,28 +89,32 @@ func (s *experienceService) CreateExperience(ctx context.Context, experienceCrea
 		)
 	}
 
+	if err := s.checkDuplicates(ctx, experienceCreate); err != nil {
+		return nil, err
+	}
+
+	if err := validateDates(experienceCreate.StartDate, experienceCreate.EndDate); err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 	experience := experienceCreate.ToExperience()
 	experience.ID = uuid.New()
 	experience.CreatedAt = &now
 	experience.UpdatedAt = &now
 
-	// Upload logo if provided
-	if experienceCreate.LogoImage != nil {
-		logoURL, err := s.uploadExperienceLogo(ctx, experience.ID.String(), experienceCreate.LogoImage)
-		if err != nil {
-			return nil, errors.Wrap(err,
-				errors.ErrInternal,
-				"Failed to upload experience logo",
-				errors.WithContext("experience_id", experience.ID),
-			)
-		}
-		experience.LogoUrl = logoURL
+	// Link the company, storing the logo on it if provided
+	if err := s.resolveCompany(ctx, &experience, experienceCreate.CompanyID, experienceCreate.LogoImage); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to resolve experience company",
+			errors.WithContext("experience_id", experience.ID),
+		)
 	}
 
 	// Upload images if provided
 	if len(experienceCreate.Images) > 0 {
-		imageURLs, err := s.uploadExperienceImages(ctx, experience.ID.String(), experienceCreate.Images)
+		imageURLs, placeholders, err := s.uploadExperienceImages(ctx, experience.ID.String(), experienceCreate.Images)
 		if err != nil {
 			return nil, errors.Wrap(err,
 				errors.ErrInternal,
@@ -84,6 +124,7 @@ func (s *experienceService) CreateExperience(ctx context.Context, experienceCrea
 		}
 
 		experience.ImagesUrl = imageURLs
+		experience.ImagePlaceholders = placeholders
 	}
 
 	// Create experience in repository
@@ -134,6 +175,13 @@ func (s *experienceService) CreateExperience(ctx context.Context, experienceCrea
 
 	createdExperienceDTO := createdExperience.ToDTO(experienceTechStack)
 
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.ExperienceCreated,
+		Entity:   "experience",
+		EntityID: createdExperience.ID.String(),
+		Summary:  fmt.Sprintf("Added experience at %s", createdExperience.Company),
+	})
+
 	return &createdExperienceDTO, nil
 }
 
@@ -152,7 +200,12 @@ func (s *experienceService) GetExperienceByID(ctx context.Context, id string) (*
 	}
 
 	if len(experiences) == 0 {
-		return nil, fmt.Errorf("experience with ID %s not found", id)
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Experience not found",
+			nil,
+			errors.WithContext("experience_id", id),
+		)
 	}
 
 	return &experiences[0], nil
@@ -173,7 +226,7 @@ func (s *experienceService) UpdateExperience(ctx context.Context, experienceUpda
 	existingExperience, err := s.GetExperienceByID(ctx, experienceUpdate.ID.String())
 	if err != nil {
 		return nil, errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing experience",
 			errors.WithContext("experience_id", experienceUpdate.ID),
 		)
@@ -200,6 +253,12 @@ func (s *experienceService) UpdateExperience(ctx context.Context, experienceUpda
 	if !validator.IsValueChanged(&existingExperience.EndDate, &experience.EndDate) {
 		experience.EndDate = existingExperience.EndDate
 	}
+	if experienceUpdate.Present {
+		experience.EndDate = nil
+	}
+	if err := validateDates(experience.StartDate, experience.EndDate); err != nil {
+		return nil, err
+	}
 	if !validator.IsValueChanged(&existingExperience.Location, &experience.Location) {
 		experience.Location = existingExperience.Location
 	}
@@ -214,23 +273,28 @@ func (s *experienceService) UpdateExperience(ctx context.Context, experienceUpda
 	}
 	experience.LogoUrl = existingExperience.LogoUrl
 	experience.ImagesUrl = existingExperience.ImagesUrl
-
-	// Upload logo if provided
-	if experienceUpdate.LogoImage != nil {
-		logoURL, err := s.uploadExperienceLogo(ctx, experience.ID.String(), experienceUpdate.LogoImage)
-		if err != nil {
-			return nil, errors.Wrap(err,
-				errors.ErrInternal,
-				"Failed to upload experience logo",
-				errors.WithContext("experience_id", experience.ID),
-			)
-		}
-		experience.LogoUrl = logoURL
+	experience.ImagePlaceholders = existingExperience.ImagePlaceholders
+	experience.ImageAlts = existingExperience.ImageAlts
+
+	// Relink the company when it changes, storing the logo on it if provided
+	companyID := experienceUpdate.CompanyID
+	if companyID == nil && experience.Company == existingExperience.Company {
+		companyID = existingExperience.CompanyID
+	}
+	if companyID != nil && existingExperience.CompanyID != nil &&
+		*companyID == *existingExperience.CompanyID && experienceUpdate.LogoImage == nil {
+		experience.CompanyID = existingExperience.CompanyID
+	} else if err := s.resolveCompany(ctx, &experience, companyID, experienceUpdate.LogoImage); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to resolve experience company",
+			errors.WithContext("experience_id", experience.ID),
+		)
 	}
 
 	// Upload images if provided
 	if len(experienceUpdate.Images) > 0 {
-		imageURLs, err := s.uploadExperienceImages(ctx, experience.ID.String(), experienceUpdate.Images)
+		imageURLs, placeholders, err := s.uploadExperienceImages(ctx, experience.ID.String(), experienceUpdate.Images)
 		if err != nil {
 			return nil, errors.Wrap(err,
 				errors.ErrInternal,
@@ -240,6 +304,7 @@ func (s *experienceService) UpdateExperience(ctx context.Context, experienceUpda
 		}
 
 		experience.ImagesUrl = imageURLs
+		experience.ImagePlaceholders = placeholders
 	}
 
 	// Update experience in repository
@@ -300,6 +365,13 @@ func (s *experienceService) UpdateExperience(ctx context.Context, experienceUpda
 
 	updatedExperienceDTO := updatedExperience.ToDTO(experienceTechStack)
 
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.ExperienceUpdated,
+		Entity:   "experience",
+		EntityID: updatedExperience.ID.String(),
+		Summary:  fmt.Sprintf("Updated experience at %s", updatedExperience.Company),
+	})
+
 	return &updatedExperienceDTO, nil
 }
 
@@ -316,7 +388,7 @@ func (s *experienceService) DeleteExperience(ctx context.Context, id string) err
 	existingExperience, err := s.GetExperienceByID(ctx, id)
 	if err != nil {
 		return errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing experience",
 			errors.WithContext("experience_id", id),
 		)
@@ -342,8 +414,8 @@ func (s *experienceService) DeleteExperience(ctx context.Context, id string) err
 	// Delete associated images if exists
 	for _, imageUrl := range existingExperience.ImagesUrl {
 		if imageUrl != "" {
-			imagePath := filepath.Join(fmt.Sprintf("itsrama/images/experience/%s/", existingExperience.ID), filepath.Base(imageUrl))
-			_, err = s.storage.Delete(ctx, imagePath)
+			imagePath := filepath.Join("itsrama", base.TenantStoragePath(ctx, fmt.Sprintf("images/experience/%s", existingExperience.ID)), filepath.Base(imageUrl))
+			err = s.storage.Delete(ctx, imagePath)
 			if err != nil {
 				// Log the error but don't return it to avoid blocking the deletion
 				fmt.Printf("Failed to delete experience image: %v\n", err)
@@ -351,14 +423,14 @@ func (s *experienceService) DeleteExperience(ctx context.Context, id string) err
 		}
 	}
 
-	if existingExperience.LogoUrl != "" {
-		imagePath := filepath.Join("itsrama/images/experience/logos/", filepath.Base(existingExperience.LogoUrl))
-		_, err = s.storage.Delete(ctx, imagePath)
-		if err != nil {
-			// Log the error but don't return it to avoid blocking the deletion
-			fmt.Printf("Failed to delete experience logo: %v\n", err)
-		}
-	}
+	// The logo belongs to the company and is kept for its other experiences
+
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.ExperienceDeleted,
+		Entity:   "experience",
+		EntityID: id,
+		Summary:  fmt.Sprintf("Deleted experience at %s", existingExperience.Company),
+	})
 
 	return nil
 }
@@ -385,6 +457,48 @@ func (s *experienceService) ListExperiences(ctx context.Context, opts base.ListO
 	return experiences, nil
 }
 
+// GetTimeline returns every experience normalized into a timeline
+func (s *experienceService) GetTimeline(ctx context.Context) (*Timeline, error) {
+	experiences, err := s.listAllExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := BuildTimeline(experiences, time.Now().UTC())
+	return &timeline, nil
+}
+
+// GetSummary totals the time spent at every company and with every tech stack
+func (s *experienceService) GetSummary(ctx context.Context) (*Summary, error) {
+	experiences, err := s.listAllExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := BuildSummary(experiences, time.Now().UTC())
+	return &summary, nil
+}
+
+// listAllExperiences loads every experience page by page
+func (s *experienceService) listAllExperiences(ctx context.Context) ([]ExperienceDTO, error) {
+	const perPage = 100
+
+	var experiences []ExperienceDTO
+	for page := 1; ; page++ {
+		batch, err := s.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		experiences = append(experiences, batch...)
+
+		if len(batch) < perPage {
+			break
+		}
+	}
+
+	return experiences, nil
+}
+
 func (s *experienceService) CountExperiences(ctx context.Context, filters []base.FilterOption) (int, error) {
 	return s.experienceRepo.Count(ctx, filters)
 }
@@ -453,75 +567,115 @@ func (s *experienceService) BulkDeleteExperiences(ctx context.Context, ids []str
 	return nil
 }
 
-func (s *experienceService) uploadExperienceLogo(ctx context.Context, experienceID string, file *multipart.FileHeader) (string, error) {
-	if experienceID == "" {
-		return "", fmt.Errorf("experience ID cannot be empty")
+func (s *experienceService) BulkCreateExperiencesPartial(ctx context.Context, experiencesCreate []*ExperienceCreate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, experienceCreate := range experiencesCreate {
+		created, err := s.CreateExperience(ctx, experienceCreate)
+		if err != nil {
+			result.Fail(i, "", err)
+			continue
+		}
+		result.Succeed(i, http.StatusCreated, created.ID.String())
+	}
+
+	return result
+}
+
+func (s *experienceService) BulkUpdateExperiencesPartial(ctx context.Context, experiencesUpdate []*ExperienceUpdate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, experienceUpdate := range experiencesUpdate {
+		updated, err := s.UpdateExperience(ctx, experienceUpdate)
+		if err != nil {
+			result.Fail(i, experienceUpdate.ID.String(), err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, updated.ID.String())
 	}
-	if file == nil {
-		return "", fmt.Errorf("file data is required")
+
+	return result
+}
+
+func (s *experienceService) BulkDeleteExperiencesPartial(ctx context.Context, ids []string) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, id := range ids {
+		if err := s.DeleteExperience(ctx, id); err != nil {
+			result.Fail(i, id, err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, id)
 	}
 
-	destPath := "images/experience/logos/" + experienceID + filepath.Ext(file.Filename)
+	return result
+}
 
-	_, err := s.storage.Upload(ctx, file, destPath, storage_go.FileOptions{
-		ContentType: func(s string) *string { return &s }("image"),
-		Upsert:      func(b bool) *bool { return &b }(true),
-	})
+// resolveCompany links the experience to the company with companyID, or to
+// the company matching its company name, creating it on first use. A logo is
+// stored once on the company and shared by every experience there.
+func (s *experienceService) resolveCompany(ctx context.Context, experience *Experience, companyID *uuid.UUID, logo *multipart.FileHeader) error {
+	var (
+		resolved *company.Company
+		err      error
+	)
+	if companyID != nil {
+		resolved, err = s.companyService.GetCompanyByID(ctx, companyID.String())
+	} else {
+		resolved, err = s.companyService.ResolveCompany(ctx, experience.Company)
+	}
 	if err != nil {
-		return "", errors.Wrap(err,
-			errors.ErrInternal,
-			"Failed to upload experience logo",
-			errors.WithContext("experience_id", experienceID),
-		)
+		return err
 	}
 
-	signedURL, err := s.storage.GetPublicURL(destPath)
-	if err != nil {
-		return "", errors.Wrap(err,
-			errors.ErrInternal,
-			"Failed to get public URL for experience logo",
-			errors.WithContext("dest_path", destPath),
-		)
+	if logo != nil {
+		resolved, err = s.companyService.SetCompanyLogo(ctx, resolved.ID.String(), logo)
+		if err != nil {
+			return err
+		}
+	}
+
+	experience.CompanyID = &resolved.ID
+	experience.Company = resolved.Name
+	if resolved.LogoUrl != "" {
+		experience.LogoUrl = resolved.LogoUrl
 	}
 
-	return signedURL, nil
+	return nil
 }
 
-func (s *experienceService) uploadExperienceImages(ctx context.Context, experienceID string, files []*multipart.FileHeader) ([]string, error) {
+// uploadExperienceImages stores the uploaded images of an experience,
+// returning their URLs and their placeholders by URL
+func (s *experienceService) uploadExperienceImages(ctx context.Context, experienceID string, files []*multipart.FileHeader) ([]string, map[string]string, error) {
 	if experienceID == "" {
-		return nil, fmt.Errorf("experience ID cannot be empty")
+		return nil, nil, fmt.Errorf("experience ID cannot be empty")
 	}
 	if len(files) == 0 {
-		return nil, fmt.Errorf("at least one file is required")
+		return nil, nil, fmt.Errorf("at least one file is required")
 	}
 
 	imageURLs := make([]string, len(files))
+	placeholders := make(map[string]string, len(files))
 	for i, file := range files {
-		destPath := fmt.Sprintf("images/experience/%s/%d%s", experienceID, i, filepath.Ext(file.Filename))
+		destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/experience/%s/%d%s", experienceID, i, filepath.Ext(file.Filename)))
 
-		_, err := s.storage.Upload(ctx, file, destPath, storage_go.FileOptions{
+		uploaded, err := s.assets.Upload(ctx, file, destPath, storage.FileOptions{
 			ContentType: func(s string) *string { return &s }("image"),
 			Upsert:      func(b bool) *bool { return &b }(true),
 		})
 		if err != nil {
-			return nil, errors.Wrap(err,
+			return nil, nil, errors.Wrap(err,
 				errors.ErrInternal,
 				"Failed to upload experience image",
 				errors.WithContext("experience_id", experienceID),
 			)
 		}
 
-		signedURL, err := s.storage.GetPublicURL(destPath)
-		if err != nil {
-			return nil, errors.Wrap(err,
-				errors.ErrInternal,
-				"Failed to get public URL for experience image",
-				errors.WithContext("dest_path", destPath),
-			)
+		imageURLs[i] = uploaded.URL
+		if uploaded.Placeholder != "" {
+			placeholders[uploaded.URL] = uploaded.Placeholder
 		}
-
-		imageURLs[i] = signedURL
 	}
 
-	return imageURLs, nil
+	return imageURLs, placeholders, nil
 }
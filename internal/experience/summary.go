@@ -0,0 +1,146 @@
+package experience
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+)
+
+// Summary totals the work history per company and per tech stack
+// @Description Total time worked, per company and per tech stack, counting overlapping roles once
+// @Name ExperienceSummary
+type Summary struct {
+	Experiences  int `json:"experiences" example:"6"`
+	CurrentRoles int `json:"current_roles" example:"1"`
+
+	// Total time worked, counting overlapping roles once
+	TotalMonths   int    `json:"total_months" example:"52"`
+	TotalDuration string `json:"total_duration" example:"4 yrs 4 mos"`
+
+	Companies  []CompanySummary   `json:"companies"`
+	TechStacks []TechStackSummary `json:"tech_stacks"`
+}
+
+// CompanySummary is the time spent at one company, newest first
+// @Name ExperienceCompanySummary
+type CompanySummary struct {
+	Company        string            `json:"company" example:"Tech Innovations Inc."`
+	CompanyID      *uuid.UUID        `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+	LogoUrl        string            `json:"logo_url" example:"https://example.com/company-logo.png"`
+	Roles          int               `json:"roles" example:"2"`
+	StartDate      utils.CustomDate  `json:"start_date" swaggertype:"string" example:"2020-01-15"`
+	EndDate        *utils.CustomDate `json:"end_date" swaggertype:"string" example:"2023-06-30"`
+	IsCurrent      bool              `json:"is_current" example:"false"`
+	DurationMonths int               `json:"duration_months" example:"42"`
+	Duration       string            `json:"duration" example:"3 yrs 6 mos"`
+	Period         string            `json:"period" example:"Jan 2020 - Jun 2023"`
+}
+
+// TechStackSummary is the time a tech stack was used across experiences,
+// longest first
+// @Name ExperienceTechStackSummary
+type TechStackSummary struct {
+	TechStackID    uuid.UUID                    `json:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	Name           string                       `json:"name" example:"Go"`
+	Category       tech_stack.TechStackCategory `json:"category" example:"Backend"`
+	ImageUrl       string                       `json:"image_url" example:"https://example.com/go-logo.png"`
+	Experiences    int                          `json:"experiences" example:"3"`
+	StartDate      utils.CustomDate             `json:"start_date" swaggertype:"string" example:"2020-01-15"`
+	EndDate        *utils.CustomDate            `json:"end_date" swaggertype:"string" example:"2023-06-30"`
+	IsCurrent      bool                         `json:"is_current" example:"true"`
+	DurationMonths int                          `json:"duration_months" example:"42"`
+	Duration       string                       `json:"duration" example:"3 yrs 6 mos"`
+	Period         string                       `json:"period" example:"Jan 2020 - Present"`
+}
+
+// BuildSummary totals the time spent at every company and with every tech
+// stack. Roles without an end date are treated as ongoing until now.
+func BuildSummary(experiences []ExperienceDTO, now time.Time) Summary {
+	timeline := BuildTimeline(experiences, now)
+	now = truncateDay(now)
+
+	summary := Summary{
+		Experiences:   len(experiences),
+		TotalMonths:   timeline.TotalMonths,
+		TotalDuration: timeline.TotalDuration,
+		Companies:     make([]CompanySummary, 0, len(timeline.Companies)),
+		TechStacks:    []TechStackSummary{},
+	}
+
+	for _, c := range timeline.Companies {
+		summary.Companies = append(summary.Companies, CompanySummary{
+			Company:        c.Company,
+			CompanyID:      c.CompanyID,
+			LogoUrl:        c.LogoUrl,
+			Roles:          len(c.Roles),
+			StartDate:      c.StartDate,
+			EndDate:        c.EndDate,
+			IsCurrent:      c.IsCurrent,
+			DurationMonths: c.DurationMonths,
+			Duration:       c.Duration,
+			Period:         c.Period,
+		})
+		for _, role := range c.Roles {
+			if role.IsCurrent {
+				summary.CurrentRoles++
+			}
+		}
+	}
+
+	// Collect the periods each tech stack was used in
+	spans := map[uuid.UUID][]interval{}
+	current := map[uuid.UUID]bool{}
+	index := map[uuid.UUID]int{}
+	for _, e := range experiences {
+		span := experienceSpan(e, now)
+		for _, link := range e.ExperienceTechStack {
+			id := link.TechStackID
+			i, ok := index[id]
+			if !ok {
+				i = len(summary.TechStacks)
+				index[id] = i
+				summary.TechStacks = append(summary.TechStacks, TechStackSummary{
+					TechStackID: id,
+					Name:        link.TechStack.Name,
+					Category:    link.TechStack.Category,
+					ImageUrl:    link.TechStack.ImageUrl,
+				})
+			}
+
+			summary.TechStacks[i].Experiences++
+			spans[id] = append(spans[id], span)
+			if e.EndDate == nil || e.EndDate.IsZero() {
+				current[id] = true
+			}
+		}
+	}
+
+	for i := range summary.TechStacks {
+		t := &summary.TechStacks[i]
+		merged := mergeIntervals(spans[t.TechStackID])
+		first, last := merged[0], merged[len(merged)-1]
+
+		t.StartDate = utils.CustomDate{Time: first.start}
+		t.IsCurrent = current[t.TechStackID]
+		if !t.IsCurrent {
+			t.EndDate = &utils.CustomDate{Time: last.end}
+		}
+		t.DurationMonths = totalMonths(merged)
+		t.Duration = FormatDuration(t.DurationMonths)
+		t.Period = FormatPeriod(t.StartDate, t.EndDate)
+	}
+
+	sort.SliceStable(summary.TechStacks, func(a, b int) bool {
+		ta, tb := summary.TechStacks[a], summary.TechStacks[b]
+		if ta.DurationMonths != tb.DurationMonths {
+			return ta.DurationMonths > tb.DurationMonths
+		}
+		return strings.ToLower(ta.Name) < strings.ToLower(tb.Name)
+	})
+
+	return summary
+}
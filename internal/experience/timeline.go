@@ -0,0 +1,356 @@
+package experience
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// minTimelineGap is the shortest break between roles reported as a gap
+const minTimelineGap = 30 * 24 * time.Hour
+
+// Timeline is the work history normalized for display
+// @Description Experiences grouped by company with computed durations, overlaps and gaps
+// @Name Timeline
+type Timeline struct {
+	Companies []TimelineCompany `json:"companies"`
+	Overlaps  []TimelineOverlap `json:"overlaps"`
+	Gaps      []TimelineGap     `json:"gaps"`
+
+	// Total time worked, counting overlapping roles once
+	TotalMonths   int    `json:"total_months" example:"52"`
+	TotalDuration string `json:"total_duration" example:"4 yrs 4 mos"`
+}
+
+// TimelineCompany groups the roles held at one company, newest first
+// @Name TimelineCompany
+type TimelineCompany struct {
+	Company        string            `json:"company" example:"Tech Innovations Inc."`
+	CompanyID      *uuid.UUID        `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+	LogoUrl        string            `json:"logo_url" example:"https://example.com/company-logo.png"`
+	StartDate      utils.CustomDate  `json:"start_date" swaggertype:"string" example:"2020-01-15"`
+	EndDate        *utils.CustomDate `json:"end_date" swaggertype:"string" example:"2023-06-30"`
+	IsCurrent      bool              `json:"is_current" example:"false"`
+	DurationMonths int               `json:"duration_months" example:"42"`
+	Duration       string            `json:"duration" example:"3 yrs 6 mos"`
+	Period         string            `json:"period" example:"Jan 2020 - Jun 2023"`
+	Roles          []TimelineRole    `json:"roles"`
+}
+
+// TimelineRole is a single experience on the timeline
+// @Name TimelineRole
+type TimelineRole struct {
+	ID             uuid.UUID         `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Role           string            `json:"role" example:"Senior Software Engineer"`
+	JobType        string            `json:"job_type" example:"Full-time"`
+	Location       string            `json:"location" example:"San Francisco, CA"`
+	Arrangement    string            `json:"arrangement" example:"Remote"`
+	StartDate      utils.CustomDate  `json:"start_date" swaggertype:"string" example:"2020-01-15"`
+	EndDate        *utils.CustomDate `json:"end_date" swaggertype:"string" example:"2023-06-30"`
+	IsCurrent      bool              `json:"is_current" example:"false"`
+	DurationMonths int               `json:"duration_months" example:"42"`
+	Duration       string            `json:"duration" example:"3 yrs 6 mos"`
+	Period         string            `json:"period" example:"Jan 2020 - Jun 2023"`
+
+	// OverlapsWith lists the IDs of roles held at the same time
+	OverlapsWith []uuid.UUID `json:"overlaps_with"`
+}
+
+// TimelineOverlap is a period during which two roles were held at once
+// @Name TimelineOverlap
+type TimelineOverlap struct {
+	ExperienceIDs  [2]uuid.UUID     `json:"experience_ids"`
+	StartDate      utils.CustomDate `json:"start_date" swaggertype:"string" example:"2022-03-01"`
+	EndDate        utils.CustomDate `json:"end_date" swaggertype:"string" example:"2022-08-31"`
+	DurationMonths int              `json:"duration_months" example:"6"`
+	Duration       string           `json:"duration" example:"6 mos"`
+}
+
+// TimelineGap is a period without any role
+// @Name TimelineGap
+type TimelineGap struct {
+	StartDate      utils.CustomDate `json:"start_date" swaggertype:"string" example:"2023-07-01"`
+	EndDate        utils.CustomDate `json:"end_date" swaggertype:"string" example:"2023-10-31"`
+	DurationMonths int              `json:"duration_months" example:"4"`
+	Duration       string           `json:"duration" example:"4 mos"`
+}
+
+// interval is a closed date range
+type interval struct {
+	start, end time.Time
+}
+
+// BuildTimeline normalizes experiences into a timeline. Roles without an end
+// date are treated as ongoing until now.
+func BuildTimeline(experiences []ExperienceDTO, now time.Time) Timeline {
+	now = truncateDay(now)
+
+	roles := make([]TimelineRole, len(experiences))
+	spans := make([]interval, len(experiences))
+	for i, e := range experiences {
+		span := experienceSpan(e, now)
+		spans[i] = span
+
+		months := monthsBetween(span.start, span.end)
+		roles[i] = TimelineRole{
+			ID:             e.ID,
+			Role:           e.Role,
+			JobType:        e.JobType,
+			Location:       e.Location,
+			Arrangement:    e.Arrangement,
+			StartDate:      e.StartDate,
+			EndDate:        e.EndDate,
+			IsCurrent:      e.EndDate == nil || e.EndDate.IsZero(),
+			DurationMonths: months,
+			Duration:       FormatDuration(months),
+			Period:         FormatPeriod(e.StartDate, e.EndDate),
+			OverlapsWith:   []uuid.UUID{},
+		}
+	}
+
+	// Detect every pair of roles held at the same time
+	overlaps := []TimelineOverlap{}
+	for i := range roles {
+		for j := i + 1; j < len(roles); j++ {
+			start := maxTime(spans[i].start, spans[j].start)
+			end := minTime(spans[i].end, spans[j].end)
+			if start.After(end) {
+				continue
+			}
+
+			roles[i].OverlapsWith = append(roles[i].OverlapsWith, roles[j].ID)
+			roles[j].OverlapsWith = append(roles[j].OverlapsWith, roles[i].ID)
+
+			months := monthsBetween(start, end)
+			overlaps = append(overlaps, TimelineOverlap{
+				ExperienceIDs:  [2]uuid.UUID{roles[i].ID, roles[j].ID},
+				StartDate:      utils.CustomDate{Time: start},
+				EndDate:        utils.CustomDate{Time: end},
+				DurationMonths: months,
+				Duration:       FormatDuration(months),
+			})
+		}
+	}
+
+	// Group roles by company reference, or by name for roles without one,
+	// keeping the first spelling and logo seen
+	var companies []TimelineCompany
+	companySpans := map[int][]interval{}
+	companyIndex := map[string]int{}
+	for _, i := range newestFirst(spans) {
+		key := strings.ToLower(strings.TrimSpace(experiences[i].Company))
+		if experiences[i].CompanyID != nil {
+			key = experiences[i].CompanyID.String()
+		}
+		c, ok := companyIndex[key]
+		if !ok {
+			c = len(companies)
+			companyIndex[key] = c
+			companies = append(companies, TimelineCompany{
+				Company:   strings.TrimSpace(experiences[i].Company),
+				CompanyID: experiences[i].CompanyID,
+				LogoUrl:   experiences[i].LogoUrl,
+			})
+		}
+		if companies[c].LogoUrl == "" {
+			companies[c].LogoUrl = experiences[i].LogoUrl
+		}
+
+		companies[c].Roles = append(companies[c].Roles, roles[i])
+		companySpans[c] = append(companySpans[c], spans[i])
+	}
+
+	for c := range companies {
+		merged := mergeIntervals(companySpans[c])
+		first, last := merged[0], merged[len(merged)-1]
+
+		companies[c].StartDate = utils.CustomDate{Time: first.start}
+		companies[c].DurationMonths = totalMonths(merged)
+		companies[c].Duration = FormatDuration(companies[c].DurationMonths)
+		for _, role := range companies[c].Roles {
+			if role.IsCurrent {
+				companies[c].IsCurrent = true
+			}
+		}
+		if !companies[c].IsCurrent {
+			companies[c].EndDate = &utils.CustomDate{Time: last.end}
+		}
+		companies[c].Period = FormatPeriod(companies[c].StartDate, companies[c].EndDate)
+	}
+
+	// Gaps are the breaks between the merged periods of all roles
+	merged := mergeIntervals(spans)
+	gaps := []TimelineGap{}
+	for i := 1; i < len(merged); i++ {
+		start := merged[i-1].end.AddDate(0, 0, 1)
+		end := merged[i].start.AddDate(0, 0, -1)
+		if end.Sub(start) < minTimelineGap {
+			continue
+		}
+
+		months := monthsBetween(start, end)
+		gaps = append(gaps, TimelineGap{
+			StartDate:      utils.CustomDate{Time: start},
+			EndDate:        utils.CustomDate{Time: end},
+			DurationMonths: months,
+			Duration:       FormatDuration(months),
+		})
+	}
+
+	total := totalMonths(merged)
+	if companies == nil {
+		companies = []TimelineCompany{}
+	}
+
+	return Timeline{
+		Companies:     companies,
+		Overlaps:      overlaps,
+		Gaps:          gaps,
+		TotalMonths:   total,
+		TotalDuration: FormatDuration(total),
+	}
+}
+
+// FormatDuration renders a number of months like "1 yr 3 mos"
+func FormatDuration(months int) string {
+	if months <= 0 {
+		return "Less than a month"
+	}
+
+	years, rest := months/12, months%12
+	var parts []string
+	switch {
+	case years == 1:
+		parts = append(parts, "1 yr")
+	case years > 1:
+		parts = append(parts, fmt.Sprintf("%d yrs", years))
+	}
+	switch {
+	case rest == 1:
+		parts = append(parts, "1 mo")
+	case rest > 1:
+		parts = append(parts, fmt.Sprintf("%d mos", rest))
+	}
+	return strings.Join(parts, " ")
+}
+
+// FormatPeriod renders a date range like "Jan 2020 - Jun 2023", with
+// "Present" standing in for a missing end date
+func FormatPeriod(start utils.CustomDate, end *utils.CustomDate) string {
+	const layout = "Jan 2006"
+
+	if end == nil || end.IsZero() {
+		return start.Format(layout) + " - Present"
+	}
+	if start.Year() == end.Year() && start.Month() == end.Month() {
+		return start.Format(layout)
+	}
+	return start.Format(layout) + " - " + end.Format(layout)
+}
+
+// validateDates checks that an experience starts and does not end before
+// it starts
+func validateDates(start utils.CustomDate, end *utils.CustomDate) error {
+	if start.IsZero() {
+		return errors.New(errors.ErrValidation, "Start date is required", nil)
+	}
+	if end != nil && !end.IsZero() && truncateDay(end.Time).Before(truncateDay(start.Time)) {
+		return errors.New(
+			errors.ErrValidation,
+			"End date must not be before the start date",
+			nil,
+			errors.WithContext("start_date", start.Format(time.DateOnly)),
+			errors.WithContext("end_date", end.Format(time.DateOnly)),
+		)
+	}
+	return nil
+}
+
+// experienceSpan returns the period of an experience, ending now if ongoing
+func experienceSpan(e ExperienceDTO, now time.Time) interval {
+	start := truncateDay(e.StartDate.Time)
+	end := now
+	if e.EndDate != nil && !e.EndDate.IsZero() {
+		end = truncateDay(e.EndDate.Time)
+	}
+	if end.Before(start) {
+		end = start
+	}
+	return interval{start: start, end: end}
+}
+
+// monthsBetween counts the calendar months touched by a period, so a role
+// from January to March lasts 3 months
+func monthsBetween(start, end time.Time) int {
+	return (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+}
+
+// totalMonths sums the months of non-overlapping periods
+func totalMonths(intervals []interval) int {
+	total := 0
+	for _, iv := range intervals {
+		total += monthsBetween(iv.start, iv.end)
+	}
+	return total
+}
+
+// mergeIntervals merges overlapping or adjacent periods, oldest first
+func mergeIntervals(intervals []interval) []interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	sorted := append([]interval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start.Before(sorted[j].start) })
+
+	merged := []interval{sorted[0]}
+	for _, iv := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if !iv.start.After(last.end.AddDate(0, 0, 1)) {
+			last.end = maxTime(last.end, iv.end)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// newestFirst returns the indexes of spans ordered by end then start date,
+// most recent first
+func newestFirst(spans []interval) []int {
+	order := make([]int, len(spans))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		sa, sb := spans[order[a]], spans[order[b]]
+		if !sa.end.Equal(sb.end) {
+			return sa.end.After(sb.end)
+		}
+		return sa.start.After(sb.start)
+	})
+	return order
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
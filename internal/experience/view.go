@@ -0,0 +1,88 @@
+package experience
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+)
+
+// PublicExperience is an experience as visitors see it
+// @Description Experience as shown publicly
+// @Name PublicExperience
+type PublicExperience struct {
+	ID                  uuid.UUID                   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Role                string                      `json:"role" example:"Senior Software Engineer"`
+	Company             string                      `json:"company" example:"Tech Innovations Inc."`
+	LogoUrl             string                      `json:"logo_url" example:"https://example.com/company-logo.png"`
+	JobType             string                      `json:"job_type" example:"Full-time"`
+	CompanyID           *uuid.UUID                  `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+	StartDate           utils.CustomDate            `json:"start_date" example:"2020-01-15" swaggertype:"string"`
+	EndDate             *utils.CustomDate           `json:"end_date" example:"2023-06-30" swaggertype:"string"`
+	Location            string                      `json:"location" example:"San Francisco, CA"`
+	Arrangement         string                      `json:"arrangement" example:"Remote"`
+	WorkDescription     string                      `json:"work_description" example:"Led development of scalable web applications"`
+	Impact              []string                    `json:"impact" example:"Increased system performance by 40%"`
+	ImagesUrl           []string                    `json:"images_url" example:"https://example.com/project1.png"`
+	ImagePlaceholders   map[string]string           `json:"image_placeholders,omitempty"`
+	ImageAlts           map[string]string           `json:"image_alts,omitempty"`
+	IsFeatured          bool                        `json:"is_featured" example:"true"`
+	CreatedAt           *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time                  `json:"updated_at,omitempty"`
+	ExperienceTechStack []PublicExperienceTechStack `json:"experience_tech_stack"`
+}
+
+// PublicExperienceTechStack is a tech stack of an experience as visitors
+// see it
+// @Description Tech stack of an experience as shown publicly
+// @Name PublicExperienceTechStack
+type PublicExperienceTechStack struct {
+	ExperienceID uuid.UUID                  `json:"experience_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TechStackID  uuid.UUID                  `json:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	TechStack    tech_stack.PublicTechStack `json:"tech_stack"`
+}
+
+// Public maps an experience to what visitors see
+func (e ExperienceDTO) Public() PublicExperience {
+	techStacks := make([]PublicExperienceTechStack, 0, len(e.ExperienceTechStack))
+	for _, stack := range e.ExperienceTechStack {
+		techStacks = append(techStacks, PublicExperienceTechStack{
+			ExperienceID: stack.ExperienceID,
+			TechStackID:  stack.TechStackID,
+			TechStack:    stack.TechStack.Public(),
+		})
+	}
+
+	return PublicExperience{
+		ID:                  e.ID,
+		Role:                e.Role,
+		Company:             e.Company,
+		LogoUrl:             e.LogoUrl,
+		JobType:             e.JobType,
+		CompanyID:           e.CompanyID,
+		StartDate:           e.StartDate,
+		EndDate:             e.EndDate,
+		Location:            e.Location,
+		Arrangement:         e.Arrangement,
+		WorkDescription:     e.WorkDescription,
+		Impact:              e.Impact,
+		ImagesUrl:           e.ImagesUrl,
+		ImagePlaceholders:   e.ImagePlaceholders,
+		ImageAlts:           e.ImageAlts,
+		IsFeatured:          e.IsFeatured,
+		CreatedAt:           e.CreatedAt,
+		UpdatedAt:           e.UpdatedAt,
+		ExperienceTechStack: techStacks,
+	}
+}
+
+// PublicView and AdminView render experiences in responses, see
+// response.View
+func (e ExperienceDTO) PublicView() interface{} {
+	return e.Public()
+}
+
+func (e ExperienceDTO) AdminView() interface{} {
+	return e
+}
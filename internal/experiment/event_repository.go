@@ -0,0 +1,94 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type EventRepository interface {
+	Create(ctx context.Context, event *Event) error
+	// Find returns the event of a type of a visitor in an experiment, or nil
+	// if none
+	Find(ctx context.Context, experimentID, visitorID string, eventType EventType) (*Event, error)
+	// Count counts the events matching filters, which is the number of
+	// distinct visitors since a visitor has one event of each type
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+	DeleteByExperiment(ctx context.Context, experimentID string) error
+}
+
+type eventRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewEventRepository(supabaseClient *supabase.SupabaseClient) EventRepository {
+	return &eventRepository{
+		supabaseClient: supabaseClient,
+		table:          "experiment_event",
+	}
+}
+
+func (r *eventRepository) Create(ctx context.Context, event *Event) error {
+	event.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(event, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record experiment event")
+	}
+	return nil
+}
+
+func (r *eventRepository) Find(ctx context.Context, experimentID, visitorID string, eventType EventType) (*Event, error) {
+	var events []Event
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("experiment_id", experimentID).
+		Eq("visitor_id", visitorID).
+		Eq("type", string(eventType))
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&events)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find experiment event")
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return &events[0], nil
+}
+
+func (r *eventRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	for _, filter := range filters {
+		query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count experiment events")
+	}
+	return int(count), nil
+}
+
+func (r *eventRepository) DeleteByExperiment(ctx context.Context, experimentID string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("experiment_id", experimentID)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete experiment events")
+	}
+	return nil
+}
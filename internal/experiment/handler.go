@@ -0,0 +1,229 @@
+package experiment
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ExperimentHandler struct {
+	base.BaseHandler
+	experimentService ExperimentService
+}
+
+func NewExperimentHandler(experimentService ExperimentService, logger *logger.Logger) *ExperimentHandler {
+	return &ExperimentHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		experimentService: experimentService,
+	}
+}
+
+// GetAssignments retrieves the variants shown to the visitor
+// @Summary Get the variants of a visitor
+// @Description Retrieve the variant of every active experiment shown to the visitor and record that they saw it. Visitors are assigned by hashing the X-Visitor-ID header, or their address and browser without it, so they keep their variants across visits.
+// @Tags Experiments
+// @Produce json
+// @Param X-Visitor-ID header string false "Random ID the frontend keeps for the visitor"
+// @Success 200 {object} response.APIResponse{data=[]Assignment} "Variants retrieved successfully"
+// @Router /experiments/assignments [get]
+func (h *ExperimentHandler) GetAssignments(c *gin.Context) {
+	assignments, err := h.experimentService.Assign(c.Request.Context(), VisitorID(c))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Variants differ between visitors
+	c.Header("Cache-Control", "private, no-store")
+	h.HandleSuccess(c, assignments, "Variants retrieved successfully")
+}
+
+// ListExperiments retrieves the experiments
+// @Summary List experiments
+// @Description Retrieve a paginated list of experiments, newest first
+// @Tags Experiments
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param field query string false "Field varied" Enums(headline, featured_order)
+// @Success 200 {object} response.APIResponse{data=[]Experiment} "Experiments retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/experiments [get]
+func (h *ExperimentHandler) ListExperiments(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if field := c.Query("field"); field != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "field", Operator: base.OperatorEqual, Value: field})
+	}
+
+	experiments, err := h.experimentService.ListExperiments(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.experimentService.CountExperiments(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, experiments, "Experiments retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetExperiment retrieves an experiment
+// @Summary Get an experiment
+// @Description Retrieve an experiment by its ID
+// @Tags Experiments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} response.APIResponse{data=Experiment} "Experiment retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Experiment not found"
+// @Router /admin/experiments/{id} [get]
+func (h *ExperimentHandler) GetExperiment(c *gin.Context) {
+	experimentID := c.Param("id")
+	if _, err := h.ValidateUUID(experimentID, "Experiment ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	experiment, err := h.experimentService.GetExperiment(c.Request.Context(), experimentID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, experiment, "Experiment retrieved successfully")
+}
+
+// CreateExperiment defines an experiment
+// @Summary Create an experiment
+// @Description Define variants of the profile headline or of the featured project order. Only one active experiment may vary a field.
+// @Tags Experiments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param experiment body ExperimentCreate true "Experiment Details"
+// @Success 200 {object} response.APIResponse{data=Experiment} "Experiment created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 409 {object} response.APIResponse "Key taken or field already varied by an active experiment"
+// @Router /admin/experiments [post]
+func (h *ExperimentHandler) CreateExperiment(c *gin.Context) {
+	var experimentInput ExperimentCreate
+	if err := h.ValidateRequest(c, &experimentInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	experiment, err := h.experimentService.CreateExperiment(c.Request.Context(), &experimentInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, experiment, "Experiment created successfully")
+}
+
+// UpdateExperiment changes an experiment
+// @Summary Update an experiment
+// @Description Start or stop an experiment or change its description. Variants can only change until visitors were assigned.
+// @Tags Experiments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Experiment ID"
+// @Param experiment body ExperimentUpdate true "Experiment Update Details"
+// @Success 200 {object} response.APIResponse{data=Experiment} "Experiment updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Experiment not found"
+// @Failure 409 {object} response.APIResponse "Variants already assigned or field already varied by an active experiment"
+// @Router /admin/experiments/{id} [put]
+func (h *ExperimentHandler) UpdateExperiment(c *gin.Context) {
+	experimentID, err := h.ValidateUUID(c.Param("id"), "Experiment ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var experimentInput ExperimentUpdate
+	if err := h.ValidateRequest(c, &experimentInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	experimentInput.ID = experimentID
+
+	experiment, err := h.experimentService.UpdateExperiment(c.Request.Context(), &experimentInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, experiment, "Experiment updated successfully")
+}
+
+// DeleteExperiment removes an experiment
+// @Summary Delete an experiment
+// @Description Remove an experiment along with its recorded exposures and conversions
+// @Tags Experiments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} response.APIResponse "Experiment deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Experiment not found"
+// @Router /admin/experiments/{id} [delete]
+func (h *ExperimentHandler) DeleteExperiment(c *gin.Context) {
+	experimentID := c.Param("id")
+	if _, err := h.ValidateUUID(experimentID, "Experiment ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.experimentService.DeleteExperiment(c.Request.Context(), experimentID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Experiment deleted successfully")
+}
+
+// GetResults reports how the variants of an experiment performed
+// @Summary Get experiment results
+// @Description Count the visitors shown each variant and those of them who then submitted the contact form
+// @Tags Experiments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} response.APIResponse{data=Results} "Experiment results retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Experiment not found"
+// @Router /admin/experiments/{id}/results [get]
+func (h *ExperimentHandler) GetResults(c *gin.Context) {
+	experimentID := c.Param("id")
+	if _, err := h.ValidateUUID(experimentID, "Experiment ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	results, err := h.experimentService.GetResults(c.Request.Context(), experimentID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, results, "Experiment results retrieved successfully")
+}
@@ -0,0 +1,103 @@
+package experiment
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+type memoryExperimentRepository struct {
+	*base.MemoryRepository[Experiment, Experiment]
+}
+
+// NewMemoryExperimentRepository creates an experiment repository kept in
+// memory, for development without a database
+func NewMemoryExperimentRepository() ExperimentRepository {
+	return &memoryExperimentRepository{
+		MemoryRepository: base.NewMemoryRepository([]Experiment{},
+			func(e *Experiment) string { return e.ID.String() },
+			func(e Experiment) Experiment { return e },
+			"key", "description",
+		),
+	}
+}
+
+func (r *memoryExperimentRepository) FindByID(ctx context.Context, id string) (*Experiment, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *memoryExperimentRepository) FindByKey(ctx context.Context, key string) (*Experiment, error) {
+	return r.findOne(ctx, "key", key)
+}
+
+func (r *memoryExperimentRepository) ListActive(ctx context.Context) ([]Experiment, error) {
+	return r.FindByField(ctx, "active", true)
+}
+
+func (r *memoryExperimentRepository) List(ctx context.Context, opts base.ListOptions) ([]Experiment, error) {
+	if opts.SortBy == "" {
+		opts.SortBy, opts.SortOrder = "created_at", base.SortDescending
+	}
+	return r.MemoryRepository.List(ctx, opts)
+}
+
+func (r *memoryExperimentRepository) findOne(ctx context.Context, field, value string) (*Experiment, error) {
+	experiments, err := r.FindByField(ctx, field, value)
+	if err != nil || len(experiments) == 0 {
+		return nil, err
+	}
+	return &experiments[0], nil
+}
+
+type memoryEventRepository struct {
+	events *base.MemoryRepository[Event, Event]
+}
+
+// NewMemoryEventRepository creates an experiment event repository kept in
+// memory, for development without a database
+func NewMemoryEventRepository() EventRepository {
+	return &memoryEventRepository{
+		events: base.NewMemoryRepository([]Event{},
+			func(e *Event) string { return e.ID.String() },
+			func(e Event) Event { return e },
+		),
+	}
+}
+
+func (r *memoryEventRepository) Create(ctx context.Context, event *Event) error {
+	_, err := r.events.Create(ctx, event)
+	return err
+}
+
+func (r *memoryEventRepository) Find(ctx context.Context, experimentID, visitorID string, eventType EventType) (*Event, error) {
+	events, err := r.events.List(ctx, base.ListOptions{
+		Page:    1,
+		PerPage: 1,
+		Filters: []base.FilterOption{
+			{Field: "experiment_id", Operator: base.OperatorEqual, Value: experimentID},
+			{Field: "visitor_id", Operator: base.OperatorEqual, Value: visitorID},
+			{Field: "type", Operator: base.OperatorEqual, Value: string(eventType)},
+		},
+	})
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return &events[0], nil
+}
+
+func (r *memoryEventRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return r.events.Count(ctx, filters)
+}
+
+func (r *memoryEventRepository) DeleteByExperiment(ctx context.Context, experimentID string) error {
+	events, err := r.events.FindByField(ctx, "experiment_id", experimentID)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := r.events.Delete(ctx, event.ID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package experiment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Field is the part of the site an experiment varies
+// @Description Part of the site an experiment varies
+// @Name ExperimentField
+type Field string
+
+// EventType is the kind of experiment event
+// @Description Kind of experiment event
+// @Name ExperimentEventType
+type EventType string
+
+const (
+	// FieldHeadline varies the profile headline
+	FieldHeadline Field = "headline"
+	// FieldFeaturedOrder varies the order of the featured projects
+	FieldFeaturedOrder Field = "featured_order"
+
+	// EventExposure is recorded when a visitor is shown a variant
+	EventExposure EventType = "exposure"
+	// EventConversion is recorded when a visitor shown a variant submits
+	// the contact form
+	EventConversion EventType = "conversion"
+)
+
+var fields = map[Field]bool{
+	FieldHeadline:      true,
+	FieldFeaturedOrder: true,
+}
+
+// Variant is one of the versions of a field shown to visitors
+// @Description Version of a field shown to a share of the visitors
+// @Name ExperimentVariant
+type Variant struct {
+	Key string `json:"key" example:"builder"`
+	// Weight is the share of visitors assigned to the variant relative to
+	// the other ones, 1 when left empty
+	Weight int `json:"weight" example:"1"`
+	// Headline is shown by variants of a headline experiment
+	Headline string `json:"headline,omitempty" example:"I build APIs that stay up"`
+	// ProjectOrder lists the featured projects in the order variants of a
+	// featured order experiment show them
+	ProjectOrder []uuid.UUID `json:"project_order,omitempty"`
+}
+
+// Experiment splits visitors between variants of a field to compare how
+// often each one leads to an inquiry
+// @Description Split of visitors between variants of a field
+// @Name Experiment
+type Experiment struct {
+	ID          uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Key         string     `json:"key" db:"key" example:"headline-2026-10"`
+	Field       Field      `json:"field" db:"field" example:"headline"`
+	Description string     `json:"description,omitempty" db:"description" example:"Outcome focused headline against the current one"`
+	Variants    []Variant  `json:"variants" db:"variants"`
+	// Active experiments assign visitors; only one per field may be active
+	Active    bool       `json:"active" db:"active" example:"true"`
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// ExperimentCreate is the input for defining an experiment
+// @Name ExperimentCreate
+type ExperimentCreate struct {
+	Key         string    `json:"key" validate:"required,max=100" example:"headline-2026-10"`
+	Field       Field     `json:"field" validate:"required" example:"headline"`
+	Description string    `json:"description" validate:"max=500" example:"Outcome focused headline against the current one"`
+	Variants    []Variant `json:"variants" validate:"required,min=2,max=5"`
+	Active      bool      `json:"active" example:"true"`
+}
+
+// ExperimentUpdate is the input for changing an experiment. Variants can
+// only be changed before the experiment has events, so results are never
+// mixed across versions.
+// @Name ExperimentUpdate
+type ExperimentUpdate struct {
+	ID          uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Description *string   `json:"description" validate:"omitempty,max=500" example:"Outcome focused headline against the current one"`
+	Variants    []Variant `json:"variants" validate:"omitempty,min=2,max=5"`
+	Active      *bool     `json:"active" example:"false"`
+}
+
+// Event is an exposure or conversion of a visitor assigned to a variant.
+// A visitor has at most one event of each type per experiment.
+// @Description Exposure or conversion of a visitor
+// @Name ExperimentEvent
+type Event struct {
+	ID           uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	ExperimentID uuid.UUID  `json:"experiment_id" db:"experiment_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Variant      string     `json:"variant" db:"variant" example:"builder"`
+	// VisitorID is a hash of the visitor, never their address
+	VisitorID string     `json:"visitor_id" db:"visitor_id" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Type      EventType  `json:"type" db:"type" example:"exposure"`
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+}
+
+// Assignment is the variant of an active experiment a visitor is shown
+// @Description Variant of an active experiment shown to a visitor
+// @Name ExperimentAssignment
+type Assignment struct {
+	Experiment   string      `json:"experiment" example:"headline-2026-10"`
+	Field        Field       `json:"field" example:"headline"`
+	Variant      string      `json:"variant" example:"builder"`
+	Headline     string      `json:"headline,omitempty" example:"I build APIs that stay up"`
+	ProjectOrder []uuid.UUID `json:"project_order,omitempty"`
+}
+
+// VariantResult is how a variant performed
+// @Description Exposed and converted visitors of a variant
+// @Name ExperimentVariantResult
+type VariantResult struct {
+	Variant     string `json:"variant" example:"builder"`
+	Exposures   int    `json:"exposures" example:"412"`
+	Conversions int    `json:"conversions" example:"9"`
+	// ConversionRate is the share of exposed visitors who converted
+	ConversionRate float64 `json:"conversion_rate" example:"0.0218"`
+}
+
+// Results is how the variants of an experiment performed
+// @Description Exposed and converted visitors per variant of an experiment
+// @Name ExperimentResults
+type Results struct {
+	ExperimentID uuid.UUID       `json:"experiment_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Key          string          `json:"key" example:"headline-2026-10"`
+	Field        Field           `json:"field" example:"headline"`
+	Active       bool            `json:"active" example:"true"`
+	Variants     []VariantResult `json:"variants"`
+}
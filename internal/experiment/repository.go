@@ -0,0 +1,174 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type ExperimentRepository interface {
+	Create(ctx context.Context, experiment *Experiment) (*Experiment, error)
+	Update(ctx context.Context, experiment *Experiment) (*Experiment, error)
+	Delete(ctx context.Context, id string) error
+	// FindByID returns the experiment with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Experiment, error)
+	// FindByKey returns the experiment with the given key, or nil if none
+	FindByKey(ctx context.Context, key string) (*Experiment, error)
+	// ListActive returns the experiments assigning visitors
+	ListActive(ctx context.Context) ([]Experiment, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Experiment, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type experimentRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewExperimentRepository(supabaseClient *supabase.SupabaseClient) ExperimentRepository {
+	return &experimentRepository{
+		supabaseClient: supabaseClient,
+		table:          "experiment",
+	}
+}
+
+func (r *experimentRepository) Create(ctx context.Context, experiment *Experiment) (*Experiment, error) {
+	experiment.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(experiment, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create experiment")
+	}
+	return experiment, nil
+}
+
+func (r *experimentRepository) Update(ctx context.Context, experiment *Experiment) (*Experiment, error) {
+	experiment.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(experiment, "minimal", "").
+		Eq("id", experiment.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update experiment")
+	}
+	return experiment, nil
+}
+
+func (r *experimentRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete experiment")
+	}
+	return nil
+}
+
+func (r *experimentRepository) FindByID(ctx context.Context, id string) (*Experiment, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *experimentRepository) FindByKey(ctx context.Context, key string) (*Experiment, error) {
+	return r.findOne(ctx, "key", key)
+}
+
+func (r *experimentRepository) ListActive(ctx context.Context) ([]Experiment, error) {
+	var experiments []Experiment
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("active", "true")
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&experiments)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list active experiments")
+	}
+	return experiments, nil
+}
+
+func (r *experimentRepository) List(ctx context.Context, opts base.ListOptions) ([]Experiment, error) {
+	var experiments []Experiment
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, newest first by default
+	sortBy, ascending := "created_at", false
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&experiments)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list experiments")
+	}
+
+	return experiments, nil
+}
+
+func (r *experimentRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count experiments")
+	}
+
+	return int(count), nil
+}
+
+func (r *experimentRepository) findOne(ctx context.Context, field, value string) (*Experiment, error) {
+	var experiments []Experiment
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&experiments)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find experiment")
+	}
+
+	if len(experiments) == 0 {
+		return nil, nil
+	}
+	return &experiments[0], nil
+}
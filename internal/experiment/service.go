@@ -0,0 +1,408 @@
+package experiment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// keyPattern is the form of experiment and variant keys, e.g.
+// "headline-2026-10"
+var keyPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type ExperimentService interface {
+	// Assign returns the variants of the active experiments shown to a
+	// visitor, recording their exposure
+	Assign(ctx context.Context, visitorID string) ([]Assignment, error)
+	// RecordConversion records a conversion in every active experiment the
+	// visitor was exposed to
+	RecordConversion(ctx context.Context, visitorID string) error
+	CreateExperiment(ctx context.Context, experimentCreate *ExperimentCreate) (*Experiment, error)
+	UpdateExperiment(ctx context.Context, experimentUpdate *ExperimentUpdate) (*Experiment, error)
+	// DeleteExperiment deletes an experiment along with its events
+	DeleteExperiment(ctx context.Context, id string) error
+	GetExperiment(ctx context.Context, id string) (*Experiment, error)
+	ListExperiments(ctx context.Context, opts base.ListOptions) ([]Experiment, error)
+	CountExperiments(ctx context.Context, filters []base.FilterOption) (int, error)
+	// GetResults counts the exposed and converted visitors of every variant
+	GetResults(ctx context.Context, id string) (*Results, error)
+}
+
+type experimentService struct {
+	experimentRepo ExperimentRepository
+	eventRepo      EventRepository
+}
+
+func NewExperimentService(experimentRepo ExperimentRepository, eventRepo EventRepository) ExperimentService {
+	return &experimentService{
+		experimentRepo: experimentRepo,
+		eventRepo:      eventRepo,
+	}
+}
+
+func (s *experimentService) Assign(ctx context.Context, visitorID string) ([]Assignment, error) {
+	experiments, err := s.experimentRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]Assignment, 0, len(experiments))
+	for _, experiment := range experiments {
+		variant := assign(&experiment, visitorID)
+		if err := s.record(ctx, &experiment, variant.Key, visitorID, EventExposure); err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, Assignment{
+			Experiment:   experiment.Key,
+			Field:        experiment.Field,
+			Variant:      variant.Key,
+			Headline:     variant.Headline,
+			ProjectOrder: variant.ProjectOrder,
+		})
+	}
+	return assignments, nil
+}
+
+func (s *experimentService) RecordConversion(ctx context.Context, visitorID string) error {
+	experiments, err := s.experimentRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, experiment := range experiments {
+		// Visitors who never saw a variant tell nothing about it
+		exposure, err := s.eventRepo.Find(ctx, experiment.ID.String(), visitorID, EventExposure)
+		if err != nil {
+			return err
+		}
+		if exposure == nil {
+			continue
+		}
+
+		if err := s.record(ctx, &experiment, exposure.Variant, visitorID, EventConversion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *experimentService) CreateExperiment(ctx context.Context, experimentCreate *ExperimentCreate) (*Experiment, error) {
+	// Validate input
+	if err := validator.ValidateModel(experimentCreate); err != nil {
+		return nil, err
+	}
+
+	key := strings.TrimSpace(experimentCreate.Key)
+	if !keyPattern.MatchString(key) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Key must be lowercase letters and digits separated by dashes",
+			nil,
+			errors.WithContext("key", key),
+		)
+	}
+	existing, err := s.experimentRepo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"An experiment with this key already exists",
+			nil,
+			errors.WithContext("key", key),
+		)
+	}
+
+	now := time.Now().UTC()
+	experiment := &Experiment{
+		ID:          uuid.New(),
+		Key:         key,
+		Field:       experimentCreate.Field,
+		Description: strings.TrimSpace(experimentCreate.Description),
+		Variants:    experimentCreate.Variants,
+		Active:      experimentCreate.Active,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	if err := s.check(ctx, experiment); err != nil {
+		return nil, err
+	}
+
+	return s.experimentRepo.Create(ctx, experiment)
+}
+
+func (s *experimentService) UpdateExperiment(ctx context.Context, experimentUpdate *ExperimentUpdate) (*Experiment, error) {
+	// Validate input
+	if err := validator.ValidateModel(experimentUpdate); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.GetExperiment(ctx, experimentUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if experimentUpdate.Description != nil {
+		experiment.Description = strings.TrimSpace(*experimentUpdate.Description)
+	}
+	if experimentUpdate.Active != nil {
+		experiment.Active = *experimentUpdate.Active
+	}
+	if experimentUpdate.Variants != nil {
+		events, err := s.eventRepo.Count(ctx, []base.FilterOption{
+			{Field: "experiment_id", Operator: base.OperatorEqual, Value: experiment.ID.String()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if events > 0 {
+			return nil, errors.New(
+				errors.ErrConflict,
+				"Variants cannot change once visitors were assigned",
+				nil,
+				errors.WithContext("events", events),
+			)
+		}
+		experiment.Variants = experimentUpdate.Variants
+	}
+
+	if err := s.check(ctx, experiment); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	experiment.UpdatedAt = &now
+
+	return s.experimentRepo.Update(ctx, experiment)
+}
+
+func (s *experimentService) DeleteExperiment(ctx context.Context, id string) error {
+	if _, err := s.GetExperiment(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.eventRepo.DeleteByExperiment(ctx, id); err != nil {
+		return err
+	}
+	return s.experimentRepo.Delete(ctx, id)
+}
+
+func (s *experimentService) GetExperiment(ctx context.Context, id string) (*Experiment, error) {
+	experiment, err := s.experimentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if experiment == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Experiment not found",
+			nil,
+			errors.WithContext("experiment_id", id),
+		)
+	}
+	return experiment, nil
+}
+
+func (s *experimentService) ListExperiments(ctx context.Context, opts base.ListOptions) ([]Experiment, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.experimentRepo.List(ctx, opts)
+}
+
+func (s *experimentService) CountExperiments(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.experimentRepo.Count(ctx, filters)
+}
+
+func (s *experimentService) GetResults(ctx context.Context, id string) (*Results, error) {
+	experiment, err := s.GetExperiment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &Results{
+		ExperimentID: experiment.ID,
+		Key:          experiment.Key,
+		Field:        experiment.Field,
+		Active:       experiment.Active,
+		Variants:     make([]VariantResult, 0, len(experiment.Variants)),
+	}
+	for _, variant := range experiment.Variants {
+		result := VariantResult{Variant: variant.Key}
+		if result.Exposures, err = s.countVisitors(ctx, experiment, variant.Key, EventExposure); err != nil {
+			return nil, err
+		}
+		if result.Conversions, err = s.countVisitors(ctx, experiment, variant.Key, EventConversion); err != nil {
+			return nil, err
+		}
+		if result.Exposures > 0 {
+			result.ConversionRate = float64(result.Conversions) / float64(result.Exposures)
+		}
+		results.Variants = append(results.Variants, result)
+	}
+	return results, nil
+}
+
+// countVisitors counts the visitors of a variant with an event of a type
+func (s *experimentService) countVisitors(ctx context.Context, experiment *Experiment, variant string, eventType EventType) (int, error) {
+	return s.eventRepo.Count(ctx, []base.FilterOption{
+		{Field: "experiment_id", Operator: base.OperatorEqual, Value: experiment.ID.String()},
+		{Field: "variant", Operator: base.OperatorEqual, Value: variant},
+		{Field: "type", Operator: base.OperatorEqual, Value: string(eventType)},
+	})
+}
+
+// record stores an event of a visitor unless they already have one of the
+// type in the experiment
+func (s *experimentService) record(ctx context.Context, experiment *Experiment, variant, visitorID string, eventType EventType) error {
+	existing, err := s.eventRepo.Find(ctx, experiment.ID.String(), visitorID, eventType)
+	if err != nil || existing != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	return s.eventRepo.Create(ctx, &Event{
+		ID:           uuid.New(),
+		ExperimentID: experiment.ID,
+		Variant:      variant,
+		VisitorID:    visitorID,
+		Type:         eventType,
+		CreatedAt:    &now,
+	})
+}
+
+// check validates the field and variants of an experiment and makes sure
+// no other active experiment varies the same field
+func (s *experimentService) check(ctx context.Context, experiment *Experiment) error {
+	if !fields[experiment.Field] {
+		return errors.New(
+			errors.ErrValidation,
+			"Field must be headline or featured_order",
+			nil,
+			errors.WithContext("field", experiment.Field),
+		)
+	}
+
+	if len(experiment.Variants) < 2 {
+		return errors.New(
+			errors.ErrValidation,
+			"An experiment needs at least two variants",
+			nil,
+		)
+	}
+	keys := map[string]bool{}
+	for i := range experiment.Variants {
+		variant := &experiment.Variants[i]
+		variant.Key = strings.TrimSpace(variant.Key)
+		variant.Headline = strings.TrimSpace(variant.Headline)
+
+		if !keyPattern.MatchString(variant.Key) {
+			return errors.New(
+				errors.ErrValidation,
+				"Variant key must be lowercase letters and digits separated by dashes",
+				nil,
+				errors.WithContext("variant", variant.Key),
+			)
+		}
+		if keys[variant.Key] {
+			return errors.New(
+				errors.ErrValidation,
+				"Variant keys must be unique",
+				nil,
+				errors.WithContext("variant", variant.Key),
+			)
+		}
+		keys[variant.Key] = true
+
+		if variant.Weight == 0 {
+			variant.Weight = 1
+		}
+		if variant.Weight < 0 || variant.Weight > 100 {
+			return errors.New(
+				errors.ErrValidation,
+				"Variant weight must be between 1 and 100",
+				nil,
+				errors.WithContext("variant", variant.Key),
+			)
+		}
+
+		// A variant only carries the value of the experiment field
+		switch experiment.Field {
+		case FieldHeadline:
+			variant.ProjectOrder = nil
+			if variant.Headline == "" || len(variant.Headline) > 255 {
+				return errors.New(
+					errors.ErrValidation,
+					"Variant headline is required and must be at most 255 characters",
+					nil,
+					errors.WithContext("variant", variant.Key),
+				)
+			}
+		case FieldFeaturedOrder:
+			variant.Headline = ""
+			if len(variant.ProjectOrder) == 0 {
+				return errors.New(
+					errors.ErrValidation,
+					"Variant project order is required",
+					nil,
+					errors.WithContext("variant", variant.Key),
+				)
+			}
+		}
+	}
+
+	if !experiment.Active {
+		return nil
+	}
+	active, err := s.experimentRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range active {
+		if other.ID != experiment.ID && other.Field == experiment.Field {
+			return errors.New(
+				errors.ErrConflict,
+				"Another active experiment already varies this field",
+				nil,
+				errors.WithContext("experiment", other.Key),
+			)
+		}
+	}
+	return nil
+}
+
+// assign picks the variant of a visitor by hashing them with the
+// experiment key, so a visitor keeps their variant across visits and
+// instances while the split follows the variant weights
+func assign(experiment *Experiment, visitorID string) Variant {
+	total := 0
+	for _, variant := range experiment.Variants {
+		total += variant.Weight
+	}
+
+	sum := sha256.Sum256([]byte(experiment.Key + "|" + visitorID))
+	bucket := int(binary.BigEndian.Uint64(sum[:8]) % uint64(total))
+	for _, variant := range experiment.Variants {
+		if bucket < variant.Weight {
+			return variant
+		}
+		bucket -= variant.Weight
+	}
+	return experiment.Variants[len(experiment.Variants)-1]
+}
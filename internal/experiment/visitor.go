@@ -0,0 +1,26 @@
+package experiment
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VisitorHeader carries a random ID the frontend keeps for a visitor, so
+// they see the same variants on every visit. Without it visitors are told
+// apart by their address and browser.
+const VisitorHeader = "X-Visitor-ID"
+
+// VisitorID returns a hash identifying the visitor of a request, the same
+// for the requests reading variants and submitting the contact form
+func VisitorID(c *gin.Context) string {
+	key := strings.TrimSpace(c.GetHeader(VisitorHeader))
+	if key == "" || len(key) > 128 {
+		key = c.ClientIP() + "|" + c.Request.UserAgent()
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
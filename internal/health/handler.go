@@ -12,12 +12,15 @@ import (
 type HealthHandler struct {
 	base.BaseHandler
 	supabaseClient *supabaseClient.Client
+	keys           KeySource
 }
 
-// NewHealthHandler creates a new health check handler
-func NewHealthHandler(supabaseClient *supabaseClient.Client) *HealthHandler {
+// NewHealthHandler creates a new health check handler. keys may be nil when
+// tokens are not verified against a key set.
+func NewHealthHandler(supabaseClient *supabaseClient.Client, keys KeySource) *HealthHandler {
 	return &HealthHandler{
 		supabaseClient: supabaseClient,
+		keys:           keys,
 	}
 }
 
@@ -34,7 +37,7 @@ func NewHealthHandler(supabaseClient *supabaseClient.Client) *HealthHandler {
 // @Router       /health [get]
 func (h *HealthHandler) GetHealthStatus(c *gin.Context) {
 	// Perform health check
-	healthStatus := CheckHealth(h.supabaseClient)
+	healthStatus := CheckHealth(h.supabaseClient, h.keys)
 
 	// Determine HTTP status code based on health status
 	var statusCode int
@@ -15,6 +15,7 @@ type HealthStatus struct {
 	Status         string         `json:"status"`
 	Timestamp      time.Time      `json:"timestamp"`
 	DatabaseHealth DatabaseHealth `json:"database"`
+	JWKSHealth     *JWKSHealth    `json:"jwks,omitempty"`
 	MemoryHealth   MemoryHealth   `json:"memory"`
 	CPUHealth      CPUHealth      `json:"cpu"`
 	SystemInfo     SystemInfo     `json:"system"`
@@ -26,6 +27,23 @@ type DatabaseHealth struct {
 	Error     string `json:"error,omitempty"`
 }
 
+// KeySource is the key set admin tokens are verified with
+type KeySource interface {
+	Ready() bool
+	LastError() error
+	Attempts() int
+	LoadedAt() *time.Time
+}
+
+// JWKSHealth represents the state of the key set admin tokens are verified
+// with. Until it is loaded, authenticated routes are unavailable.
+type JWKSHealth struct {
+	Loaded   bool       `json:"loaded"`
+	Attempts int        `json:"attempts"`
+	LoadedAt *time.Time `json:"loaded_at,omitempty"`
+	Error    string     `json:"error,omitempty"`
+}
+
 // MemoryHealth represents the memory usage of the system
 type MemoryHealth struct {
 	Total       uint64  `json:"total"`
@@ -48,7 +66,7 @@ type SystemInfo struct {
 }
 
 // CheckHealth performs a comprehensive health check
-func CheckHealth(client *supabaseClient.Client) HealthStatus {
+func CheckHealth(client *supabaseClient.Client, keys KeySource) HealthStatus {
 	status := HealthStatus{
 		Status:    "healthy",
 		Timestamp: time.Now(),
@@ -60,6 +78,14 @@ func CheckHealth(client *supabaseClient.Client) HealthStatus {
 		status.Status = "degraded"
 	}
 
+	// Check JWKS keys
+	if keys != nil {
+		status.JWKSHealth = checkJWKS(keys)
+		if !status.JWKSHealth.Loaded {
+			status.Status = "degraded"
+		}
+	}
+
 	// Check Memory Usage
 	status.MemoryHealth = checkMemoryUsage()
 	if status.MemoryHealth.UsedPercent > 90 {
@@ -100,6 +126,19 @@ func checkDatabaseConnection(client *supabaseClient.Client) DatabaseHealth {
 	return dbHealth
 }
 
+// checkJWKS reports whether the key set is loaded
+func checkJWKS(keys KeySource) *JWKSHealth {
+	jwksHealth := &JWKSHealth{
+		Loaded:   keys.Ready(),
+		Attempts: keys.Attempts(),
+		LoadedAt: keys.LoadedAt(),
+	}
+	if err := keys.LastError(); err != nil {
+		jwksHealth.Error = err.Error()
+	}
+	return jwksHealth
+}
+
 // checkMemoryUsage retrieves current memory usage
 func checkMemoryUsage() MemoryHealth {
 	vmStat, err := mem.VirtualMemory()
@@ -0,0 +1,122 @@
+package image_proxy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// Cache stores transformed images by key
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, data []byte, contentType string) error
+}
+
+// NewCache creates the cache backend named by backend: "disk", "storage" or
+// "none"
+func NewCache(backend, dir string, storage storage.Storage) (Cache, error) {
+	switch backend {
+	case "", "disk":
+		return NewDiskCache(dir)
+	case "storage":
+		return NewStorageCache(storage, dir), nil
+	case "none":
+		return noCache{}, nil
+	default:
+		return nil, fmt.Errorf("unknown image cache backend %q", backend)
+	}
+}
+
+// DiskCache keeps transformed images on the local filesystem
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a disk cache rooted at dir
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		dir = "./cache/images"
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create image cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Set writes through a temporary file so readers never see partial images
+func (c *DiskCache) Set(ctx context.Context, key string, data []byte, contentType string) error {
+	target := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+// path shards entries by key prefix to keep directories small
+func (c *DiskCache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key)
+}
+
+// StorageCache keeps transformed images in the object store
+type StorageCache struct {
+	storage storage.Storage
+	folder  string
+}
+
+// NewStorageCache creates a storage cache under folder
+func NewStorageCache(storage storage.Storage, folder string) *StorageCache {
+	if folder == "" || filepath.IsAbs(folder) {
+		folder = "_cache/images"
+	}
+	return &StorageCache{storage: storage, folder: folder}
+}
+
+func (c *StorageCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	data, err := c.storage.Download(ctx, path.Join(c.folder, key))
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
+
+func (c *StorageCache) Set(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := c.storage.UploadBytes(ctx, data, path.Join(c.folder, key), contentType)
+	return err
+}
+
+// noCache disables caching
+type noCache struct{}
+
+func (noCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	return nil, false
+}
+
+func (noCache) Set(ctx context.Context, key string, data []byte, contentType string) error {
+	return nil
+}
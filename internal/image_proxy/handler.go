@@ -0,0 +1,75 @@
+package image_proxy
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ImageProxyHandler struct {
+	base.BaseHandler
+	imageProxyService ImageProxyService
+	cacheMaxAge       int
+}
+
+func NewImageProxyHandler(imageProxyService ImageProxyService, cacheMaxAge int, logger *logger.Logger) *ImageProxyHandler {
+	return &ImageProxyHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		imageProxyService: imageProxyService,
+		cacheMaxAge:       cacheMaxAge,
+	}
+}
+
+// GetImage serves a stored image resized and transcoded on the fly
+// @Summary Get a transformed image
+// @Description Fetch an image from storage, resize it to fit within the requested width and height without upscaling, and transcode it. Transformed variants are cached and served with long-lived cache headers.
+// @Tags Images
+// @Produce image/jpeg
+// @Produce image/png
+// @Param path path string true "Storage path of the image"
+// @Param w query int false "Maximum width in pixels"
+// @Param h query int false "Maximum height in pixels"
+// @Param format query string false "Output format" Enums(jpeg, png)
+// @Param q query int false "JPEG quality (1-100)"
+// @Success 200 {file} binary "Transformed image"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Image not found"
+// @Router /img/{path} [get]
+func (h *ImageProxyHandler) GetImage(c *gin.Context) {
+	var query TransformQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	image, err := h.imageProxyService.GetImage(c.Request.Context(), c.Param("path"), query)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if image.Cached {
+		middleware.MarkCacheHit(c)
+	}
+
+	etag := `"` + image.ETag + `"`
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", h.cacheMaxAge))
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, image.ContentType, image.Data)
+}
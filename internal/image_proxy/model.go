@@ -0,0 +1,31 @@
+package image_proxy
+
+import "github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+
+// TransformQuery represents the transform requested through query parameters
+// @Name TransformQuery
+type TransformQuery struct {
+	Width   int    `form:"w" example:"640"`
+	Height  int    `form:"h" example:"360"`
+	Format  string `form:"format" example:"jpeg"`
+	Quality int    `form:"q" example:"80"`
+}
+
+// Image is a transformed image ready to be served
+type Image struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+	// Cached is set when the image was served from the cache
+	Cached bool
+}
+
+// ToOptions converts TransformQuery to image processing options
+func (q TransformQuery) ToOptions() imageproc.Options {
+	return imageproc.Options{
+		Width:   q.Width,
+		Height:  q.Height,
+		Format:  imageproc.NormalizeFormat(q.Format),
+		Quality: q.Quality,
+	}
+}
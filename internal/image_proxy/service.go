@@ -0,0 +1,115 @@
+package image_proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+	"golang.org/x/sync/singleflight"
+)
+
+// sourceExtensions lists the file extensions the proxy serves
+var sourceExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".webp": true,
+	".gif":  true,
+}
+
+type ImageProxyService interface {
+	GetImage(ctx context.Context, imagePath string, query TransformQuery) (*Image, error)
+}
+
+type imageProxyService struct {
+	storage storage.Storage
+	cache   Cache
+	group   singleflight.Group
+}
+
+func NewImageProxyService(storage storage.Storage, cache Cache) ImageProxyService {
+	return &imageProxyService{
+		storage: storage,
+		cache:   cache,
+	}
+}
+
+// GetImage returns the stored image at imagePath transformed as requested,
+// serving it from the cache when it was transformed before
+func (s *imageProxyService) GetImage(ctx context.Context, imagePath string, query TransformQuery) (*Image, error) {
+	imagePath = strings.TrimPrefix(path.Clean("/"+imagePath), "/")
+	ext := strings.ToLower(path.Ext(imagePath))
+	if imagePath == "" || !sourceExtensions[ext] {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Unsupported image path",
+			nil,
+			errors.WithContext("path", imagePath),
+		)
+	}
+
+	opts := query.ToOptions()
+	if opts.Format == "" {
+		opts.Format = imageproc.FormatPNG
+		if ext == ".jpg" || ext == ".jpeg" {
+			opts.Format = imageproc.FormatJPEG
+		}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Invalid transform options",
+			err,
+		)
+	}
+
+	key := cacheKey(imagePath, opts)
+	contentType := imageproc.ContentType(opts.Format)
+
+	if data, ok := s.cache.Get(ctx, key); ok {
+		return &Image{Data: data, ContentType: contentType, ETag: key, Cached: true}, nil
+	}
+
+	// Concurrent requests for the same variant share a single transform
+	result, err, _ := s.group.Do(key, func() (interface{}, error) {
+		source, err := s.storage.Download(ctx, imagePath)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrNotFound,
+				"Image not found",
+				errors.WithContext("path", imagePath),
+			)
+		}
+
+		data, _, err := imageproc.Transform(source, opts)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrValidation,
+				"Failed to transform image",
+				errors.WithContext("path", imagePath),
+			)
+		}
+
+		// Caching is best effort; a failed write only costs a later re-transform
+		_ = s.cache.Set(ctx, key, data, contentType)
+
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{Data: result.([]byte), ContentType: contentType, ETag: key}, nil
+}
+
+// cacheKey identifies a transformed variant of an image
+func cacheKey(imagePath string, opts imageproc.Options) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d|%s|%d", imagePath, opts.Width, opts.Height, opts.Format, opts.Quality)))
+	return hex.EncodeToString(sum[:16]) + "." + opts.Format
+}
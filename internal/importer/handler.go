@@ -0,0 +1,99 @@
+package importer
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ImportHandler struct {
+	base.BaseHandler
+	jobService jobs.JobService
+	planners   map[Entity]Planner
+}
+
+func NewImportHandler(jobService jobs.JobService, planners map[Entity]Planner, logger *logger.Logger) *ImportHandler {
+	return &ImportHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		jobService:  jobService,
+		planners:    planners,
+	}
+}
+
+// ImportContent queues a bulk import of a seed file
+// @Summary Import content in bulk
+// @Description Queue a background job creating every item of a seed file, such as example_input_projects.json, one at a time. Failing items are recorded and skipped. Poll GET /jobs/{id} for the per-item report, or follow job.progress events on /events/stream.
+// @Tags Import
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Kind of content (projects, experiences, tech_stacks)"
+// @Param items body []object true "Items in the create format of the entity"
+// @Param dry_run query bool false "Validate the items, including slug conflicts and missing references, and report the would-be changes without queueing the import"
+// @Success 202 {object} response.APIResponse{data=jobs.Job} "Import queued successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/import/{entity} [post]
+func (h *ImportHandler) ImportContent(c *gin.Context) {
+	entity := Entity(c.Param("entity"))
+	if !entities[entity] {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Unknown import entity",
+			nil,
+			errors.WithContext("entity", entity),
+		))
+		return
+	}
+
+	var items []json.RawMessage
+	if err := h.BindJSON(c, &items); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Items must be a JSON array",
+			err,
+		))
+		return
+	}
+
+	if len(items) == 0 {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"At least one item is required",
+			nil,
+		))
+		return
+	}
+
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.planners[entity](c.Request.Context(), items)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	job, err := h.jobService.Enqueue(c.Request.Context(), ImportJob, importPayload{
+		Entity: entity,
+		Items:  items,
+	})
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusAccepted, job, "Import queued successfully")
+}
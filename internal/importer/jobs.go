@@ -0,0 +1,201 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// ImportJob is the kind of job importing content from a seed file
+const ImportJob = "import.content"
+
+// ItemImporter creates one item of an import from its JSON, returning the
+// ID of the created record
+type ItemImporter func(ctx context.Context, item json.RawMessage) (string, error)
+
+// Planner validates the items of an import without writing anything
+type Planner func(ctx context.Context, items []json.RawMessage) (*base.DryRun, error)
+
+// Runner imports the items of the job payload one at a time with the
+// importer of its entity. A failing item is recorded in the report and the
+// import moves on. An interrupted import resumes after the last item of
+// its saved report rather than creating the earlier items twice.
+func Runner(importers map[Entity]ItemImporter) jobs.Runner {
+	return func(ctx context.Context, job *jobs.Job, progress jobs.Progress) (interface{}, error) {
+		var payload importPayload
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return nil, errors.Wrap(err, errors.ErrValidation, "Invalid import job payload")
+		}
+
+		importItem := importers[payload.Entity]
+		if importItem == nil {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Unknown import entity",
+				nil,
+				errors.WithContext("entity", payload.Entity),
+			)
+		}
+
+		report := Report{Entity: payload.Entity, Total: len(payload.Items)}
+		if job.Result != nil {
+			var saved Report
+			if err := json.Unmarshal(job.Result, &saved); err == nil && saved.Processed <= report.Total {
+				report = saved
+			}
+		}
+
+		for i := report.Processed; i < len(payload.Items); i++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			result := ItemResult{Index: i, Status: ItemSucceeded}
+			var message string
+			if id, err := importItem(ctx, payload.Items[i]); err != nil {
+				result.Status = ItemFailed
+				result.Error = err.Error()
+				report.Failed++
+				message = fmt.Sprintf("Item %d of %d failed: %s", i+1, report.Total, result.Error)
+			} else {
+				result.ID = id
+				report.Succeeded++
+				message = fmt.Sprintf("Imported item %d of %d", i+1, report.Total)
+			}
+			report.Processed++
+			report.Items = append(report.Items, result)
+
+			data, err := json.Marshal(report)
+			if err != nil {
+				return nil, errors.Wrap(err, errors.ErrInternal, "Failed to encode import report")
+			}
+			job.Result = data
+			progress(report.Processed*100/report.Total, message)
+		}
+
+		return report, nil
+	}
+}
+
+// ProjectImporter imports items in the format of ProjectCreate
+func ProjectImporter(projectService project.ProjectService) ItemImporter {
+	return func(ctx context.Context, item json.RawMessage) (string, error) {
+		var projectCreate project.ProjectCreate
+		if err := decodeItem(item, &projectCreate); err != nil {
+			return "", err
+		}
+
+		created, err := projectService.CreateProject(ctx, &projectCreate)
+		if err != nil {
+			return "", err
+		}
+		return created.ID.String(), nil
+	}
+}
+
+// ExperienceImporter imports items in the format of ExperienceCreate
+func ExperienceImporter(experienceService experience.ExperienceService) ItemImporter {
+	return func(ctx context.Context, item json.RawMessage) (string, error) {
+		var experienceCreate experience.ExperienceCreate
+		if err := decodeItem(item, &experienceCreate); err != nil {
+			return "", err
+		}
+
+		created, err := experienceService.CreateExperience(ctx, &experienceCreate)
+		if err != nil {
+			return "", err
+		}
+		return created.ID.String(), nil
+	}
+}
+
+// TechStackImporter imports items in the format of TechStackCreate
+func TechStackImporter(techStackService tech_stack.TechStackService) ItemImporter {
+	return func(ctx context.Context, item json.RawMessage) (string, error) {
+		var techStackCreate tech_stack.TechStackCreate
+		if err := decodeItem(item, &techStackCreate); err != nil {
+			return "", err
+		}
+
+		created, err := techStackService.CreateTechStack(ctx, &techStackCreate)
+		if err != nil {
+			return "", err
+		}
+		return created.ID.String(), nil
+	}
+}
+
+// ProjectPlanner validates items in the format of ProjectCreate
+func ProjectPlanner(projectService project.ProjectService) Planner {
+	return func(ctx context.Context, items []json.RawMessage) (*base.DryRun, error) {
+		return planItems(ctx, items, projectService.DryRunCreateProjects)
+	}
+}
+
+// ExperiencePlanner validates items in the format of ExperienceCreate
+func ExperiencePlanner(experienceService experience.ExperienceService) Planner {
+	return func(ctx context.Context, items []json.RawMessage) (*base.DryRun, error) {
+		return planItems(ctx, items, experienceService.DryRunCreateExperiences)
+	}
+}
+
+// TechStackPlanner validates items in the format of TechStackCreate
+func TechStackPlanner(techStackService tech_stack.TechStackService) Planner {
+	return func(ctx context.Context, items []json.RawMessage) (*base.DryRun, error) {
+		return planItems(ctx, items, techStackService.DryRunCreateTechStacks)
+	}
+}
+
+// planItems dry runs the creation of the items that decode, reporting the
+// others as problems at their position in the file
+func planItems[T any](ctx context.Context, items []json.RawMessage, plan func(ctx context.Context, creates []*T) (*base.DryRun, error)) (*base.DryRun, error) {
+	creates := make([]*T, 0, len(items))
+	positions := make([]int, 0, len(items))
+	malformed := map[int]string{}
+
+	for i, item := range items {
+		create := new(T)
+		if err := decodeItem(item, create); err != nil {
+			malformed[i] = err.Error()
+			continue
+		}
+		creates = append(creates, create)
+		positions = append(positions, i)
+	}
+
+	planned, err := plan(ctx, creates)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := base.NewDryRun()
+	next := 0
+	for i := range items {
+		if problem, ok := malformed[i]; ok {
+			dryRun.Add(base.PlannedChange{Index: i, Action: base.ActionCreate, Problems: []string{problem}})
+			continue
+		}
+		change := planned.Changes[next]
+		change.Index = positions[next]
+		dryRun.Add(change)
+		next++
+	}
+
+	return dryRun, nil
+}
+
+// decodeItem decodes a single item, failing it rather than the import when
+// it is malformed
+func decodeItem(item json.RawMessage, into interface{}) error {
+	if err := json.Unmarshal(item, into); err != nil {
+		return errors.New(errors.ErrValidation, "Invalid item format: "+err.Error(), err)
+	}
+	return nil
+}
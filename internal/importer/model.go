@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"encoding/json"
+)
+
+// Entity names a kind of content that can be imported
+// @Description Kind of content in an import
+// @Name ImportEntity
+type Entity string
+
+const (
+	EntityProjects    Entity = "projects"
+	EntityExperiences Entity = "experiences"
+	EntityTechStacks  Entity = "tech_stacks"
+)
+
+var entities = map[Entity]bool{
+	EntityProjects:    true,
+	EntityExperiences: true,
+	EntityTechStacks:  true,
+}
+
+// ItemStatus is the outcome of importing a single item
+// @Description Outcome of importing a single item
+// @Name ImportItemStatus
+type ItemStatus string
+
+const (
+	ItemSucceeded ItemStatus = "succeeded"
+	ItemFailed    ItemStatus = "failed"
+)
+
+// ItemResult records how one item of an import went
+// @Description Outcome of one item of an import, by its position in the file
+// @Name ImportItemResult
+type ItemResult struct {
+	Index  int        `json:"index" example:"3"`
+	Status ItemStatus `json:"status" example:"failed"`
+	ID     string     `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Error  string     `json:"error,omitempty" example:"VALIDATION_ERROR: Invalid project category"`
+}
+
+// Report is the result of an import job. It is saved after every item, so
+// the job status shows how far a running import has come.
+// @Description Per-item progress and outcome of an import
+// @Name ImportReport
+type Report struct {
+	Entity    Entity       `json:"entity" example:"projects"`
+	Total     int          `json:"total" example:"40"`
+	Processed int          `json:"processed" example:"12"`
+	Succeeded int          `json:"succeeded" example:"11"`
+	Failed    int          `json:"failed" example:"1"`
+	Items     []ItemResult `json:"items"`
+}
+
+// importPayload is the payload of an import job
+type importPayload struct {
+	Entity Entity            `json:"entity"`
+	Items  []json.RawMessage `json:"items"`
+}
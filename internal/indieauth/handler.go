@@ -0,0 +1,280 @@
+package indieauth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type IndieAuthHandler struct {
+	base.BaseHandler
+	indieAuthService IndieAuthService
+}
+
+func NewIndieAuthHandler(indieAuthService IndieAuthService, logger *logger.Logger) *IndieAuthHandler {
+	return &IndieAuthHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		indieAuthService: indieAuthService,
+	}
+}
+
+// GetMetadata retrieves the server metadata
+// @Summary IndieAuth server metadata
+// @Description Retrieve the IndieAuth endpoints. The site links this endpoint with rel="indieauth-metadata" so that IndieWeb tools can discover it from the site URL.
+// @Tags IndieAuth
+// @Produce json
+// @Success 200 {object} Metadata "Server metadata"
+// @Failure 500 {object} response.APIResponse "IndieAuth is not enabled"
+// @Router /indieauth/metadata [get]
+func (h *IndieAuthHandler) GetMetadata(c *gin.Context) {
+	metadata, err := h.indieAuthService.Metadata()
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Access-Control-Allow-Origin", "*")
+	c.JSON(http.StatusOK, metadata)
+}
+
+// Authorize starts an authorization request
+// @Summary IndieAuth authorization endpoint
+// @Description Validate an authorization request from a client and redirect the browser to the consent page of the site, where the signed-in owner approves it
+// @Tags IndieAuth
+// @Param response_type query string true "Must be code"
+// @Param client_id query string true "Client URL"
+// @Param redirect_uri query string true "Redirect URI on the client host"
+// @Param state query string true "Client state"
+// @Param code_challenge query string true "PKCE code challenge"
+// @Param code_challenge_method query string true "Must be S256"
+// @Param scope query string false "Space-separated scopes"
+// @Param me query string false "Profile URL hint"
+// @Success 302 "Redirect to the consent page"
+// @Failure 400 {object} response.APIResponse "Invalid authorization request"
+// @Failure 500 {object} response.APIResponse "IndieAuth is not enabled"
+// @Router /indieauth/auth [get]
+func (h *IndieAuthHandler) Authorize(c *gin.Context) {
+	var request AuthorizationRequest
+	if err := h.ValidateRequest(c, &request); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	consentURL, err := h.indieAuthService.ConsentURL(c.Request.Context(), &request)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, consentURL)
+}
+
+// RedeemProfile exchanges a code for the profile URL
+// @Summary Redeem an authorization code for the profile URL
+// @Description Confirm the owner's profile URL to a client signing the owner in. The code is checked against the client ID, redirect URI and PKCE verifier and can be redeemed once.
+// @Tags IndieAuth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param redemption formData CodeRedemption true "Authorization Code"
+// @Success 200 {object} Profile "Profile URL"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Authorization code is invalid or has expired"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /indieauth/auth [post]
+func (h *IndieAuthHandler) RedeemProfile(c *gin.Context) {
+	var redemption CodeRedemption
+	if err := h.ValidateRequest(c, &redemption); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	profile, err := h.indieAuthService.RedeemProfile(c.Request.Context(), &redemption)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, profile)
+}
+
+// RedeemToken exchanges a code for an access token
+// @Summary Redeem an authorization code for an access token
+// @Description Issue an access token for a code approved with a scope. The code is checked against the client ID, redirect URI and PKCE verifier and can be redeemed once.
+// @Tags IndieAuth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param redemption formData CodeRedemption true "Authorization Code"
+// @Success 200 {object} Token "Access token"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Authorization code is invalid or has expired"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /indieauth/token [post]
+func (h *IndieAuthHandler) RedeemToken(c *gin.Context) {
+	var redemption CodeRedemption
+	if err := h.ValidateRequest(c, &redemption); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	token, err := h.indieAuthService.RedeemToken(c.Request.Context(), &redemption)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, token)
+}
+
+// VerifyToken describes an access token
+// @Summary Verify an access token
+// @Description Describe the access token sent as a bearer token, for resource servers checking the tokens presented to them
+// @Tags IndieAuth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} TokenInfo "Token details"
+// @Failure 401 {object} response.APIResponse "Access token is invalid or has expired"
+// @Router /indieauth/token [get]
+func (h *IndieAuthHandler) VerifyToken(c *gin.Context) {
+	info, err := h.indieAuthService.VerifyToken(c.Request.Context(), bearerToken(c))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, info)
+}
+
+// RevokeToken revokes an access token
+// @Summary Revoke an access token
+// @Description Revoke an access token, as clients do when signing out. Unknown tokens are accepted as well.
+// @Tags IndieAuth
+// @Accept x-www-form-urlencoded
+// @Param revocation formData Revocation true "Access Token"
+// @Success 200 "Token revoked"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /indieauth/revoke [post]
+func (h *IndieAuthHandler) RevokeToken(c *gin.Context) {
+	var revocation Revocation
+	if err := h.ValidateRequest(c, &revocation); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.indieAuthService.RevokeToken(c.Request.Context(), &revocation); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// Approve approves an authorization request
+// @Summary Approve an IndieAuth request
+// @Description Issue an authorization code for a request shown on the consent page. The scope may be narrowed before approving. The browser is then sent to the returned redirect URL.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AuthorizationRequest true "Authorization Request"
+// @Success 200 {object} response.APIResponse{data=Approval} "Authorization approved successfully"
+// @Failure 400 {object} response.APIResponse "Invalid authorization request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "IndieAuth is not enabled"
+// @Router /admin/indieauth/approve [post]
+func (h *IndieAuthHandler) Approve(c *gin.Context) {
+	var request AuthorizationRequest
+	if err := h.ValidateRequest(c, &request); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	approval, err := h.indieAuthService.Approve(c.Request.Context(), &request)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, approval, "Authorization approved successfully")
+}
+
+// ListAuthorizations retrieves the authorized clients
+// @Summary List IndieAuth authorizations
+// @Description Retrieve a paginated list of access tokens granted to IndieAuth clients, newest first
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]Authorization} "Authorizations retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/indieauth/authorizations [get]
+func (h *IndieAuthHandler) ListAuthorizations(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	authorizations, err := h.indieAuthService.ListAuthorizations(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total authorizations for pagination
+	total, err := h.indieAuthService.CountAuthorizations(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandlePagination(c, authorizations, total, opts)
+}
+
+// DeleteAuthorization revokes an authorization
+// @Summary Revoke an IndieAuth authorization
+// @Description Revoke the access token granted to a client
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Authorization ID"
+// @Success 200 {object} response.APIResponse "Authorization revoked successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Authorization not found"
+// @Router /admin/indieauth/authorizations/{id} [delete]
+func (h *IndieAuthHandler) DeleteAuthorization(c *gin.Context) {
+	authorizationID := c.Param("id")
+	if _, err := h.ValidateUUID(authorizationID, "Authorization ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.indieAuthService.DeleteAuthorization(c.Request.Context(), authorizationID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Authorization revoked successfully")
+}
+
+func bearerToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	if token == authHeader {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
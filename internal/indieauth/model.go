@@ -0,0 +1,128 @@
+package indieauth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Code is an authorization code issued once the owner approves a request,
+// stored by the hash of its value
+type Code struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	TenantID      *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	CodeHash      string     `json:"code_hash" db:"code_hash"`
+	ClientID      string     `json:"client_id" db:"client_id"`
+	RedirectURI   string     `json:"redirect_uri" db:"redirect_uri"`
+	CodeChallenge string     `json:"code_challenge" db:"code_challenge"`
+	Scope         string     `json:"scope" db:"scope"`
+	Me            string     `json:"me" db:"me"`
+	ExpiresAt     time.Time  `json:"expires_at" db:"expires_at"`
+
+	// UsedAt is set once the code has been redeemed
+	UsedAt *time.Time `json:"used_at,omitempty" db:"used_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Authorization is an access token granted to a client, stored by the hash
+// of its value
+// @Description Access token granted to an IndieAuth client
+// @Name IndieAuthAuthorization
+type Authorization struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	TokenHash  string     `json:"-" db:"token_hash"`
+	ClientID   string     `json:"client_id" db:"client_id" example:"https://quill.p3k.io/"`
+	Scope      string     `json:"scope" db:"scope" example:"create media"`
+	Me         string     `json:"me" db:"me" example:"https://itsrama.com/"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// AuthorizationRequest is the request a client sends the owner's browser
+// to the authorization endpoint with
+// @Name IndieAuthAuthorizationRequest
+type AuthorizationRequest struct {
+	ResponseType        string `json:"response_type" form:"response_type" validate:"required" example:"code"`
+	ClientID            string `json:"client_id" form:"client_id" validate:"required,max=2048" example:"https://quill.p3k.io/"`
+	RedirectURI         string `json:"redirect_uri" form:"redirect_uri" validate:"required,max=2048" example:"https://quill.p3k.io/auth/callback"`
+	State               string `json:"state" form:"state" validate:"required,max=1024" example:"1234567890"`
+	CodeChallenge       string `json:"code_challenge" form:"code_challenge" validate:"required,min=43,max=128" example:"OfYAxt8zU2dAPDWQxTAUIteRzMsoj9QBdMIVEDOErUo"`
+	CodeChallengeMethod string `json:"code_challenge_method" form:"code_challenge_method" validate:"required" example:"S256"`
+	Scope               string `json:"scope,omitempty" form:"scope" validate:"max=1024" example:"create media"`
+	Me                  string `json:"me,omitempty" form:"me" validate:"max=2048" example:"https://itsrama.com/"`
+}
+
+// Approval is where the owner's browser is sent after approving a request
+// @Description Redirect back to the client carrying the authorization code
+// @Name IndieAuthApproval
+type Approval struct {
+	RedirectURL string `json:"redirect_url" example:"https://quill.p3k.io/auth/callback?code=Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h&iss=https%3A%2F%2Fapi.itsrama.com%2Fapi%2Fv1%2Findieauth&state=1234567890"`
+}
+
+// CodeRedemption is the input for exchanging an authorization code for the
+// owner's profile URL or an access token
+// @Name IndieAuthCodeRedemption
+type CodeRedemption struct {
+	GrantType    string `json:"grant_type" form:"grant_type" validate:"required" example:"authorization_code"`
+	Code         string `json:"code" form:"code" validate:"required" example:"Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"`
+	ClientID     string `json:"client_id" form:"client_id" validate:"required" example:"https://quill.p3k.io/"`
+	RedirectURI  string `json:"redirect_uri" form:"redirect_uri" validate:"required" example:"https://quill.p3k.io/auth/callback"`
+	CodeVerifier string `json:"code_verifier" form:"code_verifier" validate:"required,min=43,max=128" example:"a6128783714cfda1d388e2e98b6ae8221ac31aca31959e59512c59f5"`
+}
+
+// Revocation is the input for revoking an access token
+// @Name IndieAuthRevocation
+type Revocation struct {
+	Token string `json:"token" form:"token" validate:"required" example:"Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"`
+}
+
+// Profile is the response of redeeming a code at the authorization endpoint
+// @Description Owner profile URL confirmed by the authorization endpoint
+// @Name IndieAuthProfile
+type Profile struct {
+	Me string `json:"me" example:"https://itsrama.com/"`
+}
+
+// Token is the response of redeeming a code at the token endpoint
+// @Description Access token issued to an IndieAuth client
+// @Name IndieAuthToken
+type Token struct {
+	AccessToken string `json:"access_token" example:"Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"`
+	TokenType   string `json:"token_type" example:"Bearer"`
+	Scope       string `json:"scope" example:"create media"`
+	Me          string `json:"me" example:"https://itsrama.com/"`
+	ExpiresIn   int64  `json:"expires_in" example:"7776000"`
+}
+
+// TokenInfo describes a valid access token to the resource server
+// verifying it
+// @Description Details of a valid IndieAuth access token
+// @Name IndieAuthTokenInfo
+type TokenInfo struct {
+	Active   bool   `json:"active" example:"true"`
+	Me       string `json:"me" example:"https://itsrama.com/"`
+	ClientID string `json:"client_id" example:"https://quill.p3k.io/"`
+	Scope    string `json:"scope" example:"create media"`
+	Exp      int64  `json:"exp" example:"1767225600"`
+	Iat      int64  `json:"iat" example:"1759449600"`
+}
+
+// Metadata is the IndieAuth server metadata clients discover the
+// endpoints from
+// @Description IndieAuth server metadata
+// @Name IndieAuthMetadata
+type Metadata struct {
+	Issuer                                     string   `json:"issuer" example:"https://api.itsrama.com/api/v1/indieauth"`
+	AuthorizationEndpoint                      string   `json:"authorization_endpoint" example:"https://api.itsrama.com/api/v1/indieauth/auth"`
+	TokenEndpoint                              string   `json:"token_endpoint" example:"https://api.itsrama.com/api/v1/indieauth/token"`
+	RevocationEndpoint                         string   `json:"revocation_endpoint" example:"https://api.itsrama.com/api/v1/indieauth/revoke"`
+	ResponseTypesSupported                     []string `json:"response_types_supported"`
+	GrantTypesSupported                        []string `json:"grant_types_supported"`
+	CodeChallengeMethodsSupported              []string `json:"code_challenge_methods_supported"`
+	AuthorizationResponseIssParameterSupported bool     `json:"authorization_response_iss_parameter_supported"`
+}
@@ -0,0 +1,243 @@
+package indieauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type CodeRepository interface {
+	Create(ctx context.Context, code *Code) (*Code, error)
+	// FindByHash returns the code with the given hash, or nil if none
+	FindByHash(ctx context.Context, codeHash string) (*Code, error)
+	// MarkUsed claims an unused code, reporting false if it was used already
+	MarkUsed(ctx context.Context, id string) (bool, error)
+}
+
+type codeRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewCodeRepository(supabaseClient *supabase.SupabaseClient) CodeRepository {
+	return &codeRepository{
+		supabaseClient: supabaseClient,
+		table:          "indieauth_code",
+	}
+}
+
+func (r *codeRepository) Create(ctx context.Context, code *Code) (*Code, error) {
+	code.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(code, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create authorization code")
+	}
+	return code, nil
+}
+
+func (r *codeRepository) FindByHash(ctx context.Context, codeHash string) (*Code, error) {
+	var codes []Code
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("code_hash", codeHash)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&codes)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find authorization code")
+	}
+
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	return &codes[0], nil
+}
+
+// MarkUsed sets used_at only while it is still empty, so that a code
+// replayed concurrently is redeemed once
+func (r *codeRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	var codes []Code
+	now := time.Now().UTC()
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"used_at":    now,
+			"updated_at": now,
+		}, "representation", "").
+		Eq("id", id).
+		Is("used_at", "null")
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&codes)
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to use authorization code")
+	}
+	return len(codes) > 0, nil
+}
+
+type AuthorizationRepository interface {
+	Create(ctx context.Context, authorization *Authorization) (*Authorization, error)
+	// FindByHash returns the authorization with the given token hash, or nil
+	// if none
+	FindByHash(ctx context.Context, tokenHash string) (*Authorization, error)
+	// FindByID returns the authorization with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Authorization, error)
+	// Touch records that the token of an authorization was just used
+	Touch(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, opts base.ListOptions) ([]Authorization, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type authorizationRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewAuthorizationRepository(supabaseClient *supabase.SupabaseClient) AuthorizationRepository {
+	return &authorizationRepository{
+		supabaseClient: supabaseClient,
+		table:          "indieauth_authorization",
+	}
+}
+
+func (r *authorizationRepository) Create(ctx context.Context, authorization *Authorization) (*Authorization, error) {
+	authorization.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(authorization, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create authorization")
+	}
+	return authorization, nil
+}
+
+func (r *authorizationRepository) FindByHash(ctx context.Context, tokenHash string) (*Authorization, error) {
+	var authorizations []Authorization
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("token_hash", tokenHash)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&authorizations)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find authorization")
+	}
+
+	if len(authorizations) == 0 {
+		return nil, nil
+	}
+	return &authorizations[0], nil
+}
+
+func (r *authorizationRepository) FindByID(ctx context.Context, id string) (*Authorization, error) {
+	var authorizations []Authorization
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&authorizations)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find authorization")
+	}
+
+	if len(authorizations) == 0 {
+		return nil, nil
+	}
+	return &authorizations[0], nil
+}
+
+func (r *authorizationRepository) Touch(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"last_used_at": now,
+		}, "minimal", "").
+		Eq("id", id)
+
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update authorization")
+	}
+	return nil
+}
+
+func (r *authorizationRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete authorization")
+	}
+	return nil
+}
+
+func (r *authorizationRepository) List(ctx context.Context, opts base.ListOptions) ([]Authorization, error) {
+	var authorizations []Authorization
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: opts.SortOrder == base.SortAscending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&authorizations)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list authorizations")
+	}
+
+	return authorizations, nil
+}
+
+func (r *authorizationRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count authorizations")
+	}
+
+	return int(count), nil
+}
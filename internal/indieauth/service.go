@@ -0,0 +1,501 @@
+package indieauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	stderrors "errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// Options configures the IndieAuth server
+type Options struct {
+	Enabled bool
+	// BaseURL is the public URL of the API
+	BaseURL string
+	// ConsentPath is the path of the consent page below the site canonical URL
+	ConsentPath string
+	CodeTTL     time.Duration
+	TokenTTL    time.Duration
+}
+
+type IndieAuthService interface {
+	// Metadata describes the endpoints of the server
+	Metadata() (*Metadata, error)
+	// ConsentURL validates an authorization request and returns the consent
+	// page the owner's browser is sent to
+	ConsentURL(ctx context.Context, request *AuthorizationRequest) (string, error)
+	// Approve issues an authorization code for a request the owner consented
+	// to and returns where to send the browser back to
+	Approve(ctx context.Context, request *AuthorizationRequest) (*Approval, error)
+	// RedeemProfile exchanges a code for the owner's profile URL, which
+	// signs the owner in to the client
+	RedeemProfile(ctx context.Context, redemption *CodeRedemption) (*Profile, error)
+	// RedeemToken exchanges a code issued with a scope for an access token
+	RedeemToken(ctx context.Context, redemption *CodeRedemption) (*Token, error)
+	// VerifyToken describes a valid access token
+	VerifyToken(ctx context.Context, token string) (*TokenInfo, error)
+	// RevokeToken revokes an access token. Unknown tokens are ignored.
+	RevokeToken(ctx context.Context, revocation *Revocation) error
+
+	ListAuthorizations(ctx context.Context, opts base.ListOptions) ([]Authorization, error)
+	CountAuthorizations(ctx context.Context, filters []base.FilterOption) (int, error)
+	DeleteAuthorization(ctx context.Context, id string) error
+}
+
+type indieAuthService struct {
+	codeRepo          CodeRepository
+	authorizationRepo AuthorizationRepository
+	siteConfigService site_config.SiteConfigService
+	enabled           bool
+	baseURL           string
+	consentPath       string
+	codeTTL           time.Duration
+	tokenTTL          time.Duration
+}
+
+func NewIndieAuthService(codeRepo CodeRepository, authorizationRepo AuthorizationRepository, siteConfigService site_config.SiteConfigService, opts Options) IndieAuthService {
+	codeTTL := opts.CodeTTL
+	if codeTTL <= 0 {
+		codeTTL = 10 * time.Minute
+	}
+	tokenTTL := opts.TokenTTL
+	if tokenTTL <= 0 {
+		tokenTTL = 90 * 24 * time.Hour
+	}
+
+	return &indieAuthService{
+		codeRepo:          codeRepo,
+		authorizationRepo: authorizationRepo,
+		siteConfigService: siteConfigService,
+		enabled:           opts.Enabled,
+		baseURL:           strings.TrimRight(opts.BaseURL, "/"),
+		consentPath:       "/" + strings.TrimLeft(opts.ConsentPath, "/"),
+		codeTTL:           codeTTL,
+		tokenTTL:          tokenTTL,
+	}
+}
+
+func (s *indieAuthService) Metadata() (*Metadata, error) {
+	if !s.enabled {
+		return nil, errNotEnabled()
+	}
+
+	return &Metadata{
+		Issuer:                        s.issuer(),
+		AuthorizationEndpoint:         s.issuer() + "/auth",
+		TokenEndpoint:                 s.issuer() + "/token",
+		RevocationEndpoint:            s.issuer() + "/revoke",
+		ResponseTypesSupported:        []string{"code"},
+		GrantTypesSupported:           []string{"authorization_code"},
+		CodeChallengeMethodsSupported: []string{"S256"},
+		AuthorizationResponseIssParameterSupported: true,
+	}, nil
+}
+
+func (s *indieAuthService) ConsentURL(ctx context.Context, request *AuthorizationRequest) (string, error) {
+	if !s.enabled {
+		return "", errNotEnabled()
+	}
+
+	if err := s.validateRequest(request); err != nil {
+		return "", err
+	}
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", request.ResponseType)
+	query.Set("client_id", request.ClientID)
+	query.Set("redirect_uri", request.RedirectURI)
+	query.Set("state", request.State)
+	query.Set("code_challenge", request.CodeChallenge)
+	query.Set("code_challenge_method", request.CodeChallengeMethod)
+	if request.Scope != "" {
+		query.Set("scope", request.Scope)
+	}
+
+	consent := canonical.ResolveReference(&url.URL{Path: s.consentPath})
+	consent.RawQuery = query.Encode()
+	return consent.String(), nil
+}
+
+func (s *indieAuthService) Approve(ctx context.Context, request *AuthorizationRequest) (*Approval, error) {
+	if !s.enabled {
+		return nil, errNotEnabled()
+	}
+
+	if err := s.validateRequest(request); err != nil {
+		return nil, err
+	}
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	code := &Code{
+		ID:            uuid.New(),
+		CodeHash:      hashToken(value),
+		ClientID:      request.ClientID,
+		RedirectURI:   request.RedirectURI,
+		CodeChallenge: request.CodeChallenge,
+		Scope:         normalizeScope(request.Scope),
+		Me:            canonical.String(),
+		ExpiresAt:     now.Add(s.codeTTL),
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+	if _, err := s.codeRepo.Create(ctx, code); err != nil {
+		return nil, err
+	}
+
+	// The redirect URI was validated above and can be parsed
+	redirectURL, _ := url.Parse(request.RedirectURI)
+	query := redirectURL.Query()
+	query.Set("code", value)
+	query.Set("state", request.State)
+	query.Set("iss", s.issuer())
+	redirectURL.RawQuery = query.Encode()
+
+	return &Approval{RedirectURL: redirectURL.String()}, nil
+}
+
+func (s *indieAuthService) RedeemProfile(ctx context.Context, redemption *CodeRedemption) (*Profile, error) {
+	code, err := s.redeem(ctx, redemption, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{Me: code.Me}, nil
+}
+
+func (s *indieAuthService) RedeemToken(ctx context.Context, redemption *CodeRedemption) (*Token, error) {
+	code, err := s.redeem(ctx, redemption, true)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	authorization := &Authorization{
+		ID:        uuid.New(),
+		TokenHash: hashToken(value),
+		ClientID:  code.ClientID,
+		Scope:     code.Scope,
+		Me:        code.Me,
+		ExpiresAt: now.Add(s.tokenTTL),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+	if _, err := s.authorizationRepo.Create(ctx, authorization); err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		AccessToken: value,
+		TokenType:   "Bearer",
+		Scope:       authorization.Scope,
+		Me:          authorization.Me,
+		ExpiresIn:   int64(s.tokenTTL / time.Second),
+	}, nil
+}
+
+func (s *indieAuthService) VerifyToken(ctx context.Context, token string) (*TokenInfo, error) {
+	if !s.enabled {
+		return nil, errNotEnabled()
+	}
+
+	if token == "" {
+		return nil, errInvalidToken()
+	}
+
+	authorization, err := s.authorizationRepo.FindByHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if authorization == nil || time.Now().UTC().After(authorization.ExpiresAt) {
+		return nil, errInvalidToken()
+	}
+
+	if err := s.authorizationRepo.Touch(ctx, authorization.ID.String()); err != nil {
+		return nil, err
+	}
+
+	info := &TokenInfo{
+		Active:   true,
+		Me:       authorization.Me,
+		ClientID: authorization.ClientID,
+		Scope:    authorization.Scope,
+		Exp:      authorization.ExpiresAt.Unix(),
+	}
+	if authorization.CreatedAt != nil {
+		info.Iat = authorization.CreatedAt.Unix()
+	}
+	return info, nil
+}
+
+func (s *indieAuthService) RevokeToken(ctx context.Context, revocation *Revocation) error {
+	// Validate input
+	if err := validator.ValidateModel(revocation); err != nil {
+		return err
+	}
+
+	authorization, err := s.authorizationRepo.FindByHash(ctx, hashToken(revocation.Token))
+	if err != nil {
+		return err
+	}
+	if authorization == nil {
+		return nil
+	}
+
+	return s.authorizationRepo.Delete(ctx, authorization.ID.String())
+}
+
+func (s *indieAuthService) ListAuthorizations(ctx context.Context, opts base.ListOptions) ([]Authorization, error) {
+	// Set default sorting if not provided
+	if opts.SortBy == "" {
+		opts.SortBy = "created_at"
+		opts.SortOrder = base.SortDescending
+	}
+
+	return s.authorizationRepo.List(ctx, opts)
+}
+
+func (s *indieAuthService) CountAuthorizations(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.authorizationRepo.Count(ctx, filters)
+}
+
+func (s *indieAuthService) DeleteAuthorization(ctx context.Context, id string) error {
+	authorization, err := s.authorizationRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if authorization == nil {
+		return errors.New(
+			errors.ErrNotFound,
+			"Authorization not found",
+			nil,
+			errors.WithContext("authorization_id", id),
+		)
+	}
+
+	return s.authorizationRepo.Delete(ctx, id)
+}
+
+// redeem claims the code of a redemption after checking it was issued to
+// the same client and redirect URI and that the PKCE verifier matches
+func (s *indieAuthService) redeem(ctx context.Context, redemption *CodeRedemption, requireScope bool) (*Code, error) {
+	if !s.enabled {
+		return nil, errNotEnabled()
+	}
+
+	// Validate input
+	if err := validator.ValidateModel(redemption); err != nil {
+		return nil, err
+	}
+	if redemption.GrantType != "authorization_code" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Grant type must be authorization_code",
+			nil,
+			errors.WithContext("grant_type", redemption.GrantType),
+		)
+	}
+
+	code, err := s.codeRepo.FindByHash(ctx, hashToken(redemption.Code))
+	if err != nil {
+		return nil, err
+	}
+	if code == nil || code.UsedAt != nil || time.Now().UTC().After(code.ExpiresAt) {
+		return nil, errInvalidCode()
+	}
+	if code.ClientID != redemption.ClientID || code.RedirectURI != redemption.RedirectURI {
+		return nil, errInvalidCode()
+	}
+
+	challenge := sha256.Sum256([]byte(redemption.CodeVerifier))
+	expected := base64.RawURLEncoding.EncodeToString(challenge[:])
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(code.CodeChallenge)) != 1 {
+		return nil, errInvalidCode()
+	}
+
+	// Codes approved without a scope only sign the owner in
+	if requireScope && code.Scope == "" {
+		return nil, errors.New(
+			errors.ErrBadRequest,
+			"Authorization code was issued without a scope and cannot be exchanged for an access token",
+			nil,
+		)
+	}
+
+	claimed, err := s.codeRepo.MarkUsed(ctx, code.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errInvalidCode()
+	}
+
+	return code, nil
+}
+
+// validateRequest checks an authorization request beyond its field tags.
+// Client metadata is not fetched, so the redirect URI must be on the same
+// origin as the client ID.
+func (s *indieAuthService) validateRequest(request *AuthorizationRequest) error {
+	if err := validator.ValidateModel(request); err != nil {
+		return err
+	}
+
+	if request.ResponseType != "code" {
+		return errors.New(
+			errors.ErrValidation,
+			"Response type must be code",
+			nil,
+			errors.WithContext("response_type", request.ResponseType),
+		)
+	}
+	if request.CodeChallengeMethod != "S256" {
+		return errors.New(
+			errors.ErrValidation,
+			"Code challenge method must be S256",
+			nil,
+			errors.WithContext("code_challenge_method", request.CodeChallengeMethod),
+		)
+	}
+
+	clientID, err := parseClientURL(request.ClientID)
+	if err != nil {
+		return errors.New(
+			errors.ErrValidation,
+			"Client ID must be an http or https URL without a fragment or credentials",
+			err,
+			errors.WithContext("client_id", request.ClientID),
+		)
+	}
+
+	redirectURI, err := parseClientURL(request.RedirectURI)
+	if err != nil {
+		return errors.New(
+			errors.ErrValidation,
+			"Redirect URI must be an http or https URL without a fragment or credentials",
+			err,
+			errors.WithContext("redirect_uri", request.RedirectURI),
+		)
+	}
+
+	if redirectURI.Scheme != clientID.Scheme || !strings.EqualFold(redirectURI.Host, clientID.Host) {
+		return errors.New(
+			errors.ErrValidation,
+			"Redirect URI must be on the same host as the client ID",
+			nil,
+			errors.WithContext("client_id", request.ClientID),
+			errors.WithContext("redirect_uri", request.RedirectURI),
+		)
+	}
+
+	return nil
+}
+
+func (s *indieAuthService) issuer() string {
+	return s.baseURL + "/indieauth"
+}
+
+// canonicalURL returns the site URL, which is the owner's profile URL
+func (s *indieAuthService) canonicalURL(ctx context.Context) (*url.URL, error) {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := parseClientURL(siteConfig.SEO.CanonicalURL)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Site canonical URL must be set to use IndieAuth",
+			err,
+		)
+	}
+	canonical.RawQuery = ""
+	if canonical.Path == "" {
+		canonical.Path = "/"
+	}
+	return canonical, nil
+}
+
+func parseClientURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, stderrors.New("not an absolute http or https URL")
+	}
+	if u.Fragment != "" || u.User != nil {
+		return nil, stderrors.New("URL has a fragment or credentials")
+	}
+	return u, nil
+}
+
+// normalizeScope removes duplicate and extra whitespace from a scope list
+func normalizeScope(scope string) string {
+	seen := make(map[string]bool)
+	var scopes []string
+	for _, name := range strings.Fields(scope) {
+		if !seen[name] {
+			seen[name] = true
+			scopes = append(scopes, name)
+		}
+	}
+	return strings.Join(scopes, " ")
+}
+
+func generateToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.Wrap(err, errors.ErrInternal, "Failed to generate IndieAuth token")
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func errNotEnabled() error {
+	return errors.New(errors.ErrConfiguration, "IndieAuth is not enabled", nil)
+}
+
+func errInvalidCode() error {
+	return errors.New(errors.ErrUnauthorized, "Authorization code is invalid or has expired", nil)
+}
+
+func errInvalidToken() error {
+	return errors.New(errors.ErrUnauthorized, "Access token is invalid or has expired", nil)
+}
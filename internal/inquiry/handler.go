@@ -0,0 +1,516 @@
+package inquiry
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experiment"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type InquiryHandler struct {
+	base.BaseHandler
+	inquiryService    InquiryService
+	proposalService   ProposalService
+	paymentService    PaymentService
+	experimentService experiment.ExperimentService
+	logger            *logger.Logger
+}
+
+// maxWebhookBodySize caps the payment webhook payloads read into memory
+const maxWebhookBodySize = 1 << 20
+
+func NewInquiryHandler(inquiryService InquiryService, proposalService ProposalService, paymentService PaymentService, experimentService experiment.ExperimentService, logger *logger.Logger) *InquiryHandler {
+	return &InquiryHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		inquiryService:    inquiryService,
+		proposalService:   proposalService,
+		paymentService:    paymentService,
+		experimentService: experimentService,
+		logger:            logger,
+	}
+}
+
+// SubmitInquiry records a project inquiry from a prospect
+// @Summary Submit an inquiry
+// @Description Send a project inquiry, which starts in the "new" stage of the pipeline. It counts as a conversion in the experiments the visitor was shown variants of. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers. Submissions the spam filter flags are kept in the "spam" stage instead, answered the same way.
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Param X-Visitor-ID header string false "Random ID the frontend keeps for the visitor"
+// @Param inquiry body InquiryCreate true "Inquiry Details"
+// @Success 200 {object} response.APIResponse{data=Inquiry} "Inquiry submitted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "Missing or invalid challenge"
+// @Failure 429 {object} response.APIResponse "Too many inquiries"
+// @Router /inquiries [post]
+func (h *InquiryHandler) SubmitInquiry(c *gin.Context) {
+	var inquiryInput InquiryCreate
+	if err := h.ValidateRequest(c, &inquiryInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	inquiry, err := h.inquiryService.SubmitInquiry(c.Request.Context(), &inquiryInput, RequestMeta{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	})
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Spam is answered like any submission so senders cannot tell it was
+	// flagged, but it converts nothing
+	if inquiry.Status == StatusSpam {
+		h.logger.Info("Inquiry flagged as spam",
+			"inquiry_id", inquiry.ID,
+			"spam_score", inquiry.SpamScore,
+		)
+		answered := *inquiry
+		answered.Status = StatusNew
+		answered.SpamScore = 0
+		answered.SpamReasons = nil
+		inquiry = &answered
+	} else if err := h.experimentService.RecordConversion(c.Request.Context(), experiment.VisitorID(c)); err != nil {
+		// The inquiry is in; a conversion that fails to record only skews results
+		h.logger.Warn("Failed to record experiment conversion", "error", err)
+	}
+
+	h.HandleSuccess(c, inquiry, "Inquiry submitted successfully")
+}
+
+// ListInquiries retrieves the inquiry pipeline
+// @Summary List inquiries
+// @Description Retrieve a paginated list of inquiries, newest first, optionally filtered by status. Inquiries flagged as spam are only listed when filtering by the spam status.
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param status query string false "Pipeline stage" Enums(new, qualified, proposal_sent, accepted, declined, paid, spam)
+// @Success 200 {object} response.APIResponse{data=[]Inquiry} "Inquiries retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/inquiries [get]
+func (h *InquiryHandler) ListInquiries(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if status := c.Query("status"); status != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "status",
+			Operator: base.OperatorEqual,
+			Value:    status,
+		})
+	} else {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "status",
+			Operator: base.OperatorNotEqual,
+			Value:    string(StatusSpam),
+		})
+	}
+
+	inquiries, err := h.inquiryService.ListInquiries(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total inquiries for pagination
+	total, err := h.inquiryService.CountInquiries(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, inquiries, "Inquiries retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetInquiryByID retrieves a specific inquiry
+// @Summary Get an inquiry by ID
+// @Description Retrieve a specific inquiry using its unique identifier
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Success 200 {object} response.APIResponse{data=Inquiry} "Inquiry retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id} [get]
+func (h *InquiryHandler) GetInquiryByID(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	inquiry, err := h.inquiryService.GetInquiryByID(c.Request.Context(), inquiryID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, inquiry, "Inquiry retrieved successfully")
+}
+
+// UpdateInquiryStatus moves an inquiry through the pipeline
+// @Summary Update the status of an inquiry
+// @Description Move an inquiry to another stage of the pipeline
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Param status body InquiryStatusUpdate true "New Status"
+// @Success 200 {object} response.APIResponse{data=Inquiry} "Inquiry status updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id}/status [patch]
+func (h *InquiryHandler) UpdateInquiryStatus(c *gin.Context) {
+	inquiryID, err := h.ValidateUUID(c.Param("id"), "Inquiry ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var statusInput InquiryStatusUpdate
+	if err := h.ValidateRequest(c, &statusInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	statusInput.ID = inquiryID
+
+	inquiry, err := h.inquiryService.UpdateInquiryStatus(c.Request.Context(), &statusInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, inquiry, "Inquiry status updated successfully")
+}
+
+// DeleteInquiry removes an inquiry and its proposals
+// @Summary Delete an inquiry
+// @Description Permanently remove an inquiry along with its proposals
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Success 200 {object} response.APIResponse "Inquiry deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id} [delete]
+func (h *InquiryHandler) DeleteInquiry(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.inquiryService.DeleteInquiry(c.Request.Context(), inquiryID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Inquiry deleted successfully")
+}
+
+// CreateProposal generates a proposal PDF for an inquiry
+// @Summary Create a proposal
+// @Description Render a branded proposal PDF from line items, store it and optionally email signed download and acceptance links to the prospect
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Param proposal body ProposalCreate true "Proposal Details"
+// @Success 200 {object} response.APIResponse{data=ProposalLink} "Proposal created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id}/proposals [post]
+func (h *InquiryHandler) CreateProposal(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var proposalInput ProposalCreate
+	if err := h.ValidateRequest(c, &proposalInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	link, err := h.proposalService.CreateProposal(c.Request.Context(), inquiryID, &proposalInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, link, "Proposal created successfully")
+}
+
+// ListProposals retrieves the proposals of an inquiry
+// @Summary List proposals of an inquiry
+// @Description Retrieve the proposals quoted to an inquiry, newest first
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Success 200 {object} response.APIResponse{data=[]Proposal} "Proposals retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id}/proposals [get]
+func (h *InquiryHandler) ListProposals(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	proposals, err := h.proposalService.ListProposals(c.Request.Context(), inquiryID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, proposals, "Proposals retrieved successfully")
+}
+
+// SendProposal emails a proposal to the prospect
+// @Summary Send a proposal
+// @Description Email fresh signed download and acceptance links of a proposal to the prospect of its inquiry
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Proposal ID"
+// @Success 200 {object} response.APIResponse{data=ProposalLink} "Proposal sent successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Proposal not found"
+// @Router /admin/proposals/{id}/send [post]
+func (h *InquiryHandler) SendProposal(c *gin.Context) {
+	proposalID := c.Param("id")
+	if _, err := h.ValidateUUID(proposalID, "Proposal ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	link, err := h.proposalService.SendProposal(c.Request.Context(), proposalID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, link, "Proposal sent successfully")
+}
+
+// DownloadProposal serves a proposal PDF for a signed link
+// @Summary Download a proposal
+// @Description Download a proposal PDF using the signed link emailed to the prospect
+// @Tags Inquiries
+// @Produce application/pdf
+// @Param id path string true "Proposal ID"
+// @Param expires query string true "Link expiry as a Unix timestamp"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} binary "Proposal PDF"
+// @Failure 403 {object} response.APIResponse "Link is invalid or has expired"
+// @Failure 404 {object} response.APIResponse "Proposal not found"
+// @Router /proposals/{id}/download [get]
+func (h *InquiryHandler) DownloadProposal(c *gin.Context) {
+	proposalID := c.Param("id")
+	if _, err := h.ValidateUUID(proposalID, "Proposal ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	proposal, pdf, err := h.proposalService.DownloadProposal(c.Request.Context(), proposalID, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s.pdf"`, proposal.Number))
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// GetProposalForAcceptance retrieves a proposal for a signed acceptance link
+// @Summary Review a proposal before accepting
+// @Description Retrieve a proposal and its terms using the signed acceptance link emailed to the prospect, with a fresh download link
+// @Tags Inquiries
+// @Produce json
+// @Param id path string true "Proposal ID"
+// @Param expires query string true "Link expiry as a Unix timestamp"
+// @Param signature query string true "Link signature"
+// @Success 200 {object} response.APIResponse{data=ProposalLink} "Proposal retrieved successfully"
+// @Failure 403 {object} response.APIResponse "Link is invalid or has expired"
+// @Failure 404 {object} response.APIResponse "Proposal not found"
+// @Router /proposals/{id}/accept [get]
+func (h *InquiryHandler) GetProposalForAcceptance(c *gin.Context) {
+	proposalID := c.Param("id")
+	if _, err := h.ValidateUUID(proposalID, "Proposal ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	link, err := h.proposalService.GetProposalForAcceptance(c.Request.Context(), proposalID, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, link, "Proposal retrieved successfully")
+}
+
+// AcceptProposal records the prospect accepting a proposal
+// @Summary Accept a proposal
+// @Description Accept a proposal and its terms using the signed acceptance link. The name typed, time, IP address and user agent are recorded and the inquiry moves to accepted.
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Param id path string true "Proposal ID"
+// @Param expires query string true "Link expiry as a Unix timestamp"
+// @Param signature query string true "Link signature"
+// @Param acceptance body ProposalAcceptance true "Acceptance Details"
+// @Success 200 {object} response.APIResponse{data=Proposal} "Proposal accepted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "Link is invalid or has expired"
+// @Failure 404 {object} response.APIResponse "Proposal not found"
+// @Failure 409 {object} response.APIResponse "Proposal has already been accepted"
+// @Router /proposals/{id}/accept [post]
+func (h *InquiryHandler) AcceptProposal(c *gin.Context) {
+	proposalID := c.Param("id")
+	if _, err := h.ValidateUUID(proposalID, "Proposal ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var acceptanceInput ProposalAcceptance
+	if err := h.ValidateRequest(c, &acceptanceInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	meta := RequestMeta{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+
+	proposal, err := h.proposalService.AcceptProposal(c.Request.Context(), proposalID, c.Query("expires"), c.Query("signature"), &acceptanceInput, meta)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, proposal, "Proposal accepted successfully")
+}
+
+// CreateDepositLink creates a deposit payment link for an accepted proposal
+// @Summary Create a deposit payment link
+// @Description Create a Stripe Checkout link charging a share of an accepted proposal's total. The inquiry moves to paid once Stripe confirms the payment.
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Proposal ID"
+// @Param deposit body DepositCreate false "Deposit Details"
+// @Success 200 {object} response.APIResponse{data=Payment} "Deposit link created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Proposal not found"
+// @Failure 409 {object} response.APIResponse "Proposal has not been accepted yet"
+// @Router /admin/proposals/{id}/deposit [post]
+func (h *InquiryHandler) CreateDepositLink(c *gin.Context) {
+	proposalID := c.Param("id")
+	if _, err := h.ValidateUUID(proposalID, "Proposal ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var depositInput DepositCreate
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &depositInput); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+	}
+
+	payment, err := h.paymentService.CreateDepositLink(c.Request.Context(), proposalID, &depositInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, payment, "Deposit link created successfully")
+}
+
+// ListPayments retrieves the payments of an inquiry
+// @Summary List payments of an inquiry
+// @Description Retrieve the payment links and transactions of an inquiry, newest first
+// @Tags Inquiries
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Success 200 {object} response.APIResponse{data=[]Payment} "Payments retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /admin/inquiries/{id}/payments [get]
+func (h *InquiryHandler) ListPayments(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	payments, err := h.paymentService.ListPayments(c.Request.Context(), inquiryID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, payments, "Payments retrieved successfully")
+}
+
+// StripeWebhook applies Stripe Checkout events to payments
+// @Summary Receive Stripe webhooks
+// @Description Endpoint for Stripe webhook events. The Stripe-Signature header is verified against the webhook secret before completed, failed and expired checkouts are recorded.
+// @Tags Inquiries
+// @Accept json
+// @Produce json
+// @Param Stripe-Signature header string true "Stripe webhook signature"
+// @Success 200 {object} response.APIResponse "Webhook received"
+// @Failure 400 {object} response.APIResponse "Invalid webhook"
+// @Router /webhooks/stripe [post]
+func (h *InquiryHandler) StripeWebhook(c *gin.Context) {
+	// The signature covers the raw body, so it is read before any decoding
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrBadRequest,
+			"Failed to read webhook body",
+			err,
+		))
+		return
+	}
+
+	if err := h.paymentService.HandleStripeWebhook(c.Request.Context(), payload, c.GetHeader("Stripe-Signature")); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Webhook received")
+}
@@ -0,0 +1,60 @@
+package inquiry
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/signedlink"
+)
+
+// Link purposes, signed into every link so that a link for one action
+// cannot be replayed against another
+const (
+	PurposeDownload = "download"
+	PurposeAccept   = "accept"
+)
+
+// LinkSigner creates and verifies expiring links to public inquiry endpoints
+type LinkSigner struct {
+	signer  *signedlink.Signer
+	baseURL string
+	ttl     time.Duration
+}
+
+// NewLinkSigner creates a signer for links below baseURL that stay valid for ttl
+func NewLinkSigner(secret []byte, baseURL string, ttl time.Duration) *LinkSigner {
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+
+	return &LinkSigner{
+		signer:  signedlink.New(secret, "inquiry-link"),
+		baseURL: strings.TrimRight(baseURL, "/"),
+		ttl:     ttl,
+	}
+}
+
+// Sign returns a link to path for purpose on subject and when it expires
+func (s *LinkSigner) Sign(path, purpose, subject string) (string, time.Time) {
+	return s.SignAt(s.baseURL, path, purpose, subject)
+}
+
+// SignAt is like Sign for a link below another base URL, such as a page of
+// the site that calls the API with the signature
+func (s *LinkSigner) SignAt(baseURL, path, purpose, subject string) (string, time.Time) {
+	expiresAt := time.Now().UTC().Add(s.ttl).Truncate(time.Second)
+	expires, signature := s.signer.Sign(expiresAt, purpose, subject)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", signature)
+
+	return strings.TrimRight(baseURL, "/") + path + "?" + query.Encode(), expiresAt
+}
+
+// Verify reports whether signature was issued for purpose on subject and
+// has not expired
+func (s *LinkSigner) Verify(purpose, subject, expires, signature string) bool {
+	return s.signer.Verify(expires, signature, purpose, subject)
+}
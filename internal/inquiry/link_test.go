@@ -0,0 +1,47 @@
+package inquiry
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLinkSignerVerify(t *testing.T) {
+	signer := NewLinkSigner([]byte("secret"), "https://api.example.com", time.Hour)
+	link, _ := signer.Sign("/inquiries/1/quote", PurposeDownload, "quote-1")
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("Sign() returned an invalid URL: %v", err)
+	}
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	// Sign never issues expired links, so one is signed directly
+	expiredAt, expiredSignature := signer.signer.Sign(time.Now().Add(-time.Minute), PurposeDownload, "quote-1")
+
+	tests := []struct {
+		name      string
+		signer    *LinkSigner
+		purpose   string
+		subject   string
+		expires   string
+		signature string
+		want      bool
+	}{
+		{"valid", signer, PurposeDownload, "quote-1", expires, signature, true},
+		{"expired", signer, PurposeDownload, "quote-1", expiredAt, expiredSignature, false},
+		{"other purpose", signer, PurposeAccept, "quote-1", expires, signature, false},
+		{"other subject", signer, PurposeDownload, "quote-2", expires, signature, false},
+		{"tampered signature", signer, PurposeDownload, "quote-1", expires, strings.Repeat("0", len(signature)), false},
+		{"extended expiry", signer, PurposeDownload, "quote-1", "99999999999", signature, false},
+		{"other secret", NewLinkSigner([]byte("other"), "https://api.example.com", time.Hour), PurposeDownload, "quote-1", expires, signature, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.signer.Verify(tt.purpose, tt.subject, tt.expires, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package inquiry
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+// Status is the stage of an inquiry in the sales pipeline
+type Status string
+
+const (
+	StatusNew          Status = "new"
+	StatusQualified    Status = "qualified"
+	StatusProposalSent Status = "proposal_sent"
+	StatusAccepted     Status = "accepted"
+	StatusDeclined     Status = "declined"
+	StatusPaid         Status = "paid"
+
+	// StatusSpam holds submissions flagged by the spam filter, kept out of
+	// the pipeline until moved back to another stage
+	StatusSpam Status = "spam"
+)
+
+var statuses = map[Status]bool{
+	StatusNew:          true,
+	StatusQualified:    true,
+	StatusProposalSent: true,
+	StatusAccepted:     true,
+	StatusDeclined:     true,
+	StatusPaid:         true,
+	StatusSpam:         true,
+}
+
+// Inquiry is a project request from a prospect
+// @Description Project inquiry and its stage in the pipeline
+// @Name Inquiry
+type Inquiry struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Name     string     `json:"name" db:"name" example:"Jane Doe"`
+	Email    string     `json:"email" db:"email" example:"jane@example.com"`
+	Company  string     `json:"company,omitempty" db:"company" example:"Acme Inc."`
+	Message  string     `json:"message" db:"message" example:"We need a new booking API."`
+	Budget   string     `json:"budget,omitempty" db:"budget" example:"$5k - $10k"`
+	Status   Status     `json:"status" db:"status" example:"new"`
+
+	// Spam filter verdict, recorded when the submission was flagged
+	SpamScore   float64  `json:"spam_score,omitempty" db:"spam_score" example:"0.8"`
+	SpamReasons []string `json:"spam_reasons,omitempty" db:"spam_reasons" example:"heuristic: too many links"`
+
+	// Campaign the prospect arrived with
+	base.UTM
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// InquiryCreate is the input for submitting an inquiry
+// @Name InquiryCreate
+type InquiryCreate struct {
+	Name    string `json:"name" validate:"required,max=255" example:"Jane Doe"`
+	Email   string `json:"email" validate:"required,email,max=255" example:"jane@example.com"`
+	Company string `json:"company" validate:"max=255" example:"Acme Inc."`
+	Message string `json:"message" validate:"required,max=5000" example:"We need a new booking API."`
+	Budget  string `json:"budget" validate:"max=100" example:"$5k - $10k"`
+
+	// Campaign the prospect arrived with, as captured by the frontend on
+	// landing
+	base.UTM
+}
+
+// InquiryStatusUpdate is the input for moving an inquiry through the pipeline
+// @Name InquiryStatusUpdate
+type InquiryStatusUpdate struct {
+	ID     uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status Status    `json:"status" validate:"required" example:"qualified"`
+}
+
+// LineItem is a priced entry of a proposal
+// @Name ProposalLineItem
+type LineItem struct {
+	Description string  `json:"description" validate:"required,max=500" example:"API design and implementation"`
+	Quantity    float64 `json:"quantity" validate:"min=0" example:"1"`
+	UnitPrice   float64 `json:"unit_price" validate:"min=0" example:"4500"`
+}
+
+// Amount is the quantity times the unit price of the item
+func (i LineItem) Amount() float64 {
+	return i.Quantity * i.UnitPrice
+}
+
+// Proposal is a quote sent to the prospect of an inquiry
+// @Description Quote for an inquiry, rendered to a PDF
+// @Name Proposal
+type Proposal struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	InquiryID  uuid.UUID  `json:"inquiry_id" db:"inquiry_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Number     string     `json:"number" db:"number" example:"P-20260101-1A2B3C"`
+	Title      string     `json:"title" db:"title" example:"Booking API"`
+	Intro      string     `json:"intro,omitempty" db:"intro" example:"Thanks for reaching out, here is what I propose."`
+	Currency   string     `json:"currency" db:"currency" example:"USD"`
+	LineItems  []LineItem `json:"line_items" db:"line_items"`
+	Total      float64    `json:"total" db:"total" example:"4500"`
+	Terms      string     `json:"terms,omitempty" db:"terms" example:"50% deposit before work starts."`
+	ValidUntil *time.Time `json:"valid_until,omitempty" db:"valid_until"`
+
+	// FilePath is where the PDF is stored; it is only handed out as a signed link
+	FilePath string     `json:"-" db:"file_path"`
+	SentAt   *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+
+	// Acceptance is recorded when the prospect accepts through a signed link
+	AcceptedAt        *time.Time `json:"accepted_at,omitempty" db:"accepted_at"`
+	AcceptedName      string     `json:"accepted_name,omitempty" db:"accepted_name" example:"Jane Doe"`
+	AcceptedIP        string     `json:"accepted_ip,omitempty" db:"accepted_ip" example:"203.0.113.7"`
+	AcceptedUserAgent string     `json:"accepted_user_agent,omitempty" db:"accepted_user_agent" example:"Mozilla/5.0"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// ProposalCreate is the input for generating a proposal
+// @Name ProposalCreate
+type ProposalCreate struct {
+	Title     string     `json:"title" validate:"required,max=255" example:"Booking API"`
+	Intro     string     `json:"intro" validate:"max=5000" example:"Thanks for reaching out, here is what I propose."`
+	Currency  string     `json:"currency" example:"USD"`
+	LineItems []LineItem `json:"line_items" validate:"required,min=1,max=50"`
+	Terms     string     `json:"terms" validate:"max=5000" example:"50% deposit before work starts."`
+
+	// ValidDays overrides how many days the proposal is valid
+	ValidDays int `json:"valid_days" validate:"min=0,max=365" example:"30"`
+
+	// Send emails the download and acceptance links to the prospect right away
+	Send bool `json:"send" example:"true"`
+}
+
+// ProposalLink holds the signed links of a proposal
+// @Name ProposalLink
+type ProposalLink struct {
+	Proposal  Proposal  `json:"proposal"`
+	URL       string    `json:"url" example:"https://api.example.com/api/v1/proposals/550e8400-e29b-41d4-a716-446655440000/download?expires=1767225600&signature=..."`
+	AcceptURL string    `json:"accept_url,omitempty" example:"https://example.com/proposals/550e8400-e29b-41d4-a716-446655440000/accept?expires=1767225600&signature=..."`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ProposalAcceptance is the input for accepting a proposal
+// @Name ProposalAcceptance
+type ProposalAcceptance struct {
+	// Name is typed by the prospect as their signature
+	Name string `json:"name" validate:"required,max=255" example:"Jane Doe"`
+
+	// AgreeToTerms must be true to accept
+	AgreeToTerms bool `json:"agree_to_terms" validate:"required" example:"true"`
+}
+
+// RequestMeta identifies the client submitting an inquiry or accepting a
+// proposal
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+
+	// Referrer is only passed to the spam filter
+	Referrer string
+}
+
+// PaymentStatus is the state of a checkout
+type PaymentStatus string
+
+const (
+	PaymentPending PaymentStatus = "pending"
+	PaymentPaid    PaymentStatus = "paid"
+	PaymentFailed  PaymentStatus = "failed"
+	PaymentExpired PaymentStatus = "expired"
+)
+
+// PaymentKind is what a payment is for
+type PaymentKind string
+
+const (
+	PaymentDeposit PaymentKind = "deposit"
+)
+
+// Payment is a transaction collected for an inquiry
+// @Description Payment collected for an inquiry through a payment provider
+// @Name Payment
+type Payment struct {
+	ID                uuid.UUID     `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID          *uuid.UUID    `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	InquiryID         uuid.UUID     `json:"inquiry_id" db:"inquiry_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ProposalID        *uuid.UUID    `json:"proposal_id,omitempty" db:"proposal_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Kind              PaymentKind   `json:"kind" db:"kind" example:"deposit"`
+	Provider          string        `json:"provider" db:"provider" example:"stripe"`
+	ProviderSessionID string        `json:"provider_session_id" db:"provider_session_id" example:"cs_test_a1b2c3"`
+	ProviderPaymentID string        `json:"provider_payment_id,omitempty" db:"provider_payment_id" example:"pi_3N1b2c3"`
+	Amount            float64       `json:"amount" db:"amount" example:"2250"`
+	Currency          string        `json:"currency" db:"currency" example:"USD"`
+	Status            PaymentStatus `json:"status" db:"status" example:"pending"`
+	CheckoutURL       string        `json:"checkout_url,omitempty" db:"checkout_url" example:"https://checkout.stripe.com/c/pay/cs_test_a1b2c3"`
+
+	// LastEventID is the last provider event applied, so redeliveries are skipped
+	LastEventID string     `json:"-" db:"last_event_id"`
+	PaidAt      *time.Time `json:"paid_at,omitempty" db:"paid_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// DepositCreate is the input for creating a deposit payment link
+// @Name DepositCreate
+type DepositCreate struct {
+	// Percent of the proposal total to charge; the configured share when 0
+	Percent int `json:"percent" validate:"min=0,max=100" example:"50"`
+}
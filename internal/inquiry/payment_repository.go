@@ -0,0 +1,92 @@
+package inquiry
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *Payment) (*Payment, error)
+	Update(ctx context.Context, payment *Payment) (*Payment, error)
+	// FindBySession looks a payment up across tenants, as provider webhooks
+	// are not tenant scoped
+	FindBySession(ctx context.Context, provider, sessionID string) (*Payment, error)
+	FindByInquiry(ctx context.Context, inquiryID string) ([]Payment, error)
+}
+
+type paymentRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewPaymentRepository(supabaseClient *supabase.SupabaseClient) PaymentRepository {
+	return &paymentRepository{
+		supabaseClient: supabaseClient,
+		table:          "payment",
+	}
+}
+
+func (r *paymentRepository) Create(ctx context.Context, payment *Payment) (*Payment, error) {
+	payment.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(payment, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create payment")
+	}
+	return payment, nil
+}
+
+func (r *paymentRepository) Update(ctx context.Context, payment *Payment) (*Payment, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(payment, "minimal", "").
+		Eq("id", payment.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update payment")
+	}
+	return payment, nil
+}
+
+// FindBySession returns the payment of a provider checkout, or nil if none
+func (r *paymentRepository) FindBySession(ctx context.Context, provider, sessionID string) (*Payment, error) {
+	var payments []Payment
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("provider", provider).
+		Eq("provider_session_id", sessionID).
+		ExecuteTo(&payments)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find payment")
+	}
+
+	if len(payments) == 0 {
+		return nil, nil
+	}
+	return &payments[0], nil
+}
+
+// FindByInquiry returns the payments of an inquiry, newest first
+func (r *paymentRepository) FindByInquiry(ctx context.Context, inquiryID string) ([]Payment, error) {
+	var payments []Payment
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("inquiry_id", inquiryID)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("created_at", &postgrest.OrderOpts{Ascending: false}).
+		ExecuteTo(&payments)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list payments")
+	}
+
+	return payments, nil
+}
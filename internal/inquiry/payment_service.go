@@ -0,0 +1,264 @@
+package inquiry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/stripe"
+)
+
+// providerStripe names Stripe in the payment table
+const providerStripe = "stripe"
+
+type PaymentService interface {
+	// CreateDepositLink creates a Checkout link charging a share of an
+	// accepted proposal's total
+	CreateDepositLink(ctx context.Context, proposalID string, depositCreate *DepositCreate) (*Payment, error)
+	ListPayments(ctx context.Context, inquiryID string) ([]Payment, error)
+	// HandleStripeWebhook verifies and applies a Stripe webhook event
+	HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+// StripeOptions configures the Stripe checkouts created for deposits
+type StripeOptions struct {
+	WebhookSecret  string
+	DepositPercent int
+	SuccessURL     string
+	CancelURL      string
+}
+
+type paymentService struct {
+	paymentRepo    PaymentRepository
+	proposalRepo   ProposalRepository
+	inquiryService InquiryService
+	notifier       notification.Notifier
+	client         *stripe.Client
+	options        StripeOptions
+}
+
+// NewPaymentService creates a payment service. A nil client disables
+// payment links and webhooks.
+func NewPaymentService(paymentRepo PaymentRepository, proposalRepo ProposalRepository, inquiryService InquiryService, notifier notification.Notifier, client *stripe.Client, options StripeOptions) PaymentService {
+	if options.DepositPercent <= 0 || options.DepositPercent > 100 {
+		options.DepositPercent = 50
+	}
+
+	return &paymentService{
+		paymentRepo:    paymentRepo,
+		proposalRepo:   proposalRepo,
+		inquiryService: inquiryService,
+		notifier:       notifier,
+		client:         client,
+		options:        options,
+	}
+}
+
+func (s *paymentService) CreateDepositLink(ctx context.Context, proposalID string, depositCreate *DepositCreate) (*Payment, error) {
+	if s.client == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Stripe is not configured", nil)
+	}
+
+	// Validate input
+	if err := validator.ValidateModel(depositCreate); err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.proposalRepo.FindByID(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Proposal not found",
+			nil,
+			errors.WithContext("proposal_id", proposalID),
+		)
+	}
+
+	if proposal.AcceptedAt == nil {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Proposal has not been accepted yet",
+			nil,
+			errors.WithContext("proposal_id", proposalID),
+		)
+	}
+
+	inquiry, err := s.inquiryService.GetInquiryByID(ctx, proposal.InquiryID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	percent := s.options.DepositPercent
+	if depositCreate.Percent > 0 {
+		percent = depositCreate.Percent
+	}
+	amount := math.Round(proposal.Total*float64(percent)) / 100
+	if amount <= 0 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Deposit amount must be greater than zero",
+			nil,
+			errors.WithContext("proposal_id", proposalID),
+		)
+	}
+
+	paymentID := uuid.New()
+	session, err := s.client.CreateCheckoutSession(ctx, stripe.CheckoutParams{
+		Amount:            amount,
+		Currency:          proposal.Currency,
+		Name:              fmt.Sprintf("Deposit for %s", proposal.Title),
+		Description:       fmt.Sprintf("%d%% deposit for proposal %s", percent, proposal.Number),
+		CustomerEmail:     inquiry.Email,
+		SuccessURL:        s.options.SuccessURL,
+		CancelURL:         s.options.CancelURL,
+		ClientReferenceID: paymentID.String(),
+		Metadata: map[string]string{
+			"payment_id":  paymentID.String(),
+			"proposal_id": proposal.ID.String(),
+			"inquiry_id":  inquiry.ID.String(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to create Stripe checkout",
+			errors.WithContext("proposal_id", proposalID),
+		)
+	}
+
+	now := time.Now().UTC()
+	payment := &Payment{
+		ID:                paymentID,
+		InquiryID:         inquiry.ID,
+		ProposalID:        &proposal.ID,
+		Kind:              PaymentDeposit,
+		Provider:          providerStripe,
+		ProviderSessionID: session.ID,
+		Amount:            amount,
+		Currency:          proposal.Currency,
+		Status:            PaymentPending,
+		CheckoutURL:       session.URL,
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
+	}
+
+	createdPayment, err := s.paymentRepo.Create(ctx, payment)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to record payment",
+			errors.WithContext("proposal_id", proposalID),
+		)
+	}
+
+	return createdPayment, nil
+}
+
+func (s *paymentService) ListPayments(ctx context.Context, inquiryID string) ([]Payment, error) {
+	if _, err := s.inquiryService.GetInquiryByID(ctx, inquiryID); err != nil {
+		return nil, err
+	}
+
+	return s.paymentRepo.FindByInquiry(ctx, inquiryID)
+}
+
+func (s *paymentService) HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
+	if s.client == nil || s.options.WebhookSecret == "" {
+		return errors.New(errors.ErrConfiguration, "Stripe webhooks are not configured", nil)
+	}
+
+	event, err := stripe.ConstructEvent(payload, signature, s.options.WebhookSecret, stripe.DefaultTolerance)
+	if err != nil {
+		return errors.New(errors.ErrBadRequest, "Invalid Stripe webhook", err)
+	}
+
+	var status PaymentStatus
+	switch event.Type {
+	case stripe.EventCheckoutCompleted, stripe.EventCheckoutAsyncPaymentSucceeded:
+		status = PaymentPaid
+	case stripe.EventCheckoutAsyncPaymentFailed:
+		status = PaymentFailed
+	case stripe.EventCheckoutExpired:
+		status = PaymentExpired
+	default:
+		// Other events are acknowledged so Stripe stops retrying them
+		return nil
+	}
+
+	session, err := event.CheckoutSession()
+	if err != nil {
+		return errors.New(errors.ErrBadRequest, "Invalid Stripe webhook", err)
+	}
+
+	// Completed checkouts of delayed payment methods are settled later
+	if event.Type == stripe.EventCheckoutCompleted && session.PaymentStatus != "paid" {
+		status = PaymentPending
+	}
+
+	payment, err := s.paymentRepo.FindBySession(ctx, providerStripe, session.ID)
+	if err != nil {
+		return err
+	}
+	if payment == nil || payment.LastEventID == event.ID || payment.Status == PaymentPaid {
+		return nil
+	}
+
+	// Webhooks arrive without a tenant, so act as the payment's tenant
+	if payment.TenantID != nil {
+		ctx = base.WithTenant(ctx, base.TenantScope{ID: *payment.TenantID})
+	}
+
+	now := time.Now().UTC()
+	payment.Status = status
+	payment.LastEventID = event.ID
+	payment.UpdatedAt = &now
+	if session.PaymentIntent != "" {
+		payment.ProviderPaymentID = session.PaymentIntent
+	}
+	if status == PaymentPaid {
+		payment.PaidAt = &now
+		if session.AmountTotal > 0 {
+			payment.Amount = stripe.FromMinorUnits(session.AmountTotal, payment.Currency)
+		}
+	}
+
+	if _, err := s.paymentRepo.Update(ctx, payment); err != nil {
+		return err
+	}
+
+	if status != PaymentPaid {
+		return nil
+	}
+
+	inquiry, err := s.inquiryService.UpdateInquiryStatus(ctx, &InquiryStatusUpdate{
+		ID:     payment.InquiryID,
+		Status: StatusPaid,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.notifier.Notify(ctx, notifier.Notification{
+		Event: notification.EventPaymentReceived,
+		Level: notifier.LevelInfo,
+		Title: "Payment received",
+		Body:  fmt.Sprintf("%s paid a %s of %s", inquiry.Name, payment.Kind, formatMoney(payment.Amount, payment.Currency)),
+		Fields: map[string]string{
+			"Client":  inquiry.Name,
+			"Email":   inquiry.Email,
+			"Payment": payment.ProviderPaymentID,
+		},
+	})
+
+	return nil
+}
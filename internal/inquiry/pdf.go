@@ -0,0 +1,203 @@
+package inquiry
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Branding is how a tenant's proposals are styled
+type Branding struct {
+	Name         string
+	PrimaryColor string
+	Website      string
+}
+
+// renderProposalPDF lays out a proposal on A4 pages: a branded header, the
+// prospect, the intro, a table of line items with their total, and terms
+func renderProposalPDF(proposal *Proposal, inquiry *Inquiry, branding Branding) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(20, 20, 20)
+	pdf.SetAutoPageBreak(true, 20)
+	pdf.SetTitle(proposal.Title, true)
+	pdf.SetAuthor(branding.Name, true)
+	pdf.SetCreator(branding.Name, true)
+	if proposal.CreatedAt != nil {
+		pdf.SetCreationDate(*proposal.CreatedAt)
+	}
+
+	// Core fonts only cover cp1252
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	r, g, b := parseHexColor(branding.PrimaryColor)
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	width := pageWidth - left - right
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		footer := proposal.Number
+		if branding.Website != "" {
+			footer = branding.Website + "  |  " + footer
+		}
+		pdf.CellFormat(width/2, 5, tr(footer), "", 0, "L", false, 0, "")
+		pdf.CellFormat(width/2, 5, fmt.Sprintf("Page %d", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+	pdf.AddPage()
+
+	// Header band
+	pdf.SetFillColor(r, g, b)
+	pdf.Rect(0, 0, pageWidth, 6, "F")
+	pdf.SetTextColor(r, g, b)
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(width*0.6, 10, tr(branding.Name), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(80, 80, 80)
+	pdf.CellFormat(width*0.4, 10, tr("Proposal "+proposal.Number), "", 1, "R", false, 0, "")
+	pdf.Ln(8)
+
+	// Prospect and dates
+	pdf.SetTextColor(30, 30, 30)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(width/2, 5, "Prepared for", "", 0, "L", false, 0, "")
+	pdf.CellFormat(width/2, 5, "Details", "", 1, "R", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+
+	recipient := []string{inquiry.Name}
+	if inquiry.Company != "" {
+		recipient = append(recipient, inquiry.Company)
+	}
+	recipient = append(recipient, inquiry.Email)
+
+	details := []string{"Date: " + formatDate(proposal.CreatedAt)}
+	if proposal.ValidUntil != nil {
+		details = append(details, "Valid until: "+formatDate(proposal.ValidUntil))
+	}
+	details = append(details, "Currency: "+proposal.Currency)
+
+	for i := 0; i < len(recipient) || i < len(details); i++ {
+		var l, d string
+		if i < len(recipient) {
+			l = recipient[i]
+		}
+		if i < len(details) {
+			d = details[i]
+		}
+		pdf.CellFormat(width/2, 5, tr(l), "", 0, "L", false, 0, "")
+		pdf.CellFormat(width/2, 5, tr(d), "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(8)
+
+	// Title and intro
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.MultiCell(width, 8, tr(proposal.Title), "", "L", false)
+	if proposal.Intro != "" {
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(width, 5, tr(proposal.Intro), "", "L", false)
+	}
+	pdf.Ln(6)
+
+	// Line items
+	columns := []float64{width * 0.52, width * 0.12, width * 0.18, width * 0.18}
+	pdf.SetFillColor(r, g, b)
+	pdf.SetTextColor(255, 255, 255)
+	pdf.SetFont("Helvetica", "B", 10)
+	for i, heading := range []string{"Description", "Qty", "Unit price", "Amount"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(columns[i], 8, heading, "", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetTextColor(30, 30, 30)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetDrawColor(220, 220, 220)
+	for _, item := range proposal.LineItems {
+		lines := pdf.SplitLines([]byte(tr(item.Description)), columns[0]-2)
+		height := float64(len(lines)) * 5
+		if height < 7 {
+			height = 7
+		}
+		y := pdf.GetY()
+
+		pdf.MultiCell(columns[0], height/float64(len(lines)), tr(item.Description), "B", "L", false)
+		pdf.SetXY(left+columns[0], y)
+		pdf.CellFormat(columns[1], height, formatQuantity(item.Quantity), "B", 0, "R", false, 0, "")
+		pdf.CellFormat(columns[2], height, formatMoney(item.UnitPrice, proposal.Currency), "B", 0, "R", false, 0, "")
+		pdf.CellFormat(columns[3], height, formatMoney(item.Amount(), proposal.Currency), "B", 1, "R", false, 0, "")
+	}
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(columns[0]+columns[1]+columns[2], 10, "Total", "", 0, "R", false, 0, "")
+	pdf.SetTextColor(r, g, b)
+	pdf.CellFormat(columns[3], 10, formatMoney(proposal.Total, proposal.Currency), "", 1, "R", false, 0, "")
+	pdf.SetTextColor(30, 30, 30)
+
+	// Terms
+	if proposal.Terms != "" {
+		pdf.Ln(6)
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(width, 6, "Terms", "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.MultiCell(width, 5, tr(proposal.Terms), "", "L", false)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parseHexColor parses a #rrggbb or #rgb color, falling back to the
+// default site primary color
+func parseHexColor(hex string) (int, int, int) {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return 0x0f, 0x17, 0x2a
+	}
+	return int(value >> 16 & 0xff), int(value >> 8 & 0xff), int(value & 0xff)
+}
+
+// formatMoney formats amount with thousands separators and two decimals
+func formatMoney(amount float64, currency string) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	whole, fraction, _ := strings.Cut(strconv.FormatFloat(amount, 'f', 2, 64), ".")
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return sign + currency + " " + grouped.String() + "." + fraction
+}
+
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64)
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return time.Now().UTC().Format("January 2, 2006")
+	}
+	return t.Format("January 2, 2006")
+}
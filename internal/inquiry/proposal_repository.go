@@ -0,0 +1,91 @@
+package inquiry
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type ProposalRepository interface {
+	Create(ctx context.Context, proposal *Proposal) (*Proposal, error)
+	Update(ctx context.Context, proposal *Proposal) (*Proposal, error)
+	FindByID(ctx context.Context, id string) (*Proposal, error)
+	FindByInquiry(ctx context.Context, inquiryID string) ([]Proposal, error)
+}
+
+type proposalRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewProposalRepository(supabaseClient *supabase.SupabaseClient) ProposalRepository {
+	return &proposalRepository{
+		supabaseClient: supabaseClient,
+		table:          "proposal",
+	}
+}
+
+func (r *proposalRepository) Create(ctx context.Context, proposal *Proposal) (*Proposal, error) {
+	proposal.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(proposal, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create proposal")
+	}
+	return proposal, nil
+}
+
+func (r *proposalRepository) Update(ctx context.Context, proposal *Proposal) (*Proposal, error) {
+	proposal.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(proposal, "minimal", "").
+		Eq("id", proposal.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update proposal")
+	}
+	return proposal, nil
+}
+
+// FindByID returns the proposal with the given ID, or nil if none
+func (r *proposalRepository) FindByID(ctx context.Context, id string) (*Proposal, error) {
+	var proposals []Proposal
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&proposals)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find proposal")
+	}
+
+	if len(proposals) == 0 {
+		return nil, nil
+	}
+	return &proposals[0], nil
+}
+
+// FindByInquiry returns the proposals of an inquiry, newest first
+func (r *proposalRepository) FindByInquiry(ctx context.Context, inquiryID string) ([]Proposal, error) {
+	var proposals []Proposal
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("inquiry_id", inquiryID)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("created_at", &postgrest.OrderOpts{Ascending: false}).
+		ExecuteTo(&proposals)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list proposals")
+	}
+
+	return proposals, nil
+}
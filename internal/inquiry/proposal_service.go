@@ -0,0 +1,443 @@
+package inquiry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/mail"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+	"github.com/holycann/itsrama-portfolio-backend/internal/offering"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/mailer"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+type ProposalService interface {
+	// CreateProposal renders a proposal for an inquiry to a PDF in storage,
+	// emailing its signed links to the prospect when asked to
+	CreateProposal(ctx context.Context, inquiryID string, proposalCreate *ProposalCreate) (*ProposalLink, error)
+	// SendProposal emails fresh signed links of a proposal to the prospect
+	SendProposal(ctx context.Context, id string) (*ProposalLink, error)
+	ListProposals(ctx context.Context, inquiryID string) ([]Proposal, error)
+	// ListProposalLinks lists the proposals of an inquiry with fresh signed
+	// download and acceptance links
+	ListProposalLinks(ctx context.Context, inquiryID string) ([]ProposalLink, error)
+	// DownloadProposal returns the PDF of a proposal for a signed link
+	DownloadProposal(ctx context.Context, id, expires, signature string) (*Proposal, []byte, error)
+	// GetProposalForAcceptance returns a proposal for a signed acceptance
+	// link, with a fresh download link to review it
+	GetProposalForAcceptance(ctx context.Context, id, expires, signature string) (*ProposalLink, error)
+	// AcceptProposal records the prospect accepting a proposal through a
+	// signed acceptance link and moves its inquiry to accepted
+	AcceptProposal(ctx context.Context, id, expires, signature string, acceptance *ProposalAcceptance, meta RequestMeta) (*Proposal, error)
+}
+
+type proposalService struct {
+	proposalRepo      ProposalRepository
+	inquiryService    InquiryService
+	siteConfigService site_config.SiteConfigService
+	storage           storage.Storage
+	mailService       mail.MailService
+	notifier          notification.Notifier
+	signer            *LinkSigner
+	acceptURL         string
+	currency          string
+	validity          time.Duration
+}
+
+// NewProposalService creates a proposal service. Proposals are quoted in
+// currency unless they name another one and stay valid for validity.
+// Acceptance links point below acceptURL, or at the API when it is empty.
+func NewProposalService(
+	proposalRepo ProposalRepository,
+	inquiryService InquiryService,
+	siteConfigService site_config.SiteConfigService,
+	storage storage.Storage,
+	mailService mail.MailService,
+	notifier notification.Notifier,
+	signer *LinkSigner,
+	acceptURL string,
+	currency string,
+	validity time.Duration,
+) ProposalService {
+	return &proposalService{
+		proposalRepo:      proposalRepo,
+		inquiryService:    inquiryService,
+		siteConfigService: siteConfigService,
+		storage:           storage,
+		mailService:       mailService,
+		notifier:          notifier,
+		signer:            signer,
+		acceptURL:         acceptURL,
+		currency:          currency,
+		validity:          validity,
+	}
+}
+
+func (s *proposalService) CreateProposal(ctx context.Context, inquiryID string, proposalCreate *ProposalCreate) (*ProposalLink, error) {
+	// Validate input
+	if err := validator.ValidateModel(proposalCreate); err != nil {
+		return nil, err
+	}
+	if err := validator.ValidateModel(proposalCreate.LineItems); err != nil {
+		return nil, err
+	}
+
+	currency := s.currency
+	if proposalCreate.Currency != "" {
+		code, ok := offering.ParseCurrency(proposalCreate.Currency)
+		if !ok {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Currency must be a 3 letter ISO 4217 code",
+				nil,
+				errors.WithContext("currency", proposalCreate.Currency),
+			)
+		}
+		currency = code
+	}
+
+	inquiry, err := s.inquiryService.GetInquiryByID(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+
+	validity := s.validity
+	if proposalCreate.ValidDays > 0 {
+		validity = time.Duration(proposalCreate.ValidDays) * 24 * time.Hour
+	}
+
+	now := time.Now().UTC()
+	validUntil := now.Add(validity)
+	id := uuid.New()
+	proposal := &Proposal{
+		ID:         id,
+		InquiryID:  inquiry.ID,
+		Number:     fmt.Sprintf("P-%s-%s", now.Format("20060102"), strings.ToUpper(id.String()[:6])),
+		Title:      strings.TrimSpace(proposalCreate.Title),
+		Intro:      strings.TrimSpace(proposalCreate.Intro),
+		Currency:   currency,
+		LineItems:  make([]LineItem, 0, len(proposalCreate.LineItems)),
+		Terms:      strings.TrimSpace(proposalCreate.Terms),
+		ValidUntil: &validUntil,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+	for _, item := range proposalCreate.LineItems {
+		item.Description = strings.TrimSpace(item.Description)
+		proposal.LineItems = append(proposal.LineItems, item)
+		proposal.Total += item.Amount()
+	}
+	proposal.Total = math.Round(proposal.Total*100) / 100
+
+	branding := s.branding(ctx)
+	pdf, err := renderProposalPDF(proposal, inquiry, branding)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to render proposal",
+			errors.WithContext("inquiry_id", inquiry.ID),
+		)
+	}
+
+	filePath := base.TenantStoragePath(ctx, fmt.Sprintf("proposals/%s/%s.pdf", inquiry.ID, proposal.Number))
+	proposal.FilePath, err = s.storage.UploadBytes(ctx, pdf, filePath, "application/pdf")
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to store proposal",
+			errors.WithContext("inquiry_id", inquiry.ID),
+		)
+	}
+
+	createdProposal, err := s.proposalRepo.Create(ctx, proposal)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create proposal",
+			errors.WithContext("inquiry_id", inquiry.ID),
+		)
+	}
+
+	if proposalCreate.Send {
+		return s.send(ctx, createdProposal, inquiry, branding)
+	}
+
+	return s.link(createdProposal), nil
+}
+
+func (s *proposalService) SendProposal(ctx context.Context, id string) (*ProposalLink, error) {
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	inquiry, err := s.inquiryService.GetInquiryByID(ctx, proposal.InquiryID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.send(ctx, proposal, inquiry, s.branding(ctx))
+}
+
+func (s *proposalService) ListProposals(ctx context.Context, inquiryID string) ([]Proposal, error) {
+	if _, err := s.inquiryService.GetInquiryByID(ctx, inquiryID); err != nil {
+		return nil, err
+	}
+
+	return s.proposalRepo.FindByInquiry(ctx, inquiryID)
+}
+
+func (s *proposalService) ListProposalLinks(ctx context.Context, inquiryID string) ([]ProposalLink, error) {
+	proposals, err := s.ListProposals(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]ProposalLink, 0, len(proposals))
+	for i := range proposals {
+		links = append(links, *s.link(&proposals[i]))
+	}
+
+	return links, nil
+}
+
+func (s *proposalService) DownloadProposal(ctx context.Context, id, expires, signature string) (*Proposal, []byte, error) {
+	if !s.signer.Verify(PurposeDownload, id, expires, signature) {
+		return nil, nil, errors.New(
+			errors.ErrForbidden,
+			"Download link is invalid or has expired",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pdf, err := s.storage.Download(ctx, proposal.FilePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to download proposal",
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	return proposal, pdf, nil
+}
+
+func (s *proposalService) GetProposalForAcceptance(ctx context.Context, id, expires, signature string) (*ProposalLink, error) {
+	if !s.signer.Verify(PurposeAccept, id, expires, signature) {
+		return nil, errors.New(
+			errors.ErrForbidden,
+			"Acceptance link is invalid or has expired",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.link(proposal), nil
+}
+
+func (s *proposalService) AcceptProposal(ctx context.Context, id, expires, signature string, acceptance *ProposalAcceptance, meta RequestMeta) (*Proposal, error) {
+	// Validate input
+	if err := validator.ValidateModel(acceptance); err != nil {
+		return nil, err
+	}
+
+	if !s.signer.Verify(PurposeAccept, id, expires, signature) {
+		return nil, errors.New(
+			errors.ErrForbidden,
+			"Acceptance link is invalid or has expired",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.AcceptedAt != nil {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Proposal has already been accepted",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	now := time.Now().UTC()
+	if proposal.ValidUntil != nil && now.After(*proposal.ValidUntil) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Proposal is no longer valid",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	inquiry, err := s.inquiryService.GetInquiryByID(ctx, proposal.InquiryID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.AcceptedAt = &now
+	proposal.AcceptedName = strings.TrimSpace(acceptance.Name)
+	proposal.AcceptedIP = meta.IP
+	proposal.AcceptedUserAgent = meta.UserAgent
+	proposal.UpdatedAt = &now
+	if _, err := s.proposalRepo.Update(ctx, proposal); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to accept proposal",
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	_, err = s.inquiryService.UpdateInquiryStatus(ctx, &InquiryStatusUpdate{
+		ID:     inquiry.ID,
+		Status: StatusAccepted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.Notify(ctx, notifier.Notification{
+		Event: notification.EventProposalAccepted,
+		Level: notifier.LevelInfo,
+		Title: "Proposal accepted",
+		Body:  fmt.Sprintf("%s accepted %s (%s)", proposal.AcceptedName, proposal.Title, proposal.Number),
+		Fields: map[string]string{
+			"Client": inquiry.Name,
+			"Email":  inquiry.Email,
+			"Total":  formatMoney(proposal.Total, proposal.Currency),
+			"IP":     meta.IP,
+		},
+	})
+
+	return proposal, nil
+}
+
+// send emails the signed links of proposal to the prospect and moves the
+// inquiry to proposal_sent unless it is already further along
+func (s *proposalService) send(ctx context.Context, proposal *Proposal, inquiry *Inquiry, branding Branding) (*ProposalLink, error) {
+	link := s.link(proposal)
+
+	validUntil := ""
+	if proposal.ValidUntil != nil {
+		validUntil = formatDate(proposal.ValidUntil)
+	}
+
+	_, err := s.mailService.Enqueue(ctx, mailer.TemplateProposal, []string{inquiry.Email}, "", mailer.ProposalData{
+		SiteName:      branding.Name,
+		Name:          inquiry.Name,
+		Title:         proposal.Title,
+		Number:        proposal.Number,
+		Total:         formatMoney(proposal.Total, proposal.Currency),
+		ValidUntil:    validUntil,
+		DownloadURL:   link.URL,
+		AcceptURL:     link.AcceptURL,
+		LinkExpiresAt: formatDate(&link.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	proposal.SentAt = &now
+	proposal.UpdatedAt = &now
+	if _, err := s.proposalRepo.Update(ctx, proposal); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update proposal",
+			errors.WithContext("proposal_id", proposal.ID),
+		)
+	}
+
+	if inquiry.Status == StatusNew || inquiry.Status == StatusQualified {
+		_, err := s.inquiryService.UpdateInquiryStatus(ctx, &InquiryStatusUpdate{
+			ID:     inquiry.ID,
+			Status: StatusProposalSent,
+		})
+		if err != nil {
+			fmt.Printf("Failed to move inquiry %s to %s: %v\n", inquiry.ID, StatusProposalSent, err)
+		}
+	}
+
+	link.Proposal = *proposal
+	return link, nil
+}
+
+// link signs the download link of proposal, and its acceptance link until
+// it has been accepted
+func (s *proposalService) link(proposal *Proposal) *ProposalLink {
+	id := proposal.ID.String()
+	url, expiresAt := s.signer.Sign("/proposals/"+id+"/download", PurposeDownload, id)
+	link := &ProposalLink{Proposal: *proposal, URL: url, ExpiresAt: expiresAt}
+
+	if proposal.AcceptedAt == nil {
+		if s.acceptURL != "" {
+			link.AcceptURL, _ = s.signer.SignAt(s.acceptURL, "/proposals/"+id+"/accept", PurposeAccept, id)
+		} else {
+			link.AcceptURL, _ = s.signer.Sign("/proposals/"+id+"/accept", PurposeAccept, id)
+		}
+	}
+
+	return link
+}
+
+func (s *proposalService) getProposal(ctx context.Context, id string) (*Proposal, error) {
+	proposal, err := s.proposalRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Proposal not found",
+			nil,
+			errors.WithContext("proposal_id", id),
+		)
+	}
+
+	return proposal, nil
+}
+
+// branding styles proposals after the tenant's site, falling back to the
+// default site config when it cannot be loaded
+func (s *proposalService) branding(ctx context.Context) Branding {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		fmt.Printf("Failed to load site config for proposal branding: %v\n", err)
+		defaults := site_config.DefaultSiteConfig()
+		siteConfig = &defaults
+	}
+
+	website := siteConfig.SEO.CanonicalURL
+	website = strings.TrimPrefix(strings.TrimPrefix(website, "https://"), "http://")
+
+	return Branding{
+		Name:         siteConfig.SEO.Title,
+		PrimaryColor: siteConfig.Theme.PrimaryColor,
+		Website:      strings.TrimSuffix(website, "/"),
+	}
+}
@@ -0,0 +1,179 @@
+package inquiry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+// likeEscaper escapes LIKE wildcards so a pattern only matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type InquiryRepository interface {
+	Create(ctx context.Context, inquiry *Inquiry) (*Inquiry, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Inquiry, error)
+	FindByEmail(ctx context.Context, email string) ([]Inquiry, error)
+	SetStatus(ctx context.Context, id string, status Status) error
+	List(ctx context.Context, opts base.ListOptions) ([]Inquiry, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type inquiryRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewInquiryRepository(supabaseClient *supabase.SupabaseClient) InquiryRepository {
+	return &inquiryRepository{
+		supabaseClient: supabaseClient,
+		table:          "inquiry",
+	}
+}
+
+func (r *inquiryRepository) Create(ctx context.Context, inquiry *Inquiry) (*Inquiry, error) {
+	inquiry.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(inquiry, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create inquiry")
+	}
+	return inquiry, nil
+}
+
+func (r *inquiryRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete inquiry")
+	}
+	return nil
+}
+
+// FindByID returns the inquiry with the given ID, or nil if none
+func (r *inquiryRepository) FindByID(ctx context.Context, id string) (*Inquiry, error) {
+	var inquiries []Inquiry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&inquiries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find inquiry")
+	}
+
+	if len(inquiries) == 0 {
+		return nil, nil
+	}
+	return &inquiries[0], nil
+}
+
+// FindByEmail returns the inquiries sent from an email address, newest
+// first. Addresses are compared case-insensitively.
+func (r *inquiryRepository) FindByEmail(ctx context.Context, email string) ([]Inquiry, error) {
+	var inquiries []Inquiry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Ilike("email", likeEscaper.Replace(email))
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("created_at", &postgrest.OrderOpts{Ascending: false}).
+		ExecuteTo(&inquiries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find inquiries")
+	}
+
+	return inquiries, nil
+}
+
+// SetStatus moves an inquiry through the pipeline without touching its
+// other fields
+func (r *inquiryRepository) SetStatus(ctx context.Context, id string, status Status) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update inquiry status")
+	}
+	return nil
+}
+
+func (r *inquiryRepository) List(ctx context.Context, opts base.ListOptions) ([]Inquiry, error) {
+	var inquiries []Inquiry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorNotEqual:
+			query = query.Neq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: opts.SortOrder == base.SortAscending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&inquiries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list inquiries")
+	}
+
+	return inquiries, nil
+}
+
+func (r *inquiryRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorNotEqual:
+			query = query.Neq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count inquiries")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,202 @@
+package inquiry
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/antispam"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+type InquiryService interface {
+	// SubmitInquiry records an inquiry, flagging it as spam when the spam
+	// filter scores it over its threshold
+	SubmitInquiry(ctx context.Context, inquiryCreate *InquiryCreate, meta RequestMeta) (*Inquiry, error)
+	GetInquiryByID(ctx context.Context, id string) (*Inquiry, error)
+	// ListInquiriesByEmail returns the inquiries sent from an email address
+	ListInquiriesByEmail(ctx context.Context, email string) ([]Inquiry, error)
+	UpdateInquiryStatus(ctx context.Context, statusUpdate *InquiryStatusUpdate) (*Inquiry, error)
+	DeleteInquiry(ctx context.Context, id string) error
+	ListInquiries(ctx context.Context, opts base.ListOptions) ([]Inquiry, error)
+	CountInquiries(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type inquiryService struct {
+	inquiryRepo InquiryRepository
+	spamFilter  *antispam.Filter
+}
+
+// NewInquiryService creates the inquiry service; spamFilter may be nil to
+// accept every submission
+func NewInquiryService(inquiryRepo InquiryRepository, spamFilter *antispam.Filter) InquiryService {
+	return &inquiryService{
+		inquiryRepo: inquiryRepo,
+		spamFilter:  spamFilter,
+	}
+}
+
+// SubmitInquiry records a new inquiry at the start of the pipeline, or in
+// the spam stage when flagged by the spam filter
+func (s *inquiryService) SubmitInquiry(ctx context.Context, inquiryCreate *InquiryCreate, meta RequestMeta) (*Inquiry, error) {
+	// Validate input
+	if err := validator.ValidateModel(inquiryCreate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	inquiry := &Inquiry{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(inquiryCreate.Name),
+		Email:     strings.TrimSpace(inquiryCreate.Email),
+		Company:   strings.TrimSpace(inquiryCreate.Company),
+		Message:   strings.TrimSpace(inquiryCreate.Message),
+		Budget:    strings.TrimSpace(inquiryCreate.Budget),
+		Status:    StatusNew,
+		UTM:       inquiryCreate.UTM,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+	inquiry.UTM.Normalize()
+
+	// The filter only fails when every engine does; such submissions are
+	// kept rather than lost
+	if s.spamFilter != nil {
+		verdict, err := s.spamFilter.Check(ctx, antispam.Submission{
+			Kind:      "contact-form",
+			Author:    inquiry.Name,
+			Email:     inquiry.Email,
+			Content:   inquiry.Message,
+			IP:        meta.IP,
+			UserAgent: meta.UserAgent,
+			Referrer:  meta.Referrer,
+		})
+		if err == nil && verdict.Spam {
+			inquiry.Status = StatusSpam
+			inquiry.SpamScore = verdict.Score
+			inquiry.SpamReasons = verdict.Reasons
+		}
+	}
+
+	createdInquiry, err := s.inquiryRepo.Create(ctx, inquiry)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to submit inquiry",
+			errors.WithContext("inquiry_email", inquiry.Email),
+		)
+	}
+
+	return createdInquiry, nil
+}
+
+func (s *inquiryService) GetInquiryByID(ctx context.Context, id string) (*Inquiry, error) {
+	inquiry, err := s.inquiryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if inquiry == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Inquiry not found",
+			nil,
+			errors.WithContext("inquiry_id", id),
+		)
+	}
+
+	return inquiry, nil
+}
+
+func (s *inquiryService) ListInquiriesByEmail(ctx context.Context, email string) ([]Inquiry, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return []Inquiry{}, nil
+	}
+
+	inquiries, err := s.inquiryRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only keep exact matches in case the pattern matched more loosely, and
+	// never show spam to its sender
+	matches := make([]Inquiry, 0, len(inquiries))
+	for _, inquiry := range inquiries {
+		if inquiry.Status != StatusSpam && strings.EqualFold(inquiry.Email, email) {
+			matches = append(matches, inquiry)
+		}
+	}
+
+	return matches, nil
+}
+
+func (s *inquiryService) UpdateInquiryStatus(ctx context.Context, statusUpdate *InquiryStatusUpdate) (*Inquiry, error) {
+	// Validate input
+	if err := validator.ValidateModel(statusUpdate); err != nil {
+		return nil, err
+	}
+
+	if !statuses[statusUpdate.Status] {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Unknown inquiry status",
+			nil,
+			errors.WithContext("status", statusUpdate.Status),
+		)
+	}
+
+	inquiry, err := s.GetInquiryByID(ctx, statusUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.inquiryRepo.SetStatus(ctx, inquiry.ID.String(), statusUpdate.Status); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update inquiry status",
+			errors.WithContext("inquiry_id", inquiry.ID),
+		)
+	}
+
+	now := time.Now().UTC()
+	inquiry.Status = statusUpdate.Status
+	inquiry.UpdatedAt = &now
+
+	return inquiry, nil
+}
+
+func (s *inquiryService) DeleteInquiry(ctx context.Context, id string) error {
+	if _, err := s.GetInquiryByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.inquiryRepo.Delete(ctx, id); err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to delete inquiry",
+			errors.WithContext("inquiry_id", id),
+		)
+	}
+
+	return nil
+}
+
+func (s *inquiryService) ListInquiries(ctx context.Context, opts base.ListOptions) ([]Inquiry, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.inquiryRepo.List(ctx, opts)
+}
+
+func (s *inquiryService) CountInquiries(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.inquiryRepo.Count(ctx, filters)
+}
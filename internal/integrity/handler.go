@@ -0,0 +1,39 @@
+package integrity
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type IntegrityHandler struct {
+	base.BaseHandler
+	integrityService IntegrityService
+}
+
+func NewIntegrityHandler(integrityService IntegrityService, logger *logger.Logger) *IntegrityHandler {
+	return &IntegrityHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		integrityService: integrityService,
+	}
+}
+
+// Validate runs the integrity checks
+// @Summary Validate stored content
+// @Description Check all content for broken image URLs, tech stack links to deleted tech stacks, projects without a thumbnail and experiences ending before they start
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Validation completed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/validate [get]
+func (h *IntegrityHandler) Validate(c *gin.Context) {
+	report, err := h.integrityService.Validate(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Validation completed successfully")
+}
@@ -0,0 +1,78 @@
+package integrity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Check names an integrity check
+type Check string
+
+const (
+	// CheckBrokenImage flags image URLs not answering with a 2xx status
+	CheckBrokenImage Check = "broken_image"
+	// CheckDanglingTechStack flags tech stack links to deleted tech stacks
+	CheckDanglingTechStack Check = "dangling_tech_stack"
+	// CheckMissingThumbnail flags projects without a thumbnail image
+	CheckMissingThumbnail Check = "missing_thumbnail"
+	// CheckInvalidDateRange flags experiences ending before they start
+	CheckInvalidDateRange Check = "invalid_date_range"
+)
+
+// Entity types whose content is checked
+const (
+	EntityProject    = "project"
+	EntityExperience = "experience"
+	EntityTechStack  = "tech_stack"
+)
+
+// Issue is a problem found in stored content
+// @Description Problem found by an integrity check
+// @Name IntegrityIssue
+type Issue struct {
+	Check      Check     `json:"check" example:"broken_image"`
+	EntityType string    `json:"entity_type" example:"project"`
+	EntityID   uuid.UUID `json:"entity_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	EntityName string    `json:"entity_name" example:"Portfolio Website"`
+	Field      string    `json:"field" example:"images"`
+	Value      string    `json:"value,omitempty" example:"https://example.com/missing.png"`
+	Message    string    `json:"message" example:"image answered with status 404"`
+}
+
+// Checked counts the content an integrity run went through
+// @Description Content checked by an integrity run
+// @Name IntegrityChecked
+type Checked struct {
+	Projects    int `json:"projects" example:"12"`
+	Experiences int `json:"experiences" example:"5"`
+	TechStacks  int `json:"tech_stacks" example:"30"`
+	Images      int `json:"images" example:"48"`
+}
+
+// Report is the outcome of an integrity run
+// @Description Result of running every integrity check
+// @Name IntegrityReport
+type Report struct {
+	Valid     bool          `json:"valid" example:"false"`
+	Checked   Checked       `json:"checked"`
+	Counts    map[Check]int `json:"counts"`
+	Issues    []Issue       `json:"issues"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// add records an issue
+func (r *Report) add(issue Issue) {
+	r.Counts[issue.Check]++
+	r.Issues = append(r.Issues, issue)
+	r.Valid = false
+}
+
+// image is an image URL stored on an entity
+type image struct {
+	entityType string
+	entityID   uuid.UUID
+	entityName string
+	field      string
+	url        string
+}
@@ -0,0 +1,301 @@
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/urlcheck"
+)
+
+// pageSize is the number of entities loaded per page while collecting content
+const pageSize = 100
+
+type IntegrityService interface {
+	Validate(ctx context.Context) (*Report, error)
+}
+
+type integrityService struct {
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	techStackService  tech_stack.TechStackService
+	checker           *urlcheck.Checker
+}
+
+func NewIntegrityService(projectService project.ProjectService, experienceService experience.ExperienceService, techStackService tech_stack.TechStackService, checker *urlcheck.Checker) IntegrityService {
+	return &integrityService{
+		projectService:    projectService,
+		experienceService: experienceService,
+		techStackService:  techStackService,
+		checker:           checker,
+	}
+}
+
+// Validate runs every integrity check over the content of the tenant in ctx
+func (s *integrityService) Validate(ctx context.Context) (*Report, error) {
+	projects, err := s.listProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.listExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	techStacks, err := s.listTechStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Valid:     true,
+		Counts:    map[Check]int{},
+		Issues:    []Issue{},
+		CheckedAt: time.Now().UTC(),
+		Checked: Checked{
+			Projects:    len(projects),
+			Experiences: len(experiences),
+			TechStacks:  len(techStacks),
+		},
+	}
+
+	if err := s.checkTechStackLinks(ctx, report, projects, experiences); err != nil {
+		return nil, err
+	}
+	checkThumbnails(report, projects)
+	checkDateRanges(report, experiences)
+
+	images := collectImages(projects, experiences, techStacks)
+	report.Checked.Images = len(images)
+	if err := s.checkImages(ctx, report, images); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// checkTechStackLinks flags project and experience links to tech stacks
+// that no longer exist
+func (s *integrityService) checkTechStackLinks(ctx context.Context, report *Report, projects []project.ProjectDTO, experiences []experience.ExperienceDTO) error {
+	var ids []uuid.UUID
+	for _, p := range projects {
+		for _, link := range p.ProjectTechStack {
+			ids = append(ids, link.TechStackID)
+		}
+	}
+	for _, e := range experiences {
+		for _, link := range e.ExperienceTechStack {
+			ids = append(ids, link.TechStackID)
+		}
+	}
+
+	missing, err := s.techStackService.MissingTechStackIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	deleted := make(map[uuid.UUID]bool, len(missing))
+	for _, id := range missing {
+		deleted[id] = true
+	}
+
+	for _, p := range projects {
+		for _, link := range p.ProjectTechStack {
+			if deleted[link.TechStackID] {
+				report.add(Issue{
+					Check:      CheckDanglingTechStack,
+					EntityType: EntityProject,
+					EntityID:   p.ID,
+					EntityName: p.Title,
+					Field:      "tech_stack_ids",
+					Value:      link.TechStackID.String(),
+					Message:    "linked tech stack does not exist",
+				})
+			}
+		}
+	}
+	for _, e := range experiences {
+		for _, link := range e.ExperienceTechStack {
+			if deleted[link.TechStackID] {
+				report.add(Issue{
+					Check:      CheckDanglingTechStack,
+					EntityType: EntityExperience,
+					EntityID:   e.ID,
+					EntityName: e.Company,
+					Field:      "tech_stack_ids",
+					Value:      link.TechStackID.String(),
+					Message:    "linked tech stack does not exist",
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkThumbnails flags projects with no image marked as thumbnail
+func checkThumbnails(report *Report, projects []project.ProjectDTO) {
+	for _, p := range projects {
+		hasThumbnail := false
+		for _, image := range p.Images {
+			if image.IsThumbnail && image.Src != "" {
+				hasThumbnail = true
+				break
+			}
+		}
+
+		if !hasThumbnail {
+			report.add(Issue{
+				Check:      CheckMissingThumbnail,
+				EntityType: EntityProject,
+				EntityID:   p.ID,
+				EntityName: p.Title,
+				Field:      "images",
+				Message:    "project has no thumbnail image",
+			})
+		}
+	}
+}
+
+// checkDateRanges flags experiences whose end date is before their start date
+func checkDateRanges(report *Report, experiences []experience.ExperienceDTO) {
+	for _, e := range experiences {
+		if e.EndDate == nil || e.EndDate.IsZero() || !e.EndDate.Before(e.StartDate.Time) {
+			continue
+		}
+
+		report.add(Issue{
+			Check:      CheckInvalidDateRange,
+			EntityType: EntityExperience,
+			EntityID:   e.ID,
+			EntityName: e.Company,
+			Field:      "end_date",
+			Value:      e.EndDate.Format("2006-01-02"),
+			Message:    fmt.Sprintf("end date is before start date %s", e.StartDate.Format("2006-01-02")),
+		})
+	}
+}
+
+// checkImages flags image URLs not answering with a 2xx status
+func (s *integrityService) checkImages(ctx context.Context, report *Report, images []image) error {
+	urls := make([]string, len(images))
+	for i, img := range images {
+		urls[i] = img.url
+	}
+
+	results := s.checker.CheckAll(ctx, urls)
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, errors.ErrCanceled, "Integrity check was canceled")
+	}
+
+	for i, result := range results {
+		if result.OK() {
+			continue
+		}
+
+		message := fmt.Sprintf("image answered with status %d", result.StatusCode)
+		if result.Err != nil {
+			message = result.Err.Error()
+		}
+		report.add(Issue{
+			Check:      CheckBrokenImage,
+			EntityType: images[i].entityType,
+			EntityID:   images[i].entityID,
+			EntityName: images[i].entityName,
+			Field:      images[i].field,
+			Value:      images[i].url,
+			Message:    message,
+		})
+	}
+
+	return nil
+}
+
+// collectImages gathers the non-empty image URLs stored on projects,
+// experiences and tech stacks
+func collectImages(projects []project.ProjectDTO, experiences []experience.ExperienceDTO, techStacks []tech_stack.TechStack) []image {
+	var images []image
+
+	for _, p := range projects {
+		for _, img := range p.Images {
+			if img.Src != "" {
+				images = append(images, image{EntityProject, p.ID, p.Title, "images", img.Src})
+			}
+		}
+	}
+
+	for _, e := range experiences {
+		if e.LogoUrl != "" {
+			images = append(images, image{EntityExperience, e.ID, e.Company, "logo_url", e.LogoUrl})
+		}
+		for _, url := range e.ImagesUrl {
+			if url != "" {
+				images = append(images, image{EntityExperience, e.ID, e.Company, "images_url", url})
+			}
+		}
+	}
+
+	for _, t := range techStacks {
+		if t.ImageUrl != "" {
+			images = append(images, image{EntityTechStack, t.ID, t.Name, "image_url", t.ImageUrl})
+		}
+		if t.ImageUrlLight != "" && t.ImageUrlLight != t.ImageUrl {
+			images = append(images, image{EntityTechStack, t.ID, t.Name, "image_url_light", t.ImageUrlLight})
+		}
+		if t.ImageUrlDark != "" && t.ImageUrlDark != t.ImageUrl {
+			images = append(images, image{EntityTechStack, t.ID, t.Name, "image_url_dark", t.ImageUrlDark})
+		}
+	}
+
+	return images
+}
+
+func (s *integrityService) listProjects(ctx context.Context) ([]project.ProjectDTO, error) {
+	var all []project.ProjectDTO
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, projects...)
+		if len(projects) < pageSize {
+			return all, nil
+		}
+	}
+}
+
+func (s *integrityService) listExperiences(ctx context.Context) ([]experience.ExperienceDTO, error) {
+	var all []experience.ExperienceDTO
+	for page := 1; ; page++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, experiences...)
+		if len(experiences) < pageSize {
+			return all, nil
+		}
+	}
+}
+
+func (s *integrityService) listTechStacks(ctx context.Context) ([]tech_stack.TechStack, error) {
+	var all []tech_stack.TechStack
+	for page := 1; ; page++ {
+		techStacks, err := s.techStackService.ListTechStacks(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, techStacks...)
+		if len(techStacks) < pageSize {
+			return all, nil
+		}
+	}
+}
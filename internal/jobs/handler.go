@@ -0,0 +1,126 @@
+package jobs
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type JobHandler struct {
+	base.BaseHandler
+	jobService JobService
+}
+
+func NewJobHandler(jobService JobService, logger *logger.Logger) *JobHandler {
+	return &JobHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		jobService:  jobService,
+	}
+}
+
+// GetJobByID reports the progress of a background job
+// @Summary Get a background job by ID
+// @Description Retrieve the status, progress and result of a background job, such as a screenshot capture
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} response.APIResponse{data=Job} "Job retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Job not found"
+// @Router /jobs/{id} [get]
+func (h *JobHandler) GetJobByID(c *gin.Context) {
+	jobID, err := h.ValidateUUID(c.Param("id"), "job ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	job, err := h.jobService.GetJobByID(c.Request.Context(), jobID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, job, "Job retrieved successfully")
+}
+
+// ListJobs retrieves a paginated list of background jobs
+// @Summary List background jobs
+// @Description Retrieve background jobs with optional status and kind filtering; dead jobs are the dead letters
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param status query string false "Filter by status (queued, running, succeeded, dead)"
+// @Param kind query string false "Filter by kind"
+// @Success 200 {object} response.APIResponse{data=[]Job} "Jobs retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/jobs [get]
+func (h *JobHandler) ListJobs(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// Optional status and kind filters
+	for _, field := range []string{"status", "kind"} {
+		if value := c.Query(field); value != "" {
+			opts.Filters = append(opts.Filters, base.FilterOption{
+				Field:    field,
+				Operator: base.OperatorEqual,
+				Value:    value,
+			})
+		}
+	}
+
+	jobs, err := h.jobService.ListJobs(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.jobService.CountJobs(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, jobs, "Jobs retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// RetryJob requeues a dead background job
+// @Summary Retry a dead job
+// @Description Requeue a job that failed every attempt with a fresh set of attempts
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} response.APIResponse{data=Job} "Job requeued successfully"
+// @Failure 404 {object} response.APIResponse "Job not found"
+// @Failure 409 {object} response.APIResponse "Only dead jobs can be retried"
+// @Router /admin/jobs/{id}/retry [post]
+func (h *JobHandler) RetryJob(c *gin.Context) {
+	jobID, err := h.ValidateUUID(c.Param("id"), "job ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	job, err := h.jobService.RetryJob(c.Request.Context(), jobID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, job, "Job requeued successfully")
+}
@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobStatus represents the state of a background job
+// @Description State of a background job
+// @Name JobStatus
+type JobStatus string
+
+const (
+	// JobQueued jobs wait for a worker, including failed attempts awaiting a retry
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	// JobDead jobs failed every attempt, or failed permanently, and are kept
+	// as dead letters until retried
+	JobDead JobStatus = "dead"
+)
+
+// Job represents a long-running task in the persistent job queue
+// @Description Background job with its progress and outcome
+// @Name Job
+type Job struct {
+	ID              uuid.UUID       `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID        *uuid.UUID      `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Kind            string          `json:"kind" db:"kind" example:"project.screenshot"`
+	Payload         json.RawMessage `json:"payload,omitempty" db:"payload" swaggertype:"object"`
+	Status          JobStatus       `json:"status" db:"status" example:"running"`
+	Progress        int             `json:"progress" db:"progress" example:"40"`
+	ProgressMessage string          `json:"progress_message,omitempty" db:"progress_message" example:"Capturing screenshot"`
+	Result          json.RawMessage `json:"result,omitempty" db:"result" swaggertype:"object"`
+	Attempts        int             `json:"attempts" db:"attempts" example:"1"`
+	MaxAttempts     int             `json:"max_attempts" db:"max_attempts" example:"3"`
+	LastError       string          `json:"last_error,omitempty" db:"last_error"`
+	RunAt           *time.Time      `json:"run_at,omitempty" db:"run_at"`
+	LockedUntil     *time.Time      `json:"locked_until,omitempty" db:"locked_until"`
+	StartedAt       *time.Time      `json:"started_at,omitempty" db:"started_at"`
+	FinishedAt      *time.Time      `json:"finished_at,omitempty" db:"finished_at"`
+	CreatedAt       *time.Time      `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt       *time.Time      `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// JobUpdate is the payload of job events on the activity stream
+// @Description Progress of a background job streamed as an event
+// @Name JobUpdate
+type JobUpdate struct {
+	Kind     string    `json:"kind" example:"import.content"`
+	Status   JobStatus `json:"status" example:"running"`
+	Progress int       `json:"progress" example:"40"`
+	Message  string    `json:"message,omitempty" example:"Imported item 4 of 10"`
+}
+
+// Progress reports how far a running job has come, as a percentage with
+// a short description of the current step. The Result of the job, when
+// the runner has set one, is saved along with it as a partial result.
+type Progress func(percent int, message string)
+
+// Runner performs the work of one kind of job. The returned result is
+// stored on the job as JSON.
+type Runner func(ctx context.Context, job *Job, progress Progress) (interface{}, error)
@@ -0,0 +1,261 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type JobRepository interface {
+	base.BaseRepository[Job, Job]
+	// FindDue returns queued jobs whose run time has come and running jobs
+	// whose worker lease expired, across all tenants
+	FindDue(ctx context.Context, now time.Time, limit int) ([]Job, error)
+	// Claim starts an attempt of a job read by FindDue, holding it until
+	// lockedUntil. It reports false when another worker claimed it first.
+	Claim(ctx context.Context, job *Job, lockedUntil time.Time) (bool, error)
+	// UpdateProgress records the progress and partial result of a running
+	// job and extends its lease
+	UpdateProgress(ctx context.Context, job *Job, lockedUntil time.Time) error
+}
+
+type jobRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewJobRepository(supabaseClient *supabase.SupabaseClient) JobRepository {
+	return &jobRepository{
+		supabaseClient: supabaseClient,
+		table:          "job",
+	}
+}
+
+func (r *jobRepository) Create(ctx context.Context, job *Job) (*Job, error) {
+	job.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(job, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create job")
+	}
+	return job, nil
+}
+
+// Update keeps the tenant of the job, since workers run without one
+func (r *jobRepository) Update(ctx context.Context, job *Job) (*Job, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(job, "minimal", "").
+		Eq("id", job.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update job")
+	}
+	return job, nil
+}
+
+func (r *jobRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete job")
+	}
+	return nil
+}
+
+func (r *jobRepository) List(ctx context.Context, opts base.ListOptions) ([]Job, error) {
+	var jobs []Job
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("kind.ilike.%%%s%%,last_error.ilike.%%%s%%", opts.Search, opts.Search),
+			"",
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&jobs)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list jobs")
+	}
+
+	return jobs, nil
+}
+
+func (r *jobRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count jobs")
+	}
+
+	return int(count), nil
+}
+
+func (r *jobRepository) Exists(ctx context.Context, id string) (bool, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("id", id).
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check job existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *jobRepository) FindByField(ctx context.Context, field string, value interface{}) ([]Job, error) {
+	var jobs []Job
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&jobs)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find jobs by field")
+	}
+	return jobs, nil
+}
+
+func (r *jobRepository) Search(ctx context.Context, opts base.ListOptions) ([]Job, int, error) {
+	jobs, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search jobs")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count jobs")
+	}
+
+	return jobs, count, nil
+}
+
+func (r *jobRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]Job, error) {
+	var jobs []Job
+	at := now.UTC().Format(time.RFC3339)
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Or(fmt.Sprintf(
+			"and(status.eq.%s,run_at.lte.%s),and(status.eq.%s,locked_until.lt.%s)",
+			JobQueued, at, JobRunning, at,
+		), "").
+		Order("run_at", &postgrest.OrderOpts{Ascending: true}).
+		Limit(limit, "").
+		ExecuteTo(&jobs)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find due jobs")
+	}
+	return jobs, nil
+}
+
+// Claim only matches while the status and attempts are those read, so that
+// of several workers claiming the same job only one succeeds
+func (r *jobRepository) Claim(ctx context.Context, job *Job, lockedUntil time.Time) (bool, error) {
+	var claimed []Job
+	now := time.Now().UTC()
+	startedAt := job.StartedAt
+	if startedAt == nil {
+		startedAt = &now
+	}
+
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"status":       JobRunning,
+			"attempts":     job.Attempts + 1,
+			"locked_until": lockedUntil.UTC(),
+			"started_at":   startedAt,
+			"updated_at":   now,
+		}, "representation", "").
+		Eq("id", job.ID.String()).
+		Eq("status", string(job.Status)).
+		Eq("attempts", strconv.Itoa(job.Attempts)).
+		ExecuteTo(&claimed)
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to claim job")
+	}
+
+	if len(claimed) == 0 {
+		return false, nil
+	}
+	*job = claimed[0]
+	return true, nil
+}
+
+func (r *jobRepository) UpdateProgress(ctx context.Context, job *Job, lockedUntil time.Time) error {
+	update := map[string]interface{}{
+		"progress":         job.Progress,
+		"progress_message": job.ProgressMessage,
+		"locked_until":     lockedUntil.UTC(),
+		"updated_at":       time.Now().UTC(),
+	}
+	if job.Result != nil {
+		update["result"] = job.Result
+	}
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(update, "minimal", "").
+		Eq("id", job.ID.String()).
+		Eq("status", string(JobRunning)).
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update job progress")
+	}
+	return nil
+}
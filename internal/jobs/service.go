@@ -0,0 +1,371 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// maxRetryBackoff caps the delay between job attempts
+const maxRetryBackoff = time.Hour
+
+// TenantScoper returns the scope a job of the given tenant runs in
+type TenantScoper func(ctx context.Context, tenantID uuid.UUID) (base.TenantScope, error)
+
+type JobService interface {
+	// Register sets the runner of a kind of job
+	Register(kind string, runner Runner)
+	// Enqueue queues a job of a registered kind with a JSON payload
+	Enqueue(ctx context.Context, kind string, payload interface{}) (*Job, error)
+	GetJobByID(ctx context.Context, id string) (*Job, error)
+	ListJobs(ctx context.Context, opts base.ListOptions) ([]Job, error)
+	CountJobs(ctx context.Context, filters []base.FilterOption) (int, error)
+	// RetryJob requeues a dead job with a fresh set of attempts
+	RetryJob(ctx context.Context, id string) (*Job, error)
+	// RunNext claims the next due job and runs it, reporting whether there
+	// was one
+	RunNext(ctx context.Context) (bool, error)
+	// Pending signals when a job has been queued
+	Pending() <-chan struct{}
+}
+
+// Options tunes retries and leases of the job queue
+type Options struct {
+	MaxAttempts   int
+	RetryInterval time.Duration
+	// Lease is how long a worker holds a job without reporting progress
+	// before another worker may take it over
+	Lease     time.Duration
+	BatchSize int
+}
+
+type jobService struct {
+	jobRepo   JobRepository
+	scope     TenantScoper
+	publisher events.Publisher
+	options   Options
+	pending   chan struct{}
+
+	mu      sync.RWMutex
+	runners map[string]Runner
+}
+
+func NewJobService(jobRepo JobRepository, scope TenantScoper, publisher events.Publisher, options Options) JobService {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 3
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = 30 * time.Second
+	}
+	if options.Lease <= 0 {
+		options.Lease = 15 * time.Minute
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 10
+	}
+
+	return &jobService{
+		jobRepo:   jobRepo,
+		scope:     scope,
+		publisher: publisher,
+		options:   options,
+		pending:   make(chan struct{}, 1),
+		runners:   make(map[string]Runner),
+	}
+}
+
+func (s *jobService) Register(kind string, runner Runner) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.runners[kind] = runner
+}
+
+func (s *jobService) Enqueue(ctx context.Context, kind string, payload interface{}) (*Job, error) {
+	if s.runner(kind) == nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Unknown job kind",
+			nil,
+			errors.WithContext("kind", kind),
+		)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid job payload",
+			errors.WithContext("kind", kind),
+		)
+	}
+
+	now := time.Now().UTC()
+	job := Job{
+		ID:          uuid.New(),
+		Kind:        kind,
+		Payload:     data,
+		Status:      JobQueued,
+		MaxAttempts: s.options.MaxAttempts,
+		RunAt:       &now,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	createdJob, err := s.jobRepo.Create(ctx, &job)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to queue job",
+			errors.WithContext("kind", kind),
+		)
+	}
+
+	s.signal()
+
+	return createdJob, nil
+}
+
+func (s *jobService) GetJobByID(ctx context.Context, id string) (*Job, error) {
+	jobs, err := s.jobRepo.FindByField(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(jobs) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Job not found",
+			nil,
+			errors.WithContext("job_id", id),
+		)
+	}
+
+	return &jobs[0], nil
+}
+
+func (s *jobService) ListJobs(ctx context.Context, opts base.ListOptions) ([]Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.jobRepo.List(ctx, opts)
+}
+
+func (s *jobService) CountJobs(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.jobRepo.Count(ctx, filters)
+}
+
+func (s *jobService) RetryJob(ctx context.Context, id string) (*Job, error) {
+	job, err := s.GetJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != JobDead {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Only dead jobs can be retried",
+			nil,
+			errors.WithContext("job_id", id),
+			errors.WithContext("status", job.Status),
+		)
+	}
+
+	now := time.Now().UTC()
+	job.Status = JobQueued
+	job.Attempts = 0
+	job.MaxAttempts = s.options.MaxAttempts
+	job.Progress = 0
+	job.ProgressMessage = ""
+	job.RunAt = &now
+	job.LockedUntil = nil
+	job.FinishedAt = nil
+	job.UpdatedAt = &now
+
+	updatedJob, err := s.jobRepo.Update(ctx, job)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to requeue job",
+			errors.WithContext("job_id", id),
+		)
+	}
+
+	s.signal()
+
+	return updatedJob, nil
+}
+
+func (s *jobService) RunNext(ctx context.Context) (bool, error) {
+	jobs, err := s.jobRepo.FindDue(ctx, time.Now(), s.options.BatchSize)
+	if err != nil {
+		return false, err
+	}
+
+	// Other workers may claim the same jobs; take the first one still free
+	for i := range jobs {
+		claimed, err := s.jobRepo.Claim(ctx, &jobs[i], time.Now().Add(s.options.Lease))
+		if err != nil {
+			return false, err
+		}
+		if claimed {
+			s.attempt(ctx, &jobs[i])
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *jobService) Pending() <-chan struct{} {
+	return s.pending
+}
+
+// attempt runs a claimed job once and records the outcome. Jobs failing
+// with a validation, not found or configuration error are dead-lettered
+// right away, since retrying cannot help.
+func (s *jobService) attempt(ctx context.Context, job *Job) {
+	result, runErr := s.run(ctx, job)
+
+	now := time.Now().UTC()
+	job.LockedUntil = nil
+	job.UpdatedAt = &now
+
+	eventType := events.JobProgressed
+	switch {
+	case runErr == nil:
+		data, err := json.Marshal(result)
+		if err != nil {
+			data = nil
+		}
+		job.Status = JobSucceeded
+		job.Progress = 100
+		job.Result = data
+		job.LastError = ""
+		job.FinishedAt = &now
+		eventType = events.JobSucceeded
+	case ctx.Err() != nil:
+		// Interrupted by shutdown: run again without counting the attempt
+		job.Status = JobQueued
+		job.Attempts--
+		job.RunAt = &now
+		job.LastError = runErr.Error()
+	case permanent(runErr) || job.Attempts >= job.MaxAttempts:
+		job.Status = JobDead
+		job.LastError = runErr.Error()
+		job.FinishedAt = &now
+		eventType = events.JobDead
+	default:
+		next := now.Add(s.backoff(job.Attempts))
+		job.Status = JobQueued
+		job.RunAt = &next
+		job.LastError = runErr.Error()
+	}
+
+	// The outcome is best effort; a failed update leaves the lease to expire
+	// and the job to run again
+	_, _ = s.jobRepo.Update(context.WithoutCancel(ctx), job)
+
+	message := job.ProgressMessage
+	if job.Status != JobSucceeded {
+		message = job.LastError
+	}
+	s.publish(ctx, job, eventType, message)
+}
+
+// run calls the runner of the job in the scope of its tenant, turning a
+// panic into an error
+func (s *jobService) run(ctx context.Context, job *Job) (result interface{}, err error) {
+	runner := s.runner(job.Kind)
+	if runner == nil {
+		return nil, errors.New(errors.ErrConfiguration, "No runner registered for job kind "+job.Kind, nil)
+	}
+
+	if job.TenantID != nil && s.scope != nil {
+		scope, err := s.scope(ctx, *job.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		ctx = base.WithTenant(ctx, scope)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	progress := func(percent int, message string) {
+		percent = min(max(percent, 0), 100)
+		job.Progress = percent
+		job.ProgressMessage = message
+		_ = s.jobRepo.UpdateProgress(ctx, job, time.Now().Add(s.options.Lease))
+		s.publish(ctx, job, events.JobProgressed, message)
+	}
+
+	return runner(ctx, job, progress)
+}
+
+func (s *jobService) runner(kind string) Runner {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.runners[kind]
+}
+
+// publish streams the state of a job to subscribers of the event bus
+func (s *jobService) publish(ctx context.Context, job *Job, eventType events.EventType, message string) {
+	s.publisher.Publish(ctx, events.Event{
+		TenantID: job.TenantID,
+		Type:     eventType,
+		Entity:   "job",
+		EntityID: job.ID.String(),
+		Summary:  message,
+		Payload: JobUpdate{
+			Kind:     job.Kind,
+			Status:   job.Status,
+			Progress: job.Progress,
+			Message:  message,
+		},
+	})
+}
+
+// backoff doubles the retry interval for every failed attempt
+func (s *jobService) backoff(attempts int) time.Duration {
+	delay := s.options.RetryInterval
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	return delay
+}
+
+// signal wakes the worker pool without blocking when a wake-up is already
+// pending
+func (s *jobService) signal() {
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+// permanent reports whether an error cannot be fixed by retrying
+func permanent(err error) bool {
+	return errors.Is(err, errors.ErrValidation) ||
+		errors.Is(err, errors.ErrNotFound) ||
+		errors.Is(err, errors.ErrConfiguration)
+}
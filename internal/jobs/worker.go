@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Worker runs queued jobs in the background with a fixed pool of
+// goroutines, each running one job at a time
+type Worker struct {
+	jobService JobService
+	size       int
+	interval   time.Duration
+	logger     *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWorker creates a pool of size workers polling every interval
+func NewWorker(jobService JobService, size int, interval time.Duration, logger *logger.Logger) *Worker {
+	if size <= 0 {
+		size = 2
+	}
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	return &Worker{
+		jobService: jobService,
+		size:       size,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Start runs the pool until ctx is cancelled or Stop is called
+func (w *Worker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+
+	for i := 0; i < w.size; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx)
+		}()
+	}
+}
+
+// Stop halts the pool and waits for the running jobs to return. Jobs
+// interrupted by the cancellation are queued again.
+func (w *Worker) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+}
+
+// loop runs due jobs back to back, then waits for a new job or the next
+// poll
+func (w *Worker) loop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			ran, err := w.jobService.RunNext(ctx)
+			if err != nil && ctx.Err() == nil {
+				w.logger.Error("Failed to run job", "error", err)
+			}
+			if !ran || err != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.jobService.Pending():
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package linkcheck
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type LinkCheckHandler struct {
+	base.BaseHandler
+	linkCheckService LinkCheckService
+}
+
+func NewLinkCheckHandler(linkCheckService LinkCheckService, logger *logger.Logger) *LinkCheckHandler {
+	return &LinkCheckHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		linkCheckService: linkCheckService,
+	}
+}
+
+// CheckLinks runs a link check
+// @Summary Run a link check
+// @Description Check every project and experience URL and record the ones not answering with a 2xx status
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Report} "Link check completed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/linkcheck [post]
+func (h *LinkCheckHandler) CheckLinks(c *gin.Context) {
+	report, err := h.linkCheckService.CheckLinks(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Link check completed successfully")
+}
+
+// ListBrokenLinks retrieves the broken links found by the last link check
+// @Summary List broken links
+// @Description Retrieve the links found unreachable by the last link check
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]BrokenLink} "Broken links retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/linkcheck [get]
+func (h *LinkCheckHandler) ListBrokenLinks(c *gin.Context) {
+	links, err := h.linkCheckService.ListBrokenLinks(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, links, "Broken links retrieved successfully")
+}
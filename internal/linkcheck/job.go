@@ -0,0 +1,99 @@
+package linkcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job periodically checks the links of every tenant
+type Job struct {
+	linkCheckService LinkCheckService
+	tenantService    tenant.TenantService
+	interval         time.Duration
+	logger           *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a link check job running every interval
+func NewJob(linkCheckService LinkCheckService, tenantService tenant.TenantService, interval time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	return &Job{
+		linkCheckService: linkCheckService,
+		tenantService:    tenantService,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// check runs one interval after start to keep startup fast.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run checks the links of each tenant in turn
+func (j *Job) run(ctx context.Context) {
+	for page := 1; ; page++ {
+		tenants, err := j.tenantService.ListTenants(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			if ctx.Err() == nil {
+				j.logger.Error("Failed to list tenants for link check", "error", err)
+			}
+			return
+		}
+
+		for _, t := range tenants {
+			report, err := j.linkCheckService.CheckLinks(base.WithTenant(ctx, t.Scope()))
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				j.logger.Error("Failed to check links", "tenant", t.Slug, "error", err)
+				continue
+			}
+
+			if report.Broken > 0 {
+				j.logger.Warn("Broken links found", "tenant", t.Slug, "checked", report.Checked, "broken", report.Broken)
+			}
+		}
+
+		if len(tenants) < pageSize {
+			return
+		}
+	}
+}
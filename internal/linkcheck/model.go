@@ -0,0 +1,52 @@
+package linkcheck
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entity types whose links are checked
+const (
+	EntityProject    = "project"
+	EntityExperience = "experience"
+)
+
+// BrokenLink is a stored link that did not answer with a 2xx status
+// @Description Link found unreachable by the last link check
+// @Name BrokenLink
+type BrokenLink struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	EntityType string     `json:"entity_type" db:"entity_type" example:"project"`
+	EntityID   uuid.UUID  `json:"entity_id" db:"entity_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	EntityName string     `json:"entity_name" db:"entity_name" example:"Portfolio Website"`
+	Field      string     `json:"field" db:"field" example:"web_url"`
+	URL        string     `json:"url" db:"url" example:"https://myportfolio.com"`
+
+	// StatusCode is the HTTP status received, or 0 when the request failed
+	StatusCode int    `json:"status_code" db:"status_code" example:"404"`
+	Error      string `json:"error,omitempty" db:"error" example:"dial tcp: lookup myportfolio.com: no such host"`
+
+	CheckedAt time.Time  `json:"checked_at" db:"checked_at"`
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+}
+
+// Report summarizes a link check run
+// @Description Result of a link check run
+// @Name LinkCheckReport
+type Report struct {
+	Checked     int          `json:"checked" example:"24"`
+	Broken      int          `json:"broken" example:"2"`
+	BrokenLinks []BrokenLink `json:"broken_links"`
+	CheckedAt   time.Time    `json:"checked_at"`
+}
+
+// link is a URL stored on an entity
+type link struct {
+	entityType string
+	entityID   uuid.UUID
+	entityName string
+	field      string
+	url        string
+}
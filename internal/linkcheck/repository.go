@@ -0,0 +1,72 @@
+package linkcheck
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type BrokenLinkRepository interface {
+	List(ctx context.Context) ([]BrokenLink, error)
+	Replace(ctx context.Context, links []BrokenLink) error
+}
+
+type brokenLinkRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewBrokenLinkRepository(supabaseClient *supabase.SupabaseClient) BrokenLinkRepository {
+	return &brokenLinkRepository{
+		supabaseClient: supabaseClient,
+		table:          "broken_link",
+	}
+}
+
+func (r *brokenLinkRepository) List(ctx context.Context) ([]BrokenLink, error) {
+	var links []BrokenLink
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("entity_type", &postgrest.OrderOpts{Ascending: true}).
+		Order("entity_name", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&links)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list broken links")
+	}
+	return links, nil
+}
+
+// Replace swaps the broken links of the tenant in ctx for the given ones
+func (r *brokenLinkRepository) Replace(ctx context.Context, links []BrokenLink) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "")
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to clear broken links")
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	tenantID := base.TenantIDFromContext(ctx)
+	for i := range links {
+		links[i].TenantID = tenantID
+	}
+
+	_, _, err = r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(links, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record broken links")
+	}
+	return nil
+}
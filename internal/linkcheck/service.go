@@ -0,0 +1,148 @@
+package linkcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/urlcheck"
+)
+
+// pageSize is the number of entities loaded per page while collecting links
+const pageSize = 100
+
+type LinkCheckService interface {
+	CheckLinks(ctx context.Context) (*Report, error)
+	ListBrokenLinks(ctx context.Context) ([]BrokenLink, error)
+}
+
+type linkCheckService struct {
+	brokenLinkRepo    BrokenLinkRepository
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	checker           *urlcheck.Checker
+}
+
+func NewLinkCheckService(brokenLinkRepo BrokenLinkRepository, projectService project.ProjectService, experienceService experience.ExperienceService, checker *urlcheck.Checker) LinkCheckService {
+	return &linkCheckService{
+		brokenLinkRepo:    brokenLinkRepo,
+		projectService:    projectService,
+		experienceService: experienceService,
+		checker:           checker,
+	}
+}
+
+// CheckLinks checks every project and experience link of the tenant in ctx
+// and replaces the recorded broken links with the ones found
+func (s *linkCheckService) CheckLinks(ctx context.Context) (*Report, error) {
+	links, err := s.collectLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, len(links))
+	for i, l := range links {
+		urls[i] = l.url
+	}
+
+	checkedAt := time.Now().UTC()
+	results := s.checker.CheckAll(ctx, urls)
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrCanceled, "Link check was canceled")
+	}
+
+	broken := []BrokenLink{}
+	for i, result := range results {
+		if result.OK() {
+			continue
+		}
+
+		brokenLink := BrokenLink{
+			ID:         uuid.New(),
+			EntityType: links[i].entityType,
+			EntityID:   links[i].entityID,
+			EntityName: links[i].entityName,
+			Field:      links[i].field,
+			URL:        links[i].url,
+			StatusCode: result.StatusCode,
+			CheckedAt:  checkedAt,
+		}
+		if result.Err != nil {
+			brokenLink.Error = result.Err.Error()
+		}
+		broken = append(broken, brokenLink)
+	}
+
+	if err := s.brokenLinkRepo.Replace(ctx, broken); err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Checked:     len(links),
+		Broken:      len(broken),
+		BrokenLinks: broken,
+		CheckedAt:   checkedAt,
+	}, nil
+}
+
+func (s *linkCheckService) ListBrokenLinks(ctx context.Context) ([]BrokenLink, error) {
+	return s.brokenLinkRepo.List(ctx)
+}
+
+// collectLinks gathers the non-empty URLs stored on projects and experiences
+func (s *linkCheckService) collectLinks(ctx context.Context) ([]link, error) {
+	var links []link
+
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range projects {
+			add := func(field, url string) {
+				if url != "" {
+					links = append(links, link{EntityProject, p.ID, p.Title, field, url})
+				}
+			}
+			add("github_url", p.GithubUrl)
+			add("web_url", p.WebUrl)
+			for _, image := range p.Images {
+				add("images", image.Src)
+			}
+		}
+
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range experiences {
+			add := func(field, url string) {
+				if url != "" {
+					links = append(links, link{EntityExperience, e.ID, e.Company, field, url})
+				}
+			}
+			add("logo_url", e.LogoUrl)
+			for _, url := range e.ImagesUrl {
+				add("images_url", url)
+			}
+		}
+
+		if len(experiences) < pageSize {
+			break
+		}
+	}
+
+	return links, nil
+}
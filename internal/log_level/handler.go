@@ -0,0 +1,143 @@
+package log_level
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type LogLevelHandler struct {
+	base.BaseHandler
+	logger *logger.Logger
+}
+
+func NewLogLevelHandler(logger *logger.Logger) *LogLevelHandler {
+	return &LogLevelHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		logger:      logger,
+	}
+}
+
+// GetLevels retrieves the log levels
+// @Summary Get the log levels
+// @Description Retrieve the default log level, the level of every module that logged so far and the sinks logs are written to
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Levels} "Log levels retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/logging/levels [get]
+func (h *LogLevelHandler) GetLevels(c *gin.Context) {
+	h.HandleSuccess(c, h.current(), "Log levels retrieved successfully")
+}
+
+// UpdateLevels changes the log levels
+// @Summary Update the log levels
+// @Description Change the default log level and the levels of some modules, taking effect immediately without a restart, e.g. to turn on debug logs of one module while investigating it. Levels are debug, info, warn or error. Changes last until the server restarts.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param levels body LevelsUpdate true "Log levels"
+// @Success 200 {object} response.APIResponse{data=Levels} "Log levels updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/logging/levels [patch]
+func (h *LogLevelHandler) UpdateLevels(c *gin.Context) {
+	var input LevelsUpdate
+
+	if err := h.BindJSON(c, &input); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	// Validate every level before applying any
+	var level *logger.LogLevel
+	if input.Level != "" {
+		parsed, err := logger.ParseLevel(input.Level)
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Log level must be debug, info, warn or error",
+				err,
+				errors.WithContext("level", input.Level),
+			))
+			return
+		}
+		level = &parsed
+	}
+
+	modules := map[string]logger.LogLevel{}
+	for module, name := range input.Modules {
+		module = strings.TrimSpace(module)
+		parsed, err := logger.ParseLevel(name)
+		if module == "" || name == "" || err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Module log levels must name a module and be debug, info, warn or error",
+				err,
+				errors.WithContext("module", module),
+				errors.WithContext("level", name),
+			))
+			return
+		}
+		modules[module] = parsed
+	}
+
+	if level != nil {
+		h.logger.SetLevel(*level)
+	}
+	for module, moduleLevel := range modules {
+		h.logger.SetModuleLevel(module, moduleLevel)
+	}
+
+	levels := h.current()
+	h.logger.Info("Log levels updated",
+		"level", levels.Level,
+		"modules", input.Modules,
+	)
+
+	h.HandleSuccess(c, levels, "Log levels updated successfully")
+}
+
+// ResetModuleLevel makes a module follow the default level again
+// @Summary Reset the log level of a module
+// @Description Remove the level of a module so it follows the default log level again
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param module path string true "Module name, e.g. tech_stack"
+// @Success 200 {object} response.APIResponse{data=Levels} "Module log level reset successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/logging/levels/{module} [delete]
+func (h *LogLevelHandler) ResetModuleLevel(c *gin.Context) {
+	module := c.Param("module")
+	h.logger.ResetModuleLevel(module)
+
+	h.logger.Info("Module log level reset", "module", module)
+
+	h.HandleSuccess(c, h.current(), "Module log level reset successfully")
+}
+
+func (h *LogLevelHandler) current() Levels {
+	levels := Levels{
+		Level:   h.logger.Level().String(),
+		Modules: []ModuleLevel{},
+		Sinks:   h.logger.Sinks(),
+	}
+	for _, module := range h.logger.ModuleLevels() {
+		levels.Modules = append(levels.Modules, ModuleLevel{
+			Module:     module.Module,
+			Level:      module.Level.String(),
+			Overridden: module.Overridden,
+		})
+	}
+	return levels
+}
@@ -0,0 +1,24 @@
+package log_level
+
+// Levels are the levels logs are currently written at
+type Levels struct {
+	// Level applies to every module without a level of its own
+	Level   string        `json:"level" example:"info"`
+	Modules []ModuleLevel `json:"modules"`
+	Sinks   []string      `json:"sinks" example:"console,file"`
+}
+
+// ModuleLevel is the level of one module, named after its package such as
+// tech_stack or mailer
+type ModuleLevel struct {
+	Module     string `json:"module" example:"tech_stack"`
+	Level      string `json:"level" example:"debug"`
+	Overridden bool   `json:"overridden" example:"true"`
+}
+
+// LevelsUpdate changes the default level and the levels of some modules.
+// An empty default level is left unchanged.
+type LevelsUpdate struct {
+	Level   string            `json:"level,omitempty" example:"info" enums:"debug,info,warn,error"`
+	Modules map[string]string `json:"modules,omitempty"`
+}
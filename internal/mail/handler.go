@@ -0,0 +1,121 @@
+package mail
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type MailHandler struct {
+	base.BaseHandler
+	mailService MailService
+}
+
+func NewMailHandler(mailService MailService, logger *logger.Logger) *MailHandler {
+	return &MailHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		mailService: mailService,
+	}
+}
+
+// ListDeliveries retrieves a paginated list of email deliveries
+// @Summary List email deliveries
+// @Description Retrieve the email delivery log with optional status filtering
+// @Tags Mail
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param status query string false "Filter by status (pending, sent, failed)"
+// @Success 200 {object} response.APIResponse{data=[]MailDelivery} "Mail deliveries retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /mail/deliveries [get]
+func (h *MailHandler) ListDeliveries(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// Optional status filter
+	status := c.Query("status")
+	if status != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "status",
+			Operator: base.OperatorEqual,
+			Value:    status,
+		})
+	}
+
+	deliveries, err := h.mailService.ListDeliveries(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.mailService.CountDeliveries(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, deliveries, "Mail deliveries retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetDeliveryByID retrieves a specific email delivery
+// @Summary Get an email delivery by ID
+// @Description Retrieve a specific email delivery including its rendered content
+// @Tags Mail
+// @Produce json
+// @Param id path string true "Mail Delivery ID"
+// @Success 200 {object} response.APIResponse{data=MailDelivery} "Mail delivery retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Mail delivery not found"
+// @Router /mail/deliveries/{id} [get]
+func (h *MailHandler) GetDeliveryByID(c *gin.Context) {
+	deliveryID, err := h.ValidateUUID(c.Param("id"), "mail delivery ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	delivery, err := h.mailService.GetDeliveryByID(c.Request.Context(), deliveryID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, delivery, "Mail delivery retrieved successfully")
+}
+
+// RetryDelivery requeues a failed email delivery
+// @Summary Retry an email delivery
+// @Description Requeue a pending or failed email delivery with a fresh set of attempts
+// @Tags Mail
+// @Produce json
+// @Param id path string true "Mail Delivery ID"
+// @Success 200 {object} response.APIResponse{data=MailDelivery} "Mail delivery requeued successfully"
+// @Failure 404 {object} response.APIResponse "Mail delivery not found"
+// @Failure 409 {object} response.APIResponse "Mail delivery was already sent"
+// @Router /mail/deliveries/{id}/retry [post]
+func (h *MailHandler) RetryDelivery(c *gin.Context) {
+	deliveryID, err := h.ValidateUUID(c.Param("id"), "mail delivery ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	delivery, err := h.mailService.RetryDelivery(c.Request.Context(), deliveryID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, delivery, "Mail delivery requeued successfully")
+}
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryStatus represents the state of an email delivery
+// @Description Delivery state of an email
+// @Name DeliveryStatus
+type DeliveryStatus string
+
+const (
+	DeliveryPending DeliveryStatus = "pending"
+	DeliverySent    DeliveryStatus = "sent"
+	DeliveryFailed  DeliveryStatus = "failed"
+)
+
+// MailDelivery represents an email in the delivery log and retry queue
+// @Description Email delivery log entry
+// @Name MailDelivery
+type MailDelivery struct {
+	ID            uuid.UUID      `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID      *uuid.UUID     `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Template      string         `json:"template,omitempty" db:"template" example:"contact_notification"`
+	Recipients    []string       `json:"recipients" db:"recipients" pg:"array" example:"someone@example.com"`
+	ReplyTo       string         `json:"reply_to,omitempty" db:"reply_to" example:"visitor@example.com"`
+	Subject       string         `json:"subject" db:"subject" example:"New message from Jane"`
+	HTMLBody      string         `json:"html_body,omitempty" db:"html_body"`
+	TextBody      string         `json:"text_body,omitempty" db:"text_body"`
+	Status        DeliveryStatus `json:"status" db:"status" example:"pending"`
+	Provider      string         `json:"provider,omitempty" db:"provider" example:"smtp"`
+	Attempts      int            `json:"attempts" db:"attempts" example:"1"`
+	MaxAttempts   int            `json:"max_attempts" db:"max_attempts" example:"5"`
+	LastError     string         `json:"last_error,omitempty" db:"last_error"`
+	NextAttemptAt *time.Time     `json:"next_attempt_at,omitempty" db:"next_attempt_at"`
+	SentAt        *time.Time     `json:"sent_at,omitempty" db:"sent_at"`
+	CreatedAt     *time.Time     `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt     *time.Time     `json:"updated_at,omitempty" db:"updated_at"`
+}
@@ -0,0 +1,85 @@
+package mail
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Queue periodically delivers due emails in the background
+type Queue struct {
+	mailService MailService
+	interval    time.Duration
+	logger      *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewQueue creates a delivery queue polling every interval
+func NewQueue(mailService MailService, interval time.Duration, logger *logger.Logger) *Queue {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &Queue{
+		mailService: mailService,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Start runs the queue until ctx is cancelled or Stop is called
+func (q *Queue) Start(ctx context.Context) {
+	ctx, q.cancel = context.WithCancel(ctx)
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+
+		ticker := time.NewTicker(q.interval)
+		defer ticker.Stop()
+
+		for {
+			q.process(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-q.mailService.Pending():
+			}
+		}
+	}()
+}
+
+// Stop halts the queue and waits for the current batch to finish
+func (q *Queue) Stop() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// Drain stops the queue, then sends the deliveries that are already due
+// until ctx is done
+func (q *Queue) Drain(ctx context.Context) error {
+	q.Stop()
+	q.process(ctx)
+	return ctx.Err()
+}
+
+// process sends one batch of due deliveries
+func (q *Queue) process(ctx context.Context) {
+	processed, err := q.mailService.ProcessDue(ctx)
+	if err != nil && ctx.Err() == nil {
+		q.logger.Error("Failed to process mail queue", "error", err)
+		return
+	}
+
+	if processed > 0 {
+		q.logger.Info("Processed mail queue", "deliveries", processed)
+	}
+}
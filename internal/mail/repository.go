@@ -0,0 +1,193 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type MailDeliveryRepository interface {
+	base.BaseRepository[MailDelivery, MailDelivery]
+	FindDue(ctx context.Context, now time.Time, limit int) ([]MailDelivery, error)
+}
+
+type mailDeliveryRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewMailDeliveryRepository(supabaseClient *supabase.SupabaseClient) MailDeliveryRepository {
+	return &mailDeliveryRepository{
+		supabaseClient: supabaseClient,
+		table:          "mail_delivery",
+	}
+}
+
+func (r *mailDeliveryRepository) Create(ctx context.Context, delivery *MailDelivery) (*MailDelivery, error) {
+	delivery.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(delivery, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create mail delivery")
+	}
+	return delivery, nil
+}
+
+// Update keeps the tenant of the delivery, since the queue worker runs without one
+func (r *mailDeliveryRepository) Update(ctx context.Context, delivery *MailDelivery) (*MailDelivery, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(delivery, "minimal", "").
+		Eq("id", delivery.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update mail delivery")
+	}
+	return delivery, nil
+}
+
+func (r *mailDeliveryRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete mail delivery")
+	}
+	return nil
+}
+
+func (r *mailDeliveryRepository) List(ctx context.Context, opts base.ListOptions) ([]MailDelivery, error) {
+	var deliveries []MailDelivery
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("subject.ilike.%%%s%%,template.ilike.%%%s%%", opts.Search, opts.Search),
+			"",
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&deliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list mail deliveries")
+	}
+
+	return deliveries, nil
+}
+
+func (r *mailDeliveryRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count mail deliveries")
+	}
+
+	return int(count), nil
+}
+
+func (r *mailDeliveryRepository) Exists(ctx context.Context, id string) (bool, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("id", id).
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check mail delivery existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *mailDeliveryRepository) FindByField(ctx context.Context, field string, value interface{}) ([]MailDelivery, error) {
+	var deliveries []MailDelivery
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&deliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find mail deliveries by field")
+	}
+	return deliveries, nil
+}
+
+func (r *mailDeliveryRepository) Search(ctx context.Context, opts base.ListOptions) ([]MailDelivery, int, error) {
+	deliveries, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search mail deliveries")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count mail deliveries")
+	}
+
+	return deliveries, count, nil
+}
+
+// FindDue returns pending deliveries whose next attempt is due, across all tenants
+func (r *mailDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]MailDelivery, error) {
+	var deliveries []MailDelivery
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("status", string(DeliveryPending)).
+		Lte("next_attempt_at", now.UTC().Format(time.RFC3339)).
+		Order("next_attempt_at", &postgrest.OrderOpts{Ascending: true}).
+		Limit(limit, "").
+		ExecuteTo(&deliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find due mail deliveries")
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,260 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/mailer"
+)
+
+// maxRetryBackoff caps the delay between delivery attempts
+const maxRetryBackoff = 6 * time.Hour
+
+type MailService interface {
+	// Enqueue renders a template and queues it for delivery
+	Enqueue(ctx context.Context, template string, to []string, replyTo string, data interface{}) (*MailDelivery, error)
+	// EnqueueMessage queues an already built message for delivery
+	EnqueueMessage(ctx context.Context, template string, msg *mailer.Message) (*MailDelivery, error)
+	GetDeliveryByID(ctx context.Context, id string) (*MailDelivery, error)
+	ListDeliveries(ctx context.Context, opts base.ListOptions) ([]MailDelivery, error)
+	CountDeliveries(ctx context.Context, filters []base.FilterOption) (int, error)
+	RetryDelivery(ctx context.Context, id string) (*MailDelivery, error)
+	// ProcessDue sends every due delivery and returns how many were attempted
+	ProcessDue(ctx context.Context) (int, error)
+	// Pending signals when a delivery has been queued
+	Pending() <-chan struct{}
+}
+
+type mailService struct {
+	deliveryRepo  MailDeliveryRepository
+	mailer        *mailer.Mailer
+	maxAttempts   int
+	retryInterval time.Duration
+	batchSize     int
+	pending       chan struct{}
+}
+
+func NewMailService(deliveryRepo MailDeliveryRepository, mailer *mailer.Mailer, maxAttempts int, retryInterval time.Duration, batchSize int) MailService {
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	if retryInterval <= 0 {
+		retryInterval = time.Minute
+	}
+	if batchSize <= 0 {
+		batchSize = 20
+	}
+
+	return &mailService{
+		deliveryRepo:  deliveryRepo,
+		mailer:        mailer,
+		maxAttempts:   maxAttempts,
+		retryInterval: retryInterval,
+		batchSize:     batchSize,
+		pending:       make(chan struct{}, 1),
+	}
+}
+
+func (s *mailService) Enqueue(ctx context.Context, template string, to []string, replyTo string, data interface{}) (*MailDelivery, error) {
+	msg, err := s.mailer.Render(template, to, data)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to render email template",
+			errors.WithContext("template", template),
+		)
+	}
+	msg.ReplyTo = replyTo
+
+	return s.EnqueueMessage(ctx, template, msg)
+}
+
+func (s *mailService) EnqueueMessage(ctx context.Context, template string, msg *mailer.Message) (*MailDelivery, error) {
+	if len(msg.To) == 0 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Email requires at least one recipient",
+			nil,
+			errors.WithContext("template", template),
+		)
+	}
+
+	now := time.Now().UTC()
+	delivery := MailDelivery{
+		ID:            uuid.New(),
+		Template:      template,
+		Recipients:    msg.To,
+		ReplyTo:       msg.ReplyTo,
+		Subject:       msg.Subject,
+		HTMLBody:      msg.HTML,
+		TextBody:      msg.Text,
+		Status:        DeliveryPending,
+		Provider:      s.mailer.ProviderName(),
+		MaxAttempts:   s.maxAttempts,
+		NextAttemptAt: &now,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+
+	createdDelivery, err := s.deliveryRepo.Create(ctx, &delivery)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to queue email",
+			errors.WithContext("template", template),
+		)
+	}
+
+	s.signal()
+
+	return createdDelivery, nil
+}
+
+func (s *mailService) GetDeliveryByID(ctx context.Context, id string) (*MailDelivery, error) {
+	deliveries, err := s.deliveryRepo.FindByField(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Mail delivery not found",
+			nil,
+			errors.WithContext("delivery_id", id),
+		)
+	}
+
+	return &deliveries[0], nil
+}
+
+func (s *mailService) ListDeliveries(ctx context.Context, opts base.ListOptions) ([]MailDelivery, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.deliveryRepo.List(ctx, opts)
+}
+
+func (s *mailService) CountDeliveries(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.deliveryRepo.Count(ctx, filters)
+}
+
+// RetryDelivery requeues a failed delivery with a fresh set of attempts
+func (s *mailService) RetryDelivery(ctx context.Context, id string) (*MailDelivery, error) {
+	delivery, err := s.GetDeliveryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.Status == DeliverySent {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Mail delivery was already sent",
+			nil,
+			errors.WithContext("delivery_id", id),
+		)
+	}
+
+	now := time.Now().UTC()
+	delivery.Status = DeliveryPending
+	delivery.Attempts = 0
+	delivery.MaxAttempts = s.maxAttempts
+	delivery.NextAttemptAt = &now
+	delivery.UpdatedAt = &now
+
+	updatedDelivery, err := s.deliveryRepo.Update(ctx, delivery)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to requeue email",
+			errors.WithContext("delivery_id", id),
+		)
+	}
+
+	s.signal()
+
+	return updatedDelivery, nil
+}
+
+func (s *mailService) ProcessDue(ctx context.Context) (int, error) {
+	deliveries, err := s.deliveryRepo.FindDue(ctx, time.Now(), s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		s.attempt(ctx, &deliveries[i])
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *mailService) Pending() <-chan struct{} {
+	return s.pending
+}
+
+// attempt sends a delivery once and records the outcome
+func (s *mailService) attempt(ctx context.Context, delivery *MailDelivery) {
+	sendErr := s.mailer.Send(ctx, &mailer.Message{
+		To:      delivery.Recipients,
+		ReplyTo: delivery.ReplyTo,
+		Subject: delivery.Subject,
+		HTML:    delivery.HTMLBody,
+		Text:    delivery.TextBody,
+	})
+
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.Provider = s.mailer.ProviderName()
+	delivery.UpdatedAt = &now
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = DeliverySent
+		delivery.SentAt = &now
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+	case delivery.Attempts >= delivery.MaxAttempts:
+		delivery.Status = DeliveryFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = sendErr.Error()
+	default:
+		next := now.Add(s.backoff(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+		delivery.LastError = sendErr.Error()
+	}
+
+	// The outcome is best effort; a failed update leaves the delivery due again
+	_, _ = s.deliveryRepo.Update(ctx, delivery)
+}
+
+// backoff doubles the retry interval for every failed attempt
+func (s *mailService) backoff(attempts int) time.Duration {
+	delay := s.retryInterval
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	return delay
+}
+
+// signal wakes the queue without blocking when a wake-up is already pending
+func (s *mailService) signal() {
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,86 @@
+package maintenance
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type MaintenanceHandler struct {
+	base.BaseHandler
+	projectService project.ProjectService
+	pageService    page.PageService
+}
+
+func NewMaintenanceHandler(projectService project.ProjectService, pageService page.PageService, logger *logger.Logger) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		BaseHandler:    *base.NewBaseHandler(logger),
+		projectService: projectService,
+		pageService:    pageService,
+	}
+}
+
+// RecomputeReadingTimes recomputes the reading statistics of all content
+// @Summary Recompute reading times
+// @Description Recount the words and reading time of every project case study and page, e.g. after the counting rules change. Statistics are otherwise computed on save; only content whose statistics changed is written.
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=ReadingTimeReport} "Reading times recomputed successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/maintenance/reading-time [post]
+func (h *MaintenanceHandler) RecomputeReadingTimes(c *gin.Context) {
+	projects, err := h.projectService.RecomputeReadingTimes(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to recompute project reading times",
+		))
+		return
+	}
+
+	pages, err := h.pageService.RecomputeReadingTimes(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to recompute page reading times",
+		))
+		return
+	}
+
+	h.HandleSuccess(c, ReadingTimeReport{Projects: projects, Pages: pages}, "Reading times recomputed successfully")
+}
+
+// RebuildTOCs rebuilds the table of contents of all content
+// @Summary Rebuild tables of contents
+// @Description Rebuild the table of contents of every project case study and page, e.g. after the anchor rules change. Tables of contents are otherwise built on save; only content whose table of contents changed is written.
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=TOCReport} "Tables of contents rebuilt successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/maintenance/toc [post]
+func (h *MaintenanceHandler) RebuildTOCs(c *gin.Context) {
+	projects, err := h.projectService.RebuildTOCs(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to rebuild project tables of contents",
+		))
+		return
+	}
+
+	pages, err := h.pageService.RebuildTOCs(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to rebuild page tables of contents",
+		))
+		return
+	}
+
+	h.HandleSuccess(c, TOCReport{Projects: projects, Pages: pages}, "Tables of contents rebuilt successfully")
+}
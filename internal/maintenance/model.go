@@ -0,0 +1,17 @@
+package maintenance
+
+// ReadingTimeReport counts the content whose reading statistics changed
+// @Description Number of projects and pages whose word count or reading time was recomputed
+// @Name ReadingTimeReport
+type ReadingTimeReport struct {
+	Projects int `json:"projects" example:"3"`
+	Pages    int `json:"pages" example:"1"`
+}
+
+// TOCReport counts the content whose table of contents changed
+// @Description Number of projects and pages whose table of contents was rebuilt
+// @Name TOCReport
+type TOCReport struct {
+	Projects int `json:"projects" example:"2"`
+	Pages    int `json:"pages" example:"0"`
+}
@@ -0,0 +1,128 @@
+package media
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type MediaHandler struct {
+	base.BaseHandler
+	mediaService MediaService
+}
+
+func NewMediaHandler(mediaService MediaService, logger *logger.Logger) *MediaHandler {
+	return &MediaHandler{
+		BaseHandler:  *base.NewBaseHandler(logger),
+		mediaService: mediaService,
+	}
+}
+
+// ListImages lists the images of all content
+// @Summary List images
+// @Description List the images of every project and experience with their alt text and what is wrong with it, if anything
+// @Tags Media
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]Image} "Images retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/media [get]
+func (h *MediaHandler) ListImages(c *gin.Context) {
+	images, err := h.mediaService.ListImages(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, images, "Images retrieved successfully")
+}
+
+// GetAltReport reports images without meaningful alt text
+// @Summary Report missing alt text
+// @Description List the images whose alt text is missing, a file name, generic, too short or too long
+// @Tags Media
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=AltReport} "Alt text report generated successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/media/alt-report [get]
+func (h *MediaHandler) GetAltReport(c *gin.Context) {
+	report, err := h.mediaService.GetAltReport(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Alt text report generated successfully")
+}
+
+// UpdateAlt sets the alt text of an image
+// @Summary Update alt text
+// @Description Set the alt text of the image stored at src on a project or an experience
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param alt body AltUpdate true "Alt text"
+// @Success 200 {object} response.APIResponse{data=Image} "Alt text updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Image not found"
+// @Router /admin/media/alt [put]
+func (h *MediaHandler) UpdateAlt(c *gin.Context) {
+	var altUpdate AltUpdate
+	if err := h.BindJSON(c, &altUpdate); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	image, err := h.mediaService.UpdateAlt(c.Request.Context(), &altUpdate)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, image, "Alt text updated successfully")
+}
+
+// SuggestAlt generates alt text for an image
+// @Summary Suggest alt text
+// @Description Generate alt text describing an image with Gemini, storing it when apply is set
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param suggestion body AltSuggestionCreate true "Image to describe"
+// @Success 200 {object} response.APIResponse{data=AltSuggestion} "Alt text suggested successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Image not found"
+// @Failure 500 {object} response.APIResponse "Alt text suggestions are not configured"
+// @Failure 503 {object} response.APIResponse "Failed to suggest alt text"
+// @Router /admin/media/alt/suggest [post]
+func (h *MediaHandler) SuggestAlt(c *gin.Context) {
+	var altSuggestionCreate AltSuggestionCreate
+	if err := h.BindJSON(c, &altSuggestionCreate); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	suggestion, err := h.mediaService.SuggestAlt(c.Request.Context(), &altSuggestionCreate)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, suggestion, "Alt text suggested successfully")
+}
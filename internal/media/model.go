@@ -0,0 +1,77 @@
+package media
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Content owning images
+const (
+	EntityProject    = "project"
+	EntityExperience = "experience"
+)
+
+// AltProblem explains why the alt text of an image does not describe it
+// @Description Reason the alt text of an image is not meaningful
+// @Name AltProblem
+type AltProblem string
+
+const (
+	AltMissing  AltProblem = "missing"
+	AltFilename AltProblem = "filename"
+	AltGeneric  AltProblem = "generic"
+	AltTooShort AltProblem = "too_short"
+	AltTooLong  AltProblem = "too_long"
+)
+
+// Image is an image shown on a project or an experience
+// @Description Image of a project or an experience with its alt text
+// @Name MediaImage
+type Image struct {
+	Entity     string     `json:"entity" example:"project"`
+	EntityID   uuid.UUID  `json:"entity_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EntityName string     `json:"entity_name" example:"Portfolio Website"`
+	Src        string     `json:"src" example:"https://example.com/image.jpg"`
+	Alt        string     `json:"alt" example:"Dashboard listing projects by category"`
+	AltProblem AltProblem `json:"alt_problem,omitempty" example:"filename"`
+}
+
+// AltReport lists the images without meaningful alt text
+// @Description Images whose alt text is missing or does not describe them
+// @Name AltReport
+type AltReport struct {
+	Checked   int                `json:"checked" example:"42"`
+	Counts    map[AltProblem]int `json:"counts"`
+	Images    []Image            `json:"images"`
+	CheckedAt time.Time          `json:"checked_at"`
+}
+
+// AltUpdate sets the alt text of an image
+// @Description Alt text of the image stored at src on a project or an experience
+// @Name AltUpdate
+type AltUpdate struct {
+	Entity   string    `json:"entity" validate:"required" enums:"project,experience" example:"project"`
+	EntityID uuid.UUID `json:"entity_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Src      string    `json:"src" validate:"required" example:"https://example.com/image.jpg"`
+	Alt      string    `json:"alt" validate:"required,max=250" example:"Dashboard listing projects by category"`
+}
+
+// AltSuggestionCreate asks for alt text describing an image
+// @Description Image to suggest alt text for, applying it when apply is set
+// @Name AltSuggestionCreate
+type AltSuggestionCreate struct {
+	Entity   string    `json:"entity" validate:"required" enums:"project,experience" example:"project"`
+	EntityID uuid.UUID `json:"entity_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Src      string    `json:"src" validate:"required" example:"https://example.com/image.jpg"`
+	Apply    bool      `json:"apply" example:"false"`
+}
+
+// AltSuggestion is generated alt text for an image
+// @Description Alt text generated for an image and whether it was applied
+// @Name AltSuggestion
+type AltSuggestion struct {
+	Src     string `json:"src" example:"https://example.com/image.jpg"`
+	Alt     string `json:"alt" example:"Dashboard listing projects by category"`
+	Applied bool   `json:"applied" example:"false"`
+}
@@ -0,0 +1,410 @@
+package media
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/gemini"
+)
+
+const (
+	// pageSize is the number of entities loaded per page while collecting
+	// images
+	pageSize = 100
+
+	// minAltLength and maxAltLength bound meaningful alt text, in characters
+	minAltLength = 10
+	maxAltLength = 250
+
+	// maxImageSize bounds the image downloaded to suggest alt text for
+	maxImageSize = 10 << 20
+
+	altPrompt = "Write alt text for this image, shown on a software developer's portfolio. " +
+		"Describe what the image shows and what matters about it in one sentence of at most 125 characters. " +
+		"Do not start with \"Image of\" or \"Picture of\" and answer with the alt text only."
+)
+
+// genericAlts is alt text that says an image is there without describing it
+var genericAlts = map[string]bool{
+	"image":      true,
+	"img":        true,
+	"photo":      true,
+	"picture":    true,
+	"screenshot": true,
+	"thumbnail":  true,
+	"banner":     true,
+	"logo":       true,
+	"icon":       true,
+	"untitled":   true,
+}
+
+// imageExtensions are file extensions marking alt text as a file name
+var imageExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".webp": true,
+	".gif":  true,
+	".svg":  true,
+}
+
+// Describer answers prompts about an image
+type Describer interface {
+	DescribeImage(ctx context.Context, prompt, mimeType string, image []byte) (string, error)
+}
+
+type MediaService interface {
+	// ListImages lists the images of every project and experience along
+	// with the problem of their alt text, if any
+	ListImages(ctx context.Context) ([]Image, error)
+	// GetAltReport lists the images without meaningful alt text
+	GetAltReport(ctx context.Context) (*AltReport, error)
+	// UpdateAlt sets the alt text of an image
+	UpdateAlt(ctx context.Context, altUpdate *AltUpdate) (*Image, error)
+	// SuggestAlt generates alt text for an image, applying it if asked to
+	SuggestAlt(ctx context.Context, altSuggestionCreate *AltSuggestionCreate) (*AltSuggestion, error)
+}
+
+type mediaService struct {
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	describer         Describer
+	httpClient        *http.Client
+}
+
+// NewMediaService creates the media service. A nil describer disables alt
+// text suggestions.
+func NewMediaService(projectService project.ProjectService, experienceService experience.ExperienceService, describer Describer, timeout time.Duration) MediaService {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &mediaService{
+		projectService:    projectService,
+		experienceService: experienceService,
+		describer:         describer,
+		httpClient:        &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *mediaService) ListImages(ctx context.Context) ([]Image, error) {
+	var images []Image
+
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range projects {
+			images = append(images, projectImages(&projects[i])...)
+		}
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range experiences {
+			images = append(images, experienceImages(&experiences[i])...)
+		}
+		if len(experiences) < pageSize {
+			break
+		}
+	}
+
+	if images == nil {
+		images = []Image{}
+	}
+	return images, nil
+}
+
+func (s *mediaService) GetAltReport(ctx context.Context) (*AltReport, error) {
+	images, err := s.ListImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AltReport{
+		Checked:   len(images),
+		Counts:    map[AltProblem]int{},
+		Images:    []Image{},
+		CheckedAt: time.Now().UTC(),
+	}
+	for _, image := range images {
+		if image.AltProblem != "" {
+			report.Counts[image.AltProblem]++
+			report.Images = append(report.Images, image)
+		}
+	}
+
+	return report, nil
+}
+
+func (s *mediaService) UpdateAlt(ctx context.Context, altUpdate *AltUpdate) (*Image, error) {
+	altUpdate.Alt = strings.TrimSpace(altUpdate.Alt)
+	if err := validator.ValidateModel(altUpdate); err != nil {
+		return nil, err
+	}
+
+	return s.setAlt(ctx, altUpdate.Entity, altUpdate.EntityID.String(), altUpdate.Src, altUpdate.Alt)
+}
+
+func (s *mediaService) SuggestAlt(ctx context.Context, altSuggestionCreate *AltSuggestionCreate) (*AltSuggestion, error) {
+	if s.describer == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Alt text suggestions are not configured", nil)
+	}
+
+	if err := validator.ValidateModel(altSuggestionCreate); err != nil {
+		return nil, err
+	}
+
+	image, err := s.findImage(ctx, altSuggestionCreate.Entity, altSuggestionCreate.EntityID.String(), altSuggestionCreate.Src)
+	if err != nil {
+		return nil, err
+	}
+
+	data, mimeType, err := s.download(ctx, image.Src)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to download image",
+			errors.WithContext("src", image.Src),
+		)
+	}
+
+	alt, err := s.describer.DescribeImage(ctx, altPrompt, mimeType, data)
+	if stderrors.Is(err, gemini.ErrBlocked) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"No alt text could be suggested for this image",
+			err,
+			errors.WithContext("src", image.Src),
+		)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrUnavailable,
+			"Failed to suggest alt text",
+			errors.WithContext("src", image.Src),
+		)
+	}
+
+	suggestion := &AltSuggestion{Src: image.Src, Alt: cleanAlt(alt)}
+	if suggestion.Alt == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"No alt text could be suggested for this image",
+			nil,
+			errors.WithContext("src", image.Src),
+		)
+	}
+
+	if altSuggestionCreate.Apply {
+		if _, err := s.setAlt(ctx, image.Entity, image.EntityID.String(), image.Src, suggestion.Alt); err != nil {
+			return nil, err
+		}
+		suggestion.Applied = true
+	}
+
+	return suggestion, nil
+}
+
+// setAlt stores the alt text of an image on the content owning it
+func (s *mediaService) setAlt(ctx context.Context, entity, id, src, alt string) (*Image, error) {
+	var images []Image
+	switch entity {
+	case EntityProject:
+		updated, err := s.projectService.SetImageAlt(ctx, id, src, alt)
+		if err != nil {
+			return nil, err
+		}
+		images = projectImages(updated)
+	case EntityExperience:
+		updated, err := s.experienceService.SetImageAlt(ctx, id, src, alt)
+		if err != nil {
+			return nil, err
+		}
+		images = experienceImages(updated)
+	default:
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Unknown media entity",
+			nil,
+			errors.WithContext("entity", entity),
+		)
+	}
+
+	return imageWithSrc(images, src, entity, id)
+}
+
+// findImage returns the image stored at src on the given content
+func (s *mediaService) findImage(ctx context.Context, entity, id, src string) (*Image, error) {
+	var images []Image
+	switch entity {
+	case EntityProject:
+		found, err := s.projectService.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		images = projectImages(found)
+	case EntityExperience:
+		found, err := s.experienceService.GetExperienceByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		images = experienceImages(found)
+	default:
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Unknown media entity",
+			nil,
+			errors.WithContext("entity", entity),
+		)
+	}
+
+	return imageWithSrc(images, src, entity, id)
+}
+
+// download fetches an image, returning its content and MIME type
+func (s *mediaService) download(ctx context.Context, src string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxImageSize {
+		return nil, "", fmt.Errorf("image is larger than %d bytes", maxImageSize)
+	}
+
+	mimeType := http.DetectContentType(data)
+	if !strings.HasPrefix(mimeType, "image/") {
+		return nil, "", fmt.Errorf("unsupported content type %s", mimeType)
+	}
+	return data, mimeType, nil
+}
+
+func projectImages(p *project.ProjectDTO) []Image {
+	images := make([]Image, 0, len(p.Images))
+	for _, img := range p.Images {
+		if img.Src == "" {
+			continue
+		}
+		images = append(images, Image{
+			Entity:     EntityProject,
+			EntityID:   p.ID,
+			EntityName: p.Title,
+			Src:        img.Src,
+			Alt:        img.Alt,
+			AltProblem: altProblem(img.Alt, img.Src),
+		})
+	}
+	return images
+}
+
+func experienceImages(e *experience.ExperienceDTO) []Image {
+	images := make([]Image, 0, len(e.ImagesUrl))
+	for _, src := range e.ImagesUrl {
+		if src == "" {
+			continue
+		}
+		alt := e.ImageAlts[src]
+		images = append(images, Image{
+			Entity:     EntityExperience,
+			EntityID:   e.ID,
+			EntityName: fmt.Sprintf("%s at %s", e.Role, e.Company),
+			Src:        src,
+			Alt:        alt,
+			AltProblem: altProblem(alt, src),
+		})
+	}
+	return images
+}
+
+func imageWithSrc(images []Image, src, entity, id string) (*Image, error) {
+	for i := range images {
+		if images[i].Src == src {
+			return &images[i], nil
+		}
+	}
+	return nil, errors.New(
+		errors.ErrNotFound,
+		"Image not found",
+		nil,
+		errors.WithContext("entity", entity),
+		errors.WithContext("entity_id", id),
+		errors.WithContext("src", src),
+	)
+}
+
+// altProblem reports why alt text does not describe the image at src, or
+// nothing when it looks meaningful
+func altProblem(alt, src string) AltProblem {
+	alt = strings.TrimSpace(alt)
+	length := utf8.RuneCountInString(alt)
+
+	switch {
+	case alt == "":
+		return AltMissing
+	case isFilename(alt, src):
+		return AltFilename
+	case genericAlts[strings.ToLower(strings.TrimRight(alt, ".!"))]:
+		return AltGeneric
+	case length < minAltLength:
+		return AltTooShort
+	case length > maxAltLength:
+		return AltTooLong
+	default:
+		return ""
+	}
+}
+
+// isFilename reports whether alt is the file name of the image at src or
+// looks like one
+func isFilename(alt, src string) bool {
+	if u, err := url.Parse(src); err == nil && alt == path.Base(u.Path) {
+		return true
+	}
+	return imageExtensions[strings.ToLower(path.Ext(alt))]
+}
+
+// cleanAlt trims the quotes and whitespace models wrap answers in and
+// bounds the length of the alt text
+func cleanAlt(alt string) string {
+	alt = strings.Trim(strings.TrimSpace(alt), "\"'`")
+	alt = strings.Join(strings.Fields(alt), " ")
+	if utf8.RuneCountInString(alt) > maxAltLength {
+		alt = string([]rune(alt)[:maxAltLength])
+	}
+	return alt
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// cacheHitKey is the context key set by handlers that served a cached
+// response
+const cacheHitKey = "cache_hit"
+
+// MarkCacheHit records that the response was served from a cache, for the
+// access log
+func MarkCacheHit(c *gin.Context) {
+	c.Set(cacheHitKey, true)
+}
+
+// AccessLogConfig configures the access log
+type AccessLogConfig struct {
+	// SampleRate is the share of successful responses logged, between 0
+	// and 1. Client and server errors are always logged.
+	SampleRate float64
+}
+
+// AccessLog writes one record per request to log, a logger kept apart from
+// the application logs. Every record has the same fields so they can be
+// queried uniformly.
+func AccessLog(log *logger.Logger, config AccessLogConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		latency := time.Since(start)
+
+		// Errors are never sampled
+		status := c.Writer.Status()
+		sampleRate := 1.0
+		if status < http.StatusBadRequest {
+			sampleRate = config.SampleRate
+			if rand.Float64() >= sampleRate {
+				return
+			}
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		args := []any{
+			"request_id", response.RequestID(c).String(),
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"route", route,
+			"status", status,
+			"latency_ms", float64(latency.Microseconds()) / 1000,
+			"bytes_in", max(c.Request.ContentLength, 0),
+			"bytes_out", max(c.Writer.Size(), 0),
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
+			"user_email", c.GetString("email"),
+			"cache_hit", c.GetBool(cacheHitKey) || status == http.StatusNotModified,
+			"sample_rate", sampleRate,
+		}
+
+		switch {
+		case status >= http.StatusInternalServerError:
+			log.Error("Request processed", args...)
+		case status >= http.StatusBadRequest:
+			log.Warn("Request processed", args...)
+		default:
+			log.Info("Request processed", args...)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// BodyLimitConfig configures the maximum request body size
+type BodyLimitConfig struct {
+	// Default applies to every request body, e.g. JSON payloads
+	Default int64
+
+	// Multipart applies to multipart/form-data uploads, falling back to Default
+	Multipart int64
+}
+
+// BodyLimit rejects request bodies larger than the configured limit with a
+// 413 response. Requests announcing a larger Content-Length are rejected
+// before the handler runs; other bodies stop being readable at the limit.
+// Groups maps route group path prefixes to their own limits; the longest
+// matching prefix wins over the global limit.
+func BodyLimit(global BodyLimitConfig, groups map[string]BodyLimitConfig) gin.HandlerFunc {
+	groupLimits := newPrefixMatcher(groups)
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		config, ok := groupLimits.match(c.Request.URL.Path)
+		if !ok {
+			config = global
+		}
+
+		limit := config.Default
+		if config.Multipart > 0 && strings.HasPrefix(c.ContentType(), "multipart/") {
+			limit = config.Multipart
+		}
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			response.Error(c, errors.New(
+				errors.ErrPayloadTooLarge,
+				"Request body too large",
+				nil,
+				errors.WithContext("limit_bytes", limit),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
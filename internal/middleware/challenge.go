@@ -0,0 +1,288 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+const (
+	// ChallengeHeader carries the challenge issued by the server
+	ChallengeHeader = "X-Challenge"
+
+	// ChallengeSolutionHeader carries the nonce solving the challenge
+	ChallengeSolutionHeader = "X-Challenge-Solution"
+
+	// maxHoneypotBodySize bounds the body read when checking the honeypot field
+	maxHoneypotBodySize = 1 << 20
+)
+
+// Challenge is a signed proof-of-work challenge issued to clients
+type Challenge struct {
+	// Challenge is the opaque signed token to echo back in the X-Challenge header
+	Challenge string `json:"challenge" example:"1718000000.9f86d081884c7d65.5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"`
+
+	// Difficulty is the number of leading zero bits required in
+	// sha256(challenge + ":" + solution)
+	Difficulty int `json:"difficulty" example:"18"`
+
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// MinSolveTime is the minimum number of seconds before a solution is accepted
+	MinSolveTime int `json:"min_solve_time" example:"2"`
+}
+
+// ChallengeConfig configures a ChallengeGuard
+type ChallengeConfig struct {
+	Enabled bool
+
+	// Secret signs issued challenges; a random secret is generated when empty
+	Secret string
+
+	// Difficulty is the number of leading zero bits a solution must produce
+	Difficulty int
+
+	// TTL is how long an issued challenge remains valid
+	TTL time.Duration
+
+	// MinSolveTime rejects challenges answered faster than a human could
+	MinSolveTime time.Duration
+
+	// HoneypotField is a JSON body field that humans leave empty
+	HoneypotField string
+}
+
+// ChallengeGuard protects unauthenticated write endpoints with a signed
+// timestamp, a proof-of-work puzzle and a honeypot field
+type ChallengeGuard struct {
+	config ChallengeConfig
+	secret []byte
+	logger *logger.Logger
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+// NewChallengeGuard creates a new challenge guard
+func NewChallengeGuard(config ChallengeConfig, logger *logger.Logger) (*ChallengeGuard, error) {
+	if config.Difficulty < 0 || config.Difficulty > 32 {
+		return nil, fmt.Errorf("challenge difficulty must be between 0 and 32, got %d", config.Difficulty)
+	}
+	if config.TTL <= 0 {
+		config.TTL = 5 * time.Minute
+	}
+
+	secret := []byte(config.Secret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate challenge secret: %w", err)
+		}
+	}
+
+	return &ChallengeGuard{
+		config: config,
+		secret: secret,
+		logger: logger,
+		used:   make(map[string]time.Time),
+	}, nil
+}
+
+// IssueChallenge returns a new proof-of-work challenge
+// @Summary Issue a proof-of-work challenge
+// @Description Issue a signed challenge that must be solved before calling protected public write endpoints. Find a solution whose sha256(challenge + ":" + solution) has the required number of leading zero bits, then send the challenge and solution in the X-Challenge and X-Challenge-Solution headers.
+// @Tags System
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=Challenge} "Challenge issued successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /challenge [get]
+func (g *ChallengeGuard) IssueChallenge(c *gin.Context) {
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		response.Error(c, errors.Wrap(err, errors.ErrInternal, "Failed to issue challenge"))
+		return
+	}
+
+	now := time.Now().UTC()
+	payload := strconv.FormatInt(now.Unix(), 10) + "." + hex.EncodeToString(nonce)
+
+	response.SuccessOK(c, Challenge{
+		Challenge:    payload + "." + g.sign(payload),
+		Difficulty:   g.config.Difficulty,
+		IssuedAt:     now,
+		ExpiresAt:    now.Add(g.config.TTL),
+		MinSolveTime: int(g.config.MinSolveTime.Seconds()),
+	}, "Challenge issued successfully")
+}
+
+// RequireChallenge rejects requests without a valid, solved and unused
+// challenge or with a filled honeypot field
+func (g *ChallengeGuard) RequireChallenge() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !g.config.Enabled {
+			c.Next()
+			return
+		}
+
+		if g.honeypotFilled(c) {
+			g.reject(c, "Submission rejected", errors.WithContext("reason", "honeypot"))
+			return
+		}
+
+		challenge := c.GetHeader(ChallengeHeader)
+		solution := c.GetHeader(ChallengeSolutionHeader)
+		if challenge == "" || solution == "" {
+			g.reject(c, "Missing challenge",
+				errors.WithContext("headers", ChallengeHeader+", "+ChallengeSolutionHeader))
+			return
+		}
+
+		issuedAt, err := g.verify(challenge)
+		if err != nil {
+			g.reject(c, "Invalid challenge", errors.WithContext("error", err.Error()))
+			return
+		}
+
+		now := time.Now()
+		if now.After(issuedAt.Add(g.config.TTL)) {
+			g.reject(c, "Challenge expired")
+			return
+		}
+		if now.Before(issuedAt.Add(g.config.MinSolveTime)) {
+			g.reject(c, "Challenge answered too quickly")
+			return
+		}
+
+		if !solves(challenge, solution, g.config.Difficulty) {
+			g.reject(c, "Invalid challenge solution")
+			return
+		}
+
+		if !g.consume(challenge, issuedAt.Add(g.config.TTL)) {
+			g.reject(c, "Challenge already used")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// verify checks the challenge signature and returns its issue time
+func (g *ChallengeGuard) verify(challenge string) (time.Time, error) {
+	i := strings.LastIndex(challenge, ".")
+	if i < 0 {
+		return time.Time{}, fmt.Errorf("malformed challenge")
+	}
+
+	payload, signature := challenge[:i], challenge[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(g.sign(payload))) {
+		return time.Time{}, fmt.Errorf("signature mismatch")
+	}
+
+	timestamp, _, _ := strings.Cut(payload, ".")
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed timestamp")
+	}
+
+	return time.Unix(unix, 0), nil
+}
+
+// sign returns the hex encoded HMAC of payload
+func (g *ChallengeGuard) sign(payload string) string {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// consume marks a challenge as used, returning false if it already was
+func (g *ChallengeGuard) consume(challenge string, expiresAt time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	for key, expiry := range g.used {
+		if now.After(expiry) {
+			delete(g.used, key)
+		}
+	}
+
+	if _, ok := g.used[challenge]; ok {
+		return false
+	}
+	g.used[challenge] = expiresAt
+	return true
+}
+
+// honeypotFilled reports whether the JSON body has a non-empty honeypot
+// field. The body is restored so handlers can still bind it.
+func (g *ChallengeGuard) honeypotFilled(c *gin.Context) bool {
+	if g.config.HoneypotField == "" || c.Request.Body == nil ||
+		!strings.HasPrefix(c.ContentType(), "application/json") {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxHoneypotBodySize))
+	if err != nil {
+		return false
+	}
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return false
+	}
+
+	value, ok := fields[g.config.HoneypotField]
+	if !ok || value == nil {
+		return false
+	}
+	if s, isString := value.(string); isString {
+		return strings.TrimSpace(s) != ""
+	}
+	return true
+}
+
+// reject aborts the request with a standardized error response
+func (g *ChallengeGuard) reject(c *gin.Context, message string, opts ...func(*errors.CustomError)) {
+	g.logger.Warn("Challenge rejected", "message", message, "path", c.Request.URL.Path, "ip", c.ClientIP())
+	response.Error(c, errors.New(errors.ErrForbidden, message, nil, opts...))
+	c.Abort()
+}
+
+// solves reports whether sha256(challenge + ":" + solution) has at least
+// difficulty leading zero bits
+func solves(challenge, solution string, difficulty int) bool {
+	sum := sha256.Sum256([]byte(challenge + ":" + solution))
+
+	zeros := 0
+	for _, b := range sum {
+		if b == 0 {
+			zeros += 8
+			if zeros >= difficulty {
+				return true
+			}
+			continue
+		}
+		zeros += bits.LeadingZeros8(b)
+		break
+	}
+	return zeros >= difficulty
+}
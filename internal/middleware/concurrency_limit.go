@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// ConcurrencyLimiter caps how many requests of an endpoint class run at
+// once, so expensive work such as AI generation or rendering cannot
+// exhaust memory or CPU. Requests over the limit wait in a bounded queue;
+// once it is full, or after waiting too long, they get 429.
+type ConcurrencyLimiter struct {
+	class   string
+	slots   chan struct{}
+	queue   chan struct{}
+	maxWait time.Duration
+}
+
+// NewConcurrencyLimiter creates a limiter running at most limit requests
+// of class at once, with up to queueSize more waiting at most maxWait each
+func NewConcurrencyLimiter(class string, limit, queueSize int, maxWait time.Duration) *ConcurrencyLimiter {
+	if limit <= 0 {
+		limit = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	if maxWait <= 0 {
+		maxWait = 10 * time.Second
+	}
+
+	return &ConcurrencyLimiter{
+		class:   class,
+		slots:   make(chan struct{}, limit),
+		queue:   make(chan struct{}, queueSize),
+		maxWait: maxWait,
+	}
+}
+
+// Limit runs the request once a slot is free, rejecting it with 429 Too
+// Many Requests and a Retry-After header when none frees up in time
+func (l *ConcurrencyLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !l.acquire(c) {
+			c.Header("Retry-After", strconv.Itoa(max(1, int(l.maxWait.Seconds()))))
+			response.Error(c, errors.New(
+				errors.ErrTooManyRequests,
+				"Too many requests in progress, try again later",
+				nil,
+				errors.WithContext("class", l.class),
+				errors.WithContext("retry_after", l.maxWait.String()),
+			))
+			c.Abort()
+			return
+		}
+		defer l.release()
+
+		c.Next()
+	}
+}
+
+// InFlight returns the number of requests running
+func (l *ConcurrencyLimiter) InFlight() int {
+	return len(l.slots)
+}
+
+// Waiting returns the number of requests queued for a slot
+func (l *ConcurrencyLimiter) Waiting() int {
+	return len(l.queue)
+}
+
+// acquire takes a slot, waiting in the queue when all are busy. It gives
+// up when the queue is full, maxWait passes or the client goes away.
+func (l *ConcurrencyLimiter) acquire(c *gin.Context) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	select {
+	case l.queue <- struct{}{}:
+	default:
+		return false
+	}
+	defer func() { <-l.queue }()
+
+	timer := time.NewTimer(l.maxWait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-c.Request.Context().Done():
+		return false
+	}
+}
+
+func (l *ConcurrencyLimiter) release() {
+	<-l.slots
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// CORSPolicy serves CORS headers from a configuration whose allowed
+// origins can be replaced while the server runs. Requests already being
+// handled keep the policy they started with.
+type CORSPolicy struct {
+	mu        sync.RWMutex
+	config    cors.Config
+	handler   gin.HandlerFunc
+	updatedAt *time.Time
+}
+
+// NewCORSPolicy creates a policy from config, validating its origins
+func NewCORSPolicy(config cors.Config) (*CORSPolicy, error) {
+	p := &CORSPolicy{config: config}
+	if err := p.SetAllowedOrigins(config.AllowOrigins); err != nil {
+		return nil, err
+	}
+	p.updatedAt = nil
+	return p, nil
+}
+
+// Handler returns the middleware applying the current policy
+func (p *CORSPolicy) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p.mu.RLock()
+		handler := p.handler
+		p.mu.RUnlock()
+
+		handler(c)
+	}
+}
+
+// AllowedOrigins returns the origins currently allowed
+func (p *CORSPolicy) AllowedOrigins() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]string(nil), p.config.AllowOrigins...)
+}
+
+// UpdatedAt returns when the origins were last replaced at runtime, nil if
+// they are still the configured ones
+func (p *CORSPolicy) UpdatedAt() *time.Time {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.updatedAt
+}
+
+// SetAllowedOrigins validates origins and replaces the allowed origins,
+// taking effect on the next request. The policy is left unchanged when any
+// origin is invalid.
+func (p *CORSPolicy) SetAllowedOrigins(origins []string) error {
+	normalized, err := NormalizeOrigins(origins)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	config := p.config
+	config.AllowOrigins = normalized
+	if err := config.Validate(); err != nil {
+		return errors.New(errors.ErrValidation, "Invalid CORS configuration", err)
+	}
+
+	now := time.Now().UTC()
+	p.config = config
+	p.handler = cors.New(config)
+	p.updatedAt = &now
+	return nil
+}
+
+// NormalizeOrigins checks that each origin is a scheme and host, such as
+// https://example.com or http://localhost:3000, or the lone wildcard "*".
+// Origins are lowercased and duplicates dropped; every invalid origin is
+// reported in the error context, keyed by origin.
+func NormalizeOrigins(origins []string) ([]string, error) {
+	if len(origins) == 0 {
+		return nil, errors.New(errors.ErrValidation, "At least one allowed origin is required", fmt.Errorf("no origins given"))
+	}
+
+	problems := map[string]string{}
+	seen := map[string]bool{}
+	normalized := make([]string, 0, len(origins))
+
+	for _, origin := range origins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+		if origin == "*" {
+			if len(origins) > 1 {
+				problems[origin] = "wildcard cannot be combined with other origins"
+			}
+		} else if err := validateOrigin(origin); err != nil {
+			problems[origin] = err.Error()
+			continue
+		}
+
+		if !seen[origin] {
+			seen[origin] = true
+			normalized = append(normalized, origin)
+		}
+	}
+
+	if len(problems) > 0 {
+		details := make([]string, 0, len(problems))
+		for origin, problem := range problems {
+			details = append(details, fmt.Sprintf("%q %s", origin, problem))
+		}
+		sort.Strings(details)
+
+		return nil, errors.New(errors.ErrValidation, fmt.Sprintf("%d invalid allowed origins", len(problems)),
+			fmt.Errorf("%s", strings.Join(details, ", ")),
+			errors.WithContext("invalid_origins", problems),
+		)
+	}
+	return normalized, nil
+}
+
+// validateOrigin checks the format of a single origin
+func validateOrigin(origin string) error {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("not a valid URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if u.Hostname() == "" || strings.Contains(u.Host, "*") {
+		return fmt.Errorf("host is required and cannot contain wildcards")
+	}
+	if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("origin must not have credentials, a path, a query or a fragment")
+	}
+	if u.Path == "/" {
+		return fmt.Errorf("origin must not end with a slash")
+	}
+	return nil
+}
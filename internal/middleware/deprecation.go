@@ -0,0 +1,307 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// deprecationDateLayout is the layout of the dates of a deprecation entry
+const deprecationDateLayout = "2006-01-02"
+
+// deprecationTrafficDays is how many days of traffic are kept per endpoint
+const deprecationTrafficDays = 30
+
+// Deprecation describes the retirement of the routes under a prefix
+type Deprecation struct {
+	// Method restricts the deprecation to one HTTP method, empty for all
+	Method string
+
+	// Prefix is matched against the route template, e.g. /api/v1/projects/:id
+	Prefix string
+
+	// DeprecatedAt is announced in the Deprecation header
+	DeprecatedAt time.Time
+
+	// SunsetAt is announced in the Sunset header, zero when no removal
+	// date is planned
+	SunsetAt time.Time
+
+	// Link documents the deprecation or its replacement
+	Link string
+}
+
+// DeprecationConfig configures the deprecated routes
+type DeprecationConfig struct {
+	// Entries are "[METHOD ]PREFIX|DEPRECATED_AT[|SUNSET_AT[|LINK]]" with
+	// dates as YYYY-MM-DD
+	Entries []string
+
+	// SunsetEnforced answers 410 Gone once the sunset date has passed
+	SunsetEnforced bool
+
+	// QuietDays without traffic mark an endpoint safe to remove
+	QuietDays int
+}
+
+// DeprecatedTraffic counts the requests of one deprecated endpoint
+type DeprecatedTraffic struct {
+	Hits          int64
+	LastSeenAt    *time.Time
+	LastUserAgent string
+	HitsByDay     map[string]int64
+}
+
+type endpointTraffic struct {
+	hits          int64
+	lastSeenAt    time.Time
+	lastUserAgent string
+	hitsByDay     map[string]int64
+}
+
+// Deprecations flags deprecated routes with Deprecation (RFC 9745) and
+// Sunset (RFC 8594) headers and counts the traffic they still receive.
+// Traffic is kept in memory and starts over when the server restarts.
+type Deprecations struct {
+	mu             sync.Mutex
+	entries        []Deprecation
+	sunsetEnforced bool
+	quietDays      int
+	trackingSince  time.Time
+	traffic        map[string]*endpointTraffic
+	logger         *logger.Logger
+	now            func() time.Time
+}
+
+// NewDeprecations creates the deprecation policy, rejecting malformed
+// entries
+func NewDeprecations(config DeprecationConfig, logger *logger.Logger) (*Deprecations, error) {
+	d := &Deprecations{
+		sunsetEnforced: config.SunsetEnforced,
+		quietDays:      config.QuietDays,
+		traffic:        map[string]*endpointTraffic{},
+		logger:         logger,
+		now:            time.Now,
+	}
+	d.trackingSince = d.now().UTC()
+
+	var problems []string
+	for _, raw := range config.Entries {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		entry, err := parseDeprecation(raw)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		d.Deprecate(entry)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("route deprecations: %s", strings.Join(problems, ", "))
+	}
+	return d, nil
+}
+
+// parseDeprecation parses a "[METHOD ]PREFIX|DEPRECATED_AT[|SUNSET_AT[|LINK]]"
+// entry
+func parseDeprecation(raw string) (Deprecation, error) {
+	fields := strings.Split(raw, "|")
+	if len(fields) < 2 || len(fields) > 4 {
+		return Deprecation{}, fmt.Errorf("invalid deprecation %q", raw)
+	}
+
+	var entry Deprecation
+	route := strings.Fields(fields[0])
+	switch len(route) {
+	case 1:
+		entry.Prefix = route[0]
+	case 2:
+		entry.Method = strings.ToUpper(route[0])
+		entry.Prefix = route[1]
+	default:
+		return Deprecation{}, fmt.Errorf("invalid deprecated route %q", fields[0])
+	}
+	if !strings.HasPrefix(entry.Prefix, "/") {
+		return Deprecation{}, fmt.Errorf("invalid deprecated route %q", fields[0])
+	}
+
+	deprecatedAt, err := time.Parse(deprecationDateLayout, strings.TrimSpace(fields[1]))
+	if err != nil {
+		return Deprecation{}, fmt.Errorf("invalid deprecation date %q", fields[1])
+	}
+	entry.DeprecatedAt = deprecatedAt
+
+	if len(fields) > 2 && strings.TrimSpace(fields[2]) != "" {
+		sunsetAt, err := time.Parse(deprecationDateLayout, strings.TrimSpace(fields[2]))
+		if err != nil {
+			return Deprecation{}, fmt.Errorf("invalid sunset date %q", fields[2])
+		}
+		if sunsetAt.Before(deprecatedAt) {
+			return Deprecation{}, fmt.Errorf("sunset of %q before its deprecation", fields[0])
+		}
+		entry.SunsetAt = sunsetAt
+	}
+
+	if len(fields) > 3 {
+		entry.Link = strings.TrimSpace(fields[3])
+	}
+
+	return entry, nil
+}
+
+// Deprecate flags the routes under a prefix, for deprecations declared in
+// code next to their routes
+func (d *Deprecations) Deprecate(entry Deprecation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry.Prefix = strings.TrimSuffix(entry.Prefix, "/")
+	d.entries = append(d.entries, entry)
+
+	longestPrefixFirst(d.entries, func(entry Deprecation) string { return entry.Prefix })
+}
+
+// Middleware adds the deprecation headers to deprecated routes and counts
+// their traffic. It must be installed on the engine before routes are
+// registered.
+func (d *Deprecations) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+
+		entry, ok := d.Match(c.Request.Method, route)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		now := d.now().UTC()
+		d.record(c.Request.Method, route, c.Request.UserAgent(), now)
+
+		c.Header("Deprecation", fmt.Sprintf("@%d", entry.DeprecatedAt.Unix()))
+		if !entry.SunsetAt.IsZero() {
+			c.Header("Sunset", entry.SunsetAt.UTC().Format(http.TimeFormat))
+		}
+		if entry.Link != "" {
+			c.Header("Link", fmt.Sprintf(`<%s>; rel="deprecation"`, entry.Link))
+		}
+
+		if d.sunsetEnforced && !entry.SunsetAt.IsZero() && !now.Before(entry.SunsetAt) {
+			d.logger.Warn("Rejected request to sunset route",
+				"method", c.Request.Method,
+				"route", route,
+				"sunset_at", entry.SunsetAt.Format(deprecationDateLayout),
+			)
+			response.Error(c, errors.New(
+				errors.ErrGone,
+				"Endpoint has been removed",
+				nil,
+				errors.WithContext("sunset_at", entry.SunsetAt.Format(deprecationDateLayout)),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Match returns the deprecation of a route template, if any
+func (d *Deprecations) Match(method, route string) (Deprecation, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, entry := range d.entries {
+		if entry.Method != "" && entry.Method != method {
+			continue
+		}
+		if route == entry.Prefix || strings.HasPrefix(route, entry.Prefix+"/") {
+			return entry, true
+		}
+	}
+	return Deprecation{}, false
+}
+
+// Traffic returns the traffic of a deprecated endpoint
+func (d *Deprecations) Traffic(method, route string) DeprecatedTraffic {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	t, ok := d.traffic[method+" "+route]
+	if !ok {
+		return DeprecatedTraffic{HitsByDay: map[string]int64{}}
+	}
+
+	lastSeenAt := t.lastSeenAt
+	hitsByDay := make(map[string]int64, len(t.hitsByDay))
+	for day, hits := range t.hitsByDay {
+		hitsByDay[day] = hits
+	}
+	return DeprecatedTraffic{
+		Hits:          t.hits,
+		LastSeenAt:    &lastSeenAt,
+		LastUserAgent: t.lastUserAgent,
+		HitsByDay:     hitsByDay,
+	}
+}
+
+// TrackingSince returns when traffic started being counted
+func (d *Deprecations) TrackingSince() time.Time {
+	return d.trackingSince
+}
+
+// SunsetEnforced reports whether routes past their sunset answer 410
+func (d *Deprecations) SunsetEnforced() bool {
+	return d.sunsetEnforced
+}
+
+// SafeToRemove reports whether a deprecated endpoint went without traffic
+// for the quiet period, counting only time during which traffic was
+// tracked
+func (d *Deprecations) SafeToRemove(traffic DeprecatedTraffic) bool {
+	now := d.now().UTC()
+	quiet := time.Duration(d.quietDays) * 24 * time.Hour
+	if now.Sub(d.trackingSince) < quiet {
+		return false
+	}
+	return traffic.LastSeenAt == nil || now.Sub(*traffic.LastSeenAt) >= quiet
+}
+
+func (d *Deprecations) record(method, route, userAgent string, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := method + " " + route
+	t, ok := d.traffic[key]
+	if !ok {
+		t = &endpointTraffic{hitsByDay: map[string]int64{}}
+		d.traffic[key] = t
+	}
+
+	t.hits++
+	t.lastSeenAt = now
+	t.lastUserAgent = userAgent
+	t.hitsByDay[now.Format(deprecationDateLayout)]++
+
+	// Forget days past the kept window
+	oldest := now.AddDate(0, 0, -deprecationTrafficDays+1).Format(deprecationDateLayout)
+	for day := range t.hitsByDay {
+		if day < oldest {
+			delete(t.hitsByDay, day)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+const (
+	jwksMinRetryDelay = time.Second
+	jwksMaxRetryDelay = time.Minute
+)
+
+// JWKSLoader fetches the JSON Web Key Set that admin tokens are verified
+// with in the background, retrying with backoff until it succeeds, so that
+// an unreachable identity provider does not prevent startup
+type JWKSLoader struct {
+	url     string
+	options keyfunc.Options
+	logger  *logger.Logger
+
+	mu       sync.RWMutex
+	jwks     *keyfunc.JWKS
+	attempts int
+	lastErr  error
+	loadedAt *time.Time
+}
+
+// NewJWKSLoader creates a loader for the key set at url
+func NewJWKSLoader(url string, options keyfunc.Options, logger *logger.Logger) *JWKSLoader {
+	if options.RefreshTimeout <= 0 {
+		options.RefreshTimeout = 10 * time.Second
+	}
+	return &JWKSLoader{
+		url:     url,
+		options: options,
+		logger:  logger,
+	}
+}
+
+// Start fetches the key set in the background. Retries and the periodic
+// refresh of a loaded key set stop when ctx is done.
+func (l *JWKSLoader) Start(ctx context.Context) {
+	l.options.Ctx = ctx
+	go l.run(ctx)
+}
+
+// Ready reports whether the key set is loaded
+func (l *JWKSLoader) Ready() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.jwks != nil
+}
+
+// LastError returns why the last fetch failed, while the key set is not
+// loaded
+func (l *JWKSLoader) LastError() error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lastErr
+}
+
+// Attempts returns the number of fetches made so far
+func (l *JWKSLoader) Attempts() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.attempts
+}
+
+// LoadedAt returns when the key set was loaded, or nil
+func (l *JWKSLoader) LoadedAt() *time.Time {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.loadedAt
+}
+
+// Keyfunc looks up the key a token is signed with
+func (l *JWKSLoader) Keyfunc(token *jwt.Token) (interface{}, error) {
+	l.mu.RLock()
+	jwks := l.jwks
+	l.mu.RUnlock()
+
+	if jwks == nil {
+		return nil, fmt.Errorf("JWKS keys are not loaded yet")
+	}
+	return jwks.Keyfunc(token)
+}
+
+func (l *JWKSLoader) run(ctx context.Context) {
+	delay := jwksMinRetryDelay
+	for {
+		jwks, err := keyfunc.Get(l.url, l.options)
+
+		l.mu.Lock()
+		l.attempts++
+		attempt := l.attempts
+		if err == nil {
+			now := time.Now().UTC()
+			l.jwks = jwks
+			l.lastErr = nil
+			l.loadedAt = &now
+		} else {
+			l.lastErr = err
+		}
+		l.mu.Unlock()
+
+		if err == nil {
+			l.logger.Info("JWKS keys initialized successfully", "attempts", attempt)
+			return
+		}
+
+		l.logger.Warn("Failed to retrieve JWKS keys, retrying", "error", err, "attempt", attempt, "retry_in", delay.String())
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > jwksMaxRetryDelay {
+			delay = jwksMaxRetryDelay
+		}
+	}
+}
+
+// Check fetches the key set once, without loading it, to verify that it
+// is reachable and valid
+func (l *JWKSLoader) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.url, nil)
+	if err != nil {
+		return err
+	}
+
+	client := l.options.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if _, err := keyfunc.NewJSON(body); err != nil {
+		return fmt.Errorf("invalid JWKS: %w", err)
+	}
+	return nil
+}
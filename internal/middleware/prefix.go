@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"sort"
+	"strings"
+)
+
+// prefixMatcher finds the value configured for the longest path prefix
+// matching a request path
+type prefixMatcher[V any] struct {
+	prefixes []string
+	values   map[string]V
+}
+
+// newPrefixMatcher indexes values by path prefix
+func newPrefixMatcher[V any](values map[string]V) *prefixMatcher[V] {
+	prefixes := make([]string, 0, len(values))
+	for prefix := range values {
+		prefixes = append(prefixes, prefix)
+	}
+	longestPrefixFirst(prefixes, func(prefix string) string { return prefix })
+
+	return &prefixMatcher[V]{
+		prefixes: prefixes,
+		values:   values,
+	}
+}
+
+// match returns the value of the longest prefix of path, if any
+func (m *prefixMatcher[V]) match(path string) (V, bool) {
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return m.values[prefix], true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// longestPrefixFirst orders items by the length of their prefix, longest
+// first, so scanning them in order matches the most specific one
+func longestPrefixFirst[T any](items []T, prefix func(T) string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return len(prefix(items[i])) > len(prefix(items[j]))
+	})
+}
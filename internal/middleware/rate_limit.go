@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// RateLimiter limits the number of requests a client IP can make per window
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	clients   map[string]*rateWindow
+	lastSweep time.Time
+}
+
+// rateWindow counts the requests of a client in the current window
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// NewRateLimiter creates a limiter allowing limit requests per window
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	if limit <= 0 {
+		limit = 10
+	}
+	if window <= 0 {
+		window = time.Minute
+	}
+
+	return &RateLimiter{
+		limit:   limit,
+		window:  window,
+		clients: make(map[string]*rateWindow),
+	}
+}
+
+// Limit rejects requests over the limit with 429 Too Many Requests
+func (l *RateLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, retryAfter := l.allow(c.ClientIP(), time.Now())
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			response.Error(c, errors.New(
+				errors.ErrTooManyRequests,
+				"Too many requests",
+				nil,
+				errors.WithContext("retry_after", retryAfter.Round(time.Second).String()),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// allow counts a request of key and reports whether it is within the limit,
+// or how long until the window resets
+func (l *RateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Drop expired windows so the map does not grow with every client seen
+	if now.Sub(l.lastSweep) >= l.window {
+		for k, w := range l.clients {
+			if now.Sub(w.start) >= l.window {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.clients[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		l.clients[key] = &rateWindow{start: now, count: 1}
+		return true, 0
+	}
+
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+)
+
+// RequestIDHeader carries the ID of a request, so it can be correlated
+// across a proxy, the access log and the response envelope
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID assigns an ID to every request, keeping the one sent by a
+// proxy when it is a UUID, and echoes it in the response headers
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.GetHeader(RequestIDHeader))
+		if err != nil {
+			id = uuid.New()
+		}
+
+		c.Set(response.RequestIDKey, id)
+		c.Header(RequestIDHeader, id.String())
+		c.Next()
+	}
+}
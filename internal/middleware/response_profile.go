@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+)
+
+// ResponseProfile assigns the serialization profile of every request from
+// its path, so responses written by global middleware match the envelope
+// of the API version they answer. Profiles maps route group path prefixes
+// to profiles; the longest matching prefix wins and other paths keep
+// response.ProfileV1. The camelCase query parameters of v2 requests are
+// renamed to snake_case so handlers read them like v1 ones.
+func ResponseProfile(profiles map[string]response.Profile) gin.HandlerFunc {
+	groupProfiles := newPrefixMatcher(profiles)
+
+	return func(c *gin.Context) {
+		if profile, ok := groupProfiles.match(c.Request.URL.Path); ok {
+			c.Set(response.ProfileKey, profile)
+			if profile == response.ProfileV2 {
+				response.SnakeQuery(c.Request)
+			}
+		}
+		c.Next()
+	}
+}
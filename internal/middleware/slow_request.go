@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+)
+
+// SlowRequests reports the requests whose handlers take longer than the
+// threshold of tracker, grouped by route
+func SlowRequests(tracker *slowcall.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		elapsed := time.Since(start)
+		if elapsed < tracker.Threshold() {
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		details := map[string]string{
+			"path":   c.Request.URL.Path,
+			"status": strconv.Itoa(c.Writer.Status()),
+		}
+		if c.Request.URL.RawQuery != "" {
+			details["query"] = c.Request.URL.RawQuery
+		}
+		tracker.Observe(c.Request.Method+" "+route, elapsed, details)
+	}
+}
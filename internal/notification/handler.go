@@ -0,0 +1,271 @@
+package notification
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type NotificationHandler struct {
+	base.BaseHandler
+	notificationService NotificationService
+}
+
+func NewNotificationHandler(notificationService NotificationService, logger *logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		BaseHandler:         *base.NewBaseHandler(logger),
+		notificationService: notificationService,
+	}
+}
+
+// CreateChannel creates a new notification channel
+// @Summary Create a notification channel
+// @Description Create an email, Telegram, Discord or Slack channel with the rules routing events to it
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param channel body ChannelCreate true "Notification Channel Details"
+// @Success 200 {object} response.APIResponse{data=NotificationChannel} "Notification channel created successfully"
+// @Failure 400 {object} response.APIResponse{data=ChannelCreate} "Bad Request"
+// @Router /notifications/channels [post]
+func (h *NotificationHandler) CreateChannel(c *gin.Context) {
+	var channelInput ChannelCreate
+
+	if err := h.ValidateRequest(c, &channelInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	channel, err := h.notificationService.CreateChannel(c.Request.Context(), &channelInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, channel.Masked(), "Notification channel created successfully")
+}
+
+// GetChannelByID retrieves a specific notification channel
+// @Summary Get a notification channel by ID
+// @Description Retrieve a notification channel with its secrets masked
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification Channel ID"
+// @Success 200 {object} response.APIResponse{data=NotificationChannel} "Notification channel retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Notification channel not found"
+// @Router /notifications/channels/{id} [get]
+func (h *NotificationHandler) GetChannelByID(c *gin.Context) {
+	channelID, err := h.ValidateUUID(c.Param("id"), "notification channel ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	channel, err := h.notificationService.GetChannelByID(c.Request.Context(), channelID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, channel.Masked(), "Notification channel retrieved successfully")
+}
+
+// UpdateChannel updates an existing notification channel
+// @Summary Update a notification channel
+// @Description Update a notification channel; masked secret values are kept unchanged
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification Channel ID"
+// @Param channel body ChannelUpdate true "Notification Channel Update Details"
+// @Success 200 {object} response.APIResponse{data=NotificationChannel} "Notification channel updated successfully"
+// @Failure 400 {object} response.APIResponse{data=ChannelUpdate} "Bad Request"
+// @Failure 404 {object} response.APIResponse "Notification channel not found"
+// @Router /notifications/channels/{id} [put]
+func (h *NotificationHandler) UpdateChannel(c *gin.Context) {
+	var channelInput ChannelUpdate
+
+	channelID, err := h.ValidateUUID(c.Param("id"), "notification channel ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.BindJSON(c, &channelInput); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	// Set the ID from path
+	channelInput.ID = channelID
+
+	channel, err := h.notificationService.UpdateChannel(c.Request.Context(), &channelInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, channel.Masked(), "Notification channel updated successfully")
+}
+
+// DeleteChannel deletes an existing notification channel
+// @Summary Delete a notification channel
+// @Description Delete a notification channel by its unique identifier
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification Channel ID"
+// @Success 200 {object} response.APIResponse "Notification channel deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Notification channel not found"
+// @Router /notifications/channels/{id} [delete]
+func (h *NotificationHandler) DeleteChannel(c *gin.Context) {
+	channelID, err := h.ValidateUUID(c.Param("id"), "notification channel ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.notificationService.DeleteChannel(c.Request.Context(), channelID.String()); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Notification channel deleted successfully")
+}
+
+// ListChannels retrieves a paginated list of notification channels
+// @Summary List notification channels
+// @Description Retrieve a paginated list of notification channels with optional type filtering
+// @Tags Notifications
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param type query string false "Filter by channel type"
+// @Success 200 {object} response.APIResponse{data=[]NotificationChannel} "Notification channels retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /notifications/channels [get]
+func (h *NotificationHandler) ListChannels(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// Optional type filter
+	channelType := c.Query("type")
+	if channelType != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "type",
+			Operator: base.OperatorEqual,
+			Value:    channelType,
+		})
+	}
+
+	channels, err := h.notificationService.ListChannels(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.notificationService.CountChannels(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	masked := make([]NotificationChannel, len(channels))
+	for i := range channels {
+		masked[i] = channels[i].Masked()
+	}
+
+	h.HandleSuccess(c, masked, "Notification channels retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// TestChannel sends a test notification to a channel
+// @Summary Test a notification channel
+// @Description Send a test notification to a channel and return the resulting log entry
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification Channel ID"
+// @Success 200 {object} response.APIResponse{data=NotificationLog} "Test notification processed"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Notification channel not found"
+// @Router /notifications/channels/{id}/test [post]
+func (h *NotificationHandler) TestChannel(c *gin.Context) {
+	channelID, err := h.ValidateUUID(c.Param("id"), "notification channel ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	log, err := h.notificationService.TestChannel(c.Request.Context(), channelID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, log, "Test notification processed")
+}
+
+// ListLogs retrieves a paginated list of notification deliveries
+// @Summary List notification logs
+// @Description Retrieve the notification delivery log with optional filtering
+// @Tags Notifications
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param status query string false "Filter by status (sent, failed)"
+// @Param event query string false "Filter by event"
+// @Param channel_id query string false "Filter by channel ID"
+// @Success 200 {object} response.APIResponse{data=[]NotificationLog} "Notification logs retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /notifications/logs [get]
+func (h *NotificationHandler) ListLogs(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// Optional filters
+	for _, field := range []string{"status", "event", "channel_id"} {
+		if value := c.Query(field); value != "" {
+			opts.Filters = append(opts.Filters, base.FilterOption{
+				Field:    field,
+				Operator: base.OperatorEqual,
+				Value:    value,
+			})
+		}
+	}
+
+	logs, err := h.notificationService.ListLogs(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.notificationService.CountLogs(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, logs, "Notification logs retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
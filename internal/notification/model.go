@@ -0,0 +1,163 @@
+package notification
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+)
+
+// ChannelType represents the service a channel delivers to
+// @Description Delivery service of a notification channel
+// @Name ChannelType
+type ChannelType string
+
+// LogStatus represents the outcome of a notification delivery
+// @Description Outcome of a notification delivery
+// @Name LogStatus
+type LogStatus string
+
+const (
+	ChannelEmail    ChannelType = "email"
+	ChannelTelegram ChannelType = "telegram"
+	ChannelDiscord  ChannelType = "discord"
+	ChannelSlack    ChannelType = "slack"
+
+	LogSent   LogStatus = "sent"
+	LogFailed LogStatus = "failed"
+)
+
+// Events that can be routed to notification channels
+const (
+	EventContactCreated   = "contact.created"
+	EventWebhookFailed    = "webhook.failed"
+	EventBackupCompleted  = "backup.completed"
+	EventNotificationTest = "notification.test"
+	EventProposalAccepted = "proposal.accepted"
+	EventPaymentReceived  = "payment.received"
+	EventTrafficAnomaly   = "traffic.anomaly"
+	EventSessionFlagged   = "session.flagged"
+)
+
+// secretConfigKeys lists channel config keys hidden from API responses
+var secretConfigKeys = map[string]bool{
+	"bot_token":   true,
+	"webhook_url": true,
+}
+
+// NotificationChannel represents a destination and the rules routing events to it
+// @Description Notification channel with its routing rules
+// @Name NotificationChannel
+type NotificationChannel struct {
+	ID       uuid.UUID         `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID        `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Name     string            `json:"name" db:"name" validate:"required" example:"Ops Telegram"`
+	Type     ChannelType       `json:"type" db:"type" validate:"required" example:"telegram"`
+	Config   map[string]string `json:"config" db:"config" swaggertype:"object,string"`
+
+	// Rules
+	Events   []string       `json:"events" db:"events" pg:"array" example:"contact.*,backup.completed"`
+	MinLevel notifier.Level `json:"min_level" db:"min_level" example:"info"`
+	IsActive bool           `json:"is_active" db:"is_active" example:"true"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// ChannelCreate represents the input for creating a notification channel
+// @Name ChannelCreate
+type ChannelCreate struct {
+	Name     string            `json:"name" validate:"required,max=100" example:"Ops Telegram"`
+	Type     ChannelType       `json:"type" validate:"required" example:"telegram"`
+	Config   map[string]string `json:"config" swaggertype:"object,string"`
+	Events   []string          `json:"events" example:"contact.*,backup.completed"`
+	MinLevel notifier.Level    `json:"min_level" example:"info"`
+	IsActive bool              `json:"is_active" example:"true"`
+}
+
+// ChannelUpdate represents the input for updating a notification channel
+// @Name ChannelUpdate
+type ChannelUpdate struct {
+	ID       uuid.UUID         `json:"id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string            `json:"name" example:"Ops Telegram"`
+	Config   map[string]string `json:"config" swaggertype:"object,string"`
+	Events   []string          `json:"events" example:"contact.*,backup.completed"`
+	MinLevel notifier.Level    `json:"min_level" example:"warning"`
+	IsActive bool              `json:"is_active" example:"true"`
+}
+
+// NotificationLog represents a single delivery attempt to a channel
+// @Description Notification delivery log entry
+// @Name NotificationLog
+type NotificationLog struct {
+	ID          uuid.UUID      `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID     `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	ChannelID   *uuid.UUID     `json:"channel_id,omitempty" db:"channel_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ChannelType ChannelType    `json:"channel_type" db:"channel_type" example:"telegram"`
+	Event       string         `json:"event" db:"event" example:"contact.created"`
+	Level       notifier.Level `json:"level" db:"level" example:"info"`
+	Title       string         `json:"title" db:"title" example:"New contact message"`
+	Status      LogStatus      `json:"status" db:"status" example:"sent"`
+	Error       string         `json:"error,omitempty" db:"error"`
+	CreatedAt   *time.Time     `json:"created_at,omitempty" db:"created_at"`
+}
+
+// ToChannel converts ChannelCreate to NotificationChannel
+func (cc *ChannelCreate) ToChannel() NotificationChannel {
+	now := time.Now().UTC()
+	return NotificationChannel{
+		ID:        uuid.New(),
+		Name:      cc.Name,
+		Type:      cc.Type,
+		Config:    cc.Config,
+		Events:    cc.Events,
+		MinLevel:  cc.MinLevel,
+		IsActive:  cc.IsActive,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+}
+
+// Masked returns a copy of the channel with secret config values hidden
+func (c NotificationChannel) Masked() NotificationChannel {
+	config := make(map[string]string, len(c.Config))
+	for key, value := range c.Config {
+		if secretConfigKeys[key] && value != "" {
+			value = "********"
+		}
+		config[key] = value
+	}
+	c.Config = config
+	return c
+}
+
+// Matches reports whether the channel's rules accept the notification
+func (c NotificationChannel) Matches(n notifier.Notification) bool {
+	if !c.IsActive || levelRank(n.Level) < levelRank(c.MinLevel) {
+		return false
+	}
+
+	for _, pattern := range c.Events {
+		switch {
+		case pattern == "*" || pattern == n.Event:
+			return true
+		case strings.HasSuffix(pattern, ".*") && strings.HasPrefix(n.Event, strings.TrimSuffix(pattern, "*")):
+			return true
+		}
+	}
+
+	return false
+}
+
+// levelRank orders levels from least to most severe
+func levelRank(level notifier.Level) int {
+	switch level {
+	case notifier.LevelWarning:
+		return 1
+	case notifier.LevelError:
+		return 2
+	default:
+		return 0
+	}
+}
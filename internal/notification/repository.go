@@ -0,0 +1,262 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type ChannelRepository interface {
+	base.BaseRepository[NotificationChannel, NotificationChannel]
+}
+
+type channelRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewChannelRepository(supabaseClient *supabase.SupabaseClient) ChannelRepository {
+	return &channelRepository{
+		supabaseClient: supabaseClient,
+		table:          "notification_channel",
+	}
+}
+
+func (r *channelRepository) Create(ctx context.Context, channel *NotificationChannel) (*NotificationChannel, error) {
+	channel.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(channel, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create notification channel")
+	}
+	return channel, nil
+}
+
+func (r *channelRepository) Update(ctx context.Context, channel *NotificationChannel) (*NotificationChannel, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(channel, "minimal", "").
+		Eq("id", channel.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update notification channel")
+	}
+	return channel, nil
+}
+
+func (r *channelRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete notification channel")
+	}
+	return nil
+}
+
+func (r *channelRepository) List(ctx context.Context, opts base.ListOptions) ([]NotificationChannel, error) {
+	var channels []NotificationChannel
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("name.ilike.%%%s%%", opts.Search),
+			"",
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&channels)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list notification channels")
+	}
+
+	return channels, nil
+}
+
+func (r *channelRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count notification channels")
+	}
+
+	return int(count), nil
+}
+
+func (r *channelRepository) Exists(ctx context.Context, id string) (bool, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("id", id).
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check notification channel existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *channelRepository) FindByField(ctx context.Context, field string, value interface{}) ([]NotificationChannel, error) {
+	var channels []NotificationChannel
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&channels)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find notification channels by field")
+	}
+	return channels, nil
+}
+
+func (r *channelRepository) Search(ctx context.Context, opts base.ListOptions) ([]NotificationChannel, int, error) {
+	channels, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search notification channels")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count notification channels")
+	}
+
+	return channels, count, nil
+}
+
+type LogRepository interface {
+	Create(ctx context.Context, log *NotificationLog) (*NotificationLog, error)
+	List(ctx context.Context, opts base.ListOptions) ([]NotificationLog, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type logRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewLogRepository(supabaseClient *supabase.SupabaseClient) LogRepository {
+	return &logRepository{
+		supabaseClient: supabaseClient,
+		table:          "notification_log",
+	}
+}
+
+func (r *logRepository) Create(ctx context.Context, log *NotificationLog) (*NotificationLog, error) {
+	log.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(log, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create notification log")
+	}
+	return log, nil
+}
+
+func (r *logRepository) List(ctx context.Context, opts base.ListOptions) ([]NotificationLog, error) {
+	var logs []NotificationLog
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&logs)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list notification logs")
+	}
+
+	return logs, nil
+}
+
+func (r *logRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count notification logs")
+	}
+
+	return int(count), nil
+}
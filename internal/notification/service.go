@@ -0,0 +1,376 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/mail"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/mailer"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/notifier"
+)
+
+// sendTimeout bounds a single delivery to a channel
+const sendTimeout = 15 * time.Second
+
+// maskedValue is the placeholder returned for secret config values
+const maskedValue = "********"
+
+// Notifier pushes notifications to the channels whose rules match them
+type Notifier interface {
+	Notify(ctx context.Context, n notifier.Notification)
+}
+
+type NotificationService interface {
+	Notifier
+	CreateChannel(ctx context.Context, channelCreate *ChannelCreate) (*NotificationChannel, error)
+	GetChannelByID(ctx context.Context, id string) (*NotificationChannel, error)
+	UpdateChannel(ctx context.Context, channelUpdate *ChannelUpdate) (*NotificationChannel, error)
+	DeleteChannel(ctx context.Context, id string) error
+	ListChannels(ctx context.Context, opts base.ListOptions) ([]NotificationChannel, error)
+	CountChannels(ctx context.Context, filters []base.FilterOption) (int, error)
+	TestChannel(ctx context.Context, id string) (*NotificationLog, error)
+	ListLogs(ctx context.Context, opts base.ListOptions) ([]NotificationLog, error)
+	CountLogs(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type notificationService struct {
+	channelRepo ChannelRepository
+	logRepo     LogRepository
+	mailService mail.MailService
+	logger      *logger.Logger
+}
+
+func NewNotificationService(channelRepo ChannelRepository, logRepo LogRepository, mailService mail.MailService, logger *logger.Logger) NotificationService {
+	return &notificationService{
+		channelRepo: channelRepo,
+		logRepo:     logRepo,
+		mailService: mailService,
+		logger:      logger,
+	}
+}
+
+// Notify dispatches n in the background so callers never wait on chat services
+func (s *notificationService) Notify(ctx context.Context, n notifier.Notification) {
+	if n.Level == "" {
+		n.Level = notifier.LevelInfo
+	}
+
+	// Keep the tenant scope but detach from the request lifetime
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		channels, err := s.channelRepo.FindByField(ctx, "is_active", true)
+		if err != nil {
+			s.logger.Error("Failed to load notification channels", "event", n.Event, "error", err)
+			return
+		}
+
+		for i := range channels {
+			if channels[i].Matches(n) {
+				s.deliver(ctx, &channels[i], n)
+			}
+		}
+	}()
+}
+
+func (s *notificationService) CreateChannel(ctx context.Context, channelCreate *ChannelCreate) (*NotificationChannel, error) {
+	// Validate input
+	if err := validator.ValidateModel(channelCreate); err != nil {
+		return nil, err
+	}
+
+	channel := channelCreate.ToChannel()
+	applyChannelDefaults(&channel)
+
+	if err := s.validateChannel(&channel); err != nil {
+		return nil, err
+	}
+
+	createdChannel, err := s.channelRepo.Create(ctx, &channel)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create notification channel",
+			errors.WithContext("channel_name", channel.Name),
+		)
+	}
+
+	return createdChannel, nil
+}
+
+func (s *notificationService) GetChannelByID(ctx context.Context, id string) (*NotificationChannel, error) {
+	channels, err := s.channelRepo.FindByField(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(channels) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Notification channel not found",
+			nil,
+			errors.WithContext("channel_id", id),
+		)
+	}
+
+	return &channels[0], nil
+}
+
+func (s *notificationService) UpdateChannel(ctx context.Context, channelUpdate *ChannelUpdate) (*NotificationChannel, error) {
+	// Validate input
+	if err := validator.ValidateModel(channelUpdate); err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Invalid notification channel payload",
+			err,
+			errors.WithContext("payload", channelUpdate),
+		)
+	}
+
+	channel, err := s.GetChannelByID(ctx, channelUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	channel.IsActive = channelUpdate.IsActive
+	channel.UpdatedAt = &now
+
+	// Conditionally update fields
+	if channelUpdate.Name != "" {
+		channel.Name = channelUpdate.Name
+	}
+	if channelUpdate.Events != nil {
+		channel.Events = channelUpdate.Events
+	}
+	if channelUpdate.MinLevel != "" {
+		channel.MinLevel = channelUpdate.MinLevel
+	}
+	if channelUpdate.Config != nil {
+		config := make(map[string]string, len(channelUpdate.Config))
+		for key, value := range channelUpdate.Config {
+			// Masked secrets echo back unchanged from the API
+			if value == maskedValue {
+				value = channel.Config[key]
+			}
+			config[key] = value
+		}
+		channel.Config = config
+	}
+
+	applyChannelDefaults(channel)
+
+	if err := s.validateChannel(channel); err != nil {
+		return nil, err
+	}
+
+	updatedChannel, err := s.channelRepo.Update(ctx, channel)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update notification channel",
+			errors.WithContext("channel_id", channel.ID),
+		)
+	}
+
+	return updatedChannel, nil
+}
+
+func (s *notificationService) DeleteChannel(ctx context.Context, id string) error {
+	if _, err := s.GetChannelByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.channelRepo.Delete(ctx, id); err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to delete notification channel",
+			errors.WithContext("channel_id", id),
+		)
+	}
+
+	return nil
+}
+
+func (s *notificationService) ListChannels(ctx context.Context, opts base.ListOptions) ([]NotificationChannel, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.channelRepo.List(ctx, opts)
+}
+
+func (s *notificationService) CountChannels(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.channelRepo.Count(ctx, filters)
+}
+
+// TestChannel sends a test notification synchronously and returns its log entry
+func (s *notificationService) TestChannel(ctx context.Context, id string) (*NotificationLog, error) {
+	channel, err := s.GetChannelByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliver(ctx, channel, notifier.Notification{
+		Event: EventNotificationTest,
+		Level: notifier.LevelInfo,
+		Title: "Test notification",
+		Body:  fmt.Sprintf("Notifications for channel %q are working.", channel.Name),
+	}), nil
+}
+
+func (s *notificationService) ListLogs(ctx context.Context, opts base.ListOptions) ([]NotificationLog, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.logRepo.List(ctx, opts)
+}
+
+func (s *notificationService) CountLogs(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.logRepo.Count(ctx, filters)
+}
+
+// deliver sends n to a channel and records the attempt in the log
+func (s *notificationService) deliver(ctx context.Context, channel *NotificationChannel, n notifier.Notification) *NotificationLog {
+	sendErr := func() error {
+		sender, err := s.buildSender(channel)
+		if err != nil {
+			return err
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		defer cancel()
+
+		return sender.Send(sendCtx, n)
+	}()
+
+	now := time.Now().UTC()
+	channelID := channel.ID
+	entry := NotificationLog{
+		ID:          uuid.New(),
+		ChannelID:   &channelID,
+		ChannelType: channel.Type,
+		Event:       n.Event,
+		Level:       n.Level,
+		Title:       n.Title,
+		Status:      LogSent,
+		CreatedAt:   &now,
+	}
+	if sendErr != nil {
+		entry.Status = LogFailed
+		entry.Error = sendErr.Error()
+		s.logger.Warn("Failed to deliver notification",
+			"channel", channel.Name,
+			"event", n.Event,
+			"error", sendErr,
+		)
+	}
+
+	if _, err := s.logRepo.Create(ctx, &entry); err != nil {
+		s.logger.Error("Failed to record notification log", "channel", channel.Name, "error", err)
+	}
+
+	return &entry
+}
+
+// buildSender creates the sender matching the channel type
+func (s *notificationService) buildSender(channel *NotificationChannel) (notifier.Sender, error) {
+	switch channel.Type {
+	case ChannelEmail:
+		recipients := splitRecipients(channel.Config["to"])
+		if len(recipients) == 0 {
+			return nil, fmt.Errorf("email channel requires a \"to\" address")
+		}
+		return &emailSender{mailService: s.mailService, to: recipients}, nil
+	case ChannelTelegram:
+		return notifier.NewTelegramSender(channel.Config["bot_token"], channel.Config["chat_id"])
+	case ChannelDiscord:
+		return notifier.NewDiscordSender(channel.Config["webhook_url"])
+	case ChannelSlack:
+		return notifier.NewSlackSender(channel.Config["webhook_url"])
+	default:
+		return nil, fmt.Errorf("unsupported channel type %q", channel.Type)
+	}
+}
+
+// validateChannel checks the channel type, level and required config
+func (s *notificationService) validateChannel(channel *NotificationChannel) error {
+	switch channel.MinLevel {
+	case notifier.LevelInfo, notifier.LevelWarning, notifier.LevelError:
+	default:
+		return errors.New(
+			errors.ErrValidation,
+			"Minimum level must be info, warning or error",
+			nil,
+			errors.WithContext("min_level", channel.MinLevel),
+		)
+	}
+
+	if _, err := s.buildSender(channel); err != nil {
+		return errors.New(
+			errors.ErrValidation,
+			"Invalid notification channel config",
+			err,
+			errors.WithContext("type", channel.Type),
+		)
+	}
+
+	return nil
+}
+
+// applyChannelDefaults fills in the rules of a channel left unset
+func applyChannelDefaults(channel *NotificationChannel) {
+	if channel.Config == nil {
+		channel.Config = map[string]string{}
+	}
+	if len(channel.Events) == 0 {
+		channel.Events = []string{"*"}
+	}
+	if channel.MinLevel == "" {
+		channel.MinLevel = notifier.LevelInfo
+	}
+}
+
+// splitRecipients parses a comma separated list of addresses
+func splitRecipients(value string) []string {
+	var recipients []string
+	for _, address := range strings.Split(value, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			recipients = append(recipients, address)
+		}
+	}
+	return recipients
+}
+
+// emailSender adapts the mail queue to the notifier sender interface
+type emailSender struct {
+	mailService mail.MailService
+	to          []string
+}
+
+// Send queues the notification as an email
+func (s *emailSender) Send(ctx context.Context, n notifier.Notification) error {
+	_, err := s.mailService.Enqueue(ctx, mailer.TemplateNotification, s.to, "", mailer.NotificationData{
+		Title:  n.Title,
+		Body:   n.Body,
+		URL:    n.URL,
+		Fields: n.SortedFields(),
+	})
+	return err
+}
@@ -0,0 +1,165 @@
+package now
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type NowHandler struct {
+	base.BaseHandler
+	nowService NowService
+}
+
+func NewNowHandler(nowService NowService, logger *logger.Logger) *NowHandler {
+	return &NowHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		nowService:  nowService,
+	}
+}
+
+// GetCurrent retrieves the current /now entry
+// @Summary Get the now page
+// @Description Retrieve what I'm currently working on, reading and learning
+// @Tags Now
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=Entry} "Now entry retrieved successfully"
+// @Failure 404 {object} response.APIResponse "No now entry has been published yet"
+// @Router /now [get]
+func (h *NowHandler) GetCurrent(c *gin.Context) {
+	entry, err := h.nowService.GetCurrent(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entry, "Now entry retrieved successfully")
+}
+
+// ListEntries retrieves the history of /now entries
+// @Summary Get the now page history
+// @Description Retrieve a paginated list of current and previous now entries, newest first
+// @Tags Now
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]Entry} "Now history retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /now/history [get]
+func (h *NowHandler) ListEntries(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	entries, err := h.nowService.ListEntries(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.nowService.CountEntries(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entries, "Now history retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// Publish publishes a new /now entry
+// @Summary Publish a now entry
+// @Description Publish a new now entry written in Markdown. It becomes current and the previous entry moves to the history.
+// @Tags Now
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entry body EntryCreate true "Now Entry Details"
+// @Success 200 {object} response.APIResponse{data=Entry} "Now entry published successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/now [post]
+func (h *NowHandler) Publish(c *gin.Context) {
+	var entryInput EntryCreate
+	if err := h.ValidateRequest(c, &entryInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	entry, err := h.nowService.Publish(c.Request.Context(), &entryInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entry, "Now entry published successfully")
+}
+
+// UpdateEntry corrects a /now entry
+// @Summary Update a now entry
+// @Description Correct the current or a previous now entry without changing which one is current
+// @Tags Now
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Now Entry ID"
+// @Param entry body EntryUpdate true "Now Entry Update Details"
+// @Success 200 {object} response.APIResponse{data=Entry} "Now entry updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Now entry not found"
+// @Router /admin/now/{id} [put]
+func (h *NowHandler) UpdateEntry(c *gin.Context) {
+	entryID, err := h.ValidateUUID(c.Param("id"), "Now Entry ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var entryInput EntryUpdate
+	if err := h.ValidateRequest(c, &entryInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	entryInput.ID = entryID
+
+	entry, err := h.nowService.UpdateEntry(c.Request.Context(), &entryInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, entry, "Now entry updated successfully")
+}
+
+// DeleteEntry removes a /now entry
+// @Summary Delete a now entry
+// @Description Remove a now entry. Deleting the current entry makes the newest remaining one current.
+// @Tags Now
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Now Entry ID"
+// @Success 200 {object} response.APIResponse "Now entry deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Now entry not found"
+// @Router /admin/now/{id} [delete]
+func (h *NowHandler) DeleteEntry(c *gin.Context) {
+	entryID := c.Param("id")
+	if _, err := h.ValidateUUID(entryID, "Now Entry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.nowService.DeleteEntry(c.Request.Context(), entryID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Now entry deleted successfully")
+}
@@ -0,0 +1,52 @@
+package now
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entry is a snapshot of the /now page
+// @Description What I'm currently working on, reading and learning
+// @Name NowEntry
+type Entry struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+
+	// Content is Markdown; ContentHTML is rendered from it on save
+	Content     string `json:"content" db:"content" example:"Settling into a new role and **shipping** the portfolio redesign."`
+	ContentHTML string `json:"content_html" db:"content_html" example:"<p>Settling into a new role and <strong>shipping</strong> the portfolio redesign.</p>"`
+
+	WorkingOn []string `json:"working_on" db:"working_on" pg:"array" example:"Portfolio redesign"`
+	Reading   []string `json:"reading" db:"reading" pg:"array" example:"Designing Data-Intensive Applications"`
+	Learning  []string `json:"learning" db:"learning" pg:"array" example:"Rust"`
+	Location  string   `json:"location,omitempty" db:"location" example:"Jakarta, Indonesia"`
+
+	// IsCurrent marks the entry shown on /now; older entries form the history
+	IsCurrent bool `json:"is_current" db:"is_current" example:"true"`
+
+	PublishedAt time.Time  `json:"published_at" db:"published_at"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// EntryCreate is the input for publishing a new /now entry
+// @Name NowEntryCreate
+type EntryCreate struct {
+	Content   string   `json:"content" validate:"required,max=10000" example:"Settling into a new role and **shipping** the portfolio redesign."`
+	WorkingOn []string `json:"working_on" example:"Portfolio redesign"`
+	Reading   []string `json:"reading" example:"Designing Data-Intensive Applications"`
+	Learning  []string `json:"learning" example:"Rust"`
+	Location  string   `json:"location" validate:"max=255" example:"Jakarta, Indonesia"`
+}
+
+// EntryUpdate is the input for correcting an existing /now entry
+// @Name NowEntryUpdate
+type EntryUpdate struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Content   string    `json:"content" validate:"max=10000" example:"Settling into a new role and **shipping** the portfolio redesign."`
+	WorkingOn []string  `json:"working_on" example:"Portfolio redesign"`
+	Reading   []string  `json:"reading" example:"Designing Data-Intensive Applications"`
+	Learning  []string  `json:"learning" example:"Rust"`
+	Location  string    `json:"location" validate:"max=255" example:"Jakarta, Indonesia"`
+}
@@ -0,0 +1,197 @@
+package now
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type NowRepository interface {
+	Create(ctx context.Context, entry *Entry) (*Entry, error)
+	Update(ctx context.Context, entry *Entry) (*Entry, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Entry, error)
+	FindCurrent(ctx context.Context) (*Entry, error)
+	SetCurrent(ctx context.Context, id string, current bool) error
+	ClearCurrent(ctx context.Context) error
+	List(ctx context.Context, opts base.ListOptions) ([]Entry, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type nowRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewNowRepository(supabaseClient *supabase.SupabaseClient) NowRepository {
+	return &nowRepository{
+		supabaseClient: supabaseClient,
+		table:          "now_entry",
+	}
+}
+
+func (r *nowRepository) Create(ctx context.Context, entry *Entry) (*Entry, error) {
+	entry.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(entry, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create now entry")
+	}
+	return entry, nil
+}
+
+func (r *nowRepository) Update(ctx context.Context, entry *Entry) (*Entry, error) {
+	entry.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(entry, "minimal", "").
+		Eq("id", entry.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update now entry")
+	}
+	return entry, nil
+}
+
+func (r *nowRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete now entry")
+	}
+	return nil
+}
+
+// FindByID returns the entry with the given ID, or nil if none
+func (r *nowRepository) FindByID(ctx context.Context, id string) (*Entry, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+// FindCurrent returns the entry shown on /now, or nil if none
+func (r *nowRepository) FindCurrent(ctx context.Context) (*Entry, error) {
+	return r.findOne(ctx, "is_current", "true")
+}
+
+// SetCurrent marks or unmarks a single entry as the current one
+func (r *nowRepository) SetCurrent(ctx context.Context, id string, current bool) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"is_current": current,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update current now entry")
+	}
+	return nil
+}
+
+// ClearCurrent moves the current entry, if any, to the history
+func (r *nowRepository) ClearCurrent(ctx context.Context) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"is_current": false,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("is_current", "true")
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to clear current now entry")
+	}
+	return nil
+}
+
+func (r *nowRepository) List(ctx context.Context, opts base.ListOptions) ([]Entry, error) {
+	var entries []Entry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, newest first by default
+	sortBy, ascending := "published_at", false
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list now entries")
+	}
+
+	return entries, nil
+}
+
+func (r *nowRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count now entries")
+	}
+
+	return int(count), nil
+}
+
+// findOne returns the newest entry whose field equals value, or nil if none
+func (r *nowRepository) findOne(ctx context.Context, field, value string) (*Entry, error) {
+	var entries []Entry
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("published_at", &postgrest.OrderOpts{Ascending: false}).
+		Limit(1, "").
+		ExecuteTo(&entries)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find now entry")
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
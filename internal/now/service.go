@@ -0,0 +1,184 @@
+package now
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+type NowService interface {
+	GetCurrent(ctx context.Context) (*Entry, error)
+	Publish(ctx context.Context, entryCreate *EntryCreate) (*Entry, error)
+	UpdateEntry(ctx context.Context, entryUpdate *EntryUpdate) (*Entry, error)
+	DeleteEntry(ctx context.Context, id string) error
+	ListEntries(ctx context.Context, opts base.ListOptions) ([]Entry, error)
+	CountEntries(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type nowService struct {
+	nowRepo NowRepository
+}
+
+func NewNowService(nowRepo NowRepository) NowService {
+	return &nowService{
+		nowRepo: nowRepo,
+	}
+}
+
+// GetCurrent returns the entry shown on /now
+func (s *nowService) GetCurrent(ctx context.Context) (*Entry, error) {
+	entry, err := s.nowRepo.FindCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"No now entry has been published yet",
+			nil,
+		)
+	}
+	return entry, nil
+}
+
+// Publish makes a new entry current and moves the previous one to the history
+func (s *nowService) Publish(ctx context.Context, entryCreate *EntryCreate) (*Entry, error) {
+	// Validate input
+	if err := validator.ValidateModel(entryCreate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	content := strings.TrimSpace(entryCreate.Content)
+	entry := &Entry{
+		ID:          uuid.New(),
+		Content:     content,
+		ContentHTML: markdown.Render(content),
+		WorkingOn:   cleanItems(entryCreate.WorkingOn),
+		Reading:     cleanItems(entryCreate.Reading),
+		Learning:    cleanItems(entryCreate.Learning),
+		Location:    strings.TrimSpace(entryCreate.Location),
+		IsCurrent:   true,
+		PublishedAt: now,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	if err := s.nowRepo.ClearCurrent(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.nowRepo.Create(ctx, entry)
+}
+
+// UpdateEntry corrects an entry without changing which one is current
+func (s *nowService) UpdateEntry(ctx context.Context, entryUpdate *EntryUpdate) (*Entry, error) {
+	// Validate input
+	if err := validator.ValidateModel(entryUpdate); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.findEntry(ctx, entryUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if content := strings.TrimSpace(entryUpdate.Content); content != "" {
+		entry.Content = content
+		entry.ContentHTML = markdown.Render(content)
+	}
+	if entryUpdate.WorkingOn != nil {
+		entry.WorkingOn = cleanItems(entryUpdate.WorkingOn)
+	}
+	if entryUpdate.Reading != nil {
+		entry.Reading = cleanItems(entryUpdate.Reading)
+	}
+	if entryUpdate.Learning != nil {
+		entry.Learning = cleanItems(entryUpdate.Learning)
+	}
+	if location := strings.TrimSpace(entryUpdate.Location); location != "" {
+		entry.Location = location
+	}
+
+	now := time.Now().UTC()
+	entry.UpdatedAt = &now
+
+	return s.nowRepo.Update(ctx, entry)
+}
+
+// DeleteEntry removes an entry. Deleting the current entry makes the newest
+// remaining one current.
+func (s *nowService) DeleteEntry(ctx context.Context, id string) error {
+	entry, err := s.findEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.nowRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if !entry.IsCurrent {
+		return nil
+	}
+
+	latest, err := s.nowRepo.List(ctx, base.ListOptions{Page: 1, PerPage: 1})
+	if err != nil {
+		return err
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+	return s.nowRepo.SetCurrent(ctx, latest[0].ID.String(), true)
+}
+
+// ListEntries returns the history of /now entries, newest first
+func (s *nowService) ListEntries(ctx context.Context, opts base.ListOptions) ([]Entry, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.nowRepo.List(ctx, opts)
+}
+
+func (s *nowService) CountEntries(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.nowRepo.Count(ctx, filters)
+}
+
+// findEntry returns the entry with the given ID or a not found error
+func (s *nowService) findEntry(ctx context.Context, id string) (*Entry, error) {
+	entry, err := s.nowRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Now entry not found",
+			nil,
+			errors.WithContext("entry_id", id),
+		)
+	}
+	return entry, nil
+}
+
+// cleanItems trims list items and drops empty ones
+func cleanItems(items []string) []string {
+	cleaned := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			cleaned = append(cleaned, item)
+		}
+	}
+	return cleaned
+}
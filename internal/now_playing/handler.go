@@ -0,0 +1,37 @@
+package now_playing
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type NowPlayingHandler struct {
+	base.BaseHandler
+	nowPlayingService NowPlayingService
+}
+
+func NewNowPlayingHandler(nowPlayingService NowPlayingService, logger *logger.Logger) *NowPlayingHandler {
+	return &NowPlayingHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		nowPlayingService: nowPlayingService,
+	}
+}
+
+// GetNowPlaying retrieves the track I'm listening to
+// @Summary Get now playing
+// @Description Retrieve the track currently playing on Spotify, or the last played one when nothing is playing. Refreshed at most every 30 seconds.
+// @Tags Now Playing
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=NowPlaying} "Now playing retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Spotify is not configured or unreachable"
+// @Router /now-playing [get]
+func (h *NowPlayingHandler) GetNowPlaying(c *gin.Context) {
+	nowPlaying, err := h.nowPlayingService.GetNowPlaying(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nowPlaying, "Now playing retrieved successfully")
+}
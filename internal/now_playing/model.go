@@ -0,0 +1,25 @@
+package now_playing
+
+import "time"
+
+// NowPlaying is the track shown by the listening widget
+// @Description Currently playing or last played track on Spotify
+// @Name NowPlaying
+type NowPlaying struct {
+	// IsPlaying is false when the track below is the last one played
+	IsPlaying bool `json:"is_playing" example:"true"`
+
+	Title         string `json:"title,omitempty" example:"Midnight City"`
+	Artist        string `json:"artist,omitempty" example:"M83"`
+	Album         string `json:"album,omitempty" example:"Hurry Up, We're Dreaming"`
+	AlbumImageUrl string `json:"album_image_url,omitempty" example:"https://i.scdn.co/image/ab67616d0000b273"`
+	SongUrl       string `json:"song_url,omitempty" example:"https://open.spotify.com/track/1eyzqe2QqGZUmfcPZtrIyt"`
+	ProgressMs    int    `json:"progress_ms,omitempty" example:"83000"`
+	DurationMs    int    `json:"duration_ms,omitempty" example:"243000"`
+
+	// PlayedAt is when the last played track finished
+	PlayedAt *time.Time `json:"played_at,omitempty"`
+
+	// FetchedAt is when the track was read from Spotify
+	FetchedAt time.Time `json:"fetched_at"`
+}
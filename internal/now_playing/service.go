@@ -0,0 +1,119 @@
+package now_playing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/spotify"
+)
+
+type NowPlayingService interface {
+	GetNowPlaying(ctx context.Context) (*NowPlaying, error)
+}
+
+type nowPlayingService struct {
+	client *spotify.Client
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	cached   *NowPlaying
+	cachedAt time.Time
+}
+
+// NewNowPlayingService creates the service; a nil client disables it
+func NewNowPlayingService(client *spotify.Client, ttl time.Duration) NowPlayingService {
+	return &nowPlayingService{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// GetNowPlaying returns the track being played, falling back to the last
+// played one. Results are cached for the TTL so the widget can poll freely,
+// and the previous result is served when Spotify cannot be reached.
+func (s *nowPlayingService) GetNowPlaying(ctx context.Context) (*NowPlaying, error) {
+	if s.client == nil {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Spotify integration is not configured",
+			nil,
+		)
+	}
+
+	// Holding the lock while fetching lets concurrent requests share one call
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && time.Since(s.cachedAt) < s.ttl {
+		return s.cached, nil
+	}
+
+	nowPlaying, err := s.fetch(ctx)
+	if err != nil {
+		if s.cached != nil {
+			fmt.Printf("Failed to refresh now playing, serving cached track: %v\n", err)
+			return s.cached, nil
+		}
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to fetch now playing from Spotify",
+		)
+	}
+
+	s.cached = nowPlaying
+	s.cachedAt = nowPlaying.FetchedAt
+	return nowPlaying, nil
+}
+
+func (s *nowPlayingService) fetch(ctx context.Context) (*NowPlaying, error) {
+	fetchedAt := time.Now().UTC()
+
+	playback, err := s.client.CurrentlyPlaying(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if playback != nil && playback.IsPlaying {
+		nowPlaying := fromTrack(playback.Item)
+		nowPlaying.IsPlaying = true
+		nowPlaying.ProgressMs = playback.ProgressMs
+		nowPlaying.FetchedAt = fetchedAt
+		return nowPlaying, nil
+	}
+
+	last, err := s.client.LastPlayed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil {
+		return &NowPlaying{FetchedAt: fetchedAt}, nil
+	}
+
+	nowPlaying := fromTrack(&last.Track)
+	playedAt := last.PlayedAt.UTC()
+	nowPlaying.PlayedAt = &playedAt
+	nowPlaying.FetchedAt = fetchedAt
+	return nowPlaying, nil
+}
+
+func fromTrack(track *spotify.Track) *NowPlaying {
+	artists := make([]string, 0, len(track.Artists))
+	for _, artist := range track.Artists {
+		artists = append(artists, artist.Name)
+	}
+
+	nowPlaying := &NowPlaying{
+		Title:      track.Name,
+		Artist:     strings.Join(artists, ", "),
+		Album:      track.Album.Name,
+		SongUrl:    track.ExternalURLs.Spotify,
+		DurationMs: track.DurationMs,
+	}
+	if len(track.Album.Images) > 0 {
+		nowPlaying.AlbumImageUrl = track.Album.Images[0].URL
+	}
+	return nowPlaying
+}
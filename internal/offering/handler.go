@@ -0,0 +1,289 @@
+package offering
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type OfferingHandler struct {
+	base.BaseHandler
+	offeringService OfferingService
+	rateService     ExchangeRateService
+}
+
+func NewOfferingHandler(offeringService OfferingService, rateService ExchangeRateService, logger *logger.Logger) *OfferingHandler {
+	return &OfferingHandler{
+		BaseHandler:     *base.NewBaseHandler(logger),
+		offeringService: offeringService,
+		rateService:     rateService,
+	}
+}
+
+// CreateOffering creates a new offering
+// @Summary Create an offering
+// @Description Create a service offering with price ranges in one or more currencies. The first price is converted for other currencies.
+// @Tags Offerings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param offering body OfferingCreate true "Offering Details"
+// @Success 200 {object} response.APIResponse{data=Offering} "Offering created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /offerings [post]
+func (h *OfferingHandler) CreateOffering(c *gin.Context) {
+	var offeringInput OfferingCreate
+	if err := h.ValidateRequest(c, &offeringInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	offering, err := h.offeringService.CreateOffering(c.Request.Context(), &offeringInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, offering, "Offering created successfully")
+}
+
+// GetOfferingByID retrieves a specific offering
+// @Summary Get an offering by ID
+// @Description Retrieve an offering with its price in the requested currency. Without ?currency= the currency is taken from the Accept-Language region, falling back to the offering's first price.
+// @Tags Offerings
+// @Produce json
+// @Param id path string true "Offering ID"
+// @Param currency query string false "ISO 4217 currency code" example(EUR)
+// @Success 200 {object} response.APIResponse{data=OfferingDTO} "Offering retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Offering not found"
+// @Router /offerings/{id} [get]
+func (h *OfferingHandler) GetOfferingByID(c *gin.Context) {
+	offeringID := c.Param("id")
+	if _, err := h.ValidateUUID(offeringID, "Offering ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	currency, err := h.preferredCurrency(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	offering, err := h.offeringService.GetOfferingByID(c.Request.Context(), offeringID, currency)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, offering, "Offering retrieved successfully")
+}
+
+// UpdateOffering updates an existing offering
+// @Summary Update an offering
+// @Description Update an offering. Omitted prices and features are kept; an empty list clears them.
+// @Tags Offerings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Offering ID"
+// @Param offering body OfferingUpdate true "Offering Update Details"
+// @Success 200 {object} response.APIResponse{data=Offering} "Offering updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Offering not found"
+// @Router /offerings/{id} [put]
+func (h *OfferingHandler) UpdateOffering(c *gin.Context) {
+	offeringID, err := h.ValidateUUID(c.Param("id"), "Offering ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var offeringInput OfferingUpdate
+	if err := h.ValidateRequest(c, &offeringInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	offeringInput.ID = offeringID
+
+	offering, err := h.offeringService.UpdateOffering(c.Request.Context(), &offeringInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, offering, "Offering updated successfully")
+}
+
+// DeleteOffering deletes an existing offering
+// @Summary Delete an offering
+// @Description Delete a service offering
+// @Tags Offerings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Offering ID"
+// @Success 200 {object} response.APIResponse "Offering deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Offering not found"
+// @Router /offerings/{id} [delete]
+func (h *OfferingHandler) DeleteOffering(c *gin.Context) {
+	offeringID := c.Param("id")
+	if _, err := h.ValidateUUID(offeringID, "Offering ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.offeringService.DeleteOffering(c.Request.Context(), offeringID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Offering deleted successfully")
+}
+
+// ListOfferings retrieves the active offerings
+// @Summary List offerings
+// @Description Retrieve a paginated list of active offerings with prices in the requested currency. Without ?currency= the currency is taken from the Accept-Language region, falling back to each offering's first price.
+// @Tags Offerings
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param currency query string false "ISO 4217 currency code" example(EUR)
+// @Success 200 {object} response.APIResponse{data=[]OfferingDTO} "Offerings retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /offerings [get]
+func (h *OfferingHandler) ListOfferings(c *gin.Context) {
+	h.listOfferings(c, true)
+}
+
+// ListAllOfferings retrieves every offering including inactive ones
+// @Summary List all offerings
+// @Description Retrieve a paginated list of active and inactive offerings with prices in the requested currency
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param currency query string false "ISO 4217 currency code" example(EUR)
+// @Success 200 {object} response.APIResponse{data=[]OfferingDTO} "Offerings retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/offerings [get]
+func (h *OfferingHandler) ListAllOfferings(c *gin.Context) {
+	h.listOfferings(c, false)
+}
+
+// ListExchangeRates retrieves the stored exchange rates
+// @Summary List exchange rates
+// @Description Retrieve the exchange rates used to convert offering prices, quoted against the base currency
+// @Tags Offerings
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]ExchangeRate} "Exchange rates retrieved successfully"
+// @Router /exchange-rates [get]
+func (h *OfferingHandler) ListExchangeRates(c *gin.Context) {
+	rates, err := h.rateService.ListRates(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, rates, "Exchange rates retrieved successfully")
+}
+
+// RefreshExchangeRates fetches the latest exchange rates
+// @Summary Refresh exchange rates
+// @Description Fetch the latest exchange rates now instead of waiting for the scheduled refresh
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]ExchangeRate} "Exchange rates refreshed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Rate provider is not configured or unreachable"
+// @Router /admin/exchange-rates/refresh [post]
+func (h *OfferingHandler) RefreshExchangeRates(c *gin.Context) {
+	rates, err := h.rateService.RefreshRates(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, rates, "Exchange rates refreshed successfully")
+}
+
+func (h *OfferingHandler) listOfferings(c *gin.Context, activeOnly bool) {
+	// Parse pagination and filtering options
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// List in display order unless asked otherwise
+	if c.Query("sort_by") == "" {
+		opts.SortBy, opts.SortOrder = "position", base.SortAscending
+	}
+	if activeOnly {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "is_active",
+			Operator: base.OperatorEqual,
+			Value:    true,
+		})
+	}
+
+	currency, err := h.preferredCurrency(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	offerings, err := h.offeringService.ListOfferings(c.Request.Context(), opts, currency)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total offerings for pagination
+	total, err := h.offeringService.CountOfferings(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, offerings, "Offerings retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// preferredCurrency returns the currency requested with ?currency=, or the
+// one of the Accept-Language region when absent
+func (h *OfferingHandler) preferredCurrency(c *gin.Context) (string, error) {
+	var query PriceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		return "", errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		)
+	}
+
+	if query.Currency == "" {
+		return CurrencyFromLocale(c.GetHeader("Accept-Language")), nil
+	}
+
+	currency, ok := ParseCurrency(query.Currency)
+	if !ok {
+		return "", errors.New(
+			errors.ErrValidation,
+			"Currency must be a three letter ISO 4217 code",
+			nil,
+			errors.WithContext("currency", query.Currency),
+		)
+	}
+	return currency, nil
+}
@@ -0,0 +1,77 @@
+package offering
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// RateJob periodically refreshes the exchange rates shared by every tenant
+type RateJob struct {
+	rateService ExchangeRateService
+	interval    time.Duration
+	logger      *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRateJob creates a rate refresh job running every interval
+func NewRateJob(rateService ExchangeRateService, interval time.Duration, logger *logger.Logger) *RateJob {
+	if interval <= 0 {
+		interval = 12 * time.Hour
+	}
+
+	return &RateJob{
+		rateService: rateService,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// refresh runs right away in the background so prices can be converted
+// after a fresh deploy.
+func (j *RateJob) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.run(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *RateJob) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+func (j *RateJob) run(ctx context.Context) {
+	rates, err := j.rateService.RefreshRates(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to refresh exchange rates", "error", err)
+		}
+		return
+	}
+
+	j.logger.Info("Exchange rates refreshed", "currencies", len(rates))
+}
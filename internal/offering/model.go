@@ -0,0 +1,79 @@
+package offering
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Offering is a service offered to clients
+// @Description Service offering with price ranges in one or more currencies
+// @Name Offering
+type Offering struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+
+	Title       string   `json:"title" db:"title" example:"Backend API development"`
+	Description string   `json:"description,omitempty" db:"description" example:"Design and build a production-ready REST API"`
+	Features    []string `json:"features" db:"features" pg:"array" example:"OpenAPI docs,CI/CD setup"`
+
+	// Prices lists the price range in each currency set explicitly; the
+	// first one is converted for any other currency
+	Prices    []PriceRange `json:"prices" db:"prices"`
+	PriceUnit PriceUnit    `json:"price_unit" db:"price_unit" example:"project"`
+
+	IsActive bool `json:"is_active" db:"is_active" example:"true"`
+
+	// Position orders offerings, lowest first
+	Position int `json:"position" db:"position" example:"0"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// OfferingDTO is an offering with its price in the requested currency
+// @Name OfferingDTO
+type OfferingDTO struct {
+	Offering
+	Price *LocalizedPrice `json:"price,omitempty"`
+}
+
+// OfferingCreate is the input for adding an offering
+// @Name OfferingCreate
+type OfferingCreate struct {
+	Title       string       `json:"title" validate:"required,max=255" example:"Backend API development"`
+	Description string       `json:"description" validate:"max=5000" example:"Design and build a production-ready REST API"`
+	Features    []string     `json:"features" example:"OpenAPI docs,CI/CD setup"`
+	Prices      []PriceRange `json:"prices"`
+	PriceUnit   PriceUnit    `json:"price_unit" example:"project"`
+	IsActive    *bool        `json:"is_active" example:"true"`
+	Position    int          `json:"position" example:"0"`
+}
+
+// OfferingUpdate is the input for editing an offering. Nil fields are kept.
+// @Name OfferingUpdate
+type OfferingUpdate struct {
+	ID          uuid.UUID    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string       `json:"title" validate:"max=255" example:"Backend API development"`
+	Description string       `json:"description" validate:"max=5000" example:"Design and build a production-ready REST API"`
+	Features    []string     `json:"features" example:"OpenAPI docs,CI/CD setup"`
+	Prices      []PriceRange `json:"prices"`
+	PriceUnit   PriceUnit    `json:"price_unit" example:"hour"`
+	IsActive    *bool        `json:"is_active" example:"true"`
+	Position    *int         `json:"position" example:"1"`
+}
+
+// ExchangeRate is the units of Currency worth one unit of Base
+// @Name ExchangeRate
+type ExchangeRate struct {
+	Base      string     `json:"base" db:"base" example:"USD"`
+	Currency  string     `json:"currency" db:"currency" example:"EUR"`
+	Rate      float64    `json:"rate" db:"rate" example:"0.92"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// PriceQuery represents the currency requested through query parameters
+// @Name PriceQuery
+type PriceQuery struct {
+	Currency string `form:"currency" example:"EUR"`
+}
@@ -0,0 +1,209 @@
+package offering
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+// PriceUnit is what a price range is charged per
+type PriceUnit string
+
+const (
+	PerProject PriceUnit = "project"
+	PerHour    PriceUnit = "hour"
+	PerDay     PriceUnit = "day"
+	PerWeek    PriceUnit = "week"
+	PerMonth   PriceUnit = "month"
+)
+
+var priceUnits = map[PriceUnit]bool{
+	PerProject: true,
+	PerHour:    true,
+	PerDay:     true,
+	PerWeek:    true,
+	PerMonth:   true,
+}
+
+const maxOfferingPrices = 10
+
+// PriceRange is the price of an offering in a single currency
+// @Name PriceRange
+type PriceRange struct {
+	Currency string  `json:"currency" example:"USD"`
+	Min      float64 `json:"min" example:"1500"`
+	Max      float64 `json:"max" example:"4000"`
+}
+
+// LocalizedPrice is the price range of an offering in the requested currency
+// @Description Price range of an offering, converted when not set in the requested currency
+// @Name LocalizedPrice
+type LocalizedPrice struct {
+	Currency string    `json:"currency" example:"EUR"`
+	Min      float64   `json:"min" example:"1380"`
+	Max      float64   `json:"max" example:"3680"`
+	Unit     PriceUnit `json:"unit" example:"project"`
+
+	// Converted is true when the price was converted from another currency
+	Converted bool `json:"converted" example:"true"`
+
+	// RateUpdatedAt is when the exchange rate used was published
+	RateUpdatedAt *time.Time `json:"rate_updated_at,omitempty"`
+}
+
+// regionCurrencies maps the regions of common Accept-Language tags to their currency
+var regionCurrencies = map[string]string{
+	"US": "USD", "GB": "GBP", "ID": "IDR", "SG": "SGD", "MY": "MYR",
+	"JP": "JPY", "AU": "AUD", "CA": "CAD", "IN": "INR", "CH": "CHF",
+	"DE": "EUR", "FR": "EUR", "ES": "EUR", "IT": "EUR", "NL": "EUR",
+	"AT": "EUR", "BE": "EUR", "FI": "EUR", "IE": "EUR", "PT": "EUR",
+}
+
+// zeroDecimalCurrencies have no minor unit in everyday prices
+var zeroDecimalCurrencies = map[string]bool{
+	"IDR": true,
+	"JPY": true,
+	"KRW": true,
+	"VND": true,
+}
+
+// ParseCurrency normalizes a currency code, reporting whether it is three letters
+func ParseCurrency(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", false
+		}
+	}
+	return code, true
+}
+
+// CurrencyFromLocale returns the currency of the first region found in an
+// Accept-Language header, or an empty string if none is known
+func CurrencyFromLocale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return ""
+	}
+	for _, tag := range tags {
+		region, confidence := tag.Region()
+		if confidence != language.Exact {
+			continue
+		}
+		if currency, ok := regionCurrencies[region.String()]; ok {
+			return currency
+		}
+	}
+	return ""
+}
+
+// normalizePrices validates price ranges and upper-cases their currencies
+func normalizePrices(prices []PriceRange) ([]PriceRange, error) {
+	if len(prices) > maxOfferingPrices {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("An offering can have at most %d prices", maxOfferingPrices),
+			nil,
+		)
+	}
+
+	normalized := make([]PriceRange, 0, len(prices))
+	seen := map[string]bool{}
+	for i, price := range prices {
+		currency, ok := ParseCurrency(price.Currency)
+		if !ok {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Invalid currency code %q", price.Currency),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if seen[currency] {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Duplicate price in %s", currency),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		seen[currency] = true
+
+		if math.IsNaN(price.Min) || math.IsNaN(price.Max) || math.IsInf(price.Max, 0) || price.Min < 0 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Price in %s must be a non-negative amount", currency),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		// A missing maximum means a fixed price
+		if price.Max == 0 {
+			price.Max = price.Min
+		}
+		if price.Max < price.Min {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Price in %s has a maximum below its minimum", currency),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+
+		price.Currency = currency
+		normalized = append(normalized, price)
+	}
+
+	return normalized, nil
+}
+
+// localize returns the price of offering in currency, converting its first
+// price with rates when currency is not set explicitly. It falls back to
+// the first price when currency is empty or no rate is known.
+func localize(offering *Offering, currency string, rates *RateTable) *LocalizedPrice {
+	if len(offering.Prices) == 0 {
+		return nil
+	}
+
+	for _, price := range offering.Prices {
+		if price.Currency == currency {
+			return &LocalizedPrice{Currency: price.Currency, Min: price.Min, Max: price.Max, Unit: offering.PriceUnit}
+		}
+	}
+
+	primary := offering.Prices[0]
+	fallback := &LocalizedPrice{Currency: primary.Currency, Min: primary.Min, Max: primary.Max, Unit: offering.PriceUnit}
+	if currency == "" || rates == nil {
+		return fallback
+	}
+
+	factor, ok := rates.Factor(primary.Currency, currency)
+	if !ok {
+		return fallback
+	}
+
+	return &LocalizedPrice{
+		Currency:      currency,
+		Min:           roundPrice(primary.Min*factor, currency),
+		Max:           roundPrice(primary.Max*factor, currency),
+		Unit:          offering.PriceUnit,
+		Converted:     true,
+		RateUpdatedAt: rates.UpdatedAt(),
+	}
+}
+
+// roundPrice rounds a converted amount to whole units, or to hundreds for
+// currencies without a minor unit, so quotes do not look falsely precise
+func roundPrice(amount float64, currency string) float64 {
+	if zeroDecimalCurrencies[currency] {
+		return math.Round(amount/100) * 100
+	}
+	return math.Round(amount)
+}
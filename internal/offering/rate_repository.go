@@ -0,0 +1,60 @@
+package offering
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+// ExchangeRateRepository stores exchange rates; rates are shared by every
+// tenant, so queries are not tenant scoped
+type ExchangeRateRepository interface {
+	UpsertRates(ctx context.Context, rates []ExchangeRate) error
+	FindByBase(ctx context.Context, base string) ([]ExchangeRate, error)
+}
+
+type exchangeRateRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewExchangeRateRepository(supabaseClient *supabase.SupabaseClient) ExchangeRateRepository {
+	return &exchangeRateRepository{
+		supabaseClient: supabaseClient,
+		table:          "exchange_rate",
+	}
+}
+
+// UpsertRates stores rates, one row per base and currency
+func (r *exchangeRateRepository) UpsertRates(ctx context.Context, rates []ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(rates, "base,currency", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save exchange rates")
+	}
+	return nil
+}
+
+// FindByBase returns the rates quoted against base, ordered by currency
+func (r *exchangeRateRepository) FindByBase(ctx context.Context, base string) ([]ExchangeRate, error) {
+	var rates []ExchangeRate
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("base", base).
+		Order("currency", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&rates)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list exchange rates")
+	}
+
+	return rates, nil
+}
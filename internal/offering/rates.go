@@ -0,0 +1,157 @@
+package offering
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/exchangerate"
+)
+
+// RateTable holds the rates of every currency against a base currency
+type RateTable struct {
+	base      string
+	rates     map[string]float64
+	updatedAt *time.Time
+}
+
+// Factor returns the amount of to worth one unit of from
+func (t *RateTable) Factor(from, to string) (float64, bool) {
+	fromRate, ok := t.rate(from)
+	if !ok {
+		return 0, false
+	}
+	toRate, ok := t.rate(to)
+	if !ok {
+		return 0, false
+	}
+	return toRate / fromRate, true
+}
+
+// UpdatedAt returns when the newest rate was published
+func (t *RateTable) UpdatedAt() *time.Time {
+	return t.updatedAt
+}
+
+func (t *RateTable) rate(currency string) (float64, bool) {
+	if currency == t.base {
+		return 1, true
+	}
+	rate, ok := t.rates[currency]
+	return rate, ok && rate > 0
+}
+
+type ExchangeRateService interface {
+	ListRates(ctx context.Context) ([]ExchangeRate, error)
+	RefreshRates(ctx context.Context) ([]ExchangeRate, error)
+	GetRateTable(ctx context.Context) (*RateTable, error)
+}
+
+type exchangeRateService struct {
+	rateRepo     ExchangeRateRepository
+	client       *exchangerate.Client
+	baseCurrency string
+
+	mu     sync.RWMutex
+	loaded *RateTable
+}
+
+// NewExchangeRateService creates the service quoting rates against
+// baseCurrency; a nil client disables refreshing
+func NewExchangeRateService(rateRepo ExchangeRateRepository, client *exchangerate.Client, baseCurrency string) ExchangeRateService {
+	base, ok := ParseCurrency(baseCurrency)
+	if !ok {
+		base = "USD"
+	}
+
+	return &exchangeRateService{
+		rateRepo:     rateRepo,
+		client:       client,
+		baseCurrency: base,
+	}
+}
+
+func (s *exchangeRateService) ListRates(ctx context.Context) ([]ExchangeRate, error) {
+	return s.rateRepo.FindByBase(ctx, s.baseCurrency)
+}
+
+// RefreshRates fetches the latest rates and stores them
+func (s *exchangeRateService) RefreshRates(ctx context.Context) ([]ExchangeRate, error) {
+	if s.client == nil {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Exchange rate refresh is not configured",
+			nil,
+		)
+	}
+
+	latest, err := s.client.Latest(ctx, s.baseCurrency)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to fetch exchange rates",
+		)
+	}
+
+	updatedAt := latest.UpdatedAt
+	rates := make([]ExchangeRate, 0, len(latest.Rates))
+	for currency, rate := range latest.Rates {
+		code, ok := ParseCurrency(currency)
+		if !ok || rate <= 0 {
+			continue
+		}
+		rates = append(rates, ExchangeRate{
+			Base:      s.baseCurrency,
+			Currency:  code,
+			Rate:      rate,
+			UpdatedAt: &updatedAt,
+		})
+	}
+
+	if err := s.rateRepo.UpsertRates(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.loaded = newRateTable(s.baseCurrency, rates)
+	s.mu.Unlock()
+
+	return s.rateRepo.FindByBase(ctx, s.baseCurrency)
+}
+
+// GetRateTable returns the rates loaded in memory, reading them from the
+// database the first time. It returns nil if no rates were ever stored.
+func (s *exchangeRateService) GetRateTable(ctx context.Context) (*RateTable, error) {
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+	if loaded != nil {
+		return loaded, nil
+	}
+
+	rates, err := s.rateRepo.FindByBase(ctx, s.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	loaded = newRateTable(s.baseCurrency, rates)
+	s.mu.Lock()
+	s.loaded = loaded
+	s.mu.Unlock()
+	return loaded, nil
+}
+
+func newRateTable(base string, rates []ExchangeRate) *RateTable {
+	table := &RateTable{base: base, rates: make(map[string]float64, len(rates))}
+	for _, rate := range rates {
+		table.rates[rate.Currency] = rate.Rate
+		if rate.UpdatedAt != nil && (table.updatedAt == nil || rate.UpdatedAt.After(*table.updatedAt)) {
+			table.updatedAt = rate.UpdatedAt
+		}
+	}
+	return table
+}
@@ -0,0 +1,148 @@
+package offering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type OfferingRepository interface {
+	Create(ctx context.Context, offering *Offering) (*Offering, error)
+	Update(ctx context.Context, offering *Offering) (*Offering, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Offering, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Offering, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type offeringRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewOfferingRepository(supabaseClient *supabase.SupabaseClient) OfferingRepository {
+	return &offeringRepository{
+		supabaseClient: supabaseClient,
+		table:          "offering",
+	}
+}
+
+func (r *offeringRepository) Create(ctx context.Context, offering *Offering) (*Offering, error) {
+	offering.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(offering, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create offering")
+	}
+	return offering, nil
+}
+
+func (r *offeringRepository) Update(ctx context.Context, offering *Offering) (*Offering, error) {
+	offering.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(offering, "minimal", "").
+		Eq("id", offering.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update offering")
+	}
+	return offering, nil
+}
+
+func (r *offeringRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete offering")
+	}
+	return nil
+}
+
+// FindByID returns the offering with the given ID, or nil if none
+func (r *offeringRepository) FindByID(ctx context.Context, id string) (*Offering, error) {
+	var offerings []Offering
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&offerings)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find offering")
+	}
+
+	if len(offerings) == 0 {
+		return nil, nil
+	}
+	return &offerings[0], nil
+}
+
+func (r *offeringRepository) List(ctx context.Context, opts base.ListOptions) ([]Offering, error) {
+	var offerings []Offering
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, page order by default
+	sortBy, ascending := "position", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&offerings)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list offerings")
+	}
+
+	return offerings, nil
+}
+
+func (r *offeringRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count offerings")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,252 @@
+package offering
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+type OfferingService interface {
+	CreateOffering(ctx context.Context, offeringCreate *OfferingCreate) (*Offering, error)
+	GetOfferingByID(ctx context.Context, id, currency string) (*OfferingDTO, error)
+	UpdateOffering(ctx context.Context, offeringUpdate *OfferingUpdate) (*Offering, error)
+	DeleteOffering(ctx context.Context, id string) error
+	ListOfferings(ctx context.Context, opts base.ListOptions, currency string) ([]OfferingDTO, error)
+	CountOfferings(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type offeringService struct {
+	offeringRepo OfferingRepository
+	rates        ExchangeRateService
+}
+
+func NewOfferingService(offeringRepo OfferingRepository, rates ExchangeRateService) OfferingService {
+	return &offeringService{
+		offeringRepo: offeringRepo,
+		rates:        rates,
+	}
+}
+
+func (s *offeringService) CreateOffering(ctx context.Context, offeringCreate *OfferingCreate) (*Offering, error) {
+	// Validate input
+	if err := validator.ValidateModel(offeringCreate); err != nil {
+		return nil, err
+	}
+
+	prices, err := normalizePrices(offeringCreate.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := parsePriceUnit(offeringCreate.PriceUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	offering := &Offering{
+		ID:          uuid.New(),
+		Title:       strings.TrimSpace(offeringCreate.Title),
+		Description: strings.TrimSpace(offeringCreate.Description),
+		Features:    cleanFeatures(offeringCreate.Features),
+		Prices:      prices,
+		PriceUnit:   unit,
+		IsActive:    true,
+		Position:    offeringCreate.Position,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	if offeringCreate.IsActive != nil {
+		offering.IsActive = *offeringCreate.IsActive
+	}
+
+	createdOffering, err := s.offeringRepo.Create(ctx, offering)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create offering",
+			errors.WithContext("offering_title", offering.Title),
+		)
+	}
+
+	return createdOffering, nil
+}
+
+// GetOfferingByID returns the offering with its price in currency, or in
+// its first currency when currency is empty or cannot be converted to
+func (s *offeringService) GetOfferingByID(ctx context.Context, id, currency string) (*OfferingDTO, error) {
+	offering, err := s.findOffering(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.rateTable(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OfferingDTO{Offering: *offering, Price: localize(offering, currency, rates)}, nil
+}
+
+func (s *offeringService) UpdateOffering(ctx context.Context, offeringUpdate *OfferingUpdate) (*Offering, error) {
+	// Validate input
+	if err := validator.ValidateModel(offeringUpdate); err != nil {
+		return nil, err
+	}
+
+	offering, err := s.findOffering(ctx, offeringUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if title := strings.TrimSpace(offeringUpdate.Title); title != "" {
+		offering.Title = title
+	}
+	if description := strings.TrimSpace(offeringUpdate.Description); description != "" {
+		offering.Description = description
+	}
+	if offeringUpdate.Features != nil {
+		offering.Features = cleanFeatures(offeringUpdate.Features)
+	}
+	if offeringUpdate.Prices != nil {
+		prices, err := normalizePrices(offeringUpdate.Prices)
+		if err != nil {
+			return nil, err
+		}
+		offering.Prices = prices
+	}
+	if offeringUpdate.PriceUnit != "" {
+		unit, err := parsePriceUnit(offeringUpdate.PriceUnit)
+		if err != nil {
+			return nil, err
+		}
+		offering.PriceUnit = unit
+	}
+	if offeringUpdate.IsActive != nil {
+		offering.IsActive = *offeringUpdate.IsActive
+	}
+	if offeringUpdate.Position != nil {
+		offering.Position = *offeringUpdate.Position
+	}
+
+	now := time.Now().UTC()
+	offering.UpdatedAt = &now
+
+	updatedOffering, err := s.offeringRepo.Update(ctx, offering)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update offering",
+			errors.WithContext("offering_id", offering.ID),
+		)
+	}
+
+	return updatedOffering, nil
+}
+
+func (s *offeringService) DeleteOffering(ctx context.Context, id string) error {
+	if _, err := s.findOffering(ctx, id); err != nil {
+		return err
+	}
+
+	return s.offeringRepo.Delete(ctx, id)
+}
+
+// ListOfferings returns offerings with their prices in currency
+func (s *offeringService) ListOfferings(ctx context.Context, opts base.ListOptions, currency string) ([]OfferingDTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	offerings, err := s.offeringRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.rateTable(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]OfferingDTO, len(offerings))
+	for i := range offerings {
+		dtos[i] = OfferingDTO{Offering: offerings[i], Price: localize(&offerings[i], currency, rates)}
+	}
+	return dtos, nil
+}
+
+func (s *offeringService) CountOfferings(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.offeringRepo.Count(ctx, filters)
+}
+
+// rateTable loads exchange rates only when a conversion may be needed
+func (s *offeringService) rateTable(ctx context.Context, currency string) (*RateTable, error) {
+	if currency == "" {
+		return nil, nil
+	}
+	return s.rates.GetRateTable(ctx)
+}
+
+// findOffering returns the offering with the given ID or a not found error
+func (s *offeringService) findOffering(ctx context.Context, id string) (*Offering, error) {
+	if id == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Offering ID cannot be empty",
+			nil,
+		)
+	}
+
+	offering, err := s.offeringRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if offering == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Offering not found",
+			nil,
+			errors.WithContext("offering_id", id),
+		)
+	}
+
+	return offering, nil
+}
+
+// parsePriceUnit validates a price unit, defaulting to per project
+func parsePriceUnit(unit PriceUnit) (PriceUnit, error) {
+	unit = PriceUnit(strings.ToLower(strings.TrimSpace(string(unit))))
+	if unit == "" {
+		return PerProject, nil
+	}
+	if !priceUnits[unit] {
+		return "", errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("Unknown price unit %q", unit),
+			nil,
+		)
+	}
+	return unit, nil
+}
+
+// cleanFeatures trims features and drops empty ones
+func cleanFeatures(features []string) []string {
+	cleaned := make([]string, 0, len(features))
+	for _, feature := range features {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			cleaned = append(cleaned, feature)
+		}
+	}
+	return cleaned
+}
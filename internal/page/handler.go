@@ -0,0 +1,455 @@
+package page
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type PageHandler struct {
+	base.BaseHandler
+	pageService PageService
+}
+
+func NewPageHandler(pageService PageService, logger *logger.Logger) *PageHandler {
+	return &PageHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		pageService: pageService,
+	}
+}
+
+// ListPublishedPages retrieves the published pages
+// @Summary List pages
+// @Description Retrieve a paginated list of published pages such as the privacy policy, terms and imprint, by slug
+// @Tags Pages
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]PublicPage} "Pages retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /pages [get]
+func (h *PageHandler) ListPublishedPages(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+	opts.Filters = []base.FilterOption{{Field: "published", Operator: base.OperatorEqual, Value: true}}
+
+	h.listPages(c, opts)
+}
+
+// GetPublishedPage retrieves a published page
+// @Summary Get a page
+// @Description Retrieve a published page by its slug
+// @Tags Pages
+// @Produce json
+// @Param slug path string true "Page slug"
+// @Success 200 {object} response.APIResponse{data=PublicPage} "Page retrieved successfully"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /pages/{slug} [get]
+func (h *PageHandler) GetPublishedPage(c *gin.Context) {
+	page, err := h.pageService.GetPublishedPage(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, page, "Page retrieved successfully")
+}
+
+// ListPages retrieves every page including drafts
+// @Summary List pages for editing
+// @Description Retrieve a paginated list of published and draft pages
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param published query bool false "Only published or draft pages"
+// @Success 200 {object} response.APIResponse{data=[]Page} "Pages retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/pages [get]
+func (h *PageHandler) ListPages(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if value := c.Query("published"); value != "" {
+		published, err := strconv.ParseBool(value)
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Invalid published filter",
+				err,
+				errors.WithContext("published", value),
+			))
+			return
+		}
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "published", Operator: base.OperatorEqual, Value: published})
+	}
+
+	h.listPages(c, opts)
+}
+
+func (h *PageHandler) listPages(c *gin.Context, opts base.ListOptions) {
+	pages, err := h.pageService.ListPages(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.pageService.CountPages(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, pages, "Pages retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetPage retrieves a page for editing
+// @Summary Get a page for editing
+// @Description Retrieve a published or draft page by its ID
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Success 200 {object} response.APIResponse{data=Page} "Page retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /admin/pages/{id} [get]
+func (h *PageHandler) GetPage(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	page, err := h.pageService.GetPage(c.Request.Context(), pageID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, page, "Page retrieved successfully")
+}
+
+// CreatePage creates a page
+// @Summary Create a page
+// @Description Create a page written in Markdown, such as the privacy policy, terms or imprint. The page is saved as its first revision.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page body PageCreate true "Page Details"
+// @Success 200 {object} response.APIResponse{data=Page} "Page created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 409 {object} response.APIResponse "A page with this slug already exists"
+// @Router /admin/pages [post]
+func (h *PageHandler) CreatePage(c *gin.Context) {
+	var pageInput PageCreate
+	if err := h.ValidateRequest(c, &pageInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	page, err := h.pageService.CreatePage(c.Request.Context(), &pageInput, c.GetString("email"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, page, "Page created successfully")
+}
+
+// UpdatePage edits a page
+// @Summary Update a page
+// @Description Edit, publish or unpublish a page. Every edit is saved as a new revision.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Param page body PageUpdate true "Page Update Details"
+// @Success 200 {object} response.APIResponse{data=Page} "Page updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Failure 409 {object} response.APIResponse "A page with this slug already exists"
+// @Router /admin/pages/{id} [put]
+func (h *PageHandler) UpdatePage(c *gin.Context) {
+	pageID, err := h.ValidateUUID(c.Param("id"), "Page ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var pageInput PageUpdate
+	if err := h.ValidateRequest(c, &pageInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	pageInput.ID = pageID
+
+	page, err := h.pageService.UpdatePage(c.Request.Context(), &pageInput, c.GetString("email"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, page, "Page updated successfully")
+}
+
+// DeletePage removes a page
+// @Summary Delete a page
+// @Description Remove a page and its revision history
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Success 200 {object} response.APIResponse "Page deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /admin/pages/{id} [delete]
+func (h *PageHandler) DeletePage(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.pageService.DeletePage(c.Request.Context(), pageID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Page deleted successfully")
+}
+
+// ListRevisions retrieves the revision history of a page
+// @Summary List page revisions
+// @Description Retrieve a paginated list of the saved revisions of a page, newest first
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} response.APIResponse{data=[]Revision} "Page revisions retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /admin/pages/{id}/revisions [get]
+func (h *PageHandler) ListRevisions(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	revisions, err := h.pageService.ListRevisions(c.Request.Context(), pageID, opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.pageService.CountRevisions(c.Request.Context(), pageID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, revisions, "Page revisions retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// RestoreRevision restores an earlier revision of a page
+// @Summary Restore a page revision
+// @Description Bring back the title and body of an earlier revision, saved as a new revision. Whether the page is published is left unchanged.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Param revision path int true "Revision number"
+// @Param restore body RevisionRestore false "Restore Details"
+// @Success 200 {object} response.APIResponse{data=Page} "Page revision restored successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page or revision not found"
+// @Router /admin/pages/{id}/revisions/{revision}/restore [post]
+func (h *PageHandler) RestoreRevision(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	number, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || number < 1 {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid revision number",
+			err,
+			errors.WithContext("revision", c.Param("revision")),
+		))
+		return
+	}
+
+	var restoreInput RevisionRestore
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &restoreInput); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+	}
+
+	page, err := h.pageService.RestoreRevision(c.Request.Context(), pageID, number, &restoreInput, c.GetString("email"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, page, "Page revision restored successfully")
+}
+
+// GetPreviewPage retrieves a page with a preview link
+// @Summary Get a page preview
+// @Description Retrieve the page a preview link was created for, including drafts, without signing in. Links stop working once they expire or are revoked.
+// @Tags Pages
+// @Produce json
+// @Param token query string true "Preview token"
+// @Success 200 {object} response.APIResponse{data=PublicPage} "Page retrieved successfully"
+// @Failure 403 {object} response.APIResponse "Preview link is invalid or has expired"
+// @Router /page-previews [get]
+func (h *PageHandler) GetPreviewPage(c *gin.Context) {
+	page, err := h.pageService.GetPreviewPage(c.Request.Context(), c.Query("token"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Drafts must not be cached or indexed under the preview link
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Robots-Tag", "noindex")
+	h.HandleSuccess(c, page, "Page retrieved successfully")
+}
+
+// CreatePreview shares a page for review
+// @Summary Create a page preview link
+// @Description Sign a link showing a page, even a draft, to anyone holding it, e.g. to have it reviewed before publishing. The link works until it expires or is revoked.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Param preview body PreviewCreate false "Expiry of the link"
+// @Success 200 {object} response.APIResponse{data=PreviewLink} "Page preview created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /admin/pages/{id}/previews [post]
+func (h *PageHandler) CreatePreview(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var previewInput PreviewCreate
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &previewInput); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+	}
+
+	link, err := h.pageService.CreatePreview(c.Request.Context(), pageID, &previewInput, c.GetString("email"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, link, "Page preview created successfully")
+}
+
+// ListPreviews retrieves the preview links of a page
+// @Summary List page preview links
+// @Description Retrieve the preview links of a page, newest first, with the links of those still working
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Success 200 {object} response.APIResponse{data=[]PreviewLink} "Page previews retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page not found"
+// @Router /admin/pages/{id}/previews [get]
+func (h *PageHandler) ListPreviews(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	links, err := h.pageService.ListPreviews(c.Request.Context(), pageID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, links, "Page previews retrieved successfully")
+}
+
+// RevokePreview disables a preview link
+// @Summary Revoke a page preview link
+// @Description Stop a preview link from working before it expires
+// @Tags Pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Page ID"
+// @Param preview path string true "Preview ID"
+// @Success 200 {object} response.APIResponse "Page preview revoked successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Page preview not found"
+// @Router /admin/pages/{id}/previews/{preview} [delete]
+func (h *PageHandler) RevokePreview(c *gin.Context) {
+	pageID := c.Param("id")
+	if _, err := h.ValidateUUID(pageID, "Page ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	previewID := c.Param("preview")
+	if _, err := h.ValidateUUID(previewID, "Preview ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.pageService.RevokePreview(c.Request.Context(), pageID, previewID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Page preview revoked successfully")
+}
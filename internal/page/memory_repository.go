@@ -0,0 +1,168 @@
+package page
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+type memoryPageRepository struct {
+	*base.MemoryRepository[Page, Page]
+}
+
+// NewMemoryPageRepository creates a page repository kept in memory, for
+// development without a database
+func NewMemoryPageRepository() PageRepository {
+	return &memoryPageRepository{
+		MemoryRepository: base.NewMemoryRepository([]Page{},
+			func(p *Page) string { return p.ID.String() },
+			func(p Page) Page { return p },
+			"slug", "title",
+		),
+	}
+}
+
+func (r *memoryPageRepository) FindByID(ctx context.Context, id string) (*Page, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *memoryPageRepository) FindBySlug(ctx context.Context, slug string) (*Page, error) {
+	return r.findOne(ctx, "slug", slug)
+}
+
+func (r *memoryPageRepository) List(ctx context.Context, opts base.ListOptions) ([]Page, error) {
+	if opts.SortBy == "" {
+		opts.SortBy, opts.SortOrder = "slug", base.SortAscending
+	}
+	return r.MemoryRepository.List(ctx, opts)
+}
+
+func (r *memoryPageRepository) findOne(ctx context.Context, field, value string) (*Page, error) {
+	pages, err := r.FindByField(ctx, field, value)
+	if err != nil || len(pages) == 0 {
+		return nil, err
+	}
+	return &pages[0], nil
+}
+
+type memoryRevisionRepository struct {
+	revisions *base.MemoryRepository[Revision, Revision]
+}
+
+// NewMemoryRevisionRepository creates a page revision repository kept in
+// memory, for development without a database
+func NewMemoryRevisionRepository() RevisionRepository {
+	return &memoryRevisionRepository{
+		revisions: base.NewMemoryRepository([]Revision{},
+			func(r *Revision) string { return r.ID.String() },
+			func(r Revision) Revision { return r },
+		),
+	}
+}
+
+func (r *memoryRevisionRepository) Create(ctx context.Context, revision *Revision) error {
+	_, err := r.revisions.Create(ctx, revision)
+	return err
+}
+
+func (r *memoryRevisionRepository) ListByPage(ctx context.Context, pageID string, opts base.ListOptions) ([]Revision, error) {
+	opts.Filters = []base.FilterOption{{Field: "page_id", Operator: base.OperatorEqual, Value: pageID}}
+	opts.SortBy, opts.SortOrder = "revision", base.SortDescending
+	return r.revisions.List(ctx, opts)
+}
+
+func (r *memoryRevisionRepository) CountByPage(ctx context.Context, pageID string) (int, error) {
+	return r.revisions.Count(ctx, []base.FilterOption{{Field: "page_id", Operator: base.OperatorEqual, Value: pageID}})
+}
+
+func (r *memoryRevisionRepository) FindByNumber(ctx context.Context, pageID string, number int) (*Revision, error) {
+	revisions, err := r.revisions.List(ctx, base.ListOptions{
+		Page:    1,
+		PerPage: 1,
+		Filters: []base.FilterOption{
+			{Field: "page_id", Operator: base.OperatorEqual, Value: pageID},
+			{Field: "revision", Operator: base.OperatorEqual, Value: strconv.Itoa(number)},
+		},
+	})
+	if err != nil || len(revisions) == 0 {
+		return nil, err
+	}
+	return &revisions[0], nil
+}
+
+func (r *memoryRevisionRepository) DeleteByPage(ctx context.Context, pageID string) error {
+	revisions, err := r.revisions.FindByField(ctx, "page_id", pageID)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		if err := r.revisions.Delete(ctx, revision.ID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type memoryPreviewRepository struct {
+	previews *base.MemoryRepository[Preview, Preview]
+}
+
+// NewMemoryPreviewRepository creates a page preview repository kept in
+// memory, for development without a database
+func NewMemoryPreviewRepository() PreviewRepository {
+	return &memoryPreviewRepository{
+		previews: base.NewMemoryRepository([]Preview{},
+			func(p *Preview) string { return p.ID.String() },
+			func(p Preview) Preview { return p },
+		),
+	}
+}
+
+func (r *memoryPreviewRepository) Create(ctx context.Context, preview *Preview) error {
+	_, err := r.previews.Create(ctx, preview)
+	return err
+}
+
+func (r *memoryPreviewRepository) FindByID(ctx context.Context, id string) (*Preview, error) {
+	previews, err := r.previews.FindByField(ctx, "id", id)
+	if err != nil || len(previews) == 0 {
+		return nil, err
+	}
+	return &previews[0], nil
+}
+
+func (r *memoryPreviewRepository) ListByPage(ctx context.Context, pageID string) ([]Preview, error) {
+	count, err := r.previews.Count(ctx, nil)
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	return r.previews.List(ctx, base.ListOptions{
+		Page:      1,
+		PerPage:   count,
+		SortBy:    "created_at",
+		SortOrder: base.SortDescending,
+		Filters:   []base.FilterOption{{Field: "page_id", Operator: base.OperatorEqual, Value: pageID}},
+	})
+}
+
+func (r *memoryPreviewRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	r.previews.Modify(ctx, id, func(p *Preview) {
+		p.RevokedAt = &revokedAt
+	})
+	return nil
+}
+
+func (r *memoryPreviewRepository) DeleteByPage(ctx context.Context, pageID string) error {
+	previews, err := r.previews.FindByField(ctx, "page_id", pageID)
+	if err != nil {
+		return err
+	}
+	for _, preview := range previews {
+		if err := r.previews.Delete(ctx, preview.ID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package page
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// Page is a standalone content page such as the privacy policy, terms or
+// imprint
+// @Description Standalone content page such as the privacy policy, terms or imprint
+// @Name Page
+type Page struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Slug     string     `json:"slug" db:"slug" example:"privacy-policy"`
+	Title    string     `json:"title" db:"title" example:"Privacy Policy"`
+
+	// Body is Markdown; BodyHTML is rendered from it on save
+	Body     string `json:"body" db:"body" example:"We only collect what is needed to answer your **inquiry**."`
+	BodyHTML string `json:"body_html" db:"body_html" example:"<p>We only collect what is needed to answer your <strong>inquiry</strong>.</p>"`
+
+	// Published pages are served publicly; drafts only to admins
+	Published bool `json:"published" db:"published" example:"true"`
+
+	// Revision is the number of the latest revision of the page
+	Revision int `json:"revision" db:"revision" example:"3"`
+
+	// Search engine directives
+	SEO base.SEO `json:"seo" db:"seo"`
+
+	// Reading statistics of the body, computed on save. ReadingTime is in
+	// minutes.
+	WordCount   int `json:"word_count" db:"word_count" example:"420"`
+	ReadingTime int `json:"reading_time" db:"reading_time" example:"3"`
+
+	// Table of contents of the body, computed on save
+	TOC []markdown.Heading `json:"toc" db:"toc"`
+
+	PublishedAt *time.Time `json:"published_at,omitempty" db:"published_at"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// Revision is a saved version of a page
+// @Description Saved version of a page
+// @Name PageRevision
+type Revision struct {
+	ID        uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID  *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	PageID    uuid.UUID  `json:"page_id" db:"page_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Revision  int        `json:"revision" db:"revision" example:"3"`
+	Title     string     `json:"title" db:"title" example:"Privacy Policy"`
+	Body      string     `json:"body" db:"body" example:"We only collect what is needed to answer your **inquiry**."`
+	Published bool       `json:"published" db:"published" example:"true"`
+	Note      string     `json:"note,omitempty" db:"note" example:"Mention the analytics provider"`
+	EditedBy  string     `json:"edited_by,omitempty" db:"edited_by" example:"admin@example.com"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// PageCreate is the input for creating a page
+// @Name PageCreate
+type PageCreate struct {
+	Slug      string   `json:"slug" validate:"required,max=100" example:"privacy-policy"`
+	Title     string   `json:"title" validate:"required,max=255" example:"Privacy Policy"`
+	Body      string   `json:"body" validate:"required,max=100000" example:"We only collect what is needed to answer your **inquiry**."`
+	Published bool     `json:"published" example:"false"`
+	SEO       base.SEO `json:"seo"`
+}
+
+// PageUpdate is the input for editing a page. Every edit is saved as a new
+// revision.
+// @Name PageUpdate
+type PageUpdate struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug      string    `json:"slug" validate:"max=100" example:"privacy-policy"`
+	Title     string    `json:"title" validate:"max=255" example:"Privacy Policy"`
+	Body      string    `json:"body" validate:"max=100000" example:"We only collect what is needed to answer your **inquiry**."`
+	Published *bool     `json:"published" example:"true"`
+	// SEO replaces the search engine directives when present
+	SEO *base.SEO `json:"seo"`
+	// Note describes the change in the revision history
+	Note string `json:"note" validate:"max=500" example:"Mention the analytics provider"`
+}
+
+// RevisionRestore is the input for restoring an earlier revision of a page
+// @Name PageRevisionRestore
+type RevisionRestore struct {
+	Note string `json:"note" validate:"max=500" example:"Undo the analytics change"`
+}
+
+// Preview is a link giving read access to a page, including drafts, until
+// it expires or is revoked
+// @Description Link giving read access to a page, including drafts
+// @Name PagePreview
+type Preview struct {
+	ID        uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID  *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	PageID    uuid.UUID  `json:"page_id" db:"page_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Note      string     `json:"note,omitempty" db:"note" example:"For review by the legal team"`
+	CreatedBy string     `json:"created_by,omitempty" db:"created_by" example:"admin@example.com"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Active reports whether the preview link still works
+func (p *Preview) Active(now time.Time) bool {
+	return p.RevokedAt == nil && now.Before(p.ExpiresAt)
+}
+
+// PreviewCreate is the input for sharing a page for review
+// @Name PagePreviewCreate
+type PreviewCreate struct {
+	// ExpiresInHours is how long the link works; the configured default when 0
+	ExpiresInHours int    `json:"expires_in_hours" validate:"min=0,max=720" example:"72"`
+	Note           string `json:"note" validate:"max=255" example:"For review by the legal team"`
+}
+
+// PreviewLink is a preview with its signed link, which is left out once
+// the preview expires or is revoked
+// @Description Page preview with its signed link
+// @Name PagePreviewLink
+type PreviewLink struct {
+	Preview
+	Token string `json:"token,omitempty" example:"550e8400-e29b-41d4-a716-446655440000.1735689600.9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	URL   string `json:"url,omitempty" example:"https://itsrama.dev/preview?token=550e8400-e29b-41d4-a716-446655440000.1735689600.9f86d0"`
+}
@@ -0,0 +1,72 @@
+package page
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/signedlink"
+)
+
+// PreviewSigner signs the tokens of draft preview links. A token names the
+// preview it was issued for, so revoking the preview disables the link
+// before it expires.
+type PreviewSigner struct {
+	signer  *signedlink.Signer
+	baseURL string
+	ttl     time.Duration
+}
+
+// NewPreviewSigner creates a signer for preview links to baseURL that stay
+// valid for ttl unless another lifetime is given
+func NewPreviewSigner(secret []byte, baseURL string, ttl time.Duration) *PreviewSigner {
+	if ttl <= 0 {
+		ttl = 72 * time.Hour
+	}
+
+	return &PreviewSigner{
+		signer:  signedlink.New(secret, "page-preview"),
+		baseURL: baseURL,
+		ttl:     ttl,
+	}
+}
+
+// expiry returns when a preview created now for ttl expires, or after the
+// default lifetime when ttl is zero
+func (s *PreviewSigner) expiry(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		ttl = s.ttl
+	}
+	return time.Now().UTC().Add(ttl).Truncate(time.Second)
+}
+
+// Sign returns the token and URL of a preview link
+func (s *PreviewSigner) Sign(preview *Preview) (token, link string) {
+	expires, signature := s.signer.Sign(preview.ExpiresAt, preview.ID.String())
+	token = preview.ID.String() + "." + expires + "." + signature
+
+	query := url.Values{}
+	query.Set("token", token)
+
+	separator := "?"
+	if strings.Contains(s.baseURL, "?") {
+		separator = "&"
+	}
+	return token, s.baseURL + separator + query.Encode()
+}
+
+// Verify returns the ID of the preview token was issued for, or false when
+// the token is forged or has expired
+func (s *PreviewSigner) Verify(token string) (uuid.UUID, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(parts[0])
+	if err != nil || !s.signer.Verify(parts[1], parts[2], parts[0]) {
+		return uuid.Nil, false
+	}
+	return id, true
+}
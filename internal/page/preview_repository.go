@@ -0,0 +1,105 @@
+package page
+
+import (
+	"context"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type PreviewRepository interface {
+	Create(ctx context.Context, preview *Preview) error
+	// FindByID returns the preview with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Preview, error)
+	// ListByPage returns the previews of a page, newest first
+	ListByPage(ctx context.Context, pageID string) ([]Preview, error)
+	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+	DeleteByPage(ctx context.Context, pageID string) error
+}
+
+type previewRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewPreviewRepository(supabaseClient *supabase.SupabaseClient) PreviewRepository {
+	return &previewRepository{
+		supabaseClient: supabaseClient,
+		table:          "page_preview",
+	}
+}
+
+func (r *previewRepository) Create(ctx context.Context, preview *Preview) error {
+	preview.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(preview, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to create page preview")
+	}
+	return nil
+}
+
+func (r *previewRepository) FindByID(ctx context.Context, id string) (*Preview, error) {
+	var previews []Preview
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&previews)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find page preview")
+	}
+
+	if len(previews) == 0 {
+		return nil, nil
+	}
+	return &previews[0], nil
+}
+
+func (r *previewRepository) ListByPage(ctx context.Context, pageID string) ([]Preview, error) {
+	var previews []Preview
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("page_id", pageID)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("created_at", &postgrest.OrderOpts{Ascending: false}).
+		ExecuteTo(&previews)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list page previews")
+	}
+	return previews, nil
+}
+
+func (r *previewRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"revoked_at": revokedAt,
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to revoke page preview")
+	}
+	return nil
+}
+
+func (r *previewRepository) DeleteByPage(ctx context.Context, pageID string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("page_id", pageID)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete page previews")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package page
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *pageService) CreatePreview(ctx context.Context, pageID string, previewCreate *PreviewCreate, editor string) (*PreviewLink, error) {
+	// Validate input
+	if err := validator.ValidateModel(previewCreate); err != nil {
+		return nil, err
+	}
+
+	page, err := s.GetPage(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &Preview{
+		ID:        uuid.New(),
+		PageID:    page.ID,
+		Note:      strings.TrimSpace(previewCreate.Note),
+		CreatedBy: editor,
+		ExpiresAt: s.previewSigner.expiry(time.Duration(previewCreate.ExpiresInHours) * time.Hour),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.previewRepo.Create(ctx, preview); err != nil {
+		return nil, err
+	}
+
+	return s.previewLink(*preview), nil
+}
+
+func (s *pageService) ListPreviews(ctx context.Context, pageID string) ([]PreviewLink, error) {
+	if _, err := s.GetPage(ctx, pageID); err != nil {
+		return nil, err
+	}
+
+	previews, err := s.previewRepo.ListByPage(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]PreviewLink, len(previews))
+	for i, preview := range previews {
+		links[i] = *s.previewLink(preview)
+	}
+	return links, nil
+}
+
+func (s *pageService) RevokePreview(ctx context.Context, pageID, previewID string) error {
+	preview, err := s.previewRepo.FindByID(ctx, previewID)
+	if err != nil {
+		return err
+	}
+	if preview == nil || preview.PageID.String() != pageID {
+		return errors.New(
+			errors.ErrNotFound,
+			"Page preview not found",
+			nil,
+			errors.WithContext("page_id", pageID),
+			errors.WithContext("preview_id", previewID),
+		)
+	}
+	if preview.RevokedAt != nil {
+		return nil
+	}
+
+	return s.previewRepo.Revoke(ctx, previewID, time.Now().UTC())
+}
+
+func (s *pageService) GetPreviewPage(ctx context.Context, token string) (*Page, error) {
+	// Expired, revoked and forged links all look the same to the visitor
+	invalid := errors.New(
+		errors.ErrForbidden,
+		"Preview link is invalid or has expired",
+		nil,
+	)
+
+	previewID, ok := s.previewSigner.Verify(token)
+	if !ok {
+		return nil, invalid
+	}
+
+	preview, err := s.previewRepo.FindByID(ctx, previewID.String())
+	if err != nil {
+		return nil, err
+	}
+	if preview == nil || !preview.Active(time.Now()) {
+		return nil, invalid
+	}
+
+	page, err := s.pageRepo.FindByID(ctx, preview.PageID.String())
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, invalid
+	}
+	return page, nil
+}
+
+// previewLink signs the link of a preview. Links are derived from the
+// preview, so they can be shown again until it expires.
+func (s *pageService) previewLink(preview Preview) *PreviewLink {
+	link := &PreviewLink{Preview: preview}
+	if preview.Active(time.Now()) {
+		link.Token, link.URL = s.previewSigner.Sign(&preview)
+	}
+	return link
+}
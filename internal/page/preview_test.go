@@ -0,0 +1,46 @@
+package page
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPreviewSignerVerify(t *testing.T) {
+	signer := NewPreviewSigner([]byte("secret"), "https://example.com/preview", time.Hour)
+	preview := &Preview{ID: uuid.New(), ExpiresAt: signer.expiry(0)}
+	token, _ := signer.Sign(preview)
+	parts := strings.Split(token, ".")
+
+	expiredToken, _ := signer.Sign(&Preview{ID: preview.ID, ExpiresAt: time.Now().Add(-time.Minute)})
+
+	tests := []struct {
+		name   string
+		signer *PreviewSigner
+		token  string
+		wantOK bool
+	}{
+		{"valid", signer, token, true},
+		{"expired", signer, expiredToken, false},
+		{"other preview", signer, uuid.NewString() + "." + parts[1] + "." + parts[2], false},
+		{"tampered signature", signer, parts[0] + "." + parts[1] + "." + strings.Repeat("0", len(parts[2])), false},
+		{"extended expiry", signer, parts[0] + ".99999999999." + parts[2], false},
+		{"malformed ID", signer, "preview." + parts[1] + "." + parts[2], false},
+		{"missing part", signer, parts[0] + "." + parts[1], false},
+		{"other secret", NewPreviewSigner([]byte("other"), "https://example.com/preview", time.Hour), token, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := tt.signer.Verify(tt.token)
+			if ok != tt.wantOK {
+				t.Fatalf("Verify() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && id != preview.ID {
+				t.Errorf("Verify() id = %s, want %s", id, preview.ID)
+			}
+		})
+	}
+}
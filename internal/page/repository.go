@@ -0,0 +1,158 @@
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type PageRepository interface {
+	Create(ctx context.Context, page *Page) (*Page, error)
+	Update(ctx context.Context, page *Page) (*Page, error)
+	Delete(ctx context.Context, id string) error
+	// FindByID returns the page with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Page, error)
+	// FindBySlug returns the page with the given slug, or nil if none
+	FindBySlug(ctx context.Context, slug string) (*Page, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Page, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type pageRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewPageRepository(supabaseClient *supabase.SupabaseClient) PageRepository {
+	return &pageRepository{
+		supabaseClient: supabaseClient,
+		table:          "page",
+	}
+}
+
+func (r *pageRepository) Create(ctx context.Context, page *Page) (*Page, error) {
+	page.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(page, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create page")
+	}
+	return page, nil
+}
+
+func (r *pageRepository) Update(ctx context.Context, page *Page) (*Page, error) {
+	page.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(page, "minimal", "").
+		Eq("id", page.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update page")
+	}
+	return page, nil
+}
+
+func (r *pageRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete page")
+	}
+	return nil
+}
+
+func (r *pageRepository) FindByID(ctx context.Context, id string) (*Page, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *pageRepository) FindBySlug(ctx context.Context, slug string) (*Page, error) {
+	return r.findOne(ctx, "slug", slug)
+}
+
+func (r *pageRepository) List(ctx context.Context, opts base.ListOptions) ([]Page, error) {
+	var pages []Page
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, by slug by default
+	sortBy, ascending := "slug", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&pages)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list pages")
+	}
+
+	return pages, nil
+}
+
+func (r *pageRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count pages")
+	}
+
+	return int(count), nil
+}
+
+func (r *pageRepository) findOne(ctx context.Context, field, value string) (*Page, error) {
+	var pages []Page
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&pages)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find page")
+	}
+
+	if len(pages) == 0 {
+		return nil, nil
+	}
+	return &pages[0], nil
+}
@@ -0,0 +1,109 @@
+package page
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type RevisionRepository interface {
+	Create(ctx context.Context, revision *Revision) error
+	// ListByPage returns the revisions of a page, newest first
+	ListByPage(ctx context.Context, pageID string, opts base.ListOptions) ([]Revision, error)
+	CountByPage(ctx context.Context, pageID string) (int, error)
+	// FindByNumber returns the revision of a page with the given number, or
+	// nil if none
+	FindByNumber(ctx context.Context, pageID string, number int) (*Revision, error)
+	DeleteByPage(ctx context.Context, pageID string) error
+}
+
+type revisionRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewRevisionRepository(supabaseClient *supabase.SupabaseClient) RevisionRepository {
+	return &revisionRepository{
+		supabaseClient: supabaseClient,
+		table:          "page_revision",
+	}
+}
+
+func (r *revisionRepository) Create(ctx context.Context, revision *Revision) error {
+	revision.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(revision, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record page revision")
+	}
+	return nil
+}
+
+func (r *revisionRepository) ListByPage(ctx context.Context, pageID string, opts base.ListOptions) ([]Revision, error) {
+	var revisions []Revision
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("page_id", pageID)
+	query = base.ScopeToTenant(ctx, query)
+
+	offset := (opts.Page - 1) * opts.PerPage
+	_, err := query.
+		Order("revision", &postgrest.OrderOpts{Ascending: false}).
+		Range(offset, offset+opts.PerPage-1, "").
+		ExecuteTo(&revisions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list page revisions")
+	}
+	return revisions, nil
+}
+
+func (r *revisionRepository) CountByPage(ctx context.Context, pageID string) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("page_id", pageID)
+
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count page revisions")
+	}
+	return int(count), nil
+}
+
+func (r *revisionRepository) FindByNumber(ctx context.Context, pageID string, number int) (*Revision, error) {
+	var revisions []Revision
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("page_id", pageID).
+		Eq("revision", strconv.Itoa(number))
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&revisions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find page revision")
+	}
+
+	if len(revisions) == 0 {
+		return nil, nil
+	}
+	return &revisions[0], nil
+}
+
+func (r *revisionRepository) DeleteByPage(ctx context.Context, pageID string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("page_id", pageID)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete page revisions")
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// slugPattern is what a page slug may look like, e.g. "privacy-policy"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type PageService interface {
+	// GetPublishedPage returns a published page by its slug
+	GetPublishedPage(ctx context.Context, slug string) (*Page, error)
+	GetPage(ctx context.Context, id string) (*Page, error)
+	// CreatePage creates a page and its first revision, edited by editor
+	CreatePage(ctx context.Context, pageCreate *PageCreate, editor string) (*Page, error)
+	// UpdatePage edits a page, saving the result as a new revision
+	UpdatePage(ctx context.Context, pageUpdate *PageUpdate, editor string) (*Page, error)
+	DeletePage(ctx context.Context, id string) error
+	ListPages(ctx context.Context, opts base.ListOptions) ([]Page, error)
+	CountPages(ctx context.Context, filters []base.FilterOption) (int, error)
+	ListRevisions(ctx context.Context, pageID string, opts base.ListOptions) ([]Revision, error)
+	CountRevisions(ctx context.Context, pageID string) (int, error)
+	// RestoreRevision brings back the title and body of an earlier revision
+	// as a new revision
+	RestoreRevision(ctx context.Context, pageID string, number int, restore *RevisionRestore, editor string) (*Page, error)
+	// RecomputeReadingTimes recomputes the reading statistics of every page
+	// and returns how many changed
+	RecomputeReadingTimes(ctx context.Context) (int, error)
+	// RebuildTOCs rebuilds the table of contents of every page and returns
+	// how many changed
+	RebuildTOCs(ctx context.Context) (int, error)
+	// CreatePreview signs a link showing a page, even a draft, to anyone
+	// holding it until it expires or is revoked
+	CreatePreview(ctx context.Context, pageID string, previewCreate *PreviewCreate, editor string) (*PreviewLink, error)
+	// ListPreviews returns the preview links of a page, newest first
+	ListPreviews(ctx context.Context, pageID string) ([]PreviewLink, error)
+	RevokePreview(ctx context.Context, pageID, previewID string) error
+	// GetPreviewPage returns the page a preview token was issued for
+	GetPreviewPage(ctx context.Context, token string) (*Page, error)
+}
+
+type pageService struct {
+	pageRepo      PageRepository
+	revisionRepo  RevisionRepository
+	previewRepo   PreviewRepository
+	previewSigner *PreviewSigner
+	publisher     events.Publisher
+}
+
+func NewPageService(pageRepo PageRepository, revisionRepo RevisionRepository, previewRepo PreviewRepository, previewSigner *PreviewSigner, publisher events.Publisher) PageService {
+	return &pageService{
+		pageRepo:      pageRepo,
+		revisionRepo:  revisionRepo,
+		previewRepo:   previewRepo,
+		previewSigner: previewSigner,
+		publisher:     publisher,
+	}
+}
+
+func (s *pageService) GetPublishedPage(ctx context.Context, slug string) (*Page, error) {
+	page, err := s.pageRepo.FindBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, err
+	}
+	// Drafts are indistinguishable from missing pages to visitors
+	if page == nil || !page.Published {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Page not found",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+	return page, nil
+}
+
+func (s *pageService) GetPage(ctx context.Context, id string) (*Page, error) {
+	page, err := s.pageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Page not found",
+			nil,
+			errors.WithContext("page_id", id),
+		)
+	}
+	return page, nil
+}
+
+func (s *pageService) CreatePage(ctx context.Context, pageCreate *PageCreate, editor string) (*Page, error) {
+	// Validate input
+	if err := validator.ValidateModel(pageCreate); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.checkSlug(ctx, pageCreate.Slug, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := pageCreate.SEO.Normalize(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	body := strings.TrimSpace(pageCreate.Body)
+	page := &Page{
+		ID:        uuid.New(),
+		Slug:      slug,
+		Title:     strings.TrimSpace(pageCreate.Title),
+		Body:      body,
+		BodyHTML:  markdown.Render(body),
+		Published: pageCreate.Published,
+		Revision:  1,
+		SEO:       pageCreate.SEO,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+	if page.Published {
+		page.PublishedAt = &now
+	}
+	page.WordCount, page.ReadingTime = readingStats(body)
+	page.TOC = markdown.TableOfContents(body)
+
+	if _, err := s.pageRepo.Create(ctx, page); err != nil {
+		return nil, err
+	}
+	if err := s.record(ctx, page, "Created", editor, now); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, page, false, events.Event{
+		Type:     events.PageCreated,
+		Entity:   "page",
+		EntityID: page.ID.String(),
+		Summary:  fmt.Sprintf("Created page %s", page.Title),
+	})
+
+	return page, nil
+}
+
+func (s *pageService) UpdatePage(ctx context.Context, pageUpdate *PageUpdate, editor string) (*Page, error) {
+	// Validate input
+	if err := validator.ValidateModel(pageUpdate); err != nil {
+		return nil, err
+	}
+
+	page, err := s.GetPage(ctx, pageUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	wasPublished := page.Published
+
+	if strings.TrimSpace(pageUpdate.Slug) != "" {
+		slug, err := s.checkSlug(ctx, pageUpdate.Slug, page.ID)
+		if err != nil {
+			return nil, err
+		}
+		page.Slug = slug
+	}
+	if title := strings.TrimSpace(pageUpdate.Title); title != "" {
+		page.Title = title
+	}
+	if body := strings.TrimSpace(pageUpdate.Body); body != "" {
+		page.Body = body
+		page.BodyHTML = markdown.Render(body)
+	}
+	if pageUpdate.Published != nil {
+		page.Published = *pageUpdate.Published
+	}
+	if pageUpdate.SEO != nil {
+		if err := pageUpdate.SEO.Normalize(); err != nil {
+			return nil, err
+		}
+		page.SEO = *pageUpdate.SEO
+	}
+
+	return s.save(ctx, page, wasPublished, pageUpdate.Note, editor)
+}
+
+func (s *pageService) DeletePage(ctx context.Context, id string) error {
+	page, err := s.GetPage(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.revisionRepo.DeleteByPage(ctx, id); err != nil {
+		return err
+	}
+	if err := s.previewRepo.DeleteByPage(ctx, id); err != nil {
+		return err
+	}
+	if err := s.pageRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.publish(ctx, page, false, events.Event{
+		Type:     events.PageDeleted,
+		Entity:   "page",
+		EntityID: page.ID.String(),
+		Summary:  fmt.Sprintf("Deleted page %s", page.Title),
+	})
+
+	return nil
+}
+
+func (s *pageService) ListPages(ctx context.Context, opts base.ListOptions) ([]Page, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.pageRepo.List(ctx, opts)
+}
+
+func (s *pageService) CountPages(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.pageRepo.Count(ctx, filters)
+}
+
+func (s *pageService) ListRevisions(ctx context.Context, pageID string, opts base.ListOptions) ([]Revision, error) {
+	if _, err := s.GetPage(ctx, pageID); err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.ListByPage(ctx, pageID, opts)
+}
+
+func (s *pageService) CountRevisions(ctx context.Context, pageID string) (int, error) {
+	return s.revisionRepo.CountByPage(ctx, pageID)
+}
+
+func (s *pageService) RestoreRevision(ctx context.Context, pageID string, number int, restore *RevisionRestore, editor string) (*Page, error) {
+	// Validate input
+	if err := validator.ValidateModel(restore); err != nil {
+		return nil, err
+	}
+
+	page, err := s.GetPage(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.revisionRepo.FindByNumber(ctx, pageID, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Page revision not found",
+			nil,
+			errors.WithContext("page_id", pageID),
+			errors.WithContext("revision", number),
+		)
+	}
+
+	// Whether the page is published is left as it is, so restoring an old
+	// text never takes a page offline or publishes a draft
+	page.Title = revision.Title
+	page.Body = revision.Body
+	page.BodyHTML = markdown.Render(revision.Body)
+
+	note := strings.TrimSpace(restore.Note)
+	if note == "" {
+		note = "Restored revision " + strconv.Itoa(number)
+	}
+	return s.save(ctx, page, page.Published, note, editor)
+}
+
+// save writes an edited page as its next revision. wasPublished tells
+// whether the page was published before the edit.
+func (s *pageService) save(ctx context.Context, page *Page, wasPublished bool, note, editor string) (*Page, error) {
+	now := time.Now().UTC()
+	page.Revision++
+	page.UpdatedAt = &now
+	if page.Published && page.PublishedAt == nil {
+		page.PublishedAt = &now
+	}
+	page.WordCount, page.ReadingTime = readingStats(page.Body)
+	page.TOC = markdown.TableOfContents(page.Body)
+
+	if _, err := s.pageRepo.Update(ctx, page); err != nil {
+		return nil, err
+	}
+	if err := s.record(ctx, page, note, editor, now); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, page, wasPublished, events.Event{
+		Type:     events.PageUpdated,
+		Entity:   "page",
+		EntityID: page.ID.String(),
+		Summary:  fmt.Sprintf("Updated page %s", page.Title),
+	})
+
+	return page, nil
+}
+
+// RecomputeReadingTimes rewrites the reading statistics in place, without
+// recording a revision, as the text of the pages does not change
+func (s *pageService) RecomputeReadingTimes(ctx context.Context) (int, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	updated := 0
+	for {
+		pages, err := s.pageRepo.List(ctx, opts)
+		if err != nil {
+			return updated, err
+		}
+
+		for i := range pages {
+			page := &pages[i]
+			wordCount, readingTime := readingStats(page.Body)
+			if wordCount == page.WordCount && readingTime == page.ReadingTime {
+				continue
+			}
+			page.WordCount, page.ReadingTime = wordCount, readingTime
+			if _, err := s.pageRepo.Update(ctx, page); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(pages) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	return updated, nil
+}
+
+// RebuildTOCs rewrites the tables of contents in place, without recording a
+// revision, as the text of the pages does not change
+func (s *pageService) RebuildTOCs(ctx context.Context) (int, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	updated := 0
+	for {
+		pages, err := s.pageRepo.List(ctx, opts)
+		if err != nil {
+			return updated, err
+		}
+
+		for i := range pages {
+			page := &pages[i]
+			toc := markdown.TableOfContents(page.Body)
+			if reflect.DeepEqual(toc, page.TOC) {
+				continue
+			}
+			page.TOC = toc
+			if _, err := s.pageRepo.Update(ctx, page); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(pages) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	return updated, nil
+}
+
+// readingStats counts the words of a page body and estimates their reading
+// time
+func readingStats(body string) (wordCount, readingTime int) {
+	wordCount = markdown.WordCount(body)
+	return wordCount, markdown.ReadingTime(wordCount)
+}
+
+// publish announces a change to a page unless it is a draft both before and
+// after the change, so unpublishing a page is announced too
+func (s *pageService) publish(ctx context.Context, page *Page, wasPublished bool, event events.Event) {
+	if !page.Published && !wasPublished {
+		return
+	}
+	s.publisher.Publish(ctx, event)
+}
+
+// record saves the current state of a page to its history
+func (s *pageService) record(ctx context.Context, page *Page, note, editor string, at time.Time) error {
+	return s.revisionRepo.Create(ctx, &Revision{
+		ID:        uuid.New(),
+		PageID:    page.ID,
+		Revision:  page.Revision,
+		Title:     page.Title,
+		Body:      page.Body,
+		Published: page.Published,
+		Note:      strings.TrimSpace(note),
+		EditedBy:  editor,
+		CreatedAt: at,
+	})
+}
+
+// checkSlug normalizes a slug and makes sure no other page than self uses
+// it
+func (s *pageService) checkSlug(ctx context.Context, slug string, self uuid.UUID) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if !slugPattern.MatchString(slug) {
+		return "", errors.New(
+			errors.ErrValidation,
+			"Slug may only contain lowercase letters, digits and single dashes",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+
+	existing, err := s.pageRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && existing.ID != self {
+		return "", errors.New(
+			errors.ErrConflict,
+			"A page with this slug already exists",
+			nil,
+			errors.WithContext("slug", slug),
+		)
+	}
+	return slug, nil
+}
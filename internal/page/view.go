@@ -0,0 +1,52 @@
+package page
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// PublicPage is a page as visitors see it, without its editing state
+// @Description Page as shown publicly
+// @Name PublicPage
+type PublicPage struct {
+	ID          uuid.UUID          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug        string             `json:"slug" example:"privacy-policy"`
+	Title       string             `json:"title" example:"Privacy Policy"`
+	Body        string             `json:"body" example:"We only collect what is needed to answer your **inquiry**."`
+	BodyHTML    string             `json:"body_html" example:"<p>We only collect what is needed to answer your <strong>inquiry</strong>.</p>"`
+	SEO         base.SEO           `json:"seo"`
+	WordCount   int                `json:"word_count" example:"420"`
+	ReadingTime int                `json:"reading_time" example:"3"`
+	TOC         []markdown.Heading `json:"toc"`
+	PublishedAt *time.Time         `json:"published_at,omitempty"`
+	UpdatedAt   *time.Time         `json:"updated_at,omitempty"`
+}
+
+// Public maps a page to what visitors see
+func (p Page) Public() PublicPage {
+	return PublicPage{
+		ID:          p.ID,
+		Slug:        p.Slug,
+		Title:       p.Title,
+		Body:        p.Body,
+		BodyHTML:    p.BodyHTML,
+		SEO:         p.SEO,
+		WordCount:   p.WordCount,
+		ReadingTime: p.ReadingTime,
+		TOC:         p.TOC,
+		PublishedAt: p.PublishedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+// PublicView and AdminView render pages in responses, see response.View
+func (p Page) PublicView() interface{} {
+	return p.Public()
+}
+
+func (p Page) AdminView() interface{} {
+	return p
+}
@@ -0,0 +1,169 @@
+package portal
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// emailKey is the gin context key holding the signed-in client's email
+const emailKey = "portal_email"
+
+type PortalHandler struct {
+	base.BaseHandler
+	portalService PortalService
+}
+
+func NewPortalHandler(portalService PortalService, logger *logger.Logger) *PortalHandler {
+	return &PortalHandler{
+		BaseHandler:   *base.NewBaseHandler(logger),
+		portalService: portalService,
+	}
+}
+
+// RequireSession authenticates portal requests with a session bearer token.
+// Portal sessions are separate from admin JWTs and only grant access to the
+// client's own inquiries.
+func (h *PortalHandler) RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		email, err := h.portalService.Authenticate(c.Request.Context(), bearerToken(c))
+		if err != nil {
+			h.HandleError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(emailKey, email)
+		c.Next()
+	}
+}
+
+// RequestLogin emails a magic link to a client
+// @Summary Request a portal sign-in link
+// @Description Email a single-use sign-in link to the address an inquiry was sent from. The response is the same whether or not the address is known.
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param login body LoginRequest true "Client Email"
+// @Success 200 {object} response.APIResponse "Sign-in link sent if the email is known"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /portal/login [post]
+func (h *PortalHandler) RequestLogin(c *gin.Context) {
+	var loginInput LoginRequest
+	if err := h.ValidateRequest(c, &loginInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.portalService.RequestLogin(c.Request.Context(), &loginInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Sign-in link sent if the email is known")
+}
+
+// CreateSession exchanges a magic link for a session
+// @Summary Sign in to the portal
+// @Description Exchange the token of a sign-in link for a session token, sent as a bearer token to the other portal endpoints. Each link can be used once.
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param session body SessionCreate true "Sign-in Token"
+// @Success 200 {object} response.APIResponse{data=Session} "Signed in successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Sign-in link is invalid or has expired"
+// @Router /portal/session [post]
+func (h *PortalHandler) CreateSession(c *gin.Context) {
+	var sessionInput SessionCreate
+	if err := h.ValidateRequest(c, &sessionInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	session, err := h.portalService.CreateSession(c.Request.Context(), &sessionInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, session, "Signed in successfully")
+}
+
+// EndSession signs the client out
+// @Summary Sign out of the portal
+// @Description End the current portal session
+// @Tags Portal
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Signed out successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /portal/session [delete]
+func (h *PortalHandler) EndSession(c *gin.Context) {
+	if err := h.portalService.EndSession(c.Request.Context(), bearerToken(c)); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Signed out successfully")
+}
+
+// ListInquiries retrieves the signed-in client's inquiries
+// @Summary List my inquiries
+// @Description Retrieve the inquiries sent from the signed-in client's email address, newest first
+// @Tags Portal
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]inquiry.Inquiry} "Inquiries retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /portal/inquiries [get]
+func (h *PortalHandler) ListInquiries(c *gin.Context) {
+	inquiries, err := h.portalService.ListInquiries(c.Request.Context(), c.GetString(emailKey))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, inquiries, "Inquiries retrieved successfully")
+}
+
+// GetInquiry retrieves an inquiry of the signed-in client
+// @Summary Get my inquiry
+// @Description Retrieve an inquiry of the signed-in client with its proposals, including fresh download and acceptance links, and its payments
+// @Tags Portal
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Inquiry ID"
+// @Success 200 {object} response.APIResponse{data=InquiryDetail} "Inquiry retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Inquiry not found"
+// @Router /portal/inquiries/{id} [get]
+func (h *PortalHandler) GetInquiry(c *gin.Context) {
+	inquiryID := c.Param("id")
+	if _, err := h.ValidateUUID(inquiryID, "Inquiry ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	detail, err := h.portalService.GetInquiry(c.Request.Context(), c.GetString(emailKey), inquiryID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, detail, "Inquiry retrieved successfully")
+}
+
+// bearerToken returns the token of the Authorization header, if any
+func bearerToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	if token == authHeader {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,63 @@
+package portal
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/inquiry"
+)
+
+// TokenKind distinguishes emailed magic links from the sessions they open
+type TokenKind string
+
+const (
+	TokenLogin   TokenKind = "login"
+	TokenSession TokenKind = "session"
+)
+
+// Token is a magic link or session of a client, stored by the hash of its value
+type Token struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	TenantID  *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	Email     string     `json:"email" db:"email"`
+	Kind      TokenKind  `json:"kind" db:"kind"`
+	TokenHash string     `json:"token_hash" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+
+	// UsedAt is set once a magic link has been exchanged for a session
+	UsedAt *time.Time `json:"used_at,omitempty" db:"used_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// LoginRequest is the input for requesting a magic link
+// @Name PortalLoginRequest
+type LoginRequest struct {
+	Email string `json:"email" validate:"required,email" example:"jane@example.com"`
+}
+
+// SessionCreate is the input for exchanging a magic link for a session
+// @Name PortalSessionCreate
+type SessionCreate struct {
+	Token string `json:"token" validate:"required" example:"Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"`
+}
+
+// Session is a signed-in portal session, sent as a bearer token
+// @Description Client portal session
+// @Name PortalSession
+type Session struct {
+	Token     string    `json:"token" example:"Zq3x8H2kLm0pQ7sVtW9yA1bC4dE6fG8h"`
+	Email     string    `json:"email" example:"jane@example.com"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// InquiryDetail is an inquiry of the signed-in client with its proposals
+// and payments
+// @Description Client portal view of an inquiry
+// @Name PortalInquiryDetail
+type InquiryDetail struct {
+	inquiry.Inquiry
+	Proposals []inquiry.ProposalLink `json:"proposals"`
+	Payments  []inquiry.Payment      `json:"payments"`
+}
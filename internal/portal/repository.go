@@ -0,0 +1,95 @@
+package portal
+
+import (
+	"context"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type TokenRepository interface {
+	Create(ctx context.Context, token *Token) (*Token, error)
+	FindByHash(ctx context.Context, kind TokenKind, tokenHash string) (*Token, error)
+	// MarkUsed claims an unused token, reporting false if it was used already
+	MarkUsed(ctx context.Context, id string) (bool, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type tokenRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewTokenRepository(supabaseClient *supabase.SupabaseClient) TokenRepository {
+	return &tokenRepository{
+		supabaseClient: supabaseClient,
+		table:          "portal_token",
+	}
+}
+
+func (r *tokenRepository) Create(ctx context.Context, token *Token) (*Token, error) {
+	token.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(token, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create portal token")
+	}
+	return token, nil
+}
+
+// FindByHash returns the token of a kind with the given hash, or nil if none
+func (r *tokenRepository) FindByHash(ctx context.Context, kind TokenKind, tokenHash string) (*Token, error) {
+	var tokens []Token
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("kind", string(kind)).
+		Eq("token_hash", tokenHash)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&tokens)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find portal token")
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	return &tokens[0], nil
+}
+
+// MarkUsed sets used_at only while it is still empty, so that two requests
+// racing to exchange the same magic link cannot both succeed
+func (r *tokenRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	var tokens []Token
+	now := time.Now().UTC()
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"used_at":    now,
+			"updated_at": now,
+		}, "representation", "").
+		Eq("id", id).
+		Is("used_at", "null")
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&tokens)
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to use portal token")
+	}
+	return len(tokens) > 0, nil
+}
+
+func (r *tokenRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete portal token")
+	}
+	return nil
+}
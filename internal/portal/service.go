@@ -0,0 +1,285 @@
+package portal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/inquiry"
+	"github.com/holycann/itsrama-portfolio-backend/internal/mail"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/mailer"
+)
+
+type PortalService interface {
+	// RequestLogin emails a magic link to an address that has sent inquiries.
+	// It succeeds either way so that callers cannot probe for clients.
+	RequestLogin(ctx context.Context, loginRequest *LoginRequest) error
+	// CreateSession exchanges a magic link token for a session
+	CreateSession(ctx context.Context, sessionCreate *SessionCreate) (*Session, error)
+	// Authenticate returns the email address a session token belongs to
+	Authenticate(ctx context.Context, token string) (string, error)
+	// EndSession signs a session out
+	EndSession(ctx context.Context, token string) error
+
+	ListInquiries(ctx context.Context, email string) ([]inquiry.Inquiry, error)
+	GetInquiry(ctx context.Context, email, inquiryID string) (*InquiryDetail, error)
+}
+
+type portalService struct {
+	tokenRepo         TokenRepository
+	inquiryService    inquiry.InquiryService
+	proposalService   inquiry.ProposalService
+	paymentService    inquiry.PaymentService
+	siteConfigService site_config.SiteConfigService
+	mailService       mail.MailService
+	loginURL          string
+	linkTTL           time.Duration
+	sessionTTL        time.Duration
+}
+
+func NewPortalService(
+	tokenRepo TokenRepository,
+	inquiryService inquiry.InquiryService,
+	proposalService inquiry.ProposalService,
+	paymentService inquiry.PaymentService,
+	siteConfigService site_config.SiteConfigService,
+	mailService mail.MailService,
+	loginURL string,
+	linkTTL time.Duration,
+	sessionTTL time.Duration,
+) PortalService {
+	if linkTTL <= 0 {
+		linkTTL = 15 * time.Minute
+	}
+	if sessionTTL <= 0 {
+		sessionTTL = 24 * time.Hour
+	}
+
+	return &portalService{
+		tokenRepo:         tokenRepo,
+		inquiryService:    inquiryService,
+		proposalService:   proposalService,
+		paymentService:    paymentService,
+		siteConfigService: siteConfigService,
+		mailService:       mailService,
+		loginURL:          loginURL,
+		linkTTL:           linkTTL,
+		sessionTTL:        sessionTTL,
+	}
+}
+
+func (s *portalService) RequestLogin(ctx context.Context, loginRequest *LoginRequest) error {
+	// Validate input
+	if err := validator.ValidateModel(loginRequest); err != nil {
+		return err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(loginRequest.Email))
+	inquiries, err := s.inquiryService.ListInquiriesByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if len(inquiries) == 0 {
+		return nil
+	}
+
+	value, _, err := s.issue(ctx, email, TokenLogin, s.linkTTL)
+	if err != nil {
+		return err
+	}
+
+	loginURL, err := url.Parse(s.loginURL)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrConfiguration, "Invalid portal login URL")
+	}
+	query := loginURL.Query()
+	query.Set("token", value)
+	loginURL.RawQuery = query.Encode()
+
+	siteName := site_config.DefaultSiteConfig().SEO.Title
+	if siteConfig, err := s.siteConfigService.GetSiteConfig(ctx); err == nil {
+		siteName = siteConfig.SEO.Title
+	}
+
+	_, err = s.mailService.Enqueue(ctx, mailer.TemplatePortalLogin, []string{inquiries[0].Email}, "", mailer.PortalLoginData{
+		SiteName:  siteName,
+		Name:      inquiries[0].Name,
+		LoginURL:  loginURL.String(),
+		ExpiresIn: formatDuration(s.linkTTL),
+	})
+	return err
+}
+
+func (s *portalService) CreateSession(ctx context.Context, sessionCreate *SessionCreate) (*Session, error) {
+	// Validate input
+	if err := validator.ValidateModel(sessionCreate); err != nil {
+		return nil, err
+	}
+
+	login, err := s.tokenRepo.FindByHash(ctx, TokenLogin, hashToken(sessionCreate.Token))
+	if err != nil {
+		return nil, err
+	}
+	if login == nil || login.UsedAt != nil || time.Now().UTC().After(login.ExpiresAt) {
+		return nil, errInvalidLink()
+	}
+
+	claimed, err := s.tokenRepo.MarkUsed(ctx, login.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errInvalidLink()
+	}
+
+	value, session, err := s.issue(ctx, login.Email, TokenSession, s.sessionTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		Token:     value,
+		Email:     session.Email,
+		ExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+func (s *portalService) Authenticate(ctx context.Context, token string) (string, error) {
+	session, err := s.findSession(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return session.Email, nil
+}
+
+func (s *portalService) EndSession(ctx context.Context, token string) error {
+	session, err := s.findSession(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return s.tokenRepo.Delete(ctx, session.ID.String())
+}
+
+func (s *portalService) ListInquiries(ctx context.Context, email string) ([]inquiry.Inquiry, error) {
+	return s.inquiryService.ListInquiriesByEmail(ctx, email)
+}
+
+func (s *portalService) GetInquiry(ctx context.Context, email, inquiryID string) (*InquiryDetail, error) {
+	found, err := s.inquiryService.GetInquiryByID(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inquiries of other clients are reported as missing
+	if !strings.EqualFold(found.Email, email) {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Inquiry not found",
+			nil,
+			errors.WithContext("inquiry_id", inquiryID),
+		)
+	}
+
+	proposals, err := s.proposalService.ListProposalLinks(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.paymentService.ListPayments(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InquiryDetail{
+		Inquiry:   *found,
+		Proposals: proposals,
+		Payments:  payments,
+	}, nil
+}
+
+// issue stores a new token for email and returns its value, which is only
+// ever handed to the client
+func (s *portalService) issue(ctx context.Context, email string, kind TokenKind, ttl time.Duration) (string, *Token, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, errors.Wrap(err, errors.ErrInternal, "Failed to generate portal token")
+	}
+	value := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now().UTC()
+	token := &Token{
+		ID:        uuid.New(),
+		Email:     email,
+		Kind:      kind,
+		TokenHash: hashToken(value),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	createdToken, err := s.tokenRepo.Create(ctx, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return value, createdToken, nil
+}
+
+func (s *portalService) findSession(ctx context.Context, token string) (*Token, error) {
+	if token == "" {
+		return nil, errInvalidSession()
+	}
+
+	session, err := s.tokenRepo.FindByHash(ctx, TokenSession, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || time.Now().UTC().After(session.ExpiresAt) {
+		return nil, errInvalidSession()
+	}
+
+	return session, nil
+}
+
+func hashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func errInvalidLink() error {
+	return errors.New(errors.ErrUnauthorized, "Sign-in link is invalid or has expired", nil)
+}
+
+func errInvalidSession() error {
+	return errors.New(errors.ErrUnauthorized, "Portal session is invalid or has expired", nil)
+}
+
+// formatDuration renders a link lifetime for emails, e.g. "15 minutes"
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		if hours := int(d / time.Hour); hours != 1 {
+			return fmt.Sprintf("%d hours", hours)
+		}
+		return "1 hour"
+	case d >= time.Minute:
+		if minutes := int(d / time.Minute); minutes != 1 {
+			return fmt.Sprintf("%d minutes", minutes)
+		}
+		return "1 minute"
+	default:
+		return d.String()
+	}
+}
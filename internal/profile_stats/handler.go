@@ -0,0 +1,56 @@
+package profile_stats
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type ProfileStatHandler struct {
+	base.BaseHandler
+	profileStatService ProfileStatService
+}
+
+func NewProfileStatHandler(profileStatService ProfileStatService, logger *logger.Logger) *ProfileStatHandler {
+	return &ProfileStatHandler{
+		BaseHandler:        *base.NewBaseHandler(logger),
+		profileStatService: profileStatService,
+	}
+}
+
+// GetProfileStats retrieves the stats of every external profile
+// @Summary Get external profile stats
+// @Description Retrieve the latest statistics read from GitHub, Stack Overflow, npm and the other configured profiles
+// @Tags Stats
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]ProfileStat} "Profile stats retrieved successfully"
+// @Router /stats/profiles [get]
+func (h *ProfileStatHandler) GetProfileStats(c *gin.Context) {
+	stats, err := h.profileStatService.GetProfileStats(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, stats, "Profile stats retrieved successfully")
+}
+
+// Refresh reads every external profile now
+// @Summary Refresh external profile stats
+// @Description Read every configured profile now. Providers that fail keep their previous stats and are reported in the results.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]RefreshResult} "Profile stats refreshed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "No providers are configured"
+// @Router /admin/stats/profiles/refresh [post]
+func (h *ProfileStatHandler) Refresh(c *gin.Context) {
+	results, err := h.profileStatService.Refresh(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, results, "Profile stats refreshed successfully")
+}
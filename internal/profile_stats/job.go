@@ -0,0 +1,97 @@
+package profile_stats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job periodically refreshes the profile stats of the tenant owning the
+// configured accounts
+type Job struct {
+	profileStatService ProfileStatService
+	resolver           *tenant.Resolver
+	tenantSlug         string
+	interval           time.Duration
+	logger             *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a refresh job running every interval for the tenant with
+// tenantSlug, or the default tenant when empty
+func NewJob(profileStatService ProfileStatService, resolver *tenant.Resolver, tenantSlug string, interval time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = 12 * time.Hour
+	}
+
+	return &Job{
+		profileStatService: profileStatService,
+		resolver:           resolver,
+		tenantSlug:         tenantSlug,
+		interval:           interval,
+		logger:             logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// refresh runs right away in the background.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.run(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run refreshes every provider, logging the ones that failed
+func (j *Job) run(ctx context.Context) {
+	t, err := j.resolver.Resolve(ctx, j.tenantSlug, "")
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to resolve profile stats tenant", "tenant", j.tenantSlug, "error", err)
+		}
+		return
+	}
+
+	results, err := j.profileStatService.Refresh(base.WithTenant(ctx, t.Scope()))
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to refresh profile stats", "tenant", t.Slug, "error", err)
+		}
+		return
+	}
+
+	for _, result := range results {
+		if !result.OK {
+			j.logger.Warn("Failed to refresh profile stats", "tenant", t.Slug, "provider", result.Provider, "error", result.Error)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package profile_stats
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileStat is the latest statistics read from an external profile
+// @Description Public statistics of a developer profile such as GitHub or Stack Overflow
+// @Name ProfileStat
+type ProfileStat struct {
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+
+	Provider   string           `json:"provider" db:"provider" example:"github"`
+	Account    string           `json:"account" db:"account" example:"holycann"`
+	ProfileUrl string           `json:"profile_url,omitempty" db:"profile_url" example:"https://github.com/holycann"`
+	Metrics    map[string]int64 `json:"metrics" db:"metrics"`
+
+	// LastError is the failure of the last refresh; Metrics keep the last good values
+	LastError string `json:"-" db:"last_error"`
+
+	// FetchedAt is when Metrics were last read successfully
+	FetchedAt *time.Time `json:"fetched_at,omitempty" db:"fetched_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// RefreshResult reports the refresh of a single provider
+// @Name ProfileStatRefreshResult
+type RefreshResult struct {
+	Provider string `json:"provider" example:"github"`
+	Account  string `json:"account" example:"holycann"`
+	OK       bool   `json:"ok" example:"true"`
+	Error    string `json:"error,omitempty" example:"github: unexpected status 403"`
+}
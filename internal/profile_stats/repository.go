@@ -0,0 +1,58 @@
+package profile_stats
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type ProfileStatRepository interface {
+	Upsert(ctx context.Context, stat *ProfileStat) (*ProfileStat, error)
+	FindAll(ctx context.Context) ([]ProfileStat, error)
+}
+
+type profileStatRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewProfileStatRepository(supabaseClient *supabase.SupabaseClient) ProfileStatRepository {
+	return &profileStatRepository{
+		supabaseClient: supabaseClient,
+		table:          "profile_stat",
+	}
+}
+
+// Upsert stores the stats of the tenant in ctx, one row per provider
+func (r *profileStatRepository) Upsert(ctx context.Context, stat *ProfileStat) (*ProfileStat, error) {
+	stat.TenantID = base.TenantIDFromContext(ctx)
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(stat, "tenant_id,provider", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to save profile stats")
+	}
+	return stat, nil
+}
+
+// FindAll returns the stats of every provider, ordered by provider
+func (r *profileStatRepository) FindAll(ctx context.Context) ([]ProfileStat, error) {
+	var stats []ProfileStat
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("provider", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&stats)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list profile stats")
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,117 @@
+package profile_stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/profilestats"
+)
+
+type ProfileStatService interface {
+	GetProfileStats(ctx context.Context) ([]ProfileStat, error)
+	Refresh(ctx context.Context) ([]RefreshResult, error)
+}
+
+type profileStatService struct {
+	profileStatRepo ProfileStatRepository
+	sources         []profilestats.Source
+}
+
+// NewProfileStatService creates the service reading the given sources; with
+// no sources the stored stats stay readable but nothing is refreshed
+func NewProfileStatService(profileStatRepo ProfileStatRepository, sources []profilestats.Source) ProfileStatService {
+	return &profileStatService{
+		profileStatRepo: profileStatRepo,
+		sources:         sources,
+	}
+}
+
+// GetProfileStats returns the stored stats of the configured providers
+func (s *profileStatService) GetProfileStats(ctx context.Context) ([]ProfileStat, error) {
+	stats, err := s.profileStatRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Providers removed from the configuration are no longer shown
+	configured := make(map[string]bool, len(s.sources))
+	for _, source := range s.sources {
+		configured[source.Provider.Name()] = true
+	}
+
+	result := make([]ProfileStat, 0, len(stats))
+	for _, stat := range stats {
+		if configured[stat.Provider] && stat.FetchedAt != nil {
+			result = append(result, stat)
+		}
+	}
+	return result, nil
+}
+
+// Refresh reads every source and stores its stats. A failing provider keeps
+// its previous stats and is reported in the results.
+func (s *profileStatService) Refresh(ctx context.Context) ([]RefreshResult, error) {
+	if len(s.sources) == 0 {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"No profile stats providers are configured",
+			nil,
+		)
+	}
+
+	existing, err := s.profileStatRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byProvider := make(map[string]ProfileStat, len(existing))
+	for _, stat := range existing {
+		byProvider[stat.Provider] = stat
+	}
+
+	results := make([]RefreshResult, 0, len(s.sources))
+	for _, source := range s.sources {
+		name := source.Provider.Name()
+		result := RefreshResult{Provider: name, Account: source.Account}
+
+		now := time.Now().UTC()
+		stat, ok := byProvider[name]
+		if !ok || stat.Account != source.Account {
+			// A different account must not inherit the old figures
+			stat = ProfileStat{
+				ID:        uuid.New(),
+				Provider:  name,
+				Account:   source.Account,
+				Metrics:   map[string]int64{},
+				CreatedAt: &now,
+			}
+			if ok {
+				stat.ID = byProvider[name].ID
+			}
+		}
+		stat.UpdatedAt = &now
+
+		fetched, err := source.Provider.Fetch(ctx, source.Account)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			stat.LastError = err.Error()
+			result.Error = err.Error()
+		} else {
+			stat.ProfileUrl = fetched.ProfileURL
+			stat.Metrics = fetched.Metrics
+			stat.LastError = ""
+			stat.FetchedAt = &now
+			result.OK = true
+		}
+
+		if _, err := s.profileStatRepo.Upsert(ctx, &stat); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
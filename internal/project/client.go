@@ -0,0 +1,115 @@
+package project
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// confidentialClientName replaces the name of confidential clients in
+// public responses
+const confidentialClientName = "Confidential client"
+
+// ProjectClient credits the client a project was built for
+// @Description Client a project was built for
+// @Name ProjectClient
+type ProjectClient struct {
+	// CompanyID links the client to a company, whose name, logo and
+	// industry take precedence over the ones given here
+	CompanyID *uuid.UUID `json:"company_id,omitempty" example:"750e8400-e29b-41d4-a716-446655440000"`
+	Name      string     `json:"name" example:"Acme Corp"`
+	LogoUrl   string     `json:"logo_url,omitempty" example:"https://example.com/acme-logo.png"`
+	Industry  string     `json:"industry,omitempty" example:"Fintech"`
+	// TestimonialURL links to what the client said about the project
+	TestimonialURL string `json:"testimonial_url,omitempty" example:"https://www.linkedin.com/posts/acme-testimonial"`
+	// Confidential hides everything but the industry of the client from
+	// public responses
+	Confidential bool `json:"confidential" example:"false"`
+}
+
+// public returns the client as visitors see it, hiding who a confidential
+// client is
+func (c *ProjectClient) public() *ProjectClient {
+	if c == nil || !c.Confidential {
+		return c
+	}
+	return &ProjectClient{
+		Name:         confidentialClientName,
+		Industry:     c.Industry,
+		Confidential: true,
+	}
+}
+
+// normalizeClient validates a client and returns it trimmed. A client
+// without a name or company removes the client from the project.
+func normalizeClient(client *ProjectClient) (*ProjectClient, error) {
+	if client == nil {
+		return nil, nil
+	}
+
+	normalized := *client
+	normalized.Name = strings.TrimSpace(normalized.Name)
+	normalized.LogoUrl = strings.TrimSpace(normalized.LogoUrl)
+	normalized.Industry = strings.TrimSpace(normalized.Industry)
+	normalized.TestimonialURL = strings.TrimSpace(normalized.TestimonialURL)
+	if normalized.CompanyID != nil && *normalized.CompanyID == uuid.Nil {
+		normalized.CompanyID = nil
+	}
+	if normalized.Name == "" && normalized.CompanyID == nil {
+		return nil, nil
+	}
+
+	if len(normalized.Name) > 255 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Client name must be at most 255 characters",
+			nil,
+		)
+	}
+	if len(normalized.Industry) > 100 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Client industry must be at most 100 characters",
+			nil,
+		)
+	}
+	for _, link := range [][2]string{{"logo_url", normalized.LogoUrl}, {"testimonial_url", normalized.TestimonialURL}} {
+		if link[1] == "" {
+			continue
+		}
+		if u, err := url.Parse(link[1]); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Client links must be http(s) URLs",
+				err,
+				errors.WithContext("field", link[0]),
+			)
+		}
+	}
+
+	return &normalized, nil
+}
+
+// resolveClient validates a client and fills in the details of its company
+func (s *projectService) resolveClient(ctx context.Context, client *ProjectClient) (*ProjectClient, error) {
+	client, err := normalizeClient(client)
+	if err != nil || client == nil || client.CompanyID == nil {
+		return client, err
+	}
+
+	company, err := s.companyService.GetCompanyByID(ctx, client.CompanyID.String())
+	if err != nil {
+		return nil, err
+	}
+	client.Name = company.Name
+	if company.LogoUrl != "" {
+		client.LogoUrl = company.LogoUrl
+	}
+	if company.Industry != "" {
+		client.Industry = company.Industry
+	}
+	return client, nil
+}
@@ -0,0 +1,195 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *projectService) DryRunCreateProjects(ctx context.Context, projectsCreate []*ProjectCreate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	slugs := map[string]int{}
+
+	for i, projectCreate := range projectsCreate {
+		change := base.PlannedChange{Index: i, Action: base.ActionCreate, Name: projectCreate.Slug}
+
+		if err := validator.ValidateModel(projectCreate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if err := validateVisibility(projectCreate.Visibility); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if _, err := normalizeMetrics(projectCreate.Metrics); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if _, err := normalizeTimeline(projectCreate.Timeline); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if _, err := normalizeClient(projectCreate.Client); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		// A copy is checked so the dry run leaves the input as it is
+		seo := projectCreate.SEO
+		if err := seo.Normalize(); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+
+		problems, err := s.referenceProblems(ctx, projectCreate.Slug, uuid.Nil, projectCreate.TechStackIds, slugs, i)
+		if err != nil {
+			return nil, err
+		}
+		change.Problems = append(change.Problems, problems...)
+
+		duplicates, err := s.blockedDuplicates(ctx, projectCreate.Title, projectCreate.AllowDuplicate)
+		if err != nil {
+			return nil, err
+		}
+		for _, duplicate := range duplicates {
+			change.Problems = append(change.Problems, fmt.Sprintf("probable duplicate of project %q (%s)", duplicate.Name, duplicate.ID))
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *projectService) DryRunUpdateProjects(ctx context.Context, projectsUpdate []*ProjectUpdate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	slugs := map[string]int{}
+
+	for i, projectUpdate := range projectsUpdate {
+		change := base.PlannedChange{Index: i, Action: base.ActionUpdate, ID: projectUpdate.ID.String(), Name: projectUpdate.Slug}
+
+		if err := validator.ValidateModel(projectUpdate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if err := validateVisibility(projectUpdate.Visibility); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if projectUpdate.Metrics != nil {
+			if _, err := normalizeMetrics(projectUpdate.Metrics); err != nil {
+				change.Problems = append(change.Problems, err.Error())
+			}
+		}
+		if projectUpdate.Timeline != nil {
+			if _, err := normalizeTimeline(projectUpdate.Timeline); err != nil {
+				change.Problems = append(change.Problems, err.Error())
+			}
+		}
+		if _, err := normalizeClient(projectUpdate.Client); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if projectUpdate.SEO != nil {
+			seo := *projectUpdate.SEO
+			if err := seo.Normalize(); err != nil {
+				change.Problems = append(change.Problems, err.Error())
+			}
+		}
+
+		exists, err := s.projectRepo.Exists(ctx, projectUpdate.ID.String())
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find project",
+				errors.WithContext("project_id", projectUpdate.ID),
+			)
+		}
+		if !exists {
+			change.Problems = append(change.Problems, "project does not exist")
+		}
+
+		problems, err := s.referenceProblems(ctx, projectUpdate.Slug, projectUpdate.ID, projectUpdate.TechStackIds, slugs, i)
+		if err != nil {
+			return nil, err
+		}
+		change.Problems = append(change.Problems, problems...)
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *projectService) DryRunDeleteProjects(ctx context.Context, ids []string) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	seen := map[string]int{}
+
+	for i, id := range ids {
+		change := base.PlannedChange{Index: i, Action: base.ActionDelete, ID: id}
+
+		if first, ok := seen[id]; ok {
+			change.Problems = append(change.Problems, fmt.Sprintf("project is already deleted by item %d", first))
+			dryRun.Add(change)
+			continue
+		}
+		seen[id] = i
+
+		if _, err := uuid.Parse(id); err != nil {
+			change.Problems = append(change.Problems, "invalid project ID")
+			dryRun.Add(change)
+			continue
+		}
+
+		existing, err := s.projectRepo.FindByField(ctx, "id", id)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find project",
+				errors.WithContext("project_id", id),
+			)
+		}
+		if len(existing) == 0 {
+			change.Problems = append(change.Problems, "project does not exist")
+		} else {
+			change.Name = existing[0].Slug
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+// referenceProblems reports a slug taken by another project or by an
+// earlier item of the same request, and tech stacks that do not exist
+func (s *projectService) referenceProblems(ctx context.Context, slug string, id uuid.UUID, techStackIDs []uuid.UUID, claimed map[string]int, index int) ([]string, error) {
+	var problems []string
+
+	if slug != "" {
+		if first, ok := claimed[slug]; ok {
+			problems = append(problems, fmt.Sprintf("slug %q is also used by item %d", slug, first))
+		} else {
+			claimed[slug] = index
+
+			existing, err := s.projectRepo.FindByField(ctx, "slug", slug)
+			if err != nil {
+				return nil, errors.Wrap(err,
+					errors.ErrDatabase,
+					"Failed to check project slug",
+					errors.WithContext("slug", slug),
+				)
+			}
+			for _, project := range existing {
+				if project.ID != id {
+					problems = append(problems, fmt.Sprintf("slug %q is already taken", slug))
+					break
+				}
+			}
+		}
+	}
+
+	missing, err := s.techStackService.MissingTechStackIDs(ctx, techStackIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, techStackID := range missing {
+		problems = append(problems, fmt.Sprintf("tech stack %s does not exist", techStackID))
+	}
+
+	return problems, nil
+}
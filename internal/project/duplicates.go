@@ -0,0 +1,123 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// duplicateReason explains why projects are considered duplicates
+const duplicateReason = "same title"
+
+func (s *projectService) FindDuplicateProjects(ctx context.Context, title string, excludeID uuid.UUID) ([]base.DuplicateMatch, error) {
+	if s.dedupMode == base.DedupOff {
+		return nil, nil
+	}
+
+	normalized := base.NormalizeTitle(title)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	projects, err := s.allProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []base.DuplicateMatch
+	for _, project := range projects {
+		if project.ID != excludeID && base.NormalizeTitle(project.Title) == normalized {
+			matches = append(matches, base.DuplicateMatch{
+				ID:     project.ID.String(),
+				Name:   project.Title,
+				Reason: duplicateReason,
+			})
+		}
+	}
+
+	return matches, nil
+}
+
+func (s *projectService) FindDuplicateGroups(ctx context.Context) ([]base.DuplicateGroup, error) {
+	projects, err := s.allProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	byTitle := map[string][]base.DuplicateMatch{}
+	for _, project := range projects {
+		normalized := base.NormalizeTitle(project.Title)
+		if normalized == "" {
+			continue
+		}
+		if _, ok := byTitle[normalized]; !ok {
+			order = append(order, normalized)
+		}
+		byTitle[normalized] = append(byTitle[normalized], base.DuplicateMatch{
+			ID:     project.ID.String(),
+			Name:   project.Title,
+			Reason: duplicateReason,
+		})
+	}
+
+	groups := []base.DuplicateGroup{}
+	for _, normalized := range order {
+		if matches := byTitle[normalized]; len(matches) > 1 {
+			groups = append(groups, base.DuplicateGroup{Reason: duplicateReason, Items: matches})
+		}
+	}
+
+	return groups, nil
+}
+
+// blockedDuplicates returns the projects that stop a project titled title
+// from being created, when duplicates are blocked and not overridden
+func (s *projectService) blockedDuplicates(ctx context.Context, title string, allowDuplicate bool) ([]base.DuplicateMatch, error) {
+	if s.dedupMode != base.DedupBlock || allowDuplicate {
+		return nil, nil
+	}
+	return s.FindDuplicateProjects(ctx, title, uuid.Nil)
+}
+
+// checkDuplicates rejects a project that duplicates an existing one
+func (s *projectService) checkDuplicates(ctx context.Context, projectCreate *ProjectCreate) error {
+	duplicates, err := s.blockedDuplicates(ctx, projectCreate.Title, projectCreate.AllowDuplicate)
+	if err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to check for duplicate projects",
+		)
+	}
+
+	if len(duplicates) > 0 {
+		return errors.New(
+			errors.ErrConflict,
+			"A project with the same title already exists, set allow_duplicate to create it anyway",
+			fmt.Errorf("%d probable duplicates", len(duplicates)),
+			errors.WithContext("duplicates", duplicates),
+		)
+	}
+	return nil
+}
+
+// allProjects loads every project of the tenant
+func (s *projectService) allProjects(ctx context.Context) ([]ProjectDTO, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	var all []ProjectDTO
+	for {
+		projects, err := s.ListProjects(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, projects...)
+		if len(projects) < opts.PerPage {
+			return all, nil
+		}
+		opts.Page++
+	}
+}
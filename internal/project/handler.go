@@ -2,10 +2,13 @@ package project
 
 import (
 	"mime/multipart"
+	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
 	"github.com/holycann/itsrama-portfolio-backend/internal/response"
 	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
@@ -15,12 +18,16 @@ import (
 type ProjectHandler struct {
 	base.BaseHandler
 	projectService ProjectService
+	jobService     jobs.JobService
+	bulkConfirmer  *base.BulkConfirmer
 }
 
-func NewProjectHandler(projectService ProjectService, logger *logger.Logger) *ProjectHandler {
+func NewProjectHandler(projectService ProjectService, jobService jobs.JobService, bulkConfirmer *base.BulkConfirmer, logger *logger.Logger) *ProjectHandler {
 	return &ProjectHandler{
 		BaseHandler:    *base.NewBaseHandler(logger),
 		projectService: projectService,
+		jobService:     jobService,
+		bulkConfirmer:  bulkConfirmer,
 	}
 }
 
@@ -34,6 +41,7 @@ func NewProjectHandler(projectService ProjectService, logger *logger.Logger) *Pr
 // @Param payload formData string true "Project Details in JSON format (See ProjectCreate Model)"
 // @Success 200 {object} response.APIResponse{data=Project} "Project created successfully"
 // @Failure 400 {object} response.APIResponse{data=ProjectCreate} "Bad Request"
+// @Failure 409 {object} response.APIResponse "Probable duplicate of an existing project"
 // @Failure 500 {object} response.APIResponse "Internal Server Error"
 // @Router /projects [post]
 func (h *ProjectHandler) CreateProject(c *gin.Context) {
@@ -72,19 +80,21 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 		return
 	}
 
-	h.HandleSuccess(c, project, "Project created successfully")
+	duplicates, err := h.projectService.FindDuplicateProjects(c.Request.Context(), project.Title, project.ID)
+	h.HandleSuccess(c, project, "Project created successfully", h.DuplicateWarning(duplicates, err)...)
 }
 
 // GetProjectByID retrieves a specific project
 // @Summary Get a project by ID
-// @Description Retrieve a project using its unique identifier
+// @Description Retrieve a project using its unique identifier. The admin route returns every field of the project instead of its public view.
 // @Tags Projects
 // @Produce json
 // @Param id path string true "Project ID"
-// @Success 200 {object} response.APIResponse{data=Project} "Project retrieved successfully"
+// @Success 200 {object} response.APIResponse{data=PublicProject} "Project retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Project not found"
 // @Router /projects/{id} [get]
+// @Router /admin/projects/{id} [get]
 func (h *ProjectHandler) GetProjectByID(c *gin.Context) {
 	projectID := c.Param("id")
 	if projectID == "" {
@@ -102,6 +112,22 @@ func (h *ProjectHandler) GetProjectByID(c *gin.Context) {
 		return
 	}
 
+	// Unlisted and draft projects need a share link outside the admin routes
+	if !project.IsPublic() && !response.IsAdmin(c) {
+		h.HandleError(c, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", projectID),
+		))
+		return
+	}
+
+	if err := h.projectService.WithSeries(c.Request.Context(), project); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
 	h.HandleSuccess(c, project, "Project retrieved successfully")
 }
 
@@ -178,6 +204,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 // @Tags Projects
 // @Produce json
 // @Param id path string true "Project ID"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
 // @Success 200 {object} response.APIResponse "Project deleted successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Project not found"
@@ -193,7 +220,22 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 		return
 	}
 
-	err := h.projectService.DeleteProject(c.Request.Context(), projectID)
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.projectService.DryRunDeleteProjects(c.Request.Context(), []string{projectID})
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	err = h.projectService.DeleteProject(c.Request.Context(), projectID)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -202,17 +244,113 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	h.HandleSuccess(c, nil, "Project deleted successfully")
 }
 
+// GetImpactSummary summarizes metrics across featured projects
+// @Summary Get the project impact summary
+// @Description Aggregate the metrics of featured projects for the homepage impact section. Counts and currencies are summed, other units keep the highest value.
+// @Tags Projects
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=ImpactSummary} "Impact summary retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /projects/impact [get]
+func (h *ProjectHandler) GetImpactSummary(c *gin.Context) {
+	summary, err := h.projectService.GetImpactSummary(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, summary, "Impact summary retrieved successfully")
+}
+
+// GetProjectTimeline lays out the phases of a project for a Gantt chart
+// @Summary Get a project timeline
+// @Description Retrieve the design, build and launch phases of a project with their offsets and durations in days from the first phase, and their share of the total effort. Ongoing phases end today.
+// @Tags Projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} response.APIResponse{data=ProjectTimeline} "Project timeline retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Router /projects/{id}/timeline [get]
+// @Router /admin/projects/{id}/timeline [get]
+func (h *ProjectHandler) GetProjectTimeline(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	project, err := h.projectService.GetProjectByID(c.Request.Context(), projectID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Unlisted and draft projects need a share link outside the admin routes
+	if !project.IsPublic() && !response.IsAdmin(c) {
+		h.HandleError(c, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", projectID),
+		))
+		return
+	}
+
+	h.HandleSuccess(c, BuildTimeline(*project, time.Now()), "Project timeline retrieved successfully")
+}
+
+// CaptureScreenshot queues a refresh of the project thumbnail from its live site
+// @Summary Capture a project screenshot
+// @Description Queue a background job capturing a screenshot of the project's web URL and storing it as the project thumbnail. Poll GET /jobs/{id} for its progress.
+// @Tags Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Success 202 {object} response.APIResponse{data=jobs.Job} "Project screenshot queued successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /projects/{id}/screenshot [post]
+func (h *ProjectHandler) CaptureScreenshot(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Check the project before queueing so a bad ID fails right away
+	if _, err := h.projectService.GetProjectByID(c.Request.Context(), projectID); err != nil {
+		h.HandleError(c, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Project not found",
+			errors.WithContext("project_id", projectID),
+		))
+		return
+	}
+
+	job, err := h.jobService.Enqueue(c.Request.Context(), ScreenshotJob, screenshotPayload{ProjectID: projectID})
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusAccepted, job, "Project screenshot queued successfully")
+}
+
 // ListProjects retrieves a paginated list of projects
 // @Summary List projects
-// @Description Retrieve a paginated list of projects with optional filtering
+// @Description Retrieve a paginated list of projects with optional filtering. The admin route returns every field of the projects instead of their public view.
 // @Tags Projects
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
 // @Param category query string false "Filter by project category"
-// @Success 200 {object} response.APIResponse{data=[]Project} "Projects retrieved successfully"
+// @Param visibility query string false "Filter by visibility on the admin route" Enums(public, unlisted, draft)
+// @Success 200 {object} response.APIResponse{data=[]PublicProject} "Projects retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /projects [get]
+// @Router /admin/projects [get]
 func (h *ProjectHandler) ListProjects(c *gin.Context) {
 	// Parse pagination and filtering options
 	opts, err := base.ParsePaginationParams(c)
@@ -245,6 +383,21 @@ func (h *ProjectHandler) ListProjects(c *gin.Context) {
 		})
 	}
 
+	// Unlisted and drafts are only listed on the admin routes
+	if !response.IsAdmin(c) {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "visibility",
+			Operator: base.OperatorEqual,
+			Value:    VisibilityPublic,
+		})
+	} else if visibility := c.Query("visibility"); visibility != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "visibility",
+			Operator: base.OperatorEqual,
+			Value:    visibility,
+		})
+	}
+
 	// List projects
 	projects, err := h.projectService.ListProjects(c.Request.Context(), opts)
 	if err != nil {
@@ -271,7 +424,7 @@ func (h *ProjectHandler) ListProjects(c *gin.Context) {
 // @Param query query string true "Search query"
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
-// @Success 200 {object} response.APIResponse{data=[]Project} "Projects search completed successfully"
+// @Success 200 {object} response.APIResponse{data=[]PublicProject} "Projects search completed successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /projects/search [get]
 func (h *ProjectHandler) SearchProjects(c *gin.Context) {
@@ -288,6 +441,11 @@ func (h *ProjectHandler) SearchProjects(c *gin.Context) {
 
 	// Attach search term
 	opts.Search = query
+	opts.Filters = append(opts.Filters, base.FilterOption{
+		Field:    "visibility",
+		Operator: base.OperatorEqual,
+		Value:    VisibilityPublic,
+	})
 
 	projects, total, err := h.projectService.SearchProjects(c.Request.Context(), opts)
 	if err != nil {
@@ -307,7 +465,10 @@ func (h *ProjectHandler) SearchProjects(c *gin.Context) {
 // @Produce json
 // @Param uploaded_images formData []file false "Project Images"
 // @Param payload formData string true "Project Details in JSON array format (See ProjectCreate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]Project} "Projects created successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Projects processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]ProjectCreate} "Bad Request"
 // @Failure 500 {object} response.APIResponse "Internal Server Error"
 // @Router /projects/bulk [post]
@@ -346,6 +507,32 @@ func (h *ProjectHandler) BulkCreateProjects(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.projectService.DryRunCreateProjects(c.Request.Context(), projectsInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.projectService.BulkCreateProjectsPartial(c.Request.Context(), projectsInput)
+		h.HandleMultiStatus(c, result, "Projects processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk create projects
 	projects, err := h.projectService.BulkCreateProjects(c.Request.Context(), projectsInput)
 	if err != nil {
@@ -364,7 +551,10 @@ func (h *ProjectHandler) BulkCreateProjects(c *gin.Context) {
 // @Produce json
 // @Param uploaded_images formData []file false "Project Images"
 // @Param payload formData string true "Project Update Details in JSON array format (See ProjectUpdate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]Project} "Projects updated successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Projects processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]ProjectUpdate} "Bad Request"
 // @Router /projects/bulk [put]
 func (h *ProjectHandler) BulkUpdateProjects(c *gin.Context) {
@@ -402,6 +592,32 @@ func (h *ProjectHandler) BulkUpdateProjects(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.projectService.DryRunUpdateProjects(c.Request.Context(), projectsInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.projectService.BulkUpdateProjectsPartial(c.Request.Context(), projectsInput)
+		h.HandleMultiStatus(c, result, "Projects processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk update projects
 	updatedProjects, err := h.projectService.BulkUpdateProjects(c.Request.Context(), projectsInput)
 	if err != nil {
@@ -414,19 +630,26 @@ func (h *ProjectHandler) BulkUpdateProjects(c *gin.Context) {
 
 // BulkDeleteProjects deletes multiple projects in bulk
 // @Summary Bulk delete projects
-// @Description Delete multiple projects in a single request
+// @Description Delete multiple projects in a single request. Deleting more projects than the configured threshold answers 428 with a confirmation_token in the metadata, and only a repeat of the request with the same IDs echoing the token back before it expires deletes them.
 // @Tags Projects
 // @Accept json
 // @Produce json
 // @Param ids body []string true "Project IDs to delete"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
+// @Param X-Confirmation-Token header string false "Confirmation token issued for the same IDs"
+// @Param confirmation_token query string false "Confirmation token, for clients that cannot set headers"
 // @Success 200 {object} response.APIResponse "Projects deleted successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Projects processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 403 {object} response.APIResponse "The deletion must be confirmed by another admin"
+// @Failure 428 {object} response.APIResponse "The deletion must be confirmed"
 // @Router /projects/bulk [delete]
 func (h *ProjectHandler) BulkDeleteProjects(c *gin.Context) {
 	var idsInput []string
 
 	// Bind input
-	if err := c.ShouldBindJSON(&idsInput); err != nil {
+	if err := h.BindJSON(c, &idsInput); err != nil {
 		h.HandleError(c, errors.New(
 			errors.ErrValidation,
 			"Invalid input",
@@ -435,8 +658,39 @@ func (h *ProjectHandler) BulkDeleteProjects(c *gin.Context) {
 		return
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.projectService.DryRunDeleteProjects(c.Request.Context(), idsInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	if err := h.bulkConfirmer.Confirm(c, "projects.delete", idsInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.projectService.BulkDeleteProjectsPartial(c.Request.Context(), idsInput)
+		h.HandleMultiStatus(c, result, "Projects processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk delete projects
-	err := h.projectService.BulkDeleteProjects(c.Request.Context(), idsInput)
+	err = h.projectService.BulkDeleteProjects(c.Request.Context(), idsInput)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -444,3 +698,69 @@ func (h *ProjectHandler) BulkDeleteProjects(c *gin.Context) {
 
 	h.HandleSuccess(c, nil, "Projects deleted successfully")
 }
+
+// CreateShareLink signs a private link to a project
+// @Summary Create a project share link
+// @Description Sign an expiring link giving read access to a project, so that unlisted and draft case studies can be shared before they are published
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param share body ShareLinkCreate false "Share Link Details"
+// @Success 200 {object} response.APIResponse{data=ShareLink} "Share link created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Router /projects/{id}/share [post]
+func (h *ProjectHandler) CreateShareLink(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var shareInput ShareLinkCreate
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &shareInput); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+	}
+
+	link, err := h.projectService.CreateShareLink(c.Request.Context(), projectID, &shareInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, link, "Share link created successfully")
+}
+
+// GetSharedProject retrieves a project through a share link
+// @Summary Get a shared project
+// @Description Retrieve a project, including unlisted and draft projects, using a signed share link
+// @Tags Projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param token query string true "Share link token"
+// @Success 200 {object} response.APIResponse{data=PublicProject} "Project retrieved successfully"
+// @Failure 403 {object} response.APIResponse "Share link is invalid or has expired"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Router /projects/{id}/shared [get]
+func (h *ProjectHandler) GetSharedProject(c *gin.Context) {
+	project, err := h.projectService.GetProjectByID(c.Request.Context(), c.GetString(sharedProjectKey))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.projectService.WithSeries(c.Request.Context(), project); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Shared drafts must not be indexed or cached by intermediaries
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+	h.HandleSuccess(c, project, "Project retrieved successfully")
+}
@@ -0,0 +1,31 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// ScreenshotJob is the kind of job capturing a project screenshot
+const ScreenshotJob = "project.screenshot"
+
+// screenshotPayload is the payload of a screenshot job
+type screenshotPayload struct {
+	ProjectID string `json:"project_id"`
+}
+
+// ScreenshotRunner captures the screenshot of the project named in the job
+// payload, returning the updated project
+func ScreenshotRunner(projectService ProjectService) jobs.Runner {
+	return func(ctx context.Context, job *jobs.Job, progress jobs.Progress) (interface{}, error) {
+		var payload screenshotPayload
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return nil, errors.Wrap(err, errors.ErrValidation, "Invalid screenshot job payload")
+		}
+
+		progress(10, "Capturing screenshot")
+		return projectService.CaptureScreenshot(ctx, payload.ProjectID)
+	}
+}
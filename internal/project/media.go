@@ -0,0 +1,58 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *projectService) SetImageAlt(ctx context.Context, id string, src string, alt string) (*ProjectDTO, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Project not found",
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	images := make([]ProjectImage, len(project.Images))
+	copy(images, project.Images)
+
+	found := false
+	for i := range images {
+		if images[i].Src == src {
+			images[i].Alt = alt
+			found = true
+		}
+	}
+	if !found {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project has no image with this source",
+			nil,
+			errors.WithContext("project_id", id),
+			errors.WithContext("src", src),
+		)
+	}
+
+	if err := s.projectRepo.SetImages(ctx, id, images); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	project.Images = images
+	project.UpdatedAt = &now
+
+	s.publish(ctx, project, events.Event{
+		Type:     events.ProjectUpdated,
+		Entity:   "project",
+		EntityID: project.ID.String(),
+		Summary:  fmt.Sprintf("Updated image alt text of project %s", project.Title),
+	})
+
+	return project, nil
+}
@@ -0,0 +1,112 @@
+package project
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+type memoryProjectRepository struct {
+	*base.MemoryRepository[Project, ProjectDTO]
+	mu         sync.RWMutex
+	links      []ProjectTechStack
+	techStacks tech_stack.TechStackRepository
+}
+
+// NewMemoryProjectRepository creates a project repository kept in memory,
+// for development without a database. Tech stack names are looked up in
+// techStacks when projects are read.
+func NewMemoryProjectRepository(projects []Project, links []ProjectTechStack, techStacks tech_stack.TechStackRepository) ProjectRepository {
+	r := &memoryProjectRepository{
+		links:      append([]ProjectTechStack(nil), links...),
+		techStacks: techStacks,
+	}
+	r.MemoryRepository = base.NewMemoryRepository(projects,
+		func(p *Project) string { return p.ID.String() },
+		r.toDTO,
+		"title", "category",
+	)
+	return r
+}
+
+func (r *memoryProjectRepository) CreateProjectTechStack(ctx context.Context, project *ProjectTechStack) (*ProjectTechStack, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.links = append(r.links, *project)
+	return project, nil
+}
+
+func (r *memoryProjectRepository) DeleteProjectTechStack(ctx context.Context, projectID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.links[:0]
+	for _, link := range r.links {
+		if link.ProjectID.String() != projectID {
+			kept = append(kept, link)
+		}
+	}
+	r.links = kept
+	return nil
+}
+
+func (r *memoryProjectRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	r.Modify(ctx, id, func(p *Project) {
+		now := time.Now().UTC()
+		p.IsFeatured = featured
+		p.UpdatedAt = &now
+	})
+	return nil
+}
+
+func (r *memoryProjectRepository) SetImages(ctx context.Context, id string, images []ProjectImage) error {
+	r.Modify(ctx, id, func(p *Project) {
+		now := time.Now().UTC()
+		p.Images = images
+		p.UpdatedAt = &now
+	})
+	return nil
+}
+
+func (r *memoryProjectRepository) SetReading(ctx context.Context, id string, wordCount, readingTime int) error {
+	r.Modify(ctx, id, func(p *Project) {
+		p.WordCount = wordCount
+		p.ReadingTime = readingTime
+	})
+	return nil
+}
+
+func (r *memoryProjectRepository) SetTOC(ctx context.Context, id string, toc []markdown.Heading) error {
+	r.Modify(ctx, id, func(p *Project) {
+		p.TOC = toc
+	})
+	return nil
+}
+
+// toDTO embeds the id and name of the project tech stacks, as the REST
+// API does
+func (r *memoryProjectRepository) toDTO(p Project) ProjectDTO {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projectTechStack := []ProjectTechStackDTO{}
+	for _, link := range r.links {
+		if link.ProjectID != p.ID {
+			continue
+		}
+
+		dto := ProjectTechStackDTO{ProjectID: link.ProjectID, TechStackID: link.TechStackID}
+		techStacks, _ := r.techStacks.FindByField(context.Background(), "id", link.TechStackID)
+		if len(techStacks) > 0 {
+			dto.TechStack = tech_stack.TechStack{ID: techStacks[0].ID, Name: techStacks[0].Name}
+		}
+		projectTechStack = append(projectTechStack, dto)
+	}
+
+	return p.ToDTO(projectTechStack)
+}
@@ -0,0 +1,231 @@
+package project
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// MetricKey identifies what a project metric measures
+// @Description Kind of project metric
+// @Name MetricKey
+type MetricKey string
+
+// MetricUnit is the unit a project metric value is expressed in
+// @Description Unit of a project metric value
+// @Name MetricUnit
+type MetricUnit string
+
+const (
+	MetricUsers         MetricKey = "users"
+	MetricStars         MetricKey = "stars"
+	MetricDownloads     MetricKey = "downloads"
+	MetricRevenueImpact MetricKey = "revenue_impact"
+	MetricCostSavings   MetricKey = "cost_savings"
+	MetricPerformance   MetricKey = "performance"
+	MetricUptime        MetricKey = "uptime"
+
+	UnitCount        MetricUnit = "count"
+	UnitPercent      MetricUnit = "percent"
+	UnitMilliseconds MetricUnit = "ms"
+	UnitSeconds      MetricUnit = "s"
+	UnitRequests     MetricUnit = "rps"
+	UnitMultiplier   MetricUnit = "x"
+	UnitUSD          MetricUnit = "usd"
+	UnitEUR          MetricUnit = "eur"
+	UnitIDR          MetricUnit = "idr"
+)
+
+// metricUnits lists the units each metric key can be expressed in
+var metricUnits = map[MetricKey][]MetricUnit{
+	MetricUsers:         {UnitCount},
+	MetricStars:         {UnitCount},
+	MetricDownloads:     {UnitCount},
+	MetricRevenueImpact: {UnitUSD, UnitEUR, UnitIDR, UnitPercent},
+	MetricCostSavings:   {UnitUSD, UnitEUR, UnitIDR, UnitPercent},
+	MetricPerformance:   {UnitPercent, UnitMilliseconds, UnitSeconds, UnitRequests, UnitMultiplier},
+	MetricUptime:        {UnitPercent},
+}
+
+// additiveUnits are summed across projects; other units keep the best value
+var additiveUnits = map[MetricUnit]bool{
+	UnitCount: true,
+	UnitUSD:   true,
+	UnitEUR:   true,
+	UnitIDR:   true,
+}
+
+const maxProjectMetrics = 20
+
+// ProjectMetric is a typed key/value measurement of a project's impact
+// @Description Measured impact of a project, such as users or stars
+// @Name ProjectMetric
+type ProjectMetric struct {
+	Key   MetricKey  `json:"key" example:"users"`
+	Label string     `json:"label,omitempty" example:"Monthly active users"`
+	Value float64    `json:"value" example:"12000"`
+	Unit  MetricUnit `json:"unit" example:"count"`
+}
+
+// ImpactMetric is a metric aggregated across featured projects
+// @Description Metric summarized across featured projects
+// @Name ImpactMetric
+type ImpactMetric struct {
+	Key   MetricKey  `json:"key" example:"users"`
+	Unit  MetricUnit `json:"unit" example:"count"`
+	Value float64    `json:"value" example:"48000"`
+
+	// Aggregation is "sum" for counts and currencies and "max" otherwise
+	Aggregation  string      `json:"aggregation" example:"sum"`
+	ProjectCount int         `json:"project_count" example:"3"`
+	ProjectIDs   []uuid.UUID `json:"project_ids"`
+}
+
+// ImpactSummary is the homepage impact section built from featured projects
+// @Description Metrics summarized across featured projects
+// @Name ImpactSummary
+type ImpactSummary struct {
+	ProjectCount int            `json:"project_count" example:"4"`
+	Metrics      []ImpactMetric `json:"metrics"`
+}
+
+// normalizeMetrics validates metrics and returns them with trimmed labels
+// and lower-cased keys and units
+func normalizeMetrics(metrics []ProjectMetric) ([]ProjectMetric, error) {
+	if len(metrics) > maxProjectMetrics {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("A project can have at most %d metrics", maxProjectMetrics),
+			nil,
+		)
+	}
+
+	normalized := make([]ProjectMetric, 0, len(metrics))
+	seen := map[string]bool{}
+	for i, metric := range metrics {
+		metric.Key = MetricKey(strings.ToLower(strings.TrimSpace(string(metric.Key))))
+		metric.Unit = MetricUnit(strings.ToLower(strings.TrimSpace(string(metric.Unit))))
+		metric.Label = strings.TrimSpace(metric.Label)
+
+		units, ok := metricUnits[metric.Key]
+		if !ok {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Unknown metric key %q", metric.Key),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if !slices.Contains(units, metric.Unit) {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Metric %q cannot be measured in %q", metric.Key, metric.Unit),
+				nil,
+				errors.WithContext("index", i),
+				errors.WithContext("allowed_units", units),
+			)
+		}
+		if math.IsNaN(metric.Value) || math.IsInf(metric.Value, 0) || metric.Value < 0 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Metric %q must have a non-negative value", metric.Key),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if metric.Unit == UnitCount && metric.Value != math.Trunc(metric.Value) {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Metric %q must be a whole number", metric.Key),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if metric.Unit == UnitPercent && metric.Key == MetricUptime && metric.Value > 100 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Uptime cannot exceed 100 percent",
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if len(metric.Label) > 100 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Metric label must be at most 100 characters",
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+
+		id := string(metric.Key) + "|" + string(metric.Unit) + "|" + strings.ToLower(metric.Label)
+		if seen[id] {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Duplicate metric %q in %q", metric.Key, metric.Unit),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		seen[id] = true
+
+		normalized = append(normalized, metric)
+	}
+
+	return normalized, nil
+}
+
+// SummarizeImpact aggregates the metrics of projects per key and unit,
+// summing counts and currencies and keeping the highest value otherwise
+func SummarizeImpact(projects []ProjectDTO) ImpactSummary {
+	summary := ImpactSummary{
+		ProjectCount: len(projects),
+		Metrics:      []ImpactMetric{},
+	}
+
+	index := map[string]int{}
+	for _, project := range projects {
+		for _, metric := range project.Metrics {
+			key := string(metric.Key) + "|" + string(metric.Unit)
+			i, ok := index[key]
+			if !ok {
+				aggregation := "max"
+				if additiveUnits[metric.Unit] {
+					aggregation = "sum"
+				}
+				i = len(summary.Metrics)
+				index[key] = i
+				summary.Metrics = append(summary.Metrics, ImpactMetric{
+					Key:         metric.Key,
+					Unit:        metric.Unit,
+					Aggregation: aggregation,
+				})
+			}
+
+			impact := &summary.Metrics[i]
+			if impact.Aggregation == "sum" {
+				impact.Value += metric.Value
+			} else {
+				impact.Value = math.Max(impact.Value, metric.Value)
+			}
+			if !slices.Contains(impact.ProjectIDs, project.ID) {
+				impact.ProjectIDs = append(impact.ProjectIDs, project.ID)
+				impact.ProjectCount++
+			}
+		}
+	}
+
+	// Show the metrics backed by the most projects first
+	slices.SortStableFunc(summary.Metrics, func(a, b ImpactMetric) int {
+		if a.ProjectCount != b.ProjectCount {
+			return b.ProjectCount - a.ProjectCount
+		}
+		return strings.Compare(string(a.Key)+string(a.Unit), string(b.Key)+string(b.Unit))
+	})
+
+	return summary
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
 	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
 )
 
 // DevelopmentStatus represents the development stage of a project
@@ -23,6 +25,11 @@ type ProgressStatus string
 // @Name ProjectCategory
 type ProjectCategory string
 
+// Visibility controls who can see a project
+// @Description Visibility of a project
+// @Name Visibility
+type Visibility string
+
 const (
 	Alpha DevelopmentStatus = "Alpha"
 	Beta  DevelopmentStatus = "Beta"
@@ -33,6 +40,12 @@ const (
 	OnHold     ProgressStatus = "On Hold"
 	Completed  ProgressStatus = "Completed"
 
+	// Unlisted and draft projects are hidden from public endpoints and can
+	// only be viewed by admins or through a share link
+	VisibilityPublic   Visibility = "public"
+	VisibilityUnlisted Visibility = "unlisted"
+	VisibilityDraft    Visibility = "draft"
+
 	WebDevelopment ProjectCategory = "Web Development"
 	ApiDevelopment ProjectCategory = "API Development"
 	BotDevelopment ProjectCategory = "Bot Development"
@@ -49,6 +62,12 @@ type ProjectImage struct {
 	Src         string `json:"src" example:"https://example.com/image.jpg"`
 	Alt         string `json:"alt" example:"Project screenshot"`
 	IsThumbnail bool   `json:"is_thumbnail" example:"false"`
+	// Placeholder is a tiny copy of the image as a data URI, shown while the
+	// image loads
+	Placeholder string `json:"placeholder,omitempty" example:"data:image/png;base64,iVBORw0KGgo="`
+	// DominantColor is the most common color of the image as #rrggbb, used
+	// to tint the background while the image loads
+	DominantColor string `json:"dominant_color,omitempty" example:"#1e293b"`
 }
 
 // Project represents the main project model
@@ -56,8 +75,9 @@ type ProjectImage struct {
 // @Name Project
 type Project struct {
 	// Identification
-	ID   uuid.UUID `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Slug string    `json:"slug" db:"slug" validate:"required" example:"portfolio-website"`
+	ID       uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Slug     string     `json:"slug" db:"slug" validate:"required" example:"portfolio-website"`
 
 	// Project Details
 	Title       string          `json:"title" db:"title" validate:"required" example:"Portfolio Website"`
@@ -71,14 +91,29 @@ type Project struct {
 	WebUrl    string `json:"web_url,omitempty" db:"web_url" example:"https://myportfolio.com"`
 
 	// Project Content
-	Images   []ProjectImage `json:"images" db:"images" pg:"array"`
-	Features []string       `json:"features" db:"features" pg:"array" example:"Responsive Design,Dark Mode"`
+	Images   []ProjectImage  `json:"images" db:"images" pg:"array"`
+	Features []string        `json:"features" db:"features" pg:"array" example:"Responsive Design,Dark Mode"`
+	Metrics  []ProjectMetric `json:"metrics" db:"metrics"`
+	Timeline []TimelinePhase `json:"timeline" db:"timeline"`
+	Client   *ProjectClient  `json:"client,omitempty" db:"client"`
 
 	// Status
 	DevelopmentStatus  DevelopmentStatus `json:"development_status" db:"development_status" example:"Beta"`
 	ProgressStatus     ProgressStatus    `json:"progress_status" db:"progress_status" example:"In Progress"`
 	ProgressPercentage int               `json:"progress_percentage" db:"progress_percentage" example:"75"`
 	IsFeatured         bool              `json:"is_featured" db:"is_featured" example:"true"`
+	Visibility         Visibility        `json:"visibility" db:"visibility" example:"public"`
+
+	// Search engine directives
+	SEO base.SEO `json:"seo" db:"seo"`
+
+	// Reading statistics of the case study, computed on save. ReadingTime
+	// is in minutes.
+	WordCount   int `json:"word_count" db:"word_count" example:"840"`
+	ReadingTime int `json:"reading_time" db:"reading_time" example:"5"`
+
+	// Table of contents of the description, computed on save
+	TOC []markdown.Heading `json:"toc" db:"toc"`
 
 	// Metadata
 	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
@@ -122,14 +157,29 @@ type ProjectDTO struct {
 	WebUrl    string `json:"web_url,omitempty" db:"web_url" example:"https://myportfolio.com"`
 
 	// Project Content
-	Images   []ProjectImage `json:"images" db:"images" pg:"array"`
-	Features []string       `json:"features" db:"features" pg:"array" example:"Responsive Design,Dark Mode"`
+	Images   []ProjectImage  `json:"images" db:"images" pg:"array"`
+	Features []string        `json:"features" db:"features" pg:"array" example:"Responsive Design,Dark Mode"`
+	Metrics  []ProjectMetric `json:"metrics" db:"metrics"`
+	Timeline []TimelinePhase `json:"timeline" db:"timeline"`
+	Client   *ProjectClient  `json:"client,omitempty" db:"client"`
 
 	// Status
 	DevelopmentStatus  DevelopmentStatus `json:"development_status" db:"development_status" example:"Beta"`
 	ProgressStatus     ProgressStatus    `json:"progress_status" db:"progress_status" example:"In Progress"`
 	ProgressPercentage int               `json:"progress_percentage" db:"progress_percentage" example:"75"`
 	IsFeatured         bool              `json:"is_featured" db:"is_featured" example:"true"`
+	Visibility         Visibility        `json:"visibility" db:"visibility" example:"public"`
+
+	// Search engine directives
+	SEO base.SEO `json:"seo" db:"seo"`
+
+	// Reading statistics of the case study, computed on save. ReadingTime
+	// is in minutes.
+	WordCount   int `json:"word_count" db:"word_count" example:"840"`
+	ReadingTime int `json:"reading_time" db:"reading_time" example:"5"`
+
+	// Table of contents of the description, computed on save
+	TOC []markdown.Heading `json:"toc" db:"toc"`
 
 	// Metadata
 	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
@@ -137,6 +187,9 @@ type ProjectDTO struct {
 
 	// Relationships
 	ProjectTechStack []ProjectTechStackDTO `json:"project_tech_stack" db:"project_tech_stack" pg:"array"`
+
+	// Series the project belongs to, only filled in for single projects
+	Series []SeriesNav `json:"series,omitempty"`
 }
 
 // ProjectCreate represents the input for creating a new project
@@ -156,10 +209,25 @@ type ProjectCreate struct {
 
 	Features []string `json:"features" example:"Responsive Design,Dark Mode"`
 
+	Metrics []ProjectMetric `json:"metrics"`
+
+	// Timeline lists the design, build and launch phases in order
+	Timeline []TimelinePhase `json:"timeline"`
+
+	// Client optionally credits who the project was built for
+	Client *ProjectClient `json:"client"`
+
 	DevelopmentStatus  DevelopmentStatus `json:"development_status" example:"Beta"`
 	ProgressStatus     ProgressStatus    `json:"progress_status" example:"In Progress"`
 	ProgressPercentage int               `json:"progress_percentage" example:"75"`
 	IsFeatured         bool              `json:"is_featured" example:"true"`
+	Visibility         Visibility        `json:"visibility" example:"draft"`
+
+	SEO base.SEO `json:"seo"`
+
+	// AllowDuplicate creates the project even when one with the same title
+	// exists and duplicates are blocked
+	AllowDuplicate bool `json:"allow_duplicate,omitempty" example:"false"`
 
 	UploadedImages []*multipart.FileHeader `json:"uploaded_images" swaggerignore:"true"`
 }
@@ -183,10 +251,23 @@ type ProjectUpdate struct {
 
 	Features []string `json:"features" example:"Responsive Design,Dark Mode,Performance Optimization"`
 
+	// Metrics replaces the project metrics when present; send an empty list to clear them
+	Metrics []ProjectMetric `json:"metrics"`
+
+	// Timeline replaces the project timeline when present; send an empty list to clear it
+	Timeline []TimelinePhase `json:"timeline"`
+
+	// Client replaces the project client when present; send one without a name or company to remove it
+	Client *ProjectClient `json:"client"`
+
 	DevelopmentStatus  DevelopmentStatus `json:"development_status" example:"Beta"`
 	ProgressStatus     ProgressStatus    `json:"progress_status" example:"Completed"`
 	ProgressPercentage int               `json:"progress_percentage" example:"100"`
 	IsFeatured         bool              `json:"is_featured" example:"true"`
+	Visibility         Visibility        `json:"visibility" example:"draft"`
+
+	// SEO replaces the search engine directives when present
+	SEO *base.SEO `json:"seo"`
 
 	UploadedImages []*multipart.FileHeader `json:"uploaded_images" swaggerignore:"true"`
 }
@@ -205,10 +286,14 @@ func (pc *ProjectCreate) ToProject() Project {
 		GithubUrl:          pc.GithubUrl,
 		WebUrl:             pc.WebUrl,
 		Features:           pc.Features,
+		Metrics:            pc.Metrics,
+		Timeline:           pc.Timeline,
 		DevelopmentStatus:  pc.DevelopmentStatus,
 		ProgressStatus:     pc.ProgressStatus,
 		ProgressPercentage: pc.ProgressPercentage,
 		IsFeatured:         pc.IsFeatured,
+		Visibility:         pc.Visibility,
+		SEO:                pc.SEO,
 		Images:             nil, // Will be set during file upload
 		CreatedAt:          &now,
 		UpdatedAt:          &now,
@@ -229,8 +314,11 @@ func (pu *ProjectUpdate) ToProject() Project {
 		GithubUrl:          pu.GithubUrl,
 		WebUrl:             pu.WebUrl,
 		IsFeatured:         pu.IsFeatured,
+		Visibility:         pu.Visibility,
 		Images:             nil, // Will be set during file upload
 		Features:           pu.Features,
+		Metrics:            pu.Metrics,
+		Timeline:           pu.Timeline,
 		DevelopmentStatus:  pu.DevelopmentStatus,
 		ProgressStatus:     pu.ProgressStatus,
 		ProgressPercentage: pu.ProgressPercentage,
@@ -251,8 +339,16 @@ func (p *Project) ToDTO(projectTechStack []ProjectTechStackDTO) ProjectDTO {
 		GithubUrl:          p.GithubUrl,
 		WebUrl:             p.WebUrl,
 		IsFeatured:         p.IsFeatured,
+		Visibility:         p.Visibility,
+		SEO:                p.SEO,
+		WordCount:          p.WordCount,
+		ReadingTime:        p.ReadingTime,
+		TOC:                p.TOC,
 		Images:             p.Images,
 		Features:           p.Features,
+		Metrics:            p.Metrics,
+		Timeline:           p.Timeline,
+		Client:             p.Client,
 		DevelopmentStatus:  p.DevelopmentStatus,
 		ProgressStatus:     p.ProgressStatus,
 		ProgressPercentage: p.ProgressPercentage,
@@ -261,3 +357,43 @@ func (p *Project) ToDTO(projectTechStack []ProjectTechStackDTO) ProjectDTO {
 		ProjectTechStack:   projectTechStack,
 	}
 }
+
+// readingStats counts the words of the case study of a project, its
+// Markdown description and features, and estimates their reading time
+func readingStats(description string, features []string) (wordCount, readingTime int) {
+	wordCount = markdown.WordCount(description)
+	for _, feature := range features {
+		wordCount += markdown.WordCount(feature)
+	}
+	return wordCount, markdown.ReadingTime(wordCount)
+}
+
+// IsPublic reports whether a project can be seen without a share link
+func (p *ProjectDTO) IsPublic() bool {
+	return p.Visibility == "" || p.Visibility == VisibilityPublic
+}
+
+// visibilities are the accepted project visibilities
+var visibilities = map[Visibility]bool{
+	VisibilityPublic:   true,
+	VisibilityUnlisted: true,
+	VisibilityDraft:    true,
+}
+
+// ShareLinkCreate is the input for sharing a project
+// @Description Input model for creating a project share link
+// @Name ShareLinkCreate
+type ShareLinkCreate struct {
+	// ExpiresInHours is how long the link works; the configured default when 0
+	ExpiresInHours int `json:"expires_in_hours" validate:"min=0,max=2160" example:"72"`
+}
+
+// ShareLink is a signed link to a project
+// @Description Signed link giving read access to a project
+// @Name ShareLink
+type ShareLink struct {
+	ProjectID uuid.UUID `json:"project_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Token     string    `json:"token" example:"1735689600.9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	URL       string    `json:"url" example:"https://itsrama.dev/projects/550e8400-e29b-41d4-a716-446655440000/preview?token=1735689600.9f86d0"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
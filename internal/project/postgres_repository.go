@@ -0,0 +1,227 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// projectSelect loads projects with their tech stacks embedded the way the
+// REST API returns them
+const projectSelect = `SELECT p.*, COALESCE((
+		SELECT jsonb_agg(jsonb_build_object(
+			'project_id', pts.project_id,
+			'tech_stack_id', pts.tech_stack_id,
+			'tech_stack', jsonb_build_object('id', ts.id, 'name', ts.name)
+		))
+		FROM project_tech_stack pts
+		JOIN tech_stack ts ON ts.id = pts.tech_stack_id
+		WHERE pts.project_id = p.id
+	), '[]'::jsonb) AS project_tech_stack
+	FROM project p`
+
+type postgresProjectRepository struct {
+	db      *database.DB
+	storage storage.Storage
+	table   string
+}
+
+// NewPostgresProjectRepository creates a project repository that queries
+// Postgres directly, replacing the tech stack links of a project in a single
+// transaction
+func NewPostgresProjectRepository(db *database.DB, storage storage.Storage) ProjectRepository {
+	return &postgresProjectRepository{
+		db:      db,
+		storage: storage,
+		table:   "project",
+	}
+}
+
+func (r *postgresProjectRepository) Create(ctx context.Context, project *Project) (*Project, error) {
+	project.TenantID = base.TenantIDFromContext(ctx)
+	if err := r.db.Insert(ctx, r.table, project); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create project")
+	}
+	return project, nil
+}
+
+// CreateProjectTechStack links a tech stack to a project of the tenant
+func (r *postgresProjectRepository) CreateProjectTechStack(ctx context.Context, project *ProjectTechStack) (*ProjectTechStack, error) {
+	exists, err := r.Exists(ctx, project.ProjectID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", project.ProjectID),
+		)
+	}
+
+	if err := r.db.Insert(ctx, "project_tech_stack", project); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create project tech stack")
+	}
+	return project, nil
+}
+
+func (r *postgresProjectRepository) Update(ctx context.Context, project *Project) (*Project, error) {
+	project.TenantID = base.TenantIDFromContext(ctx)
+	filter := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", project.ID))
+	if _, err := r.db.Update(ctx, r.table, project, filter); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update project")
+	}
+	return project, nil
+}
+
+// Delete removes the tech stack links of the project along with it
+func (r *postgresProjectRepository) Delete(ctx context.Context, id string) error {
+	err := r.db.InTx(ctx, func(ctx context.Context) error {
+		if _, err := r.db.Delete(ctx, "project_tech_stack", scopeLinksToTenant(ctx, database.NewFilter().Eq("project_id", id))); err != nil {
+			return err
+		}
+		_, err := r.db.Delete(ctx, r.table, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)))
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete project")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) DeleteProjectTechStack(ctx context.Context, projectID string) error {
+	if _, err := r.db.Delete(ctx, "project_tech_stack", scopeLinksToTenant(ctx, database.NewFilter().Eq("project_id", projectID))); err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete project tech stack")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	_, err := r.db.Update(ctx, r.table, map[string]interface{}{
+		"is_featured": featured,
+		"updated_at":  time.Now().UTC(),
+	}, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)))
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project featured status")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) SetImages(ctx context.Context, id string, images []ProjectImage) error {
+	_, err := r.db.Update(ctx, r.table, map[string]interface{}{
+		"images":     images,
+		"updated_at": time.Now().UTC(),
+	}, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)))
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project images")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) SetReading(ctx context.Context, id string, wordCount, readingTime int) error {
+	_, err := r.db.Update(ctx, r.table, map[string]interface{}{
+		"word_count":   wordCount,
+		"reading_time": readingTime,
+	}, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)))
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project reading statistics")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) SetTOC(ctx context.Context, id string, toc []markdown.Heading) error {
+	_, err := r.db.Update(ctx, r.table, map[string]interface{}{
+		"toc": toc,
+	}, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)))
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project table of contents")
+	}
+	return nil
+}
+
+func (r *postgresProjectRepository) List(ctx context.Context, opts base.ListOptions) ([]ProjectDTO, error) {
+	var projects []ProjectDTO
+	where, args := r.listFilter(ctx, opts).SQL(0)
+	page := database.Page(opts.SortBy, opts.SortOrder == base.SortAscending, opts.Page, opts.PerPage)
+
+	if err := r.db.Select(ctx, &projects, projectSelect+" "+where+" "+page, args...); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list projects")
+	}
+
+	return projects, nil
+}
+
+func (r *postgresProjectRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	filter := base.ApplySQLFilters(base.ScopeFilterToTenant(ctx, database.NewFilter()), filters)
+	where, args := filter.SQL(0)
+
+	count, err := r.db.Count(ctx, "SELECT id FROM project "+where, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count projects")
+	}
+
+	return count, nil
+}
+
+func (r *postgresProjectRepository) Exists(ctx context.Context, id string) (bool, error) {
+	where, args := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)).SQL(0)
+
+	count, err := r.db.Count(ctx, "SELECT id FROM project "+where+" LIMIT 1", args...)
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check project existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *postgresProjectRepository) FindByField(ctx context.Context, field string, value interface{}) ([]ProjectDTO, error) {
+	var projects []ProjectDTO
+	where, args := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq(field, value)).SQL(0)
+
+	if err := r.db.Select(ctx, &projects, projectSelect+" "+where, args...); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find projects by field")
+	}
+	return projects, nil
+}
+
+func (r *postgresProjectRepository) Search(ctx context.Context, opts base.ListOptions) ([]ProjectDTO, int, error) {
+	projects, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search projects")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count projects")
+	}
+
+	return projects, count, nil
+}
+
+// listFilter builds the conditions of a list request, matching the search
+// term against the title and category
+func (r *postgresProjectRepository) listFilter(ctx context.Context, opts base.ListOptions) *database.Filter {
+	filter := base.ApplySQLFilters(base.ScopeFilterToTenant(ctx, database.NewFilter()), opts.Filters)
+	if opts.Search != "" {
+		pattern := "%" + opts.Search + "%"
+		filter = filter.Where("p.title ILIKE ? OR p.category::text ILIKE ?", pattern, pattern)
+	}
+	return filter
+}
+
+// scopeLinksToTenant limits a filter on project_tech_stack, which has no
+// tenant column of its own, to the links of the tenant's projects
+func scopeLinksToTenant(ctx context.Context, filter *database.Filter) *database.Filter {
+	tenant, ok := base.TenantFromContext(ctx)
+	if !ok {
+		return filter
+	}
+	return filter.Where("project_id IN (SELECT id FROM project WHERE tenant_id::text = ?)", tenant.ID.String())
+}
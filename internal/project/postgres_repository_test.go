@@ -0,0 +1,94 @@
+package project
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
+)
+
+func TestScopeLinksToTenant(t *testing.T) {
+	tenantID := uuid.New()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "no tenant",
+			ctx:      context.Background(),
+			wantSQL:  `WHERE ("project_id"::text = $1)`,
+			wantArgs: []any{"p"},
+		},
+		{
+			name:     "tenant",
+			ctx:      base.WithTenant(context.Background(), base.TenantScope{ID: tenantID}),
+			wantSQL:  `WHERE ("project_id"::text = $1) AND (project_id IN (SELECT id FROM project WHERE tenant_id::text = $2))`,
+			wantArgs: []any{"p", tenantID.String()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := scopeLinksToTenant(tt.ctx, database.NewFilter().Eq("project_id", "p")).SQL(0)
+			if sql != tt.wantSQL {
+				t.Errorf("SQL() = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("SQL() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPostgresListFilter(t *testing.T) {
+	tenantID := uuid.New()
+	tenant := base.WithTenant(context.Background(), base.TenantScope{ID: tenantID})
+	repo := &postgresProjectRepository{}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		opts     base.ListOptions
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name: "no tenant",
+			ctx:  context.Background(),
+		},
+		{
+			name:     "tenant",
+			ctx:      tenant,
+			wantSQL:  `WHERE ("tenant_id"::text = $1)`,
+			wantArgs: []any{tenantID.String()},
+		},
+		{
+			name: "tenant with filters and search",
+			ctx:  tenant,
+			opts: base.ListOptions{
+				Filters: []base.FilterOption{{Field: "category", Operator: base.OperatorEqual, Value: "web"}},
+				Search:  "go",
+			},
+			wantSQL:  `WHERE ("tenant_id"::text = $1) AND ("category"::text = $2) AND (p.title ILIKE $3 OR p.category::text ILIKE $4)`,
+			wantArgs: []any{tenantID.String(), "web", "%go%", "%go%"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := repo.listFilter(tt.ctx, tt.opts).SQL(0)
+			if sql != tt.wantSQL {
+				t.Errorf("SQL() = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("SQL() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package project
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *projectService) TechStackReferences(ctx context.Context) ([]tech_stack.Reference, error) {
+	projects, err := s.allProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []tech_stack.Reference
+	for _, project := range projects {
+		for _, link := range project.ProjectTechStack {
+			references = append(references, tech_stack.Reference{
+				Entity:      "project",
+				EntityID:    project.ID,
+				EntityName:  project.Title,
+				TechStackID: link.TechStackID,
+			})
+		}
+	}
+	return references, nil
+}
+
+func (s *projectService) UnlinkTechStacks(ctx context.Context, techStackIDs []uuid.UUID) error {
+	unlinked := make(map[uuid.UUID]bool, len(techStackIDs))
+	for _, id := range techStackIDs {
+		unlinked[id] = true
+	}
+
+	projects, err := s.allProjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		var kept []uuid.UUID
+		for _, link := range project.ProjectTechStack {
+			if !unlinked[link.TechStackID] {
+				kept = append(kept, link.TechStackID)
+			}
+		}
+		if len(kept) == len(project.ProjectTechStack) {
+			continue
+		}
+
+		// Links are replaced as a whole, as updating a project does
+		if err := s.projectRepo.DeleteProjectTechStack(ctx, project.ID.String()); err != nil {
+			return errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to delete project tech stack",
+				errors.WithContext("project_id", project.ID),
+			)
+		}
+		for _, techStackID := range kept {
+			if _, err := s.projectRepo.CreateProjectTechStack(ctx, &ProjectTechStack{ProjectID: project.ID, TechStackID: techStackID}); err != nil {
+				return errors.Wrap(err,
+					errors.ErrDatabase,
+					"Failed to create project tech stack",
+					errors.WithContext("project_id", project.ID),
+					errors.WithContext("tech_stack_id", techStackID),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
 	postgrest "github.com/supabase-community/postgrest-go"
 )
@@ -14,15 +17,22 @@ type ProjectRepository interface {
 	base.BaseRepository[Project, ProjectDTO]
 	CreateProjectTechStack(ctx context.Context, project *ProjectTechStack) (*ProjectTechStack, error)
 	DeleteProjectTechStack(ctx context.Context, projectID string) error
+	SetFeatured(ctx context.Context, id string, featured bool) error
+	SetImages(ctx context.Context, id string, images []ProjectImage) error
+	// SetReading stores recomputed reading statistics without touching
+	// updated_at, as the content itself did not change
+	SetReading(ctx context.Context, id string, wordCount, readingTime int) error
+	// SetTOC stores a rebuilt table of contents without touching updated_at
+	SetTOC(ctx context.Context, id string, toc []markdown.Heading) error
 }
 
 type projectRepository struct {
 	supabaseClient *supabase.SupabaseClient
-	storage        supabase.SupabaseStorage
+	storage        storage.Storage
 	table          string
 }
 
-func NewProjectRepository(supabaseClient *supabase.SupabaseClient, storage supabase.SupabaseStorage) ProjectRepository {
+func NewProjectRepository(supabaseClient *supabase.SupabaseClient, storage storage.Storage) ProjectRepository {
 	return &projectRepository{
 		supabaseClient: supabaseClient,
 		storage:        storage,
@@ -31,6 +41,7 @@ func NewProjectRepository(supabaseClient *supabase.SupabaseClient, storage supab
 }
 
 func (r *projectRepository) Create(ctx context.Context, project *Project) (*Project, error) {
+	project.TenantID = base.TenantIDFromContext(ctx)
 	_, _, err := r.supabaseClient.GetClient().
 		From(r.table).
 		Insert(project, false, "", "minimal", "").
@@ -53,11 +64,12 @@ func (r *projectRepository) CreateProjectTechStack(ctx context.Context, project
 }
 
 func (r *projectRepository) Update(ctx context.Context, project *Project) (*Project, error) {
-	_, _, err := r.supabaseClient.GetClient().
+	project.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Update(project, "minimal", "").
-		Eq("id", project.ID.String()).
-		Execute()
+		Eq("id", project.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update project")
 	}
@@ -65,11 +77,11 @@ func (r *projectRepository) Update(ctx context.Context, project *Project) (*Proj
 }
 
 func (r *projectRepository) Delete(ctx context.Context, id string) error {
-	_, _, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Delete("minimal", "").
-		Eq("id", id).
-		Execute()
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return errors.Wrap(err, errors.ErrDatabase, "failed to delete project")
 	}
@@ -88,11 +100,56 @@ func (r *projectRepository) DeleteProjectTechStack(ctx context.Context, projectI
 	return nil
 }
 
+func (r *projectRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"is_featured": featured,
+			"updated_at":  time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project featured status")
+	}
+	return nil
+}
+
+func (r *projectRepository) SetReading(ctx context.Context, id string, wordCount, readingTime int) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"word_count":   wordCount,
+			"reading_time": readingTime,
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project reading statistics")
+	}
+	return nil
+}
+
+func (r *projectRepository) SetTOC(ctx context.Context, id string, toc []markdown.Heading) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"toc": toc,
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project table of contents")
+	}
+	return nil
+}
+
 func (r *projectRepository) List(ctx context.Context, opts base.ListOptions) ([]ProjectDTO, error) {
 	var projects []ProjectDTO
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, project_tech_stack(tech_stack_id, tech_stack(id, name))", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -138,6 +195,7 @@ func (r *projectRepository) Count(ctx context.Context, filters []base.FilterOpti
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range filters {
@@ -158,12 +216,12 @@ func (r *projectRepository) Count(ctx context.Context, filters []base.FilterOpti
 }
 
 func (r *projectRepository) Exists(ctx context.Context, id string) (bool, error) {
-	_, count, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true).
 		Eq("id", id).
-		Limit(1, "").
-		Execute()
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
 
 	if err != nil {
 		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check project existence")
@@ -174,11 +232,11 @@ func (r *projectRepository) Exists(ctx context.Context, id string) (bool, error)
 
 func (r *projectRepository) FindByField(ctx context.Context, field string, value interface{}) ([]ProjectDTO, error) {
 	var projects []ProjectDTO
-	_, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, project_tech_stack(tech_stack_id, tech_stack(id, name))", "", false).
-		Eq(field, fmt.Sprintf("%v", value)).
-		ExecuteTo(&projects)
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&projects)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find projects by field")
 	}
@@ -190,6 +248,7 @@ func (r *projectRepository) Search(ctx context.Context, opts base.ListOptions) (
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*, project_tech_stack(tech_stack_id, tech_stack(id, name))", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -236,3 +295,18 @@ func (r *projectRepository) Search(ctx context.Context, opts base.ListOptions) (
 
 	return projects, count, nil
 }
+
+func (r *projectRepository) SetImages(ctx context.Context, id string, images []ProjectImage) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"images":     images,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update project images")
+	}
+	return nil
+}
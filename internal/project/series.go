@@ -0,0 +1,50 @@
+package project
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// SeriesNav places a project within a series of projects
+// @Description Place of a project within a series, with its neighbours
+// @Name ProjectSeriesNav
+type SeriesNav struct {
+	CollectionID uuid.UUID `json:"collection_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug         string    `json:"slug" example:"building-this-portfolio"`
+	Title        string    `json:"title" example:"Building this portfolio"`
+	// Position is the 1-based place of the project among Total projects
+	Position int `json:"position" example:"2"`
+	Total    int `json:"total" example:"4"`
+	// Prev and Next are the nearest public projects before and after it
+	Prev *SeriesItem `json:"prev,omitempty"`
+	Next *SeriesItem `json:"next,omitempty"`
+}
+
+// SeriesItem is a neighbour of a project in a series
+// @Description Neighbour of a project in a series
+// @Name ProjectSeriesItem
+type SeriesItem struct {
+	ID    uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug  string    `json:"slug" example:"portfolio-website"`
+	Title string    `json:"title" example:"Portfolio Website"`
+}
+
+// SeriesLookup finds the series a project belongs to. It is implemented by
+// the collection module, which depends on projects itself.
+type SeriesLookup interface {
+	SeriesOf(ctx context.Context, projectID uuid.UUID) ([]SeriesNav, error)
+}
+
+func (s *projectService) WithSeries(ctx context.Context, project *ProjectDTO) error {
+	if s.seriesLookup == nil {
+		return nil
+	}
+
+	series, err := s.seriesLookup.SeriesOf(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	project.Series = series
+	return nil
+}
@@ -2,20 +2,32 @@ package project
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"mime/multipart"
+	"net/http"
+	"path"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/company"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
 	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
 	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
-	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
-	storage_go "github.com/supabase-community/storage-go"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/screenshot"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 )
 
+// screenshotName is the file name of captured project screenshots
+const screenshotName = "screenshot"
+
 type ProjectService interface {
 	CreateProject(ctx context.Context, projectCreate *ProjectCreate) (*ProjectDTO, error)
 	GetProjectByID(ctx context.Context, id string) (*ProjectDTO, error)
@@ -27,20 +39,70 @@ type ProjectService interface {
 	BulkCreateProjects(ctx context.Context, projectsCreate []*ProjectCreate) ([]ProjectDTO, error)
 	BulkUpdateProjects(ctx context.Context, projectsUpdate []*ProjectUpdate) ([]ProjectDTO, error)
 	BulkDeleteProjects(ctx context.Context, ids []string) error
-	uploadProjectImages(ctx context.Context, projectID string, files []*multipart.FileHeader) ([]string, error)
+	// BulkCreateProjectsPartial, BulkUpdateProjectsPartial and BulkDeleteProjectsPartial
+	// write every item independently, carrying on past failures and
+	// reporting the outcome of each
+	BulkCreateProjectsPartial(ctx context.Context, projectsCreate []*ProjectCreate) *base.BulkResult
+	BulkUpdateProjectsPartial(ctx context.Context, projectsUpdate []*ProjectUpdate) *base.BulkResult
+	BulkDeleteProjectsPartial(ctx context.Context, ids []string) *base.BulkResult
+	// DryRunCreateProjects, DryRunUpdateProjects and DryRunDeleteProjects
+	// validate a bulk write, including slug conflicts and missing tech stack
+	// references, without writing anything
+	DryRunCreateProjects(ctx context.Context, projectsCreate []*ProjectCreate) (*base.DryRun, error)
+	DryRunUpdateProjects(ctx context.Context, projectsUpdate []*ProjectUpdate) (*base.DryRun, error)
+	DryRunDeleteProjects(ctx context.Context, ids []string) (*base.DryRun, error)
+	// FindDuplicateProjects returns the projects other than excludeID whose
+	// title matches once normalized, or none when duplicate checks are off
+	FindDuplicateProjects(ctx context.Context, title string, excludeID uuid.UUID) ([]base.DuplicateMatch, error)
+	// FindDuplicateGroups groups existing projects sharing a normalized title
+	FindDuplicateGroups(ctx context.Context) ([]base.DuplicateGroup, error)
+	// Referrer lets tech stacks linked from here be checked and unlinked
+	// before they are deleted
+	tech_stack.Referrer
+	SetProjectFeatured(ctx context.Context, id string, featured bool) (*ProjectDTO, error)
+	CaptureScreenshot(ctx context.Context, id string) (*ProjectDTO, error)
+	GetImpactSummary(ctx context.Context) (*ImpactSummary, error)
+	// CreateShareLink signs a link giving read access to a project, including
+	// unlisted and draft projects
+	CreateShareLink(ctx context.Context, id string, shareLinkCreate *ShareLinkCreate) (*ShareLink, error)
+	// SetImageAlt sets the alt text of the project image stored at src
+	SetImageAlt(ctx context.Context, id string, src string, alt string) (*ProjectDTO, error)
+	// RecomputeReadingTimes recomputes the reading statistics of every
+	// project and returns how many changed
+	RecomputeReadingTimes(ctx context.Context) (int, error)
+	// RebuildTOCs rebuilds the table of contents of every project and
+	// returns how many changed
+	RebuildTOCs(ctx context.Context) (int, error)
+	// WithSeries fills in the series the project belongs to
+	WithSeries(ctx context.Context, project *ProjectDTO) error
+	uploadProjectImages(ctx context.Context, projectID string, files []*multipart.FileHeader) ([]ProjectImage, error)
 }
 
 type projectService struct {
 	projectRepo      ProjectRepository
 	techStackService tech_stack.TechStackService
-	storage          supabase.SupabaseStorage
+	companyService   company.CompanyService
+	storage          storage.Storage
+	assets           asset.AssetService
+	capturer         screenshot.Capturer
+	publisher        events.Publisher
+	shareSigner      *ShareSigner
+	seriesLookup     SeriesLookup
+	dedupMode        base.DedupMode
 }
 
-func NewProjectService(projectRepo ProjectRepository, techStackService tech_stack.TechStackService, storage supabase.SupabaseStorage) ProjectService {
+func NewProjectService(projectRepo ProjectRepository, techStackService tech_stack.TechStackService, companyService company.CompanyService, storage storage.Storage, assets asset.AssetService, capturer screenshot.Capturer, publisher events.Publisher, shareSigner *ShareSigner, seriesLookup SeriesLookup, dedupMode base.DedupMode) ProjectService {
 	return &projectService{
 		projectRepo:      projectRepo,
 		techStackService: techStackService,
+		companyService:   companyService,
 		storage:          storage,
+		assets:           assets,
+		capturer:         capturer,
+		publisher:        publisher,
+		shareSigner:      shareSigner,
+		seriesLookup:     seriesLookup,
+		dedupMode:        dedupMode,
 	}
 }
 
@@ -50,15 +112,46 @@ func (s *projectService) CreateProject(ctx context.Context, projectCreate *Proje
 		return nil, err
 	}
 
+	if err := validateVisibility(projectCreate.Visibility); err != nil {
+		return nil, err
+	}
+
+	if err := projectCreate.SEO.Normalize(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDuplicates(ctx, projectCreate); err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 	project := projectCreate.ToProject()
 	project.ID = uuid.New()
 	project.CreatedAt = &now
 	project.UpdatedAt = &now
+	if project.Visibility == "" {
+		project.Visibility = VisibilityPublic
+	}
+
+	metrics, err := normalizeMetrics(projectCreate.Metrics)
+	if err != nil {
+		return nil, err
+	}
+	project.Metrics = metrics
+	timeline, err := normalizeTimeline(projectCreate.Timeline)
+	if err != nil {
+		return nil, err
+	}
+	project.Timeline = timeline
+	if project.Client, err = s.resolveClient(ctx, projectCreate.Client); err != nil {
+		return nil, err
+	}
+	project.WordCount, project.ReadingTime = readingStats(project.Description, project.Features)
+	project.TOC = markdown.TableOfContents(project.Description)
 
 	// Upload images if provided
 	if len(projectCreate.UploadedImages) > 0 {
-		imageURLs, err := s.uploadProjectImages(ctx, project.ID.String(), projectCreate.UploadedImages)
+		images, err := s.uploadProjectImages(ctx, project.ID.String(), projectCreate.UploadedImages)
 		if err != nil {
 			return nil, errors.Wrap(err,
 				errors.ErrInternal,
@@ -67,16 +160,7 @@ func (s *projectService) CreateProject(ctx context.Context, projectCreate *Proje
 			)
 		}
 
-		imageData := make([]ProjectImage, len(imageURLs))
-		for i, url := range imageURLs {
-			imageData[i] = ProjectImage{
-				Src:         url,
-				Alt:         filepath.Base(url),
-				IsThumbnail: i == 0,
-			}
-		}
-
-		project.Images = imageData
+		project.Images = images
 	}
 
 	// Create project in repository
@@ -128,6 +212,13 @@ func (s *projectService) CreateProject(ctx context.Context, projectCreate *Proje
 
 	createdProjectDTO := createdProject.ToDTO(projectTechStack)
 
+	s.publish(ctx, &createdProjectDTO, events.Event{
+		Type:     events.ProjectCreated,
+		Entity:   "project",
+		EntityID: createdProject.ID.String(),
+		Summary:  fmt.Sprintf("Added project %s", createdProject.Title),
+	})
+
 	return &createdProjectDTO, nil
 }
 
@@ -142,7 +233,12 @@ func (s *projectService) GetProjectByID(ctx context.Context, id string) (*Projec
 	}
 
 	if len(projects) == 0 {
-		return nil, fmt.Errorf("project not found")
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", id),
+		)
 	}
 
 	return &projects[0], nil
@@ -159,11 +255,15 @@ func (s *projectService) UpdateProject(ctx context.Context, projectUpdate *Proje
 		)
 	}
 
+	if err := validateVisibility(projectUpdate.Visibility); err != nil {
+		return nil, err
+	}
+
 	// Retrieve existing project
 	existingProject, err := s.GetProjectByID(ctx, projectUpdate.ID.String())
 	if err != nil {
 		return nil, errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing project",
 			errors.WithContext("project_id", projectUpdate.ID),
 		)
@@ -174,9 +274,44 @@ func (s *projectService) UpdateProject(ctx context.Context, projectUpdate *Proje
 	project.CreatedAt = existingProject.CreatedAt
 	project.UpdatedAt = &now
 
+	// Replace metrics only when provided
+	if projectUpdate.Metrics != nil {
+		metrics, err := normalizeMetrics(projectUpdate.Metrics)
+		if err != nil {
+			return nil, err
+		}
+		project.Metrics = metrics
+	} else if existingProject.Metrics != nil {
+		project.Metrics = existingProject.Metrics
+	} else {
+		project.Metrics = []ProjectMetric{}
+	}
+
+	// Replace the timeline only when provided
+	if projectUpdate.Timeline != nil {
+		timeline, err := normalizeTimeline(projectUpdate.Timeline)
+		if err != nil {
+			return nil, err
+		}
+		project.Timeline = timeline
+	} else if existingProject.Timeline != nil {
+		project.Timeline = existingProject.Timeline
+	} else {
+		project.Timeline = []TimelinePhase{}
+	}
+
+	// Replace the client only when provided
+	if projectUpdate.Client != nil {
+		if project.Client, err = s.resolveClient(ctx, projectUpdate.Client); err != nil {
+			return nil, err
+		}
+	} else {
+		project.Client = existingProject.Client
+	}
+
 	// Upload images if provided
 	if len(projectUpdate.UploadedImages) > 0 {
-		imageURLs, err := s.uploadProjectImages(ctx, project.ID.String(), projectUpdate.UploadedImages)
+		images, err := s.uploadProjectImages(ctx, project.ID.String(), projectUpdate.UploadedImages)
 		if err != nil {
 			return nil, errors.Wrap(err,
 				errors.ErrInternal,
@@ -185,16 +320,7 @@ func (s *projectService) UpdateProject(ctx context.Context, projectUpdate *Proje
 			)
 		}
 
-		imageData := make([]ProjectImage, len(imageURLs))
-		for i, url := range imageURLs {
-			imageData[i] = ProjectImage{
-				Src:         url,
-				Alt:         filepath.Base(url),
-				IsThumbnail: i == 0,
-			}
-		}
-
-		project.Images = imageData
+		project.Images = images
 	} else {
 		project.Images = existingProject.Images
 	}
@@ -233,6 +359,21 @@ func (s *projectService) UpdateProject(ctx context.Context, projectUpdate *Proje
 	if !validator.IsValueChanged(&existingProject.ProgressPercentage, &project.ProgressPercentage) {
 		project.ProgressPercentage = existingProject.ProgressPercentage
 	}
+	if project.Visibility == "" {
+		project.Visibility = existingProject.Visibility
+	}
+	project.WordCount, project.ReadingTime = readingStats(project.Description, project.Features)
+	project.TOC = markdown.TableOfContents(project.Description)
+
+	// Replace the search engine directives only when provided
+	if projectUpdate.SEO != nil {
+		if err := projectUpdate.SEO.Normalize(); err != nil {
+			return nil, err
+		}
+		project.SEO = *projectUpdate.SEO
+	} else {
+		project.SEO = existingProject.SEO
+	}
 
 	// Update project in repository
 	updatedProject, err := s.projectRepo.Update(ctx, &project)
@@ -293,6 +434,13 @@ func (s *projectService) UpdateProject(ctx context.Context, projectUpdate *Proje
 
 	updatedProjectDTO := updatedProject.ToDTO(projectTechStack)
 
+	s.publish(ctx, &updatedProjectDTO, events.Event{
+		Type:     events.ProjectUpdated,
+		Entity:   "project",
+		EntityID: updatedProject.ID.String(),
+		Summary:  fmt.Sprintf("Updated project %s", updatedProject.Title),
+	})
+
 	return &updatedProjectDTO, nil
 }
 
@@ -309,7 +457,7 @@ func (s *projectService) DeleteProject(ctx context.Context, id string) error {
 	existingProject, err := s.GetProjectByID(ctx, id)
 	if err != nil {
 		return errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing project",
 			errors.WithContext("project_id", id),
 		)
@@ -335,8 +483,8 @@ func (s *projectService) DeleteProject(ctx context.Context, id string) error {
 	// Delete associated images if exists
 	for _, image := range existingProject.Images {
 		if image.Src != "" {
-			imagePath := filepath.Join(fmt.Sprintf("itsrama/images/project/%s/", existingProject.ID), filepath.Base(image.Src))
-			_, err = s.storage.Delete(ctx, imagePath)
+			imagePath := filepath.Join("itsrama", base.TenantStoragePath(ctx, fmt.Sprintf("images/project/%s", existingProject.ID)), filepath.Base(image.Src))
+			err = s.storage.Delete(ctx, imagePath)
 			if err != nil {
 				// Log the error but don't return it to avoid blocking the deletion
 				fmt.Printf("Failed to delete project image: %v\n", err)
@@ -344,6 +492,13 @@ func (s *projectService) DeleteProject(ctx context.Context, id string) error {
 		}
 	}
 
+	s.publish(ctx, existingProject, events.Event{
+		Type:     events.ProjectDeleted,
+		Entity:   "project",
+		EntityID: id,
+		Summary:  fmt.Sprintf("Deleted project %s", existingProject.Title),
+	})
+
 	return nil
 }
 
@@ -436,7 +591,306 @@ func (s *projectService) BulkDeleteProjects(ctx context.Context, ids []string) e
 	return nil
 }
 
-func (s *projectService) uploadProjectImages(ctx context.Context, projectID string, files []*multipart.FileHeader) ([]string, error) {
+func (s *projectService) BulkCreateProjectsPartial(ctx context.Context, projectsCreate []*ProjectCreate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, projectCreate := range projectsCreate {
+		created, err := s.CreateProject(ctx, projectCreate)
+		if err != nil {
+			result.Fail(i, "", err)
+			continue
+		}
+		result.Succeed(i, http.StatusCreated, created.ID.String())
+	}
+
+	return result
+}
+
+func (s *projectService) BulkUpdateProjectsPartial(ctx context.Context, projectsUpdate []*ProjectUpdate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, projectUpdate := range projectsUpdate {
+		updated, err := s.UpdateProject(ctx, projectUpdate)
+		if err != nil {
+			result.Fail(i, projectUpdate.ID.String(), err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, updated.ID.String())
+	}
+
+	return result
+}
+
+func (s *projectService) BulkDeleteProjectsPartial(ctx context.Context, ids []string) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, id := range ids {
+		if err := s.DeleteProject(ctx, id); err != nil {
+			result.Fail(i, id, err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, id)
+	}
+
+	return result
+}
+
+// SetProjectFeatured toggles whether a project is featured
+func (s *projectService) SetProjectFeatured(ctx context.Context, id string, featured bool) (*ProjectDTO, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Project not found",
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	if err := s.projectRepo.SetFeatured(ctx, id, featured); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	project.IsFeatured = featured
+	project.UpdatedAt = &now
+
+	summary := fmt.Sprintf("Unfeatured project %s", project.Title)
+	if featured {
+		summary = fmt.Sprintf("Featured project %s", project.Title)
+	}
+
+	s.publish(ctx, project, events.Event{
+		Type:     events.ProjectUpdated,
+		Entity:   "project",
+		EntityID: project.ID.String(),
+		Summary:  summary,
+	})
+
+	return project, nil
+}
+
+// CaptureScreenshot captures the live site at the project's web URL and
+// stores it as the project thumbnail, replacing any previous screenshot
+func (s *projectService) CaptureScreenshot(ctx context.Context, id string) (*ProjectDTO, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Project not found",
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	if project.WebUrl == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Project has no web URL to capture",
+			nil,
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	data, contentType, err := s.capturer.Capture(ctx, project.WebUrl)
+	if stderrors.Is(err, screenshot.ErrDisabled) {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Screenshot capture is not configured",
+			err,
+		)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNetwork,
+			"Failed to capture project screenshot",
+			errors.WithContext("web_url", project.WebUrl),
+		)
+	}
+
+	ext := ".png"
+	if contentType == "image/jpeg" {
+		ext = ".jpg"
+	}
+	destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/project/%s/%s%s", id, screenshotName, ext))
+
+	uploaded, err := s.assets.UploadBytes(ctx, data, destPath, contentType)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to upload project screenshot",
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	// The screenshot becomes the only thumbnail and replaces the previous one
+	images := []ProjectImage{{
+		Src:           uploaded.URL,
+		Alt:           fmt.Sprintf("Screenshot of %s", project.Title),
+		IsThumbnail:   true,
+		Placeholder:   uploaded.Placeholder,
+		DominantColor: uploaded.DominantColor,
+	}}
+	for _, image := range project.Images {
+		if strings.HasPrefix(path.Base(image.Src), screenshotName+".") {
+			continue
+		}
+		image.IsThumbnail = false
+		images = append(images, image)
+	}
+
+	if err := s.projectRepo.SetImages(ctx, id, images); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	project.Images = images
+	project.UpdatedAt = &now
+
+	s.publish(ctx, project, events.Event{
+		Type:     events.ProjectUpdated,
+		Entity:   "project",
+		EntityID: project.ID.String(),
+		Summary:  fmt.Sprintf("Captured screenshot of project %s", project.Title),
+	})
+
+	return project, nil
+}
+
+// GetImpactSummary aggregates the metrics of every featured project
+func (s *projectService) GetImpactSummary(ctx context.Context) (*ImpactSummary, error) {
+	opts := base.ListOptions{
+		Page:    1,
+		PerPage: 100,
+		Filters: []base.FilterOption{{
+			Field:    "is_featured",
+			Operator: base.OperatorEqual,
+			Value:    true,
+		}, {
+			Field:    "visibility",
+			Operator: base.OperatorEqual,
+			Value:    VisibilityPublic,
+		}},
+	}
+
+	var featured []ProjectDTO
+	for {
+		projects, err := s.ListProjects(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		featured = append(featured, projects...)
+		if len(projects) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	summary := SummarizeImpact(featured)
+	return &summary, nil
+}
+
+func (s *projectService) RecomputeReadingTimes(ctx context.Context) (int, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	updated := 0
+	for {
+		projects, err := s.ListProjects(ctx, opts)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, project := range projects {
+			wordCount, readingTime := readingStats(project.Description, project.Features)
+			if wordCount == project.WordCount && readingTime == project.ReadingTime {
+				continue
+			}
+			if err := s.projectRepo.SetReading(ctx, project.ID.String(), wordCount, readingTime); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(projects) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	return updated, nil
+}
+
+func (s *projectService) RebuildTOCs(ctx context.Context) (int, error) {
+	opts := base.ListOptions{Page: 1, PerPage: 100}
+
+	updated := 0
+	for {
+		projects, err := s.ListProjects(ctx, opts)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, project := range projects {
+			toc := markdown.TableOfContents(project.Description)
+			if reflect.DeepEqual(toc, project.TOC) {
+				continue
+			}
+			if err := s.projectRepo.SetTOC(ctx, project.ID.String(), toc); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(projects) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	return updated, nil
+}
+
+func (s *projectService) CreateShareLink(ctx context.Context, id string, shareLinkCreate *ShareLinkCreate) (*ShareLink, error) {
+	// Validate input
+	if err := validator.ValidateModel(shareLinkCreate); err != nil {
+		return nil, err
+	}
+
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrNotFound,
+			"Project not found",
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	return s.shareSigner.Sign(project.ID, time.Duration(shareLinkCreate.ExpiresInHours)*time.Hour), nil
+}
+
+// publish announces a change to a project unless it is hidden from the public
+func (s *projectService) publish(ctx context.Context, project *ProjectDTO, event events.Event) {
+	if !project.IsPublic() {
+		return
+	}
+	s.publisher.Publish(ctx, event)
+}
+
+// validateVisibility rejects unknown visibilities; empty keeps the default
+func validateVisibility(visibility Visibility) error {
+	if visibility == "" || visibilities[visibility] {
+		return nil
+	}
+
+	return errors.New(
+		errors.ErrValidation,
+		"Unknown project visibility",
+		nil,
+		errors.WithContext("visibility", visibility),
+	)
+}
+
+// uploadProjectImages stores the uploaded images of a project, the first one
+// being its thumbnail
+func (s *projectService) uploadProjectImages(ctx context.Context, projectID string, files []*multipart.FileHeader) ([]ProjectImage, error) {
 	if projectID == "" {
 		return nil, fmt.Errorf("project ID cannot be empty")
 	}
@@ -444,11 +898,11 @@ func (s *projectService) uploadProjectImages(ctx context.Context, projectID stri
 		return nil, fmt.Errorf("at least one file is required")
 	}
 
-	imageURLs := make([]string, len(files))
+	images := make([]ProjectImage, len(files))
 	for i, file := range files {
-		destPath := fmt.Sprintf("images/project/%s/%d%s", projectID, i, filepath.Ext(file.Filename))
+		destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/project/%s/%d%s", projectID, i, filepath.Ext(file.Filename)))
 
-		_, err := s.storage.Upload(ctx, file, destPath, storage_go.FileOptions{
+		uploaded, err := s.assets.Upload(ctx, file, destPath, storage.FileOptions{
 			ContentType: func(s string) *string { return &s }("image"),
 			Upsert:      func(b bool) *bool { return &b }(true),
 		})
@@ -460,17 +914,14 @@ func (s *projectService) uploadProjectImages(ctx context.Context, projectID stri
 			)
 		}
 
-		signedURL, err := s.storage.GetPublicURL(destPath)
-		if err != nil {
-			return nil, errors.Wrap(err,
-				errors.ErrInternal,
-				"Failed to get public URL for project image",
-				errors.WithContext("dest_path", destPath),
-			)
+		images[i] = ProjectImage{
+			Src:           uploaded.URL,
+			Alt:           filepath.Base(uploaded.URL),
+			IsThumbnail:   i == 0,
+			Placeholder:   uploaded.Placeholder,
+			DominantColor: uploaded.DominantColor,
 		}
-
-		imageURLs[i] = signedURL
 	}
 
-	return imageURLs, nil
+	return images, nil
 }
@@ -0,0 +1,86 @@
+package project
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/signedlink"
+)
+
+// sharedProjectKey is the gin context key holding the ID of the project a
+// verified share link grants access to
+const sharedProjectKey = "shared_project_id"
+
+// ShareSigner creates and verifies expiring links that give read access to a
+// single project, including unlisted and draft projects
+type ShareSigner struct {
+	signer  *signedlink.Signer
+	baseURL string
+	ttl     time.Duration
+}
+
+// NewShareSigner creates a signer for share links below baseURL that stay
+// valid for ttl unless another lifetime is given
+func NewShareSigner(secret []byte, baseURL string, ttl time.Duration) *ShareSigner {
+	if ttl <= 0 {
+		ttl = 72 * time.Hour
+	}
+
+	return &ShareSigner{
+		signer:  signedlink.New(secret, "project-share"),
+		baseURL: strings.TrimRight(baseURL, "/"),
+		ttl:     ttl,
+	}
+}
+
+// Sign returns a share link to a project valid for ttl, or the default
+// lifetime when ttl is zero
+func (s *ShareSigner) Sign(projectID uuid.UUID, ttl time.Duration) *ShareLink {
+	if ttl <= 0 {
+		ttl = s.ttl
+	}
+
+	expiresAt := time.Now().UTC().Add(ttl).Truncate(time.Second)
+	expires, signature := s.signer.Sign(expiresAt, projectID.String())
+	token := expires + "." + signature
+
+	query := url.Values{}
+	query.Set("token", token)
+
+	return &ShareLink{
+		ProjectID: projectID,
+		Token:     token,
+		URL:       s.baseURL + "/projects/" + projectID.String() + "/shared?" + query.Encode(),
+		ExpiresAt: expiresAt,
+	}
+}
+
+// Verify reports whether token was issued for the project and has not expired
+func (s *ShareSigner) Verify(projectID, token string) bool {
+	expires, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+
+	return s.signer.Verify(expires, signature, projectID)
+}
+
+// Middleware rejects requests whose token query parameter is not a valid
+// share link for the project in the id path parameter
+func (s *ShareSigner) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("id"))
+		if err != nil || !s.Verify(projectID.String(), c.Query("token")) {
+			response.Forbidden(c, "invalid_share_link", "Share link is invalid or has expired", "")
+			c.Abort()
+			return
+		}
+
+		c.Set(sharedProjectKey, projectID.String())
+		c.Next()
+	}
+}
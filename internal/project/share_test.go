@@ -0,0 +1,43 @@
+package project
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestShareSignerVerify(t *testing.T) {
+	signer := NewShareSigner([]byte("secret"), "https://example.com", time.Hour)
+	projectID := uuid.New()
+	token := signer.Sign(projectID, 0).Token
+	expires, signature, _ := strings.Cut(token, ".")
+
+	// Sign never issues expired links, so one is signed directly
+	expiredAt, expiredSignature := signer.signer.Sign(time.Now().Add(-time.Minute), projectID.String())
+
+	tests := []struct {
+		name      string
+		signer    *ShareSigner
+		projectID string
+		token     string
+		want      bool
+	}{
+		{"valid", signer, projectID.String(), token, true},
+		{"expired", signer, projectID.String(), expiredAt + "." + expiredSignature, false},
+		{"other project", signer, uuid.NewString(), token, false},
+		{"tampered signature", signer, projectID.String(), expires + "." + strings.Repeat("0", len(signature)), false},
+		{"extended expiry", signer, projectID.String(), "99999999999." + signature, false},
+		{"missing signature", signer, projectID.String(), expires, false},
+		{"other secret", NewShareSigner([]byte("other"), "https://example.com", time.Hour), projectID.String(), token, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.signer.Verify(tt.projectID, tt.token); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
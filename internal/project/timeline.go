@@ -0,0 +1,267 @@
+package project
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// PhaseKind is the stage of a project a timeline phase belongs to
+// @Description Stage of a project timeline phase
+// @Name PhaseKind
+type PhaseKind string
+
+const (
+	PhaseDesign PhaseKind = "design"
+	PhaseBuild  PhaseKind = "build"
+	PhaseLaunch PhaseKind = "launch"
+)
+
+// phaseRanks orders the phase kinds; a timeline lists them in this order
+var phaseRanks = map[PhaseKind]int{
+	PhaseDesign: 0,
+	PhaseBuild:  1,
+	PhaseLaunch: 2,
+}
+
+const maxTimelinePhases = 20
+
+// TimelinePhase is a dated stage of a project and the effort it took
+// @Description Dated stage of a project with the effort spent on it
+// @Name TimelinePhase
+type TimelinePhase struct {
+	Kind  PhaseKind `json:"kind" example:"build"`
+	Label string    `json:"label,omitempty" example:"Backend API"`
+	// StartDate and EndDate are inclusive. Only the last phase may leave
+	// EndDate out while it is still ongoing.
+	StartDate   utils.CustomDate  `json:"start_date" example:"2024-02-01" swaggertype:"string"`
+	EndDate     *utils.CustomDate `json:"end_date,omitempty" example:"2024-03-15" swaggertype:"string"`
+	EffortHours float64           `json:"effort_hours" example:"120"`
+}
+
+// ProjectTimeline lays out the phases of a project for a Gantt chart
+// @Description Phases of a project laid out for a Gantt chart
+// @Name ProjectTimeline
+type ProjectTimeline struct {
+	ProjectID uuid.UUID         `json:"project_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title     string            `json:"title" example:"Portfolio Website"`
+	StartDate *utils.CustomDate `json:"start_date" example:"2024-01-08" swaggertype:"string"`
+	EndDate   *utils.CustomDate `json:"end_date" example:"2024-04-30" swaggertype:"string"`
+	// DurationDays spans from the first to the last day of the timeline
+	DurationDays     int          `json:"duration_days" example:"114"`
+	TotalEffortHours float64      `json:"total_effort_hours" example:"260"`
+	Phases           []GanttPhase `json:"phases"`
+}
+
+// GanttPhase is a bar of a project timeline
+// @Description Phase of a project timeline positioned for a Gantt chart
+// @Name GanttPhase
+type GanttPhase struct {
+	Kind      PhaseKind         `json:"kind" example:"build"`
+	Label     string            `json:"label" example:"Backend API"`
+	StartDate *utils.CustomDate `json:"start_date" example:"2024-02-01" swaggertype:"string"`
+	EndDate   *utils.CustomDate `json:"end_date" example:"2024-03-15" swaggertype:"string"`
+	// OffsetDays is how many days after the start of the timeline the
+	// phase starts
+	OffsetDays   int `json:"offset_days" example:"24"`
+	DurationDays int `json:"duration_days" example:"44"`
+	// Ongoing phases end today until they are given an end date
+	Ongoing     bool    `json:"ongoing" example:"false"`
+	EffortHours float64 `json:"effort_hours" example:"120"`
+	// EffortShare is the fraction of the total effort spent on the phase
+	EffortShare float64 `json:"effort_share" example:"0.46"`
+}
+
+// normalizeTimeline validates timeline phases and returns them with
+// trimmed labels and lower-cased kinds. Phases must be listed in order of
+// their kind and start date, and later stages cannot end before earlier
+// ones.
+func normalizeTimeline(phases []TimelinePhase) ([]TimelinePhase, error) {
+	if len(phases) > maxTimelinePhases {
+		return nil, errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("A project can have at most %d timeline phases", maxTimelinePhases),
+			nil,
+		)
+	}
+
+	normalized := make([]TimelinePhase, 0, len(phases))
+	// latestEnd is the latest end of the phases so far, and previousKindEnd
+	// that of the phases of an earlier kind than the current one
+	var latestEnd, previousKindEnd time.Time
+	for i, phase := range phases {
+		phase.Kind = PhaseKind(strings.ToLower(strings.TrimSpace(string(phase.Kind))))
+		phase.Label = strings.TrimSpace(phase.Label)
+
+		rank, ok := phaseRanks[phase.Kind]
+		if !ok {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Unknown timeline phase %q", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+				errors.WithContext("allowed_phases", []PhaseKind{PhaseDesign, PhaseBuild, PhaseLaunch}),
+			)
+		}
+		if len(phase.Label) > 100 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Timeline phase label must be at most 100 characters",
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if phase.StartDate.IsZero() {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Timeline phase %q needs a start date", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if phase.EndDate != nil && phase.EndDate.IsZero() {
+			phase.EndDate = nil
+		}
+		if phase.EndDate == nil && i < len(phases)-1 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Only the last timeline phase may be ongoing",
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if phase.EndDate != nil && phase.EndDate.Before(phase.StartDate.Time) {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Timeline phase %q ends before it starts", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if math.IsNaN(phase.EffortHours) || math.IsInf(phase.EffortHours, 0) || phase.EffortHours < 0 {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Timeline phase %q must have a non-negative effort", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if phase.EndDate != nil && phase.EffortHours > float64(24*inclusiveDays(phase.StartDate.Time, phase.EndDate.Time)) {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Timeline phase %q has more effort than hours between its dates", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+
+		if i > 0 {
+			previous := normalized[i-1]
+			previousRank := phaseRanks[previous.Kind]
+			if rank < previousRank {
+				return nil, errors.New(
+					errors.ErrValidation,
+					fmt.Sprintf("Timeline phase %q cannot come after %q", phase.Kind, previous.Kind),
+					nil,
+					errors.WithContext("index", i),
+				)
+			}
+			if phase.StartDate.Before(previous.StartDate.Time) {
+				return nil, errors.New(
+					errors.ErrValidation,
+					"Timeline phases must be listed by start date",
+					nil,
+					errors.WithContext("index", i),
+				)
+			}
+			if rank > previousRank {
+				previousKindEnd = latestEnd
+			}
+		}
+		if phase.EndDate != nil && phase.EndDate.Before(previousKindEnd) {
+			return nil, errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Timeline phase %q cannot end before an earlier stage ends", phase.Kind),
+				nil,
+				errors.WithContext("index", i),
+			)
+		}
+		if phase.EndDate != nil && phase.EndDate.After(latestEnd) {
+			latestEnd = phase.EndDate.Time
+		}
+
+		normalized = append(normalized, phase)
+	}
+
+	return normalized, nil
+}
+
+// BuildTimeline positions the phases of a project on a day scale starting
+// at its first phase, ending ongoing phases on the day of now
+func BuildTimeline(project ProjectDTO, now time.Time) ProjectTimeline {
+	timeline := ProjectTimeline{
+		ProjectID: project.ID,
+		Title:     project.Title,
+		Phases:    []GanttPhase{},
+	}
+	if len(project.Timeline) == 0 {
+		return timeline
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	start := project.Timeline[0].StartDate.Time
+	var end time.Time
+	for _, phase := range project.Timeline {
+		timeline.TotalEffortHours += phase.EffortHours
+		if phaseEnd := endOf(phase, today); phaseEnd.After(end) {
+			end = phaseEnd
+		}
+	}
+	timeline.StartDate = &utils.CustomDate{Time: start}
+	timeline.EndDate = &utils.CustomDate{Time: end}
+	timeline.DurationDays = inclusiveDays(start, end)
+
+	for _, phase := range project.Timeline {
+		phaseEnd := endOf(phase, today)
+		bar := GanttPhase{
+			Kind:         phase.Kind,
+			Label:        phase.Label,
+			StartDate:    &utils.CustomDate{Time: phase.StartDate.Time},
+			EndDate:      &utils.CustomDate{Time: phaseEnd},
+			OffsetDays:   inclusiveDays(start, phase.StartDate.Time) - 1,
+			DurationDays: inclusiveDays(phase.StartDate.Time, phaseEnd),
+			Ongoing:      phase.EndDate == nil,
+			EffortHours:  phase.EffortHours,
+		}
+		if bar.Label == "" {
+			bar.Label = strings.ToUpper(string(phase.Kind[:1])) + string(phase.Kind[1:])
+		}
+		if timeline.TotalEffortHours > 0 {
+			bar.EffortShare = math.Round(phase.EffortHours/timeline.TotalEffortHours*100) / 100
+		}
+		timeline.Phases = append(timeline.Phases, bar)
+	}
+
+	return timeline
+}
+
+// endOf is the last day of a phase, today while it is ongoing
+func endOf(phase TimelinePhase, today time.Time) time.Time {
+	if phase.EndDate != nil {
+		return phase.EndDate.Time
+	}
+	if today.Before(phase.StartDate.Time) {
+		return phase.StartDate.Time
+	}
+	return today
+}
+
+// inclusiveDays counts the days from start to end, both included
+func inclusiveDays(start, end time.Time) int {
+	return int(end.Sub(start).Hours()/24) + 1
+}
@@ -0,0 +1,102 @@
+package project
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+)
+
+// PublicProject is a project as visitors see it. Confidential clients are
+// redacted and the visibility is left out.
+// @Description Project as shown publicly
+// @Name PublicProject
+type PublicProject struct {
+	ID                 uuid.UUID                `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug               string                   `json:"slug" example:"portfolio-website"`
+	Title              string                   `json:"title" example:"Portfolio Website"`
+	Subtitle           string                   `json:"subtitle" example:"Personal portfolio showcasing projects"`
+	Description        string                   `json:"description" example:"A responsive website to display my professional projects and skills"`
+	MyRole             []string                 `json:"my_role" example:"Full-stack Developer,UI/UX Designer"`
+	Category           ProjectCategory          `json:"category" example:"Web Development"`
+	GithubUrl          string                   `json:"github_url,omitempty" example:"https://github.com/username/project"`
+	WebUrl             string                   `json:"web_url,omitempty" example:"https://myportfolio.com"`
+	Images             []ProjectImage           `json:"images"`
+	Features           []string                 `json:"features" example:"Responsive Design,Dark Mode"`
+	Metrics            []ProjectMetric          `json:"metrics"`
+	Timeline           []TimelinePhase          `json:"timeline"`
+	Client             *ProjectClient           `json:"client,omitempty"`
+	DevelopmentStatus  DevelopmentStatus        `json:"development_status" example:"Beta"`
+	ProgressStatus     ProgressStatus           `json:"progress_status" example:"In Progress"`
+	ProgressPercentage int                      `json:"progress_percentage" example:"75"`
+	IsFeatured         bool                     `json:"is_featured" example:"true"`
+	SEO                base.SEO                 `json:"seo"`
+	WordCount          int                      `json:"word_count" example:"840"`
+	ReadingTime        int                      `json:"reading_time" example:"5"`
+	TOC                []markdown.Heading       `json:"toc"`
+	CreatedAt          *time.Time               `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time               `json:"updated_at,omitempty"`
+	ProjectTechStack   []PublicProjectTechStack `json:"project_tech_stack"`
+	Series             []SeriesNav              `json:"series,omitempty"`
+}
+
+// PublicProjectTechStack is a tech stack of a project as visitors see it
+// @Description Tech stack of a project as shown publicly
+// @Name PublicProjectTechStack
+type PublicProjectTechStack struct {
+	ProjectID   uuid.UUID                  `json:"project_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TechStackID uuid.UUID                  `json:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	TechStack   tech_stack.PublicTechStack `json:"tech_stack"`
+}
+
+// Public maps a project to what visitors see
+func (p ProjectDTO) Public() PublicProject {
+	techStacks := make([]PublicProjectTechStack, 0, len(p.ProjectTechStack))
+	for _, stack := range p.ProjectTechStack {
+		techStacks = append(techStacks, PublicProjectTechStack{
+			ProjectID:   stack.ProjectID,
+			TechStackID: stack.TechStackID,
+			TechStack:   stack.TechStack.Public(),
+		})
+	}
+
+	return PublicProject{
+		ID:                 p.ID,
+		Slug:               p.Slug,
+		Title:              p.Title,
+		Subtitle:           p.Subtitle,
+		Description:        p.Description,
+		MyRole:             p.MyRole,
+		Category:           p.Category,
+		GithubUrl:          p.GithubUrl,
+		WebUrl:             p.WebUrl,
+		Images:             p.Images,
+		Features:           p.Features,
+		Metrics:            p.Metrics,
+		Timeline:           p.Timeline,
+		Client:             p.Client.public(),
+		DevelopmentStatus:  p.DevelopmentStatus,
+		ProgressStatus:     p.ProgressStatus,
+		ProgressPercentage: p.ProgressPercentage,
+		IsFeatured:         p.IsFeatured,
+		SEO:                p.SEO,
+		WordCount:          p.WordCount,
+		ReadingTime:        p.ReadingTime,
+		TOC:                p.TOC,
+		CreatedAt:          p.CreatedAt,
+		UpdatedAt:          p.UpdatedAt,
+		ProjectTechStack:   techStacks,
+		Series:             p.Series,
+	}
+}
+
+// PublicView and AdminView render projects in responses, see response.View
+func (p ProjectDTO) PublicView() interface{} {
+	return p.Public()
+}
+
+func (p ProjectDTO) AdminView() interface{} {
+	return p
+}
@@ -0,0 +1,44 @@
+package public_stats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type PublicStatsHandler struct {
+	base.BaseHandler
+	publicStatsService PublicStatsService
+}
+
+func NewPublicStatsHandler(publicStatsService PublicStatsService, logger *logger.Logger) *PublicStatsHandler {
+	return &PublicStatsHandler{
+		BaseHandler:        *base.NewBaseHandler(logger),
+		publicStatsService: publicStatsService,
+	}
+}
+
+// GetPublicStats retrieves the numbers of the homepage counters
+// @Summary Get public stats
+// @Description Retrieve the projects shipped, years of experience, technologies used and total page views, computed from live data at most once an hour
+// @Tags Stats
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=PublicStats} "Public stats retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /stats/public [get]
+func (h *PublicStatsHandler) GetPublicStats(c *gin.Context) {
+	stats, err := h.publicStatsService.GetPublicStats(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Browsers and CDNs may keep the stats until they are computed again
+	maxAge := max(CacheTTL-time.Since(stats.ComputedAt), 0)
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+
+	h.HandleSuccess(c, stats, "Public stats retrieved successfully")
+}
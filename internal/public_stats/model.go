@@ -0,0 +1,24 @@
+package public_stats
+
+import (
+	"time"
+)
+
+// PublicStats is the curated numbers shown by the animated counters on the
+// homepage
+// @Description Curated numbers computed from the portfolio content and its traffic
+// @Name PublicStats
+type PublicStats struct {
+	// ProjectsShipped counts the public projects marked completed
+	ProjectsShipped int `json:"projects_shipped" example:"24"`
+	// YearsOfExperience is the time worked in whole years, counting
+	// overlapping roles once
+	YearsOfExperience int `json:"years_of_experience" example:"4"`
+	// TechnologiesUsed counts the distinct tech stacks of public projects
+	// and experiences
+	TechnologiesUsed int `json:"technologies_used" example:"32"`
+	// TotalViews counts every page view ever recorded
+	TotalViews int64 `json:"total_views" example:"58210"`
+
+	ComputedAt time.Time `json:"computed_at" example:"2026-01-15T10:00:00Z"`
+}
@@ -0,0 +1,136 @@
+package public_stats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/analytics"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+)
+
+// CacheTTL is how long computed stats are served before being computed again
+const CacheTTL = time.Hour
+
+type PublicStatsService interface {
+	// GetPublicStats returns the stats of the tenant in ctx, computing them
+	// at most once per CacheTTL
+	GetPublicStats(ctx context.Context) (*PublicStats, error)
+}
+
+type publicStatsService struct {
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	analyticsService  analytics.AnalyticsService
+
+	mu    sync.Mutex
+	cache map[string]*PublicStats
+}
+
+func NewPublicStatsService(projectService project.ProjectService, experienceService experience.ExperienceService, analyticsService analytics.AnalyticsService) PublicStatsService {
+	return &publicStatsService{
+		projectService:    projectService,
+		experienceService: experienceService,
+		analyticsService:  analyticsService,
+		cache:             make(map[string]*PublicStats),
+	}
+}
+
+func (s *publicStatsService) GetPublicStats(ctx context.Context) (*PublicStats, error) {
+	key := tenantCacheKey(ctx)
+
+	s.mu.Lock()
+	cached, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && time.Since(cached.ComputedAt) < CacheTTL {
+		return cached, nil
+	}
+
+	stats, err := s.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cache[key] = stats
+	s.mu.Unlock()
+
+	return stats, nil
+}
+
+// compute reads the stats from the live content and traffic
+func (s *publicStatsService) compute(ctx context.Context) (*PublicStats, error) {
+	projects, err := s.listPublicProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.experienceService.GetSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := s.analyticsService.TotalViews(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &PublicStats{
+		YearsOfExperience: summary.TotalMonths / 12,
+		TotalViews:        views,
+		ComputedAt:        time.Now().UTC(),
+	}
+
+	techStacks := make(map[uuid.UUID]bool)
+	for _, p := range projects {
+		if p.ProgressStatus == project.Completed {
+			stats.ProjectsShipped++
+		}
+		for _, link := range p.ProjectTechStack {
+			techStacks[link.TechStackID] = true
+		}
+	}
+	for _, techStack := range summary.TechStacks {
+		techStacks[techStack.TechStackID] = true
+	}
+	stats.TechnologiesUsed = len(techStacks)
+
+	return stats, nil
+}
+
+// listPublicProjects loads every public project page by page
+func (s *publicStatsService) listPublicProjects(ctx context.Context) ([]project.ProjectDTO, error) {
+	opts := base.ListOptions{
+		Page:    1,
+		PerPage: 100,
+		Filters: []base.FilterOption{{
+			Field:    "visibility",
+			Operator: base.OperatorEqual,
+			Value:    project.VisibilityPublic,
+		}},
+	}
+
+	var all []project.ProjectDTO
+	for {
+		projects, err := s.projectService.ListProjects(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, projects...)
+		if len(projects) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+	return all, nil
+}
+
+func tenantCacheKey(ctx context.Context) string {
+	if tenantID := base.TenantIDFromContext(ctx); tenantID != nil {
+		return tenantID.String()
+	}
+	return "default"
+}
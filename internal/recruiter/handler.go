@@ -0,0 +1,89 @@
+package recruiter
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Bounds of the number of items returned per section
+const (
+	defaultLimit = 5
+	maxLimit     = 20
+)
+
+type RecruiterHandler struct {
+	base.BaseHandler
+	recruiterService RecruiterService
+}
+
+func NewRecruiterHandler(recruiterService RecruiterService, logger *logger.Logger) *RecruiterHandler {
+	return &RecruiterHandler{
+		BaseHandler:      *base.NewBaseHandler(logger),
+		recruiterService: recruiterService,
+	}
+}
+
+// GetProfile retrieves the portfolio tailored to a role
+// @Summary Get a recruiter profile
+// @Description Retrieve the projects, experiences and skills matching a role keyword, ranked by relevance, for generating resume pages tailored to an application. Roles such as backend, frontend, fullstack, devops, mobile, data, bot and design are expanded to related terms; other words are matched as given.
+// @Tags Profile
+// @Produce json
+// @Param role query string true "Role keyword, e.g. backend or \"backend devops\""
+// @Param limit query int false "Items per section" default(5) maximum(20)
+// @Success 200 {object} response.APIResponse{data=PublicProfile} "Recruiter profile retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /profile/recruiter [get]
+func (h *RecruiterHandler) GetProfile(c *gin.Context) {
+	limit := defaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxLimit {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Limit must be between 1 and 20",
+				err,
+			))
+			return
+		}
+		limit = parsed
+	}
+
+	profile, err := h.recruiterService.GetProfile(c.Request.Context(), c.Query("role"), limit)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, profile, "Recruiter profile retrieved successfully")
+}
+
+// MatchJobDescription compares a job description with the portfolio
+// @Summary Match a job description
+// @Description Recognize the skills a pasted job description asks for, split them into the ones matching the portfolio's tech stacks and the missing ones, and suggest the projects and experiences to highlight when applying
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param job body JobDescriptionMatch true "Job Description"
+// @Success 200 {object} response.APIResponse{data=PublicJobMatch} "Job description matched successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /ai/match-jd [post]
+func (h *RecruiterHandler) MatchJobDescription(c *gin.Context) {
+	var jobInput JobDescriptionMatch
+	if err := h.ValidateRequest(c, &jobInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	match, err := h.recruiterService.MatchJobDescription(c.Request.Context(), &jobInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, match, "Job description matched successfully")
+}
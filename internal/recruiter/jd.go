@@ -0,0 +1,231 @@
+package recruiter
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+)
+
+// skillVocabulary maps the skills recognized in job descriptions to their
+// display names. Tech stacks of the portfolio are recognized as well.
+var skillVocabulary = map[string]string{
+	// Languages
+	"go": "Go", "python": "Python", "java": "Java", "kotlin": "Kotlin", "swift": "Swift",
+	"javascript": "JavaScript", "typescript": "TypeScript", "php": "PHP", "ruby": "Ruby",
+	"rust": "Rust", "c++": "C++", "c#": "C#", "scala": "Scala", "elixir": "Elixir", "dart": "Dart",
+	"sql": "SQL", "bash": "Bash",
+
+	// Backend
+	"node.js": "Node.js", "express": "Express", "nestjs": "NestJS", "django": "Django",
+	"flask": "Flask", "fastapi": "FastAPI", "spring": "Spring", "laravel": "Laravel",
+	"rails": "Rails", ".net": ".NET", "gin": "Gin", "fiber": "Fiber", "grpc": "gRPC",
+	"graphql": "GraphQL", "rest": "REST", "microservices": "Microservices", "websocket": "WebSocket",
+
+	// Frontend and mobile
+	"react": "React", "next.js": "Next.js", "vue": "Vue", "nuxt": "Nuxt", "angular": "Angular",
+	"svelte": "Svelte", "html": "HTML", "css": "CSS", "tailwind": "Tailwind CSS", "redux": "Redux",
+	"react native": "React Native", "flutter": "Flutter", "android": "Android", "ios": "iOS",
+
+	// Data
+	"postgresql": "PostgreSQL", "mysql": "MySQL", "mongodb": "MongoDB", "redis": "Redis",
+	"elasticsearch": "Elasticsearch", "kafka": "Kafka", "rabbitmq": "RabbitMQ", "supabase": "Supabase",
+	"firebase": "Firebase", "dynamodb": "DynamoDB", "sqlite": "SQLite", "bigquery": "BigQuery",
+	"pandas": "pandas", "spark": "Spark", "machine learning": "Machine Learning",
+	"tensorflow": "TensorFlow", "pytorch": "PyTorch",
+
+	// Infrastructure
+	"docker": "Docker", "kubernetes": "Kubernetes", "terraform": "Terraform", "ansible": "Ansible",
+	"aws": "AWS", "google cloud": "Google Cloud", "azure": "Azure", "linux": "Linux", "nginx": "Nginx",
+	"ci/cd": "CI/CD", "github actions": "GitHub Actions", "gitlab ci": "GitLab CI", "jenkins": "Jenkins",
+	"prometheus": "Prometheus", "grafana": "Grafana", "git": "Git",
+
+	// Practices and tools
+	"tdd": "TDD", "agile": "Agile", "scrum": "Scrum", "figma": "Figma", "jest": "Jest",
+}
+
+// skillAliases maps other spellings of a skill to a key of skillVocabulary
+var skillAliases = map[string]string{
+	"golang": "go", "nodejs": "node.js", "node": "node.js", "expressjs": "express",
+	"express.js": "express", "js": "javascript", "ts": "typescript", "reactjs": "react",
+	"react.js": "react", "nextjs": "next.js", "vuejs": "vue", "vue.js": "vue", "nuxtjs": "nuxt",
+	"nuxt.js": "nuxt", "postgres": "postgresql", "mongo": "mongodb", "k8s": "kubernetes",
+	"gcp": "google cloud", "amazon web services": "aws", "tailwindcss": "tailwind",
+	"tailwind css": "tailwind", "restful": "rest", "microservice": "microservices",
+	"spring boot": "spring", "ruby on rails": "rails", "ml": "machine learning", "cicd": "ci/cd",
+	"dotnet": ".net", "asp.net": ".net", "elastic": "elasticsearch",
+}
+
+// caseSensitiveTerms are only recognized when capitalized as given, since
+// their lowercase forms are common English words
+var caseSensitiveTerms = map[string]*regexp.Regexp{
+	"go":      regexp.MustCompile(`(^|[^\w.])Go([^\w.+#-]|$)`),
+	"node":    regexp.MustCompile(`\bNode\b`),
+	"swift":   regexp.MustCompile(`\bSwift\b`),
+	"spring":  regexp.MustCompile(`\bSpring\b`),
+	"express": regexp.MustCompile(`\bExpress\b`),
+	"rest":    regexp.MustCompile(`\bREST\b`),
+	"rust":    regexp.MustCompile(`\bRust\b`),
+	"dart":    regexp.MustCompile(`\bDart\b`),
+	"gin":     regexp.MustCompile(`\bGin\b`),
+	"fiber":   regexp.MustCompile(`\bFiber\b`),
+	"spark":   regexp.MustCompile(`\bSpark\b`),
+}
+
+// canonicalSkill returns the vocabulary key of a skill or tech stack name,
+// or its normalized name when it is not in the vocabulary
+func canonicalSkill(name string) string {
+	for _, key := range []string{strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(normalize(name))} {
+		if alias, ok := skillAliases[key]; ok {
+			return alias
+		}
+		if _, ok := skillVocabulary[key]; ok {
+			return key
+		}
+	}
+	return strings.TrimSpace(normalize(name))
+}
+
+// ExtractSkills returns the skills a job description asks for, in order of
+// first mention, recognizing the vocabulary and the given tech stacks
+func ExtractSkills(description string, techStacks []tech_stack.TechStack) []string {
+	normalized := normalize(description)
+
+	type mention struct {
+		skill string
+		index int
+	}
+	var mentions []mention
+	found := make(map[string]int)
+
+	consider := func(term string) {
+		skill := canonicalSkill(term)
+		if skill == "" {
+			return
+		}
+
+		var index int
+		if pattern, ok := caseSensitiveTerms[strings.TrimSpace(normalize(term))]; ok {
+			loc := pattern.FindStringIndex(description)
+			if loc == nil {
+				return
+			}
+			// Positions in the original text only order mentions roughly
+			index = loc[0]
+		} else {
+			index = strings.Index(normalized, normalize(term))
+			if index < 0 {
+				return
+			}
+		}
+
+		if previous, ok := found[skill]; !ok || index < previous {
+			found[skill] = index
+		}
+	}
+
+	for term := range skillVocabulary {
+		consider(term)
+	}
+	for term := range skillAliases {
+		consider(term)
+	}
+	for _, t := range techStacks {
+		consider(t.Name)
+	}
+
+	for skill, index := range found {
+		mentions = append(mentions, mention{skill: skill, index: index})
+	}
+	slices.SortFunc(mentions, func(a, b mention) int {
+		if a.index != b.index {
+			return a.index - b.index
+		}
+		return strings.Compare(a.skill, b.skill)
+	})
+
+	skills := make([]string, len(mentions))
+	for i, m := range mentions {
+		skills[i] = m.skill
+	}
+	return skills
+}
+
+// MatchJobDescription compares the skills a job description asks for with
+// the tech stacks of the portfolio and suggests the projects and
+// experiences that demonstrate the matched skills
+func MatchJobDescription(description string, projects []project.ProjectDTO, experiences []experience.ExperienceDTO, techStacks []tech_stack.TechStack, limit int) *JobMatch {
+	stacksBySkill := make(map[string]tech_stack.TechStack, len(techStacks))
+	for _, t := range techStacks {
+		stacksBySkill[canonicalSkill(t.Name)] = t
+	}
+
+	usage := make(map[string]int)
+	for _, p := range projects {
+		for _, stack := range p.ProjectTechStack {
+			usage[stack.TechStack.ID.String()]++
+		}
+	}
+	for _, e := range experiences {
+		for _, stack := range e.ExperienceTechStack {
+			usage[stack.TechStack.ID.String()]++
+		}
+	}
+
+	required := ExtractSkills(description, techStacks)
+	result := &JobMatch{
+		Required: make([]string, 0, len(required)),
+		Matched:  []MatchedSkill{},
+		Missing:  []string{},
+	}
+
+	var keywords []string
+	for _, skill := range required {
+		name := displayName(skill)
+		t, ok := stacksBySkill[skill]
+		if !ok {
+			result.Required = append(result.Required, name)
+			result.Missing = append(result.Missing, name)
+			continue
+		}
+
+		result.Required = append(result.Required, t.Name)
+		result.Matched = append(result.Matched, MatchedSkill{
+			TechStack: t,
+			UsedIn:    usage[t.ID.String()],
+		})
+		keywords = append(keywords, strings.ToLower(t.Name))
+		if skill != strings.ToLower(t.Name) {
+			keywords = append(keywords, skill)
+		}
+	}
+
+	if len(required) > 0 {
+		result.Coverage = math.Round(float64(len(result.Matched))/float64(len(required))*1000) / 10
+	}
+
+	// Skills used most are the strongest evidence
+	slices.SortStableFunc(result.Matched, func(a, b MatchedSkill) int {
+		return b.UsedIn - a.UsedIn
+	})
+
+	result.Projects = []RankedProject{}
+	result.Experiences = []RankedExperience{}
+	if len(keywords) > 0 {
+		result.Projects = truncate(RankProjects(projects, keywords), limit)
+		result.Experiences = truncate(RankExperiences(experiences, keywords), limit)
+	}
+	return result
+}
+
+// displayName returns how a canonical skill is written
+func displayName(skill string) string {
+	if name, ok := skillVocabulary[skill]; ok {
+		return name
+	}
+	return skill
+}
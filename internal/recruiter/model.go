@@ -0,0 +1,89 @@
+package recruiter
+
+import (
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+)
+
+// Match is how relevant an item is to a role and which keywords it matched
+type Match struct {
+	Score   float64  `json:"score" example:"7.5"`
+	Matched []string `json:"matched" example:"api,go,postgresql"`
+}
+
+// RankedProject is a project ranked for a role
+// @Description Project with its relevance to a role
+// @Name RankedProject
+type RankedProject struct {
+	project.ProjectDTO
+	Match
+}
+
+// RankedExperience is an experience ranked for a role
+// @Description Experience with its relevance to a role
+// @Name RankedExperience
+type RankedExperience struct {
+	experience.ExperienceDTO
+	Match
+}
+
+// RankedSkill is a tech stack ranked for a role
+// @Description Skill with its relevance to a role
+// @Name RankedSkill
+type RankedSkill struct {
+	tech_stack.TechStack
+	Match
+}
+
+// Profile is the subset of the portfolio relevant to a role, most relevant
+// first
+// @Description Portfolio tailored to a role
+// @Name RecruiterProfile
+type Profile struct {
+	Role string `json:"role" example:"backend"`
+
+	// Keywords are the terms the role was expanded to for matching
+	Keywords []string `json:"keywords" example:"backend,api,go,postgresql"`
+
+	Projects    []RankedProject    `json:"projects"`
+	Experiences []RankedExperience `json:"experiences"`
+	Skills      []RankedSkill      `json:"skills"`
+}
+
+// JobDescriptionMatch is the input for matching a job description
+// @Name JobDescriptionMatch
+type JobDescriptionMatch struct {
+	Description string `json:"description" validate:"required,min=20,max=20000" example:"We are looking for a backend engineer with Go, PostgreSQL and Kubernetes experience."`
+
+	// Limit is the number of projects and experiences suggested, 5 when empty
+	Limit int `json:"limit,omitempty" validate:"min=0,max=20" example:"5"`
+}
+
+// MatchedSkill is a skill asked for by a job description that the
+// portfolio has
+// @Description Tech stack matching a skill of a job description
+// @Name MatchedSkill
+type MatchedSkill struct {
+	tech_stack.TechStack
+
+	// UsedIn is the number of projects and experiences using the tech stack
+	UsedIn int `json:"used_in" example:"4"`
+}
+
+// JobMatch compares a job description with the portfolio
+// @Description Skills of a job description found and missing in the portfolio, with the projects and experiences to highlight
+// @Name JobMatch
+type JobMatch struct {
+	// Required are the skills recognized in the job description, in order
+	// of first mention
+	Required []string       `json:"required" example:"Go,PostgreSQL,Kubernetes"`
+	Matched  []MatchedSkill `json:"matched"`
+	Missing  []string       `json:"missing" example:"Kubernetes"`
+
+	// Coverage is the percentage of required skills matched
+	Coverage float64 `json:"coverage" example:"66.7"`
+
+	Projects    []RankedProject    `json:"projects"`
+	Experiences []RankedExperience `json:"experiences"`
+}
@@ -0,0 +1,295 @@
+package recruiter
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+)
+
+// roleKeywords expands common role names to the terms that signal them
+var roleKeywords = map[string][]string{
+	"backend": {
+		"backend", "back-end", "api", "rest", "graphql", "grpc", "server", "microservice", "microservices",
+		"database", "sql", "postgresql", "postgres", "mysql", "redis", "go", "golang", "node.js", "nodejs",
+		"express", "python", "django", "java", "spring", "php", "laravel",
+	},
+	"frontend": {
+		"frontend", "front-end", "ui", "web", "react", "next.js", "nextjs", "vue", "nuxt", "svelte",
+		"angular", "javascript", "typescript", "html", "css", "tailwind", "responsive",
+	},
+	"devops": {
+		"devops", "infrastructure", "ci", "cd", "pipeline", "docker", "kubernetes", "k8s", "terraform",
+		"ansible", "aws", "gcp", "azure", "cloud", "linux", "nginx", "monitoring",
+	},
+	"mobile": {
+		"mobile", "android", "ios", "flutter", "dart", "react native", "kotlin", "swift",
+	},
+	"data": {
+		"data", "analytics", "etl", "sql", "python", "pandas", "machine learning", "ml", "ai",
+		"postgresql", "bigquery", "warehouse",
+	},
+	"bot": {
+		"bot", "chatbot", "automation", "telegram", "discord", "whatsapp",
+	},
+	"design": {
+		"design", "ui", "ux", "ui/ux", "figma", "prototype", "wireframe",
+	},
+}
+
+// roleAliases maps other spellings of a role to a key of roleKeywords
+var roleAliases = map[string]string{
+	"back-end":   "backend",
+	"server":     "backend",
+	"api":        "backend",
+	"front-end":  "frontend",
+	"web":        "frontend",
+	"infra":      "devops",
+	"sre":        "devops",
+	"platform":   "devops",
+	"cloud":      "devops",
+	"android":    "mobile",
+	"ios":        "mobile",
+	"ml":         "data",
+	"analytics":  "data",
+	"automation": "bot",
+	"ux":         "design",
+	"ui":         "design",
+	"ui/ux":      "design",
+}
+
+// roleCategories are the tech stack categories that belong to a role
+var roleCategories = map[string][]tech_stack.TechStackCategory{
+	"backend":  {tech_stack.CategoryBackend, tech_stack.CategoryDatabase},
+	"frontend": {tech_stack.CategoryFrontend, tech_stack.CategoryFrameworks},
+	"devops":   {tech_stack.CategoryDevOps},
+	"data":     {tech_stack.CategoryDatabase},
+}
+
+// Field weights used when scoring matches
+const (
+	weightTitle       = 3.0
+	weightRole        = 3.0
+	weightTechStack   = 2.0
+	weightCategory    = 2.0
+	weightSubtitle    = 2.0
+	weightDescription = 1.0
+	weightList        = 1.0
+)
+
+// ExpandRole returns the keywords matched for a role, which may combine
+// several roles such as "fullstack" or "backend devops"
+func ExpandRole(role string) []string {
+	var keywords []string
+	add := func(terms ...string) {
+		for _, term := range terms {
+			if term != "" && !slices.Contains(keywords, term) {
+				keywords = append(keywords, term)
+			}
+		}
+	}
+
+	for _, word := range strings.Fields(strings.ToLower(role)) {
+		word = strings.Trim(word, ",;")
+		if word == "fullstack" || word == "full-stack" {
+			add(word)
+			add(roleKeywords["backend"]...)
+			add(roleKeywords["frontend"]...)
+			continue
+		}
+
+		key := word
+		if alias, ok := roleAliases[word]; ok {
+			key = alias
+		}
+		add(word)
+		add(roleKeywords[key]...)
+	}
+
+	return keywords
+}
+
+// categoriesFor returns the tech stack categories of the roles in keywords
+func categoriesFor(keywords []string) []tech_stack.TechStackCategory {
+	var categories []tech_stack.TechStackCategory
+	for role, roleCategories := range roleCategories {
+		if slices.Contains(keywords, role) {
+			for _, category := range roleCategories {
+				if !slices.Contains(categories, category) {
+					categories = append(categories, category)
+				}
+			}
+		}
+	}
+	return categories
+}
+
+// matcher scores text fields against a set of keywords
+type matcher struct {
+	keywords []string
+	score    float64
+	matched  []string
+}
+
+func newMatcher(keywords []string) *matcher {
+	return &matcher{keywords: keywords}
+}
+
+// field adds weight for each keyword found in text
+func (m *matcher) field(weight float64, texts ...string) {
+	normalized := normalize(strings.Join(texts, " "))
+	if normalized == " " {
+		return
+	}
+
+	for _, keyword := range m.keywords {
+		if strings.Contains(normalized, normalize(keyword)) {
+			m.score += weight
+			if !slices.Contains(m.matched, keyword) {
+				m.matched = append(m.matched, keyword)
+			}
+		}
+	}
+}
+
+func (m *matcher) result() Match {
+	matched := m.matched
+	if matched == nil {
+		matched = []string{}
+	}
+	slices.Sort(matched)
+	return Match{Score: math.Round(m.score*10) / 10, Matched: matched}
+}
+
+// normalize lowercases text and pads its words with spaces, so that keywords
+// only match whole words
+func normalize(text string) string {
+	var b strings.Builder
+	b.WriteByte(' ')
+	space := true
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(".+#-", r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	if !space {
+		b.WriteByte(' ')
+	}
+
+	// Trailing punctuation such as "APIs." should still match
+	return strings.NewReplacer(". ", " ", ", ", " ").Replace(b.String())
+}
+
+// RankProjects scores projects against keywords, dropping those that do
+// not match at all
+func RankProjects(projects []project.ProjectDTO, keywords []string) []RankedProject {
+	ranked := make([]RankedProject, 0, len(projects))
+	for _, p := range projects {
+		m := newMatcher(keywords)
+		m.field(weightTitle, p.Title)
+		m.field(weightSubtitle, p.Subtitle)
+		m.field(weightDescription, p.Description)
+		m.field(weightRole, p.MyRole...)
+		m.field(weightCategory, string(p.Category))
+		m.field(weightList, p.Features...)
+		for _, stack := range p.ProjectTechStack {
+			m.field(weightTechStack, stack.TechStack.Name)
+		}
+		if m.score == 0 {
+			continue
+		}
+		if p.IsFeatured {
+			m.score++
+		}
+		ranked = append(ranked, RankedProject{ProjectDTO: p, Match: m.result()})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b RankedProject) int {
+		return compareScore(a.Score, b.Score)
+	})
+	return ranked
+}
+
+// RankExperiences scores experiences against keywords, dropping those that
+// do not match at all
+func RankExperiences(experiences []experience.ExperienceDTO, keywords []string) []RankedExperience {
+	ranked := make([]RankedExperience, 0, len(experiences))
+	for _, e := range experiences {
+		m := newMatcher(keywords)
+		m.field(weightRole, e.Role)
+		m.field(weightDescription, e.WorkDescription)
+		m.field(weightList, e.Impact...)
+		for _, stack := range e.ExperienceTechStack {
+			m.field(weightTechStack, stack.TechStack.Name)
+		}
+		if m.score == 0 {
+			continue
+		}
+		if e.EndDate == nil {
+			m.score++
+		}
+		ranked = append(ranked, RankedExperience{ExperienceDTO: e, Match: m.result()})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b RankedExperience) int {
+		if c := compareScore(a.Score, b.Score); c != 0 {
+			return c
+		}
+		return b.StartDate.Compare(a.StartDate.Time)
+	})
+	return ranked
+}
+
+// RankSkills scores tech stacks against keywords and the categories of the
+// role, weighting them by proficiency
+func RankSkills(techStacks []tech_stack.TechStack, keywords []string) []RankedSkill {
+	categories := categoriesFor(keywords)
+
+	ranked := make([]RankedSkill, 0, len(techStacks))
+	for _, t := range techStacks {
+		m := newMatcher(keywords)
+		m.field(weightTitle, t.Name)
+		m.field(weightRole, t.Role)
+		if slices.Contains(categories, t.Category) {
+			m.score += weightCategory
+		}
+		if m.score == 0 {
+			continue
+		}
+		if t.IsCoreSkill {
+			m.score++
+		}
+		m.score *= 1 + float64(t.ProficiencyLevel)/10
+		ranked = append(ranked, RankedSkill{TechStack: t, Match: m.result()})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b RankedSkill) int {
+		if c := compareScore(a.Score, b.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return ranked
+}
+
+// compareScore orders higher scores first
+func compareScore(a, b float64) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,165 @@
+package recruiter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// pageSize is the page size used to read every project, experience and skill
+const pageSize = 100
+
+type RecruiterService interface {
+	// GetProfile returns up to limit projects, experiences and skills of
+	// each kind matching role, most relevant first
+	GetProfile(ctx context.Context, role string, limit int) (*Profile, error)
+	// MatchJobDescription compares the skills a job description asks for
+	// with the portfolio
+	MatchJobDescription(ctx context.Context, jobDescriptionMatch *JobDescriptionMatch) (*JobMatch, error)
+}
+
+type recruiterService struct {
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	techStackService  tech_stack.TechStackService
+}
+
+func NewRecruiterService(projectService project.ProjectService, experienceService experience.ExperienceService, techStackService tech_stack.TechStackService) RecruiterService {
+	return &recruiterService{
+		projectService:    projectService,
+		experienceService: experienceService,
+		techStackService:  techStackService,
+	}
+}
+
+func (s *recruiterService) GetProfile(ctx context.Context, role string, limit int) (*Profile, error) {
+	role = strings.TrimSpace(role)
+	keywords := ExpandRole(role)
+	if len(keywords) == 0 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Role is required",
+			nil,
+		)
+	}
+
+	projects, err := s.listProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.listExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	techStacks, err := s.listTechStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		Role:        role,
+		Keywords:    keywords,
+		Projects:    truncate(RankProjects(projects, keywords), limit),
+		Experiences: truncate(RankExperiences(experiences, keywords), limit),
+		Skills:      truncate(RankSkills(techStacks, keywords), limit),
+	}, nil
+}
+
+func (s *recruiterService) MatchJobDescription(ctx context.Context, jobDescriptionMatch *JobDescriptionMatch) (*JobMatch, error) {
+	// Validate input
+	if err := validator.ValidateModel(jobDescriptionMatch); err != nil {
+		return nil, err
+	}
+
+	limit := jobDescriptionMatch.Limit
+	if limit == 0 {
+		limit = 5
+	}
+
+	projects, err := s.listProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.listExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	techStacks, err := s.listTechStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchJobDescription(jobDescriptionMatch.Description, projects, experiences, techStacks, limit), nil
+}
+
+// listProjects returns every public project
+func (s *recruiterService) listProjects(ctx context.Context) ([]project.ProjectDTO, error) {
+	var projects []project.ProjectDTO
+	for page := 1; ; page++ {
+		batch, err := s.projectService.ListProjects(ctx, base.ListOptions{
+			Page:    page,
+			PerPage: pageSize,
+			Filters: []base.FilterOption{{
+				Field:    "visibility",
+				Operator: base.OperatorEqual,
+				Value:    project.VisibilityPublic,
+			}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, batch...)
+
+		if len(batch) < pageSize {
+			return projects, nil
+		}
+	}
+}
+
+func (s *recruiterService) listExperiences(ctx context.Context) ([]experience.ExperienceDTO, error) {
+	var experiences []experience.ExperienceDTO
+	for page := 1; ; page++ {
+		batch, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		experiences = append(experiences, batch...)
+
+		if len(batch) < pageSize {
+			return experiences, nil
+		}
+	}
+}
+
+func (s *recruiterService) listTechStacks(ctx context.Context) ([]tech_stack.TechStack, error) {
+	var techStacks []tech_stack.TechStack
+	for page := 1; ; page++ {
+		batch, err := s.techStackService.ListTechStacks(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		techStacks = append(techStacks, batch...)
+
+		if len(batch) < pageSize {
+			return techStacks, nil
+		}
+	}
+}
+
+// truncate keeps the first limit items
+func truncate[T any](items []T, limit int) []T {
+	if limit > 0 && len(items) > limit {
+		return items[:limit]
+	}
+	return items
+}
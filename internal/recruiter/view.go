@@ -0,0 +1,146 @@
+package recruiter
+
+import (
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tech_stack"
+)
+
+// PublicRankedProject is a ranked project as visitors see it
+// @Name PublicRankedProject
+type PublicRankedProject struct {
+	project.PublicProject
+	Match
+}
+
+// PublicRankedExperience is a ranked experience as visitors see it
+// @Name PublicRankedExperience
+type PublicRankedExperience struct {
+	experience.PublicExperience
+	Match
+}
+
+// PublicRankedSkill is a ranked skill as visitors see it
+// @Name PublicRankedSkill
+type PublicRankedSkill struct {
+	tech_stack.PublicTechStack
+	Match
+}
+
+// PublicMatchedSkill is a matched skill as visitors see it
+// @Name PublicMatchedSkill
+type PublicMatchedSkill struct {
+	tech_stack.PublicTechStack
+	UsedIn int `json:"used_in" example:"4"`
+}
+
+// PublicProfile is a profile as visitors see it
+// @Name PublicRecruiterProfile
+type PublicProfile struct {
+	Role        string                   `json:"role" example:"backend"`
+	Keywords    []string                 `json:"keywords" example:"backend,api,go,postgresql"`
+	Projects    []PublicRankedProject    `json:"projects"`
+	Experiences []PublicRankedExperience `json:"experiences"`
+	Skills      []PublicRankedSkill      `json:"skills"`
+}
+
+// PublicJobMatch is a job match as visitors see it
+// @Name PublicJobMatch
+type PublicJobMatch struct {
+	Required    []string                 `json:"required" example:"Go,PostgreSQL,Kubernetes"`
+	Matched     []PublicMatchedSkill     `json:"matched"`
+	Missing     []string                 `json:"missing" example:"Kubernetes"`
+	Coverage    float64                  `json:"coverage" example:"66.7"`
+	Projects    []PublicRankedProject    `json:"projects"`
+	Experiences []PublicRankedExperience `json:"experiences"`
+}
+
+// PublicView and AdminView render profiles in responses, see response.View
+func (p Profile) PublicView() interface{} {
+	public := PublicProfile{
+		Role:        p.Role,
+		Keywords:    p.Keywords,
+		Projects:    publicProjects(p.Projects),
+		Experiences: publicExperiences(p.Experiences),
+		Skills:      make([]PublicRankedSkill, 0, len(p.Skills)),
+	}
+	for _, skill := range p.Skills {
+		public.Skills = append(public.Skills, skill.PublicView().(PublicRankedSkill))
+	}
+	return public
+}
+
+func (p Profile) AdminView() interface{} {
+	return p
+}
+
+// PublicView and AdminView render job matches in responses, see
+// response.View
+func (m JobMatch) PublicView() interface{} {
+	public := PublicJobMatch{
+		Required:    m.Required,
+		Matched:     make([]PublicMatchedSkill, 0, len(m.Matched)),
+		Missing:     m.Missing,
+		Coverage:    m.Coverage,
+		Projects:    publicProjects(m.Projects),
+		Experiences: publicExperiences(m.Experiences),
+	}
+	for _, skill := range m.Matched {
+		public.Matched = append(public.Matched, skill.PublicView().(PublicMatchedSkill))
+	}
+	return public
+}
+
+func (m JobMatch) AdminView() interface{} {
+	return m
+}
+
+// PublicView and AdminView are implemented again for ranked items, which
+// would otherwise be rendered as the model they embed
+func (r RankedProject) PublicView() interface{} {
+	return PublicRankedProject{PublicProject: r.ProjectDTO.Public(), Match: r.Match}
+}
+
+func (r RankedProject) AdminView() interface{} {
+	return r
+}
+
+func (r RankedExperience) PublicView() interface{} {
+	return PublicRankedExperience{PublicExperience: r.ExperienceDTO.Public(), Match: r.Match}
+}
+
+func (r RankedExperience) AdminView() interface{} {
+	return r
+}
+
+func (r RankedSkill) PublicView() interface{} {
+	return PublicRankedSkill{PublicTechStack: r.TechStack.Public(), Match: r.Match}
+}
+
+func (r RankedSkill) AdminView() interface{} {
+	return r
+}
+
+func (s MatchedSkill) PublicView() interface{} {
+	return PublicMatchedSkill{PublicTechStack: s.TechStack.Public(), UsedIn: s.UsedIn}
+}
+
+func (s MatchedSkill) AdminView() interface{} {
+	return s
+}
+
+func publicProjects(projects []RankedProject) []PublicRankedProject {
+	public := make([]PublicRankedProject, 0, len(projects))
+	for _, p := range projects {
+		public = append(public, p.PublicView().(PublicRankedProject))
+	}
+	return public
+}
+
+func publicExperiences(experiences []RankedExperience) []PublicRankedExperience {
+	public := make([]PublicRankedExperience, 0, len(experiences))
+	for _, e := range experiences {
+		public = append(public, e.PublicView().(PublicRankedExperience))
+	}
+	return public
+}
@@ -0,0 +1,202 @@
+package redirect
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type RedirectHandler struct {
+	base.BaseHandler
+	redirectService RedirectService
+}
+
+func NewRedirectHandler(redirectService RedirectService, logger *logger.Logger) *RedirectHandler {
+	return &RedirectHandler{
+		BaseHandler:     *base.NewBaseHandler(logger),
+		redirectService: redirectService,
+	}
+}
+
+// Resolve looks up where a path redirects to
+// @Summary Resolve a redirect
+// @Description Look up where a frontend path redirects to, for the edge middleware to answer old URLs with. A query string on the path is passed on to the target.
+// @Tags Redirects
+// @Produce json
+// @Param path query string true "Requested path" example(/blog/hello-world)
+// @Success 200 {object} response.APIResponse{data=Resolution} "Redirect resolved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "No redirect for this path"
+// @Router /redirects/resolve [get]
+func (h *RedirectHandler) Resolve(c *gin.Context) {
+	resolution, err := h.redirectService.Resolve(c.Request.Context(), c.Query("path"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, resolution, "Redirect resolved successfully")
+}
+
+// ListRedirects retrieves the redirects
+// @Summary List redirects
+// @Description Retrieve a paginated list of redirects, by path
+// @Tags Redirects
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param search query string false "Search in paths and targets"
+// @Success 200 {object} response.APIResponse{data=[]Redirect} "Redirects retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /admin/redirects [get]
+func (h *RedirectHandler) ListRedirects(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if search := c.Query("search"); search != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{Field: "from_path", Operator: base.OperatorLike, Value: search})
+	}
+
+	redirects, err := h.redirectService.ListRedirects(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.redirectService.CountRedirects(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, redirects, "Redirects retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetRedirect retrieves a redirect
+// @Summary Get a redirect
+// @Description Retrieve a redirect by its ID
+// @Tags Redirects
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Success 200 {object} response.APIResponse{data=Redirect} "Redirect retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Redirect not found"
+// @Router /admin/redirects/{id} [get]
+func (h *RedirectHandler) GetRedirect(c *gin.Context) {
+	redirectID := c.Param("id")
+	if _, err := h.ValidateUUID(redirectID, "Redirect ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	redirect, err := h.redirectService.GetRedirect(c.Request.Context(), redirectID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, redirect, "Redirect retrieved successfully")
+}
+
+// CreateRedirect adds a redirect
+// @Summary Create a redirect
+// @Description Send requests for an old path to a new path or URL. A path ending in /* matches every path below it, and a target ending in /* receives the rest of the matched path.
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param redirect body RedirectCreate true "Redirect Details"
+// @Success 200 {object} response.APIResponse{data=Redirect} "Redirect created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 409 {object} response.APIResponse "A redirect for this path already exists"
+// @Router /admin/redirects [post]
+func (h *RedirectHandler) CreateRedirect(c *gin.Context) {
+	var redirectInput RedirectCreate
+	if err := h.ValidateRequest(c, &redirectInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	redirect, err := h.redirectService.CreateRedirect(c.Request.Context(), &redirectInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, redirect, "Redirect created successfully")
+}
+
+// UpdateRedirect changes a redirect
+// @Summary Update a redirect
+// @Description Change the path, target or status code of a redirect
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Param redirect body RedirectUpdate true "Redirect Update Details"
+// @Success 200 {object} response.APIResponse{data=Redirect} "Redirect updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Redirect not found"
+// @Failure 409 {object} response.APIResponse "A redirect for this path already exists"
+// @Router /admin/redirects/{id} [put]
+func (h *RedirectHandler) UpdateRedirect(c *gin.Context) {
+	redirectID, err := h.ValidateUUID(c.Param("id"), "Redirect ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	var redirectInput RedirectUpdate
+	if err := h.ValidateRequest(c, &redirectInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	redirectInput.ID = redirectID
+
+	redirect, err := h.redirectService.UpdateRedirect(c.Request.Context(), &redirectInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, redirect, "Redirect updated successfully")
+}
+
+// DeleteRedirect removes a redirect
+// @Summary Delete a redirect
+// @Description Remove a redirect
+// @Tags Redirects
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Success 200 {object} response.APIResponse "Redirect deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Redirect not found"
+// @Router /admin/redirects/{id} [delete]
+func (h *RedirectHandler) DeleteRedirect(c *gin.Context) {
+	redirectID := c.Param("id")
+	if _, err := h.ValidateUUID(redirectID, "Redirect ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.redirectService.DeleteRedirect(c.Request.Context(), redirectID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Redirect deleted successfully")
+}
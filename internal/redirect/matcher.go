@@ -0,0 +1,92 @@
+package redirect
+
+import (
+	"sort"
+	"strings"
+)
+
+// wildcard ends from paths matching every path below them and targets
+// receiving the rest of the matched path
+const wildcard = "/*"
+
+// matcher finds the redirect of a path: an exact rule in a single lookup,
+// otherwise the wildcard rule with the longest prefix
+type matcher struct {
+	exact map[string]Redirect
+	// prefixes are the wildcard rules, longest prefix first
+	prefixes []Redirect
+}
+
+func newMatcher(redirects []Redirect) *matcher {
+	m := &matcher{exact: make(map[string]Redirect, len(redirects))}
+	for _, redirect := range redirects {
+		if strings.HasSuffix(redirect.FromPath, wildcard) {
+			m.prefixes = append(m.prefixes, redirect)
+			continue
+		}
+		m.exact[redirect.FromPath] = redirect
+	}
+
+	sort.Slice(m.prefixes, func(i, j int) bool {
+		return len(m.prefixes[i].FromPath) > len(m.prefixes[j].FromPath)
+	})
+	return m
+}
+
+// match returns the resolution of path, which may carry a query string
+// that is passed on to the target, or nil when no rule matches
+func (m *matcher) match(path string) *Resolution {
+	path, query, _ := strings.Cut(path, "?")
+	path = normalizePath(path)
+
+	if redirect, ok := m.exact[path]; ok {
+		return resolution(path, redirect, redirect.ToURL, query)
+	}
+
+	for _, redirect := range m.prefixes {
+		prefix := strings.TrimSuffix(redirect.FromPath, wildcard)
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+
+		location := redirect.ToURL
+		if strings.HasSuffix(location, wildcard) {
+			location = strings.TrimSuffix(location, wildcard) + strings.TrimPrefix(path, prefix)
+		}
+		return resolution(path, redirect, location, query)
+	}
+	return nil
+}
+
+func resolution(path string, redirect Redirect, location, query string) *Resolution {
+	if query != "" {
+		separator := "?"
+		if strings.Contains(location, "?") {
+			separator = "&"
+		}
+		location += separator + query
+	}
+
+	return &Resolution{
+		Path:       path,
+		Location:   location,
+		StatusCode: redirect.StatusCode,
+		RedirectID: redirect.ID,
+	}
+}
+
+// normalizePath gives a path a leading slash and no trailing one, so
+// /blog/post/ and blog/post match the same rule
+func normalizePath(path string) string {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+		if path == "" {
+			path = "/"
+		}
+	}
+	return path
+}
@@ -0,0 +1,54 @@
+package redirect
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+type memoryRedirectRepository struct {
+	*base.MemoryRepository[Redirect, Redirect]
+}
+
+// NewMemoryRedirectRepository creates a redirect repository kept in
+// memory, for development without a database
+func NewMemoryRedirectRepository() RedirectRepository {
+	return &memoryRedirectRepository{
+		MemoryRepository: base.NewMemoryRepository([]Redirect{},
+			func(r *Redirect) string { return r.ID.String() },
+			func(r Redirect) Redirect { return r },
+			"from_path", "to_url",
+		),
+	}
+}
+
+func (r *memoryRedirectRepository) FindByID(ctx context.Context, id string) (*Redirect, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *memoryRedirectRepository) FindByFromPath(ctx context.Context, fromPath string) (*Redirect, error) {
+	return r.findOne(ctx, "from_path", fromPath)
+}
+
+func (r *memoryRedirectRepository) ListAll(ctx context.Context) ([]Redirect, error) {
+	count, err := r.Count(ctx, nil)
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	return r.MemoryRepository.List(ctx, base.ListOptions{Page: 1, PerPage: count})
+}
+
+func (r *memoryRedirectRepository) List(ctx context.Context, opts base.ListOptions) ([]Redirect, error) {
+	if opts.SortBy == "" {
+		opts.SortBy, opts.SortOrder = "from_path", base.SortAscending
+	}
+	return r.MemoryRepository.List(ctx, opts)
+}
+
+func (r *memoryRedirectRepository) findOne(ctx context.Context, field, value string) (*Redirect, error) {
+	redirects, err := r.FindByField(ctx, field, value)
+	if err != nil || len(redirects) == 0 {
+		return nil, err
+	}
+	return &redirects[0], nil
+}
@@ -0,0 +1,50 @@
+package redirect
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Redirect sends requests for an old path to a new URL. A from path ending
+// in /* matches every path below it, and a target ending in /* receives
+// the rest of the matched path.
+// @Description Rule sending requests for an old path to a new URL
+// @Name Redirect
+type Redirect struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	FromPath   string     `json:"from_path" db:"from_path" example:"/blog/*"`
+	ToURL      string     `json:"to_url" db:"to_url" example:"/articles/*"`
+	StatusCode int        `json:"status_code" db:"status_code" example:"301"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// RedirectCreate is the input for adding a redirect
+// @Name RedirectCreate
+type RedirectCreate struct {
+	FromPath string `json:"from_path" validate:"required,max=2048" example:"/blog/*"`
+	ToURL    string `json:"to_url" validate:"required,max=2048" example:"/articles/*"`
+	// StatusCode is 301, 302, 307 or 308, 301 when left empty
+	StatusCode int `json:"status_code" example:"301"`
+}
+
+// RedirectUpdate is the input for changing a redirect
+// @Name RedirectUpdate
+type RedirectUpdate struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FromPath   string    `json:"from_path" validate:"max=2048" example:"/blog/*"`
+	ToURL      string    `json:"to_url" validate:"max=2048" example:"/articles/*"`
+	StatusCode int       `json:"status_code" example:"308"`
+}
+
+// Resolution is where a path redirects to
+// @Description Where a path redirects to
+// @Name RedirectResolution
+type Resolution struct {
+	Path       string    `json:"path" example:"/blog/hello-world"`
+	Location   string    `json:"location" example:"/articles/hello-world"`
+	StatusCode int       `json:"status_code" example:"301"`
+	RedirectID uuid.UUID `json:"redirect_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
@@ -0,0 +1,173 @@
+package redirect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type RedirectRepository interface {
+	Create(ctx context.Context, redirect *Redirect) (*Redirect, error)
+	Update(ctx context.Context, redirect *Redirect) (*Redirect, error)
+	Delete(ctx context.Context, id string) error
+	// FindByID returns the redirect with the given ID, or nil if none
+	FindByID(ctx context.Context, id string) (*Redirect, error)
+	// FindByFromPath returns the redirect of the given path, or nil if none
+	FindByFromPath(ctx context.Context, fromPath string) (*Redirect, error)
+	// ListAll returns every redirect, for building the matcher
+	ListAll(ctx context.Context) ([]Redirect, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Redirect, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type redirectRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewRedirectRepository(supabaseClient *supabase.SupabaseClient) RedirectRepository {
+	return &redirectRepository{
+		supabaseClient: supabaseClient,
+		table:          "redirect",
+	}
+}
+
+func (r *redirectRepository) Create(ctx context.Context, redirect *Redirect) (*Redirect, error) {
+	redirect.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(redirect, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create redirect")
+	}
+	return redirect, nil
+}
+
+func (r *redirectRepository) Update(ctx context.Context, redirect *Redirect) (*Redirect, error) {
+	redirect.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(redirect, "minimal", "").
+		Eq("id", redirect.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update redirect")
+	}
+	return redirect, nil
+}
+
+func (r *redirectRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete redirect")
+	}
+	return nil
+}
+
+func (r *redirectRepository) FindByID(ctx context.Context, id string) (*Redirect, error) {
+	return r.findOne(ctx, "id", id)
+}
+
+func (r *redirectRepository) FindByFromPath(ctx context.Context, fromPath string) (*Redirect, error) {
+	return r.findOne(ctx, "from_path", fromPath)
+}
+
+func (r *redirectRepository) ListAll(ctx context.Context) ([]Redirect, error) {
+	var redirects []Redirect
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&redirects)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list redirects")
+	}
+	return redirects, nil
+}
+
+func (r *redirectRepository) List(ctx context.Context, opts base.ListOptions) ([]Redirect, error) {
+	var redirects []Redirect
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, by path by default
+	sortBy, ascending := "from_path", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&redirects)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list redirects")
+	}
+
+	return redirects, nil
+}
+
+func (r *redirectRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count redirects")
+	}
+
+	return int(count), nil
+}
+
+func (r *redirectRepository) findOne(ctx context.Context, field, value string) (*Redirect, error) {
+	var redirects []Redirect
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, value)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&redirects)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find redirect")
+	}
+
+	if len(redirects) == 0 {
+		return nil, nil
+	}
+	return &redirects[0], nil
+}
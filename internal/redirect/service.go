@@ -0,0 +1,284 @@
+package redirect
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// refreshInterval is how long a matcher is used before it is rebuilt, so
+// changes made through another instance are picked up
+const refreshInterval = 5 * time.Minute
+
+type RedirectService interface {
+	// Resolve returns where path redirects to
+	Resolve(ctx context.Context, path string) (*Resolution, error)
+	CreateRedirect(ctx context.Context, redirectCreate *RedirectCreate) (*Redirect, error)
+	UpdateRedirect(ctx context.Context, redirectUpdate *RedirectUpdate) (*Redirect, error)
+	DeleteRedirect(ctx context.Context, id string) error
+	GetRedirect(ctx context.Context, id string) (*Redirect, error)
+	ListRedirects(ctx context.Context, opts base.ListOptions) ([]Redirect, error)
+	CountRedirects(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+// builtMatcher is the matcher of a tenant and when it was built
+type builtMatcher struct {
+	matcher *matcher
+	builtAt time.Time
+}
+
+type redirectService struct {
+	redirectRepo RedirectRepository
+
+	mu       sync.RWMutex
+	matchers map[uuid.UUID]builtMatcher
+}
+
+func NewRedirectService(redirectRepo RedirectRepository) RedirectService {
+	return &redirectService{
+		redirectRepo: redirectRepo,
+		matchers:     map[uuid.UUID]builtMatcher{},
+	}
+}
+
+func (s *redirectService) Resolve(ctx context.Context, path string) (*Resolution, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Path is required",
+			nil,
+		)
+	}
+
+	m, err := s.matcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := m.match(path)
+	if resolution == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"No redirect for this path",
+			nil,
+			errors.WithContext("path", path),
+		)
+	}
+	return resolution, nil
+}
+
+func (s *redirectService) CreateRedirect(ctx context.Context, redirectCreate *RedirectCreate) (*Redirect, error) {
+	// Validate input
+	if err := validator.ValidateModel(redirectCreate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	redirect := &Redirect{
+		ID:         uuid.New(),
+		FromPath:   normalizeFromPath(redirectCreate.FromPath),
+		ToURL:      strings.TrimSpace(redirectCreate.ToURL),
+		StatusCode: redirectCreate.StatusCode,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+	if redirect.StatusCode == 0 {
+		redirect.StatusCode = http.StatusMovedPermanently
+	}
+
+	if err := s.check(ctx, redirect); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.redirectRepo.Create(ctx, redirect); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+	return redirect, nil
+}
+
+func (s *redirectService) UpdateRedirect(ctx context.Context, redirectUpdate *RedirectUpdate) (*Redirect, error) {
+	// Validate input
+	if err := validator.ValidateModel(redirectUpdate); err != nil {
+		return nil, err
+	}
+
+	redirect, err := s.GetRedirect(ctx, redirectUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(redirectUpdate.FromPath) != "" {
+		redirect.FromPath = normalizeFromPath(redirectUpdate.FromPath)
+	}
+	if toURL := strings.TrimSpace(redirectUpdate.ToURL); toURL != "" {
+		redirect.ToURL = toURL
+	}
+	if redirectUpdate.StatusCode != 0 {
+		redirect.StatusCode = redirectUpdate.StatusCode
+	}
+
+	if err := s.check(ctx, redirect); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	redirect.UpdatedAt = &now
+
+	if _, err := s.redirectRepo.Update(ctx, redirect); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+	return redirect, nil
+}
+
+func (s *redirectService) DeleteRedirect(ctx context.Context, id string) error {
+	if _, err := s.GetRedirect(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.redirectRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate(ctx)
+	return nil
+}
+
+func (s *redirectService) GetRedirect(ctx context.Context, id string) (*Redirect, error) {
+	redirect, err := s.redirectRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if redirect == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Redirect not found",
+			nil,
+			errors.WithContext("redirect_id", id),
+		)
+	}
+	return redirect, nil
+}
+
+func (s *redirectService) ListRedirects(ctx context.Context, opts base.ListOptions) ([]Redirect, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.redirectRepo.List(ctx, opts)
+}
+
+func (s *redirectService) CountRedirects(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.redirectRepo.Count(ctx, filters)
+}
+
+// matcher returns the matcher of the tenant in ctx, building it when it is
+// missing or stale
+func (s *redirectService) matcher(ctx context.Context) (*matcher, error) {
+	tenantID := tenantKey(ctx)
+
+	s.mu.RLock()
+	built, ok := s.matchers[tenantID]
+	s.mu.RUnlock()
+	if ok && time.Since(built.builtAt) < refreshInterval {
+		return built.matcher, nil
+	}
+
+	redirects, err := s.redirectRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := newMatcher(redirects)
+
+	s.mu.Lock()
+	s.matchers[tenantID] = builtMatcher{matcher: m, builtAt: time.Now()}
+	s.mu.Unlock()
+	return m, nil
+}
+
+// invalidate drops the matcher of the tenant in ctx after its redirects
+// changed
+func (s *redirectService) invalidate(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.matchers, tenantKey(ctx))
+}
+
+// check validates a redirect and makes sure no other one uses its path
+func (s *redirectService) check(ctx context.Context, redirect *Redirect) error {
+	switch redirect.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return errors.New(
+			errors.ErrValidation,
+			"Status code must be 301, 302, 307 or 308",
+			nil,
+			errors.WithContext("status_code", redirect.StatusCode),
+		)
+	}
+
+	if !strings.HasPrefix(redirect.ToURL, "/") || strings.HasPrefix(redirect.ToURL, "//") {
+		target, err := url.Parse(redirect.ToURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return errors.New(
+				errors.ErrValidation,
+				"Target must be a path or an http(s) URL",
+				err,
+				errors.WithContext("to_url", redirect.ToURL),
+			)
+		}
+	}
+
+	// A rule sending a path to itself would loop forever
+	if redirect.ToURL == redirect.FromPath {
+		return errors.New(
+			errors.ErrValidation,
+			"A redirect cannot point to its own path",
+			nil,
+			errors.WithContext("from_path", redirect.FromPath),
+		)
+	}
+
+	existing, err := s.redirectRepo.FindByFromPath(ctx, redirect.FromPath)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != redirect.ID {
+		return errors.New(
+			errors.ErrConflict,
+			"A redirect for this path already exists",
+			nil,
+			errors.WithContext("from_path", redirect.FromPath),
+		)
+	}
+	return nil
+}
+
+// normalizeFromPath normalizes a from path the way requested paths are,
+// keeping a trailing wildcard. Query strings are not matched on.
+func normalizeFromPath(fromPath string) string {
+	fromPath, _, _ = strings.Cut(strings.TrimSpace(fromPath), "?")
+	if prefix, ok := strings.CutSuffix(fromPath, wildcard); ok {
+		return strings.TrimSuffix(normalizePath(prefix), "/") + wildcard
+	}
+	return normalizePath(fromPath)
+}
+
+// tenantKey returns the ID of the tenant in ctx, which matchers are built
+// for, or the nil UUID outside of a tenant
+func tenantKey(ctx context.Context) uuid.UUID {
+	tenant, _ := base.TenantFromContext(ctx)
+	return tenant.ID
+}
@@ -0,0 +1,31 @@
+package response
+
+import "github.com/gin-gonic/gin"
+
+// ProfileKey is the context key of the serialization profile of a request
+const ProfileKey = "response_profile"
+
+// Profile selects how responses are serialized, so the same handlers can
+// answer every API version
+type Profile string
+
+const (
+	// ProfileV1 renders the original envelope with snake_case keys, page
+	// based pagination and errors inside the envelope
+	ProfileV1 Profile = "v1"
+
+	// ProfileV2 renders camelCase keys, page token pagination and RFC 7807
+	// problem details for errors, and accepts camelCase request keys
+	ProfileV2 Profile = "v2"
+)
+
+// ProfileOf returns the serialization profile assigned to the request,
+// defaulting to ProfileV1
+func ProfileOf(c *gin.Context) Profile {
+	if value, ok := c.Get(ProfileKey); ok {
+		if profile, ok := value.(Profile); ok {
+			return profile
+		}
+	}
+	return ProfileV1
+}
@@ -10,6 +10,20 @@ import (
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
 )
 
+// RequestIDKey is the context key of the ID assigned to each request
+const RequestIDKey = "request_id"
+
+// RequestID returns the ID assigned to the request, or a new one when no
+// middleware assigned it
+func RequestID(c *gin.Context) uuid.UUID {
+	if id, ok := c.Get(RequestIDKey); ok {
+		if requestID, ok := id.(uuid.UUID); ok {
+			return requestID
+		}
+	}
+	return uuid.New()
+}
+
 // ResponseOption allows for optional configuration of responses
 type ResponseOption func(*APIResponse)
 
@@ -88,14 +102,16 @@ func WithPagination(total, page, perPage int) ResponseOption {
 	}
 }
 
-// Success creates a flexible successful API response
+// Success creates a flexible successful API response. Data is rendered
+// for the role of the request, see View, in the envelope of the request's
+// serialization profile.
 func Success(c *gin.Context, statusCode int, data interface{}, message string, opts ...ResponseOption) {
 	resp := &APIResponse{
 		Success:   true,
-		RequestID: uuid.New(),
+		RequestID: RequestID(c),
 		Timestamp: time.Now().UTC(),
 		Message:   message,
-		Data:      data,
+		Data:      View(c, data),
 		Metadata:  make(map[string]interface{}),
 	}
 
@@ -104,10 +120,15 @@ func Success(c *gin.Context, statusCode int, data interface{}, message string, o
 		opt(resp)
 	}
 
+	if ProfileOf(c) == ProfileV2 {
+		writeV2(c, statusCode, resp)
+		return
+	}
 	c.JSON(statusCode, resp)
 }
 
-// Error creates a standardized error response from a CustomError
+// Error creates a standardized error response from a CustomError, written
+// as problem details under ProfileV2
 func Error(c *gin.Context, err *errors.CustomError, opts ...ResponseOption) {
 	errorMessage := err.Error()
 	var lastError string
@@ -123,37 +144,14 @@ func Error(c *gin.Context, err *errors.CustomError, opts ...ResponseOption) {
 
 	resp := &APIResponse{
 		Success:   false,
-		RequestID: uuid.New(),
+		RequestID: RequestID(c),
 		Timestamp: time.Now().UTC(),
 		Message:   lastError,
 		Metadata:  make(map[string]interface{}),
 	}
 
 	// Determine status code based on error type
-	var statusCode int
-	switch err.Type {
-	case errors.ErrValidation, errors.ErrBadRequest:
-		statusCode = http.StatusBadRequest
-	case errors.ErrNotFound:
-		statusCode = http.StatusNotFound
-	case errors.ErrAuthentication, errors.ErrUnauthorized:
-		statusCode = http.StatusUnauthorized
-	case errors.ErrAuthorization, errors.ErrForbidden:
-		statusCode = http.StatusForbidden
-	case errors.ErrDatabase,
-		errors.ErrNetwork,
-		errors.ErrConfiguration,
-		errors.ErrInternal,
-		errors.ErrTimeout,
-		errors.ErrCanceled:
-		statusCode = http.StatusInternalServerError
-	case errors.ErrConflict:
-		statusCode = http.StatusConflict
-	case errors.ErrMethodNotAllowed:
-		statusCode = http.StatusMethodNotAllowed
-	default:
-		statusCode = http.StatusInternalServerError
-	}
+	statusCode := StatusCode(err.Type)
 
 	// Create comprehensive error details
 	resp.Error = &ErrorDetails{
@@ -171,6 +169,10 @@ func Error(c *gin.Context, err *errors.CustomError, opts ...ResponseOption) {
 		opt(resp)
 	}
 
+	if ProfileOf(c) == ProfileV2 {
+		writeProblem(c, statusCode, err, resp)
+		return
+	}
 	c.JSON(statusCode, resp)
 }
 
@@ -249,3 +251,40 @@ func InternalServerError(c *gin.Context, errorCode string, message string, detai
 	)
 	Error(c, customErr)
 }
+
+// StatusCode returns the HTTP status answered for an error type
+func StatusCode(errorType errors.ErrorType) int {
+	switch errorType {
+	case errors.ErrValidation, errors.ErrBadRequest:
+		return http.StatusBadRequest
+	case errors.ErrNotFound:
+		return http.StatusNotFound
+	case errors.ErrAuthentication, errors.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case errors.ErrAuthorization, errors.ErrForbidden:
+		return http.StatusForbidden
+	case errors.ErrDatabase,
+		errors.ErrNetwork,
+		errors.ErrConfiguration,
+		errors.ErrInternal,
+		errors.ErrTimeout,
+		errors.ErrCanceled:
+		return http.StatusInternalServerError
+	case errors.ErrConflict:
+		return http.StatusConflict
+	case errors.ErrMethodNotAllowed:
+		return http.StatusMethodNotAllowed
+	case errors.ErrTooManyRequests:
+		return http.StatusTooManyRequests
+	case errors.ErrPayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case errors.ErrUnavailable:
+		return http.StatusServiceUnavailable
+	case errors.ErrConfirmation:
+		return http.StatusPreconditionRequired
+	case errors.ErrGone:
+		return http.StatusGone
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,314 @@
+package response
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix prefixes the error type in the type URI of a problem
+const problemTypePrefix = "urn:problem-type:"
+
+// snakeCaseKey matches the keys renamed to camelCase; keys carrying
+// uppercase letters, e.g. locales like pt_BR, are kept as they are
+var snakeCaseKey = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)+$`)
+
+var (
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+	// jsonFieldCache holds the JSON field index of each struct type
+	jsonFieldCache sync.Map
+)
+
+// EnvelopeV2 is the response structure of the v2 API
+type EnvelopeV2 struct {
+	// Actual response data, with camelCase keys
+	Data interface{} `json:"data"`
+
+	// Request ID, timestamp, message and any additional metadata
+	Meta map[string]interface{} `json:"meta"`
+
+	// Page token pagination information (optional)
+	Pagination *PageTokenPagination `json:"pagination,omitempty"`
+}
+
+// PageTokenPagination represents the pagination metadata of the v2 API.
+// The page tokens are opaque; pass them back as the pageToken query
+// parameter. They address pages by position, so items created or deleted
+// between requests shift the pages that follow.
+type PageTokenPagination struct {
+	Total         int    `json:"total"`
+	Limit         int    `json:"limit"`
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	PrevPageToken string `json:"prevPageToken,omitempty"`
+	HasMore       bool   `json:"hasMore"`
+}
+
+// Problem is an RFC 7807 problem details document, extended with the
+// request ID and the machine-readable error code
+type Problem struct {
+	Type      string                 `json:"type"`
+	Title     string                 `json:"title"`
+	Status    int                    `json:"status"`
+	Detail    string                 `json:"detail,omitempty"`
+	Instance  string                 `json:"instance,omitempty"`
+	Code      string                 `json:"code"`
+	RequestID uuid.UUID              `json:"requestId"`
+	Timestamp time.Time              `json:"timestamp"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+}
+
+// EncodePageToken returns the opaque page token pointing at an offset
+func EncodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("o:" + strconv.Itoa(offset)))
+}
+
+// DecodePageToken returns the offset a page token points at
+func DecodePageToken(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	value, ok := strings.CutPrefix(string(raw), "o:")
+	if !ok {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	return offset, nil
+}
+
+// pageTokenPagination translates page based pagination into page tokens
+func pageTokenPagination(p *Pagination) *PageTokenPagination {
+	if p == nil {
+		return nil
+	}
+
+	offset := (p.Page - 1) * p.PerPage
+	tokens := &PageTokenPagination{
+		Total:   p.Total,
+		Limit:   p.PerPage,
+		HasMore: p.HasNextPage,
+	}
+	if p.HasNextPage {
+		tokens.NextPageToken = EncodePageToken(offset + p.PerPage)
+	}
+	if offset > 0 {
+		tokens.PrevPageToken = EncodePageToken(max(offset-p.PerPage, 0))
+	}
+	return tokens
+}
+
+// writeV2 writes a successful response in the v2 envelope
+func writeV2(c *gin.Context, statusCode int, resp *APIResponse) {
+	meta, _ := camelize(resp.Metadata).(map[string]interface{})
+	if meta == nil {
+		meta = make(map[string]interface{})
+	}
+	meta["requestId"] = resp.RequestID
+	meta["timestamp"] = resp.Timestamp
+	if resp.Message != "" {
+		meta["message"] = resp.Message
+	}
+
+	c.JSON(statusCode, &EnvelopeV2{
+		Data:       camelize(resp.Data),
+		Meta:       meta,
+		Pagination: pageTokenPagination(resp.Pagination),
+	})
+}
+
+// writeProblem writes an error response as RFC 7807 problem details
+func writeProblem(c *gin.Context, statusCode int, err *errors.CustomError, resp *APIResponse) {
+	code := string(err.Type)
+	problem := &Problem{
+		Type:      problemTypePrefix + strings.ReplaceAll(strings.ToLower(strings.TrimSuffix(code, "_ERROR")), "_", "-"),
+		Title:     http.StatusText(statusCode),
+		Status:    statusCode,
+		Detail:    resp.Message,
+		Code:      code,
+		RequestID: resp.RequestID,
+		Timestamp: resp.Timestamp,
+	}
+	if c.Request != nil {
+		problem.Instance = c.Request.URL.Path
+	}
+	if len(resp.Metadata) > 0 {
+		problem.Context, _ = camelize(resp.Metadata).(map[string]interface{})
+	}
+
+	c.Header("Content-Type", ProblemContentType)
+	c.JSON(statusCode, problem)
+}
+
+// camelize renders data through its JSON encoding with its object keys in
+// camelCase. Struct fields and the maps handlers build are renamed; maps
+// held in struct fields, such as metadata or configuration, are data and
+// keep their keys. Data that cannot be encoded is returned as is so the
+// encoding error surfaces when the response is written.
+func camelize(data interface{}) interface{} {
+	if data == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return data
+	}
+	return camelValue(reflect.ValueOf(data), value, false)
+}
+
+// camelValue renames the object keys of a decoded JSON value, guided by the
+// Go value it was encoded from. Keys of free-form maps are kept.
+func camelValue(source reflect.Value, value interface{}, freeForm bool) interface{} {
+	source = indirect(source)
+	if !source.IsValid() || source.Type().Implements(marshalerType) {
+		// Types encoding themselves define their own keys
+		return value
+	}
+
+	switch source.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		fields := jsonFields(source.Type())
+		renamed := make(map[string]interface{}, len(object))
+		for key, item := range object {
+			var field reflect.Value
+			if index, ok := fields[key]; ok {
+				field, _ = source.FieldByIndexErr(index)
+			}
+			renamed[camelKey(key)] = camelValue(field, item, indirect(field).Kind() == reflect.Map)
+		}
+		return renamed
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		keyType := source.Type().Key()
+		renamed := make(map[string]interface{}, len(object))
+		for key, item := range object {
+			var entry reflect.Value
+			if keyType.Kind() == reflect.String {
+				entry = source.MapIndex(reflect.ValueOf(key).Convert(keyType))
+			}
+			if !freeForm {
+				key = camelKey(key)
+			}
+			renamed[key] = camelValue(entry, item, freeForm)
+		}
+		return renamed
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		for i := range items {
+			if i < source.Len() {
+				items[i] = camelValue(source.Index(i), items[i], freeForm)
+			}
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// camelKey converts a snake_case key to camelCase
+func camelKey(key string) string {
+	if !snakeCaseKey.MatchString(key) {
+		return key
+	}
+
+	parts := strings.Split(key, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// indirect follows pointers and interfaces to the value they hold
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// jsonFields indexes the fields of a struct type by their JSON name,
+// including the fields promoted from embedded structs
+func jsonFields(t reflect.Type) map[string][]int {
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.(map[string][]int)
+	}
+
+	fields := make(map[string][]int)
+	collectJSONFields(t, nil, fields)
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+func collectJSONFields(t reflect.Type, index []int, fields map[string][]int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectJSONFields(embedded, fieldIndex, fields)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		// Like encoding/json, the shallowest field wins
+		if existing, ok := fields[name]; !ok || len(fieldIndex) < len(existing) {
+			fields[name] = fieldIndex
+		}
+	}
+}
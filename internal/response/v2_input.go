@@ -0,0 +1,138 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// camelCaseKey matches the keys v2 clients send in camelCase
+var camelCaseKey = regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*)+$`)
+
+// SnakeKey converts a camelCase key to snake_case, so v2 clients can use
+// the names they are answered with
+func SnakeKey(key string) string {
+	if !camelCaseKey.MatchString(key) {
+		return key
+	}
+
+	var b strings.Builder
+	for _, r := range key {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SnakeQuery renames the camelCase query parameters of a v2 request to the
+// snake_case names handlers read. A parameter sent under both names keeps
+// its snake_case values.
+func SnakeQuery(r *http.Request) {
+	query := r.URL.Query()
+	renamed := false
+	for key, values := range query {
+		snake := SnakeKey(key)
+		if snake == key {
+			continue
+		}
+		if _, ok := query[snake]; !ok {
+			query[snake] = values
+		}
+		delete(query, key)
+		renamed = true
+	}
+
+	if renamed {
+		r.URL.RawQuery = query.Encode()
+	}
+}
+
+// SnakeJSON renames the camelCase keys of a v2 JSON body to the JSON names
+// of the fields of target, so the body binds like a v1 one. Like responses,
+// maps held in struct fields are data and keep their keys.
+func SnakeJSON(body []byte, target interface{}) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(snakeValue(reflect.TypeOf(target), value))
+}
+
+// snakeValue renames the object keys of a decoded JSON value after the
+// fields of the type it is bound to
+func snakeValue(t reflect.Type, value interface{}) interface{} {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Types decoding themselves define their own keys
+		return value
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		fields := jsonFields(t)
+		renamed := make(map[string]interface{}, len(object))
+		for key, item := range object {
+			name := fieldName(fields, key)
+			if _, sent := object[name]; name != key && sent {
+				// The field was also sent under its own name
+				continue
+			}
+
+			var fieldType reflect.Type
+			if index, ok := fields[name]; ok {
+				fieldType = t.FieldByIndex(index).Type
+			}
+			renamed[name] = snakeValue(fieldType, item)
+		}
+		return renamed
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		for key, item := range object {
+			object[key] = snakeValue(t.Elem(), item)
+		}
+		return object
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		for i := range items {
+			items[i] = snakeValue(t.Elem(), items[i])
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// fieldName returns the JSON name of the field a key addresses, either
+// directly or by its camelCase form
+func fieldName(fields map[string][]int, key string) string {
+	if _, ok := fields[key]; ok {
+		return key
+	}
+	for name := range fields {
+		if camelKey(name) == key {
+			return name
+		}
+	}
+	return key
+}
@@ -0,0 +1,62 @@
+package response
+
+import (
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Viewable is implemented by models whose public responses show less than
+// admins see. PublicView lists the fields visitors may see explicitly, so a
+// field added to a model stays private until it is added there too.
+//
+// Types embedding a Viewable must implement it themselves, or they are
+// rendered as the view of the embedded model.
+type Viewable interface {
+	PublicView() interface{}
+	AdminView() interface{}
+}
+
+var viewableType = reflect.TypeOf((*Viewable)(nil)).Elem()
+
+// IsAdmin reports whether the request was authenticated by the admin JWT
+func IsAdmin(c *gin.Context) bool {
+	_, ok := c.Get("user_id")
+	return ok
+}
+
+// View renders data for the role of the request: a Viewable, or each one
+// of a slice of them, is replaced by its admin view on routes behind the
+// admin JWT and by its public view elsewhere. Other data is left as is.
+func View(c *gin.Context, data interface{}) interface{} {
+	admin := IsAdmin(c)
+
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		return data
+	}
+	if v, ok := data.(Viewable); ok {
+		return pick(v, admin)
+	}
+
+	if value.Kind() != reflect.Slice || value.IsNil() || !value.Type().Elem().Implements(viewableType) {
+		return data
+	}
+
+	views := make([]interface{}, value.Len())
+	for i := range views {
+		item := value.Index(i)
+		if item.Kind() == reflect.Pointer && item.IsNil() {
+			continue
+		}
+		views[i] = pick(item.Interface().(Viewable), admin)
+	}
+	return views
+}
+
+func pick(v Viewable, admin bool) interface{} {
+	if admin {
+		return v.AdminView()
+	}
+	return v.PublicView()
+}
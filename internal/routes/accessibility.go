@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/accessibility"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterAccessibilityRoutes sets up admin routes for accessibility audits
+func RegisterAccessibilityRoutes(
+	r *gin.RouterGroup,
+	accessibilityHandler *accessibility.AccessibilityHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for accessibility audits
+	audit := r.Group("/admin/accessibility", routerMiddleware.VerifyJWT())
+	{
+		// Run an audit of all content now
+		audit.POST("",
+			accessibilityHandler.Audit,
+		)
+
+		// Report the issues found by the last audit
+		audit.GET("",
+			accessibilityHandler.GetReport,
+		)
+
+		// Check a single entity, e.g. before publishing it
+		audit.GET("/:entity/:id",
+			accessibilityHandler.CheckEntity,
+		)
+	}
+}
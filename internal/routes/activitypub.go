@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/activitypub"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterActivityPubRoutes sets up the federation routes. They resolve the
+// tenant from the actor's username, so they are registered before the
+// tenant resolver middleware.
+func RegisterActivityPubRoutes(
+	r *gin.RouterGroup,
+	wellKnown *gin.RouterGroup,
+	activityPubHandler *activitypub.ActivityPubHandler,
+	rateLimiter *middleware.RateLimiter,
+) {
+	// Find the actor of an account
+	wellKnown.GET("/webfinger",
+		activityPubHandler.WebFinger,
+	)
+
+	// Create a route group for actors
+	actorRoutes := r.Group("/activitypub/users/:username", activityPubHandler.ResolveActor())
+	{
+		// Get the actor
+		actorRoutes.GET("",
+			activityPubHandler.GetActor,
+		)
+
+		// Get the published notes
+		actorRoutes.GET("/outbox",
+			activityPubHandler.GetOutbox,
+		)
+
+		// Get the follower count
+		actorRoutes.GET("/followers",
+			activityPubHandler.GetFollowers,
+		)
+
+		// Get a published note
+		actorRoutes.GET("/notes/:id",
+			activityPubHandler.GetNote,
+		)
+
+		// Receive an activity
+		actorRoutes.POST("/inbox",
+			rateLimiter.Limit(),
+			activityPubHandler.PostInbox,
+		)
+	}
+}
+
+// RegisterActivityPubAdminRoutes sets up routes for managing followers
+func RegisterActivityPubAdminRoutes(
+	r *gin.RouterGroup,
+	activityPubHandler *activitypub.ActivityPubHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for managing ActivityPub
+	adminRoutes := r.Group("/admin/activitypub", routerMiddleware.VerifyJWT())
+	{
+		// List followers
+		adminRoutes.GET("/followers",
+			activityPubHandler.ListFollowers,
+		)
+
+		// Remove a follower
+		adminRoutes.DELETE("/followers/:id",
+			activityPubHandler.RemoveFollower,
+		)
+
+		// Announce new projects now
+		adminRoutes.POST("/publish",
+			activityPubHandler.Publish,
+		)
+	}
+}
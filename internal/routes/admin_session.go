@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/admin_session"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterAdminSessionRoutes sets up admin routes for reviewing admin
+// sign-ins
+func RegisterAdminSessionRoutes(
+	r *gin.RouterGroup,
+	sessionHandler *admin_session.SessionHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for admin sessions
+	adminSessions := r.Group("/admin/sessions", routerMiddleware.VerifyJWT())
+	{
+		// List sessions, optionally only flagged ones
+		adminSessions.GET("",
+			sessionHandler.ListSessions,
+		)
+
+		// Get a session
+		adminSessions.GET("/:id",
+			sessionHandler.GetSession,
+		)
+
+		// Flag a session as suspicious or clear the flag
+		adminSessions.PUT("/:id/flag",
+			sessionHandler.FlagSession,
+		)
+	}
+}
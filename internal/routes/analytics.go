@@ -0,0 +1,85 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/analytics"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterAnalyticsRoutes sets up routes for analytics operations
+func RegisterAnalyticsRoutes(
+	r *gin.RouterGroup,
+	analyticsHandler *analytics.AnalyticsHandler,
+	routerMiddleware *middleware.Middleware,
+	challengeGuard *middleware.ChallengeGuard,
+) {
+	// Create a route group for analytics
+	analyticsGroup := r.Group("/analytics")
+	{
+		// Record a visitor event. Clients send it with fetch and
+		// keepalive rather than navigator.sendBeacon, which cannot set the
+		// challenge headers.
+		analyticsGroup.POST("/events",
+			challengeGuard.RequireChallenge(),
+			analyticsHandler.TrackEvent,
+		)
+
+		// Record the case study sections a visitor reached
+		analyticsGroup.POST("/engagement",
+			challengeGuard.RequireChallenge(),
+			analyticsHandler.RecordEngagement,
+		)
+
+		// Get how far visitors read into case studies
+		analyticsGroup.GET("/engagement",
+			routerMiddleware.VerifyJWT(),
+			analyticsHandler.GetEngagementReport,
+		)
+
+		// Get visitors by location
+		analyticsGroup.GET("/geo",
+			routerMiddleware.VerifyJWT(),
+			analyticsHandler.GetGeoStats,
+		)
+
+		// Get visits and inquiries by campaign
+		analyticsGroup.GET("/campaigns",
+			routerMiddleware.VerifyJWT(),
+			analyticsHandler.GetCampaignStats,
+		)
+
+		// Create a route group for managing funnels
+		funnels := analyticsGroup.Group("/funnels", routerMiddleware.VerifyJWT())
+		{
+			// List funnels
+			funnels.GET("",
+				analyticsHandler.ListFunnels,
+			)
+
+			// Create a funnel
+			funnels.POST("",
+				analyticsHandler.CreateFunnel,
+			)
+
+			// Get a funnel
+			funnels.GET("/:id",
+				analyticsHandler.GetFunnel,
+			)
+
+			// Update a funnel
+			funnels.PUT("/:id",
+				analyticsHandler.UpdateFunnel,
+			)
+
+			// Delete a funnel
+			funnels.DELETE("/:id",
+				analyticsHandler.DeleteFunnel,
+			)
+
+			// Get the step-through rates of a funnel
+			funnels.GET("/:id/report",
+				analyticsHandler.GetFunnelReport,
+			)
+		}
+	}
+}
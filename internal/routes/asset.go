@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterAssetRoutes sets up routes for asset operations
+func RegisterAssetRoutes(
+	r *gin.RouterGroup,
+	assetHandler *asset.AssetHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for assets
+	assets := r.Group("/assets")
+	{
+		// Get the logical path to URL manifest
+		assets.GET("/manifest",
+			assetHandler.GetManifest,
+		)
+
+		// List assets with their fingerprints
+		assets.GET("",
+			routerMiddleware.VerifyJWT(),
+			assetHandler.ListAssets,
+		)
+	}
+}
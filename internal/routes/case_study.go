@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/case_study"
+)
+
+// RegisterCaseStudyRoutes sets up routes for rendered case studies
+func RegisterCaseStudyRoutes(
+	r *gin.RouterGroup,
+	caseStudyHandler *case_study.CaseStudyHandler,
+) {
+	// Get the printable case study of a project
+	r.GET("/projects/:id/print",
+		caseStudyHandler.GetPrintView,
+	)
+
+	// Get the highlighted code of a gist or repository file
+	r.GET("/snippets",
+		caseStudyHandler.GetSnippet,
+	)
+}
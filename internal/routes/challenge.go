@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterChallengeRoutes sets up routes for proof-of-work challenges
+func RegisterChallengeRoutes(
+	r *gin.RouterGroup,
+	challengeGuard *middleware.ChallengeGuard,
+) {
+	// Issue a challenge for protected public write endpoints
+	r.GET("/challenge",
+		challengeGuard.IssueChallenge,
+	)
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/changelog"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterChangelogRoutes sets up the public changelog feed and the routes
+// for writing entries by hand
+func RegisterChangelogRoutes(
+	r *gin.RouterGroup,
+	changelogHandler *changelog.ChangelogHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Get the changelog feed
+	r.GET("/changelog",
+		changelogHandler.ListEntries,
+	)
+
+	// Create a route group for managing changelog entries
+	entries := r.Group("/admin/changelog", routerMiddleware.VerifyJWT())
+	{
+		// Create a changelog entry
+		entries.POST("",
+			changelogHandler.CreateEntry,
+		)
+
+		// Update a changelog entry
+		entries.PUT("/:id",
+			changelogHandler.UpdateEntry,
+		)
+
+		// Delete a changelog entry
+		entries.DELETE("/:id",
+			changelogHandler.DeleteEntry,
+		)
+	}
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/chat"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterChatRoutes sets up routes for the portfolio chatbot
+func RegisterChatRoutes(
+	r *gin.RouterGroup,
+	chatHandler *chat.ChatHandler,
+	rateLimiter *middleware.RateLimiter,
+	aiLimiter *middleware.ConcurrencyLimiter,
+) {
+	// Create a route group for the chatbot
+	chatRoutes := r.Group("/chat")
+	{
+		// Ask a question about the portfolio
+		chatRoutes.POST("",
+			rateLimiter.Limit(),
+			aiLimiter.Limit(),
+			chatHandler.Ask,
+		)
+
+		// End a conversation
+		chatRoutes.DELETE("/:session_id",
+			chatHandler.EndSession,
+		)
+	}
+}
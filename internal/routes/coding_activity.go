@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/coding_activity"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterCodingActivityRoutes sets up routes for the coding activity chart
+func RegisterCodingActivityRoutes(
+	r *gin.RouterGroup,
+	codingActivityHandler *coding_activity.CodingActivityHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for coding activity
+	activity := r.Group("/coding-activity")
+	{
+		// Get totals and languages over the last days
+		activity.GET("",
+			codingActivityHandler.GetSummary,
+		)
+
+		// Get hours per week
+		activity.GET("/weekly",
+			codingActivityHandler.GetWeeklyActivity,
+		)
+	}
+
+	// Pull coding activity from WakaTime now
+	r.POST("/admin/coding-activity/refresh",
+		routerMiddleware.VerifyJWT(),
+		codingActivityHandler.Sync,
+	)
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/collection"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterCollectionRoutes sets up routes for reading and managing series
+// of projects
+func RegisterCollectionRoutes(
+	r *gin.RouterGroup,
+	collectionHandler *collection.CollectionHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for reading collections
+	collections := r.Group("/collections")
+	{
+		// List collections
+		collections.GET("",
+			collectionHandler.ListCollections,
+		)
+
+		// Get a collection with its projects
+		collections.GET("/:slug",
+			collectionHandler.GetCollectionDetail,
+		)
+	}
+
+	// Create a route group for managing collections
+	admin := r.Group("/admin/collections", routerMiddleware.VerifyJWT())
+	{
+		// Create a collection
+		admin.POST("",
+			collectionHandler.CreateCollection,
+		)
+
+		// Get a collection
+		admin.GET("/:id",
+			collectionHandler.GetCollection,
+		)
+
+		// Update a collection
+		admin.PUT("/:id",
+			collectionHandler.UpdateCollection,
+		)
+
+		// Delete a collection
+		admin.DELETE("/:id",
+			collectionHandler.DeleteCollection,
+		)
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/command_index"
+)
+
+// RegisterCommandIndexRoutes sets up routes for the command palette
+func RegisterCommandIndexRoutes(
+	r *gin.RouterGroup,
+	commandIndexHandler *command_index.CommandIndexHandler,
+) {
+	// Get the index of the public content
+	r.GET("/command-index",
+		commandIndexHandler.GetCommandIndex,
+	)
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/company"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterCompanyRoutes sets up routes for company operations
+func RegisterCompanyRoutes(
+	r *gin.RouterGroup,
+	companyHandler *company.CompanyHandler,
+	experienceHandler *experience.ExperienceHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for companies
+	companies := r.Group("/companies")
+	{
+		// Create a new company
+		companies.POST("",
+			routerMiddleware.VerifyJWT(),
+			companyHandler.CreateCompany,
+		)
+
+		// List companies
+		companies.GET("",
+			companyHandler.ListCompanies,
+		)
+
+		// Get a specific company by ID
+		companies.GET("/:id",
+			companyHandler.GetCompanyByID,
+		)
+
+		// List the experiences at a company
+		companies.GET("/:id/experiences",
+			experienceHandler.ListCompanyExperiences,
+		)
+
+		// Update a company
+		companies.PUT("/:id",
+			routerMiddleware.VerifyJWT(),
+			companyHandler.UpdateCompany,
+		)
+
+		// Delete a company
+		companies.DELETE("/:id",
+			routerMiddleware.VerifyJWT(),
+			companyHandler.DeleteCompany,
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/contract_fixtures"
+)
+
+// RegisterFixturesRoutes sets up routes serving canonical example payloads,
+// for mock servers and contract tests. The group is tagged debug by the
+// caller so restricted environments hide it.
+func RegisterFixturesRoutes(
+	r *gin.RouterGroup,
+	fixturesHandler *contract_fixtures.FixturesHandler,
+) {
+	// Create a route group for the fixtures
+	fixturesGroup := r.Group("/_fixtures")
+	{
+		// List the entities fixtures are served for
+		fixturesGroup.GET("",
+			fixturesHandler.ListEntities,
+		)
+
+		// Get the fixture of an entity
+		fixturesGroup.GET("/:entity",
+			fixturesHandler.GetFixture,
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/cors_policy"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterCORSPolicyRoutes sets up admin routes for the CORS policy
+func RegisterCORSPolicyRoutes(
+	r *gin.RouterGroup,
+	corsPolicyHandler *cors_policy.CORSPolicyHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the CORS policy
+	corsPolicy := r.Group("/admin/cors", routerMiddleware.VerifyJWT())
+	{
+		// Get the allowed origins
+		corsPolicy.GET("",
+			corsPolicyHandler.GetPolicy,
+		)
+
+		// Replace the allowed origins
+		corsPolicy.PUT("",
+			corsPolicyHandler.UpdateOrigins,
+		)
+	}
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/deprecation"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterDeprecationRoutes sets up admin routes for the deprecated
+// endpoints report
+func RegisterDeprecationRoutes(
+	r *gin.RouterGroup,
+	deprecationHandler *deprecation.DeprecationHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the deprecations
+	deprecations := r.Group("/admin/deprecations", routerMiddleware.VerifyJWT())
+	{
+		// Get the deprecated endpoints and their traffic
+		deprecations.GET("",
+			deprecationHandler.GetReport,
+		)
+	}
+}
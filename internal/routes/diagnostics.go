@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/diagnostics"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterDiagnosticsRoutes sets up admin routes for diagnosing a running server
+func RegisterDiagnosticsRoutes(
+	r *gin.RouterGroup,
+	diagnosticsHandler *diagnostics.DiagnosticsHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for diagnostics
+	adminDiagnostics := r.Group("/admin/diagnostics", routerMiddleware.VerifyJWT())
+	{
+		// Count slow database calls and requests
+		adminDiagnostics.GET("/slow-calls",
+			diagnosticsHandler.GetSlowCalls,
+		)
+
+		// Clear the slow call counts
+		adminDiagnostics.DELETE("/slow-calls",
+			diagnosticsHandler.ResetSlowCalls,
+		)
+	}
+
+	// Report goroutines, heap and GC statistics and build information
+	r.GET("/admin/runtime",
+		routerMiddleware.VerifyJWT(),
+		diagnosticsHandler.GetRuntime,
+	)
+}
+
+// RegisterProfilingRoutes serves the pprof profiles at /debug/pprof to
+// admins. They are tagged admin like /admin/runtime so the admin JWT is
+// their only gate, production included; hide or gate the admin tag with
+// ROUTES_HIDDEN_TAGS or ROUTES_GATED_TAGS to turn them off per environment.
+func RegisterProfilingRoutes(
+	r *gin.Engine,
+	exposure *Exposure,
+	diagnosticsHandler *diagnostics.DiagnosticsHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	profiling := exposure.Group(r, "/debug/pprof", TagAdmin, routerMiddleware.VerifyJWT())
+	{
+		// Index, named profiles, CPU profile and execution trace
+		profiling.GET("/*profile", diagnosticsHandler.Profile)
+
+		// Symbol lookups post the program counters
+		profiling.POST("/*profile", diagnosticsHandler.Profile)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/duplicates"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterDuplicatesRoutes sets up routes for the duplicate content report
+func RegisterDuplicatesRoutes(
+	r *gin.RouterGroup,
+	duplicatesHandler *duplicates.DuplicatesHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the duplicates report
+	adminDuplicates := r.Group("/admin/duplicates", routerMiddleware.VerifyJWT())
+	{
+		// Report probable duplicates among existing content
+		adminDuplicates.GET("",
+			duplicatesHandler.GetDuplicates,
+		)
+	}
+}
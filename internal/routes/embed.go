@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/embed"
+)
+
+// RegisterEmbedRoutes sets up routes for oEmbed and link preview images
+func RegisterEmbedRoutes(
+	r *gin.RouterGroup,
+	embedHandler *embed.EmbedHandler,
+) {
+	// Describe a project page in the oEmbed format
+	r.GET("/oembed",
+		embedHandler.GetOEmbed,
+	)
+
+	// Render the link preview image of a project
+	r.GET("/og/projects/:slug",
+		embedHandler.GetProjectCard,
+	)
+}
@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/endorsement"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterEndorsementRoutes sets up routes for endorsing tech stacks and
+// moderating endorsements
+func RegisterEndorsementRoutes(
+	r *gin.RouterGroup,
+	endorsementHandler *endorsement.EndorsementHandler,
+	routerMiddleware *middleware.Middleware,
+	rateLimiter *middleware.RateLimiter,
+	challengeGuard *middleware.ChallengeGuard,
+) {
+	// Endorse a tech stack
+	r.POST("/tech-stacks/:id/endorsements",
+		rateLimiter.Limit(),
+		challengeGuard.RequireChallenge(),
+		endorsementHandler.Endorse,
+	)
+
+	// Create a route group for endorsement moderation
+	endorsements := r.Group("/admin/endorsements", routerMiddleware.VerifyJWT())
+	{
+		// List endorsements
+		endorsements.GET("",
+			endorsementHandler.ListEndorsements,
+		)
+
+		// Hide or show an endorsement
+		endorsements.PATCH("/:id",
+			endorsementHandler.ModerateEndorsement,
+		)
+
+		// Delete an endorsement
+		endorsements.DELETE("/:id",
+			endorsementHandler.DeleteEndorsement,
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterEventRoutes sets up routes for the activity event stream
+func RegisterEventRoutes(
+	r *gin.RouterGroup,
+	eventHandler *events.EventHandler,
+	routerMiddleware *middleware.Middleware,
+	queryTokenMaxLifetime time.Duration,
+) {
+	// Create a route group for events
+	eventsGroup := r.Group("/events")
+	{
+		// Stream activity events. EventSource cannot set headers, so the
+		// token may also be sent in the query
+		eventsGroup.GET("/stream",
+			routerMiddleware.VerifyStreamJWT(queryTokenMaxLifetime),
+			eventHandler.StreamEvents,
+		)
+	}
+}
@@ -26,6 +26,16 @@ func RegisterExperienceRoutes(
 			experienceHandler.ListExperiences,
 		)
 
+		// Get the experience timeline
+		experiences.GET("/timeline",
+			experienceHandler.GetTimeline,
+		)
+
+		// Get the experience summary
+		experiences.GET("/summary",
+			experienceHandler.GetSummary,
+		)
+
 		// Get a specific experience by ID
 		experiences.GET("/:id",
 			experienceHandler.GetExperienceByID,
@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experiment"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterExperimentRoutes sets up routes for assigning visitors to
+// variants and managing experiments
+func RegisterExperimentRoutes(
+	r *gin.RouterGroup,
+	experimentHandler *experiment.ExperimentHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Get the variants shown to the visitor
+	r.GET("/experiments/assignments",
+		experimentHandler.GetAssignments,
+	)
+
+	// Create a route group for managing experiments
+	experiments := r.Group("/admin/experiments", routerMiddleware.VerifyJWT())
+	{
+		// List experiments
+		experiments.GET("",
+			experimentHandler.ListExperiments,
+		)
+
+		// Create an experiment
+		experiments.POST("",
+			experimentHandler.CreateExperiment,
+		)
+
+		// Get an experiment
+		experiments.GET("/:id",
+			experimentHandler.GetExperiment,
+		)
+
+		// Update an experiment
+		experiments.PUT("/:id",
+			experimentHandler.UpdateExperiment,
+		)
+
+		// Delete an experiment
+		experiments.DELETE("/:id",
+			experimentHandler.DeleteExperiment,
+		)
+
+		// Get the results of an experiment
+		experiments.GET("/:id/results",
+			experimentHandler.GetResults,
+		)
+	}
+}
@@ -0,0 +1,202 @@
+package routes
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Tag classifies a route group for per-environment exposure
+type Tag string
+
+const (
+	// TagAdmin marks administration endpoints
+	TagAdmin Tag = "admin"
+	// TagDebug marks endpoints meant for diagnosing a running server
+	TagDebug Tag = "debug"
+	// TagDocs marks the API documentation
+	TagDocs Tag = "docs"
+)
+
+var knownTags = map[Tag]bool{TagAdmin: true, TagDebug: true, TagDocs: true}
+
+// ExposureConfig configures which tagged route groups are served
+type ExposureConfig struct {
+	// Restricted turns hiding and gating on, typically in production
+	Restricted bool
+
+	// Hidden tags answer 404 as if their routes did not exist
+	Hidden []string
+
+	// Gated tags are only served to clients in AllowedNetworks, in CIDR
+	// notation; other clients get 404
+	Gated           []string
+	AllowedNetworks []string
+}
+
+type taggedPrefix struct {
+	prefix string
+	tag    Tag
+}
+
+// Exposure decides centrally whether tagged route groups are served, so
+// handlers need no environment checks of their own
+type Exposure struct {
+	restricted bool
+	hidden     map[Tag]bool
+	gated      map[Tag]bool
+	networks   []*net.IPNet
+	prefixes   []taggedPrefix
+	logger     *logger.Logger
+}
+
+// NewExposure creates an exposure policy, rejecting unknown tags and
+// malformed networks
+func NewExposure(config ExposureConfig, logger *logger.Logger) (*Exposure, error) {
+	e := &Exposure{
+		restricted: config.Restricted,
+		hidden:     map[Tag]bool{},
+		gated:      map[Tag]bool{},
+		logger:     logger,
+	}
+
+	var problems []string
+	parseTags := func(names []string, into map[Tag]bool) {
+		for _, name := range names {
+			tag := Tag(strings.TrimSpace(name))
+			if tag == "" {
+				continue
+			}
+			if !knownTags[tag] {
+				problems = append(problems, fmt.Sprintf("unknown route tag %q", tag))
+				continue
+			}
+			into[tag] = true
+		}
+	}
+	parseTags(config.Hidden, e.hidden)
+	parseTags(config.Gated, e.gated)
+
+	for _, network := range config.AllowedNetworks {
+		network = strings.TrimSpace(network)
+		if network == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("invalid network %q", network))
+			continue
+		}
+		e.networks = append(e.networks, ipNet)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("route exposure: %s", strings.Join(problems, ", "))
+	}
+	return e, nil
+}
+
+// router is satisfied by both *gin.Engine and *gin.RouterGroup
+type router interface {
+	Group(relativePath string, handlers ...gin.HandlerFunc) *gin.RouterGroup
+	BasePath() string
+}
+
+// Group creates a route group whose exposure is decided by tag
+func (e *Exposure) Group(r router, relativePath string, tag Tag, handlers ...gin.HandlerFunc) *gin.RouterGroup {
+	group := r.Group(relativePath, handlers...)
+	e.Tag(group.BasePath(), tag)
+	return group
+}
+
+// Tag applies tag to every route under the path prefix, for groups
+// registered elsewhere such as the /admin groups of each feature
+func (e *Exposure) Tag(prefix string, tag Tag) {
+	e.prefixes = append(e.prefixes, taggedPrefix{prefix: strings.TrimSuffix(prefix, "/"), tag: tag})
+
+	// Longest prefixes first so the most specific tag matches
+	sort.SliceStable(e.prefixes, func(i, j int) bool {
+		return len(e.prefixes[i].prefix) > len(e.prefixes[j].prefix)
+	})
+}
+
+// Middleware enforces the exposure of tagged routes. It must be installed
+// on the engine before routes are registered.
+func (e *Exposure) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !e.restricted {
+			c.Next()
+			return
+		}
+
+		tag, ok := e.match(c.Request.URL.Path)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		switch {
+		case e.hidden[tag]:
+		case e.gated[tag] && !e.allowed(c.ClientIP()):
+			e.logger.Warn("Rejected request to gated route",
+				"tag", tag,
+				"path", c.Request.URL.Path,
+				"client_ip", c.ClientIP(),
+			)
+		default:
+			c.Next()
+			return
+		}
+
+		// Answer as if the route did not exist
+		response.NotFound(c, "route_not_found", "Endpoint not found", c.Request.URL.Path)
+		c.Abort()
+	}
+}
+
+// Summary lists the hidden and gated tags, for logging at startup
+func (e *Exposure) Summary() (hidden []string, gated []string) {
+	if !e.restricted {
+		return nil, nil
+	}
+	for tag := range e.hidden {
+		hidden = append(hidden, string(tag))
+	}
+	for tag := range e.gated {
+		if !e.hidden[tag] {
+			gated = append(gated, string(tag))
+		}
+	}
+	sort.Strings(hidden)
+	sort.Strings(gated)
+	return hidden, gated
+}
+
+// match returns the tag of the longest prefix containing path
+func (e *Exposure) match(path string) (Tag, bool) {
+	for _, p := range e.prefixes {
+		if path == p.prefix || strings.HasPrefix(path, p.prefix+"/") {
+			return p.tag, true
+		}
+	}
+	return "", false
+}
+
+// allowed reports whether the client address is in an allowed network
+func (e *Exposure) allowed(clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, network := range e.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/image_proxy"
+)
+
+// RegisterImageProxyRoutes sets up routes for the image proxy
+func RegisterImageProxyRoutes(
+	r *gin.RouterGroup,
+	imageProxyHandler *image_proxy.ImageProxyHandler,
+) {
+	// Serve a stored image with on-the-fly transforms
+	r.GET("/img/*path",
+		imageProxyHandler.GetImage,
+	)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/importer"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterImportRoutes sets up routes for bulk content imports
+func RegisterImportRoutes(
+	r *gin.RouterGroup,
+	importHandler *importer.ImportHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for imports
+	adminImport := r.Group("/admin/import", routerMiddleware.VerifyJWT())
+	{
+		// Queue an import of a seed file
+		adminImport.POST("/:entity",
+			importHandler.ImportContent,
+		)
+	}
+}
@@ -0,0 +1,73 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/indieauth"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterIndieAuthRoutes sets up the IndieAuth server routes, which let
+// IndieWeb tools sign in with the portfolio domain. Authorization requests
+// are approved by the signed-in owner on the consent page of the site.
+func RegisterIndieAuthRoutes(
+	r *gin.RouterGroup,
+	indieAuthHandler *indieauth.IndieAuthHandler,
+	routerMiddleware *middleware.Middleware,
+	rateLimiter *middleware.RateLimiter,
+) {
+	// Create a route group for the IndieAuth server
+	indieAuthRoutes := r.Group("/indieauth")
+	{
+		// Server metadata
+		indieAuthRoutes.GET("/metadata",
+			indieAuthHandler.GetMetadata,
+		)
+
+		// Send an authorization request to the consent page
+		indieAuthRoutes.GET("/auth",
+			indieAuthHandler.Authorize,
+		)
+
+		// Redeem a code for the profile URL
+		indieAuthRoutes.POST("/auth",
+			rateLimiter.Limit(),
+			indieAuthHandler.RedeemProfile,
+		)
+
+		// Redeem a code for an access token
+		indieAuthRoutes.POST("/token",
+			rateLimiter.Limit(),
+			indieAuthHandler.RedeemToken,
+		)
+
+		// Verify an access token
+		indieAuthRoutes.GET("/token",
+			indieAuthHandler.VerifyToken,
+		)
+
+		// Revoke an access token
+		indieAuthRoutes.POST("/revoke",
+			rateLimiter.Limit(),
+			indieAuthHandler.RevokeToken,
+		)
+	}
+
+	// Create a route group for managing IndieAuth
+	adminRoutes := r.Group("/admin/indieauth", routerMiddleware.VerifyJWT())
+	{
+		// Approve an authorization request
+		adminRoutes.POST("/approve",
+			indieAuthHandler.Approve,
+		)
+
+		// List authorized clients
+		adminRoutes.GET("/authorizations",
+			indieAuthHandler.ListAuthorizations,
+		)
+
+		// Revoke a client's authorization
+		adminRoutes.DELETE("/authorizations/:id",
+			indieAuthHandler.DeleteAuthorization,
+		)
+	}
+}
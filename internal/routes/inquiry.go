@@ -0,0 +1,97 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/inquiry"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterInquiryRoutes sets up routes for submitting inquiries, managing
+// the pipeline, delivering proposals and collecting payments
+func RegisterInquiryRoutes(
+	r *gin.RouterGroup,
+	inquiryHandler *inquiry.InquiryHandler,
+	routerMiddleware *middleware.Middleware,
+	rateLimiter *middleware.RateLimiter,
+	challengeGuard *middleware.ChallengeGuard,
+) {
+	// Submit an inquiry
+	r.POST("/inquiries",
+		rateLimiter.Limit(),
+		challengeGuard.RequireChallenge(),
+		inquiryHandler.SubmitInquiry,
+	)
+
+	// Download a proposal with a signed link
+	r.GET("/proposals/:id/download",
+		inquiryHandler.DownloadProposal,
+	)
+
+	// Review a proposal with a signed acceptance link
+	r.GET("/proposals/:id/accept",
+		inquiryHandler.GetProposalForAcceptance,
+	)
+
+	// Accept a proposal with a signed acceptance link
+	r.POST("/proposals/:id/accept",
+		inquiryHandler.AcceptProposal,
+	)
+
+	// Receive Stripe payment events
+	r.POST("/webhooks/stripe",
+		inquiryHandler.StripeWebhook,
+	)
+
+	// Create a route group for the inquiry pipeline
+	inquiries := r.Group("/admin/inquiries", routerMiddleware.VerifyJWT())
+	{
+		// List inquiries
+		inquiries.GET("",
+			inquiryHandler.ListInquiries,
+		)
+
+		// Get a specific inquiry by ID
+		inquiries.GET("/:id",
+			inquiryHandler.GetInquiryByID,
+		)
+
+		// Move an inquiry through the pipeline
+		inquiries.PATCH("/:id/status",
+			inquiryHandler.UpdateInquiryStatus,
+		)
+
+		// Delete an inquiry
+		inquiries.DELETE("/:id",
+			inquiryHandler.DeleteInquiry,
+		)
+
+		// Create a proposal for an inquiry
+		inquiries.POST("/:id/proposals",
+			inquiryHandler.CreateProposal,
+		)
+
+		// List the proposals of an inquiry
+		inquiries.GET("/:id/proposals",
+			inquiryHandler.ListProposals,
+		)
+
+		// List the payments of an inquiry
+		inquiries.GET("/:id/payments",
+			inquiryHandler.ListPayments,
+		)
+	}
+
+	// Create a route group for proposals
+	proposals := r.Group("/admin/proposals", routerMiddleware.VerifyJWT())
+	{
+		// Email a proposal to the prospect
+		proposals.POST("/:id/send",
+			inquiryHandler.SendProposal,
+		)
+
+		// Create a deposit payment link for an accepted proposal
+		proposals.POST("/:id/deposit",
+			inquiryHandler.CreateDepositLink,
+		)
+	}
+}
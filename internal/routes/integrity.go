@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/integrity"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterIntegrityRoutes sets up admin routes for content validation
+func RegisterIntegrityRoutes(
+	r *gin.RouterGroup,
+	integrityHandler *integrity.IntegrityHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for content validation
+	validate := r.Group("/admin/validate", routerMiddleware.VerifyJWT())
+	{
+		// Run every integrity check
+		validate.GET("",
+			integrityHandler.Validate,
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/jobs"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterJobRoutes sets up routes for background jobs
+func RegisterJobRoutes(
+	r *gin.RouterGroup,
+	jobHandler *jobs.JobHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Report the progress of a job
+	r.GET("/jobs/:id",
+		routerMiddleware.VerifyJWT(),
+		jobHandler.GetJobByID,
+	)
+
+	// Create a route group for job administration
+	adminJobs := r.Group("/admin/jobs", routerMiddleware.VerifyJWT())
+	{
+		// List jobs, including dead letters
+		adminJobs.GET("",
+			jobHandler.ListJobs,
+		)
+
+		// Requeue a dead job
+		adminJobs.POST("/:id/retry",
+			jobHandler.RetryJob,
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/linkcheck"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterLinkCheckRoutes sets up admin routes for link checking
+func RegisterLinkCheckRoutes(
+	r *gin.RouterGroup,
+	linkCheckHandler *linkcheck.LinkCheckHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for link checks
+	linkCheck := r.Group("/admin/linkcheck", routerMiddleware.VerifyJWT())
+	{
+		// Run a link check now
+		linkCheck.POST("",
+			linkCheckHandler.CheckLinks,
+		)
+
+		// List broken links found by the last check
+		linkCheck.GET("",
+			linkCheckHandler.ListBrokenLinks,
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/log_level"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterLogLevelRoutes sets up admin routes for adjusting log levels at
+// runtime
+func RegisterLogLevelRoutes(
+	r *gin.RouterGroup,
+	logLevelHandler *log_level.LogLevelHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the log levels
+	logLevels := r.Group("/admin/logging/levels", routerMiddleware.VerifyJWT())
+	{
+		// Get the default and module levels
+		logLevels.GET("",
+			logLevelHandler.GetLevels,
+		)
+
+		// Change the default and module levels
+		logLevels.PATCH("",
+			logLevelHandler.UpdateLevels,
+		)
+
+		// Make a module follow the default level again
+		logLevels.DELETE("/:module",
+			logLevelHandler.ResetModuleLevel,
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/mail"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterMailRoutes sets up routes for the email delivery log
+func RegisterMailRoutes(
+	r *gin.RouterGroup,
+	mailHandler *mail.MailHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for mail
+	mailGroup := r.Group("/mail")
+	{
+		// List email deliveries
+		mailGroup.GET("/deliveries",
+			routerMiddleware.VerifyJWT(),
+			mailHandler.ListDeliveries,
+		)
+
+		// Get a specific email delivery by ID
+		mailGroup.GET("/deliveries/:id",
+			routerMiddleware.VerifyJWT(),
+			mailHandler.GetDeliveryByID,
+		)
+
+		// Requeue an email delivery
+		mailGroup.POST("/deliveries/:id/retry",
+			routerMiddleware.VerifyJWT(),
+			mailHandler.RetryDelivery,
+		)
+	}
+}
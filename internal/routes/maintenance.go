@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/maintenance"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterMaintenanceRoutes sets up routes for content maintenance tasks
+func RegisterMaintenanceRoutes(
+	r *gin.RouterGroup,
+	maintenanceHandler *maintenance.MaintenanceHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for maintenance tasks
+	adminMaintenance := r.Group("/admin/maintenance", routerMiddleware.VerifyJWT())
+	{
+		// Recompute word counts and reading times
+		adminMaintenance.POST("/reading-time",
+			maintenanceHandler.RecomputeReadingTimes,
+		)
+
+		// Rebuild tables of contents
+		adminMaintenance.POST("/toc",
+			maintenanceHandler.RebuildTOCs,
+		)
+	}
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/media"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+)
+
+// RegisterMediaRoutes sets up admin routes for images and their alt text
+func RegisterMediaRoutes(
+	r *gin.RouterGroup,
+	mediaHandler *media.MediaHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for media management
+	adminMedia := r.Group("/admin/media", routerMiddleware.VerifyJWT())
+	{
+		// List the images of all content
+		adminMedia.GET("",
+			mediaHandler.ListImages,
+		)
+
+		// Report images without meaningful alt text
+		adminMedia.GET("/alt-report",
+			mediaHandler.GetAltReport,
+		)
+
+		// Set the alt text of an image
+		adminMedia.PUT("/alt",
+			mediaHandler.UpdateAlt,
+		)
+
+		// Generate alt text for an image
+		adminMedia.POST("/alt/suggest",
+			mediaHandler.SuggestAlt,
+		)
+	}
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/notification"
+)
+
+// RegisterNotificationRoutes sets up routes for notification channels and logs
+func RegisterNotificationRoutes(
+	r *gin.RouterGroup,
+	notificationHandler *notification.NotificationHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for notifications
+	notifications := r.Group("/notifications", routerMiddleware.VerifyJWT())
+	{
+		// Create a new notification channel
+		notifications.POST("/channels",
+			notificationHandler.CreateChannel,
+		)
+
+		// List notification channels
+		notifications.GET("/channels",
+			notificationHandler.ListChannels,
+		)
+
+		// Get a specific notification channel by ID
+		notifications.GET("/channels/:id",
+			notificationHandler.GetChannelByID,
+		)
+
+		// Update a notification channel
+		notifications.PUT("/channels/:id",
+			notificationHandler.UpdateChannel,
+		)
+
+		// Delete a notification channel
+		notifications.DELETE("/channels/:id",
+			notificationHandler.DeleteChannel,
+		)
+
+		// Send a test notification to a channel
+		notifications.POST("/channels/:id/test",
+			notificationHandler.TestChannel,
+		)
+
+		// List notification logs
+		notifications.GET("/logs",
+			notificationHandler.ListLogs,
+		)
+	}
+}
@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now"
+)
+
+// RegisterNowRoutes sets up routes for the /now page and its management
+func RegisterNowRoutes(
+	r *gin.RouterGroup,
+	nowHandler *now.NowHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Get the current now entry
+	r.GET("/now",
+		nowHandler.GetCurrent,
+	)
+
+	// Get the now entry history
+	r.GET("/now/history",
+		nowHandler.ListEntries,
+	)
+
+	// Create a route group for managing now entries
+	entries := r.Group("/admin/now", routerMiddleware.VerifyJWT())
+	{
+		// Publish a new current entry
+		entries.POST("",
+			nowHandler.Publish,
+		)
+
+		// Update a now entry
+		entries.PUT("/:id",
+			nowHandler.UpdateEntry,
+		)
+
+		// Delete a now entry
+		entries.DELETE("/:id",
+			nowHandler.DeleteEntry,
+		)
+	}
+}
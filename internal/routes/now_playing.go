@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/now_playing"
+)
+
+// RegisterNowPlayingRoutes sets up routes for the listening widget
+func RegisterNowPlayingRoutes(
+	r *gin.RouterGroup,
+	nowPlayingHandler *now_playing.NowPlayingHandler,
+) {
+	// Get the track currently or last played
+	r.GET("/now-playing",
+		nowPlayingHandler.GetNowPlaying,
+	)
+}
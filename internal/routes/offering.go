@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/offering"
+)
+
+// RegisterOfferingRoutes sets up routes for service offerings and their pricing
+func RegisterOfferingRoutes(
+	r *gin.RouterGroup,
+	offeringHandler *offering.OfferingHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for offerings
+	offerings := r.Group("/offerings")
+	{
+		// Create a new offering
+		offerings.POST("",
+			routerMiddleware.VerifyJWT(),
+			offeringHandler.CreateOffering,
+		)
+
+		// List active offerings
+		offerings.GET("",
+			offeringHandler.ListOfferings,
+		)
+
+		// Get a specific offering by ID
+		offerings.GET("/:id",
+			offeringHandler.GetOfferingByID,
+		)
+
+		// Update an offering
+		offerings.PUT("/:id",
+			routerMiddleware.VerifyJWT(),
+			offeringHandler.UpdateOffering,
+		)
+
+		// Delete an offering
+		offerings.DELETE("/:id",
+			routerMiddleware.VerifyJWT(),
+			offeringHandler.DeleteOffering,
+		)
+	}
+
+	// Get the exchange rates used for conversion
+	r.GET("/exchange-rates",
+		offeringHandler.ListExchangeRates,
+	)
+
+	// Create a route group for managing offerings
+	admin := r.Group("/admin", routerMiddleware.VerifyJWT())
+	{
+		// List every offering including inactive ones
+		admin.GET("/offerings",
+			offeringHandler.ListAllOfferings,
+		)
+
+		// Refresh exchange rates now
+		admin.POST("/exchange-rates/refresh",
+			offeringHandler.RefreshExchangeRates,
+		)
+	}
+}
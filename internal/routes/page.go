@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+)
+
+// RegisterPageRoutes sets up routes for standalone pages such as the
+// privacy policy and their management
+func RegisterPageRoutes(
+	r *gin.RouterGroup,
+	pageHandler *page.PageHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// List published pages
+	r.GET("/pages",
+		pageHandler.ListPublishedPages,
+	)
+
+	// Get a published page by slug
+	r.GET("/pages/:slug",
+		pageHandler.GetPublishedPage,
+	)
+
+	// Get a page, even a draft, with a preview link
+	r.GET("/page-previews",
+		pageHandler.GetPreviewPage,
+	)
+
+	// Create a route group for managing pages
+	pages := r.Group("/admin/pages", routerMiddleware.VerifyJWT())
+	{
+		// List pages including drafts
+		pages.GET("",
+			pageHandler.ListPages,
+		)
+
+		// Create a page
+		pages.POST("",
+			pageHandler.CreatePage,
+		)
+
+		// Get a page by ID
+		pages.GET("/:id",
+			pageHandler.GetPage,
+		)
+
+		// Update a page
+		pages.PUT("/:id",
+			pageHandler.UpdatePage,
+		)
+
+		// Delete a page
+		pages.DELETE("/:id",
+			pageHandler.DeletePage,
+		)
+
+		// List the revisions of a page
+		pages.GET("/:id/revisions",
+			pageHandler.ListRevisions,
+		)
+
+		// Restore an earlier revision of a page
+		pages.POST("/:id/revisions/:revision/restore",
+			pageHandler.RestoreRevision,
+		)
+
+		// Share a page for review with a preview link
+		pages.POST("/:id/previews",
+			pageHandler.CreatePreview,
+		)
+
+		// List the preview links of a page
+		pages.GET("/:id/previews",
+			pageHandler.ListPreviews,
+		)
+
+		// Revoke a preview link
+		pages.DELETE("/:id/previews/:preview",
+			pageHandler.RevokePreview,
+		)
+	}
+}
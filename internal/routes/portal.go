@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/portal"
+)
+
+// RegisterPortalRoutes sets up the client portal routes. Portal sessions are
+// issued through magic links and are separate from admin authentication.
+func RegisterPortalRoutes(
+	r *gin.RouterGroup,
+	portalHandler *portal.PortalHandler,
+	rateLimiter *middleware.RateLimiter,
+) {
+	// Create a route group for the client portal
+	portalRoutes := r.Group("/portal")
+	{
+		// Request a magic sign-in link
+		portalRoutes.POST("/login",
+			rateLimiter.Limit(),
+			portalHandler.RequestLogin,
+		)
+
+		// Exchange a magic link for a session
+		portalRoutes.POST("/session",
+			rateLimiter.Limit(),
+			portalHandler.CreateSession,
+		)
+
+		// Routes below require a portal session
+		authenticated := portalRoutes.Group("", portalHandler.RequireSession())
+		{
+			// Sign out
+			authenticated.DELETE("/session",
+				portalHandler.EndSession,
+			)
+
+			// List the client's inquiries
+			authenticated.GET("/inquiries",
+				portalHandler.ListInquiries,
+			)
+
+			// Get an inquiry with its proposals and payments
+			authenticated.GET("/inquiries/:id",
+				portalHandler.GetInquiry,
+			)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/profile_stats"
+)
+
+// RegisterProfileStatRoutes sets up routes for external profile stats
+func RegisterProfileStatRoutes(
+	r *gin.RouterGroup,
+	profileStatHandler *profile_stats.ProfileStatHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Get the stats of every external profile
+	r.GET("/stats/profiles",
+		profileStatHandler.GetProfileStats,
+	)
+
+	// Read every external profile now
+	r.POST("/admin/stats/profiles/refresh",
+		routerMiddleware.VerifyJWT(),
+		profileStatHandler.Refresh,
+	)
+}
@@ -11,6 +11,8 @@ func RegisterProjectRoutes(
 	r *gin.RouterGroup,
 	projectHandler *project.ProjectHandler,
 	routerMiddleware *middleware.Middleware,
+	shareSigner *project.ShareSigner,
+	renderLimiter *middleware.ConcurrencyLimiter,
 ) {
 	// Create a route group for projects
 	projects := r.Group("/projects")
@@ -26,6 +28,11 @@ func RegisterProjectRoutes(
 			projectHandler.ListProjects,
 		)
 
+		// Summarize metrics across featured projects
+		projects.GET("/impact",
+			projectHandler.GetImpactSummary,
+		)
+
 		// Get a specific project by ID
 		projects.GET("/:id",
 			projectHandler.GetProjectByID,
@@ -43,6 +50,30 @@ func RegisterProjectRoutes(
 			projectHandler.DeleteProject,
 		)
 
+		// Get the timeline of a project for a Gantt chart
+		projects.GET("/:id/timeline",
+			projectHandler.GetProjectTimeline,
+		)
+
+		// Sign a private share link to a project
+		projects.POST("/:id/share",
+			routerMiddleware.VerifyJWT(),
+			projectHandler.CreateShareLink,
+		)
+
+		// Get a project with a share link, including unlisted and drafts
+		projects.GET("/:id/shared",
+			shareSigner.Middleware(),
+			projectHandler.GetSharedProject,
+		)
+
+		// Refresh the project thumbnail from its live site
+		projects.POST("/:id/screenshot",
+			routerMiddleware.VerifyJWT(),
+			renderLimiter.Limit(),
+			projectHandler.CaptureScreenshot,
+		)
+
 		// Search projects
 		projects.GET("/search",
 			projectHandler.SearchProjects,
@@ -66,4 +97,23 @@ func RegisterProjectRoutes(
 			projectHandler.BulkDeleteProjects,
 		)
 	}
+
+	// Create a route group for managing projects, including unlisted and drafts
+	adminProjects := r.Group("/admin/projects", routerMiddleware.VerifyJWT())
+	{
+		// List all projects
+		adminProjects.GET("",
+			projectHandler.ListProjects,
+		)
+
+		// Get any project by ID
+		adminProjects.GET("/:id",
+			projectHandler.GetProjectByID,
+		)
+
+		// Get the timeline of any project
+		adminProjects.GET("/:id/timeline",
+			projectHandler.GetProjectTimeline,
+		)
+	}
 }
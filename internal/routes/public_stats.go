@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/public_stats"
+)
+
+// RegisterPublicStatsRoutes sets up routes for the homepage counters
+func RegisterPublicStatsRoutes(
+	r *gin.RouterGroup,
+	publicStatsHandler *public_stats.PublicStatsHandler,
+) {
+	// Get the curated numbers of the homepage counters
+	r.GET("/stats/public",
+		publicStatsHandler.GetPublicStats,
+	)
+}
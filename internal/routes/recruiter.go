@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/recruiter"
+)
+
+// RegisterRecruiterRoutes sets up routes for role tailored profiles
+func RegisterRecruiterRoutes(
+	r *gin.RouterGroup,
+	recruiterHandler *recruiter.RecruiterHandler,
+	rateLimiter *middleware.RateLimiter,
+	aiLimiter *middleware.ConcurrencyLimiter,
+) {
+	// Get the portfolio tailored to a role
+	r.GET("/profile/recruiter",
+		recruiterHandler.GetProfile,
+	)
+
+	// Compare a job description with the portfolio
+	r.POST("/ai/match-jd",
+		rateLimiter.Limit(),
+		aiLimiter.Limit(),
+		recruiterHandler.MatchJobDescription,
+	)
+}
@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/redirect"
+)
+
+// RegisterRedirectRoutes sets up routes for resolving and managing
+// redirects of old frontend URLs
+func RegisterRedirectRoutes(
+	r *gin.RouterGroup,
+	redirectHandler *redirect.RedirectHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Resolve where a path redirects to
+	r.GET("/redirects/resolve",
+		redirectHandler.Resolve,
+	)
+
+	// Create a route group for managing redirects
+	redirects := r.Group("/admin/redirects", routerMiddleware.VerifyJWT())
+	{
+		// List redirects
+		redirects.GET("",
+			redirectHandler.ListRedirects,
+		)
+
+		// Create a redirect
+		redirects.POST("",
+			redirectHandler.CreateRedirect,
+		)
+
+		// Get a redirect
+		redirects.GET("/:id",
+			redirectHandler.GetRedirect,
+		)
+
+		// Update a redirect
+		redirects.PUT("/:id",
+			redirectHandler.UpdateRedirect,
+		)
+
+		// Delete a redirect
+		redirects.DELETE("/:id",
+			redirectHandler.DeleteRedirect,
+		)
+	}
+}
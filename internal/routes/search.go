@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/search"
+)
+
+// RegisterSearchRoutes sets up routes for semantic search
+func RegisterSearchRoutes(
+	r *gin.RouterGroup,
+	searchHandler *search.SearchHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Search content by meaning
+	r.GET("/search/semantic",
+		searchHandler.SemanticSearch,
+	)
+
+	// Find projects related to a project
+	r.GET("/projects/:id/related",
+		searchHandler.RelatedProjects,
+	)
+
+	// Re-embed changed content now
+	r.POST("/admin/search/reindex",
+		routerMiddleware.VerifyJWT(),
+		searchHandler.Reindex,
+	)
+}
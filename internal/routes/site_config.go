@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+)
+
+// RegisterSiteConfigRoutes sets up routes for site config operations
+func RegisterSiteConfigRoutes(
+	r *gin.RouterGroup,
+	siteConfigHandler *site_config.SiteConfigHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the site config
+	siteConfig := r.Group("/site-config")
+	{
+		// Get the site config
+		siteConfig.GET("",
+			siteConfigHandler.GetSiteConfig,
+		)
+
+		// Replace the site config
+		siteConfig.PUT("",
+			routerMiddleware.VerifyJWT(),
+			siteConfigHandler.UpdateSiteConfig,
+		)
+
+		// Reset the site config to its defaults
+		siteConfig.DELETE("",
+			routerMiddleware.VerifyJWT(),
+			siteConfigHandler.ResetSiteConfig,
+		)
+
+		// Generate the favicon and app icons from the profile avatar
+		siteConfig.POST("/icons",
+			routerMiddleware.VerifyJWT(),
+			siteConfigHandler.GenerateIcons,
+		)
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/sitemap"
+)
+
+// RegisterSitemapRoutes sets up the route serving the sitemap of the site
+func RegisterSitemapRoutes(
+	r *gin.RouterGroup,
+	sitemapHandler *sitemap.SitemapHandler,
+) {
+	// Get the sitemap
+	r.GET("/sitemap.xml",
+		sitemapHandler.GetSitemap,
+	)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/storage_usage"
+)
+
+// RegisterStorageUsageRoutes sets up admin routes for storage usage
+func RegisterStorageUsageRoutes(
+	r *gin.RouterGroup,
+	storageUsageHandler *storage_usage.StorageUsageHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for storage usage
+	adminStorage := r.Group("/admin/storage", routerMiddleware.VerifyJWT())
+	{
+		// Report the bytes stored per module against the quota
+		adminStorage.GET("/usage",
+			storageUsageHandler.GetUsage,
+		)
+	}
+}
@@ -26,17 +26,34 @@ func RegisterTechStackRoutes(
 			techStackHandler.ListTechStacks,
 		)
 
+		// Get skill proficiency over time
+		techStacks.GET("/history",
+			techStackHandler.GetProficiencyHistory,
+		)
+
 		// Get a specific tech stack by ID
 		techStacks.GET("/:id",
 			techStackHandler.GetTechStackByID,
 		)
 
+		// List the content linking to a tech stack
+		techStacks.GET("/:id/references",
+			routerMiddleware.VerifyJWT(),
+			techStackHandler.GetTechStackReferences,
+		)
+
 		// Update a tech stack
 		techStacks.PUT("/:id",
 			routerMiddleware.VerifyJWT(),
 			techStackHandler.UpdateTechStack,
 		)
 
+		// Remove links to deleted tech stacks
+		techStacks.DELETE("/orphans",
+			routerMiddleware.VerifyJWT(),
+			techStackHandler.CleanupOrphanReferences,
+		)
+
 		// Delete a tech stack
 		techStacks.DELETE("/:id",
 			routerMiddleware.VerifyJWT(),
@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+)
+
+// RegisterTenantRoutes sets up routes for tenant operations
+func RegisterTenantRoutes(
+	r *gin.RouterGroup,
+	tenantHandler *tenant.TenantHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for tenants
+	tenants := r.Group("/tenants")
+	{
+		// Get the tenant resolved for the request
+		tenants.GET("/current",
+			tenantHandler.GetCurrentTenant,
+		)
+
+		// Create a new tenant
+		tenants.POST("",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.CreateTenant,
+		)
+
+		// List tenants
+		tenants.GET("",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.ListTenants,
+		)
+
+		// Get a specific tenant by ID
+		tenants.GET("/:id",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.GetTenantByID,
+		)
+
+		// Update a tenant
+		tenants.PUT("/:id",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.UpdateTenant,
+		)
+
+		// Delete a tenant
+		tenants.DELETE("/:id",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.DeleteTenant,
+		)
+
+		// List custom domains of a tenant
+		tenants.GET("/:id/domains",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.ListDomains,
+		)
+
+		// Register a custom domain for a tenant
+		tenants.POST("/:id/domains",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.AddDomain,
+		)
+
+		// Verify a custom domain via its DNS TXT challenge
+		tenants.POST("/:id/domains/:domainId/verify",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.VerifyDomain,
+		)
+
+		// Remove a custom domain from a tenant
+		tenants.DELETE("/:id/domains/:domainId",
+			routerMiddleware.VerifyJWT(),
+			tenantHandler.RemoveDomain,
+		)
+	}
+}
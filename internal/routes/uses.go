@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/uses"
+)
+
+// RegisterUsesRoutes sets up routes for the /uses page and its items
+func RegisterUsesRoutes(
+	r *gin.RouterGroup,
+	usesHandler *uses.UsesHandler,
+	routerMiddleware *middleware.Middleware,
+) {
+	// Create a route group for the uses page
+	usesGroup := r.Group("/uses")
+	{
+		// Get the uses page grouped by category
+		usesGroup.GET("",
+			usesHandler.GetUsesPage,
+		)
+
+		// Reorder the uses page
+		usesGroup.PUT("/order",
+			routerMiddleware.VerifyJWT(),
+			usesHandler.ReorderItems,
+		)
+
+		// Create a new item
+		usesGroup.POST("/items",
+			routerMiddleware.VerifyJWT(),
+			usesHandler.CreateItem,
+		)
+
+		// List items
+		usesGroup.GET("/items",
+			usesHandler.ListItems,
+		)
+
+		// Get a specific item by ID
+		usesGroup.GET("/items/:id",
+			usesHandler.GetItemByID,
+		)
+
+		// Update an item
+		usesGroup.PUT("/items/:id",
+			routerMiddleware.VerifyJWT(),
+			usesHandler.UpdateItem,
+		)
+
+		// Delete an item
+		usesGroup.DELETE("/items/:id",
+			routerMiddleware.VerifyJWT(),
+			usesHandler.DeleteItem,
+		)
+	}
+}
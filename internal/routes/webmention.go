@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/middleware"
+	"github.com/holycann/itsrama-portfolio-backend/internal/webmention"
+)
+
+// RegisterWebmentionRoutes sets up routes for receiving, displaying and
+// sending Webmentions
+func RegisterWebmentionRoutes(
+	r *gin.RouterGroup,
+	webmentionHandler *webmention.WebmentionHandler,
+	routerMiddleware *middleware.Middleware,
+	rateLimiter *middleware.RateLimiter,
+	challengeGuard *middleware.ChallengeGuard,
+) {
+	// Receive a Webmention
+	r.POST("/webmention",
+		rateLimiter.Limit(),
+		challengeGuard.RequireChallenge(),
+		webmentionHandler.Receive,
+	)
+
+	// List the verified Webmentions of a project
+	r.GET("/projects/:id/webmentions",
+		webmentionHandler.ListProjectMentions,
+	)
+
+	// Create a route group for managing Webmentions
+	adminRoutes := r.Group("/admin/webmentions", routerMiddleware.VerifyJWT())
+	{
+		// List received Webmentions
+		adminRoutes.GET("",
+			webmentionHandler.ListMentions,
+		)
+
+		// Send Webmentions for project links now
+		adminRoutes.POST("/send",
+			webmentionHandler.SendMentions,
+		)
+
+		// Delete a received Webmention
+		adminRoutes.DELETE("/:id",
+			webmentionHandler.DeleteMention,
+		)
+	}
+}
@@ -0,0 +1,141 @@
+package search
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Bounds of the number of search results
+const (
+	defaultLimit = 10
+	maxLimit     = 50
+
+	defaultRelatedLimit = 3
+)
+
+type SearchHandler struct {
+	base.BaseHandler
+	searchService SearchService
+}
+
+func NewSearchHandler(searchService SearchService, logger *logger.Logger) *SearchHandler {
+	return &SearchHandler{
+		BaseHandler:   *base.NewBaseHandler(logger),
+		searchService: searchService,
+	}
+}
+
+// SemanticSearch finds content by meaning
+// @Summary Semantic search
+// @Description Search public projects and experiences by meaning rather than exact words, e.g. "payment integrations" also finds a project built on Stripe. Results are ordered by similarity.
+// @Tags Search
+// @Produce json
+// @Param q query string true "Search query"
+// @Param type query string false "Only return this kind of content" Enums(project, experience)
+// @Param limit query int false "Number of results" default(10) maximum(50)
+// @Success 200 {object} response.APIResponse{data=[]Result} "Search completed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 500 {object} response.APIResponse "Semantic search is not configured or unreachable"
+// @Router /search/semantic [get]
+func (h *SearchHandler) SemanticSearch(c *gin.Context) {
+	limit := defaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxLimit {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Limit must be between 1 and 50",
+				err,
+			))
+			return
+		}
+		limit = parsed
+	}
+
+	results, err := h.searchService.Search(c.Request.Context(), &SearchQuery{
+		Query: c.Query("q"),
+		Type:  SourceType(c.Query("type")),
+		Limit: limit,
+	})
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, results, "Search completed successfully")
+}
+
+// Reindex re-embeds the searchable content
+// @Summary Reindex semantic search
+// @Description Embed the projects and experiences changed since they were last embedded and remove deleted or hidden ones. Content is also reindexed automatically after changes.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param force query bool false "Re-embed all content, e.g. after changing the embedding model"
+// @Success 200 {object} response.APIResponse{data=IndexReport} "Content reindexed successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/search/reindex [post]
+func (h *SearchHandler) Reindex(c *gin.Context) {
+	force, _ := strconv.ParseBool(c.Query("force"))
+
+	report, err := h.searchService.Reindex(c.Request.Context(), force)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Content reindexed successfully")
+}
+
+// RelatedProjects finds projects related to a project
+// @Summary Related projects
+// @Description Retrieve public projects related to a project, ranked by the tech stacks and category they share and by how close they are in meaning, with newer projects ranked higher. Results are cached until projects change.
+// @Tags Search
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param limit query int false "Number of results" default(3) maximum(10)
+// @Success 200 {object} response.APIResponse{data=[]Result} "Related projects retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /projects/{id}/related [get]
+func (h *SearchHandler) RelatedProjects(c *gin.Context) {
+	limit := defaultRelatedLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > MaxRelated {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Limit must be between 1 and 10",
+				err,
+			))
+			return
+		}
+		limit = parsed
+	}
+
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid project ID",
+			err,
+			errors.WithContext("project_id", id),
+		))
+		return
+	}
+
+	results, err := h.searchService.RelatedProjects(c.Request.Context(), id, limit)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, results, "Related projects retrieved successfully")
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job keeps the embeddings of every tenant up to date. It reindexes shortly
+// after projects or experiences change and on a fixed interval, which also
+// catches changes whose events were dropped.
+type Job struct {
+	searchService SearchService
+	tenantService tenant.TenantService
+	bus           *events.Bus
+	interval      time.Duration
+	delay         time.Duration
+	logger        *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a reindex job running every interval and delay after
+// content change events published on bus
+func NewJob(searchService SearchService, tenantService tenant.TenantService, bus *events.Bus, interval, delay time.Duration, logger *logger.Logger) *Job {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	if delay <= 0 {
+		delay = 30 * time.Second
+	}
+
+	return &Job{
+		searchService: searchService,
+		tenantService: tenantService,
+		bus:           bus,
+		interval:      interval,
+		delay:         delay,
+		logger:        logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. The first
+// run happens right away so that content created before semantic search was
+// enabled becomes searchable.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	// Without a subscription the job still reindexes on the interval
+	var changes <-chan events.Event
+	sub, err := j.bus.SubscribeInternal()
+	if err != nil {
+		j.logger.Warn("Failed to subscribe to content changes for reindexing", "error", err)
+	} else {
+		changes = sub.Events()
+	}
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		if sub != nil {
+			defer sub.Close()
+		}
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		// pending fires once content changes have settled for delay
+		pending := time.NewTimer(0)
+		defer pending.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				if isContentChange(event) {
+					pending.Reset(j.delay)
+				}
+			case <-pending.C:
+				j.run(ctx)
+			case <-ticker.C:
+				j.run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current run to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// run reindexes each tenant in turn
+func (j *Job) run(ctx context.Context) {
+	for page := 1; ; page++ {
+		tenants, err := j.tenantService.ListTenants(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			if ctx.Err() == nil {
+				j.logger.Error("Failed to list tenants for reindexing", "error", err)
+			}
+			return
+		}
+
+		for _, t := range tenants {
+			report, err := j.searchService.Reindex(base.WithTenant(ctx, t.Scope()), false)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				j.logger.Error("Failed to reindex content", "tenant", t.Slug, "error", err)
+				continue
+			}
+
+			if report.Embedded > 0 || report.Removed > 0 {
+				j.logger.Info("Content reindexed", "tenant", t.Slug, "embedded", report.Embedded, "removed", report.Removed)
+			}
+		}
+
+		if len(tenants) < pageSize {
+			return
+		}
+	}
+}
+
+// isContentChange reports whether an event changes searchable content
+func isContentChange(event events.Event) bool {
+	return strings.HasPrefix(string(event.Type), "project.") ||
+		strings.HasPrefix(string(event.Type), "experience.")
+}
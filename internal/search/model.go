@@ -0,0 +1,97 @@
+package search
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SourceType identifies the kind of content an embedding was made from
+type SourceType string
+
+const (
+	SourceProject    SourceType = "project"
+	SourceExperience SourceType = "experience"
+)
+
+// sourceTypes are the source types accepted as a search filter
+var sourceTypes = map[SourceType]bool{
+	SourceProject:    true,
+	SourceExperience: true,
+}
+
+// Embedding is the stored vector of a project or experience
+type Embedding struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	TenantID   *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	SourceType SourceType `json:"source_type" db:"source_type"`
+	SourceID   uuid.UUID  `json:"source_id" db:"source_id"`
+	Title      string     `json:"title" db:"title"`
+	Snippet    string     `json:"snippet" db:"snippet"`
+
+	// ContentHash and Model tell whether the embedding is still current
+	ContentHash string `json:"content_hash" db:"content_hash"`
+	Model       string `json:"model" db:"model"`
+
+	// Embedding is only set when writing
+	Embedding []float32 `json:"embedding,omitempty" db:"embedding"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// SearchQuery is the input for a semantic search
+type SearchQuery struct {
+	Query string     `validate:"required,max=500"`
+	Type  SourceType `validate:"max=20"`
+	Limit int        `validate:"min=1,max=50"`
+}
+
+// Result is a project or experience matching a semantic search
+// @Description Content matching a semantic search, most similar first
+// @Name SemanticSearchResult
+type Result struct {
+	Type    SourceType `json:"type" example:"project"`
+	ID      uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title   string     `json:"title" example:"Portfolio Website"`
+	Snippet string     `json:"snippet" example:"A responsive website to display my professional projects and skills"`
+
+	// Score is the cosine similarity between the query and the content
+	Score float64 `json:"score" example:"0.82"`
+}
+
+// Passage is a search result with the full text it was embedded from, used
+// to ground answers about the portfolio
+type Passage struct {
+	Result
+	Text string `json:"text"`
+}
+
+// IndexReport summarizes a reindex run
+// @Description Result of re-embedding the searchable content
+// @Name SemanticIndexReport
+type IndexReport struct {
+	Indexed   int       `json:"indexed" example:"24"`
+	Embedded  int       `json:"embedded" example:"3"`
+	Removed   int       `json:"removed" example:"1"`
+	Model     string    `json:"model" example:"gemini/text-embedding-004"`
+	IndexedAt time.Time `json:"indexed_at"`
+}
+
+// document is the searchable text of a project or experience
+type document struct {
+	sourceType SourceType
+	sourceID   uuid.UUID
+	title      string
+	snippet    string
+	text       string
+}
+
+// match is a row returned by the match_content_embedding function
+type match struct {
+	SourceType SourceType `json:"source_type"`
+	SourceID   uuid.UUID  `json:"source_id"`
+	Title      string     `json:"title"`
+	Snippet    string     `json:"snippet"`
+	Similarity float64    `json:"similarity"`
+}
@@ -0,0 +1,268 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// MaxRelated is the largest number of related projects returned
+	MaxRelated = 10
+
+	// relatedCandidates is the number of nearest embeddings considered
+	relatedCandidates = 50
+
+	// tagWeight is the share of tag overlap in the relevance of a related
+	// project, the rest being the similarity of their embeddings
+	tagWeight = 0.5
+
+	// relatedHalfLife is the age at which the score of a project is halved
+	relatedHalfLife = 2 * 365 * 24 * time.Hour
+
+	// relatedMaxAge bounds how long related projects are cached, so that
+	// embeddings updated by a later reindex are picked up
+	relatedMaxAge = time.Hour
+)
+
+// relatedEvents are the domain events that change related projects
+var relatedEvents = map[events.EventType]bool{
+	events.ProjectCreated: true,
+	events.ProjectUpdated: true,
+	events.ProjectDeleted: true,
+}
+
+type relatedEntry struct {
+	results     []Result
+	generatedAt time.Time
+}
+
+// RelatedCache keeps the related projects of every tenant until their
+// projects change
+type RelatedCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]relatedEntry
+	// versions count the invalidations of every tenant, so results ranked
+	// while projects changed are not stored
+	versions map[string]int
+}
+
+func NewRelatedCache() *RelatedCache {
+	return &RelatedCache{
+		entries:  make(map[string]map[string]relatedEntry),
+		versions: make(map[string]int),
+	}
+}
+
+// Get returns the related projects of the project id of the tenant in ctx,
+// or false when they are missing or expired, along with the version to
+// store new results under
+func (c *RelatedCache) Get(ctx context.Context, id string) ([]Result, bool, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	entry, ok := c.entries[key][id]
+	if !ok || time.Since(entry.generatedAt) >= relatedMaxAge {
+		return nil, false, c.versions[key]
+	}
+	return entry.results, true, c.versions[key]
+}
+
+// Set stores the related projects of the project id unless the projects of
+// the tenant in ctx changed since version was read
+func (c *RelatedCache) Set(ctx context.Context, id string, results []Result, version int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	if c.versions[key] != version {
+		return
+	}
+	if c.entries[key] == nil {
+		c.entries[key] = make(map[string]relatedEntry)
+	}
+	c.entries[key][id] = relatedEntry{results: results, generatedAt: time.Now()}
+}
+
+// Invalidate drops the related projects of the tenant in ctx
+func (c *RelatedCache) Invalidate(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := tenantCacheKey(ctx)
+	delete(c.entries, key)
+	c.versions[key]++
+}
+
+func tenantCacheKey(ctx context.Context) string {
+	if tenantID := base.TenantIDFromContext(ctx); tenantID != nil {
+		return tenantID.String()
+	}
+	return "default"
+}
+
+// RelatedPublisher forwards domain events to the next publisher and drops
+// the related projects of the tenant whose projects changed
+type RelatedPublisher struct {
+	next  events.Publisher
+	cache *RelatedCache
+}
+
+func NewRelatedPublisher(next events.Publisher, cache *RelatedCache) *RelatedPublisher {
+	return &RelatedPublisher{
+		next:  next,
+		cache: cache,
+	}
+}
+
+func (p *RelatedPublisher) Publish(ctx context.Context, event events.Event) {
+	if relatedEvents[event.Type] {
+		p.cache.Invalidate(ctx)
+	}
+
+	p.next.Publish(ctx, event)
+}
+
+func (s *searchService) RelatedProjects(ctx context.Context, id string, limit int) ([]Result, error) {
+	results, ok, version := s.relatedCache.Get(ctx, id)
+	if !ok {
+		var err error
+		if results, err = s.rankRelatedProjects(ctx, id); err != nil {
+			return nil, err
+		}
+		s.relatedCache.Set(ctx, id, results, version)
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// rankRelatedProjects scores every other public project by the tech stacks
+// and category it shares with the project id and by the similarity of their
+// embeddings, decayed by its age, and returns the best ones
+func (s *searchService) rankRelatedProjects(ctx context.Context, id string) ([]Result, error) {
+	source, err := s.projectService.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !source.IsPublic() {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", id),
+		)
+	}
+
+	matches, err := s.embeddingRepo.Related(ctx, SourceProject, id, relatedCandidates)
+	if err != nil {
+		return nil, err
+	}
+	similarities := make(map[string]float64, len(matches))
+	for _, m := range matches {
+		similarities[m.SourceID.String()] = math.Max(m.Similarity, 0)
+	}
+
+	sourceTags := projectTags(*source)
+	now := time.Now()
+
+	var results []Result
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+			Page:    page,
+			PerPage: pageSize,
+			Filters: []base.FilterOption{{
+				Field:    "visibility",
+				Operator: base.OperatorEqual,
+				Value:    project.VisibilityPublic,
+			}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range projects {
+			if p.ID == source.ID {
+				continue
+			}
+
+			relevance := overlap(sourceTags, projectTags(p))
+			if len(matches) > 0 {
+				relevance = tagWeight*relevance + (1-tagWeight)*similarities[p.ID.String()]
+			}
+			if relevance <= 0 {
+				continue
+			}
+
+			doc := projectDocument(p)
+			results = append(results, Result{
+				Type:    SourceProject,
+				ID:      p.ID,
+				Title:   doc.title,
+				Snippet: doc.snippet,
+				Score:   relevance * recency(p, now),
+			})
+		}
+
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > MaxRelated {
+		results = results[:MaxRelated]
+	}
+	return results, nil
+}
+
+// projectTags are the tech stacks and category of a project
+func projectTags(p project.ProjectDTO) map[string]bool {
+	tags := make(map[string]bool, len(p.ProjectTechStack)+1)
+	for _, stack := range p.ProjectTechStack {
+		tags["stack:"+stack.TechStackID.String()] = true
+	}
+	if p.Category != "" {
+		tags["category:"+string(p.Category)] = true
+	}
+	return tags
+}
+
+// overlap is the share of tags of a and b they have in common
+func overlap(a, b map[string]bool) float64 {
+	shared := 0
+	for tag := range a {
+		if b[tag] {
+			shared++
+		}
+	}
+	if union := len(a) + len(b) - shared; union > 0 {
+		return float64(shared) / float64(union)
+	}
+	return 0
+}
+
+// recency halves the score of a project every relatedHalfLife since it was
+// created
+func recency(p project.ProjectDTO, now time.Time) float64 {
+	if p.CreatedAt == nil {
+		return 1
+	}
+	age := now.Sub(*p.CreatedAt)
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, age.Hours()/relatedHalfLife.Hours())
+}
@@ -0,0 +1,141 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type EmbeddingRepository interface {
+	// FindAll returns the embeddings of the tenant in ctx without their vectors
+	FindAll(ctx context.Context) ([]Embedding, error)
+	Upsert(ctx context.Context, embeddings []Embedding) error
+	Delete(ctx context.Context, ids []string) error
+	// Match returns up to limit embeddings closest to vector
+	Match(ctx context.Context, vector []float32, sourceType SourceType, limit int, minSimilarity float64) ([]match, error)
+	// Related returns up to limit embeddings of the same type closest to
+	// the stored embedding of a source, or none when it is not embedded
+	Related(ctx context.Context, sourceType SourceType, sourceID string, limit int) ([]match, error)
+}
+
+type embeddingRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewEmbeddingRepository(supabaseClient *supabase.SupabaseClient) EmbeddingRepository {
+	return &embeddingRepository{
+		supabaseClient: supabaseClient,
+		table:          "content_embedding",
+	}
+}
+
+func (r *embeddingRepository) FindAll(ctx context.Context) ([]Embedding, error) {
+	var embeddings []Embedding
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id,tenant_id,source_type,source_id,title,snippet,content_hash,model,created_at,updated_at", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&embeddings)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list content embeddings")
+	}
+
+	return embeddings, nil
+}
+
+// Upsert stores embeddings of the tenant in ctx, replacing the previous
+// embedding of the same source
+func (r *embeddingRepository) Upsert(ctx context.Context, embeddings []Embedding) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+
+	tenantID := base.TenantIDFromContext(ctx)
+	for i := range embeddings {
+		embeddings[i].TenantID = tenantID
+	}
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(embeddings, "source_type,source_id", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save content embeddings")
+	}
+	return nil
+}
+
+func (r *embeddingRepository) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		In("id", ids)
+
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete content embeddings")
+	}
+	return nil
+}
+
+func (r *embeddingRepository) Match(ctx context.Context, vector []float32, sourceType SourceType, limit int, minSimilarity float64) ([]match, error) {
+	params := map[string]interface{}{
+		"p_tenant_id":      base.TenantIDFromContext(ctx),
+		"p_embedding":      vector,
+		"p_match_count":    limit,
+		"p_min_similarity": minSimilarity,
+	}
+	if sourceType != "" {
+		params["p_source_type"] = string(sourceType)
+	}
+
+	result := r.supabaseClient.GetClient().Rpc("match_content_embedding", "", params)
+	if result == "" {
+		return nil, errors.New(errors.ErrDatabase, "failed to search content embeddings", nil)
+	}
+
+	var matches []match
+	if err := json.Unmarshal([]byte(result), &matches); err != nil {
+		return nil, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to search content embeddings",
+		)
+	}
+
+	return matches, nil
+}
+
+func (r *embeddingRepository) Related(ctx context.Context, sourceType SourceType, sourceID string, limit int) ([]match, error) {
+	params := map[string]interface{}{
+		"p_tenant_id":   base.TenantIDFromContext(ctx),
+		"p_source_type": string(sourceType),
+		"p_source_id":   sourceID,
+		"p_match_count": limit,
+	}
+
+	result := r.supabaseClient.GetClient().Rpc("related_content_embedding", "", params)
+	if result == "" {
+		return nil, errors.New(errors.ErrDatabase, "failed to find related content embeddings", nil)
+	}
+
+	var matches []match
+	if err := json.Unmarshal([]byte(result), &matches); err != nil {
+		return nil, errors.Wrap(
+			fmt.Errorf("unexpected response: %s", result),
+			errors.ErrDatabase,
+			"failed to find related content embeddings",
+		)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,405 @@
+package search
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/experience"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/embedding"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// pageSize is the page size used to read every project and experience
+	pageSize = 100
+
+	// snippetLength is the maximum number of characters of a result snippet
+	snippetLength = 200
+
+	// minSimilarity hides results that are barely related to the query
+	minSimilarity = 0.2
+)
+
+type SearchService interface {
+	// Search returns the public projects and experiences closest in meaning
+	// to the query
+	Search(ctx context.Context, searchQuery *SearchQuery) ([]Result, error)
+	// Retrieve returns up to limit passages most relevant to the query with
+	// their current text, skipping content deleted or hidden since indexing
+	Retrieve(ctx context.Context, query string, limit int) ([]Passage, error)
+	// Reindex embeds the projects and experiences of the tenant in ctx whose
+	// text changed since they were last embedded, or all of them when force
+	// is set, and removes embeddings of deleted or hidden content
+	Reindex(ctx context.Context, force bool) (*IndexReport, error)
+	// RelatedProjects returns up to limit public projects related to the
+	// project id by shared tech stacks and category and by meaning, newer
+	// projects first among equals
+	RelatedProjects(ctx context.Context, id string, limit int) ([]Result, error)
+}
+
+type searchService struct {
+	embeddingRepo     EmbeddingRepository
+	projectService    project.ProjectService
+	experienceService experience.ExperienceService
+	embedder          embedding.Embedder
+	batchSize         int
+	relatedCache      *RelatedCache
+
+	// mu serializes reindex runs started by the job and by admins
+	mu sync.Mutex
+}
+
+func NewSearchService(embeddingRepo EmbeddingRepository, projectService project.ProjectService, experienceService experience.ExperienceService, embedder embedding.Embedder, batchSize int, relatedCache *RelatedCache) SearchService {
+	if batchSize <= 0 {
+		batchSize = 32
+	}
+
+	return &searchService{
+		embeddingRepo:     embeddingRepo,
+		projectService:    projectService,
+		experienceService: experienceService,
+		embedder:          embedder,
+		batchSize:         batchSize,
+		relatedCache:      relatedCache,
+	}
+}
+
+func (s *searchService) Search(ctx context.Context, searchQuery *SearchQuery) ([]Result, error) {
+	searchQuery.Query = strings.TrimSpace(searchQuery.Query)
+
+	// Validate input
+	if err := validator.ValidateModel(searchQuery); err != nil {
+		return nil, err
+	}
+	if searchQuery.Type != "" && !sourceTypes[searchQuery.Type] {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Type must be project or experience",
+			nil,
+			errors.WithContext("type", searchQuery.Type),
+		)
+	}
+
+	vectors, err := s.embed(ctx, []string{searchQuery.Query})
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.embeddingRepo.Match(ctx, vectors[0], searchQuery.Type, searchQuery.Limit, minSimilarity)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(matches))
+	for i, m := range matches {
+		results[i] = Result{
+			Type:    m.SourceType,
+			ID:      m.SourceID,
+			Title:   m.Title,
+			Snippet: m.Snippet,
+			Score:   m.Similarity,
+		}
+	}
+	return results, nil
+}
+
+func (s *searchService) Retrieve(ctx context.Context, query string, limit int) ([]Passage, error) {
+	results, err := s.Search(ctx, &SearchQuery{Query: query, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	passages := make([]Passage, 0, len(results))
+	for _, result := range results {
+		var doc document
+		switch result.Type {
+		case SourceProject:
+			p, err := s.projectService.GetProjectByID(ctx, result.ID.String())
+			if err != nil {
+				if errors.Is(err, errors.ErrDatabase) {
+					return nil, err
+				}
+				continue
+			}
+			if !p.IsPublic() {
+				continue
+			}
+			doc = projectDocument(*p)
+		case SourceExperience:
+			e, err := s.experienceService.GetExperienceByID(ctx, result.ID.String())
+			if err != nil {
+				if errors.Is(err, errors.ErrDatabase) {
+					return nil, err
+				}
+				continue
+			}
+			doc = experienceDocument(*e)
+		default:
+			continue
+		}
+
+		result.Title = doc.title
+		passages = append(passages, Passage{Result: result, Text: doc.text})
+	}
+
+	return passages, nil
+}
+
+func (s *searchService) Reindex(ctx context.Context, force bool) (*IndexReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	documents, err := s.collectDocuments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.embeddingRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storedByKey := make(map[string]Embedding, len(stored))
+	for _, e := range stored {
+		storedByKey[key(e.SourceType, e.SourceID)] = e
+	}
+
+	model := s.embedder.Model()
+	report := &IndexReport{
+		Indexed: len(documents),
+		Model:   model,
+	}
+
+	var pending []Embedding
+	var texts []string
+	current := make(map[string]bool, len(documents))
+	for _, doc := range documents {
+		k := key(doc.sourceType, doc.sourceID)
+		current[k] = true
+
+		hash := hashText(doc.text)
+		existing, ok := storedByKey[k]
+		if ok && !force && existing.ContentHash == hash && existing.Model == model {
+			continue
+		}
+
+		id := uuid.New()
+		if ok {
+			id = existing.ID
+		}
+		pending = append(pending, Embedding{
+			ID:          id,
+			SourceType:  doc.sourceType,
+			SourceID:    doc.sourceID,
+			Title:       doc.title,
+			Snippet:     doc.snippet,
+			ContentHash: hash,
+			Model:       model,
+		})
+		texts = append(texts, doc.text)
+	}
+
+	for start := 0; start < len(pending); start += s.batchSize {
+		end := min(start+s.batchSize, len(pending))
+
+		vectors, err := s.embed(ctx, texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		batch := pending[start:end]
+		for i := range batch {
+			batch[i].Embedding = vectors[i]
+		}
+		if err := s.embeddingRepo.Upsert(ctx, batch); err != nil {
+			return nil, err
+		}
+		report.Embedded += len(batch)
+	}
+
+	var stale []string
+	for k, e := range storedByKey {
+		if !current[k] {
+			stale = append(stale, e.ID.String())
+		}
+	}
+	if err := s.embeddingRepo.Delete(ctx, stale); err != nil {
+		return nil, err
+	}
+	report.Removed = len(stale)
+
+	// Related projects are ranked with the embeddings
+	if report.Embedded > 0 || report.Removed > 0 {
+		s.relatedCache.Invalidate(ctx)
+	}
+
+	report.IndexedAt = time.Now().UTC()
+	return report, nil
+}
+
+// embed wraps embedding provider errors in application errors
+func (s *searchService) embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors, err := s.embedder.Embed(ctx, texts)
+	if stderrors.Is(err, embedding.ErrDisabled) {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Semantic search is not configured",
+			err,
+		)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrNetwork, "Failed to embed content")
+	}
+	return vectors, nil
+}
+
+// collectDocuments builds the searchable text of every public project and
+// every experience of the tenant in ctx
+func (s *searchService) collectDocuments(ctx context.Context) ([]document, error) {
+	var documents []document
+
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+			Page:    page,
+			PerPage: pageSize,
+			Filters: []base.FilterOption{{
+				Field:    "visibility",
+				Operator: base.OperatorEqual,
+				Value:    project.VisibilityPublic,
+			}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			documents = append(documents, projectDocument(p))
+		}
+
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		experiences, err := s.experienceService.ListExperiences(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range experiences {
+			documents = append(documents, experienceDocument(e))
+		}
+
+		if len(experiences) < pageSize {
+			break
+		}
+	}
+
+	return documents, nil
+}
+
+func projectDocument(p project.ProjectDTO) document {
+	var stacks []string
+	for _, stack := range p.ProjectTechStack {
+		stacks = append(stacks, stack.TechStack.Name)
+	}
+
+	return document{
+		sourceType: SourceProject,
+		sourceID:   p.ID,
+		title:      p.Title,
+		snippet:    snippet(p.Subtitle, p.Description),
+		text: joinLines(
+			p.Title,
+			p.Subtitle,
+			string(p.Category),
+			p.Description,
+			labeled("Role", p.MyRole),
+			labeled("Features", p.Features),
+			labeled("Tech stack", stacks),
+		),
+	}
+}
+
+func experienceDocument(e experience.ExperienceDTO) document {
+	var stacks []string
+	for _, stack := range e.ExperienceTechStack {
+		stacks = append(stacks, stack.TechStack.Name)
+	}
+
+	title := e.Role
+	if e.Company != "" {
+		title += " at " + e.Company
+	}
+
+	return document{
+		sourceType: SourceExperience,
+		sourceID:   e.ID,
+		title:      title,
+		snippet:    snippet(e.WorkDescription),
+		text: joinLines(
+			title,
+			e.JobType,
+			e.Location,
+			e.WorkDescription,
+			labeled("Impact", e.Impact),
+			labeled("Tech stack", stacks),
+		),
+	}
+}
+
+// labeled renders a list as a single "Label: a, b" line
+func labeled(label string, items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	return label + ": " + strings.Join(items, ", ")
+}
+
+func joinLines(lines ...string) string {
+	nonEmpty := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// snippet returns the first non-empty text, shortened to snippetLength
+// characters at a word boundary
+func snippet(texts ...string) string {
+	for _, text := range texts {
+		text = strings.Join(strings.Fields(text), " ")
+		if text == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) <= snippetLength {
+			return text
+		}
+
+		cut := string([]rune(text)[:snippetLength])
+		if i := strings.LastIndex(cut, " "); i > 0 {
+			cut = cut[:i]
+		}
+		return cut + "…"
+	}
+	return ""
+}
+
+func hashText(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+func key(sourceType SourceType, sourceID uuid.UUID) string {
+	return string(sourceType) + ":" + sourceID.String()
+}
@@ -0,0 +1,100 @@
+// Package shutdown stops the components of the server in a fixed order,
+// giving each its own timeout and logging the progress
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Component is a part of the server that is stopped on shutdown
+type Component struct {
+	Name    string
+	Timeout time.Duration
+	Stop    func(ctx context.Context) error
+}
+
+// Sequence stops components in the order they were added
+type Sequence struct {
+	components     []Component
+	defaultTimeout time.Duration
+	logger         *logger.Logger
+}
+
+// NewSequence creates an empty sequence. Components added without a
+// timeout get defaultTimeout.
+func NewSequence(defaultTimeout time.Duration, logger *logger.Logger) *Sequence {
+	return &Sequence{
+		defaultTimeout: defaultTimeout,
+		logger:         logger,
+	}
+}
+
+// Add appends a component stopped with a context
+func (s *Sequence) Add(name string, timeout time.Duration, stop func(ctx context.Context) error) {
+	if timeout <= 0 {
+		timeout = s.defaultTimeout
+	}
+	s.components = append(s.components, Component{Name: name, Timeout: timeout, Stop: stop})
+}
+
+// AddFunc appends a component whose stop function blocks until it is done
+// and takes no context, such as a background job waiting for its current
+// run. It is abandoned when it outlasts its timeout.
+func (s *Sequence) AddFunc(name string, timeout time.Duration, stop func()) {
+	s.Add(name, timeout, func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			stop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// Stop stops every component in turn. A component that fails or times out
+// is logged and the sequence moves on; once ctx is done, the remaining
+// components are skipped.
+func (s *Sequence) Stop(ctx context.Context) error {
+	var failures []error
+	total := len(s.components)
+
+	for i, component := range s.components {
+		if ctx.Err() != nil {
+			s.logger.Warn("Shutdown deadline reached, skipping component",
+				"component", component.Name, "step", fmt.Sprintf("%d/%d", i+1, total))
+			failures = append(failures, fmt.Errorf("%s: skipped: %w", component.Name, ctx.Err()))
+			continue
+		}
+
+		s.logger.Info("Stopping component", "component", component.Name, "step", fmt.Sprintf("%d/%d", i+1, total))
+
+		componentCtx, cancel := context.WithTimeout(ctx, component.Timeout)
+		start := time.Now()
+		err := component.Stop(componentCtx)
+		cancel()
+
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			s.logger.Warn("Component did not stop in time", "component", component.Name, "timeout", component.Timeout.String())
+			failures = append(failures, fmt.Errorf("%s: timed out after %s", component.Name, component.Timeout))
+		case err != nil:
+			s.logger.Error("Component failed to stop", "component", component.Name, "error", err)
+			failures = append(failures, fmt.Errorf("%s: %w", component.Name, err))
+		default:
+			s.logger.Info("Component stopped", "component", component.Name, "duration", time.Since(start).String())
+		}
+	}
+
+	return errors.Join(failures...)
+}
@@ -0,0 +1,116 @@
+package site_config
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type SiteConfigHandler struct {
+	base.BaseHandler
+	siteConfigService SiteConfigService
+}
+
+func NewSiteConfigHandler(siteConfigService SiteConfigService, logger *logger.Logger) *SiteConfigHandler {
+	return &SiteConfigHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		siteConfigService: siteConfigService,
+	}
+}
+
+// GetSiteConfig retrieves the site config
+// @Summary Get the site config
+// @Description Retrieve theme colors, navigation, section toggles and SEO defaults of the current site
+// @Tags Site Config
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=SiteConfig} "Site config retrieved successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /site-config [get]
+func (h *SiteConfigHandler) GetSiteConfig(c *gin.Context) {
+	siteConfig, err := h.siteConfigService.GetSiteConfig(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, siteConfig, "Site config retrieved successfully")
+}
+
+// UpdateSiteConfig replaces the site config
+// @Summary Update the site config
+// @Description Replace theme colors, navigation, section toggles and SEO defaults of the current site
+// @Tags Site Config
+// @Accept json
+// @Produce json
+// @Param siteConfig body SiteConfigUpdate true "Site Config"
+// @Success 200 {object} response.APIResponse{data=SiteConfig} "Site config updated successfully"
+// @Failure 400 {object} response.APIResponse{data=SiteConfigUpdate} "Bad Request"
+// @Router /site-config [put]
+func (h *SiteConfigHandler) UpdateSiteConfig(c *gin.Context) {
+	var siteConfigInput SiteConfigUpdate
+
+	if err := h.BindJSON(c, &siteConfigInput); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	siteConfig, err := h.siteConfigService.UpdateSiteConfig(c.Request.Context(), &siteConfigInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, siteConfig, "Site config updated successfully")
+}
+
+// ResetSiteConfig restores the default site config
+// @Summary Reset the site config
+// @Description Restore the default theme, navigation, section toggles and SEO defaults of the current site
+// @Tags Site Config
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=SiteConfig} "Site config reset successfully"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /site-config [delete]
+func (h *SiteConfigHandler) ResetSiteConfig(c *gin.Context) {
+	siteConfig, err := h.siteConfigService.ResetSiteConfig(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, siteConfig, "Site config reset successfully")
+}
+
+// GenerateIcons generates the site icons from an uploaded avatar
+// @Summary Generate the site icons
+// @Description Upload the profile avatar and generate the favicon, app icons and web app manifest of the current site from it, stored under stable paths
+// @Tags Site Config
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param avatar formData file true "Profile avatar (JPEG, PNG or WebP)"
+// @Success 200 {object} response.APIResponse{data=IconSet} "Site icons generated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /site-config/icons [post]
+func (h *SiteConfigHandler) GenerateIcons(c *gin.Context) {
+	avatar, err := h.HandleFileUpload(c, "avatar", maxAvatarSize, avatarTypes)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	iconSet, err := h.siteConfigService.GenerateIcons(c.Request.Context(), avatar)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, iconSet, "Site icons generated successfully")
+}
@@ -0,0 +1,196 @@
+package site_config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+const (
+	// avatarPath and iconFolder are the stable storage paths the frontend
+	// references, relative to the tenant storage folder
+	avatarPath = "site/avatar"
+	iconFolder = "site/icons"
+
+	faviconName  = "favicon.ico"
+	manifestName = "manifest.json"
+)
+
+// maxAvatarSize and avatarTypes restrict the avatar icons are generated from
+const maxAvatarSize = 10 * 1024 * 1024
+
+var avatarTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// iconFile is an icon generated from the avatar
+type iconFile struct {
+	name string
+	size int
+	// favicon bundles the icon into favicon.ico
+	favicon bool
+	// manifest lists the icon in the web app manifest
+	manifest bool
+}
+
+var iconFiles = []iconFile{
+	{name: "favicon-16x16.png", size: 16, favicon: true},
+	{name: "favicon-32x32.png", size: 32, favicon: true},
+	{name: "favicon-48x48.png", size: 48, favicon: true},
+	{name: "apple-touch-icon.png", size: 180},
+	{name: "android-chrome-192x192.png", size: 192, manifest: true},
+	{name: "android-chrome-512x512.png", size: 512, manifest: true},
+}
+
+// webManifest is the web app manifest of the site
+type webManifest struct {
+	Name            string `json:"name"`
+	ShortName       string `json:"short_name"`
+	Icons           []Icon `json:"icons"`
+	ThemeColor      string `json:"theme_color,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	Display         string `json:"display"`
+	StartURL        string `json:"start_url"`
+}
+
+func (s *siteConfigService) GenerateIcons(ctx context.Context, avatar *multipart.FileHeader) (*IconSet, error) {
+	data, err := storage.ReadUpload(avatar)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrFileUpload,
+			"Failed to read avatar",
+		)
+	}
+
+	sizes := make([]int, len(iconFiles))
+	for i, file := range iconFiles {
+		sizes[i] = file.size
+	}
+	icons, err := imageproc.SquareIcons(data, sizes...)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Avatar is not a supported image",
+			err,
+		)
+	}
+
+	siteConfig, err := s.siteConfigRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if siteConfig == nil {
+		defaults := DefaultSiteConfig()
+		now := time.Now().UTC()
+		defaults.ID = uuid.New()
+		defaults.CreatedAt = &now
+		siteConfig = &defaults
+	}
+
+	sum := sha256.Sum256(data)
+	iconSet := &IconSet{
+		Icons:       []Icon{},
+		Version:     hex.EncodeToString(sum[:])[:16],
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	ext := strings.ToLower(filepath.Ext(avatar.Filename))
+	if iconSet.AvatarURL, err = s.storeIcon(ctx, avatarPath+ext, data, avatar.Header.Get("Content-Type")); err != nil {
+		return nil, err
+	}
+
+	var favicons [][]byte
+	var manifestIcons []Icon
+	for i, file := range iconFiles {
+		url, err := s.storeIcon(ctx, path.Join(iconFolder, file.name), icons[i], "image/png")
+		if err != nil {
+			return nil, err
+		}
+
+		icon := Icon{Src: url, Sizes: fmt.Sprintf("%dx%d", file.size, file.size), Type: "image/png"}
+		iconSet.Icons = append(iconSet.Icons, icon)
+		if file.favicon {
+			favicons = append(favicons, icons[i])
+		}
+		if file.manifest {
+			manifestIcons = append(manifestIcons, icon)
+		}
+	}
+
+	favicon, err := imageproc.EncodeICO(favicons)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrInternal,
+			"Failed to encode favicon",
+			err,
+		)
+	}
+	if iconSet.FaviconURL, err = s.storeIcon(ctx, path.Join(iconFolder, faviconName), favicon, "image/x-icon"); err != nil {
+		return nil, err
+	}
+
+	manifest, err := json.MarshalIndent(webManifest{
+		Name:            siteConfig.SEO.Title,
+		ShortName:       siteConfig.SEO.Title,
+		Icons:           manifestIcons,
+		ThemeColor:      siteConfig.Theme.PrimaryColor,
+		BackgroundColor: siteConfig.Theme.BackgroundColor,
+		Display:         "standalone",
+		StartURL:        "/",
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrInternal,
+			"Failed to encode web app manifest",
+			err,
+		)
+	}
+	if iconSet.ManifestURL, err = s.storeIcon(ctx, path.Join(iconFolder, manifestName), manifest, "application/manifest+json"); err != nil {
+		return nil, err
+	}
+
+	siteConfig.Icons = iconSet
+	siteConfig.UpdatedAt = &iconSet.GeneratedAt
+	if _, err := s.siteConfigRepo.Upsert(ctx, siteConfig); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update site config",
+		)
+	}
+
+	return iconSet, nil
+}
+
+// storeIcon writes a file below the tenant storage folder, replacing the
+// previous one, and returns its public URL
+func (s *siteConfigService) storeIcon(ctx context.Context, name string, data []byte, contentType string) (string, error) {
+	storedPath, err := s.storage.UploadBytes(ctx, data, base.TenantStoragePath(ctx, name), contentType)
+	if err != nil {
+		return "", errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to upload icon",
+			errors.WithContext("path", name),
+		)
+	}
+
+	url, err := s.storage.GetPublicURL(storedPath)
+	if err != nil {
+		return "", errors.Wrap(err,
+			errors.ErrStorage,
+			"Failed to get public URL for icon",
+			errors.WithContext("path", storedPath),
+		)
+	}
+	return url, nil
+}
@@ -0,0 +1,120 @@
+package site_config
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ThemeConfig holds the theme colors and typography of a site
+// @Description Theme colors and typography of a site
+// @Name ThemeConfig
+type ThemeConfig struct {
+	PrimaryColor    string `json:"primary_color" example:"#0f172a"`
+	SecondaryColor  string `json:"secondary_color" example:"#38bdf8"`
+	AccentColor     string `json:"accent_color" example:"#f97316"`
+	BackgroundColor string `json:"background_color" example:"#ffffff"`
+	TextColor       string `json:"text_color" example:"#111827"`
+	FontFamily      string `json:"font_family" example:"Inter"`
+	DefaultMode     string `json:"default_mode" example:"system"`
+}
+
+// NavItem represents an entry of the site navigation
+// @Description Navigation entry, optionally with nested children
+// @Name NavItem
+type NavItem struct {
+	Label    string    `json:"label" validate:"required" example:"Projects"`
+	Href     string    `json:"href" validate:"required" example:"/projects"`
+	External bool      `json:"external" example:"false"`
+	Children []NavItem `json:"children,omitempty"`
+}
+
+// SEODefaults holds the fallback SEO metadata of a site
+// @Description Fallback SEO metadata of a site
+// @Name SEODefaults
+type SEODefaults struct {
+	Title        string   `json:"title" example:"Itsrama Portfolio"`
+	TitleFormat  string   `json:"title_format" example:"%s | Itsrama"`
+	Description  string   `json:"description" example:"Portfolio of Muhamad Ramadhan"`
+	Keywords     []string `json:"keywords" example:"portfolio,developer"`
+	OGImage      string   `json:"og_image" example:"https://example.com/og.png"`
+	TwitterCard  string   `json:"twitter_card" example:"summary_large_image"`
+	CanonicalURL string   `json:"canonical_url" example:"https://itsrama.kawasan.digital"`
+}
+
+// Icon is an icon of the site, described as web app manifests do
+// @Description Icon of the site
+// @Name SiteIcon
+type Icon struct {
+	Src   string `json:"src" example:"https://example.com/site/icons/favicon-32x32.png"`
+	Sizes string `json:"sizes" example:"32x32"`
+	Type  string `json:"type" example:"image/png"`
+}
+
+// IconSet holds the favicon and app icons generated from the profile avatar,
+// stored under stable paths
+// @Description Favicon, app icons and web app manifest of the site
+// @Name IconSet
+type IconSet struct {
+	AvatarURL   string    `json:"avatar_url" example:"https://example.com/site/avatar.png"`
+	FaviconURL  string    `json:"favicon_url" example:"https://example.com/site/icons/favicon.ico"`
+	ManifestURL string    `json:"manifest_url" example:"https://example.com/site/icons/manifest.json"`
+	Icons       []Icon    `json:"icons"`
+	Version     string    `json:"version" example:"3f2a9c1b7e4d8a60"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// SiteConfig represents the data-driven configuration of a tenant's site
+// @Description Theme, navigation, section toggles and SEO defaults of a site
+// @Name SiteConfig
+type SiteConfig struct {
+	ID         uuid.UUID       `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID      `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Theme      ThemeConfig     `json:"theme" db:"theme"`
+	Navigation []NavItem       `json:"navigation" db:"navigation"`
+	Sections   map[string]bool `json:"sections" db:"sections" swaggertype:"object,boolean"`
+	SEO        SEODefaults     `json:"seo" db:"seo"`
+	Icons      *IconSet        `json:"icons,omitempty" db:"icons"`
+	CreatedAt  *time.Time      `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time      `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// SiteConfigUpdate represents the input for replacing a site config
+// @Name SiteConfigUpdate
+type SiteConfigUpdate struct {
+	Theme      ThemeConfig     `json:"theme"`
+	Navigation []NavItem       `json:"navigation"`
+	Sections   map[string]bool `json:"sections" swaggertype:"object,boolean"`
+	SEO        SEODefaults     `json:"seo"`
+}
+
+// DefaultSiteConfig returns the config served before a tenant customizes it
+func DefaultSiteConfig() SiteConfig {
+	return SiteConfig{
+		Theme: ThemeConfig{
+			PrimaryColor:    "#0f172a",
+			SecondaryColor:  "#38bdf8",
+			AccentColor:     "#f97316",
+			BackgroundColor: "#ffffff",
+			TextColor:       "#111827",
+			FontFamily:      "Inter",
+			DefaultMode:     "system",
+		},
+		Navigation: []NavItem{
+			{Label: "Home", Href: "/"},
+			{Label: "Experience", Href: "/experiences"},
+			{Label: "Projects", Href: "/projects"},
+			{Label: "Tech Stack", Href: "/tech-stacks"},
+		},
+		Sections: map[string]bool{
+			"hero":        true,
+			"experiences": true,
+			"projects":    true,
+			"tech_stacks": true,
+		},
+		SEO: SEODefaults{
+			TitleFormat: "%s",
+			TwitterCard: "summary_large_image",
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package site_config
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type SiteConfigRepository interface {
+	Find(ctx context.Context) (*SiteConfig, error)
+	Upsert(ctx context.Context, siteConfig *SiteConfig) (*SiteConfig, error)
+}
+
+type siteConfigRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewSiteConfigRepository(supabaseClient *supabase.SupabaseClient) SiteConfigRepository {
+	return &siteConfigRepository{
+		supabaseClient: supabaseClient,
+		table:          "site_config",
+	}
+}
+
+// Find returns the site config of the tenant in ctx, or nil if none is stored
+func (r *siteConfigRepository) Find(ctx context.Context) (*SiteConfig, error) {
+	var siteConfigs []SiteConfig
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Limit(1, "")
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&siteConfigs)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find site config")
+	}
+
+	if len(siteConfigs) == 0 {
+		return nil, nil
+	}
+
+	return &siteConfigs[0], nil
+}
+
+// Upsert stores the site config of the tenant in ctx, one row per tenant
+func (r *siteConfigRepository) Upsert(ctx context.Context, siteConfig *SiteConfig) (*SiteConfig, error) {
+	siteConfig.TenantID = base.TenantIDFromContext(ctx)
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(siteConfig, "tenant_id", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to save site config")
+	}
+	return siteConfig, nil
+}
@@ -0,0 +1,174 @@
+package site_config
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// hexColorPattern matches #rgb, #rrggbb and #rrggbbaa colors
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// maxNavDepth limits how deeply navigation items may be nested
+const maxNavDepth = 3
+
+type SiteConfigService interface {
+	GetSiteConfig(ctx context.Context) (*SiteConfig, error)
+	UpdateSiteConfig(ctx context.Context, siteConfigUpdate *SiteConfigUpdate) (*SiteConfig, error)
+	ResetSiteConfig(ctx context.Context) (*SiteConfig, error)
+	// GenerateIcons stores the profile avatar and generates the favicon, app
+	// icons and web app manifest of the site from it
+	GenerateIcons(ctx context.Context, avatar *multipart.FileHeader) (*IconSet, error)
+}
+
+type siteConfigService struct {
+	siteConfigRepo SiteConfigRepository
+	storage        storage.Storage
+}
+
+func NewSiteConfigService(siteConfigRepo SiteConfigRepository, storage storage.Storage) SiteConfigService {
+	return &siteConfigService{
+		siteConfigRepo: siteConfigRepo,
+		storage:        storage,
+	}
+}
+
+// GetSiteConfig returns the stored config, falling back to the defaults
+func (s *siteConfigService) GetSiteConfig(ctx context.Context) (*SiteConfig, error) {
+	siteConfig, err := s.siteConfigRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if siteConfig == nil {
+		defaults := DefaultSiteConfig()
+		return &defaults, nil
+	}
+
+	return siteConfig, nil
+}
+
+func (s *siteConfigService) UpdateSiteConfig(ctx context.Context, siteConfigUpdate *SiteConfigUpdate) (*SiteConfig, error) {
+	if err := validateSiteConfig(siteConfigUpdate); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.siteConfigRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	siteConfig := SiteConfig{
+		ID:         uuid.New(),
+		Theme:      siteConfigUpdate.Theme,
+		Navigation: siteConfigUpdate.Navigation,
+		Sections:   siteConfigUpdate.Sections,
+		SEO:        siteConfigUpdate.SEO,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+
+	if existing != nil {
+		siteConfig.ID = existing.ID
+		siteConfig.Icons = existing.Icons
+		siteConfig.CreatedAt = existing.CreatedAt
+	}
+
+	if siteConfig.Navigation == nil {
+		siteConfig.Navigation = []NavItem{}
+	}
+	if siteConfig.Sections == nil {
+		siteConfig.Sections = map[string]bool{}
+	}
+
+	updatedSiteConfig, err := s.siteConfigRepo.Upsert(ctx, &siteConfig)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update site config",
+		)
+	}
+
+	return updatedSiteConfig, nil
+}
+
+// ResetSiteConfig replaces the stored config with the defaults
+func (s *siteConfigService) ResetSiteConfig(ctx context.Context) (*SiteConfig, error) {
+	defaults := DefaultSiteConfig()
+	return s.UpdateSiteConfig(ctx, &SiteConfigUpdate{
+		Theme:      defaults.Theme,
+		Navigation: defaults.Navigation,
+		Sections:   defaults.Sections,
+		SEO:        defaults.SEO,
+	})
+}
+
+// validateSiteConfig checks theme colors and the navigation tree
+func validateSiteConfig(siteConfigUpdate *SiteConfigUpdate) error {
+	colors := map[string]string{
+		"primary_color":    siteConfigUpdate.Theme.PrimaryColor,
+		"secondary_color":  siteConfigUpdate.Theme.SecondaryColor,
+		"accent_color":     siteConfigUpdate.Theme.AccentColor,
+		"background_color": siteConfigUpdate.Theme.BackgroundColor,
+		"text_color":       siteConfigUpdate.Theme.TextColor,
+	}
+
+	for field, color := range colors {
+		if color != "" && !hexColorPattern.MatchString(color) {
+			return errors.New(
+				errors.ErrValidation,
+				fmt.Sprintf("Theme %s must be a hex color", field),
+				nil,
+				errors.WithContext(field, color),
+			)
+		}
+	}
+
+	switch siteConfigUpdate.Theme.DefaultMode {
+	case "", "light", "dark", "system":
+	default:
+		return errors.New(
+			errors.ErrValidation,
+			"Theme default_mode must be light, dark or system",
+			nil,
+			errors.WithContext("default_mode", siteConfigUpdate.Theme.DefaultMode),
+		)
+	}
+
+	return validateNavigation(siteConfigUpdate.Navigation, 1)
+}
+
+// validateNavigation ensures every navigation item has a label and href
+func validateNavigation(items []NavItem, depth int) error {
+	if depth > maxNavDepth {
+		return errors.New(
+			errors.ErrValidation,
+			fmt.Sprintf("Navigation cannot be nested deeper than %d levels", maxNavDepth),
+			nil,
+		)
+	}
+
+	for _, item := range items {
+		if item.Label == "" || item.Href == "" {
+			return errors.New(
+				errors.ErrValidation,
+				"Navigation items require a label and href",
+				nil,
+				errors.WithContext("item", item),
+			)
+		}
+
+		if err := validateNavigation(item.Children, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type SitemapHandler struct {
+	base.BaseHandler
+	sitemapService SitemapService
+}
+
+func NewSitemapHandler(sitemapService SitemapService, logger *logger.Logger) *SitemapHandler {
+	return &SitemapHandler{
+		BaseHandler:    *base.NewBaseHandler(logger),
+		sitemapService: sitemapService,
+	}
+}
+
+// GetSitemap serves the sitemap of the site
+// @Summary Get the sitemap
+// @Description Retrieve the sitemap of the site in the sitemaps.org XML format, listing public projects and published pages. Projects and pages marked noindex are left out, and those with a canonical URL are listed at it.
+// @Tags SEO
+// @Produce xml
+// @Success 200 {string} string "Sitemap XML"
+// @Failure 500 {object} response.APIResponse "The site has no canonical URL to build the sitemap from"
+// @Router /sitemap.xml [get]
+func (h *SitemapHandler) GetSitemap(c *gin.Context) {
+	urlSet, err := h.sitemapService.Generate(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	body, err := xml.MarshalIndent(urlSet, "", "  ")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", append([]byte(xml.Header), body...))
+}
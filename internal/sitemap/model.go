@@ -0,0 +1,16 @@
+package sitemap
+
+import "encoding/xml"
+
+// URLSet is a sitemap in the sitemaps.org format
+type URLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	XMLNS   string   `xml:"xmlns,attr"`
+	URLs    []URL    `xml:"url"`
+}
+
+// URL is a page listed in a sitemap
+type URL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
@@ -0,0 +1,127 @@
+package sitemap
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/page"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+const (
+	// slugPlaceholder is replaced by the slug in the project and page paths
+	slugPlaceholder = "{slug}"
+
+	// batchSize is how many projects or pages are read at a time
+	batchSize = 100
+)
+
+type SitemapService interface {
+	// Generate lists the public projects and published pages of the site,
+	// leaving out those asking not to be indexed
+	Generate(ctx context.Context) (*URLSet, error)
+}
+
+type sitemapService struct {
+	projectService    project.ProjectService
+	pageService       page.PageService
+	siteConfigService site_config.SiteConfigService
+	projectPath       string
+	pagePath          string
+}
+
+func NewSitemapService(projectService project.ProjectService, pageService page.PageService, siteConfigService site_config.SiteConfigService, projectPath, pagePath string) SitemapService {
+	return &sitemapService{
+		projectService:    projectService,
+		pageService:       pageService,
+		siteConfigService: siteConfigService,
+		projectPath:       normalizePath(projectPath, "/projects/"+slugPlaceholder),
+		pagePath:          normalizePath(pagePath, "/"+slugPlaceholder),
+	}
+}
+
+func (s *sitemapService) Generate(ctx context.Context) (*URLSet, error) {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := url.Parse(siteConfig.SEO.CanonicalURL)
+	if err != nil || (canonical.Scheme != "http" && canonical.Scheme != "https") || canonical.Host == "" {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"The site has no canonical URL to build the sitemap from",
+			err,
+		)
+	}
+	site := strings.TrimRight(canonical.Scheme+"://"+canonical.Host+canonical.Path, "/")
+
+	urlSet := &URLSet{
+		XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  []URL{{Loc: site + "/"}},
+	}
+
+	publicProjects := []base.FilterOption{{Field: "visibility", Operator: base.OperatorEqual, Value: project.VisibilityPublic}}
+	for pageNumber := 1; ; pageNumber++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize, Filters: publicProjects})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			if p.SEO.NoIndex {
+				continue
+			}
+			urlSet.URLs = append(urlSet.URLs, entry(site, s.projectPath, p.Slug, p.SEO, p.UpdatedAt))
+		}
+		if len(projects) < batchSize {
+			break
+		}
+	}
+
+	publishedPages := []base.FilterOption{{Field: "published", Operator: base.OperatorEqual, Value: true}}
+	for pageNumber := 1; ; pageNumber++ {
+		pages, err := s.pageService.ListPages(ctx, base.ListOptions{Page: pageNumber, PerPage: batchSize, Filters: publishedPages})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pages {
+			if p.SEO.NoIndex {
+				continue
+			}
+			urlSet.URLs = append(urlSet.URLs, entry(site, s.pagePath, p.Slug, p.SEO, p.UpdatedAt))
+		}
+		if len(pages) < batchSize {
+			break
+		}
+	}
+
+	return urlSet, nil
+}
+
+// entry lists an entity at its canonical URL, or at its path on the site
+// when it has none
+func entry(site, path, slug string, seo base.SEO, updatedAt *time.Time) URL {
+	loc := seo.CanonicalURL
+	if loc == "" {
+		loc = site + strings.ReplaceAll(path, slugPlaceholder, url.PathEscape(slug))
+	}
+
+	u := URL{Loc: loc}
+	if updatedAt != nil {
+		u.LastMod = updatedAt.UTC().Format("2006-01-02")
+	}
+	return u
+}
+
+// normalizePath falls back to fallback for paths without a slug
+// placeholder and gives paths a leading slash
+func normalizePath(path, fallback string) string {
+	if !strings.Contains(path, slugPlaceholder) {
+		path = fallback
+	}
+	return "/" + strings.TrimLeft(path, "/")
+}
@@ -0,0 +1,60 @@
+package storage_usage
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type StorageUsageHandler struct {
+	base.BaseHandler
+	storageUsageService StorageUsageService
+}
+
+func NewStorageUsageHandler(storageUsageService StorageUsageService, logger *logger.Logger) *StorageUsageHandler {
+	return &StorageUsageHandler{
+		BaseHandler:         *base.NewBaseHandler(logger),
+		storageUsageService: storageUsageService,
+	}
+}
+
+// GetUsage reports the storage used
+// @Summary Get storage usage
+// @Description Report the bytes stored per module and the room left under the storage quota. Files stored before usage tracking started are not counted.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=Usage} "Storage usage retrieved successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /admin/storage/usage [get]
+func (h *StorageUsageHandler) GetUsage(c *gin.Context) {
+	usage, err := h.storageUsageService.GetUsage(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, usage, "Storage usage retrieved successfully")
+}
+
+// QuotaGuard rejects multipart uploads whose body would take the storage
+// over its quota with a 413 response, before the handler does any work
+func (h *StorageUsageHandler) QuotaGuard() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.ContentLength <= 0 || !strings.HasPrefix(c.ContentType(), "multipart/") {
+			c.Next()
+			return
+		}
+
+		if err := h.storageUsageService.CheckQuota(c.Request.Context(), c.Request.ContentLength); err != nil {
+			h.HandleError(c, err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
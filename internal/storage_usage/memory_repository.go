@@ -0,0 +1,44 @@
+package storage_usage
+
+import (
+	"context"
+	"sync"
+)
+
+type memoryStoredFileRepository struct {
+	mu    sync.RWMutex
+	files map[string]StoredFile
+}
+
+// NewMemoryStoredFileRepository creates a stored file repository kept in
+// memory, for development without a database
+func NewMemoryStoredFileRepository() StoredFileRepository {
+	return &memoryStoredFileRepository{files: map[string]StoredFile{}}
+}
+
+func (r *memoryStoredFileRepository) Upsert(ctx context.Context, file *StoredFile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.files[file.Path] = *file
+	return nil
+}
+
+func (r *memoryStoredFileRepository) Delete(ctx context.Context, path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.files, path)
+	return nil
+}
+
+func (r *memoryStoredFileRepository) List(ctx context.Context) ([]StoredFile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	files := make([]StoredFile, 0, len(r.files))
+	for _, file := range r.files {
+		files = append(files, file)
+	}
+	return files, nil
+}
@@ -0,0 +1,36 @@
+package storage_usage
+
+import "time"
+
+// StoredFile records the size of a file written to storage
+type StoredFile struct {
+	Path string `json:"path" db:"path"`
+	// Module is the folder of the file without its IDs, such as
+	// itsrama/images/project
+	Module    string     `json:"module" db:"module"`
+	Size      int64      `json:"size" db:"size"`
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// ModuleUsage is the storage used by one module
+// @Description Storage used by the files of one module
+// @Name ModuleUsage
+type ModuleUsage struct {
+	Module string `json:"module" example:"itsrama/images/project"`
+	Files  int    `json:"files" example:"42"`
+	Bytes  int64  `json:"bytes" example:"73400320"`
+}
+
+// Usage reports the storage used against the quota
+// @Description Storage used per module and against the quota
+// @Name StorageUsage
+type Usage struct {
+	TotalBytes int64 `json:"total_bytes" example:"104857600"`
+	Files      int   `json:"files" example:"64"`
+	// QuotaBytes is 0 when no quota is enforced
+	QuotaBytes     int64         `json:"quota_bytes" example:"1073741824"`
+	RemainingBytes *int64        `json:"remaining_bytes,omitempty" example:"968884224"`
+	UsedPercent    *float64      `json:"used_percent,omitempty" example:"9.77"`
+	Modules        []ModuleUsage `json:"modules"`
+}
@@ -0,0 +1,67 @@
+package storage_usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+)
+
+type StoredFileRepository interface {
+	Upsert(ctx context.Context, file *StoredFile) error
+	Delete(ctx context.Context, path string) error
+	List(ctx context.Context) ([]StoredFile, error)
+}
+
+type storedFileRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewStoredFileRepository(supabaseClient *supabase.SupabaseClient) StoredFileRepository {
+	return &storedFileRepository{
+		supabaseClient: supabaseClient,
+		table:          "stored_file",
+	}
+}
+
+// Upsert records a file, one row per path
+func (r *storedFileRepository) Upsert(ctx context.Context, file *StoredFile) error {
+	now := time.Now().UTC()
+	file.UpdatedAt = &now
+
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(file, "path", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save stored file")
+	}
+	return nil
+}
+
+func (r *storedFileRepository) Delete(ctx context.Context, path string) error {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("path", path).
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete stored file")
+	}
+	return nil
+}
+
+// List returns the path, module and size of every stored file
+func (r *storedFileRepository) List(ctx context.Context) ([]StoredFile, error) {
+	var files []StoredFile
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("path, module, size", "", false).
+		ExecuteTo(&files)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list stored files")
+	}
+	return files, nil
+}
@@ -0,0 +1,127 @@
+package storage_usage
+
+import (
+	"context"
+	"math"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+type StorageUsageService interface {
+	GetUsage(ctx context.Context) (*Usage, error)
+	// CheckQuota rejects writing size more bytes when they would take the
+	// storage over its quota
+	CheckQuota(ctx context.Context, size int64) error
+	// Record and Remove keep the size of the stored files up to date
+	Record(ctx context.Context, filePath string, size int64) error
+	Remove(ctx context.Context, filePath string) error
+}
+
+type storageUsageService struct {
+	storedFileRepo StoredFileRepository
+	quota          int64
+}
+
+// NewStorageUsageService creates a storage usage service enforcing a quota
+// of quota bytes, or none when quota is 0
+func NewStorageUsageService(storedFileRepo StoredFileRepository, quota int64) StorageUsageService {
+	return &storageUsageService{
+		storedFileRepo: storedFileRepo,
+		quota:          quota,
+	}
+}
+
+func (s *storageUsageService) GetUsage(ctx context.Context) (*Usage, error) {
+	files, err := s.storedFileRepo.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to list stored files",
+		)
+	}
+
+	usage := &Usage{QuotaBytes: s.quota, Files: len(files), Modules: []ModuleUsage{}}
+	modules := map[string]*ModuleUsage{}
+	for _, file := range files {
+		usage.TotalBytes += file.Size
+
+		module, ok := modules[file.Module]
+		if !ok {
+			module = &ModuleUsage{Module: file.Module}
+			modules[file.Module] = module
+		}
+		module.Files++
+		module.Bytes += file.Size
+	}
+
+	for _, module := range modules {
+		usage.Modules = append(usage.Modules, *module)
+	}
+	sort.Slice(usage.Modules, func(i, j int) bool {
+		return usage.Modules[i].Bytes > usage.Modules[j].Bytes
+	})
+
+	if s.quota > 0 {
+		remaining := max(s.quota-usage.TotalBytes, 0)
+		percent := math.Round(float64(usage.TotalBytes)/float64(s.quota)*10000) / 100
+		usage.RemainingBytes = &remaining
+		usage.UsedPercent = &percent
+	}
+
+	return usage, nil
+}
+
+func (s *storageUsageService) CheckQuota(ctx context.Context, size int64) error {
+	if s.quota <= 0 {
+		return nil
+	}
+
+	usage, err := s.GetUsage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if usage.TotalBytes+size > s.quota {
+		return errors.New(
+			errors.ErrPayloadTooLarge,
+			"Storage quota exceeded, delete unused files or raise STORAGE_QUOTA_BYTES",
+			nil,
+			errors.WithContext("quota_bytes", s.quota),
+			errors.WithContext("used_bytes", usage.TotalBytes),
+			errors.WithContext("upload_bytes", size),
+		)
+	}
+	return nil
+}
+
+func (s *storageUsageService) Record(ctx context.Context, filePath string, size int64) error {
+	return s.storedFileRepo.Upsert(ctx, &StoredFile{
+		Path:   filePath,
+		Module: ModuleOf(filePath),
+		Size:   size,
+	})
+}
+
+func (s *storageUsageService) Remove(ctx context.Context, filePath string) error {
+	return s.storedFileRepo.Delete(ctx, filePath)
+}
+
+// ModuleOf returns the folder of a stored file without the IDs in it, so
+// that itsrama/images/project/<id>/0.png belongs to itsrama/images/project
+func ModuleOf(filePath string) string {
+	var segments []string
+	for _, segment := range strings.Split(path.Dir(path.Clean(filePath)), "/") {
+		if segment == "." || segment == "" {
+			continue
+		}
+		if _, err := uuid.Parse(segment); err == nil {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,77 @@
+package storage_usage
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+// TrackedStorage records the size of the files written through it and
+// refuses writes over the storage quota. Files stored before tracking
+// started are not counted.
+type TrackedStorage struct {
+	storage.Storage
+	usage         StorageUsageService
+	defaultFolder string
+	logger        *logger.Logger
+}
+
+// NewTrackedStorage wraps backend, whose paths are placed below
+// defaultFolder, to track its usage
+func NewTrackedStorage(backend storage.Storage, usage StorageUsageService, defaultFolder string, logger *logger.Logger) *TrackedStorage {
+	return &TrackedStorage{
+		Storage:       backend,
+		usage:         usage,
+		defaultFolder: defaultFolder,
+		logger:        logger,
+	}
+}
+
+func (s *TrackedStorage) Upload(ctx context.Context, file *multipart.FileHeader, path string, opts ...storage.FileOptions) (string, error) {
+	if err := s.usage.CheckQuota(ctx, file.Size); err != nil {
+		return "", err
+	}
+
+	storedPath, err := s.Storage.Upload(ctx, file, path, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	s.record(ctx, storedPath, file.Size)
+	return storedPath, nil
+}
+
+func (s *TrackedStorage) UploadBytes(ctx context.Context, data []byte, path string, contentType string) (string, error) {
+	if err := s.usage.CheckQuota(ctx, int64(len(data))); err != nil {
+		return "", err
+	}
+
+	storedPath, err := s.Storage.UploadBytes(ctx, data, path, contentType)
+	if err != nil {
+		return "", err
+	}
+
+	s.record(ctx, storedPath, int64(len(data)))
+	return storedPath, nil
+}
+
+func (s *TrackedStorage) Delete(ctx context.Context, path string) error {
+	if err := s.Storage.Delete(ctx, path); err != nil {
+		return err
+	}
+
+	// The file is gone even if its size stays recorded
+	if err := s.usage.Remove(ctx, storage.InFolder(s.defaultFolder, path)); err != nil {
+		s.logger.Warn("Failed to remove stored file from storage usage", "path", path, "error", err.Error())
+	}
+	return nil
+}
+
+// record saves the size of a written file without failing the write
+func (s *TrackedStorage) record(ctx context.Context, storedPath string, size int64) {
+	if err := s.usage.Record(ctx, storage.InFolder(s.defaultFolder, storedPath), size); err != nil {
+		s.logger.Warn("Failed to record stored file in storage usage", "path", storedPath, "error", err.Error())
+	}
+}
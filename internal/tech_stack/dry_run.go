@@ -0,0 +1,187 @@
+package tech_stack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+func (s *techStackService) DryRunCreateTechStacks(ctx context.Context, techStacksCreate []*TechStackCreate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	names := map[string]int{}
+
+	for i, techStackCreate := range techStacksCreate {
+		change := base.PlannedChange{Index: i, Action: base.ActionCreate, Name: techStackCreate.Name}
+
+		if err := validator.ValidateModel(techStackCreate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if err := validateProficiency(techStackCreate.ProficiencyLevel, techStackCreate.YearsOfExperience); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+
+		problem, err := s.nameConflict(ctx, techStackCreate.Name, uuid.Nil, names, i)
+		if err != nil {
+			return nil, err
+		}
+		if problem != "" {
+			change.Problems = append(change.Problems, problem)
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *techStackService) DryRunUpdateTechStacks(ctx context.Context, techStacksUpdate []*TechStackUpdate) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	names := map[string]int{}
+
+	for i, techStackUpdate := range techStacksUpdate {
+		change := base.PlannedChange{Index: i, Action: base.ActionUpdate, ID: techStackUpdate.ID.String(), Name: techStackUpdate.Name}
+
+		if err := validator.ValidateModel(techStackUpdate); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+		if err := validateProficiency(techStackUpdate.ProficiencyLevel, techStackUpdate.YearsOfExperience); err != nil {
+			change.Problems = append(change.Problems, err.Error())
+		}
+
+		existing, err := s.techStackRepo.FindByField(ctx, "id", techStackUpdate.ID.String())
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find tech stack",
+				errors.WithContext("tech_stack_id", techStackUpdate.ID),
+			)
+		}
+		if len(existing) == 0 {
+			change.Problems = append(change.Problems, "tech stack does not exist")
+		} else if change.Name == "" {
+			change.Name = existing[0].Name
+		}
+
+		if techStackUpdate.Name != "" {
+			problem, err := s.nameConflict(ctx, techStackUpdate.Name, techStackUpdate.ID, names, i)
+			if err != nil {
+				return nil, err
+			}
+			if problem != "" {
+				change.Problems = append(change.Problems, problem)
+			}
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *techStackService) DryRunDeleteTechStacks(ctx context.Context, ids []string, cascade bool) (*base.DryRun, error) {
+	dryRun := base.NewDryRun()
+	seen := map[string]int{}
+
+	for i, id := range ids {
+		change := base.PlannedChange{Index: i, Action: base.ActionDelete, ID: id}
+
+		if first, ok := seen[id]; ok {
+			change.Problems = append(change.Problems, fmt.Sprintf("tech stack is already deleted by item %d", first))
+			dryRun.Add(change)
+			continue
+		}
+		seen[id] = i
+
+		if _, err := uuid.Parse(id); err != nil {
+			change.Problems = append(change.Problems, "invalid tech stack ID")
+			dryRun.Add(change)
+			continue
+		}
+
+		existing, err := s.techStackRepo.FindByField(ctx, "id", id)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to find tech stack",
+				errors.WithContext("tech_stack_id", id),
+			)
+		}
+		if len(existing) == 0 {
+			change.Problems = append(change.Problems, "tech stack does not exist")
+		} else {
+			change.Name = existing[0].Name
+		}
+
+		references, err := s.GetTechStackReferences(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(references) > 0 && !cascade {
+			change.Problems = append(change.Problems, fmt.Sprintf("tech stack still has %d links from other content, set cascade to remove them", len(references)))
+		}
+
+		dryRun.Add(change)
+	}
+
+	return dryRun, nil
+}
+
+func (s *techStackService) MissingTechStackIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	var missing []uuid.UUID
+	seen := map[uuid.UUID]bool{}
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		exists, err := s.techStackRepo.Exists(ctx, id.String())
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to check tech stack",
+				errors.WithContext("tech_stack_id", id),
+			)
+		}
+		if !exists {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing, nil
+}
+
+// nameConflict describes why name cannot be used by the tech stack with
+// the given ID, if another tech stack has it or an earlier item of the same
+// request claims it
+func (s *techStackService) nameConflict(ctx context.Context, name string, id uuid.UUID, claimed map[string]int, index int) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+
+	if first, ok := claimed[name]; ok {
+		return fmt.Sprintf("name %q is also used by item %d", name, first), nil
+	}
+	claimed[name] = index
+
+	existing, err := s.techStackRepo.FindByField(ctx, "name", name)
+	if err != nil {
+		return "", errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to check tech stack name",
+			errors.WithContext("tech_stack_name", name),
+		)
+	}
+	for _, techStack := range existing {
+		if techStack.ID != id {
+			return fmt.Sprintf("name %q is already taken", name), nil
+		}
+	}
+
+	return "", nil
+}
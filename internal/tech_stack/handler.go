@@ -1,6 +1,11 @@
 package tech_stack
 
 import (
+	"mime/multipart"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
@@ -29,6 +34,8 @@ func NewTechStackHandler(techStackService TechStackService, logger *logger.Logge
 // @Accept multipart/form-data
 // @Produce json
 // @Param image formData file false "Tech Stack Image"
+// @Param image_light formData file false "Tech Stack Image for light backgrounds, generated from the image when omitted"
+// @Param image_dark formData file false "Tech Stack Image for dark backgrounds, generated from the image when omitted"
 // @Param payload formData string true "Tech Stack Details in JSON format (See TechStackCreate Model)"
 // @Success 200 {object} response.APIResponse{data=TechStack} "Tech stack created successfully"
 // @Failure 400 {object} response.APIResponse{data=TechStackCreate} "Bad Request"
@@ -64,6 +71,12 @@ func (h *TechStackHandler) CreateTechStack(c *gin.Context) {
 		techStackInput.Image = imageFileHeaders[0]
 	}
 
+	// Get logo variant files
+	if techStackInput.ImageLight, techStackInput.ImageDark, err = extractLogoVariants(c); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
 	// Create tech stack
 	techStack, err := h.techStackService.CreateTechStack(c.Request.Context(), &techStackInput)
 	if err != nil {
@@ -80,7 +93,7 @@ func (h *TechStackHandler) CreateTechStack(c *gin.Context) {
 // @Tags Tech Stacks
 // @Produce json
 // @Param id path string true "Tech Stack ID"
-// @Success 200 {object} response.APIResponse{data=TechStack} "Tech stack retrieved successfully"
+// @Success 200 {object} response.APIResponse{data=PublicTechStack} "Tech stack retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Tech stack not found"
 // @Router /tech-stacks/{id} [get]
@@ -112,6 +125,8 @@ func (h *TechStackHandler) GetTechStackByID(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Tech Stack ID"
 // @Param image formData file false "Tech Stack Image"
+// @Param image_light formData file false "Tech Stack Image for light backgrounds, generated from the image when omitted"
+// @Param image_dark formData file false "Tech Stack Image for dark backgrounds, generated from the image when omitted"
 // @Param payload formData string true "Tech Stack Update Details in JSON format (See TechStackUpdate Model)"
 // @Success 200 {object} response.APIResponse{data=TechStack} "Tech stack updated successfully"
 // @Failure 400 {object} response.APIResponse{data=TechStackUpdate} "Bad Request"
@@ -162,6 +177,12 @@ func (h *TechStackHandler) UpdateTechStack(c *gin.Context) {
 		techStackInput.Image = imageFileHeaders[0]
 	}
 
+	// Get logo variant files
+	if techStackInput.ImageLight, techStackInput.ImageDark, err = extractLogoVariants(c); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
 	// Update tech stack
 	updatedTechStack, err := h.techStackService.UpdateTechStack(c.Request.Context(), &techStackInput)
 	if err != nil {
@@ -178,9 +199,12 @@ func (h *TechStackHandler) UpdateTechStack(c *gin.Context) {
 // @Tags Tech Stacks
 // @Produce json
 // @Param id path string true "Tech Stack ID"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param cascade query bool false "Remove the links of projects and experiences to the tech stack along with it, instead of refusing the delete"
 // @Success 200 {object} response.APIResponse "Tech stack deleted successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Failure 404 {object} response.APIResponse "Tech stack not found"
+// @Failure 409 {object} response.APIResponse "Tech stack is still linked to other content"
 // @Router /tech-stacks/{id} [delete]
 func (h *TechStackHandler) DeleteTechStack(c *gin.Context) {
 	techStackID := c.Param("id")
@@ -193,7 +217,28 @@ func (h *TechStackHandler) DeleteTechStack(c *gin.Context) {
 		return
 	}
 
-	err := h.techStackService.DeleteTechStack(c.Request.Context(), techStackID)
+	cascade, err := isCascade(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.techStackService.DryRunDeleteTechStacks(c.Request.Context(), []string{techStackID}, cascade)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	err = h.techStackService.DeleteTechStack(c.Request.Context(), techStackID, cascade)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -202,6 +247,101 @@ func (h *TechStackHandler) DeleteTechStack(c *gin.Context) {
 	h.HandleSuccess(c, nil, "Tech stack deleted successfully")
 }
 
+// GetTechStackReferences lists the content linking to a tech stack
+// @Summary List tech stack references
+// @Description List the projects and experiences linking to a tech stack, which keep it from being deleted without cascade
+// @Tags Tech Stacks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tech Stack ID"
+// @Success 200 {object} response.APIResponse{data=[]Reference} "Tech stack references retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /tech-stacks/{id}/references [get]
+func (h *TechStackHandler) GetTechStackReferences(c *gin.Context) {
+	references, err := h.techStackService.GetTechStackReferences(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, references, "Tech stack references retrieved successfully")
+}
+
+// CleanupOrphanReferences removes links to deleted tech stacks
+// @Summary Clean up orphaned tech stack links
+// @Description Remove the links of projects and experiences to tech stacks that no longer exist
+// @Tags Tech Stacks
+// @Produce json
+// @Security BearerAuth
+// @Param dry_run query bool false "Report the orphaned links without removing them"
+// @Success 200 {object} response.APIResponse{data=[]Reference} "Orphaned tech stack links removed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 500 {object} response.APIResponse "Internal Server Error"
+// @Router /tech-stacks/orphans [delete]
+func (h *TechStackHandler) CleanupOrphanReferences(c *gin.Context) {
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	orphans, err := h.techStackService.CleanupOrphanReferences(c.Request.Context(), dryRun)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if dryRun {
+		h.HandleSuccess(c, orphans, "Dry run completed, nothing was written")
+		return
+	}
+	h.HandleSuccess(c, orphans, "Orphaned tech stack links removed successfully")
+}
+
+// GetProficiencyHistory retrieves skill proficiency over time
+// @Summary Get skill proficiency history
+// @Description Retrieve the proficiency level and years of experience of skills over time, one series per tech stack, for skill growth charts
+// @Tags Tech Stacks
+// @Produce json
+// @Param ids query string false "Comma-separated tech stack IDs, defaults to every rated tech stack"
+// @Param since query string false "Only include changes since this time (RFC3339 or YYYY-MM-DD)"
+// @Success 200 {object} response.APIResponse{data=[]ProficiencySeries} "Proficiency history retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /tech-stacks/history [get]
+func (h *TechStackHandler) GetProficiencyHistory(c *gin.Context) {
+	var ids []string
+	for _, id := range strings.Split(c.Query("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	var since time.Time
+	if value := c.Query("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			parsed, err = time.Parse(time.DateOnly, value)
+		}
+		if err != nil {
+			h.HandleError(c, errors.New(
+				errors.ErrValidation,
+				"Invalid since parameter",
+				err,
+			))
+			return
+		}
+		since = parsed
+	}
+
+	series, err := h.techStackService.GetProficiencyHistory(c.Request.Context(), ids, since)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, series, "Proficiency history retrieved successfully")
+}
+
 // ListTechStacks retrieves a paginated list of tech stacks
 // @Summary List tech stacks
 // @Description Retrieve a paginated list of tech stacks with optional filtering
@@ -210,7 +350,7 @@ func (h *TechStackHandler) DeleteTechStack(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
 // @Param category query string false "Filter by category"
-// @Success 200 {object} response.APIResponse{data=[]TechStack} "Tech stacks retrieved successfully"
+// @Success 200 {object} response.APIResponse{data=[]PublicTechStack} "Tech stacks retrieved successfully"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /tech-stacks [get]
 func (h *TechStackHandler) ListTechStacks(c *gin.Context) {
@@ -261,7 +401,10 @@ func (h *TechStackHandler) ListTechStacks(c *gin.Context) {
 // @Produce json
 // @Param image formData []file false "Tech Stack Images"
 // @Param payload formData string true "Tech Stack Details in JSON array format (See TechStackCreate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]TechStack} "Tech stacks created successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Tech stacks processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]TechStackCreate} "Bad Request"
 // @Router /tech-stacks/bulk [post]
 func (h *TechStackHandler) BulkCreateTechStacks(c *gin.Context) {
@@ -298,6 +441,32 @@ func (h *TechStackHandler) BulkCreateTechStacks(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.techStackService.DryRunCreateTechStacks(c.Request.Context(), techStacksInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.techStackService.BulkCreateTechStacksPartial(c.Request.Context(), techStacksInput)
+		h.HandleMultiStatus(c, result, "Tech stacks processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk create tech stacks
 	techStacks, err := h.techStackService.BulkCreateTechStacks(c.Request.Context(), techStacksInput)
 	if err != nil {
@@ -316,7 +485,10 @@ func (h *TechStackHandler) BulkCreateTechStacks(c *gin.Context) {
 // @Produce json
 // @Param images formData []file false "Tech Stack Images"
 // @Param payload formData string true "Tech Stack Update Details in JSON array format (See TechStackUpdate Model)"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
 // @Success 200 {object} response.APIResponse{data=[]TechStack} "Tech stacks updated successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Tech stacks processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse{data=[]TechStackUpdate} "Bad Request"
 // @Router /tech-stacks/bulk [put]
 func (h *TechStackHandler) BulkUpdateTechStacks(c *gin.Context) {
@@ -351,6 +523,32 @@ func (h *TechStackHandler) BulkUpdateTechStacks(c *gin.Context) {
 		}
 	}
 
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.techStackService.DryRunUpdateTechStacks(c.Request.Context(), techStacksInput)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.techStackService.BulkUpdateTechStacksPartial(c.Request.Context(), techStacksInput)
+		h.HandleMultiStatus(c, result, "Tech stacks processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk update tech stacks
 	updatedTechStacks, err := h.techStackService.BulkUpdateTechStacks(c.Request.Context(), techStacksInput)
 	if err != nil {
@@ -368,14 +566,18 @@ func (h *TechStackHandler) BulkUpdateTechStacks(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param ids body []string true "Tech Stack IDs to delete"
+// @Param dry_run query bool false "Validate and report the changes without writing anything"
+// @Param atomic query bool false "Stop at the first failing item (default true); false carries on past failures and answers 207 with the outcome of each item"
+// @Param cascade query bool false "Remove the links of projects and experiences to the tech stacks along with them, instead of refusing the delete"
 // @Success 200 {object} response.APIResponse "Tech stacks deleted successfully"
+// @Success 207 {object} response.APIResponse{data=base.BulkResult} "Tech stacks processed, see each item for its outcome"
 // @Failure 400 {object} response.APIResponse "Bad Request"
 // @Router /tech-stacks/bulk [delete]
 func (h *TechStackHandler) BulkDeleteTechStacks(c *gin.Context) {
 	var idsInput []string
 
 	// Bind input
-	if err := c.ShouldBindJSON(&idsInput); err != nil {
+	if err := h.BindJSON(c, &idsInput); err != nil {
 		h.HandleError(c, errors.New(
 			errors.ErrValidation,
 			"Invalid input",
@@ -384,8 +586,40 @@ func (h *TechStackHandler) BulkDeleteTechStacks(c *gin.Context) {
 		return
 	}
 
+	cascade, err := isCascade(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	dryRun, err := h.IsDryRun(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if dryRun {
+		plan, err := h.techStackService.DryRunDeleteTechStacks(c.Request.Context(), idsInput, cascade)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		h.HandleSuccess(c, plan, "Dry run completed, nothing was written")
+		return
+	}
+
+	atomic, err := h.IsAtomic(c)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+	if !atomic {
+		result := h.techStackService.BulkDeleteTechStacksPartial(c.Request.Context(), idsInput, cascade)
+		h.HandleMultiStatus(c, result, "Tech stacks processed, see each item for its outcome")
+		return
+	}
+
 	// Bulk delete tech stacks
-	err := h.techStackService.BulkDeleteTechStacks(c.Request.Context(), idsInput)
+	err = h.techStackService.BulkDeleteTechStacks(c.Request.Context(), idsInput, cascade)
 	if err != nil {
 		h.HandleError(c, err)
 		return
@@ -393,3 +627,39 @@ func (h *TechStackHandler) BulkDeleteTechStacks(c *gin.Context) {
 
 	h.HandleSuccess(c, nil, "Tech stacks deleted successfully")
 }
+
+// isCascade reports whether a delete request asks, with ?cascade=true, to
+// remove the links to the tech stacks along with them
+func isCascade(c *gin.Context) (bool, error) {
+	value := c.Query("cascade")
+	if value == "" {
+		return false, nil
+	}
+
+	cascade, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New(
+			errors.ErrValidation,
+			"Invalid cascade value",
+			err,
+			errors.WithContext("cascade", value),
+		)
+	}
+	return cascade, nil
+}
+
+// extractLogoVariants returns the uploaded logo variants for light and dark
+// backgrounds, if any
+func extractLogoVariants(c *gin.Context) (*multipart.FileHeader, *multipart.FileHeader, error) {
+	var variants [2]*multipart.FileHeader
+	for i, field := range []string{"image_light", "image_dark"} {
+		fileHeaders, err := utils.ExtractFileHeaders(c, field, 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(fileHeaders) > 0 {
+			variants[i] = fileHeaders[0]
+		}
+	}
+	return variants[0], variants[1], nil
+}
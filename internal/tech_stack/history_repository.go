@@ -0,0 +1,65 @@
+package tech_stack
+
+import (
+	"context"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type TechStackHistoryRepository interface {
+	Create(ctx context.Context, history *TechStackHistory) error
+	List(ctx context.Context, techStackIDs []string, since time.Time) ([]TechStackHistory, error)
+}
+
+type techStackHistoryRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewTechStackHistoryRepository(supabaseClient *supabase.SupabaseClient) TechStackHistoryRepository {
+	return &techStackHistoryRepository{
+		supabaseClient: supabaseClient,
+		table:          "tech_stack_history",
+	}
+}
+
+func (r *techStackHistoryRepository) Create(ctx context.Context, history *TechStackHistory) error {
+	history.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(history, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to record tech stack history")
+	}
+	return nil
+}
+
+// List returns the history of the given tech stacks, or of all tech stacks
+// when none are given, recorded since the given time, oldest first
+func (r *techStackHistoryRepository) List(ctx context.Context, techStackIDs []string, since time.Time) ([]TechStackHistory, error) {
+	var history []TechStackHistory
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	if len(techStackIDs) > 0 {
+		query = query.In("tech_stack_id", techStackIDs)
+	}
+	if !since.IsZero() {
+		query = query.Gte("recorded_at", since.UTC().Format(time.RFC3339))
+	}
+
+	_, err := query.
+		Order("recorded_at", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&history)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list tech stack history")
+	}
+	return history, nil
+}
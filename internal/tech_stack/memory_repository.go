@@ -0,0 +1,13 @@
+package tech_stack
+
+import "github.com/holycann/itsrama-portfolio-backend/internal/base"
+
+// NewMemoryTechStackRepository creates a tech stack repository kept in
+// memory, for development without a database
+func NewMemoryTechStackRepository(techStacks []TechStack) TechStackRepository {
+	return base.NewMemoryRepository(techStacks,
+		func(t *TechStack) string { return t.ID.String() },
+		func(t TechStack) TechStack { return t },
+		"name", "category",
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
 )
 
 // TechStackCategory represents the predefined categories for tech stack
@@ -27,14 +28,29 @@ const (
 // @Name TechStack
 type TechStack struct {
 	ID          uuid.UUID         `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID        `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
 	Name        string            `json:"name" db:"name" validate:"required" example:"Go"`
 	Category    TechStackCategory `json:"category" db:"category" example:"Backend"`
 	Version     string            `json:"version" db:"version" example:"1.20"`
 	Role        string            `json:"role" db:"role" example:"Backend Development"`
 	IsCoreSkill bool              `json:"is_core_skill" db:"is_core_skill" example:"true"`
 	ImageUrl    string            `json:"image_url" db:"image_url" example:"https://example.com/go-logo.png"`
-	CreatedAt   *time.Time        `json:"created_at,omitempty" db:"created_at"`
-	UpdatedAt   *time.Time        `json:"updated_at,omitempty" db:"updated_at"`
+
+	// ImageUrlLight and ImageUrlDark are the logo variants for light and dark
+	// backgrounds, and LogoPreference the variant frontends should show
+	ImageUrlLight  string               `json:"image_url_light,omitempty" db:"image_url_light" example:"https://example.com/go-logo.png"`
+	ImageUrlDark   string               `json:"image_url_dark,omitempty" db:"image_url_dark" example:"https://example.com/go-logo-dark.png"`
+	LogoPreference asset.LogoPreference `json:"logo_preference,omitempty" db:"logo_preference" enums:"auto,light,dark" example:"auto"`
+
+	// Proficiency from 1 (beginner) to 5 (expert), 0 when not rated
+	ProficiencyLevel  int     `json:"proficiency_level,omitempty" db:"proficiency_level" example:"4"`
+	YearsOfExperience float64 `json:"years_of_experience,omitempty" db:"years_of_experience" example:"3.5"`
+
+	// EndorsementCount is maintained by the database and never written by the API
+	EndorsementCount int `json:"endorsement_count,omitempty" db:"endorsement_count" example:"12"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 // TechStackCreate represents the input for creating a new tech stack
@@ -46,6 +62,20 @@ type TechStackCreate struct {
 	Role        string                `json:"role" example:"Data Science"`
 	IsCoreSkill bool                  `json:"is_core_skill" example:"true"`
 	Image       *multipart.FileHeader `json:"image" swaggerignore:"true"`
+
+	// ImageLight and ImageDark replace the logo variants generated from the image
+	ImageLight     *multipart.FileHeader `json:"image_light" swaggerignore:"true"`
+	ImageDark      *multipart.FileHeader `json:"image_dark" swaggerignore:"true"`
+	LogoPreference string                `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"auto"`
+
+	ProficiencyLevel  int     `json:"proficiency_level,omitempty" example:"3"`
+	YearsOfExperience float64 `json:"years_of_experience,omitempty" example:"2"`
+
+	// IconSlug overrides the icon slug derived from the name when no image is uploaded
+	IconSlug string `json:"icon_slug,omitempty" example:"python"`
+
+	// SkipIconFetch disables fetching a logo when no image is uploaded
+	SkipIconFetch bool `json:"skip_icon_fetch,omitempty" example:"false"`
 }
 
 // TechStackUpdate represents the input for updating an existing tech stack
@@ -58,6 +88,14 @@ type TechStackUpdate struct {
 	Role        string                `json:"role" example:"Systems Programming"`
 	IsCoreSkill bool                  `json:"is_core_skill" example:"true"`
 	Image       *multipart.FileHeader `json:"image" swaggerignore:"true"`
+
+	// ImageLight and ImageDark replace the logo variants generated from the image
+	ImageLight     *multipart.FileHeader `json:"image_light" swaggerignore:"true"`
+	ImageDark      *multipart.FileHeader `json:"image_dark" swaggerignore:"true"`
+	LogoPreference string                `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"dark"`
+
+	ProficiencyLevel  int     `json:"proficiency_level,omitempty" example:"5"`
+	YearsOfExperience float64 `json:"years_of_experience,omitempty" example:"4"`
 }
 
 // ToTechStack converts TechStackCreate to TechStack
@@ -70,8 +108,12 @@ func (tc *TechStackCreate) ToTechStack() TechStack {
 		Version:     tc.Version,
 		Role:        tc.Role,
 		IsCoreSkill: tc.IsCoreSkill,
-		CreatedAt:   &now,
-		UpdatedAt:   &now,
+
+		ProficiencyLevel:  tc.ProficiencyLevel,
+		YearsOfExperience: tc.YearsOfExperience,
+
+		CreatedAt: &now,
+		UpdatedAt: &now,
 	}
 }
 
@@ -85,6 +127,40 @@ func (tu *TechStackUpdate) ToTechStack() TechStack {
 		Version:     tu.Version,
 		Role:        tu.Role,
 		IsCoreSkill: tu.IsCoreSkill,
-		UpdatedAt:   &now,
+
+		ProficiencyLevel:  tu.ProficiencyLevel,
+		YearsOfExperience: tu.YearsOfExperience,
+
+		UpdatedAt: &now,
 	}
 }
+
+// TechStackHistory is a snapshot of a skill's proficiency at a point in time
+// @Description Recorded change of a skill's proficiency
+// @Name TechStackHistory
+type TechStackHistory struct {
+	ID                uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID          *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	TechStackID       uuid.UUID  `json:"tech_stack_id" db:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	ProficiencyLevel  int        `json:"proficiency_level" db:"proficiency_level" example:"4"`
+	YearsOfExperience float64    `json:"years_of_experience" db:"years_of_experience" example:"3.5"`
+	RecordedAt        time.Time  `json:"recorded_at" db:"recorded_at"`
+}
+
+// ProficiencyPoint is a single point of a skill growth series
+// @Name ProficiencyPoint
+type ProficiencyPoint struct {
+	RecordedAt        time.Time `json:"recorded_at"`
+	ProficiencyLevel  int       `json:"proficiency_level" example:"4"`
+	YearsOfExperience float64   `json:"years_of_experience" example:"3.5"`
+}
+
+// ProficiencySeries is the proficiency history of a skill, oldest first
+// @Description Time series of a skill's proficiency for growth charts
+// @Name ProficiencySeries
+type ProficiencySeries struct {
+	TechStackID uuid.UUID          `json:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	Name        string             `json:"name" example:"Go"`
+	Category    TechStackCategory  `json:"category" example:"Backend"`
+	Points      []ProficiencyPoint `json:"points"`
+}
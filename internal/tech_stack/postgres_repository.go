@@ -0,0 +1,115 @@
+package tech_stack
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/database"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+type postgresTechStackRepository struct {
+	db    *database.DB
+	table string
+}
+
+// NewPostgresTechStackRepository creates a tech stack repository that
+// queries Postgres directly
+func NewPostgresTechStackRepository(db *database.DB) TechStackRepository {
+	return &postgresTechStackRepository{
+		db:    db,
+		table: "tech_stack",
+	}
+}
+
+func (r *postgresTechStackRepository) Create(ctx context.Context, techStack *TechStack) (*TechStack, error) {
+	techStack.TenantID = base.TenantIDFromContext(ctx)
+	if err := r.db.Insert(ctx, r.table, techStack); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create tech stack")
+	}
+	return techStack, nil
+}
+
+func (r *postgresTechStackRepository) Update(ctx context.Context, techStack *TechStack) (*TechStack, error) {
+	techStack.TenantID = base.TenantIDFromContext(ctx)
+	filter := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", techStack.ID))
+	if _, err := r.db.Update(ctx, r.table, techStack, filter); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update tech stack")
+	}
+	return techStack, nil
+}
+
+func (r *postgresTechStackRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.Delete(ctx, r.table, base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id))); err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete tech stack")
+	}
+	return nil
+}
+
+func (r *postgresTechStackRepository) List(ctx context.Context, opts base.ListOptions) ([]TechStack, error) {
+	var techStacks []TechStack
+	filter := base.ApplySQLFilters(base.ScopeFilterToTenant(ctx, database.NewFilter()), opts.Filters)
+
+	// Apply search if provided
+	if opts.Search != "" {
+		pattern := "%" + opts.Search + "%"
+		filter = filter.Where("name ILIKE ? OR category::text ILIKE ?", pattern, pattern)
+	}
+
+	where, args := filter.SQL(0)
+	page := database.Page(opts.SortBy, opts.SortOrder == base.SortAscending, opts.Page, opts.PerPage)
+
+	if err := r.db.Select(ctx, &techStacks, "SELECT * FROM tech_stack "+where+" "+page, args...); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list tech stacks")
+	}
+
+	return techStacks, nil
+}
+
+func (r *postgresTechStackRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	filter := base.ApplySQLFilters(base.ScopeFilterToTenant(ctx, database.NewFilter()), filters)
+	where, args := filter.SQL(0)
+
+	count, err := r.db.Count(ctx, "SELECT id FROM tech_stack "+where, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tech stacks")
+	}
+
+	return count, nil
+}
+
+func (r *postgresTechStackRepository) Exists(ctx context.Context, id string) (bool, error) {
+	where, args := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq("id", id)).SQL(0)
+
+	count, err := r.db.Count(ctx, "SELECT id FROM tech_stack "+where+" LIMIT 1", args...)
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check tech stack existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *postgresTechStackRepository) FindByField(ctx context.Context, field string, value interface{}) ([]TechStack, error) {
+	var techStacks []TechStack
+	where, args := base.ScopeFilterToTenant(ctx, database.NewFilter().Eq(field, value)).SQL(0)
+
+	if err := r.db.Select(ctx, &techStacks, "SELECT * FROM tech_stack "+where, args...); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find tech stacks by field")
+	}
+	return techStacks, nil
+}
+
+func (r *postgresTechStackRepository) Search(ctx context.Context, opts base.ListOptions) ([]TechStack, int, error) {
+	techStacks, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search tech stacks")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tech stacks")
+	}
+
+	return techStacks, count, nil
+}
@@ -0,0 +1,160 @@
+package tech_stack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// Reference is a link from content, such as a project, to a tech stack
+// @Description Link from content to a tech stack
+// @Name TechStackReference
+type Reference struct {
+	Entity      string    `json:"entity" example:"project"`
+	EntityID    uuid.UUID `json:"entity_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EntityName  string    `json:"entity_name" example:"Portfolio Website"`
+	TechStackID uuid.UUID `json:"tech_stack_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+}
+
+// Referrer is content linking to tech stacks. Tech stacks cannot be
+// deleted while a referrer still links to them, unless the links are
+// removed along with them.
+type Referrer interface {
+	// TechStackReferences lists the tech stack links of the tenant in ctx
+	TechStackReferences(ctx context.Context) ([]Reference, error)
+	// UnlinkTechStacks removes every link to the given tech stacks
+	UnlinkTechStacks(ctx context.Context, techStackIDs []uuid.UUID) error
+}
+
+func (s *techStackService) RegisterReferrer(referrer Referrer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.referrers = append(s.referrers, referrer)
+}
+
+func (s *techStackService) GetTechStackReferences(ctx context.Context, id string) ([]Reference, error) {
+	techStackID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Invalid tech stack ID",
+			err,
+			errors.WithContext("tech_stack_id", id),
+		)
+	}
+
+	references, err := s.allReferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := []Reference{}
+	for _, reference := range references {
+		if reference.TechStackID == techStackID {
+			found = append(found, reference)
+		}
+	}
+	return found, nil
+}
+
+func (s *techStackService) CleanupOrphanReferences(ctx context.Context, dryRun bool) ([]Reference, error) {
+	references, err := s.allReferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(references))
+	for i, reference := range references {
+		ids[i] = reference.TechStackID
+	}
+	missing, err := s.MissingTechStackIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make(map[uuid.UUID]bool, len(missing))
+	for _, id := range missing {
+		deleted[id] = true
+	}
+	orphans := []Reference{}
+	for _, reference := range references {
+		if deleted[reference.TechStackID] {
+			orphans = append(orphans, reference)
+		}
+	}
+
+	if dryRun || len(missing) == 0 {
+		return orphans, nil
+	}
+
+	if err := s.unlink(ctx, missing); err != nil {
+		return nil, err
+	}
+	return orphans, nil
+}
+
+// checkReferences stops the tech stack with the given ID from being deleted
+// while content links to it, or removes the links when cascading
+func (s *techStackService) checkReferences(ctx context.Context, id string, cascade bool) error {
+	references, err := s.GetTechStackReferences(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(references) == 0 {
+		return nil
+	}
+
+	if !cascade {
+		return errors.New(
+			errors.ErrConflict,
+			"Tech stack is still linked to other content, set cascade to remove the links with it",
+			fmt.Errorf("%d references", len(references)),
+			errors.WithContext("tech_stack_id", id),
+			errors.WithContext("references", references),
+		)
+	}
+
+	return s.unlink(ctx, []uuid.UUID{references[0].TechStackID})
+}
+
+// allReferences lists the tech stack links of every registered referrer
+func (s *techStackService) allReferences(ctx context.Context) ([]Reference, error) {
+	s.mu.RLock()
+	referrers := s.referrers
+	s.mu.RUnlock()
+
+	var references []Reference
+	for _, referrer := range referrers {
+		found, err := referrer.TechStackReferences(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to list tech stack references",
+			)
+		}
+		references = append(references, found...)
+	}
+	return references, nil
+}
+
+// unlink removes the links of every registered referrer to the given tech
+// stacks
+func (s *techStackService) unlink(ctx context.Context, techStackIDs []uuid.UUID) error {
+	s.mu.RLock()
+	referrers := s.referrers
+	s.mu.RUnlock()
+
+	for _, referrer := range referrers {
+		if err := referrer.UnlinkTechStacks(ctx, techStackIDs); err != nil {
+			return errors.Wrap(err,
+				errors.ErrDatabase,
+				"Failed to remove tech stack references",
+				errors.WithContext("tech_stack_ids", techStackIDs),
+			)
+		}
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ func NewTechStackRepository(supabaseClient *supabase.SupabaseClient) TechStackRe
 }
 
 func (r *techStackRepository) Create(ctx context.Context, techStack *TechStack) (*TechStack, error) {
+	techStack.TenantID = base.TenantIDFromContext(ctx)
 	_, _, err := r.supabaseClient.GetClient().
 		From(r.table).
 		Insert(techStack, false, "", "minimal", "").
@@ -38,11 +39,12 @@ func (r *techStackRepository) Create(ctx context.Context, techStack *TechStack)
 }
 
 func (r *techStackRepository) Update(ctx context.Context, techStack *TechStack) (*TechStack, error) {
-	_, _, err := r.supabaseClient.GetClient().
+	techStack.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Update(techStack, "minimal", "").
-		Eq("id", techStack.ID.String()).
-		Execute()
+		Eq("id", techStack.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update tech stack")
 	}
@@ -50,11 +52,11 @@ func (r *techStackRepository) Update(ctx context.Context, techStack *TechStack)
 }
 
 func (r *techStackRepository) Delete(ctx context.Context, id string) error {
-	_, _, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Delete("minimal", "").
-		Eq("id", id).
-		Execute()
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
 	if err != nil {
 		return errors.Wrap(err, errors.ErrDatabase, "failed to delete tech stack")
 	}
@@ -66,6 +68,7 @@ func (r *techStackRepository) List(ctx context.Context, opts base.ListOptions) (
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -107,6 +110,7 @@ func (r *techStackRepository) Count(ctx context.Context, filters []base.FilterOp
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range filters {
@@ -127,12 +131,12 @@ func (r *techStackRepository) Count(ctx context.Context, filters []base.FilterOp
 }
 
 func (r *techStackRepository) Exists(ctx context.Context, id string) (bool, error) {
-	_, count, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("id", "exact", true).
 		Eq("id", id).
-		Limit(1, "").
-		Execute()
+		Limit(1, "")
+	_, count, err := base.ScopeToTenant(ctx, query).Execute()
 
 	if err != nil {
 		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check tech stack existence")
@@ -143,11 +147,11 @@ func (r *techStackRepository) Exists(ctx context.Context, id string) (bool, erro
 
 func (r *techStackRepository) FindByField(ctx context.Context, field string, value interface{}) ([]TechStack, error) {
 	var techStacks []TechStack
-	_, err := r.supabaseClient.GetClient().
+	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*", "", false).
-		Eq(field, fmt.Sprintf("%v", value)).
-		ExecuteTo(&techStacks)
+		Eq(field, fmt.Sprintf("%v", value))
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&techStacks)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find tech stacks by field")
 	}
@@ -159,6 +163,7 @@ func (r *techStackRepository) Search(ctx context.Context, opts base.ListOptions)
 	query := r.supabaseClient.GetClient().
 		From(r.table).
 		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
 
 	// Apply filters
 	for _, filter := range opts.Filters {
@@ -2,41 +2,83 @@ package tech_stack
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"mime/multipart"
+	"net/http"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
 	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
 	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
 	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
-	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
-	storage_go "github.com/supabase-community/storage-go"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/icons"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 )
 
 type TechStackService interface {
 	CreateTechStack(ctx context.Context, techStackCreate *TechStackCreate) (*TechStack, error)
 	GetTechStackByID(ctx context.Context, id string) (*TechStack, error)
 	UpdateTechStack(ctx context.Context, techStackUpdate *TechStackUpdate) (*TechStack, error)
-	DeleteTechStack(ctx context.Context, id string) error
+	// DeleteTechStack deletes a tech stack no content links to, or removes
+	// the links along with it when cascade is set
+	DeleteTechStack(ctx context.Context, id string, cascade bool) error
 	ListTechStacks(ctx context.Context, opts base.ListOptions) ([]TechStack, error)
 	CountTechStacks(ctx context.Context, filters []base.FilterOption) (int, error)
 	SearchTechStacks(ctx context.Context, opts base.ListOptions) ([]TechStack, int, error)
 	BulkCreateTechStacks(ctx context.Context, techStacksCreate []*TechStackCreate) ([]TechStack, error)
 	BulkUpdateTechStacks(ctx context.Context, techStacksUpdate []*TechStackUpdate) ([]TechStack, error)
-	BulkDeleteTechStacks(ctx context.Context, ids []string) error
+	BulkDeleteTechStacks(ctx context.Context, ids []string, cascade bool) error
+	// BulkCreateTechStacksPartial, BulkUpdateTechStacksPartial and BulkDeleteTechStacksPartial
+	// write every item independently, carrying on past failures and
+	// reporting the outcome of each
+	BulkCreateTechStacksPartial(ctx context.Context, techStacksCreate []*TechStackCreate) *base.BulkResult
+	BulkUpdateTechStacksPartial(ctx context.Context, techStacksUpdate []*TechStackUpdate) *base.BulkResult
+	BulkDeleteTechStacksPartial(ctx context.Context, ids []string, cascade bool) *base.BulkResult
+	// DryRunCreateTechStacks, DryRunUpdateTechStacks and DryRunDeleteTechStacks
+	// validate a bulk write, including name conflicts, without writing
+	// anything
+	DryRunCreateTechStacks(ctx context.Context, techStacksCreate []*TechStackCreate) (*base.DryRun, error)
+	DryRunUpdateTechStacks(ctx context.Context, techStacksUpdate []*TechStackUpdate) (*base.DryRun, error)
+	DryRunDeleteTechStacks(ctx context.Context, ids []string, cascade bool) (*base.DryRun, error)
+	// MissingTechStackIDs returns the IDs not matching any tech stack, for
+	// checking references before writing them
+	MissingTechStackIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+	GetProficiencyHistory(ctx context.Context, ids []string, since time.Time) ([]ProficiencySeries, error)
+	// RegisterReferrer adds content whose links are checked before tech
+	// stacks are deleted
+	RegisterReferrer(referrer Referrer)
+	// GetTechStackReferences lists the content linking to a tech stack
+	GetTechStackReferences(ctx context.Context, id string) ([]Reference, error)
+	// CleanupOrphanReferences removes the links to tech stacks that no
+	// longer exist and returns them, or only returns them on a dry run
+	CleanupOrphanReferences(ctx context.Context, dryRun bool) ([]Reference, error)
 }
 
 type techStackService struct {
 	techStackRepo TechStackRepository
-	storage       supabase.SupabaseStorage
+	historyRepo   TechStackHistoryRepository
+	storage       storage.Storage
+	assets        asset.AssetService
+	icons         *icons.Fetcher
+	publisher     events.Publisher
+
+	mu        sync.RWMutex
+	referrers []Referrer
 }
 
-func NewTechStackService(techStackRepo TechStackRepository, storage supabase.SupabaseStorage) TechStackService {
+func NewTechStackService(techStackRepo TechStackRepository, historyRepo TechStackHistoryRepository, storage storage.Storage, assets asset.AssetService, iconFetcher *icons.Fetcher, publisher events.Publisher) TechStackService {
 	return &techStackService{
 		techStackRepo: techStackRepo,
+		historyRepo:   historyRepo,
 		storage:       storage,
+		assets:        assets,
+		icons:         iconFetcher,
+		publisher:     publisher,
 	}
 }
 
@@ -45,14 +87,24 @@ func (s *techStackService) CreateTechStack(ctx context.Context, techStackCreate
 	if err := validator.ValidateModel(techStackCreate); err != nil {
 		return nil, err
 	}
+	if err := validateProficiency(techStackCreate.ProficiencyLevel, techStackCreate.YearsOfExperience); err != nil {
+		return nil, err
+	}
+
+	logoPreference, err := asset.ParseLogoPreference(techStackCreate.LogoPreference, asset.LogoAuto)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now().UTC()
 	techStack := techStackCreate.ToTechStack()
 	techStack.ID = uuid.New()
+	techStack.LogoPreference = logoPreference
 	techStack.CreatedAt = &now
 	techStack.UpdatedAt = &now
 
 	// Upload image if provided
+	var logo []byte
 	if techStackCreate.Image != nil {
 		imageURL, err := s.uploadTechStackImage(ctx, techStack.ID.String(), techStackCreate.Image)
 		if err != nil {
@@ -63,6 +115,19 @@ func (s *techStackService) CreateTechStack(ctx context.Context, techStackCreate
 			)
 		}
 		techStack.ImageUrl = imageURL
+		logo, _ = storage.ReadUpload(techStackCreate.Image)
+	} else if s.icons != nil && !techStackCreate.SkipIconFetch {
+		imageURL, data, err := s.fetchTechStackIcon(ctx, techStack.ID.String(), techStack.Name, techStackCreate.IconSlug)
+		if err != nil {
+			// Log the error but don't return it; the icon can still be uploaded later
+			fmt.Printf("Failed to fetch tech stack icon: %v\n", err)
+		}
+		techStack.ImageUrl = imageURL
+		logo = data
+	}
+
+	if err := s.uploadLogoVariants(ctx, &techStack, logo, techStackCreate.ImageLight, techStackCreate.ImageDark); err != nil {
+		return nil, err
 	}
 
 	// Create tech stack in repository
@@ -75,6 +140,17 @@ func (s *techStackService) CreateTechStack(ctx context.Context, techStackCreate
 		)
 	}
 
+	if createdTechStack.ProficiencyLevel > 0 || createdTechStack.YearsOfExperience > 0 {
+		s.recordHistory(ctx, createdTechStack)
+	}
+
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.TechStackCreated,
+		Entity:   "tech_stack",
+		EntityID: createdTechStack.ID.String(),
+		Summary:  fmt.Sprintf("Added tech stack %s", createdTechStack.Name),
+	})
+
 	return createdTechStack, nil
 }
 
@@ -89,7 +165,12 @@ func (s *techStackService) GetTechStackByID(ctx context.Context, id string) (*Te
 	}
 
 	if len(techStacks) == 0 {
-		return nil, fmt.Errorf("tech stack with ID %s not found", id)
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Tech stack not found",
+			nil,
+			errors.WithContext("tech_stack_id", id),
+		)
 	}
 
 	return &techStacks[0], nil
@@ -105,12 +186,15 @@ func (s *techStackService) UpdateTechStack(ctx context.Context, techStackUpdate
 			errors.WithContext("payload", techStackUpdate),
 		)
 	}
+	if err := validateProficiency(techStackUpdate.ProficiencyLevel, techStackUpdate.YearsOfExperience); err != nil {
+		return nil, err
+	}
 
 	// Retrieve existing tech stack
 	existingTechStack, err := s.GetTechStackByID(ctx, techStackUpdate.ID.String())
 	if err != nil {
 		return nil, errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing tech stack",
 			errors.WithContext("tech_stack_id", techStackUpdate.ID),
 		)
@@ -134,8 +218,20 @@ func (s *techStackService) UpdateTechStack(ctx context.Context, techStackUpdate
 	if !validator.IsValueChanged(&existingTechStack.Role, &techStack.Role) {
 		techStack.Role = existingTechStack.Role
 	}
+	if techStack.ProficiencyLevel == 0 {
+		techStack.ProficiencyLevel = existingTechStack.ProficiencyLevel
+	}
+	if techStack.YearsOfExperience == 0 {
+		techStack.YearsOfExperience = existingTechStack.YearsOfExperience
+	}
+	if techStack.LogoPreference, err = asset.ParseLogoPreference(techStackUpdate.LogoPreference, existingTechStack.LogoPreference); err != nil {
+		return nil, err
+	}
 
 	// Upload image if provided
+	var logo []byte
+	techStack.ImageUrlLight = existingTechStack.ImageUrlLight
+	techStack.ImageUrlDark = existingTechStack.ImageUrlDark
 	if techStackUpdate.Image != nil {
 		imageURL, err := s.uploadTechStackImage(ctx, techStack.ID.String(), techStackUpdate.Image)
 		if err != nil {
@@ -146,10 +242,15 @@ func (s *techStackService) UpdateTechStack(ctx context.Context, techStackUpdate
 			)
 		}
 		techStack.ImageUrl = imageURL
+		logo, _ = storage.ReadUpload(techStackUpdate.Image)
 	} else {
 		techStack.ImageUrl = existingTechStack.ImageUrl
 	}
 
+	if err := s.uploadLogoVariants(ctx, &techStack, logo, techStackUpdate.ImageLight, techStackUpdate.ImageDark); err != nil {
+		return nil, err
+	}
+
 	// Update tech stack in repository
 	updatedTechStack, err := s.techStackRepo.Update(ctx, &techStack)
 	if err != nil {
@@ -160,10 +261,22 @@ func (s *techStackService) UpdateTechStack(ctx context.Context, techStackUpdate
 		)
 	}
 
+	if updatedTechStack.ProficiencyLevel != existingTechStack.ProficiencyLevel ||
+		updatedTechStack.YearsOfExperience != existingTechStack.YearsOfExperience {
+		s.recordHistory(ctx, updatedTechStack)
+	}
+
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.TechStackUpdated,
+		Entity:   "tech_stack",
+		EntityID: updatedTechStack.ID.String(),
+		Summary:  fmt.Sprintf("Updated tech stack %s", updatedTechStack.Name),
+	})
+
 	return updatedTechStack, nil
 }
 
-func (s *techStackService) DeleteTechStack(ctx context.Context, id string) error {
+func (s *techStackService) DeleteTechStack(ctx context.Context, id string, cascade bool) error {
 	if id == "" {
 		return errors.New(
 			errors.ErrValidation,
@@ -176,12 +289,17 @@ func (s *techStackService) DeleteTechStack(ctx context.Context, id string) error
 	existingTechStack, err := s.GetTechStackByID(ctx, id)
 	if err != nil {
 		return errors.Wrap(err,
-			errors.ErrDatabase,
+			errors.Preserve(err, errors.ErrDatabase, errors.ErrNotFound),
 			"Failed to retrieve existing tech stack",
 			errors.WithContext("tech_stack_id", id),
 		)
 	}
 
+	// Keep content from linking to a deleted tech stack
+	if err := s.checkReferences(ctx, id, cascade); err != nil {
+		return err
+	}
+
 	// Delete tech stack from repository
 	err = s.techStackRepo.Delete(ctx, id)
 	if err != nil {
@@ -194,14 +312,21 @@ func (s *techStackService) DeleteTechStack(ctx context.Context, id string) error
 
 	// Delete associated image if exists
 	if existingTechStack.ImageUrl != "" {
-		imagePath := filepath.Join("itsrama/images/tech_stack/", filepath.Base(existingTechStack.ImageUrl))
-		_, err = s.storage.Delete(ctx, imagePath)
+		imagePath := filepath.Join("itsrama", base.TenantStoragePath(ctx, "images/tech_stack"), filepath.Base(existingTechStack.ImageUrl))
+		err = s.storage.Delete(ctx, imagePath)
 		if err != nil {
 			// Log the error but don't return it to avoid blocking the deletion
 			fmt.Printf("Failed to delete tech stack image: %v\n", err)
 		}
 	}
 
+	s.publisher.Publish(ctx, events.Event{
+		Type:     events.TechStackDeleted,
+		Entity:   "tech_stack",
+		EntityID: id,
+		Summary:  fmt.Sprintf("Deleted tech stack %s", existingTechStack.Name),
+	})
+
 	return nil
 }
 
@@ -278,12 +403,12 @@ func (s *techStackService) BulkUpdateTechStacks(ctx context.Context, techStacksU
 	return techStacks, nil
 }
 
-func (s *techStackService) BulkDeleteTechStacks(ctx context.Context, ids []string) error {
+func (s *techStackService) BulkDeleteTechStacks(ctx context.Context, ids []string, cascade bool) error {
 	for _, id := range ids {
-		err := s.DeleteTechStack(ctx, id)
+		err := s.DeleteTechStack(ctx, id, cascade)
 		if err != nil {
 			return errors.Wrap(err,
-				errors.ErrDatabase,
+				errors.Preserve(err, errors.ErrDatabase, errors.ErrConflict),
 				"Failed to delete tech stack",
 				errors.WithContext("tech_stack_id", id),
 			)
@@ -292,6 +417,136 @@ func (s *techStackService) BulkDeleteTechStacks(ctx context.Context, ids []strin
 	return nil
 }
 
+func (s *techStackService) BulkCreateTechStacksPartial(ctx context.Context, techStacksCreate []*TechStackCreate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, techStackCreate := range techStacksCreate {
+		created, err := s.CreateTechStack(ctx, techStackCreate)
+		if err != nil {
+			result.Fail(i, "", err)
+			continue
+		}
+		result.Succeed(i, http.StatusCreated, created.ID.String())
+	}
+
+	return result
+}
+
+func (s *techStackService) BulkUpdateTechStacksPartial(ctx context.Context, techStacksUpdate []*TechStackUpdate) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, techStackUpdate := range techStacksUpdate {
+		updated, err := s.UpdateTechStack(ctx, techStackUpdate)
+		if err != nil {
+			result.Fail(i, techStackUpdate.ID.String(), err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, updated.ID.String())
+	}
+
+	return result
+}
+
+func (s *techStackService) BulkDeleteTechStacksPartial(ctx context.Context, ids []string, cascade bool) *base.BulkResult {
+	result := base.NewBulkResult()
+
+	for i, id := range ids {
+		if err := s.DeleteTechStack(ctx, id, cascade); err != nil {
+			result.Fail(i, id, err)
+			continue
+		}
+		result.Succeed(i, http.StatusOK, id)
+	}
+
+	return result
+}
+
+// GetProficiencyHistory returns the proficiency series of the given tech
+// stacks, or of every rated tech stack when none are given
+func (s *techStackService) GetProficiencyHistory(ctx context.Context, ids []string, since time.Time) ([]ProficiencySeries, error) {
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Invalid tech stack ID",
+				err,
+				errors.WithContext("tech_stack_id", id),
+			)
+		}
+	}
+
+	history, err := s.historyRepo.List(ctx, ids, since)
+	if err != nil {
+		return nil, err
+	}
+
+	series := []ProficiencySeries{}
+	index := make(map[uuid.UUID]int)
+	for _, h := range history {
+		i, ok := index[h.TechStackID]
+		if !ok {
+			techStack, err := s.GetTechStackByID(ctx, h.TechStackID.String())
+			if err != nil {
+				// The tech stack was deleted after the history was read
+				continue
+			}
+
+			i = len(series)
+			index[h.TechStackID] = i
+			series = append(series, ProficiencySeries{
+				TechStackID: techStack.ID,
+				Name:        techStack.Name,
+				Category:    techStack.Category,
+			})
+		}
+
+		series[i].Points = append(series[i].Points, ProficiencyPoint{
+			RecordedAt:        h.RecordedAt,
+			ProficiencyLevel:  h.ProficiencyLevel,
+			YearsOfExperience: h.YearsOfExperience,
+		})
+	}
+
+	return series, nil
+}
+
+// recordHistory stores a snapshot of the tech stack's current proficiency
+func (s *techStackService) recordHistory(ctx context.Context, techStack *TechStack) {
+	err := s.historyRepo.Create(ctx, &TechStackHistory{
+		ID:                uuid.New(),
+		TechStackID:       techStack.ID,
+		ProficiencyLevel:  techStack.ProficiencyLevel,
+		YearsOfExperience: techStack.YearsOfExperience,
+		RecordedAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		// Log the error but don't return it; the tech stack itself was saved
+		fmt.Printf("Failed to record tech stack history: %v\n", err)
+	}
+}
+
+// validateProficiency checks the proficiency level is 1-5 and the years of
+// experience are not negative; zero values mean not set
+func validateProficiency(level int, years float64) error {
+	if level < 0 || level > 5 {
+		return errors.New(
+			errors.ErrValidation,
+			"Proficiency level must be between 1 and 5",
+			nil,
+			errors.WithContext("proficiency_level", level),
+		)
+	}
+	if years < 0 {
+		return errors.New(
+			errors.ErrValidation,
+			"Years of experience cannot be negative",
+			nil,
+			errors.WithContext("years_of_experience", years),
+		)
+	}
+	return nil
+}
+
 func (s *techStackService) uploadTechStackImage(ctx context.Context, techStackID string, file *multipart.FileHeader) (string, error) {
 	if techStackID == "" {
 		return "", fmt.Errorf("tech stack ID cannot be empty")
@@ -300,9 +555,9 @@ func (s *techStackService) uploadTechStackImage(ctx context.Context, techStackID
 		return "", fmt.Errorf("file is required")
 	}
 
-	destPath := fmt.Sprintf("images/tech_stack/%s%s", techStackID, filepath.Ext(file.Filename))
+	destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/tech_stack/%s%s", techStackID, filepath.Ext(file.Filename)))
 
-	_, err := s.storage.Upload(ctx, file, destPath, storage_go.FileOptions{
+	uploaded, err := s.assets.Upload(ctx, file, destPath, storage.FileOptions{
 		ContentType: func(s string) *string { return &s }("image"),
 		Upsert:      func(b bool) *bool { return &b }(true),
 	})
@@ -314,14 +569,52 @@ func (s *techStackService) uploadTechStackImage(ctx context.Context, techStackID
 		)
 	}
 
-	signedURL, err := s.storage.GetPublicURL(destPath)
+	return uploaded.URL, nil
+}
+
+// fetchTechStackIcon stores the logo matching the tech stack name from the
+// configured icon sets and returns its URL and content, or an empty URL if
+// none matches
+func (s *techStackService) fetchTechStackIcon(ctx context.Context, techStackID, name, slug string) (string, []byte, error) {
+	data, err := s.icons.Fetch(ctx, name, slug)
+	if stderrors.Is(err, icons.ErrNotFound) {
+		return "", nil, nil
+	}
 	if err != nil {
-		return "", errors.Wrap(err,
+		return "", nil, err
+	}
+
+	destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/tech_stack/%s.svg", techStackID))
+
+	uploaded, err := s.assets.UploadBytes(ctx, data, destPath, "image/svg+xml")
+	if err != nil {
+		return "", nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to upload tech stack icon",
+			errors.WithContext("tech_stack_id", techStackID),
+		)
+	}
+
+	return uploaded.URL, data, nil
+}
+
+// uploadLogoVariants sets the light and dark logo variants of a tech stack
+// from its newly stored logo and the variants uploaded along with it
+func (s *techStackService) uploadLogoVariants(ctx context.Context, techStack *TechStack, logo []byte, light, dark *multipart.FileHeader) error {
+	variants, err := asset.UploadLogoVariants(ctx, s.assets,
+		base.TenantStoragePath(ctx, fmt.Sprintf("images/tech_stack/%s", techStack.ID)),
+		asset.LogoVariants{Light: techStack.ImageUrlLight, Dark: techStack.ImageUrlDark},
+		asset.LogoUpload{Original: logo, OriginalURL: techStack.ImageUrl, Light: light, Dark: dark},
+	)
+	if err != nil {
+		return errors.Wrap(err,
 			errors.ErrInternal,
-			"Failed to get public URL for tech stack image",
-			errors.WithContext("dest_path", destPath),
+			"Failed to upload tech stack logo variants",
+			errors.WithContext("tech_stack_id", techStack.ID),
 		)
 	}
 
-	return signedURL, nil
+	techStack.ImageUrlLight = variants.Light
+	techStack.ImageUrlDark = variants.Dark
+	return nil
 }
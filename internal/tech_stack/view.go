@@ -0,0 +1,60 @@
+package tech_stack
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+)
+
+// PublicTechStack is a tech stack as visitors see it
+// @Description Tech stack as shown publicly
+// @Name PublicTechStack
+type PublicTechStack struct {
+	ID                uuid.UUID            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name              string               `json:"name" example:"Go"`
+	Category          TechStackCategory    `json:"category" example:"Backend"`
+	Version           string               `json:"version" example:"1.20"`
+	Role              string               `json:"role" example:"Backend Development"`
+	IsCoreSkill       bool                 `json:"is_core_skill" example:"true"`
+	ImageUrl          string               `json:"image_url" example:"https://example.com/go-logo.png"`
+	ImageUrlLight     string               `json:"image_url_light,omitempty" example:"https://example.com/go-logo.png"`
+	ImageUrlDark      string               `json:"image_url_dark,omitempty" example:"https://example.com/go-logo-dark.png"`
+	LogoPreference    asset.LogoPreference `json:"logo_preference,omitempty" enums:"auto,light,dark" example:"auto"`
+	ProficiencyLevel  int                  `json:"proficiency_level,omitempty" example:"4"`
+	YearsOfExperience float64              `json:"years_of_experience,omitempty" example:"3.5"`
+	EndorsementCount  int                  `json:"endorsement_count,omitempty" example:"12"`
+	CreatedAt         *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time           `json:"updated_at,omitempty"`
+}
+
+// Public maps a tech stack to what visitors see
+func (t TechStack) Public() PublicTechStack {
+	return PublicTechStack{
+		ID:                t.ID,
+		Name:              t.Name,
+		Category:          t.Category,
+		Version:           t.Version,
+		Role:              t.Role,
+		IsCoreSkill:       t.IsCoreSkill,
+		ImageUrl:          t.ImageUrl,
+		ImageUrlLight:     t.ImageUrlLight,
+		ImageUrlDark:      t.ImageUrlDark,
+		LogoPreference:    t.LogoPreference,
+		ProficiencyLevel:  t.ProficiencyLevel,
+		YearsOfExperience: t.YearsOfExperience,
+		EndorsementCount:  t.EndorsementCount,
+		CreatedAt:         t.CreatedAt,
+		UpdatedAt:         t.UpdatedAt,
+	}
+}
+
+// PublicView and AdminView render tech stacks in responses, see
+// response.View
+func (t TechStack) PublicView() interface{} {
+	return t.Public()
+}
+
+func (t TechStack) AdminView() interface{} {
+	return t
+}
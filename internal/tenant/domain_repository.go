@@ -0,0 +1,170 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type TenantDomainRepository interface {
+	base.BaseRepository[TenantDomain, TenantDomain]
+}
+
+type tenantDomainRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewTenantDomainRepository(supabaseClient *supabase.SupabaseClient) TenantDomainRepository {
+	return &tenantDomainRepository{
+		supabaseClient: supabaseClient,
+		table:          "tenant_domain",
+	}
+}
+
+func (r *tenantDomainRepository) Create(ctx context.Context, domain *TenantDomain) (*TenantDomain, error) {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(domain, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create tenant domain")
+	}
+	return domain, nil
+}
+
+func (r *tenantDomainRepository) Update(ctx context.Context, domain *TenantDomain) (*TenantDomain, error) {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(domain, "minimal", "").
+		Eq("id", domain.ID.String()).
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update tenant domain")
+	}
+	return domain, nil
+}
+
+func (r *tenantDomainRepository) Delete(ctx context.Context, id string) error {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id).
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete tenant domain")
+	}
+	return nil
+}
+
+func (r *tenantDomainRepository) List(ctx context.Context, opts base.ListOptions) ([]TenantDomain, error) {
+	var domains []TenantDomain
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("domain.ilike.%%%s%%", opts.Search),
+			"",
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&domains)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list tenant domains")
+	}
+
+	return domains, nil
+}
+
+func (r *tenantDomainRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tenant domains")
+	}
+
+	return int(count), nil
+}
+
+func (r *tenantDomainRepository) Exists(ctx context.Context, id string) (bool, error) {
+	_, count, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("id", id).
+		Limit(1, "").
+		Execute()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check tenant domain existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *tenantDomainRepository) FindByField(ctx context.Context, field string, value interface{}) ([]TenantDomain, error) {
+	var domains []TenantDomain
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value)).
+		ExecuteTo(&domains)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find tenants by field")
+	}
+	return domains, nil
+}
+
+func (r *tenantDomainRepository) Search(ctx context.Context, opts base.ListOptions) ([]TenantDomain, int, error) {
+	domains, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search tenant domains")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tenant domains")
+	}
+
+	return domains, count, nil
+}
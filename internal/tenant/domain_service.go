@@ -0,0 +1,250 @@
+package tenant
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// domainPattern matches fully qualified hostnames without a port
+var domainPattern = regexp.MustCompile(`^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// verificationValuePrefix prefixes the token published in the TXT record
+const verificationValuePrefix = "itsrama-verification="
+
+type TenantDomainService interface {
+	AddDomain(ctx context.Context, domainCreate *TenantDomainCreate) (*TenantDomain, error)
+	GetDomainByID(ctx context.Context, tenantID, id string) (*TenantDomain, error)
+	GetVerifiedDomain(ctx context.Context, host string) (*TenantDomain, error)
+	VerifyDomain(ctx context.Context, tenantID, id string) (*TenantDomain, error)
+	RemoveDomain(ctx context.Context, tenantID, id string) error
+	ListDomains(ctx context.Context, tenantID string) ([]TenantDomain, error)
+	Challenge(domain *TenantDomain) DomainChallenge
+}
+
+type tenantDomainService struct {
+	domainRepo    TenantDomainRepository
+	tenantService TenantService
+	recordPrefix  string
+	lookupTXT     func(ctx context.Context, name string) ([]string, error)
+}
+
+func NewTenantDomainService(domainRepo TenantDomainRepository, tenantService TenantService, recordPrefix string) TenantDomainService {
+	if recordPrefix == "" {
+		recordPrefix = "_itsrama-verify"
+	}
+
+	return &tenantDomainService{
+		domainRepo:    domainRepo,
+		tenantService: tenantService,
+		recordPrefix:  recordPrefix,
+		lookupTXT:     net.DefaultResolver.LookupTXT,
+	}
+}
+
+func (s *tenantDomainService) AddDomain(ctx context.Context, domainCreate *TenantDomainCreate) (*TenantDomain, error) {
+	// Validate input
+	if err := validator.ValidateModel(domainCreate); err != nil {
+		return nil, err
+	}
+
+	domainCreate.Domain = NormalizeHost(domainCreate.Domain)
+	if !domainPattern.MatchString(domainCreate.Domain) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Domain must be a fully qualified hostname",
+			nil,
+			errors.WithContext("domain", domainCreate.Domain),
+		)
+	}
+
+	// Ensure the tenant exists
+	if _, err := s.tenantService.GetTenantByID(ctx, domainCreate.TenantID.String()); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.domainRepo.FindByField(ctx, "domain", domainCreate.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Domain is already registered",
+			nil,
+			errors.WithContext("domain", domainCreate.Domain),
+		)
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to generate verification token",
+		)
+	}
+
+	domain := domainCreate.ToTenantDomain(token)
+
+	createdDomain, err := s.domainRepo.Create(ctx, &domain)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to register domain",
+			errors.WithContext("domain", domain.Domain),
+		)
+	}
+
+	return createdDomain, nil
+}
+
+func (s *tenantDomainService) GetDomainByID(ctx context.Context, tenantID, id string) (*TenantDomain, error) {
+	domains, err := s.domainRepo.FindByField(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(domains) == 0 || domains[0].TenantID.String() != tenantID {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Domain not found",
+			nil,
+			errors.WithContext("domain_id", id),
+		)
+	}
+
+	return &domains[0], nil
+}
+
+// GetVerifiedDomain returns the verified domain matching host, or nil if none
+func (s *tenantDomainService) GetVerifiedDomain(ctx context.Context, host string) (*TenantDomain, error) {
+	domains, err := s.domainRepo.FindByField(ctx, "domain", NormalizeHost(host))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range domains {
+		if domains[i].IsVerified {
+			return &domains[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *tenantDomainService) VerifyDomain(ctx context.Context, tenantID, id string) (*TenantDomain, error) {
+	domain, err := s.GetDomainByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := s.Challenge(domain)
+
+	records, err := s.lookupTXT(ctx, challenge.RecordName)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrBadRequest,
+			"Failed to look up DNS TXT record",
+			errors.WithContext("record_name", challenge.RecordName),
+		)
+	}
+
+	verified := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == challenge.RecordValue {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return nil, errors.New(
+			errors.ErrBadRequest,
+			"DNS TXT record does not contain the verification token",
+			nil,
+			errors.WithContext("record_name", challenge.RecordName),
+			errors.WithContext("record_value", challenge.RecordValue),
+		)
+	}
+
+	now := time.Now().UTC()
+	domain.IsVerified = true
+	domain.VerifiedAt = &now
+	domain.UpdatedAt = &now
+
+	updatedDomain, err := s.domainRepo.Update(ctx, domain)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to mark domain as verified",
+			errors.WithContext("domain", domain.Domain),
+		)
+	}
+
+	return updatedDomain, nil
+}
+
+func (s *tenantDomainService) RemoveDomain(ctx context.Context, tenantID, id string) error {
+	if _, err := s.GetDomainByID(ctx, tenantID, id); err != nil {
+		return err
+	}
+
+	if err := s.domainRepo.Delete(ctx, id); err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to remove domain",
+			errors.WithContext("domain_id", id),
+		)
+	}
+
+	return nil
+}
+
+func (s *tenantDomainService) ListDomains(ctx context.Context, tenantID string) ([]TenantDomain, error) {
+	return s.domainRepo.List(ctx, base.ListOptions{
+		Page:      1,
+		PerPage:   100,
+		SortBy:    "created_at",
+		SortOrder: base.SortAscending,
+		Filters: []base.FilterOption{
+			{Field: "tenant_id", Operator: base.OperatorEqual, Value: tenantID},
+		},
+	})
+}
+
+// Challenge describes the TXT record that proves ownership of the domain
+func (s *tenantDomainService) Challenge(domain *TenantDomain) DomainChallenge {
+	return DomainChallenge{
+		Domain:      domain.Domain,
+		RecordType:  "TXT",
+		RecordName:  s.recordPrefix + "." + domain.Domain,
+		RecordValue: verificationValuePrefix + domain.VerificationToken,
+		IsVerified:  domain.IsVerified,
+	}
+}
+
+// NormalizeHost lowercases a host and strips its port and trailing dot
+func NormalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// generateVerificationToken returns a random hex token
+func generateVerificationToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,352 @@
+package tenant
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type TenantHandler struct {
+	base.BaseHandler
+	tenantService TenantService
+	domainService TenantDomainService
+	resolver      *Resolver
+}
+
+func NewTenantHandler(tenantService TenantService, domainService TenantDomainService, resolver *Resolver, logger *logger.Logger) *TenantHandler {
+	return &TenantHandler{
+		BaseHandler:   *base.NewBaseHandler(logger),
+		tenantService: tenantService,
+		domainService: domainService,
+		resolver:      resolver,
+	}
+}
+
+// CreateTenant creates a new tenant
+// @Summary Create a new tenant
+// @Description Create a new portfolio tenant
+// @Tags Tenants
+// @Accept json
+// @Produce json
+// @Param tenant body TenantCreate true "Tenant Details"
+// @Success 200 {object} response.APIResponse{data=Tenant} "Tenant created successfully"
+// @Failure 400 {object} response.APIResponse{data=TenantCreate} "Bad Request"
+// @Failure 409 {object} response.APIResponse "Tenant slug already exists"
+// @Router /tenants [post]
+func (h *TenantHandler) CreateTenant(c *gin.Context) {
+	var tenantInput TenantCreate
+
+	if err := h.ValidateRequest(c, &tenantInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	tenant, err := h.tenantService.CreateTenant(c.Request.Context(), &tenantInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.resolver.Invalidate()
+
+	h.HandleSuccess(c, tenant, "Tenant created successfully")
+}
+
+// GetTenantByID retrieves a specific tenant
+// @Summary Get a tenant by ID
+// @Description Retrieve a specific tenant using its unique identifier
+// @Tags Tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} response.APIResponse{data=Tenant} "Tenant retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Tenant not found"
+// @Router /tenants/{id} [get]
+func (h *TenantHandler) GetTenantByID(c *gin.Context) {
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	tenant, err := h.tenantService.GetTenantByID(c.Request.Context(), tenantID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, tenant, "Tenant retrieved successfully")
+}
+
+// UpdateTenant updates an existing tenant
+// @Summary Update a tenant
+// @Description Update an existing tenant's name, storage folder or status
+// @Tags Tenants
+// @Accept json
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param tenant body TenantUpdate true "Tenant Update Details"
+// @Success 200 {object} response.APIResponse{data=Tenant} "Tenant updated successfully"
+// @Failure 400 {object} response.APIResponse{data=TenantUpdate} "Bad Request"
+// @Failure 404 {object} response.APIResponse "Tenant not found"
+// @Router /tenants/{id} [put]
+func (h *TenantHandler) UpdateTenant(c *gin.Context) {
+	var tenantInput TenantUpdate
+
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.BindJSON(c, &tenantInput); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid input",
+			err,
+		))
+		return
+	}
+
+	// Set the ID from path
+	tenantInput.ID = tenantID
+
+	updatedTenant, err := h.tenantService.UpdateTenant(c.Request.Context(), &tenantInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.resolver.Invalidate()
+
+	h.HandleSuccess(c, updatedTenant, "Tenant updated successfully")
+}
+
+// DeleteTenant deletes an existing tenant
+// @Summary Delete a tenant
+// @Description Delete a tenant and all of its content
+// @Tags Tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} response.APIResponse "Tenant deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Tenant not found"
+// @Failure 409 {object} response.APIResponse "The default tenant cannot be deleted"
+// @Router /tenants/{id} [delete]
+func (h *TenantHandler) DeleteTenant(c *gin.Context) {
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.tenantService.DeleteTenant(c.Request.Context(), tenantID.String()); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.resolver.Invalidate()
+
+	h.HandleSuccess(c, nil, "Tenant deleted successfully")
+}
+
+// ListTenants retrieves a paginated list of tenants
+// @Summary List tenants
+// @Description Retrieve a paginated list of tenants
+// @Tags Tenants
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param search query string false "Search by slug or name"
+// @Success 200 {object} response.APIResponse{data=[]Tenant} "Tenants retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /tenants [get]
+func (h *TenantHandler) ListTenants(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	tenants, err := h.tenantService.ListTenants(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	total, err := h.tenantService.CountTenants(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, tenants, "Tenants retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
+
+// GetCurrentTenant retrieves the tenant resolved for the request
+// @Summary Get the current tenant
+// @Description Retrieve the tenant resolved from the request host or tenant header
+// @Tags Tenants
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=Tenant} "Tenant retrieved successfully"
+// @Failure 404 {object} response.APIResponse "Tenant not found"
+// @Router /tenants/current [get]
+func (h *TenantHandler) GetCurrentTenant(c *gin.Context) {
+	scope, ok := base.TenantFromContext(c.Request.Context())
+	if !ok {
+		h.HandleError(c, errors.New(
+			errors.ErrNotFound,
+			"Tenant not found",
+			nil,
+		))
+		return
+	}
+
+	tenant, err := h.tenantService.GetTenantByID(c.Request.Context(), scope.ID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, tenant, "Tenant retrieved successfully")
+}
+
+// AddDomain registers a custom domain for a tenant
+// @Summary Register a custom domain
+// @Description Register a custom domain for a tenant and return the DNS TXT challenge proving ownership
+// @Tags Tenants
+// @Accept json
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param domain body TenantDomainCreate true "Domain Details"
+// @Success 200 {object} response.APIResponse{data=DomainChallenge} "Domain registered successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Tenant not found"
+// @Failure 409 {object} response.APIResponse "Domain is already registered"
+// @Router /tenants/{id}/domains [post]
+func (h *TenantHandler) AddDomain(c *gin.Context) {
+	var domainInput TenantDomainCreate
+
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.ValidateRequest(c, &domainInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Set the tenant ID from path
+	domainInput.TenantID = tenantID
+
+	domain, err := h.domainService.AddDomain(c.Request.Context(), &domainInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, h.domainService.Challenge(domain), "Domain registered successfully")
+}
+
+// ListDomains retrieves the custom domains of a tenant
+// @Summary List custom domains
+// @Description Retrieve the custom domains registered for a tenant
+// @Tags Tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} response.APIResponse{data=[]TenantDomain} "Domains retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /tenants/{id}/domains [get]
+func (h *TenantHandler) ListDomains(c *gin.Context) {
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	domains, err := h.domainService.ListDomains(c.Request.Context(), tenantID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, domains, "Domains retrieved successfully")
+}
+
+// VerifyDomain checks the DNS TXT challenge of a custom domain
+// @Summary Verify a custom domain
+// @Description Look up the DNS TXT challenge record and mark the domain as verified when it matches
+// @Tags Tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param domainId path string true "Domain ID"
+// @Success 200 {object} response.APIResponse{data=TenantDomain} "Domain verified successfully"
+// @Failure 400 {object} response.APIResponse "DNS TXT record does not contain the verification token"
+// @Failure 404 {object} response.APIResponse "Domain not found"
+// @Router /tenants/{id}/domains/{domainId}/verify [post]
+func (h *TenantHandler) VerifyDomain(c *gin.Context) {
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	domainID, err := h.ValidateUUID(c.Param("domainId"), "domain ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	domain, err := h.domainService.VerifyDomain(c.Request.Context(), tenantID.String(), domainID.String())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.resolver.Invalidate()
+
+	h.HandleSuccess(c, domain, "Domain verified successfully")
+}
+
+// RemoveDomain removes a custom domain from a tenant
+// @Summary Remove a custom domain
+// @Description Remove a custom domain from a tenant
+// @Tags Tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param domainId path string true "Domain ID"
+// @Success 200 {object} response.APIResponse "Domain removed successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Domain not found"
+// @Router /tenants/{id}/domains/{domainId} [delete]
+func (h *TenantHandler) RemoveDomain(c *gin.Context) {
+	tenantID, err := h.ValidateUUID(c.Param("id"), "tenant ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	domainID, err := h.ValidateUUID(c.Param("domainId"), "domain ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.domainService.RemoveDomain(c.Request.Context(), tenantID.String(), domainID.String()); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.resolver.Invalidate()
+
+	h.HandleSuccess(c, nil, "Domain removed successfully")
+}
@@ -0,0 +1,23 @@
+package tenant
+
+import "github.com/holycann/itsrama-portfolio-backend/internal/base"
+
+// NewMemoryTenantRepository creates a tenant repository kept in memory,
+// for development without a database
+func NewMemoryTenantRepository(tenants []Tenant) TenantRepository {
+	return base.NewMemoryRepository(tenants,
+		func(t *Tenant) string { return t.ID.String() },
+		func(t Tenant) Tenant { return t },
+		"slug", "name",
+	)
+}
+
+// NewMemoryTenantDomainRepository creates a tenant domain repository kept
+// in memory, for development without a database
+func NewMemoryTenantDomainRepository(domains []TenantDomain) TenantDomainRepository {
+	return base.NewMemoryRepository(domains,
+		func(d *TenantDomain) string { return d.ID.String() },
+		func(d TenantDomain) TenantDomain { return d },
+		"domain",
+	)
+}
@@ -0,0 +1,125 @@
+package tenant
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+)
+
+// Tenant represents a portfolio site served by the backend
+// @Description Portfolio site (tenant) hosted by the backend
+// @Name Tenant
+type Tenant struct {
+	ID            uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Slug          string     `json:"slug" db:"slug" validate:"required" example:"itsrama"`
+	Name          string     `json:"name" db:"name" validate:"required" example:"Itsrama"`
+	StorageFolder string     `json:"storage_folder,omitempty" db:"storage_folder" example:"tenants/itsrama"`
+	IsActive      bool       `json:"is_active" db:"is_active" example:"true"`
+	IsDefault     bool       `json:"is_default" db:"is_default" example:"false"`
+	CreatedAt     *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// TenantCreate represents the input for creating a new tenant
+// @Name TenantCreate
+type TenantCreate struct {
+	Slug          string `json:"slug" validate:"required,max=100" example:"friend-portfolio"`
+	Name          string `json:"name" validate:"required" example:"Friend Portfolio"`
+	StorageFolder string `json:"storage_folder" example:"tenants/friend-portfolio"`
+	IsActive      bool   `json:"is_active" example:"true"`
+}
+
+// TenantUpdate represents the input for updating an existing tenant
+// @Name TenantUpdate
+type TenantUpdate struct {
+	ID            uuid.UUID `json:"id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name          string    `json:"name" example:"Friend Portfolio"`
+	StorageFolder string    `json:"storage_folder" example:"tenants/friend-portfolio"`
+	IsActive      bool      `json:"is_active" example:"true"`
+}
+
+// ToTenant converts TenantCreate to Tenant
+func (tc *TenantCreate) ToTenant() Tenant {
+	now := time.Now().UTC()
+
+	storageFolder := tc.StorageFolder
+	if storageFolder == "" {
+		storageFolder = "tenants/" + tc.Slug
+	}
+
+	return Tenant{
+		ID:            uuid.New(),
+		Slug:          tc.Slug,
+		Name:          tc.Name,
+		StorageFolder: storageFolder,
+		IsActive:      tc.IsActive,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+}
+
+// ToTenant converts TenantUpdate to Tenant
+func (tu *TenantUpdate) ToTenant() Tenant {
+	now := time.Now().UTC()
+	return Tenant{
+		ID:            tu.ID,
+		Name:          tu.Name,
+		StorageFolder: tu.StorageFolder,
+		IsActive:      tu.IsActive,
+		UpdatedAt:     &now,
+	}
+}
+
+// Scope converts the tenant into the scope carried by request contexts
+func (t *Tenant) Scope() base.TenantScope {
+	return base.TenantScope{
+		ID:            t.ID,
+		Slug:          t.Slug,
+		StorageFolder: t.StorageFolder,
+	}
+}
+
+// TenantDomain represents a custom domain mapped to a tenant
+// @Description Custom domain serving a tenant's portfolio
+// @Name TenantDomain
+type TenantDomain struct {
+	ID                uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID          uuid.UUID  `json:"tenant_id" db:"tenant_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Domain            string     `json:"domain" db:"domain" validate:"required" example:"portfolio.example.com"`
+	VerificationToken string     `json:"verification_token" db:"verification_token" example:"3f1c9a0b7e2d4c6a8b5e1f0d2c4a6b8e"`
+	IsVerified        bool       `json:"is_verified" db:"is_verified" example:"false"`
+	VerifiedAt        *time.Time `json:"verified_at,omitempty" db:"verified_at"`
+	CreatedAt         *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// TenantDomainCreate represents the input for registering a custom domain
+// @Name TenantDomainCreate
+type TenantDomainCreate struct {
+	TenantID uuid.UUID `json:"-" swaggerignore:"true"`
+	Domain   string    `json:"domain" validate:"required,max=253" example:"portfolio.example.com"`
+}
+
+// DomainChallenge describes the DNS record proving ownership of a domain
+// @Name DomainChallenge
+type DomainChallenge struct {
+	Domain      string `json:"domain" example:"portfolio.example.com"`
+	RecordType  string `json:"record_type" example:"TXT"`
+	RecordName  string `json:"record_name" example:"_itsrama-verify.portfolio.example.com"`
+	RecordValue string `json:"record_value" example:"itsrama-verification=3f1c9a0b7e2d4c6a8b5e1f0d2c4a6b8e"`
+	IsVerified  bool   `json:"is_verified" example:"false"`
+}
+
+// ToTenantDomain converts TenantDomainCreate to TenantDomain
+func (dc *TenantDomainCreate) ToTenantDomain(verificationToken string) TenantDomain {
+	now := time.Now().UTC()
+	return TenantDomain{
+		ID:                uuid.New(),
+		TenantID:          dc.TenantID,
+		Domain:            dc.Domain,
+		VerificationToken: verificationToken,
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
+	}
+}
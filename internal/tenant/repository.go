@@ -0,0 +1,170 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type TenantRepository interface {
+	base.BaseRepository[Tenant, Tenant]
+}
+
+type tenantRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewTenantRepository(supabaseClient *supabase.SupabaseClient) TenantRepository {
+	return &tenantRepository{
+		supabaseClient: supabaseClient,
+		table:          "tenant",
+	}
+}
+
+func (r *tenantRepository) Create(ctx context.Context, tenant *Tenant) (*Tenant, error) {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(tenant, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create tenant")
+	}
+	return tenant, nil
+}
+
+func (r *tenantRepository) Update(ctx context.Context, tenant *Tenant) (*Tenant, error) {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(tenant, "minimal", "").
+		Eq("id", tenant.ID.String()).
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update tenant")
+	}
+	return tenant, nil
+}
+
+func (r *tenantRepository) Delete(ctx context.Context, id string) error {
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id).
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete tenant")
+	}
+	return nil
+}
+
+func (r *tenantRepository) List(ctx context.Context, opts base.ListOptions) ([]Tenant, error) {
+	var tenants []Tenant
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply search if provided
+	if opts.Search != "" {
+		query = query.Or(
+			fmt.Sprintf("slug.ilike.%%%s%%,name.ilike.%%%s%%", opts.Search, opts.Search),
+			"",
+		)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		ascending := opts.SortOrder == base.SortAscending
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: ascending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&tenants)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list tenants")
+	}
+
+	return tenants, nil
+}
+
+func (r *tenantRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tenants")
+	}
+
+	return int(count), nil
+}
+
+func (r *tenantRepository) Exists(ctx context.Context, id string) (bool, error) {
+	_, count, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true).
+		Eq("id", id).
+		Limit(1, "").
+		Execute()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.ErrDatabase, "failed to check tenant existence")
+	}
+
+	return count > 0, nil
+}
+
+func (r *tenantRepository) FindByField(ctx context.Context, field string, value interface{}) ([]Tenant, error) {
+	var tenants []Tenant
+	_, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq(field, fmt.Sprintf("%v", value)).
+		ExecuteTo(&tenants)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find tenants by field")
+	}
+	return tenants, nil
+}
+
+func (r *tenantRepository) Search(ctx context.Context, opts base.ListOptions) ([]Tenant, int, error) {
+	tenants, err := r.List(ctx, opts)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to search tenants")
+	}
+
+	// Count total results
+	count, err := r.Count(ctx, opts.Filters)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrDatabase, "failed to count tenants")
+	}
+
+	return tenants, count, nil
+}
@@ -0,0 +1,242 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// defaultTenantKey is the cache key used for the default tenant
+const defaultTenantKey = "__default__"
+
+// domainCacheSize bounds the hosts kept in the custom domain cache, which
+// any client can grow by sending arbitrary Host headers
+const domainCacheSize = 1024
+
+// cachedTenant is a resolved tenant with its cache expiry
+type cachedTenant struct {
+	tenant    *Tenant
+	expiresAt time.Time
+}
+
+// Resolver resolves the tenant of incoming requests from a header, a verified
+// custom domain or a subdomain, falling back to the default tenant
+type Resolver struct {
+	tenantService TenantService
+	domainService TenantDomainService
+	header        string
+	baseDomain    string
+	ttl           time.Duration
+
+	mu          sync.RWMutex
+	cache       map[string]cachedTenant
+	domainCache map[string]cachedTenant
+}
+
+// NewResolver creates a new tenant resolver
+func NewResolver(tenantService TenantService, domainService TenantDomainService, header, baseDomain string, ttl time.Duration) *Resolver {
+	if header == "" {
+		header = "X-Tenant"
+	}
+
+	return &Resolver{
+		tenantService: tenantService,
+		domainService: domainService,
+		header:        header,
+		baseDomain:    strings.ToLower(strings.TrimPrefix(baseDomain, ".")),
+		ttl:           ttl,
+		cache:         make(map[string]cachedTenant),
+		domainCache:   make(map[string]cachedTenant),
+	}
+}
+
+// Middleware resolves the tenant and scopes the request context to it
+func (r *Resolver) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant, err := r.Resolve(c.Request.Context(), c.GetHeader(r.header), c.Request.Host)
+		if err != nil {
+			customErr, ok := err.(*errors.CustomError)
+			if !ok {
+				customErr = errors.Wrap(err, errors.ErrInternal, "Failed to resolve tenant")
+			}
+			response.Error(c, customErr)
+			c.Abort()
+			return
+		}
+
+		if !tenant.IsActive {
+			response.NotFound(c, "tenant_inactive", "Tenant is not active", tenant.Slug)
+			c.Abort()
+			return
+		}
+
+		c.Set("tenant_id", tenant.ID.String())
+		c.Set("tenant_slug", tenant.Slug)
+		c.Request = c.Request.WithContext(base.WithTenant(c.Request.Context(), tenant.Scope()))
+
+		c.Next()
+	}
+}
+
+// Resolve returns the tenant identified by the header slug, custom domain,
+// subdomain or the default tenant, in that order
+func (r *Resolver) Resolve(ctx context.Context, headerSlug, host string) (*Tenant, error) {
+	if slug := strings.TrimSpace(strings.ToLower(headerSlug)); slug != "" {
+		return r.lookup(ctx, slug, func() (*Tenant, error) {
+			return r.tenantService.GetTenantBySlug(ctx, slug)
+		})
+	}
+
+	tenant, err := r.lookupDomain(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if tenant != nil {
+		return tenant, nil
+	}
+
+	if slug := r.subdomainSlug(host); slug != "" {
+		return r.lookup(ctx, slug, func() (*Tenant, error) {
+			return r.tenantService.GetTenantBySlug(ctx, slug)
+		})
+	}
+
+	return r.lookup(ctx, defaultTenantKey, func() (*Tenant, error) {
+		return r.tenantService.GetDefaultTenant(ctx)
+	})
+}
+
+// Invalidate clears all cached tenants
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache = make(map[string]cachedTenant)
+	r.domainCache = make(map[string]cachedTenant)
+}
+
+// lookup returns a cached tenant or loads and caches it
+func (r *Resolver) lookup(ctx context.Context, key string, load func() (*Tenant, error)) (*Tenant, error) {
+	r.mu.RLock()
+	cached, ok := r.cache[key]
+	r.mu.RUnlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.tenant, nil
+	}
+
+	tenant, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cache[key] = cachedTenant{
+		tenant:    tenant,
+		expiresAt: time.Now().Add(r.ttl),
+	}
+	r.mu.Unlock()
+
+	return tenant, nil
+}
+
+// lookupDomain returns the tenant owning a verified custom domain. Hosts
+// without a verified domain are cached as misses so they skip the database;
+// the base domain and its subdomains are never custom domains and are not
+// looked up at all.
+func (r *Resolver) lookupDomain(ctx context.Context, host string) (*Tenant, error) {
+	hostname := NormalizeHost(host)
+	if hostname == "" || r.domainService == nil || r.isBaseDomain(hostname) {
+		return nil, nil
+	}
+
+	r.mu.RLock()
+	cached, ok := r.domainCache[hostname]
+	r.mu.RUnlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.tenant, nil
+	}
+
+	var tenant *Tenant
+	domain, err := r.domainService.GetVerifiedDomain(ctx, hostname)
+	if err != nil {
+		return nil, err
+	}
+	if domain != nil {
+		tenant, err = r.tenantService.GetTenantByID(ctx, domain.TenantID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	r.evictDomains()
+	r.domainCache[hostname] = cachedTenant{
+		tenant:    tenant,
+		expiresAt: time.Now().Add(r.ttl),
+	}
+	r.mu.Unlock()
+
+	return tenant, nil
+}
+
+// evictDomains makes room in a full domain cache by dropping expired hosts,
+// then the host closest to expiring. The caller holds the lock.
+func (r *Resolver) evictDomains() {
+	if len(r.domainCache) < domainCacheSize {
+		return
+	}
+
+	now := time.Now()
+	for hostname, cached := range r.domainCache {
+		if !now.Before(cached.expiresAt) {
+			delete(r.domainCache, hostname)
+		}
+	}
+
+	for len(r.domainCache) >= domainCacheSize {
+		var oldest string
+		var oldestAt time.Time
+		for hostname, cached := range r.domainCache {
+			if oldest == "" || cached.expiresAt.Before(oldestAt) {
+				oldest, oldestAt = hostname, cached.expiresAt
+			}
+		}
+		delete(r.domainCache, oldest)
+	}
+}
+
+// isBaseDomain reports whether a normalized host is the base domain or one
+// of its subdomains
+func (r *Resolver) isBaseDomain(hostname string) bool {
+	if r.baseDomain == "" {
+		return false
+	}
+	return hostname == r.baseDomain || strings.HasSuffix(hostname, "."+r.baseDomain)
+}
+
+// subdomainSlug extracts the tenant slug from a subdomain of the base domain
+func (r *Resolver) subdomainSlug(host string) string {
+	if r.baseDomain == "" || host == "" {
+		return ""
+	}
+
+	hostname := NormalizeHost(host)
+	if !strings.HasSuffix(hostname, "."+r.baseDomain) {
+		return ""
+	}
+
+	slug := strings.TrimSuffix(hostname, "."+r.baseDomain)
+	if strings.Contains(slug, ".") || slug == "www" {
+		return ""
+	}
+
+	return slug
+}
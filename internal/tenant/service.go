@@ -0,0 +1,193 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+)
+
+// slugPattern restricts tenant slugs to values safe for hostnames and storage paths
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+type TenantService interface {
+	CreateTenant(ctx context.Context, tenantCreate *TenantCreate) (*Tenant, error)
+	GetTenantByID(ctx context.Context, id string) (*Tenant, error)
+	GetTenantBySlug(ctx context.Context, slug string) (*Tenant, error)
+	GetDefaultTenant(ctx context.Context) (*Tenant, error)
+	UpdateTenant(ctx context.Context, tenantUpdate *TenantUpdate) (*Tenant, error)
+	DeleteTenant(ctx context.Context, id string) error
+	ListTenants(ctx context.Context, opts base.ListOptions) ([]Tenant, error)
+	CountTenants(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type tenantService struct {
+	tenantRepo TenantRepository
+}
+
+func NewTenantService(tenantRepo TenantRepository) TenantService {
+	return &tenantService{
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (s *tenantService) CreateTenant(ctx context.Context, tenantCreate *TenantCreate) (*Tenant, error) {
+	// Validate input
+	if err := validator.ValidateModel(tenantCreate); err != nil {
+		return nil, err
+	}
+
+	if !slugPattern.MatchString(tenantCreate.Slug) {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Tenant slug must contain only lowercase letters, numbers and dashes",
+			nil,
+			errors.WithContext("slug", tenantCreate.Slug),
+		)
+	}
+
+	existing, err := s.tenantRepo.FindByField(ctx, "slug", tenantCreate.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, errors.New(
+			errors.ErrConflict,
+			"Tenant slug already exists",
+			nil,
+			errors.WithContext("slug", tenantCreate.Slug),
+		)
+	}
+
+	tenant := tenantCreate.ToTenant()
+
+	createdTenant, err := s.tenantRepo.Create(ctx, &tenant)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create tenant",
+			errors.WithContext("tenant_slug", tenant.Slug),
+		)
+	}
+
+	return createdTenant, nil
+}
+
+func (s *tenantService) GetTenantByID(ctx context.Context, id string) (*Tenant, error) {
+	return s.findOne(ctx, "id", id)
+}
+
+func (s *tenantService) GetTenantBySlug(ctx context.Context, slug string) (*Tenant, error) {
+	return s.findOne(ctx, "slug", slug)
+}
+
+func (s *tenantService) GetDefaultTenant(ctx context.Context) (*Tenant, error) {
+	return s.findOne(ctx, "is_default", true)
+}
+
+func (s *tenantService) UpdateTenant(ctx context.Context, tenantUpdate *TenantUpdate) (*Tenant, error) {
+	// Validate input
+	if err := validator.ValidateModel(tenantUpdate); err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Invalid tenant payload",
+			err,
+			errors.WithContext("payload", tenantUpdate),
+		)
+	}
+
+	existingTenant, err := s.GetTenantByID(ctx, tenantUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	tenant := tenantUpdate.ToTenant()
+	tenant.Slug = existingTenant.Slug
+	tenant.IsDefault = existingTenant.IsDefault
+	tenant.CreatedAt = existingTenant.CreatedAt
+	tenant.UpdatedAt = &now
+
+	// Conditionally update fields
+	if tenant.Name == "" {
+		tenant.Name = existingTenant.Name
+	}
+	if tenant.StorageFolder == "" {
+		tenant.StorageFolder = existingTenant.StorageFolder
+	}
+
+	updatedTenant, err := s.tenantRepo.Update(ctx, &tenant)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update tenant",
+			errors.WithContext("tenant_id", tenant.ID),
+		)
+	}
+
+	return updatedTenant, nil
+}
+
+func (s *tenantService) DeleteTenant(ctx context.Context, id string) error {
+	existingTenant, err := s.GetTenantByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if existingTenant.IsDefault {
+		return errors.New(
+			errors.ErrConflict,
+			"The default tenant cannot be deleted",
+			nil,
+			errors.WithContext("tenant_id", id),
+		)
+	}
+
+	if err := s.tenantRepo.Delete(ctx, id); err != nil {
+		return errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to delete tenant",
+			errors.WithContext("tenant_id", id),
+		)
+	}
+
+	return nil
+}
+
+func (s *tenantService) ListTenants(ctx context.Context, opts base.ListOptions) ([]Tenant, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.tenantRepo.List(ctx, opts)
+}
+
+func (s *tenantService) CountTenants(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.tenantRepo.Count(ctx, filters)
+}
+
+// findOne returns the single tenant matching field, or a not found error
+func (s *tenantService) findOne(ctx context.Context, field string, value interface{}) (*Tenant, error) {
+	tenants, err := s.tenantRepo.FindByField(ctx, field, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tenants) == 0 {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Tenant not found",
+			nil,
+			errors.WithContext(field, value),
+		)
+	}
+
+	return &tenants[0], nil
+}
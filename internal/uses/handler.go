@@ -0,0 +1,286 @@
+package uses
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/internal/utils"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type UsesHandler struct {
+	base.BaseHandler
+	usesService UsesService
+}
+
+func NewUsesHandler(usesService UsesService, logger *logger.Logger) *UsesHandler {
+	return &UsesHandler{
+		BaseHandler: *base.NewBaseHandler(logger),
+		usesService: usesService,
+	}
+}
+
+// GetUsesPage retrieves the /uses page
+// @Summary Get the uses page
+// @Description Retrieve the hardware, software and tools I use, grouped by category in page order
+// @Tags Uses
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]Category} "Uses page retrieved successfully"
+// @Router /uses [get]
+func (h *UsesHandler) GetUsesPage(c *gin.Context) {
+	categories, err := h.usesService.GetUsesPage(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, categories, "Uses page retrieved successfully")
+}
+
+// CreateItem adds an item to the /uses page
+// @Summary Create a uses item
+// @Description Add an item to the end of the uses page with an optional image upload
+// @Tags Uses
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param image formData file false "Item Image"
+// @Param payload formData string true "Item Details in JSON format (See UsesItemCreate Model)"
+// @Success 200 {object} response.APIResponse{data=Item} "Uses item created successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /uses/items [post]
+func (h *UsesHandler) CreateItem(c *gin.Context) {
+	var itemInput ItemCreate
+
+	// Parse multipart form data
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrBadRequest,
+			"Failed to parse multipart form",
+			err,
+		))
+		return
+	}
+
+	// Extract and validate form fields
+	if err := utils.ExtractFormDataPayload(c, &itemInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Get image file
+	imageFileHeaders, err := utils.ExtractFileHeaders(c, "image", 2)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if len(imageFileHeaders) > 0 {
+		itemInput.Image = imageFileHeaders[0]
+	}
+
+	item, err := h.usesService.CreateItem(c.Request.Context(), &itemInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, item, "Uses item created successfully")
+}
+
+// GetItemByID retrieves a specific item
+// @Summary Get a uses item by ID
+// @Description Retrieve a specific uses item using its unique identifier
+// @Tags Uses
+// @Produce json
+// @Param id path string true "Uses Item ID"
+// @Success 200 {object} response.APIResponse{data=Item} "Uses item retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Uses item not found"
+// @Router /uses/items/{id} [get]
+func (h *UsesHandler) GetItemByID(c *gin.Context) {
+	itemID := c.Param("id")
+	if _, err := h.ValidateUUID(itemID, "Uses Item ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	item, err := h.usesService.GetItemByID(c.Request.Context(), itemID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, item, "Uses item retrieved successfully")
+}
+
+// UpdateItem updates an existing item
+// @Summary Update a uses item
+// @Description Update an existing uses item with new details and optional image
+// @Tags Uses
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Uses Item ID"
+// @Param image formData file false "Item Image"
+// @Param payload formData string true "Item Update Details in JSON format (See UsesItemUpdate Model)"
+// @Success 200 {object} response.APIResponse{data=Item} "Uses item updated successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Uses item not found"
+// @Router /uses/items/{id} [put]
+func (h *UsesHandler) UpdateItem(c *gin.Context) {
+	var itemInput ItemUpdate
+
+	itemID, err := h.ValidateUUID(c.Param("id"), "Uses Item ID")
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Parse multipart form data
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrBadRequest,
+			"Failed to parse multipart form",
+			err,
+		))
+		return
+	}
+
+	// Extract and validate form fields
+	if err := utils.ExtractFormDataPayload(c, &itemInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Set the ID from path
+	itemInput.ID = itemID
+
+	// Get image file
+	imageFileHeaders, err := utils.ExtractFileHeaders(c, "image", 2)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if len(imageFileHeaders) > 0 {
+		itemInput.Image = imageFileHeaders[0]
+	}
+
+	item, err := h.usesService.UpdateItem(c.Request.Context(), &itemInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, item, "Uses item updated successfully")
+}
+
+// DeleteItem removes an item from the /uses page
+// @Summary Delete a uses item
+// @Description Remove an item from the uses page
+// @Tags Uses
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Uses Item ID"
+// @Success 200 {object} response.APIResponse "Uses item deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Uses item not found"
+// @Router /uses/items/{id} [delete]
+func (h *UsesHandler) DeleteItem(c *gin.Context) {
+	itemID := c.Param("id")
+	if _, err := h.ValidateUUID(itemID, "Uses Item ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.usesService.DeleteItem(c.Request.Context(), itemID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Uses item deleted successfully")
+}
+
+// ReorderItems changes the order of the /uses page
+// @Summary Reorder the uses page
+// @Description Move the listed items to the top of the uses page in the given order. Items left out keep their relative order after them.
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param order body ItemOrder true "New Item Order"
+// @Success 200 {object} response.APIResponse{data=[]Category} "Uses page reordered successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Uses item not found"
+// @Router /uses/order [put]
+func (h *UsesHandler) ReorderItems(c *gin.Context) {
+	var orderInput ItemOrder
+	if err := h.ValidateRequest(c, &orderInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	categories, err := h.usesService.ReorderItems(c.Request.Context(), &orderInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, categories, "Uses page reordered successfully")
+}
+
+// ListItems retrieves a paginated list of items
+// @Summary List uses items
+// @Description Retrieve a paginated list of uses items in page order with optional filtering
+// @Tags Uses
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param category query string false "Filter by category"
+// @Success 200 {object} response.APIResponse{data=[]Item} "Uses items retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Router /uses/items [get]
+func (h *UsesHandler) ListItems(c *gin.Context) {
+	// Parse pagination and filtering options
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	// List in page order unless asked otherwise
+	if c.Query("sort_by") == "" {
+		opts.SortBy, opts.SortOrder = "position", base.SortAscending
+	}
+
+	// Optional category filter
+	if category := c.Query("category"); category != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "category",
+			Operator: base.OperatorEqual,
+			Value:    category,
+		})
+	}
+
+	items, err := h.usesService.ListItems(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total items for pagination
+	total, err := h.usesService.CountItems(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, items, "Uses items retrieved successfully",
+		response.WithPagination(total, opts.Page, opts.PerPage))
+}
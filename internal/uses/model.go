@@ -0,0 +1,63 @@
+package uses
+
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Item is a piece of hardware, software or a tool on the /uses page
+// @Description Gear, software or tool I use
+// @Name UsesItem
+type Item struct {
+	ID          uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	Name        string     `json:"name" db:"name" example:"MacBook Pro 14\""`
+	Category    string     `json:"category" db:"category" example:"Hardware"`
+	Description string     `json:"description,omitempty" db:"description" example:"M3 Pro, 36 GB. My daily driver."`
+	Url         string     `json:"url,omitempty" db:"url" example:"https://www.apple.com/macbook-pro/"`
+	ImageUrl    string     `json:"image_url,omitempty" db:"image_url" example:"https://example.com/macbook.png"`
+
+	// Position orders items on the page, lowest first
+	Position int `json:"position" db:"position" example:"0"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// ItemCreate is the input for adding an item to the /uses page
+// @Name UsesItemCreate
+type ItemCreate struct {
+	Name        string                `json:"name" validate:"required,max=255" example:"MacBook Pro 14\""`
+	Category    string                `json:"category" validate:"required,max=100" example:"Hardware"`
+	Description string                `json:"description" validate:"max=2000" example:"M3 Pro, 36 GB. My daily driver."`
+	Url         string                `json:"url" example:"https://www.apple.com/macbook-pro/"`
+	Image       *multipart.FileHeader `json:"image" swaggerignore:"true"`
+}
+
+// ItemUpdate is the input for editing an item on the /uses page
+// @Name UsesItemUpdate
+type ItemUpdate struct {
+	ID          uuid.UUID             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string                `json:"name" validate:"max=255" example:"MacBook Pro 14\""`
+	Category    string                `json:"category" validate:"max=100" example:"Hardware"`
+	Description string                `json:"description" validate:"max=2000" example:"M3 Pro, 36 GB. My daily driver."`
+	Url         string                `json:"url" example:"https://www.apple.com/macbook-pro/"`
+	Image       *multipart.FileHeader `json:"image" swaggerignore:"true"`
+}
+
+// ItemOrder is the input for reordering the /uses page
+// @Name UsesItemOrder
+type ItemOrder struct {
+	// IDs lists items in their new order; items left out keep their position after them
+	IDs []uuid.UUID `json:"ids" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// Category groups the items of one category in display order
+// @Description Items of a /uses category
+// @Name UsesCategory
+type Category struct {
+	Name  string `json:"name" example:"Hardware"`
+	Items []Item `json:"items"`
+}
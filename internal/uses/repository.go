@@ -0,0 +1,188 @@
+package uses
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type UsesRepository interface {
+	Create(ctx context.Context, item *Item) (*Item, error)
+	Update(ctx context.Context, item *Item) (*Item, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Item, error)
+	FindLast(ctx context.Context) (*Item, error)
+	SetPosition(ctx context.Context, id string, position int) error
+	List(ctx context.Context, opts base.ListOptions) ([]Item, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type usesRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewUsesRepository(supabaseClient *supabase.SupabaseClient) UsesRepository {
+	return &usesRepository{
+		supabaseClient: supabaseClient,
+		table:          "uses_item",
+	}
+}
+
+func (r *usesRepository) Create(ctx context.Context, item *Item) (*Item, error) {
+	item.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(item, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create uses item")
+	}
+	return item, nil
+}
+
+func (r *usesRepository) Update(ctx context.Context, item *Item) (*Item, error) {
+	item.TenantID = base.TenantIDFromContext(ctx)
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(item, "minimal", "").
+		Eq("id", item.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to update uses item")
+	}
+	return item, nil
+}
+
+func (r *usesRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete uses item")
+	}
+	return nil
+}
+
+// FindByID returns the item with the given ID, or nil if none
+func (r *usesRepository) FindByID(ctx context.Context, id string) (*Item, error) {
+	var items []Item
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&items)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find uses item")
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return &items[0], nil
+}
+
+// FindLast returns the item with the highest position, or nil if none
+func (r *usesRepository) FindLast(ctx context.Context) (*Item, error) {
+	var items []Item
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("position", &postgrest.OrderOpts{Ascending: false}).
+		Limit(1, "").
+		ExecuteTo(&items)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find last uses item")
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return &items[0], nil
+}
+
+// SetPosition moves a single item without touching its other fields
+func (r *usesRepository) SetPosition(ctx context.Context, id string, position int) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"position":   position,
+			"updated_at": time.Now().UTC(),
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update uses item position")
+	}
+	return nil
+}
+
+func (r *usesRepository) List(ctx context.Context, opts base.ListOptions) ([]Item, error) {
+	var items []Item
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting, page order by default
+	sortBy, ascending := "position", true
+	if opts.SortBy != "" {
+		sortBy, ascending = opts.SortBy, opts.SortOrder == base.SortAscending
+	}
+	query = query.Order(sortBy, &postgrest.OrderOpts{Ascending: ascending})
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&items)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list uses items")
+	}
+
+	return items, nil
+}
+
+func (r *usesRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count uses items")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,328 @@
+package uses
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/asset"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
+)
+
+type UsesService interface {
+	GetUsesPage(ctx context.Context) ([]Category, error)
+	CreateItem(ctx context.Context, itemCreate *ItemCreate) (*Item, error)
+	GetItemByID(ctx context.Context, id string) (*Item, error)
+	UpdateItem(ctx context.Context, itemUpdate *ItemUpdate) (*Item, error)
+	DeleteItem(ctx context.Context, id string) error
+	ReorderItems(ctx context.Context, order *ItemOrder) ([]Category, error)
+	ListItems(ctx context.Context, opts base.ListOptions) ([]Item, error)
+	CountItems(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type usesService struct {
+	usesRepo UsesRepository
+	assets   asset.AssetService
+}
+
+func NewUsesService(usesRepo UsesRepository, assets asset.AssetService) UsesService {
+	return &usesService{
+		usesRepo: usesRepo,
+		assets:   assets,
+	}
+}
+
+// GetUsesPage returns every item grouped by category. Categories appear in
+// the order of their first item.
+func (s *usesService) GetUsesPage(ctx context.Context) ([]Category, error) {
+	items, err := s.allItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupByCategory(items), nil
+}
+
+func (s *usesService) CreateItem(ctx context.Context, itemCreate *ItemCreate) (*Item, error) {
+	// Validate input
+	if err := validator.ValidateModel(itemCreate); err != nil {
+		return nil, err
+	}
+
+	// New items go to the end of the page
+	position := 0
+	last, err := s.usesRepo.FindLast(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		position = last.Position + 1
+	}
+
+	now := time.Now().UTC()
+	item := &Item{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(itemCreate.Name),
+		Category:    strings.TrimSpace(itemCreate.Category),
+		Description: strings.TrimSpace(itemCreate.Description),
+		Url:         strings.TrimSpace(itemCreate.Url),
+		Position:    position,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	// Upload image if provided
+	if itemCreate.Image != nil {
+		imageURL, err := s.uploadItemImage(ctx, item.ID.String(), itemCreate.Image)
+		if err != nil {
+			return nil, err
+		}
+		item.ImageUrl = imageURL
+	}
+
+	createdItem, err := s.usesRepo.Create(ctx, item)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to create uses item",
+			errors.WithContext("item_name", item.Name),
+		)
+	}
+
+	return createdItem, nil
+}
+
+func (s *usesService) GetItemByID(ctx context.Context, id string) (*Item, error) {
+	if id == "" {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Uses item ID cannot be empty",
+			nil,
+		)
+	}
+
+	item, err := s.usesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Uses item not found",
+			nil,
+			errors.WithContext("item_id", id),
+		)
+	}
+
+	return item, nil
+}
+
+func (s *usesService) UpdateItem(ctx context.Context, itemUpdate *ItemUpdate) (*Item, error) {
+	// Validate input
+	if err := validator.ValidateModel(itemUpdate); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetItemByID(ctx, itemUpdate.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if name := strings.TrimSpace(itemUpdate.Name); name != "" {
+		item.Name = name
+	}
+	if category := strings.TrimSpace(itemUpdate.Category); category != "" {
+		item.Category = category
+	}
+	if description := strings.TrimSpace(itemUpdate.Description); description != "" {
+		item.Description = description
+	}
+	if url := strings.TrimSpace(itemUpdate.Url); url != "" {
+		item.Url = url
+	}
+
+	// Upload image if provided
+	if itemUpdate.Image != nil {
+		imageURL, err := s.uploadItemImage(ctx, item.ID.String(), itemUpdate.Image)
+		if err != nil {
+			return nil, err
+		}
+		item.ImageUrl = imageURL
+	}
+
+	now := time.Now().UTC()
+	item.UpdatedAt = &now
+
+	updatedItem, err := s.usesRepo.Update(ctx, item)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrDatabase,
+			"Failed to update uses item",
+			errors.WithContext("item_id", item.ID),
+		)
+	}
+
+	return updatedItem, nil
+}
+
+func (s *usesService) DeleteItem(ctx context.Context, id string) error {
+	if _, err := s.GetItemByID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.usesRepo.Delete(ctx, id)
+}
+
+// ReorderItems moves the listed items to the top of the page in the given
+// order. Items left out keep their relative order after them.
+func (s *usesService) ReorderItems(ctx context.Context, order *ItemOrder) ([]Category, error) {
+	if len(order.IDs) == 0 {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"At least one uses item ID is required",
+			nil,
+		)
+	}
+
+	items, err := s.allItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]Item, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+
+	ordered := make([]Item, 0, len(items))
+	listed := make(map[uuid.UUID]bool, len(order.IDs))
+	for _, id := range order.IDs {
+		item, ok := byID[id]
+		if !ok {
+			return nil, errors.New(
+				errors.ErrNotFound,
+				"Uses item not found",
+				nil,
+				errors.WithContext("item_id", id),
+			)
+		}
+		if listed[id] {
+			return nil, errors.New(
+				errors.ErrValidation,
+				"Uses item listed more than once",
+				nil,
+				errors.WithContext("item_id", id),
+			)
+		}
+		listed[id] = true
+		ordered = append(ordered, item)
+	}
+	for _, item := range items {
+		if !listed[item.ID] {
+			ordered = append(ordered, item)
+		}
+	}
+
+	// Only write the items that actually moved
+	for i := range ordered {
+		if ordered[i].Position == i {
+			continue
+		}
+		if err := s.usesRepo.SetPosition(ctx, ordered[i].ID.String(), i); err != nil {
+			return nil, err
+		}
+		ordered[i].Position = i
+	}
+
+	return groupByCategory(ordered), nil
+}
+
+func (s *usesService) ListItems(ctx context.Context, opts base.ListOptions) ([]Item, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err,
+			errors.ErrValidation,
+			"Invalid list options",
+			errors.WithContext("options", opts),
+		)
+	}
+
+	return s.usesRepo.List(ctx, opts)
+}
+
+func (s *usesService) CountItems(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.usesRepo.Count(ctx, filters)
+}
+
+// allItems returns every item in page order
+func (s *usesService) allItems(ctx context.Context) ([]Item, error) {
+	opts := base.ListOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	var all []Item
+	for {
+		items, err := s.ListItems(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	return all, nil
+}
+
+// groupByCategory groups items in page order by category, ignoring case so
+// that "Hardware" and "hardware" end up together
+func groupByCategory(items []Item) []Category {
+	categories := []Category{}
+	index := make(map[string]int)
+	for _, item := range items {
+		key := strings.ToLower(item.Category)
+		i, ok := index[key]
+		if !ok {
+			i = len(categories)
+			index[key] = i
+			categories = append(categories, Category{Name: item.Category})
+		}
+		categories[i].Items = append(categories[i].Items, item)
+	}
+	return categories
+}
+
+func (s *usesService) uploadItemImage(ctx context.Context, itemID string, file *multipart.FileHeader) (string, error) {
+	if itemID == "" {
+		return "", fmt.Errorf("uses item ID cannot be empty")
+	}
+	if file == nil {
+		return "", fmt.Errorf("file is required")
+	}
+
+	destPath := base.TenantStoragePath(ctx, fmt.Sprintf("images/uses/%s%s", itemID, filepath.Ext(file.Filename)))
+
+	uploaded, err := s.assets.Upload(ctx, file, destPath, storage.FileOptions{
+		ContentType: func(s string) *string { return &s }("image"),
+		Upsert:      func(b bool) *bool { return &b }(true),
+	})
+	if err != nil {
+		return "", errors.Wrap(err,
+			errors.ErrInternal,
+			"Failed to upload uses item image",
+			errors.WithContext("item_id", itemID),
+		)
+	}
+
+	return uploaded.URL, nil
+}
@@ -0,0 +1,185 @@
+package webmention
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/response"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+type WebmentionHandler struct {
+	base.BaseHandler
+	webmentionService WebmentionService
+}
+
+func NewWebmentionHandler(webmentionService WebmentionService, logger *logger.Logger) *WebmentionHandler {
+	return &WebmentionHandler{
+		BaseHandler:       *base.NewBaseHandler(logger),
+		webmentionService: webmentionService,
+	}
+}
+
+// Receive accepts a Webmention
+// @Summary Receive a Webmention
+// @Description Webmention endpoint for project pages. The mention is accepted right away and displayed once its source has been fetched and found to link to the target. Sending the same mention again re-verifies it, removing it when the source no longer links to the target. Requires a solved challenge from GET /challenge in the X-Challenge and X-Challenge-Solution headers.
+// @Tags Webmentions
+// @Accept x-www-form-urlencoded,json
+// @Produce json
+// @Param source formData string true "URL of the page mentioning the target"
+// @Param target formData string true "URL of the mentioned project page"
+// @Success 202 {object} response.APIResponse{data=MentionReceipt} "Webmention accepted for verification"
+// @Failure 400 {object} response.APIResponse "Invalid source or target does not accept Webmentions"
+// @Failure 403 {object} response.APIResponse "Missing or invalid challenge"
+// @Failure 429 {object} response.APIResponse "Too many requests"
+// @Router /webmention [post]
+func (h *WebmentionHandler) Receive(c *gin.Context) {
+	var mentionInput MentionCreate
+	if err := h.ValidateRequest(c, &mentionInput); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	receipt, err := h.webmentionService.Receive(c.Request.Context(), &mentionInput)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusAccepted, receipt, "Webmention accepted for verification")
+}
+
+// ListProjectMentions retrieves the Webmentions of a project
+// @Summary List project Webmentions
+// @Description Retrieve the verified Webmentions of a public project, newest first, for display on its page
+// @Tags Webmentions
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} response.APIResponse{data=[]PublicMention} "Webmentions retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 404 {object} response.APIResponse "Project not found"
+// @Router /projects/{id}/webmentions [get]
+func (h *WebmentionHandler) ListProjectMentions(c *gin.Context) {
+	projectID := c.Param("id")
+	if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	mentions, err := h.webmentionService.ListProjectMentions(c.Request.Context(), projectID)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, mentions, "Webmentions retrieved successfully")
+}
+
+// ListMentions retrieves received Webmentions
+// @Summary List Webmentions
+// @Description Retrieve a paginated list of received Webmentions, newest first, optionally filtered by status or project
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param status query string false "Verification status" Enums(new, verified, rejected, deleted)
+// @Param project_id query string false "Project ID"
+// @Success 200 {object} response.APIResponse{data=[]Mention} "Webmentions retrieved successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /admin/webmentions [get]
+func (h *WebmentionHandler) ListMentions(c *gin.Context) {
+	opts, err := base.ParsePaginationParams(c)
+	if err != nil {
+		h.HandleError(c, errors.New(
+			errors.ErrValidation,
+			"Invalid query parameters",
+			err,
+		))
+		return
+	}
+
+	if status := c.Query("status"); status != "" {
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "status",
+			Operator: base.OperatorEqual,
+			Value:    status,
+		})
+	}
+	if projectID := c.Query("project_id"); projectID != "" {
+		if _, err := h.ValidateUUID(projectID, "Project ID"); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		opts.Filters = append(opts.Filters, base.FilterOption{
+			Field:    "project_id",
+			Operator: base.OperatorEqual,
+			Value:    projectID,
+		})
+	}
+
+	mentions, err := h.webmentionService.ListMentions(c.Request.Context(), opts)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	// Count total mentions for pagination
+	total, err := h.webmentionService.CountMentions(c.Request.Context(), opts.Filters)
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandlePagination(c, mentions, total, opts)
+}
+
+// DeleteMention removes a received Webmention
+// @Summary Delete a Webmention
+// @Description Remove a received Webmention, e.g. spam. Deleted mentions are accepted again if their source sends them again.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webmention ID"
+// @Success 200 {object} response.APIResponse "Webmention deleted successfully"
+// @Failure 400 {object} response.APIResponse "Bad Request"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Webmention not found"
+// @Router /admin/webmentions/{id} [delete]
+func (h *WebmentionHandler) DeleteMention(c *gin.Context) {
+	mentionID := c.Param("id")
+	if _, err := h.ValidateUUID(mentionID, "Webmention ID"); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	if err := h.webmentionService.DeleteMention(c.Request.Context(), mentionID); err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, nil, "Webmention deleted successfully")
+}
+
+// SendMentions sends Webmentions for project links
+// @Summary Send Webmentions
+// @Description Notify the pages linked from public projects whose content changed since they were last notified, and pages no longer linked. Mentions are also sent automatically after projects change.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=SendReport} "Webmentions sent successfully"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 500 {object} response.APIResponse "Site canonical URL is not set"
+// @Router /admin/webmentions/send [post]
+func (h *WebmentionHandler) SendMentions(c *gin.Context) {
+	report, err := h.webmentionService.SendMentions(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err)
+		return
+	}
+
+	h.HandleSuccess(c, report, "Webmentions sent successfully")
+}
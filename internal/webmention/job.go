@@ -0,0 +1,183 @@
+package webmention
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/events"
+	"github.com/holycann/itsrama-portfolio-backend/internal/tenant"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Job verifies received mentions and sends mentions for the links of every
+// tenant's projects. Mentions are sent shortly after projects change and on
+// a fixed interval, which also catches changes whose events were dropped.
+type Job struct {
+	webmentionService WebmentionService
+	tenantService     tenant.TenantService
+	bus               *events.Bus
+	verifyInterval    time.Duration
+	sendInterval      time.Duration
+	sendDelay         time.Duration
+	logger            *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJob creates a job verifying mentions every verifyInterval and sending
+// them every sendInterval and sendDelay after project change events
+// published on bus
+func NewJob(webmentionService WebmentionService, tenantService tenant.TenantService, bus *events.Bus, verifyInterval, sendInterval, sendDelay time.Duration, logger *logger.Logger) *Job {
+	if verifyInterval <= 0 {
+		verifyInterval = 30 * time.Second
+	}
+	if sendInterval <= 0 {
+		sendInterval = 24 * time.Hour
+	}
+	if sendDelay <= 0 {
+		sendDelay = time.Minute
+	}
+
+	return &Job{
+		webmentionService: webmentionService,
+		tenantService:     tenantService,
+		bus:               bus,
+		verifyInterval:    verifyInterval,
+		sendInterval:      sendInterval,
+		sendDelay:         sendDelay,
+		logger:            logger,
+	}
+}
+
+// Start runs the job until ctx is cancelled or Stop is called. Verifying
+// and sending run separately so that slow targets do not hold up received
+// mentions.
+func (j *Job) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	j.wg.Add(2)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.verifyInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.forEachTenant(ctx, j.verify)
+			}
+		}
+	}()
+
+	// Without a subscription the job still sends on the interval
+	var changes <-chan events.Event
+	sub, err := j.bus.SubscribeInternal()
+	if err != nil {
+		j.logger.Warn("Failed to subscribe to project changes for webmentions", "error", err)
+	} else {
+		changes = sub.Events()
+	}
+
+	go func() {
+		defer j.wg.Done()
+		if sub != nil {
+			defer sub.Close()
+		}
+
+		ticker := time.NewTicker(j.sendInterval)
+		defer ticker.Stop()
+
+		// pending fires once project changes have settled for sendDelay
+		pending := time.NewTimer(j.sendDelay)
+		defer pending.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				if strings.HasPrefix(string(event.Type), "project.") {
+					pending.Reset(j.sendDelay)
+				}
+			case <-pending.C:
+				j.forEachTenant(ctx, j.send)
+			case <-ticker.C:
+				j.forEachTenant(ctx, j.send)
+			}
+		}
+	}()
+}
+
+// Stop halts the job and waits for the current runs to finish
+func (j *Job) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// forEachTenant calls run for each tenant in turn
+func (j *Job) forEachTenant(ctx context.Context, run func(context.Context, *tenant.Tenant)) {
+	for page := 1; ; page++ {
+		tenants, err := j.tenantService.ListTenants(ctx, base.ListOptions{Page: page, PerPage: pageSize})
+		if err != nil {
+			if ctx.Err() == nil {
+				j.logger.Error("Failed to list tenants for webmentions", "error", err)
+			}
+			return
+		}
+
+		for i := range tenants {
+			if ctx.Err() != nil {
+				return
+			}
+			run(base.WithTenant(ctx, tenants[i].Scope()), &tenants[i])
+		}
+
+		if len(tenants) < pageSize {
+			return
+		}
+	}
+}
+
+func (j *Job) verify(ctx context.Context, t *tenant.Tenant) {
+	report, err := j.webmentionService.VerifyPending(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			j.logger.Error("Failed to verify webmentions", "tenant", t.Slug, "error", err)
+		}
+		return
+	}
+
+	if report.Verified > 0 || report.Rejected > 0 || report.Deleted > 0 || report.Failed > 0 {
+		j.logger.Info("Webmentions verified", "tenant", t.Slug, "verified", report.Verified,
+			"rejected", report.Rejected, "deleted", report.Deleted, "failed", report.Failed)
+	}
+}
+
+func (j *Job) send(ctx context.Context, t *tenant.Tenant) {
+	report, err := j.webmentionService.SendMentions(ctx)
+	if err != nil {
+		// Tenants without a canonical URL have no project pages to send from
+		if ctx.Err() == nil && !errors.Is(err, errors.ErrConfiguration) {
+			j.logger.Error("Failed to send webmentions", "tenant", t.Slug, "error", err)
+		}
+		return
+	}
+
+	if report.Sent > 0 || report.Failed > 0 {
+		j.logger.Info("Webmentions sent", "tenant", t.Slug, "sent", report.Sent, "failed", report.Failed)
+	}
+}
@@ -0,0 +1,133 @@
+package webmention
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MentionStatus is the verification state of a received mention
+// @Description Verification state of a received Webmention
+// @Name MentionStatus
+type MentionStatus string
+
+const (
+	// MentionNew has been received but not verified yet
+	MentionNew MentionStatus = "new"
+	// MentionVerified links to its target and is displayed
+	MentionVerified MentionStatus = "verified"
+	// MentionRejected never linked to its target
+	MentionRejected MentionStatus = "rejected"
+	// MentionDeleted linked to its target before, but its source no longer
+	// does or is gone
+	MentionDeleted MentionStatus = "deleted"
+)
+
+// Mention is a Webmention received for a project page
+// @Description Webmention received for a project page
+// @Name Mention
+type Mention struct {
+	ID         uuid.UUID     `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TenantID   *uuid.UUID    `json:"tenant_id,omitempty" db:"tenant_id" swaggerignore:"true"`
+	ProjectID  uuid.UUID     `json:"project_id" db:"project_id" example:"650f9500-f39c-52d5-b827-557766550001"`
+	Source     string        `json:"source" db:"source" example:"https://blog.example.com/posts/portfolio-review"`
+	Target     string        `json:"target" db:"target" example:"https://itsrama.kawasan.digital/projects/portfolio-website"`
+	Status     MentionStatus `json:"status" db:"status" example:"verified"`
+	Pending    bool          `json:"pending" db:"pending" example:"false"`
+	Title      string        `json:"title" db:"title" example:"Reviewing developer portfolios"`
+	AuthorName string        `json:"author_name" db:"author_name" example:"Jane Doe"`
+	AuthorURL  string        `json:"author_url" db:"author_url" example:"https://blog.example.com"`
+	Excerpt    string        `json:"excerpt" db:"excerpt" example:"One of the cleanest portfolios I have seen this year."`
+	Error      string        `json:"error" db:"error" example:""`
+	ReceivedAt time.Time     `json:"received_at" db:"received_at"`
+	VerifiedAt *time.Time    `json:"verified_at,omitempty" db:"verified_at"`
+	CreatedAt  *time.Time    `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt  *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// MentionCreate is a Webmention notification, sent form encoded as the
+// specification requires or as JSON
+// @Description Webmention notification that source links to target
+// @Name MentionCreate
+type MentionCreate struct {
+	Source string `json:"source" form:"source" validate:"required,max=2048" example:"https://blog.example.com/posts/portfolio-review"`
+	Target string `json:"target" form:"target" validate:"required,max=2048" example:"https://itsrama.kawasan.digital/projects/portfolio-website"`
+}
+
+// MentionReceipt acknowledges a mention queued for verification
+// @Description Acknowledgement of a Webmention queued for verification
+// @Name MentionReceipt
+type MentionReceipt struct {
+	ID     uuid.UUID     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status MentionStatus `json:"status" example:"new"`
+}
+
+// PublicMention is a verified mention as displayed on a project page
+// @Description Verified Webmention of a project
+// @Name PublicMention
+type PublicMention struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Source     string     `json:"source" example:"https://blog.example.com/posts/portfolio-review"`
+	Title      string     `json:"title" example:"Reviewing developer portfolios"`
+	AuthorName string     `json:"author_name" example:"Jane Doe"`
+	AuthorURL  string     `json:"author_url" example:"https://blog.example.com"`
+	Excerpt    string     `json:"excerpt" example:"One of the cleanest portfolios I have seen this year."`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
+
+// SendStatus is the outcome of sending a mention
+type SendStatus string
+
+const (
+	SendSent       SendStatus = "sent"
+	SendNoEndpoint SendStatus = "no_endpoint"
+	SendFailed     SendStatus = "failed"
+)
+
+// Sent records the last Webmention sent for a link of a project page
+type Sent struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	ProjectID   uuid.UUID  `json:"project_id" db:"project_id"`
+	Source      string     `json:"source" db:"source"`
+	Target      string     `json:"target" db:"target"`
+	ContentHash string     `json:"content_hash" db:"content_hash"`
+	Status      SendStatus `json:"status" db:"status"`
+	Endpoint    string     `json:"endpoint" db:"endpoint"`
+	StatusCode  int        `json:"status_code" db:"status_code"`
+	Error       string     `json:"error" db:"error"`
+	SentAt      time.Time  `json:"sent_at" db:"sent_at"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// SendReport summarizes a run of sending mentions
+// @Description Summary of a run of sending Webmentions for project links
+// @Name SendReport
+type SendReport struct {
+	Projects   int `json:"projects" example:"12"`
+	Sent       int `json:"sent" example:"3"`
+	NoEndpoint int `json:"no_endpoint" example:"8"`
+	Failed     int `json:"failed" example:"1"`
+	Unchanged  int `json:"unchanged" example:"40"`
+}
+
+// VerifyReport summarizes a run of verifying received mentions
+type VerifyReport struct {
+	Verified int
+	Rejected int
+	Deleted  int
+	Failed   int
+}
+
+func (m *Mention) public() PublicMention {
+	return PublicMention{
+		ID:         m.ID,
+		Source:     m.Source,
+		Title:      m.Title,
+		AuthorName: m.AuthorName,
+		AuthorURL:  m.AuthorURL,
+		Excerpt:    m.Excerpt,
+		VerifiedAt: m.VerifiedAt,
+	}
+}
@@ -0,0 +1,294 @@
+package webmention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/supabase"
+	postgrest "github.com/supabase-community/postgrest-go"
+)
+
+type MentionRepository interface {
+	Create(ctx context.Context, mention *Mention) (*Mention, error)
+	Update(ctx context.Context, mention *Mention) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Mention, error)
+	// FindBySourceTarget returns the mention of target by source, or nil if none
+	FindBySourceTarget(ctx context.Context, source, target string) (*Mention, error)
+	// MarkPending queues a mention for verification again without touching
+	// what was verified before
+	MarkPending(ctx context.Context, id string) error
+	// ListPending returns up to limit mentions awaiting verification, oldest first
+	ListPending(ctx context.Context, limit int) ([]Mention, error)
+	// ListByProject returns the mentions of a project with a status, newest first
+	ListByProject(ctx context.Context, projectID string, status MentionStatus) ([]Mention, error)
+	List(ctx context.Context, opts base.ListOptions) ([]Mention, error)
+	Count(ctx context.Context, filters []base.FilterOption) (int, error)
+}
+
+type mentionRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewMentionRepository(supabaseClient *supabase.SupabaseClient) MentionRepository {
+	return &mentionRepository{
+		supabaseClient: supabaseClient,
+		table:          "webmention",
+	}
+}
+
+func (r *mentionRepository) Create(ctx context.Context, mention *Mention) (*Mention, error) {
+	mention.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Insert(mention, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to create webmention")
+	}
+	return mention, nil
+}
+
+func (r *mentionRepository) Update(ctx context.Context, mention *Mention) error {
+	now := time.Now().UTC()
+	mention.UpdatedAt = &now
+
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(mention, "minimal", "").
+		Eq("id", mention.ID.String())
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to update webmention")
+	}
+	return nil
+}
+
+func (r *mentionRepository) Delete(ctx context.Context, id string) error {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete webmention")
+	}
+	return nil
+}
+
+// FindByID returns the mention with the given ID, or nil if none
+func (r *mentionRepository) FindByID(ctx context.Context, id string) (*Mention, error) {
+	var mentions []Mention
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("id", id)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&mentions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find webmention")
+	}
+
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+	return &mentions[0], nil
+}
+
+func (r *mentionRepository) FindBySourceTarget(ctx context.Context, source, target string) (*Mention, error) {
+	var mentions []Mention
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("source", source).
+		Eq("target", target)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&mentions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to find webmention")
+	}
+
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+	return &mentions[0], nil
+}
+
+func (r *mentionRepository) MarkPending(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Update(map[string]interface{}{
+			"pending":     true,
+			"received_at": now,
+			"updated_at":  now,
+		}, "minimal", "").
+		Eq("id", id)
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to queue webmention")
+	}
+	return nil
+}
+
+func (r *mentionRepository) ListPending(ctx context.Context, limit int) ([]Mention, error) {
+	var mentions []Mention
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("pending", "true")
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("received_at", &postgrest.OrderOpts{Ascending: true}).
+		Limit(limit, "").
+		ExecuteTo(&mentions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list pending webmentions")
+	}
+
+	return mentions, nil
+}
+
+func (r *mentionRepository) ListByProject(ctx context.Context, projectID string, status MentionStatus) ([]Mention, error) {
+	var mentions []Mention
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false).
+		Eq("project_id", projectID).
+		Eq("status", string(status))
+
+	_, err := base.ScopeToTenant(ctx, query).
+		Order("verified_at", &postgrest.OrderOpts{Ascending: false}).
+		ExecuteTo(&mentions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list project webmentions")
+	}
+
+	return mentions, nil
+}
+
+func (r *mentionRepository) List(ctx context.Context, opts base.ListOptions) ([]Mention, error) {
+	var mentions []Mention
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range opts.Filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		query = query.Order(opts.SortBy, &postgrest.OrderOpts{Ascending: opts.SortOrder == base.SortAscending})
+	}
+
+	// Apply pagination
+	offset := (opts.Page - 1) * opts.PerPage
+	query = query.Range(offset, offset+opts.PerPage-1, "")
+
+	_, err := query.ExecuteTo(&mentions)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list webmentions")
+	}
+
+	return mentions, nil
+}
+
+func (r *mentionRepository) Count(ctx context.Context, filters []base.FilterOption) (int, error) {
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("id", "exact", true)
+	query = base.ScopeToTenant(ctx, query)
+
+	// Apply filters
+	for _, filter := range filters {
+		switch filter.Operator {
+		case base.OperatorEqual:
+			query = query.Eq(filter.Field, fmt.Sprintf("%v", filter.Value))
+		case base.OperatorLike:
+			query = query.Like(filter.Field, fmt.Sprintf("%%%v%%", filter.Value))
+		}
+	}
+
+	_, count, err := query.Execute()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDatabase, "failed to count webmentions")
+	}
+
+	return int(count), nil
+}
+
+type SentRepository interface {
+	// FindAll returns what was last sent for every link of the tenant in ctx
+	FindAll(ctx context.Context) ([]Sent, error)
+	Upsert(ctx context.Context, sent *Sent) error
+	Delete(ctx context.Context, ids []string) error
+}
+
+type sentRepository struct {
+	supabaseClient *supabase.SupabaseClient
+	table          string
+}
+
+func NewSentRepository(supabaseClient *supabase.SupabaseClient) SentRepository {
+	return &sentRepository{
+		supabaseClient: supabaseClient,
+		table:          "webmention_sent",
+	}
+}
+
+func (r *sentRepository) FindAll(ctx context.Context) ([]Sent, error) {
+	var sent []Sent
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Select("*", "", false)
+
+	_, err := base.ScopeToTenant(ctx, query).ExecuteTo(&sent)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDatabase, "failed to list sent webmentions")
+	}
+
+	return sent, nil
+}
+
+// Upsert stores what was sent for a link, replacing the previous record of
+// the same source and target
+func (r *sentRepository) Upsert(ctx context.Context, sent *Sent) error {
+	sent.TenantID = base.TenantIDFromContext(ctx)
+	_, _, err := r.supabaseClient.GetClient().
+		From(r.table).
+		Upsert(sent, "source,target", "minimal", "").
+		Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to save sent webmention")
+	}
+	return nil
+}
+
+func (r *sentRepository) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := r.supabaseClient.GetClient().
+		From(r.table).
+		Delete("minimal", "").
+		In("id", ids)
+
+	_, _, err := base.ScopeToTenant(ctx, query).Execute()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDatabase, "failed to delete sent webmentions")
+	}
+	return nil
+}
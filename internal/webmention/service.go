@@ -0,0 +1,553 @@
+package webmention
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/holycann/itsrama-portfolio-backend/internal/base"
+	"github.com/holycann/itsrama-portfolio-backend/internal/project"
+	"github.com/holycann/itsrama-portfolio-backend/internal/site_config"
+	"github.com/holycann/itsrama-portfolio-backend/internal/validator"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/errors"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/markdown"
+	"github.com/holycann/itsrama-portfolio-backend/pkg/webmention"
+)
+
+const (
+	// pageSize is the page size used to read every public project
+	pageSize = 100
+
+	// verifyBatchSize bounds the mentions verified per tenant in one run
+	verifyBatchSize = 50
+
+	// slugPlaceholder is replaced by the project slug in the project path
+	slugPlaceholder = "{slug}"
+)
+
+type WebmentionService interface {
+	// Receive queues a mention of a public project page for verification
+	Receive(ctx context.Context, mentionCreate *MentionCreate) (*MentionReceipt, error)
+	// VerifyPending fetches the sources of the pending mentions of the tenant
+	// in ctx and keeps those that link to their target
+	VerifyPending(ctx context.Context) (*VerifyReport, error)
+	// ListProjectMentions returns the verified mentions of a public project
+	ListProjectMentions(ctx context.Context, projectID string) ([]PublicMention, error)
+	ListMentions(ctx context.Context, opts base.ListOptions) ([]Mention, error)
+	CountMentions(ctx context.Context, filters []base.FilterOption) (int, error)
+	DeleteMention(ctx context.Context, id string) error
+	// SendMentions notifies the pages linked from public projects of the
+	// tenant in ctx whose content changed since they were last notified,
+	// including pages no longer linked
+	SendMentions(ctx context.Context) (*SendReport, error)
+}
+
+type webmentionService struct {
+	mentionRepo       MentionRepository
+	sentRepo          SentRepository
+	projectService    project.ProjectService
+	siteConfigService site_config.SiteConfigService
+	client            *webmention.Client
+	projectPath       string
+
+	// mu serializes send runs started by the job and by admins
+	mu sync.Mutex
+}
+
+func NewWebmentionService(mentionRepo MentionRepository, sentRepo SentRepository, projectService project.ProjectService, siteConfigService site_config.SiteConfigService, client *webmention.Client, projectPath string) WebmentionService {
+	if !strings.Contains(projectPath, slugPlaceholder) {
+		projectPath = "/projects/" + slugPlaceholder
+	}
+
+	return &webmentionService{
+		mentionRepo:       mentionRepo,
+		sentRepo:          sentRepo,
+		projectService:    projectService,
+		siteConfigService: siteConfigService,
+		client:            client,
+		projectPath:       "/" + strings.TrimLeft(projectPath, "/"),
+	}
+}
+
+func (s *webmentionService) Receive(ctx context.Context, mentionCreate *MentionCreate) (*MentionReceipt, error) {
+	if s.client == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Webmentions are not enabled", nil)
+	}
+
+	if err := validator.ValidateModel(mentionCreate); err != nil {
+		return nil, err
+	}
+
+	source, err := parseHTTPURL(mentionCreate.Source)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Source must be an http or https URL",
+			err,
+			errors.WithContext("source", mentionCreate.Source),
+		)
+	}
+	target, err := parseHTTPURL(mentionCreate.Target)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrValidation,
+			"Target must be an http or https URL",
+			err,
+			errors.WithContext("target", mentionCreate.Target),
+		)
+	}
+	if sameURL(source, target) {
+		return nil, errors.New(errors.ErrValidation, "Source and target must be different pages", nil)
+	}
+
+	p, err := s.resolveTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.mentionRepo.FindBySourceTarget(ctx, source.String(), target.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// A mention sent again means its source changed, so it is verified again
+	// while what was verified before stays displayed
+	if existing != nil {
+		if err := s.mentionRepo.MarkPending(ctx, existing.ID.String()); err != nil {
+			return nil, err
+		}
+		return &MentionReceipt{ID: existing.ID, Status: existing.Status}, nil
+	}
+
+	mention, err := s.mentionRepo.Create(ctx, &Mention{
+		ID:         uuid.New(),
+		ProjectID:  p.ID,
+		Source:     source.String(),
+		Target:     target.String(),
+		Status:     MentionNew,
+		Pending:    true,
+		ReceivedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MentionReceipt{ID: mention.ID, Status: mention.Status}, nil
+}
+
+// resolveTarget returns the public project whose page is target
+func (s *webmentionService) resolveTarget(ctx context.Context, target *url.URL) (*project.ProjectDTO, error) {
+	rejected := errors.New(
+		errors.ErrValidation,
+		"Target does not accept Webmentions",
+		nil,
+		errors.WithContext("target", target.String()),
+	)
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		if errors.Is(err, errors.ErrConfiguration) {
+			return nil, rejected
+		}
+		return nil, err
+	}
+	if !strings.EqualFold(target.Host, canonical.Host) {
+		return nil, rejected
+	}
+
+	slug, ok := s.slugFromPath(canonical, target.Path)
+	if !ok {
+		return nil, rejected
+	}
+
+	projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+		Page:    1,
+		PerPage: 1,
+		Filters: []base.FilterOption{
+			{Field: "slug", Operator: base.OperatorEqual, Value: slug},
+			{Field: "visibility", Operator: base.OperatorEqual, Value: project.VisibilityPublic},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, rejected
+	}
+
+	return &projects[0], nil
+}
+
+// slugFromPath extracts the project slug from the path of a project page
+func (s *webmentionService) slugFromPath(canonical *url.URL, path string) (string, bool) {
+	prefix, suffix, _ := strings.Cut(s.projectPath, slugPlaceholder)
+	prefix = strings.TrimRight(canonical.Path, "/") + prefix
+	suffix = strings.TrimRight(suffix, "/")
+
+	path = strings.TrimRight(path, "/")
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) || len(path) < len(prefix)+len(suffix) {
+		return "", false
+	}
+
+	slug := path[len(prefix) : len(path)-len(suffix)]
+	if slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
+
+// projectURL returns the page URL of a project
+func (s *webmentionService) projectURL(canonical *url.URL, slug string) string {
+	return strings.TrimRight(canonical.String(), "/") +
+		strings.ReplaceAll(s.projectPath, slugPlaceholder, url.PathEscape(slug))
+}
+
+// canonicalURL returns the canonical URL of the site of the tenant in ctx,
+// which project pages are published below
+func (s *webmentionService) canonicalURL(ctx context.Context) (*url.URL, error) {
+	siteConfig, err := s.siteConfigService.GetSiteConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := parseHTTPURL(siteConfig.SEO.CanonicalURL)
+	if err != nil {
+		return nil, errors.New(
+			errors.ErrConfiguration,
+			"Site canonical URL must be set to use Webmentions",
+			err,
+		)
+	}
+	canonical.RawQuery = ""
+	canonical.Fragment = ""
+	return canonical, nil
+}
+
+func (s *webmentionService) VerifyPending(ctx context.Context) (*VerifyReport, error) {
+	if s.client == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Webmentions are not enabled", nil)
+	}
+
+	mentions, err := s.mentionRepo.ListPending(ctx, verifyBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VerifyReport{}
+	for i := range mentions {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		mention := &mentions[i]
+		s.verify(ctx, mention, report)
+		if err := s.mentionRepo.Update(ctx, mention); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// verify fetches the source of a mention and updates its status
+func (s *webmentionService) verify(ctx context.Context, mention *Mention, report *VerifyReport) {
+	mention.Pending = false
+
+	page, err := s.client.Fetch(ctx, mention.Source)
+	switch {
+	case err == nil && webmention.LinksTo(page, mention.Target):
+		entry := webmention.ParseEntry(page)
+		now := time.Now().UTC()
+		mention.Status = MentionVerified
+		mention.Title = entry.Title
+		mention.AuthorName = entry.AuthorName
+		mention.AuthorURL = entry.AuthorURL
+		mention.Excerpt = entry.Excerpt
+		mention.Error = ""
+		mention.VerifiedAt = &now
+		report.Verified++
+		return
+	case err == nil:
+		mention.Error = "source does not link to target"
+	case stderrors.Is(err, webmention.ErrGone):
+		mention.Error = "source is gone"
+	default:
+		// The source may only be down for a while, so a mention verified
+		// before stays displayed
+		mention.Error = err.Error()
+		if mention.Status != MentionVerified {
+			mention.Status = MentionRejected
+		}
+		report.Failed++
+		return
+	}
+
+	if mention.Status == MentionVerified {
+		mention.Status = MentionDeleted
+		report.Deleted++
+	} else {
+		mention.Status = MentionRejected
+		report.Rejected++
+	}
+}
+
+func (s *webmentionService) ListProjectMentions(ctx context.Context, projectID string) ([]PublicMention, error) {
+	p, err := s.projectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, errors.ErrDatabase) {
+			return nil, err
+		}
+		p = nil
+	}
+	if p == nil || !p.IsPublic() {
+		return nil, errors.New(
+			errors.ErrNotFound,
+			"Project not found",
+			nil,
+			errors.WithContext("project_id", projectID),
+		)
+	}
+
+	mentions, err := s.mentionRepo.ListByProject(ctx, projectID, MentionVerified)
+	if err != nil {
+		return nil, err
+	}
+
+	public := make([]PublicMention, len(mentions))
+	for i := range mentions {
+		public[i] = mentions[i].public()
+	}
+	return public, nil
+}
+
+func (s *webmentionService) ListMentions(ctx context.Context, opts base.ListOptions) ([]Mention, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.New(errors.ErrValidation, "Invalid list options", err)
+	}
+
+	if opts.SortBy == "" {
+		opts.SortBy = "received_at"
+		opts.SortOrder = base.SortDescending
+	}
+
+	return s.mentionRepo.List(ctx, opts)
+}
+
+func (s *webmentionService) CountMentions(ctx context.Context, filters []base.FilterOption) (int, error) {
+	return s.mentionRepo.Count(ctx, filters)
+}
+
+func (s *webmentionService) DeleteMention(ctx context.Context, id string) error {
+	mention, err := s.mentionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if mention == nil {
+		return errors.New(
+			errors.ErrNotFound,
+			"Webmention not found",
+			nil,
+			errors.WithContext("webmention_id", id),
+		)
+	}
+
+	return s.mentionRepo.Delete(ctx, id)
+}
+
+func (s *webmentionService) SendMentions(ctx context.Context) (*SendReport, error) {
+	if s.client == nil {
+		return nil, errors.New(errors.ErrConfiguration, "Webmentions are not enabled", nil)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	canonical, err := s.canonicalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := s.sentRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[string]Sent, len(sent))
+	for _, record := range sent {
+		previous[record.Source+" "+record.Target] = record
+	}
+
+	report := &SendReport{}
+	for page := 1; ; page++ {
+		projects, err := s.projectService.ListProjects(ctx, base.ListOptions{
+			Page:    page,
+			PerPage: pageSize,
+			Filters: []base.FilterOption{{
+				Field:    "visibility",
+				Operator: base.OperatorEqual,
+				Value:    project.VisibilityPublic,
+			}},
+		})
+		if err != nil {
+			return report, err
+		}
+
+		for _, p := range projects {
+			report.Projects++
+			source := s.projectURL(canonical, p.Slug)
+			hash := contentHash(p)
+
+			for _, target := range projectLinks(p, source, canonical) {
+				key := source + " " + target
+				record, found := previous[key]
+				delete(previous, key)
+
+				if found && record.ContentHash == hash && record.Status != SendFailed {
+					report.Unchanged++
+					continue
+				}
+
+				if err := s.send(ctx, p.ID, source, target, hash, report); err != nil {
+					return report, err
+				}
+			}
+		}
+
+		if len(projects) < pageSize {
+			break
+		}
+	}
+
+	// Pages no longer linked, including those of projects since deleted,
+	// hidden or renamed, are notified once more so they can drop the mention
+	var removed []string
+	for _, record := range previous {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		if record.Status == SendSent {
+			s.notify(ctx, &record)
+			s.count(&record, report)
+		}
+		removed = append(removed, record.ID.String())
+	}
+	if err := s.sentRepo.Delete(ctx, removed); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// send notifies target that source links to it and records the outcome
+func (s *webmentionService) send(ctx context.Context, projectID uuid.UUID, source, target, hash string, report *SendReport) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	record := &Sent{
+		ID:          uuid.New(),
+		ProjectID:   projectID,
+		Source:      source,
+		Target:      target,
+		ContentHash: hash,
+	}
+	s.notify(ctx, record)
+	s.count(record, report)
+
+	return s.sentRepo.Upsert(ctx, record)
+}
+
+// notify discovers the endpoint of a record's target and sends the mention,
+// storing the outcome in the record
+func (s *webmentionService) notify(ctx context.Context, record *Sent) {
+	record.SentAt = time.Now().UTC()
+	record.Endpoint = ""
+	record.StatusCode = 0
+	record.Error = ""
+
+	endpoint, err := s.client.Discover(ctx, record.Target)
+	if err != nil {
+		if stderrors.Is(err, webmention.ErrNoEndpoint) {
+			record.Status = SendNoEndpoint
+			return
+		}
+		record.Status = SendFailed
+		record.Error = err.Error()
+		return
+	}
+
+	record.Endpoint = endpoint
+	record.StatusCode, err = s.client.Send(ctx, endpoint, record.Source, record.Target)
+	if err != nil {
+		record.Status = SendFailed
+		record.Error = err.Error()
+		return
+	}
+	record.Status = SendSent
+}
+
+func (s *webmentionService) count(record *Sent, report *SendReport) {
+	switch record.Status {
+	case SendSent:
+		report.Sent++
+	case SendNoEndpoint:
+		report.NoEndpoint++
+	default:
+		report.Failed++
+	}
+}
+
+// projectLinks returns the external pages a project page links to
+func projectLinks(p project.ProjectDTO, source string, canonical *url.URL) []string {
+	sourceURL, _ := url.Parse(source)
+	links := webmention.Links([]byte(markdown.Render(p.Description)), sourceURL)
+	if p.WebUrl != "" {
+		links = append(links, p.WebUrl)
+	}
+
+	var external []string
+	seen := make(map[string]bool)
+	for _, link := range links {
+		u, err := parseHTTPURL(link)
+		if err != nil || strings.EqualFold(u.Host, canonical.Host) {
+			continue
+		}
+		u.Fragment = ""
+		if link = u.String(); !seen[link] {
+			seen[link] = true
+			external = append(external, link)
+		}
+	}
+	return external
+}
+
+// contentHash identifies the content of a project page that mentions are
+// sent for, so that targets are only notified again when it changes
+func contentHash(p project.ProjectDTO) string {
+	sum := sha256.Sum256([]byte(p.Slug + "\n" + p.Title + "\n" + p.WebUrl + "\n" + p.Description))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseHTTPURL parses an absolute http or https URL
+func parseHTTPURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, stderrors.New("not an absolute http or https URL")
+	}
+	return u, nil
+}
+
+// sameURL reports whether two URLs point to the same page
+func sameURL(a, b *url.URL) bool {
+	return strings.EqualFold(a.Host, b.Host) &&
+		strings.TrimRight(a.Path, "/") == strings.TrimRight(b.Path, "/") &&
+		a.RawQuery == b.RawQuery
+}
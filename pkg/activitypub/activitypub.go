@@ -0,0 +1,207 @@
+// Package activitypub implements the parts of ActivityPub, HTTP Signatures
+// and WebFinger needed to publish to and accept followers from the fediverse,
+// as described in https://www.w3.org/TR/activitypub/
+package activitypub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ContentType is the media type of ActivityPub documents
+const ContentType = "application/activity+json"
+
+// Context is the JSON-LD context of every document served
+var Context = []string{
+	"https://www.w3.org/ns/activitystreams",
+	"https://w3id.org/security/v1",
+}
+
+// PublicCollection addresses an activity to everyone
+const PublicCollection = "https://www.w3.org/ns/activitystreams#Public"
+
+// Actor is a person, or any other account, on the fediverse
+type Actor struct {
+	Context           interface{} `json:"@context,omitempty"`
+	ID                string      `json:"id"`
+	Type              string      `json:"type"`
+	PreferredUsername string      `json:"preferredUsername,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	Summary           string      `json:"summary,omitempty"`
+	URL               string      `json:"url,omitempty"`
+	Inbox             string      `json:"inbox"`
+	Outbox            string      `json:"outbox,omitempty"`
+	Followers         string      `json:"followers,omitempty"`
+	Endpoints         *Endpoints  `json:"endpoints,omitempty"`
+	PublicKey         *PublicKey  `json:"publicKey,omitempty"`
+	Icon              *Image      `json:"icon,omitempty"`
+}
+
+// Endpoints lists the shared endpoints of an actor's server
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+// PublicKey is the key an actor signs its requests with
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Image is an image attached to an actor or object
+type Image struct {
+	Type      string `json:"type"`
+	MediaType string `json:"mediaType,omitempty"`
+	URL       string `json:"url"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Activity is an action performed by an actor. Object is either the ID of
+// an object or the object itself.
+type Activity struct {
+	Context   interface{}     `json:"@context,omitempty"`
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Actor     string          `json:"actor"`
+	Object    json.RawMessage `json:"object,omitempty"`
+	To        []string        `json:"to,omitempty"`
+	Cc        []string        `json:"cc,omitempty"`
+	Published string          `json:"published,omitempty"`
+}
+
+// ObjectID returns the ID of the activity's object, whether the object is
+// given by ID or embedded
+func (a *Activity) ObjectID() string {
+	var id string
+	if err := json.Unmarshal(a.Object, &id); err == nil {
+		return id
+	}
+
+	var object struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(a.Object, &object); err == nil {
+		return object.ID
+	}
+	return ""
+}
+
+// ObjectActivity decodes an embedded activity object, such as the Follow
+// undone by an Undo
+func (a *Activity) ObjectActivity() (*Activity, bool) {
+	var object Activity
+	if err := json.Unmarshal(a.Object, &object); err != nil || object.Type == "" {
+		return nil, false
+	}
+	return &object, true
+}
+
+// Note is a short post
+type Note struct {
+	Context      interface{} `json:"@context,omitempty"`
+	ID           string      `json:"id"`
+	Type         string      `json:"type"`
+	AttributedTo string      `json:"attributedTo"`
+	Content      string      `json:"content"`
+	URL          string      `json:"url,omitempty"`
+	To           []string    `json:"to,omitempty"`
+	Cc           []string    `json:"cc,omitempty"`
+	Published    string      `json:"published,omitempty"`
+	Updated      string      `json:"updated,omitempty"`
+	Attachment   []Image     `json:"attachment,omitempty"`
+}
+
+// OrderedCollection is a paged list of items, newest first
+type OrderedCollection struct {
+	Context      interface{}   `json:"@context,omitempty"`
+	ID           string        `json:"id"`
+	Type         string        `json:"type"`
+	TotalItems   int           `json:"totalItems"`
+	First        string        `json:"first,omitempty"`
+	PartOf       string        `json:"partOf,omitempty"`
+	Next         string        `json:"next,omitempty"`
+	OrderedItems []interface{} `json:"orderedItems,omitempty"`
+}
+
+// WebFinger is the JSON Resource Descriptor answering a WebFinger query
+type WebFinger struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+// WebFingerLink points to a representation of a WebFinger subject
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
+
+// GenerateKey creates an RSA key pair for signing requests, returned as PEM
+func GenerateKey() (privateKeyPem, publicKeyPem string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", fmt.Errorf("activitypub: failed to generate key: %w", err)
+	}
+
+	private, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("activitypub: failed to encode private key: %w", err)
+	}
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("activitypub: failed to encode public key: %w", err)
+	}
+
+	privateKeyPem = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: private}))
+	publicKeyPem = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public}))
+	return privateKeyPem, publicKeyPem, nil
+}
+
+// ParsePrivateKey decodes a PEM encoded RSA private key
+func ParsePrivateKey(privateKeyPem string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, errors.New("activitypub: invalid private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: invalid private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("activitypub: private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// ParsePublicKey decodes a PEM encoded RSA public key
+func ParsePublicKey(publicKeyPem string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return nil, errors.New("activitypub: invalid public key")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: invalid public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("activitypub: public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
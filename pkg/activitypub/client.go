@@ -0,0 +1,129 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/safehttp"
+)
+
+// maxDocumentSize bounds the size of fetched actor documents
+const maxDocumentSize = 1 << 20
+
+// Client fetches remote actors and delivers activities to their inboxes,
+// signing every request. It refuses to connect to private and loopback
+// addresses, since the URLs it requests come from strangers.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+// NewClient creates a client with a per-request timeout
+func NewClient(timeout time.Duration, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if userAgent == "" {
+		userAgent = "itsrama-activitypub/1.0"
+	}
+
+	return &Client{
+		httpClient: safehttp.NewClient(timeout),
+		userAgent:  userAgent,
+	}
+}
+
+// FetchActor retrieves a remote actor, signing the request with key as
+// servers running in authorized fetch mode require
+func (c *Client) FetchActor(ctx context.Context, actorURL, keyID string, key *rsa.PrivateKey) (*Actor, error) {
+	u, err := url.Parse(actorURL)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: invalid actor URL %q", actorURL)
+	}
+	u.Fragment = ""
+	if err := validateURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", ContentType+`, application/ld+json; profile="https://www.w3.org/ns/activitystreams"`)
+	if err := Sign(req, nil, keyID, key); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("activitypub: fetching actor answered %d", resp.StatusCode)
+	}
+
+	var actor Actor
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxDocumentSize)).Decode(&actor); err != nil {
+		return nil, fmt.Errorf("activitypub: invalid actor document: %w", err)
+	}
+	if actor.ID == "" || actor.Inbox == "" {
+		return nil, fmt.Errorf("activitypub: actor document lacks an ID or inbox")
+	}
+	return &actor, nil
+}
+
+// Deliver posts an activity to an inbox
+func (c *Client) Deliver(ctx context.Context, inbox string, activity interface{}, keyID string, key *rsa.PrivateKey) error {
+	u, err := url.Parse(inbox)
+	if err != nil {
+		return fmt.Errorf("activitypub: invalid inbox %q", inbox)
+	}
+	if err := validateURL(u); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("activitypub: failed to encode activity: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("activitypub: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Content-Type", ContentType)
+	if err := Sign(req, body, keyID, key); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("activitypub: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDocumentSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("activitypub: inbox answered %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// validateURL only allows absolute http(s) URLs
+func validateURL(u *url.URL) error {
+	if !safehttp.ValidURL(u) {
+		return fmt.Errorf("activitypub: invalid URL %q", u.String())
+	}
+	return nil
+}
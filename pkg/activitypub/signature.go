@@ -0,0 +1,137 @@
+package activitypub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxClockSkew is how far the Date of a signed request may be from now
+const maxClockSkew = 12 * time.Hour
+
+// ErrInvalidSignature is returned when a request is unsigned or its
+// signature does not verify
+var ErrInvalidSignature = errors.New("activitypub: invalid signature")
+
+// Sign adds an HTTP signature over the request target, host, date and, for
+// requests with a body, its digest, as expected by Mastodon
+func Sign(req *http.Request, body []byte, keyID string, key *rsa.PrivateKey) error {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	req.Header.Set("Host", host)
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		req.Header.Set("Digest", digest(body))
+		headers = append(headers, "digest")
+	}
+
+	hash := sha256.Sum256([]byte(signingString(req, host, headers)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return fmt.Errorf("activitypub: failed to sign request: %w", err)
+	}
+
+	req.Header.Set("Signature", fmt.Sprintf(
+		`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(signature),
+	))
+	return nil
+}
+
+// Verify checks the HTTP signature of a received request with the key
+// returned by lookup, and returns the ID of that key
+func Verify(req *http.Request, body []byte, lookup func(keyID string) (*rsa.PublicKey, error)) (string, error) {
+	params := signatureParams(req.Header.Get("Signature"))
+	keyID, encoded := params["keyId"], params["signature"]
+	if keyID == "" || encoded == "" {
+		return "", ErrInvalidSignature
+	}
+	if algorithm := params["algorithm"]; algorithm != "" && algorithm != "rsa-sha256" && algorithm != "hs2019" {
+		return "", fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSignature, algorithm)
+	}
+
+	headers := strings.Fields(strings.ToLower(params["headers"]))
+	if len(headers) == 0 {
+		headers = []string{"date"}
+	}
+	required := []string{"(request-target)", "host", "date"}
+	if req.Method == http.MethodPost {
+		required = append(required, "digest")
+	}
+	for _, header := range required {
+		if !slices.Contains(headers, header) {
+			return "", fmt.Errorf("%w: %s is not signed", ErrInvalidSignature, header)
+		}
+	}
+
+	date, err := http.ParseTime(req.Header.Get("Date"))
+	if err != nil || time.Since(date).Abs() > maxClockSkew {
+		return "", fmt.Errorf("%w: date is missing or too far off", ErrInvalidSignature)
+	}
+	if req.Method == http.MethodPost && req.Header.Get("Digest") != digest(body) {
+		return "", fmt.Errorf("%w: digest does not match body", ErrInvalidSignature)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	key, err := lookup(keyID)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(signingString(req, req.Host, headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature); err != nil {
+		return "", ErrInvalidSignature
+	}
+	return keyID, nil
+}
+
+// signingString joins the signed headers of a request
+func signingString(req *http.Request, host string, headers []string) string {
+	lines := make([]string, len(headers))
+	for i, header := range headers {
+		var value string
+		switch header {
+		case "(request-target)":
+			value = strings.ToLower(req.Method) + " " + req.URL.RequestURI()
+		case "host":
+			value = host
+		default:
+			value = strings.Join(req.Header.Values(header), ", ")
+		}
+		lines[i] = header + ": " + value
+	}
+	return strings.Join(lines, "\n")
+}
+
+// signatureParams parses the key="value" pairs of a Signature header
+func signatureParams(header string) map[string]string {
+	params := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			params[key] = strings.Trim(value, `"`)
+		}
+	}
+	return params
+}
+
+func digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
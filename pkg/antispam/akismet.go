@@ -0,0 +1,93 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// AkismetConfig configures the Akismet engine
+type AkismetConfig struct {
+	APIKey string
+
+	// Blog is the front page URL of the site the submissions belong to
+	Blog string
+}
+
+// Akismet scores submissions with the Akismet comment-check API
+type Akismet struct {
+	apiKey     string
+	blog       string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewAkismet creates an Akismet engine
+func NewAkismet(cfg AkismetConfig) (*Akismet, error) {
+	if cfg.APIKey == "" || cfg.Blog == "" {
+		return nil, fmt.Errorf("akismet API key and blog URL are required")
+	}
+
+	return &Akismet{
+		apiKey:     cfg.APIKey,
+		blog:       cfg.Blog,
+		baseURL:    "https://rest.akismet.com/1.1",
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Name returns the engine name
+func (a *Akismet) Name() string {
+	return "akismet"
+}
+
+// Check asks Akismet whether the submission is spam
+func (a *Akismet) Check(ctx context.Context, s Submission) (*Verdict, error) {
+	form := url.Values{
+		"api_key":              {a.apiKey},
+		"blog":                 {a.blog},
+		"user_ip":              {s.IP},
+		"user_agent":           {s.UserAgent},
+		"referrer":             {s.Referrer},
+		"permalink":            {s.Permalink},
+		"comment_type":         {s.Kind},
+		"comment_author":       {s.Author},
+		"comment_author_email": {s.Email},
+		"comment_author_url":   {s.URL},
+		"comment_content":      {s.Content},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/comment-check", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	switch strings.TrimSpace(string(body)) {
+	case "true":
+		// Akismet marks blatant spam that can be discarded outright
+		if resp.Header.Get("X-akismet-pro-tip") == "discard" {
+			return &Verdict{Score: 1, Reasons: []string{"blatant spam"}}, nil
+		}
+		return &Verdict{Score: 0.9, Reasons: []string{"flagged as spam"}}, nil
+	case "false":
+		return &Verdict{}, nil
+	default:
+		return nil, fmt.Errorf("invalid response: %s", resp.Header.Get("X-akismet-debug-help"))
+	}
+}
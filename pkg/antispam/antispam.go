@@ -0,0 +1,128 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Submission is user generated content to be checked for spam
+type Submission struct {
+	// Kind describes the submission, e.g. "contact-form" or "comment"
+	Kind      string
+	Author    string
+	Email     string
+	URL       string
+	Content   string
+	IP        string
+	UserAgent string
+	Referrer  string
+	Permalink string
+
+	// Honeypot is the value of a hidden form field that humans leave empty
+	Honeypot string
+}
+
+// Verdict is the outcome of a spam check
+type Verdict struct {
+	// Score ranges from 0 (ham) to 1 (certain spam)
+	Score   float64  `json:"score"`
+	Spam    bool     `json:"spam"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// Engine scores a submission
+type Engine interface {
+	Name() string
+	Check(ctx context.Context, s Submission) (*Verdict, error)
+}
+
+// Config configures a Filter
+type Config struct {
+	// Engines lists the engines to run: "heuristic" and/or "akismet"
+	Engines []string
+
+	// Threshold is the score at or above which a submission is flagged
+	Threshold float64
+
+	Heuristic HeuristicConfig
+	Akismet   AkismetConfig
+}
+
+// Filter runs submissions through one or more engines and flags those whose
+// highest score reaches the threshold
+type Filter struct {
+	engines   []Engine
+	threshold float64
+}
+
+// NewFilter creates a filter from the given engines
+func NewFilter(threshold float64, engines ...Engine) *Filter {
+	if threshold <= 0 {
+		threshold = 0.5
+	}
+
+	return &Filter{
+		engines:   engines,
+		threshold: threshold,
+	}
+}
+
+// NewFilterFromConfig creates a filter with the engines named in cfg
+func NewFilterFromConfig(cfg Config) (*Filter, error) {
+	names := cfg.Engines
+	if len(names) == 0 {
+		names = []string{"heuristic"}
+	}
+
+	engines := make([]Engine, 0, len(names))
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "heuristic":
+			engines = append(engines, NewHeuristic(cfg.Heuristic))
+		case "akismet":
+			engine, err := NewAkismet(cfg.Akismet)
+			if err != nil {
+				return nil, err
+			}
+			engines = append(engines, engine)
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown antispam engine %q", name)
+		}
+	}
+
+	return NewFilter(cfg.Threshold, engines...), nil
+}
+
+// Check scores a submission with every engine. Engine failures are skipped so
+// a flaky remote service never blocks submissions; the error is returned only
+// when no engine produced a verdict.
+func (f *Filter) Check(ctx context.Context, s Submission) (*Verdict, error) {
+	verdict := &Verdict{}
+	var errs []string
+	checked := 0
+
+	for _, engine := range f.engines {
+		v, err := engine.Check(ctx, s)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", engine.Name(), err))
+			continue
+		}
+		checked++
+
+		if v.Score > verdict.Score {
+			verdict.Score = v.Score
+		}
+		for _, reason := range v.Reasons {
+			verdict.Reasons = append(verdict.Reasons, engine.Name()+": "+reason)
+		}
+	}
+
+	if checked == 0 && len(errs) > 0 {
+		return nil, fmt.Errorf("antispam check failed: %s", strings.Join(errs, "; "))
+	}
+
+	verdict.Spam = verdict.Score >= f.threshold
+	return verdict, nil
+}
@@ -0,0 +1,92 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// linkPattern matches URLs and bare www links
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+// defaultKeywords are phrases commonly found in spam
+var defaultKeywords = []string{
+	"casino", "viagra", "crypto investment", "seo services", "backlinks",
+	"buy followers", "loan offer", "work from home", "click here", "limited offer",
+}
+
+// HeuristicConfig configures the heuristic engine
+type HeuristicConfig struct {
+	// Keywords are case insensitive phrases that raise the score
+	Keywords []string
+
+	// MaxLinks is the number of links tolerated before the score rises
+	MaxLinks int
+}
+
+// Heuristic scores submissions with local rules: a filled honeypot field,
+// spam keywords and excessive links
+type Heuristic struct {
+	keywords []string
+	maxLinks int
+}
+
+// NewHeuristic creates a heuristic engine
+func NewHeuristic(cfg HeuristicConfig) *Heuristic {
+	keywords := cfg.Keywords
+	if len(keywords) == 0 {
+		keywords = defaultKeywords
+	}
+
+	normalized := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		if keyword = strings.ToLower(strings.TrimSpace(keyword)); keyword != "" {
+			normalized = append(normalized, keyword)
+		}
+	}
+
+	maxLinks := cfg.MaxLinks
+	if maxLinks <= 0 {
+		maxLinks = 2
+	}
+
+	return &Heuristic{
+		keywords: normalized,
+		maxLinks: maxLinks,
+	}
+}
+
+// Name returns the engine name
+func (h *Heuristic) Name() string {
+	return "heuristic"
+}
+
+// Check scores the submission
+func (h *Heuristic) Check(ctx context.Context, s Submission) (*Verdict, error) {
+	verdict := &Verdict{}
+
+	if strings.TrimSpace(s.Honeypot) != "" {
+		verdict.Score = 1
+		verdict.Reasons = append(verdict.Reasons, "honeypot field filled")
+		return verdict, nil
+	}
+
+	text := strings.ToLower(s.Author + " " + s.Content)
+	for _, keyword := range h.keywords {
+		if strings.Contains(text, keyword) {
+			verdict.Score += 0.3
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("contains %q", keyword))
+		}
+	}
+
+	if links := len(linkPattern.FindAllString(s.Content, -1)); links > h.maxLinks {
+		verdict.Score += 0.2 * float64(links-h.maxLinks)
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("contains %d links", links))
+	}
+
+	if verdict.Score > 1 {
+		verdict.Score = 1
+	}
+	return verdict, nil
+}
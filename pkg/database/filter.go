@@ -0,0 +1,96 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Filter builds the WHERE clause of a statement. Conditions are joined
+// with AND and use ? as the placeholder for their arguments.
+type Filter struct {
+	conditions []string
+	args       []any
+}
+
+// NewFilter creates an empty filter
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+// Eq matches rows whose column equals value. Values are compared as text,
+// as the REST API does, so that any column type can be filtered on.
+func (f *Filter) Eq(column string, value any) *Filter {
+	return f.Where(Column(column)+"::text = ?", fmt.Sprintf("%v", value))
+}
+
+// Like matches rows whose column matches a LIKE pattern
+func (f *Filter) Like(column string, pattern string) *Filter {
+	return f.Where(Column(column)+"::text LIKE ?", pattern)
+}
+
+// In matches rows whose column is one of values
+func (f *Filter) In(column string, values []string) *Filter {
+	return f.Where(Column(column)+"::text = ANY(?)", values)
+}
+
+// Where adds a raw condition
+func (f *Filter) Where(condition string, args ...any) *Filter {
+	f.conditions = append(f.conditions, "("+condition+")")
+	f.args = append(f.args, args...)
+	return f
+}
+
+// SQL renders the WHERE clause, numbering placeholders after the first
+// offset arguments of the statement
+func (f *Filter) SQL(offset int) (string, []any) {
+	if f == nil || len(f.conditions) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString("WHERE ")
+	n := offset
+	for i, condition := range f.conditions {
+		if i > 0 {
+			b.WriteString(" AND ")
+		}
+		for _, part := range strings.SplitAfter(condition, "?") {
+			if strings.HasSuffix(part, "?") {
+				n++
+				b.WriteString(strings.TrimSuffix(part, "?") + "$" + strconv.Itoa(n))
+				continue
+			}
+			b.WriteString(part)
+		}
+	}
+
+	return b.String(), f.args
+}
+
+// Page renders the ORDER BY, LIMIT and OFFSET of a page of results.
+// Without sortBy rows are not ordered.
+func Page(sortBy string, ascending bool, page, perPage int) string {
+	var b strings.Builder
+	if sortBy != "" {
+		direction := "DESC"
+		if ascending {
+			direction = "ASC"
+		}
+		fmt.Fprintf(&b, "ORDER BY %s %s ", Column(sortBy), direction)
+	}
+	if page < 1 {
+		page = 1
+	}
+	if perPage > 0 {
+		fmt.Fprintf(&b, "LIMIT %d OFFSET %d", perPage, (page-1)*perPage)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// Column quotes a column name, which may come from request parameters
+func Column(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *Filter
+		offset   int
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:   "nil filter",
+			filter: nil,
+		},
+		{
+			name:   "empty filter",
+			filter: NewFilter(),
+		},
+		{
+			name:     "single condition",
+			filter:   NewFilter().Eq("id", 42),
+			wantSQL:  `WHERE ("id"::text = $1)`,
+			wantArgs: []any{"42"},
+		},
+		{
+			name:     "conditions joined with and",
+			filter:   NewFilter().Eq("tenant_id", "t").Like("title", "%go%"),
+			wantSQL:  `WHERE ("tenant_id"::text = $1) AND ("title"::text LIKE $2)`,
+			wantArgs: []any{"t", "%go%"},
+		},
+		{
+			name:     "numbered after the statement arguments",
+			filter:   NewFilter().Eq("id", "a").In("status", []string{"draft", "published"}),
+			offset:   3,
+			wantSQL:  `WHERE ("id"::text = $4) AND ("status"::text = ANY($5))`,
+			wantArgs: []any{"a", []string{"draft", "published"}},
+		},
+		{
+			name:     "several placeholders in one condition",
+			filter:   NewFilter().Where("title ILIKE ? OR category ILIKE ?", "%a%", "%a%").Eq("id", "b"),
+			wantSQL:  `WHERE (title ILIKE $1 OR category ILIKE $2) AND ("id"::text = $3)`,
+			wantArgs: []any{"%a%", "%a%", "b"},
+		},
+		{
+			name:     "condition without placeholders",
+			filter:   NewFilter().Where("deleted_at IS NULL").Eq("id", "c"),
+			wantSQL:  `WHERE (deleted_at IS NULL) AND ("id"::text = $1)`,
+			wantArgs: []any{"c"},
+		},
+		{
+			name:     "quoted column names",
+			filter:   NewFilter().Eq(`title"; DROP TABLE project; --`, "d"),
+			wantSQL:  `WHERE ("title""; DROP TABLE project; --"::text = $1)`,
+			wantArgs: []any{"d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := tt.filter.SQL(tt.offset)
+			if sql != tt.wantSQL {
+				t.Errorf("SQL() = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("SQL() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		ascending bool
+		page      int
+		perPage   int
+		want      string
+	}{
+		{"first page", "created_at", false, 1, 10, `ORDER BY "created_at" DESC LIMIT 10 OFFSET 0`},
+		{"later page ascending", "title", true, 3, 20, `ORDER BY "title" ASC LIMIT 20 OFFSET 40`},
+		{"page below one", "title", true, 0, 5, `ORDER BY "title" ASC LIMIT 5 OFFSET 0`},
+		{"unsorted", "", false, 2, 10, `LIMIT 10 OFFSET 10`},
+		{"unpaginated", "title", false, 1, 0, `ORDER BY "title" DESC`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Page(tt.sortBy, tt.ascending, tt.page, tt.perPage); got != tt.want {
+				t.Errorf("Page() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Package database talks to Postgres directly through pgx, as an
+// alternative to the Supabase REST API. Rows are exchanged as JSON, like
+// PostgREST does, so the same models work with both.
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Config holds the connection settings of a Postgres database
+type Config struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Name     string
+	// Schema is put first on the search path, so tables can be referred to
+	// without it
+	Schema   string
+	SSLMode  string
+	MaxConns int32
+
+	// SlowQueries, when set, is told about every statement
+	SlowQueries *slowcall.Tracker
+}
+
+// DB is a pool of Postgres connections
+type DB struct {
+	pool *pgxpool.Pool
+}
+
+// querier runs statements on the pool or a transaction
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// txContextKey is the context key holding the transaction started by InTx
+type txContextKey struct{}
+
+// Open connects to the database and checks the connection
+func Open(ctx context.Context, cfg Config) (*DB, error) {
+	if cfg.SSLMode == "" {
+		cfg.SSLMode = "disable"
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     cfg.Host + ":" + strconv.Itoa(cfg.Port),
+		Path:     cfg.Name,
+		RawQuery: url.Values{"sslmode": {cfg.SSLMode}}.Encode(),
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid database configuration: %w", err)
+	}
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.SlowQueries != nil {
+		poolConfig.ConnConfig.Tracer = &slowQueryTracer{tracker: cfg.SlowQueries}
+	}
+	if cfg.Schema != "" {
+		poolConfig.ConnConfig.RuntimeParams["search_path"] = pgx.Identifier{cfg.Schema}.Sanitize() + ",public"
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := pool.Ping(pingCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return &DB{pool: pool}, nil
+}
+
+// Close closes all connections
+func (db *DB) Close() {
+	db.pool.Close()
+}
+
+// Ping checks that the database is reachable
+func (db *DB) Ping(ctx context.Context) error {
+	return db.pool.Ping(ctx)
+}
+
+// InTx runs fn in a transaction, which the statements issued with the
+// context passed to fn take part in. The transaction is committed when fn
+// succeeds and rolled back otherwise. Nested calls join the outer
+// transaction.
+func (db *DB) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Select runs query and decodes its rows into dest, a pointer to a slice.
+// Each row is converted to a JSON object keyed by column name, so columns
+// holding nested rows can be built with jsonb functions.
+func (db *DB) Select(ctx context.Context, dest any, query string, args ...any) error {
+	rows, err := db.conn(ctx).Query(ctx, "SELECT to_jsonb(r) FROM ("+query+") r", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var encoded []json.RawMessage
+	for rows.Next() {
+		var row json.RawMessage
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		encoded = append(encoded, row)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if encoded == nil {
+		encoded = []json.RawMessage{}
+	}
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// Count returns the number of rows query returns
+func (db *DB) Count(ctx context.Context, query string, args ...any) (int, error) {
+	var count int
+	err := db.conn(ctx).QueryRow(ctx, "SELECT count(*) FROM ("+query+") r", args...).Scan(&count)
+	return count, err
+}
+
+// Exec runs a statement and returns the number of rows it affected
+func (db *DB) Exec(ctx context.Context, statement string, args ...any) (int64, error) {
+	tag, err := db.conn(ctx).Exec(ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Insert adds row to table. The row is encoded as JSON, whose keys name
+// the columns to set; columns left out get their default.
+func (db *DB) Insert(ctx context.Context, table string, row any) error {
+	data, columns, err := encodeRow(row)
+	if err != nil {
+		return err
+	}
+
+	name := pgx.Identifier{table}.Sanitize()
+	statement := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM jsonb_populate_record(NULL::%s, $1)",
+		name, columns, columns, name)
+	_, err = db.conn(ctx).Exec(ctx, statement, data)
+	return err
+}
+
+// Update sets the columns encoded in row, as for Insert, on the rows of
+// table matching filter, and returns the number of rows updated
+func (db *DB) Update(ctx context.Context, table string, row any, filter *Filter) (int64, error) {
+	data, columns, err := encodeRow(row)
+	if err != nil {
+		return 0, err
+	}
+
+	where, args := filter.SQL(1)
+	name := pgx.Identifier{table}.Sanitize()
+	statement := fmt.Sprintf("UPDATE %s SET (%s) = (SELECT %s FROM jsonb_populate_record(NULL::%s, $1)) %s",
+		name, columns, columns, name, where)
+	return db.Exec(ctx, statement, append([]any{data}, args...)...)
+}
+
+// Delete removes the rows of table matching filter
+func (db *DB) Delete(ctx context.Context, table string, filter *Filter) (int64, error) {
+	where, args := filter.SQL(0)
+	return db.Exec(ctx, fmt.Sprintf("DELETE FROM %s %s", pgx.Identifier{table}.Sanitize(), where), args...)
+}
+
+// conn returns the transaction in ctx, or the pool outside of one
+func (db *DB) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.pool
+}
+
+// encodeRow encodes row as a JSON object and lists its keys as columns
+func encodeRow(row any) ([]byte, string, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode row: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, "", fmt.Errorf("row must encode to a JSON object: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, "", fmt.Errorf("row has no columns")
+	}
+
+	columns := make([]string, 0, len(fields))
+	for name := range fields {
+		columns = append(columns, pgx.Identifier{name}.Sanitize())
+	}
+	return data, strings.Join(columns, ", "), nil
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxReportedSQL bounds the statement text and arguments reported for a
+// slow query
+const maxReportedSQL = 500
+
+// slowQueryTracer reports the statements taking longer than the threshold
+// of its tracker
+type slowQueryTracer struct {
+	tracker *slowcall.Tracker
+}
+
+// queryStart is what the tracer keeps about a running statement
+type queryStart struct {
+	at   time.Time
+	sql  string
+	args []any
+}
+
+type queryStartKey struct{}
+
+func (t *slowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{at: time.Now(), sql: data.SQL, args: data.Args})
+}
+
+func (t *slowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+
+	elapsed := time.Since(start.at)
+	if elapsed < t.tracker.Threshold() {
+		return
+	}
+
+	sql := truncate(strings.Join(strings.Fields(start.sql), " "))
+	details := map[string]string{"sql": sql}
+	if len(start.args) > 0 {
+		details["args"] = truncate(fmt.Sprintf("%v", start.args))
+	}
+	if data.Err != nil {
+		details["error"] = data.Err.Error()
+	}
+	t.tracker.Observe(sql, elapsed, details)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxReportedSQL {
+		return s
+	}
+	return s[:maxReportedSQL] + "…"
+}
@@ -0,0 +1,88 @@
+// Package diagram renders Mermaid and PlantUML diagrams to SVG through a
+// Kroki compatible rendering server
+package diagram
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxDiagramSize bounds the SVG read from the rendering server
+const maxDiagramSize = 5 << 20
+
+// ErrUnsupported is returned for code block languages that are not diagrams
+var ErrUnsupported = errors.New("diagram: unsupported language")
+
+// kinds maps code block languages to the diagram types of the server
+var kinds = map[string]string{
+	"mermaid":  "mermaid",
+	"plantuml": "plantuml",
+	"puml":     "plantuml",
+}
+
+// Kind returns the diagram type rendered for a code block language, and
+// false when the language is not a diagram
+func Kind(language string) (string, bool) {
+	kind, ok := kinds[strings.ToLower(language)]
+	return kind, ok
+}
+
+// Client renders diagrams with a Kroki compatible server
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the server at baseURL, e.g.
+// https://kroki.io
+func NewClient(baseURL string, timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Render renders the diagram in the code block of language to SVG
+func (c *Client) Render(ctx context.Context, language, source string) ([]byte, error) {
+	kind, ok := Kind(language)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+kind+"/svg", strings.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("diagram: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Accept", "image/svg+xml")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("diagram: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("diagram: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDiagramSize))
+	if err != nil {
+		return nil, fmt.Errorf("diagram: failed to read response: %w", err)
+	}
+	if !bytes.Contains(data, []byte("<svg")) {
+		return nil, fmt.Errorf("diagram: response is not an SVG image")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,100 @@
+// Package embedding turns text into vectors with a configurable embedding
+// provider
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrDisabled is returned by the embedder used when no provider is configured
+var ErrDisabled = errors.New("embedding is disabled")
+
+// maxResponseSize bounds the response read from an embedding API
+const maxResponseSize = 32 << 20
+
+// Embedder returns one vector per text, all of the configured dimensions
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model identifies the provider and model, so that vectors produced by
+	// another model can be detected and replaced
+	Model() string
+}
+
+// Config selects and configures an embedding provider
+type Config struct {
+	// Provider is "openai", "gemini" or "none". The openai provider works
+	// with any service exposing an OpenAI compatible /embeddings endpoint.
+	Provider string
+
+	APIKey string
+
+	// APIURL overrides the provider's base URL
+	APIURL string
+
+	Model string
+
+	// Dimensions is the length of the vectors requested from the provider
+	Dimensions int
+
+	Timeout time.Duration
+}
+
+// NewEmbedder creates the embedder for the configured provider
+func NewEmbedder(cfg Config) (Embedder, error) {
+	if cfg.Dimensions <= 0 {
+		cfg.Dimensions = 768
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+
+	switch cfg.Provider {
+	case "", "none":
+		return disabled{}, nil
+	case "openai":
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("embedding API key is required for the openai provider")
+		}
+		if cfg.Model == "" {
+			cfg.Model = "text-embedding-3-small"
+		}
+		return newOpenAIEmbedder(cfg), nil
+	case "gemini":
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("embedding API key is required for the gemini provider")
+		}
+		if cfg.Model == "" {
+			cfg.Model = "text-embedding-004"
+		}
+		return newGeminiEmbedder(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q", cfg.Provider)
+	}
+}
+
+// checkVectors verifies that a provider returned one vector of the expected
+// length per text
+func checkVectors(vectors [][]float32, texts, dimensions int) error {
+	if len(vectors) != texts {
+		return fmt.Errorf("embedding: expected %d vectors, got %d", texts, len(vectors))
+	}
+	for _, vector := range vectors {
+		if len(vector) != dimensions {
+			return fmt.Errorf("embedding: expected %d dimensions, got %d", dimensions, len(vector))
+		}
+	}
+	return nil
+}
+
+type disabled struct{}
+
+func (disabled) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return nil, ErrDisabled
+}
+
+func (disabled) Model() string {
+	return "none"
+}
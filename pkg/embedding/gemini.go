@@ -0,0 +1,107 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const geminiURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// geminiEmbedder calls the Gemini batchEmbedContents endpoint
+type geminiEmbedder struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+func newGeminiEmbedder(cfg Config) *geminiEmbedder {
+	if cfg.APIURL == "" {
+		cfg.APIURL = geminiURL
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+	cfg.Model = strings.TrimPrefix(cfg.Model, "models/")
+
+	return &geminiEmbedder{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (e *geminiEmbedder) Model() string {
+	return "gemini/" + e.cfg.Model
+}
+
+func (e *geminiEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	type content struct {
+		Parts []map[string]string `json:"parts"`
+	}
+	type embedRequest struct {
+		Model                string  `json:"model"`
+		Content              content `json:"content"`
+		OutputDimensionality int     `json:"outputDimensionality"`
+	}
+
+	model := "models/" + e.cfg.Model
+	requests := make([]embedRequest, len(texts))
+	for i, text := range texts {
+		requests[i] = embedRequest{
+			Model:                model,
+			Content:              content{Parts: []map[string]string{{"text": text}}},
+			OutputDimensionality: e.cfg.Dimensions,
+		}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"requests": requests})
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to encode request: %w", err)
+	}
+
+	endpoint := e.cfg.APIURL + "/" + model + ":batchEmbedContents"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to build request: %w", err)
+	}
+	req.Header.Set("x-goog-api-key", e.cfg.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embedding: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding: unexpected status %d: %s", resp.StatusCode, truncate(data))
+	}
+
+	var result struct {
+		Embeddings []struct {
+			Values []float32 `json:"values"`
+		} `json:"embeddings"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("embedding: failed to decode response: %w", err)
+	}
+
+	vectors := make([][]float32, len(result.Embeddings))
+	for i, item := range result.Embeddings {
+		vectors[i] = item.Values
+	}
+
+	if err := checkVectors(vectors, len(texts), e.cfg.Dimensions); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
@@ -0,0 +1,103 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const openAIURL = "https://api.openai.com/v1"
+
+// openAIEmbedder calls an OpenAI compatible /embeddings endpoint
+type openAIEmbedder struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+func newOpenAIEmbedder(cfg Config) *openAIEmbedder {
+	if cfg.APIURL == "" {
+		cfg.APIURL = openAIURL
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+
+	return &openAIEmbedder{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (e *openAIEmbedder) Model() string {
+	return "openai/" + e.cfg.Model
+}
+
+func (e *openAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"model":      e.cfg.Model,
+		"input":      texts,
+		"dimensions": e.cfg.Dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.APIURL+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+e.cfg.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embedding: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("embedding: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding: unexpected status %d: %s", resp.StatusCode, truncate(data))
+	}
+
+	var result struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("embedding: failed to decode response: %w", err)
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, item := range result.Data {
+		if item.Index < 0 || item.Index >= len(vectors) {
+			return nil, fmt.Errorf("embedding: unexpected index %d", item.Index)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+
+	if err := checkVectors(vectors, len(texts), e.cfg.Dimensions); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
+
+// truncate shortens an error response for inclusion in an error message
+func truncate(data []byte) string {
+	const max = 200
+	if len(data) > max {
+		return string(data[:max]) + "..."
+	}
+	return string(data)
+}
@@ -28,6 +28,11 @@ const (
 	ErrMethodNotAllowed ErrorType = "METHOD_NOT_ALLOWED_ERROR"
 	ErrFileUpload       ErrorType = "FILE_UPLOAD_ERROR"
 	ErrStorage          ErrorType = "STORAGE_ERROR"
+	ErrTooManyRequests  ErrorType = "TOO_MANY_REQUESTS_ERROR"
+	ErrPayloadTooLarge  ErrorType = "PAYLOAD_TOO_LARGE_ERROR"
+	ErrUnavailable      ErrorType = "SERVICE_UNAVAILABLE_ERROR"
+	ErrConfirmation     ErrorType = "CONFIRMATION_REQUIRED"
+	ErrGone             ErrorType = "GONE_ERROR"
 )
 
 // CustomError represents a structured error with additional context
@@ -148,3 +153,15 @@ func Is(err error, errorType ErrorType) bool {
 	}
 	return false
 }
+
+// Preserve returns the type of err when it is one of types, and fallback
+// otherwise, so that wrapping does not hide a meaningful type such as not
+// found
+func Preserve(err error, fallback ErrorType, types ...ErrorType) ErrorType {
+	for _, errorType := range types {
+		if Is(err, errorType) {
+			return errorType
+		}
+	}
+	return fallback
+}
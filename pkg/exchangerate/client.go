@@ -0,0 +1,97 @@
+// Package exchangerate reads currency exchange rates from an
+// open.er-api.com compatible API
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Rates are the units of each currency worth one unit of Base
+type Rates struct {
+	Base      string
+	Rates     map[string]float64
+	UpdatedAt time.Time
+}
+
+// Client fetches the latest rates
+type Client struct {
+	// url is the endpoint with a {base} placeholder
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the endpoint at rawURL, which must contain
+// a {base} placeholder
+func NewClient(rawURL string, timeout time.Duration) (*Client, error) {
+	if !strings.Contains(rawURL, "{base}") {
+		return nil, fmt.Errorf("exchange rate URL must contain a {base} placeholder")
+	}
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+
+	return &Client{
+		url:        rawURL,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Latest returns the current rates against base
+func (c *Client) Latest(ctx context.Context, base string) (*Rates, error) {
+	endpoint := strings.ReplaceAll(c.url, "{base}", url.PathEscape(strings.ToUpper(base)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("exchangerate: failed to build request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("exchangerate: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("exchangerate: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exchangerate: unexpected status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Result             string             `json:"result"`
+		ErrorType          string             `json:"error-type"`
+		BaseCode           string             `json:"base_code"`
+		TimeLastUpdateUnix int64              `json:"time_last_update_unix"`
+		Rates              map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("exchangerate: failed to decode response: %w", err)
+	}
+	if result.Result != "" && result.Result != "success" {
+		return nil, fmt.Errorf("exchangerate: request failed: %s", result.ErrorType)
+	}
+	if len(result.Rates) == 0 {
+		return nil, fmt.Errorf("exchangerate: response has no rates")
+	}
+
+	rates := &Rates{
+		Base:      strings.ToUpper(base),
+		Rates:     result.Rates,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if result.BaseCode != "" {
+		rates.Base = strings.ToUpper(result.BaseCode)
+	}
+	if result.TimeLastUpdateUnix > 0 {
+		rates.UpdatedAt = time.Unix(result.TimeLastUpdateUnix, 0).UTC()
+	}
+	return rates, nil
+}
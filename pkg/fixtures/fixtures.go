@@ -0,0 +1,27 @@
+// Package fixtures loads the JSON seed data the API is started with in
+// development mode
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Load decodes dir/name.json into dest. A missing file leaves dest
+// untouched, so fixtures can be left out for features that are not needed.
+func Load(dir, name string, dest interface{}) error {
+	data, err := os.ReadFile(filepath.Join(dir, name+".json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s fixtures: %w", name, err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("invalid %s fixtures: %w", name, err)
+	}
+	return nil
+}
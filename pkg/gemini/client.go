@@ -0,0 +1,190 @@
+// Package gemini generates text with the Gemini API using the REST API
+// directly
+package gemini
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const apiURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// maxResponseSize bounds the response read from the API
+const maxResponseSize = 4 << 20
+
+// ErrBlocked is returned when Gemini refuses to answer a prompt for safety
+// reasons
+var ErrBlocked = errors.New("gemini: response blocked")
+
+// Roles of the messages of a conversation
+const (
+	RoleUser  = "user"
+	RoleModel = "model"
+)
+
+// Message is a turn of a conversation
+type Message struct {
+	Role string
+	Text string
+}
+
+// GenerationConfig tunes the generated text. Nil values use the model
+// defaults.
+type GenerationConfig struct {
+	Temperature     *float32
+	TopK            *float32
+	TopP            *float32
+	MaxOutputTokens int
+}
+
+// Client calls the Gemini API with an API key
+type Client struct {
+	apiKey     string
+	model      string
+	generation GenerationConfig
+	httpClient *http.Client
+}
+
+// NewClient creates a client generating text with model
+func NewClient(apiKey, model string, generation GenerationConfig, timeout time.Duration) (*Client, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("gemini API key is required")
+	}
+	if model == "" {
+		model = "gemini-2.0-flash"
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &Client{
+		apiKey:     apiKey,
+		model:      strings.TrimPrefix(model, "models/"),
+		generation: generation,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type part struct {
+	Text       string      `json:"text,omitempty"`
+	InlineData *inlineData `json:"inlineData,omitempty"`
+}
+
+type inlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     []byte `json:"data"`
+}
+
+type content struct {
+	Role  string `json:"role,omitempty"`
+	Parts []part `json:"parts"`
+}
+
+type generationConfig struct {
+	Temperature     *float32 `json:"temperature,omitempty"`
+	TopK            *float32 `json:"topK,omitempty"`
+	TopP            *float32 `json:"topP,omitempty"`
+	MaxOutputTokens int      `json:"maxOutputTokens,omitempty"`
+}
+
+// Generate returns the model's reply to a conversation, following the
+// system instruction
+func (c *Client) Generate(ctx context.Context, systemInstruction string, messages []Message) (string, error) {
+	contents := make([]content, len(messages))
+	for i, m := range messages {
+		contents[i] = content{Role: m.Role, Parts: []part{{Text: m.Text}}}
+	}
+	return c.generate(ctx, systemInstruction, contents)
+}
+
+// DescribeImage returns the model's answer to a prompt about an image
+func (c *Client) DescribeImage(ctx context.Context, prompt, mimeType string, image []byte) (string, error) {
+	contents := []content{{
+		Role: RoleUser,
+		Parts: []part{
+			{InlineData: &inlineData{MimeType: mimeType, Data: image}},
+			{Text: prompt},
+		},
+	}}
+	return c.generate(ctx, "", contents)
+}
+
+// generate sends a generateContent request and returns the text of the
+// first candidate
+func (c *Client) generate(ctx context.Context, systemInstruction string, contents []content) (string, error) {
+	request := map[string]interface{}{
+		"contents": contents,
+		"generationConfig": generationConfig{
+			Temperature:     c.generation.Temperature,
+			TopK:            c.generation.TopK,
+			TopP:            c.generation.TopP,
+			MaxOutputTokens: c.generation.MaxOutputTokens,
+		},
+	}
+	if systemInstruction != "" {
+		request["systemInstruction"] = content{Parts: []part{{Text: systemInstruction}}}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("gemini: failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/models/"+c.model+":generateContent", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("gemini: failed to build request: %w", err)
+	}
+	req.Header.Set("x-goog-api-key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("gemini: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", fmt.Errorf("gemini: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(data) > 200 {
+			data = data[:200]
+		}
+		return "", fmt.Errorf("gemini: unexpected status %d: %s", resp.StatusCode, data)
+	}
+
+	var result struct {
+		Candidates []struct {
+			Content      content `json:"content"`
+			FinishReason string  `json:"finishReason"`
+		} `json:"candidates"`
+		PromptFeedback struct {
+			BlockReason string `json:"blockReason"`
+		} `json:"promptFeedback"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return "", fmt.Errorf("gemini: failed to decode response: %w", err)
+	}
+
+	if result.PromptFeedback.BlockReason != "" || len(result.Candidates) == 0 {
+		return "", ErrBlocked
+	}
+	candidate := result.Candidates[0]
+	if candidate.FinishReason == "SAFETY" || candidate.FinishReason == "PROHIBITED_CONTENT" {
+		return "", ErrBlocked
+	}
+
+	var text strings.Builder
+	for _, p := range candidate.Content.Parts {
+		text.WriteString(p.Text)
+	}
+	return strings.TrimSpace(text.String()), nil
+}
@@ -0,0 +1,98 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// Location is the geographic location of an IP address
+type Location struct {
+	CountryCode string
+	CountryName string
+	Region      string
+	City        string
+}
+
+// Locator resolves IP addresses to locations
+type Locator interface {
+	Lookup(ip string) (*Location, error)
+	Close() error
+}
+
+// NewLocator opens the MaxMind database at path. An empty path returns a
+// locator that resolves every address to an empty location.
+func NewLocator(path string) (Locator, error) {
+	if path == "" {
+		return noopLocator{}, nil
+	}
+	return NewMaxMindLocator(path)
+}
+
+// MaxMindLocator looks up addresses in a local GeoIP2/GeoLite2 City or
+// Country database without any external calls
+type MaxMindLocator struct {
+	reader *geoip2.Reader
+}
+
+// NewMaxMindLocator opens a MaxMind database file
+func NewMaxMindLocator(path string) (*MaxMindLocator, error) {
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+
+	return &MaxMindLocator{reader: reader}, nil
+}
+
+// Lookup returns the location of ip. Private and unknown addresses resolve to
+// an empty location.
+func (l *MaxMindLocator) Lookup(ip string) (*Location, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsUnspecified() {
+		return &Location{}, nil
+	}
+
+	// City databases are a superset of country databases, so try them first
+	if city, err := l.reader.City(addr); err == nil {
+		location := &Location{
+			CountryCode: city.Country.IsoCode,
+			CountryName: city.Country.Names["en"],
+			City:        city.City.Names["en"],
+		}
+		if len(city.Subdivisions) > 0 {
+			location.Region = city.Subdivisions[0].Names["en"]
+		}
+		return location, nil
+	}
+
+	country, err := l.reader.Country(addr)
+	if err != nil {
+		return nil, fmt.Errorf("geoip lookup failed: %w", err)
+	}
+
+	return &Location{
+		CountryCode: country.Country.IsoCode,
+		CountryName: country.Country.Names["en"],
+	}, nil
+}
+
+// Close releases the database
+func (l *MaxMindLocator) Close() error {
+	return l.reader.Close()
+}
+
+// noopLocator is used when no database is configured
+type noopLocator struct{}
+
+func (noopLocator) Lookup(ip string) (*Location, error) {
+	return &Location{}, nil
+}
+
+func (noopLocator) Close() error {
+	return nil
+}
@@ -0,0 +1,234 @@
+// Package highlight marks up source code for syntax highlighting
+//
+// Code is split into comments, strings, numbers, keywords and plain text
+// with a small lexer per language family. Tokens are wrapped in spans with
+// the classes hl-c, hl-s, hl-n and hl-k, which pages style themselves.
+// Unknown languages are only escaped.
+package highlight
+
+import (
+	"html"
+	"path"
+	"strings"
+)
+
+// syntax describes the tokens of a language family
+type syntax struct {
+	lineComments []string
+	blockComment [2]string
+	quotes       string
+	keywords     map[string]bool
+	// ignoreCase matches keywords, listed in upper case, in any case
+	ignoreCase bool
+}
+
+func words(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(list) {
+		set[word] = true
+	}
+	return set
+}
+
+var syntaxes = map[string]*syntax{
+	"go": {
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+		keywords: words(`break case chan const continue default defer else fallthrough for func go goto if
+			import interface map package range return select struct switch type var nil true false`),
+	},
+	"javascript": {
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+		keywords: words(`async await break case catch class const continue default delete do else export
+			extends finally for from function if import in instanceof interface let new null return static
+			super switch this throw true false try type typeof undefined var void while yield`),
+	},
+	"python": {
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+		keywords: words(`and as assert async await break class continue def del elif else except False
+			finally for from global if import in is lambda None nonlocal not or pass raise return True try
+			while with yield`),
+	},
+	"rust": {
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"",
+		keywords: words(`as async await break const continue crate else enum extern false fn for if impl
+			in let loop match mod move mut pub ref return self Self static struct super trait true type
+			unsafe use where while`),
+	},
+	"c": {
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'",
+		keywords: words(`abstract auto bool break case catch char class const continue default do double
+			else enum extends extern false final float for fun if implements import int interface long
+			namespace new null override package private protected public return short signed sizeof
+			static struct super switch this throw throws true try typedef union unsigned val var void
+			volatile while`),
+	},
+	"shell": {
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+		keywords: words(`case do done elif else esac export fi for function if in local return then
+			until while`),
+	},
+	"sql": {
+		lineComments: []string{"--"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "'",
+		ignoreCase:   true,
+		keywords: words(`ALTER AND AS ASC BY CREATE DELETE DESC DISTINCT DROP FROM GROUP HAVING IN INDEX
+			INSERT INTO IS JOIN KEY LEFT LIMIT NOT NULL ON OR ORDER PRIMARY REFERENCES SELECT SET TABLE
+			UNION UPDATE VALUES WHERE WITH`),
+	},
+	"yaml": {
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+		keywords:     words(`true false null yes no`),
+	},
+}
+
+// aliases maps language names and file extensions to a language family
+var aliases = map[string]string{
+	"go": "go", "golang": "go",
+	"js": "javascript", "jsx": "javascript", "javascript": "javascript", "mjs": "javascript",
+	"ts": "javascript", "tsx": "javascript", "typescript": "javascript", "json": "javascript",
+	"py": "python", "python": "python",
+	"rs": "rust", "rust": "rust",
+	"c": "c", "h": "c", "cpp": "c", "cc": "c", "hpp": "c", "java": "c", "kt": "c", "kotlin": "c",
+	"cs": "c", "csharp": "c", "swift": "c", "dart": "c", "php": "c",
+	"sh": "shell", "bash": "shell", "zsh": "shell", "shell": "shell", "dockerfile": "shell",
+	"sql": "sql",
+	"yml": "yaml", "yaml": "yaml", "toml": "yaml",
+}
+
+// Language returns the language of a file from its extension, or the
+// lowercase base name for files such as Dockerfile
+func Language(filename string) string {
+	name := strings.ToLower(path.Base(filename))
+	if ext := path.Ext(name); ext != "" {
+		return strings.TrimPrefix(ext, ".")
+	}
+	return name
+}
+
+// HTML escapes code and wraps its tokens in highlighting spans
+func HTML(language, code string) string {
+	s, ok := syntaxes[aliases[strings.ToLower(language)]]
+	if !ok {
+		return html.EscapeString(code)
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(code); {
+		end, class := s.token(code, i)
+		text := html.EscapeString(code[i:end])
+		if class != "" {
+			b.WriteString(`<span class="` + class + `">` + text + `</span>`)
+		} else {
+			b.WriteString(text)
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// token returns the end and the class of the token starting at code[i]
+func (s *syntax) token(code string, i int) (int, string) {
+	rest := code[i:]
+
+	for _, prefix := range s.lineComments {
+		if strings.HasPrefix(rest, prefix) {
+			if n := strings.IndexByte(rest, '\n'); n >= 0 {
+				return i + n, "hl-c"
+			}
+			return len(code), "hl-c"
+		}
+	}
+
+	if open := s.blockComment[0]; open != "" && strings.HasPrefix(rest, open) {
+		if n := strings.Index(rest[len(open):], s.blockComment[1]); n >= 0 {
+			return i + len(open) + n + len(s.blockComment[1]), "hl-c"
+		}
+		return len(code), "hl-c"
+	}
+
+	c := code[i]
+	switch {
+	case strings.IndexByte(s.quotes, c) >= 0:
+		return stringEnd(code, i), "hl-s"
+
+	case isDigit(c):
+		end := i
+		for end < len(code) && (isWordByte(code[end]) || code[end] == '.') {
+			end++
+		}
+		return end, "hl-n"
+
+	case isWordByte(c):
+		end := i
+		for end < len(code) && isWordByte(code[end]) {
+			end++
+		}
+		word := code[i:end]
+		if s.keywords[word] || (s.ignoreCase && s.keywords[strings.ToUpper(word)]) {
+			return end, "hl-k"
+		}
+		return end, ""
+
+	default:
+		end := i + 1
+		for end < len(code) && !isWordByte(code[end]) && !s.startsToken(code[end:]) {
+			end++
+		}
+		return end, ""
+	}
+}
+
+// startsToken reports whether a comment or string starts at rest
+func (s *syntax) startsToken(rest string) bool {
+	if strings.IndexByte(s.quotes, rest[0]) >= 0 {
+		return true
+	}
+	for _, prefix := range s.lineComments {
+		if strings.HasPrefix(rest, prefix) {
+			return true
+		}
+	}
+	return s.blockComment[0] != "" && strings.HasPrefix(rest, s.blockComment[0])
+}
+
+// stringEnd returns the end of the string literal opened at code[start].
+// Backslashes escape the next character, and strings other than raw ones
+// end at the end of the line.
+func stringEnd(code string, start int) int {
+	quote := code[start]
+	for i := start + 1; i < len(code); i++ {
+		switch code[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			return i + 1
+		case '\n':
+			if quote != '`' {
+				return i
+			}
+		}
+	}
+	return len(code)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
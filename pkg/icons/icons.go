@@ -0,0 +1,168 @@
+// Package icons fetches technology logos from public SVG icon sets
+package icons
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrNotFound is returned when no source has an icon for a name
+var ErrNotFound = errors.New("icon not found")
+
+// maxIconSize bounds the size of a fetched icon
+const maxIconSize = 512 << 10
+
+// Source is an icon set serving SVG files by slug
+type Source struct {
+	Name string
+
+	// URL is the icon URL with a {slug} placeholder
+	URL string
+
+	// Slug converts a technology name to the slug used by the icon set
+	Slug func(name string) string
+}
+
+// SimpleIcons serves brand icons from the Simple Icons set
+var SimpleIcons = Source{
+	Name: "simpleicons",
+	URL:  "https://cdn.jsdelivr.net/npm/simple-icons@latest/icons/{slug}.svg",
+	Slug: SimpleIconsSlug,
+}
+
+// Devicon serves programming language and tool icons from the devicon set
+var Devicon = Source{
+	Name: "devicon",
+	URL:  "https://cdn.jsdelivr.net/gh/devicons/devicon/icons/{slug}/{slug}-original.svg",
+	Slug: DeviconSlug,
+}
+
+// Sources maps source names to the built-in icon sets
+var Sources = map[string]Source{
+	SimpleIcons.Name: SimpleIcons,
+	Devicon.Name:     Devicon,
+}
+
+// Fetcher looks up icons in a list of sources, in order
+type Fetcher struct {
+	sources    []Source
+	httpClient *http.Client
+}
+
+// NewFetcher creates a fetcher using the named sources in order
+func NewFetcher(sourceNames []string, timeout time.Duration) (*Fetcher, error) {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	sources := make([]Source, 0, len(sourceNames))
+	for _, name := range sourceNames {
+		source, ok := Sources[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown icon source %q", name)
+		}
+		sources = append(sources, source)
+	}
+
+	return &Fetcher{
+		sources:    sources,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Fetch returns the SVG icon for a technology name. When slug is set it is
+// used as is instead of being derived from name.
+func (f *Fetcher) Fetch(ctx context.Context, name, slug string) ([]byte, error) {
+	for _, source := range f.sources {
+		s := slug
+		if s == "" {
+			s = source.Slug(name)
+		}
+		if s == "" {
+			continue
+		}
+
+		data, err := f.get(ctx, strings.ReplaceAll(source.URL, "{slug}", s))
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("icons: %s: %w", source.Name, err)
+		}
+		return data, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (f *Fetcher) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxIconSize))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(data, []byte("<svg")) {
+		return nil, fmt.Errorf("response is not an SVG")
+	}
+	return data, nil
+}
+
+// SimpleIconsSlug converts a name to a Simple Icons slug, e.g. "Node.js"
+// becomes "nodedotjs" and "C++" becomes "cplusplus"
+func SimpleIconsSlug(name string) string {
+	return slugify(name, map[rune]string{
+		'+': "plus",
+		'.': "dot",
+		'&': "and",
+		'#': "sharp",
+	})
+}
+
+// DeviconSlug converts a name to a devicon slug, e.g. "Node.js" becomes
+// "nodejs" and "C#" becomes "csharp"
+func DeviconSlug(name string) string {
+	return slugify(name, map[rune]string{
+		'+': "plus",
+		'#': "sharp",
+	})
+}
+
+// slugify lowercases name, replaces the given symbols and drops any other
+// character that is not a letter or digit
+func slugify(name string, replacements map[rune]string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if replacement, ok := replacements[r]; ok {
+			sb.WriteString(replacement)
+			continue
+		}
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,190 @@
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Dimensions of cards, the size link previews are shown at
+const (
+	CardWidth  = 1200
+	CardHeight = 630
+)
+
+const (
+	cardMargin       = 80
+	cardAccentHeight = 12
+
+	defaultCardBackground = "#0f172a"
+)
+
+// Card is the text drawn on a link preview image
+type Card struct {
+	Title       string
+	Description string
+	// Footer is drawn at the bottom, e.g. the name of the site
+	Footer string
+	// Background is a #rrggbb color, dark slate when empty. The text is
+	// drawn in black or white, whichever reads better on it.
+	Background string
+}
+
+// cardFaces are the font faces of cards, parsed once
+var cardFaces = sync.OnceValues(func() (*cardFaceSet, error) {
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, err
+	}
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+
+	faces := &cardFaceSet{}
+	if faces.title, err = newFace(bold, 64); err != nil {
+		return nil, err
+	}
+	if faces.description, err = newFace(regular, 32); err != nil {
+		return nil, err
+	}
+	if faces.footer, err = newFace(bold, 28); err != nil {
+		return nil, err
+	}
+	return faces, nil
+})
+
+type cardFaceSet struct {
+	title, description, footer font.Face
+}
+
+func newFace(f *opentype.Font, size float64) (font.Face, error) {
+	return opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+}
+
+// RenderCard draws a card as a CardWidth by CardHeight PNG. Long titles and
+// descriptions are wrapped and cut off with an ellipsis.
+func RenderCard(card Card) ([]byte, error) {
+	faces, err := cardFaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load card fonts: %w", err)
+	}
+
+	background, ok := parseHexColor(card.Background)
+	if !ok {
+		background, _ = parseHexColor(defaultCardBackground)
+	}
+	text, muted := color.RGBA{0xff, 0xff, 0xff, 0xff}, color.RGBA{0xcb, 0xd5, 0xe1, 0xff}
+	if luminance(background) > 0.55 {
+		text, muted = color.RGBA{0x0f, 0x17, 0x2a, 0xff}, color.RGBA{0x33, 0x41, 0x55, 0xff}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, CardWidth, CardHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, CardHeight-cardAccentHeight, CardWidth, CardHeight), image.NewUniform(text), image.Point{}, draw.Src)
+
+	width := CardWidth - 2*cardMargin
+	footerY := CardHeight - cardMargin - faces.footer.Metrics().Height.Ceil()
+	drawLines(img, faces.footer, text, wrap(faces.footer, card.Footer, width, 1), footerY)
+
+	// The description gets the lines left between the title and the footer
+	y := drawLines(img, faces.title, text, wrap(faces.title, card.Title, width, 3), cardMargin) + 24
+	if maxLines := min((footerY-32-y)/lineHeight(faces.description), 4); maxLines > 0 {
+		drawLines(img, faces.description, muted, wrap(faces.description, card.Description, width, maxLines), y)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode card: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawLines draws lines from top y down and returns the y below the last one
+func drawLines(img draw.Image, face font.Face, c color.Color, lines []string, y int) int {
+	drawer := font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face}
+	for _, line := range lines {
+		drawer.Dot = fixed.P(cardMargin, y+face.Metrics().Ascent.Ceil())
+		drawer.DrawString(line)
+		y += lineHeight(face)
+	}
+	return y
+}
+
+// lineHeight is the distance between the tops of two lines of face
+func lineHeight(face font.Face) int {
+	return face.Metrics().Height.Ceil() * 6 / 5
+}
+
+// wrap breaks text into at most maxLines lines no wider than width, ending
+// the last line with an ellipsis when text does not fit
+func wrap(face font.Face, text string, width, maxLines int) []string {
+	limit := fixed.I(width)
+
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line == "" || font.MeasureString(face, candidate) <= limit {
+			line = candidate
+			continue
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	// Only a single word can be wider than the card, it is cut
+	for i := range lines {
+		lines[i] = fit(face, lines[i], "", limit)
+	}
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+		lines[maxLines-1] = fit(face, lines[maxLines-1], "\u2026", limit)
+	}
+	return lines
+}
+
+// fit drops characters from the end of text until it is no wider than
+// limit with suffix appended, along with the spaces and punctuation the
+// suffix would follow
+func fit(face font.Face, text, suffix string, limit fixed.Int26_6) string {
+	runes := []rune(text)
+	for len(runes) > 0 && font.MeasureString(face, string(runes)+suffix) > limit {
+		runes = runes[:len(runes)-1]
+	}
+	return strings.TrimRight(string(runes), " .,;:") + suffix
+}
+
+// parseHexColor parses a #rrggbb color
+func parseHexColor(hex string) (color.RGBA, bool) {
+	var r, g, b uint8
+	if len(hex) != 7 || hex[0] != '#' {
+		return color.RGBA{}, false
+	}
+	if _, err := fmt.Sscanf(hex[1:], "%02x%02x%02x", &r, &g, &b); err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{r, g, b, 0xff}, true
+}
+
+// luminance is the perceived brightness of c between 0 and 1
+func luminance(c color.RGBA) float64 {
+	return (0.299*float64(c.R) + 0.587*float64(c.G) + 0.114*float64(c.B)) / 255
+}
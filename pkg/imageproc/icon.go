@@ -0,0 +1,89 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/png"
+
+	"golang.org/x/image/draw"
+)
+
+// maxIconSize is the largest size an ICO file entry can declare
+const maxIconSize = 256
+
+// SquareIcons crops the center square of an image and scales it to each of
+// the given sizes, returning one PNG per size. Unlike Transform, small
+// images are scaled up.
+func SquareIcons(src []byte, sizes ...int) ([][]byte, error) {
+	img, _, err := decode(src)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	if side == 0 {
+		return nil, fmt.Errorf("image is empty")
+	}
+	crop := image.Rect(0, 0, side, side).Add(image.Point{
+		X: bounds.Min.X + (bounds.Dx()-side)/2,
+		Y: bounds.Min.Y + (bounds.Dy()-side)/2,
+	})
+
+	icons := make([][]byte, len(sizes))
+	for i, size := range sizes {
+		if size <= 0 || size > MaxDimension {
+			return nil, fmt.Errorf("icon size must be between 1 and %d", MaxDimension)
+		}
+
+		dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Src, nil)
+
+		var buf bytes.Buffer
+		if err := (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, dst); err != nil {
+			return nil, fmt.Errorf("failed to encode icon: %w", err)
+		}
+		icons[i] = buf.Bytes()
+	}
+
+	return icons, nil
+}
+
+// EncodeICO packs PNG images of at most 256x256 pixels into an ICO file,
+// as browsers expect of favicon.ico
+func EncodeICO(pngs [][]byte) ([]byte, error) {
+	var header bytes.Buffer
+	var images bytes.Buffer
+
+	binary.Write(&header, binary.LittleEndian, [3]uint16{0, 1, uint16(len(pngs))})
+	offset := 6 + 16*len(pngs)
+
+	for _, data := range pngs {
+		config, err := png.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read icon: %w", err)
+		}
+		if config.Width > maxIconSize || config.Height > maxIconSize {
+			return nil, fmt.Errorf("icon of %dx%d pixels is too large", config.Width, config.Height)
+		}
+
+		// A size of 0 stands for 256 pixels
+		binary.Write(&header, binary.LittleEndian, struct {
+			Width, Height, Colors, Reserved uint8
+			Planes, BitCount                uint16
+			Size, Offset                    uint32
+		}{
+			Width:    uint8(config.Width % maxIconSize),
+			Height:   uint8(config.Height % maxIconSize),
+			Planes:   1,
+			BitCount: 32,
+			Size:     uint32(len(data)),
+			Offset:   uint32(offset + images.Len()),
+		})
+		images.Write(data)
+	}
+
+	return append(header.Bytes(), images.Bytes()...), nil
+}
@@ -0,0 +1,163 @@
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Output formats
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+)
+
+const (
+	// MaxDimension bounds the requested width and height
+	MaxDimension = 4096
+
+	// maxSourcePixels guards against decompression bombs
+	maxSourcePixels = 50_000_000
+
+	defaultQuality = 82
+)
+
+// Options describes a transform. Zero values keep the source dimension or format.
+type Options struct {
+	Width   int
+	Height  int
+	Format  string
+	Quality int
+}
+
+// Validate checks the requested dimensions and format
+func (o Options) Validate() error {
+	if o.Width < 0 || o.Width > MaxDimension || o.Height < 0 || o.Height > MaxDimension {
+		return fmt.Errorf("width and height must be between 0 and %d", MaxDimension)
+	}
+	if o.Quality < 0 || o.Quality > 100 {
+		return fmt.Errorf("quality must be between 0 and 100")
+	}
+	switch o.Format {
+	case "", FormatJPEG, FormatPNG:
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q", o.Format)
+	}
+}
+
+// NormalizeFormat maps format aliases to a supported output format
+func NormalizeFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "jpg", "jpeg":
+		return FormatJPEG
+	case "png":
+		return FormatPNG
+	default:
+		return strings.ToLower(strings.TrimSpace(format))
+	}
+}
+
+// ContentType returns the MIME type of an output format
+func ContentType(format string) string {
+	if format == FormatPNG {
+		return "image/png"
+	}
+	return "image/jpeg"
+}
+
+// Transform decodes src, scales it to fit within the requested box while
+// keeping its aspect ratio, and encodes it in the requested format. Images are
+// never upscaled. It returns the encoded image and its format.
+func Transform(src []byte, opts Options) ([]byte, string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	img, sourceFormat, err := decode(src)
+	if err != nil {
+		return nil, "", err
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = FormatPNG
+		if sourceFormat == "jpeg" {
+			format = FormatJPEG
+		}
+	}
+
+	img = resize(img, opts.Width, opts.Height)
+
+	var buf bytes.Buffer
+	switch format {
+	case FormatJPEG:
+		quality := opts.Quality
+		if quality == 0 {
+			quality = defaultQuality
+		}
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case FormatPNG:
+		err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	return buf.Bytes(), format, nil
+}
+
+// decode decodes src after checking that it is not too large to hold in
+// memory, returning the image and its format
+func decode(src []byte) (image.Image, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(src))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image: %w", err)
+	}
+	if config.Width*config.Height > maxSourcePixels {
+		return nil, "", fmt.Errorf("image of %dx%d pixels is too large", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, format, nil
+}
+
+// resize scales img down to fit within width x height. A zero dimension is
+// derived from the other one to keep the aspect ratio.
+func resize(img image.Image, width, height int) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW == 0 || srcH == 0 || (width == 0 && height == 0) {
+		return img
+	}
+
+	scale := 1.0
+	if width > 0 {
+		scale = float64(width) / float64(srcW)
+	}
+	if height > 0 {
+		if s := float64(height) / float64(srcH); width == 0 || s < scale {
+			scale = s
+		}
+	}
+	if scale >= 1 {
+		return img
+	}
+
+	dstW := max(1, int(float64(srcW)*scale+0.5))
+	dstH := max(1, int(float64(srcH)*scale+0.5))
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
@@ -0,0 +1,187 @@
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+)
+
+// Tone is how light the content of a logo is
+type Tone string
+
+const (
+	// ToneDark logos are legible on light backgrounds
+	ToneDark Tone = "dark"
+	// ToneLight logos are legible on dark backgrounds
+	ToneLight Tone = "light"
+	// ToneNeutral logos are legible on both, or carry their own background
+	ToneNeutral Tone = "neutral"
+)
+
+const (
+	// darkLuminance and lightLuminance bound the mean luminance of the
+	// visible pixels of dark and light logos
+	darkLuminance  = 0.35
+	lightLuminance = 0.65
+
+	// minTransparentShare is the share of transparent pixels below which a
+	// logo is taken to carry its own background
+	minTransparentShare = 0.05
+
+	// toneSampleSize bounds the image the tone of a logo is measured on
+	toneSampleSize = 128
+)
+
+// InvertLogo returns a copy of a logo for backgrounds of the opposite tone,
+// with the lightness of every pixel inverted and its hue kept, along with
+// its content type and the tone of the original. Neutral logos have no
+// copy. SVG logos are only inverted when they leave their colors to the
+// default black, as most single color icon sets do.
+func InvertLogo(src []byte) ([]byte, string, Tone, error) {
+	if isSVG(src) {
+		inverted, tone := invertSVG(src)
+		return inverted, "image/svg+xml", tone, nil
+	}
+
+	img, _, err := decode(src)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	tone := logoTone(resize(img, toneSampleSize, toneSampleSize))
+	if tone == ToneNeutral {
+		return nil, "", tone, nil
+	}
+
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			h, s, l := toHSL(c)
+			r, g, b := fromHSL(h, s, 1-l)
+			dst.SetNRGBA(x-bounds.Min.X, y-bounds.Min.Y, color.NRGBA{R: r, G: g, B: b, A: c.A})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, dst); err != nil {
+		return nil, "", "", fmt.Errorf("failed to encode logo: %w", err)
+	}
+	return buf.Bytes(), "image/png", tone, nil
+}
+
+// logoTone measures the mean luminance of the visible pixels of a logo
+func logoTone(img image.Image) Tone {
+	var total, transparent int
+	var luminance float64
+
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			total++
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 0x80 {
+				transparent++
+				continue
+			}
+			luminance += (0.2126*float64(c.R) + 0.7152*float64(c.G) + 0.0722*float64(c.B)) / 0xFF
+		}
+	}
+
+	visible := total - transparent
+	if visible == 0 || float64(transparent) < minTransparentShare*float64(total) {
+		return ToneNeutral
+	}
+
+	switch mean := luminance / float64(visible); {
+	case mean < darkLuminance:
+		return ToneDark
+	case mean > lightLuminance:
+		return ToneLight
+	default:
+		return ToneNeutral
+	}
+}
+
+// isSVG reports whether src starts like an SVG document
+func isSVG(src []byte) bool {
+	head := src[:min(len(src), 1024)]
+	return bytes.Contains(bytes.ToLower(head), []byte("<svg"))
+}
+
+// invertSVG paints an SVG without colors of its own white
+func invertSVG(src []byte) ([]byte, Tone) {
+	lower := bytes.ToLower(src)
+	for _, styling := range [][]byte{[]byte("fill"), []byte("stroke"), []byte("<style"), []byte("color")} {
+		if bytes.Contains(lower, styling) {
+			return nil, ToneNeutral
+		}
+	}
+
+	i := bytes.Index(lower, []byte("<svg"))
+	inverted := make([]byte, 0, len(src)+16)
+	inverted = append(inverted, src[:i+4]...)
+	inverted = append(inverted, ` fill="#ffffff"`...)
+	inverted = append(inverted, src[i+4:]...)
+	return inverted, ToneDark
+}
+
+// toHSL converts a color to hue, saturation and lightness, all from 0 to 1
+func toHSL(c color.NRGBA) (float64, float64, float64) {
+	r, g, b := float64(c.R)/0xFF, float64(c.G)/0xFF, float64(c.B)/0xFF
+	high, low := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l := (high + low) / 2
+	if high == low {
+		return 0, 0, l
+	}
+
+	d := high - low
+	s := d / (1 - math.Abs(2*l-1))
+
+	var h float64
+	switch high {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h /= 6
+	if h < 0 {
+		h++
+	}
+	return h, s, l
+}
+
+// fromHSL converts hue, saturation and lightness back to RGB
+func fromHSL(h, s, l float64) (uint8, uint8, uint8) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h*6, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch int(h * 6) {
+	case 0:
+		r, g, b = c, x, 0
+	case 1:
+		r, g, b = x, c, 0
+	case 2:
+		r, g, b = 0, c, x
+	case 3:
+		r, g, b = 0, x, c
+	case 4:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	channel := func(v float64) uint8 {
+		return uint8(math.Round(math.Min(1, math.Max(0, v+m)) * 0xFF))
+	}
+	return channel(r), channel(g), channel(b)
+}
@@ -0,0 +1,88 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+)
+
+const (
+	// placeholderSize bounds the width and height of placeholders in pixels
+	placeholderSize = 16
+
+	// colorSampleSize bounds the image the dominant color is picked from
+	colorSampleSize = 64
+)
+
+// Preview is what frontends show in place of an image while it loads
+type Preview struct {
+	// Placeholder is a tiny copy of the image as a PNG data URI, small
+	// enough to embed in API responses and meant to be stretched and blurred
+	Placeholder string
+	// DominantColor is the most common color of the image as #rrggbb
+	DominantColor string
+}
+
+// NewPreview decodes an image and builds its preview
+func NewPreview(src []byte) (*Preview, error) {
+	img, _, err := decode(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, resize(img, placeholderSize, placeholderSize)); err != nil {
+		return nil, fmt.Errorf("failed to encode placeholder: %w", err)
+	}
+
+	return &Preview{
+		Placeholder:   "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+		DominantColor: dominantColor(resize(img, colorSampleSize, colorSampleSize)),
+	}, nil
+}
+
+// dominantColor groups the opaque pixels of img into coarse color buckets
+// and returns the average color of the largest bucket, so that a large area
+// of one color wins over a mix of many
+func dominantColor(img image.Image) string {
+	type bucket struct {
+		r, g, b, count int
+	}
+	buckets := map[int]*bucket{}
+	var largest *bucket
+
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a < 0x8000 {
+				continue
+			}
+			// Undo the alpha premultiplication and keep 8 bits per channel
+			r, g, b = (r*0xFF+a/2)/a, (g*0xFF+a/2)/a, (b*0xFF+a/2)/a
+
+			key := int(r>>4)<<8 | int(g>>4)<<4 | int(b>>4)
+			bk, ok := buckets[key]
+			if !ok {
+				bk = &bucket{}
+				buckets[key] = bk
+			}
+			bk.r += int(r)
+			bk.g += int(g)
+			bk.b += int(b)
+			bk.count++
+
+			if largest == nil || bk.count > largest.count {
+				largest = bk
+			}
+		}
+	}
+
+	if largest == nil {
+		return ""
+	}
+	return fmt.Sprintf("#%02x%02x%02x", largest.r/largest.count, largest.g/largest.count, largest.b/largest.count)
+}
@@ -0,0 +1,247 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/jpeg"
+)
+
+// sanitizedQuality is the JPEG quality used when a photo has to be
+// re-encoded to apply its orientation
+const sanitizedQuality = 92
+
+var (
+	jpegMagic = []byte{0xFF, 0xD8}
+	pngMagic  = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// Sanitize removes the metadata of a JPEG, PNG or WebP image, such as EXIF
+// GPS coordinates, camera details and comments, and applies the EXIF
+// orientation of JPEG photos to their pixels so that they display the same
+// without it. Other content is returned unchanged.
+func Sanitize(src []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(src, jpegMagic):
+		return sanitizeJPEG(src)
+	case bytes.HasPrefix(src, pngMagic):
+		return sanitizePNG(src)
+	case len(src) >= 12 && string(src[0:4]) == "RIFF" && string(src[8:12]) == "WEBP":
+		return sanitizeWebP(src)
+	default:
+		return src, nil
+	}
+}
+
+// sanitizeJPEG drops the APP1 (EXIF, XMP), APP13 (IPTC) and comment
+// segments. The JFIF, ICC profile and Adobe segments are kept as they
+// affect how the image is decoded.
+func sanitizeJPEG(src []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(src)))
+	out.Write(jpegMagic)
+
+	orientation := 1
+	pos := 2
+	for {
+		if pos+4 > len(src) || src[pos] != 0xFF {
+			return nil, fmt.Errorf("malformed JPEG segment at offset %d", pos)
+		}
+		marker := src[pos+1]
+
+		// Fill bytes before a marker
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(src[pos+2 : pos+4]))
+		end := pos + 2 + length
+		if length < 2 || end > len(src) {
+			return nil, fmt.Errorf("malformed JPEG segment at offset %d", pos)
+		}
+
+		switch {
+		case marker == 0xDA:
+			// Start of scan, the compressed image data follows
+			out.Write(src[pos:])
+			if orientation == 1 {
+				return out.Bytes(), nil
+			}
+			return applyOrientation(out.Bytes(), orientation)
+		case marker == 0xE1:
+			if o, ok := exifOrientation(src[pos+4 : end]); ok {
+				orientation = o
+			}
+		case marker == 0xED, marker == 0xFE:
+		default:
+			out.Write(src[pos:end])
+		}
+		pos = end
+	}
+}
+
+// exifOrientation reads the orientation tag of an APP1 EXIF segment
+func exifOrientation(segment []byte) (int, bool) {
+	if !bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+		return 0, false
+	}
+	tiff := segment[6:]
+	if len(tiff) < 8 {
+		return 0, false
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, false
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 0, false
+	}
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0, false
+		}
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+			if orientation < 1 || orientation > 8 {
+				return 0, false
+			}
+			return orientation, true
+		}
+	}
+	return 0, false
+}
+
+// applyOrientation decodes a JPEG without metadata, turns its pixels to
+// the given EXIF orientation and encodes it again. Images too large to
+// decode safely are kept as they are.
+func applyOrientation(src []byte, orientation int) ([]byte, error) {
+	config, err := jpeg.DecodeConfig(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if config.Width*config.Height > maxSourcePixels {
+		return src, nil
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, orient(img, orientation), &jpeg.Options{Quality: sanitizedQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// orient returns img flipped and rotated as its EXIF orientation describes
+func orient(img image.Image, orientation int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		for x := 0; x < dstW; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			default:
+				sx, sy = x, y
+			}
+			dst.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+	return dst
+}
+
+// sanitizePNG drops the EXIF, text and timestamp chunks
+func sanitizePNG(src []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(src)))
+	out.Write(pngMagic)
+
+	pos := len(pngMagic)
+	for pos < len(src) {
+		if pos+8 > len(src) {
+			return nil, fmt.Errorf("malformed PNG chunk at offset %d", pos)
+		}
+		length := int(binary.BigEndian.Uint32(src[pos : pos+4]))
+		end := pos + 12 + length
+		if length < 0 || end > len(src) {
+			return nil, fmt.Errorf("malformed PNG chunk at offset %d", pos)
+		}
+
+		switch string(src[pos+4 : pos+8]) {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			out.Write(src[pos:end])
+		}
+		pos = end
+	}
+	return out.Bytes(), nil
+}
+
+// sanitizeWebP drops the EXIF and XMP chunks and clears their flags in the
+// extended header
+func sanitizeWebP(src []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(src)))
+	out.Write(src[0:12])
+
+	pos := 12
+	for pos < len(src) {
+		if pos+8 > len(src) {
+			return nil, fmt.Errorf("malformed WebP chunk at offset %d", pos)
+		}
+		size := int(binary.LittleEndian.Uint32(src[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2
+		if size < 0 || end > len(src) {
+			return nil, fmt.Errorf("malformed WebP chunk at offset %d", pos)
+		}
+
+		switch string(src[pos : pos+4]) {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), src[pos:end]...)
+			if len(chunk) > 8 {
+				chunk[8] &^= 0x08 | 0x04
+			}
+			out.Write(chunk)
+		default:
+			out.Write(src[pos:end])
+		}
+		pos = end
+	}
+
+	data := out.Bytes()
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+	return data, nil
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// fanoutHandler hands every record to each of its handlers
+type fanoutHandler []slog.Handler
+
+func (f fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, h := range f {
+		if h.Enabled(ctx, r.Level) {
+			errs = append(errs, h.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, len(f))
+	for i, h := range f {
+		handlers[i] = h.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (f fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, len(f))
+	for i, h := range f {
+		handlers[i] = h.WithGroup(name)
+	}
+	return handlers
+}
+
+// lineHandler formats each record as a single line of JSON or text and
+// hands it to emit along with its level, for sinks that label or route
+// records by level such as Loki and syslog
+type lineHandler struct {
+	opts   *slog.HandlerOptions
+	json   bool
+	derive []func(slog.Handler) slog.Handler
+	emit   func(r slog.Record, line []byte) error
+}
+
+func (h *lineHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.opts.Level.Level()
+}
+
+func (h *lineHandler) Handle(ctx context.Context, r slog.Record) error {
+	var buf bytes.Buffer
+
+	var formatter slog.Handler
+	if h.json {
+		formatter = slog.NewJSONHandler(&buf, h.opts)
+	} else {
+		formatter = slog.NewTextHandler(&buf, h.opts)
+	}
+	for _, derive := range h.derive {
+		formatter = derive(formatter)
+	}
+
+	if err := formatter.Handle(ctx, r); err != nil {
+		return err
+	}
+	return h.emit(r, bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+func (h *lineHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(formatter slog.Handler) slog.Handler { return formatter.WithAttrs(attrs) })
+}
+
+func (h *lineHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(formatter slog.Handler) slog.Handler { return formatter.WithGroup(name) })
+}
+
+func (h *lineHandler) with(derive func(slog.Handler) slog.Handler) *lineHandler {
+	clone := *h
+	clone.derive = append(append([]func(slog.Handler) slog.Handler{}, h.derive...), derive)
+	return &clone
+}
@@ -0,0 +1,159 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ParseLevel parses a level name such as "debug" or "warn"
+func ParseLevel(name string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return DebugLevel, nil
+	case "", "info":
+		return InfoLevel, nil
+	case "warn", "warning":
+		return WarnLevel, nil
+	case "error":
+		return ErrorLevel, nil
+	default:
+		return InfoLevel, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", name)
+	}
+}
+
+// String returns the name of the level as accepted by ParseLevel
+func (l LogLevel) String() string {
+	switch {
+	case l <= DebugLevel:
+		return "debug"
+	case l <= InfoLevel:
+		return "info"
+	case l <= WarnLevel:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// ModuleLevel is the level records of one module are filtered at
+type ModuleLevel struct {
+	Module string
+	Level  LogLevel
+	// Overridden is set when the module has a level of its own rather than
+	// the default one
+	Overridden bool
+}
+
+// levels decides which records are written. A module is the name of the
+// package logging a record, e.g. "tech_stack" or "mailer", and may have a
+// level of its own overriding the default. Levels can change at runtime.
+type levels struct {
+	mu      sync.RWMutex
+	base    LogLevel
+	modules map[string]LogLevel
+	// lowest is the lowest of the default and module levels, so records
+	// below every level are dropped before their caller is looked up
+	lowest LogLevel
+
+	// seen are the modules that logged, so they can be listed
+	seen sync.Map
+}
+
+func newLevels(base LogLevel, modules map[string]LogLevel) *levels {
+	l := &levels{base: base, modules: map[string]LogLevel{}}
+	for module, level := range modules {
+		l.modules[module] = level
+	}
+	l.updateLowest()
+	return l
+}
+
+// mayLog reports whether a record of level is written for some module
+func (l *levels) mayLog(level LogLevel) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return level >= l.lowest
+}
+
+// enabled reports whether a record of level is written for module
+func (l *levels) enabled(module string, level LogLevel) bool {
+	l.seen.Store(module, struct{}{})
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if moduleLevel, ok := l.modules[module]; ok {
+		return level >= moduleLevel
+	}
+	return level >= l.base
+}
+
+func (l *levels) setBase(level LogLevel) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.base = level
+	l.updateLowest()
+}
+
+func (l *levels) setModule(module string, level LogLevel) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.modules[module] = level
+	l.updateLowest()
+}
+
+func (l *levels) resetModule(module string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.modules, module)
+	l.updateLowest()
+}
+
+// updateLowest must be called with mu held
+func (l *levels) updateLowest() {
+	l.lowest = l.base
+	for _, level := range l.modules {
+		l.lowest = min(l.lowest, level)
+	}
+}
+
+// list returns the level of every module that logged or has a level of its
+// own, sorted by module
+func (l *levels) list() []ModuleLevel {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	names := map[string]bool{}
+	l.seen.Range(func(key, _ any) bool {
+		names[key.(string)] = true
+		return true
+	})
+	for module := range l.modules {
+		names[module] = true
+	}
+
+	list := make([]ModuleLevel, 0, len(names))
+	for module := range names {
+		level, overridden := l.modules[module]
+		if !overridden {
+			level = l.base
+		}
+		list = append(list, ModuleLevel{Module: module, Level: level, Overridden: overridden})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Module < list[j].Module })
+	return list
+}
+
+// moduleOf returns the package name of a function name as reported by
+// runtime.FuncForPC, e.g. "tech_stack" for
+// github.com/holycann/itsrama-portfolio-backend/internal/tech_stack.(*TechStackService).Create
+func moduleOf(funcName string) string {
+	if i := strings.LastIndex(funcName, "/"); i >= 0 {
+		funcName = funcName[i+1:]
+	}
+	if i := strings.Index(funcName, "."); i >= 0 {
+		funcName = funcName[:i]
+	}
+	return funcName
+}
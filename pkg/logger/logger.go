@@ -2,6 +2,7 @@ package logger
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -9,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/lmittmann/tint"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -25,25 +27,126 @@ const (
 	FatalLevel = LogLevel(slog.LevelError + 1)
 )
 
+// Sinks records can be written to
+const (
+	// SinkConsole writes colored text to stdout
+	SinkConsole = "console"
+	// SinkFile writes text to the rotated log file at Path
+	SinkFile = "file"
+	// SinkJSON writes one JSON object per record to stdout, for log
+	// collectors
+	SinkJSON = "json"
+	// SinkLoki pushes records to Grafana Loki
+	SinkLoki = "loki"
+	// SinkSyslog writes records to syslog
+	SinkSyslog = "syslog"
+)
+
 // LoggerConfig contains logger configuration
 type LoggerConfig struct {
-	Path        string
-	Level       LogLevel
-	Development bool
-	MaxSize     int
-	MaxBackups  int
-	MaxAge      int
-	Compress    bool
+	Path  string
+	Level LogLevel
+	// ModuleLevels override Level for the records of some modules, keyed
+	// by package name such as "tech_stack"
+	ModuleLevels map[string]LogLevel
+	Development  bool
+	MaxSize      int
+	MaxBackups   int
+	MaxAge       int
+	Compress     bool
+
+	// Sinks lists where records are written, console and file when empty
+	Sinks  []string
+	Loki   LokiConfig
+	Syslog SyslogConfig
+}
+
+// SyslogConfig configures writing records to syslog
+type SyslogConfig struct {
+	// Network and Address locate a remote syslog server, e.g. "udp" and
+	// "logs.example.com:514"; both empty use the local syslog daemon
+	Network string
+	Address string
+	Tag     string
 }
 
 // Logger wraps slog with additional features
 type Logger struct {
 	logger     *slog.Logger
 	fileWriter *lumberjack.Logger
+	levels     *levels
+	sinks      []string
+	closers    []io.Closer
 }
 
-// NewLogger creates a new Logger instance
+// NewLogger creates a new Logger instance. Sinks that cannot be set up are
+// reported on stdout and skipped, so logging never prevents startup.
 func NewLogger(cfg LoggerConfig) *Logger {
+	sinks := cfg.Sinks
+	if len(sinks) == 0 {
+		sinks = []string{SinkConsole, SinkFile}
+	}
+
+	l := &Logger{levels: newLevels(cfg.Level, cfg.ModuleLevels)}
+
+	// Records are filtered per module before they reach the handlers
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	tintOpts := &tint.Options{
+		Level:      slog.LevelDebug,
+		TimeFormat: "2006-01-02 15:04:05",
+	}
+
+	if cfg.Development {
+		opts.AddSource = true
+		tintOpts.AddSource = true
+	}
+
+	var handlers fanoutHandler
+	for _, sink := range sinks {
+		switch sink = strings.ToLower(strings.TrimSpace(sink)); sink {
+		case SinkConsole:
+			handlers = append(handlers, tint.NewHandler(os.Stdout, tintOpts))
+		case SinkFile:
+			l.fileWriter = newFileWriter(cfg)
+			l.closers = append(l.closers, l.fileWriter)
+			handlers = append(handlers, tint.NewHandler(l.fileWriter, tintOpts))
+		case SinkJSON:
+			handlers = append(handlers, slog.NewJSONHandler(os.Stdout, opts))
+		case SinkLoki:
+			pusher, err := newLokiPusher(cfg.Loki)
+			if err != nil {
+				fmt.Printf("Failed to set up Loki log sink: %v\n", err)
+				continue
+			}
+			l.closers = append(l.closers, pusher)
+			handlers = append(handlers, &lineHandler{opts: opts, json: true, emit: pusher.emit})
+		case SinkSyslog:
+			handler, closer, err := newSyslogHandler(cfg.Syslog, opts)
+			if err != nil {
+				fmt.Printf("Failed to set up syslog log sink: %v\n", err)
+				continue
+			}
+			l.closers = append(l.closers, closer)
+			handlers = append(handlers, handler)
+		default:
+			fmt.Printf("Unknown log sink %q\n", sink)
+			continue
+		}
+		l.sinks = append(l.sinks, sink)
+	}
+
+	// Never lose records entirely
+	if len(handlers) == 0 {
+		handlers = append(handlers, tint.NewHandler(os.Stdout, tintOpts))
+		l.sinks = append(l.sinks, SinkConsole)
+	}
+
+	l.logger = slog.New(handlers)
+	return l
+}
+
+// newFileWriter opens the rotated log file
+func newFileWriter(cfg LoggerConfig) *lumberjack.Logger {
 	// Set default configuration
 	if cfg.Path == "" {
 		cfg.Path = filepath.Join("logs", "app.log")
@@ -74,35 +177,20 @@ func NewLogger(cfg LoggerConfig) *Logger {
 		fileWriter.MaxAge = 30
 	}
 
-	// Combine file and stdout
-	multiWriter := io.MultiWriter(os.Stdout, fileWriter)
-
-	// Create handler
-	opts := &tint.Options{
-		Level:      slog.Level(cfg.Level),
-		TimeFormat: "2006-01-02 15:04:05",
-	}
-
-	if cfg.Development {
-		opts.AddSource = true
-	}
-
-	handler := tint.NewHandler(multiWriter, opts)
-	slogLogger := slog.New(handler)
-
-	return &Logger{
-		logger:     slogLogger,
-		fileWriter: fileWriter,
-	}
+	return fileWriter
 }
 
 // log is a generic logging method to reduce code duplication
 func (l *Logger) log(level slog.Level, msg string, args ...any) {
-	handler := l.logger.Handler()
-	if handler.Enabled(context.Background(), level) {
-		pc, file, line, _ := runtime.Caller(2)
-		funcName := runtime.FuncForPC(pc).Name()
+	if !l.levels.mayLog(LogLevel(level)) {
+		return
+	}
+
+	pc, file, line, _ := runtime.Caller(2)
+	funcName := runtime.FuncForPC(pc).Name()
 
+	handler := l.logger.Handler()
+	if l.levels.enabled(moduleOf(funcName), LogLevel(level)) && handler.Enabled(context.Background(), level) {
 		// Add caller info for development mode
 		if opts, ok := handler.(interface{ Options() *tint.Options }); ok {
 			tintOpts := opts.Options()
@@ -112,16 +200,12 @@ func (l *Logger) log(level slog.Level, msg string, args ...any) {
 			}
 		}
 
-		switch level {
-		case slog.LevelDebug:
-			l.logger.Debug(msg, args...)
-		case slog.LevelInfo:
-			l.logger.Info(msg, args...)
-		case slog.LevelWarn:
-			l.logger.Warn(msg, args...)
-		case slog.LevelError:
-			l.logger.Error(msg, args...)
-		}
+		// Attribute the record to the caller rather than to this method
+		var pcs [1]uintptr
+		runtime.Callers(3, pcs[:])
+		record := slog.NewRecord(time.Now(), level, msg, pcs[0])
+		record.Add(args...)
+		_ = handler.Handle(context.Background(), record)
 	}
 }
 
@@ -151,14 +235,55 @@ func (l *Logger) Fatal(msg string, args ...any) {
 	os.Exit(1)
 }
 
+// Level returns the level records are written at, unless their module has
+// a level of its own
+func (l *Logger) Level() LogLevel {
+	l.levels.mu.RLock()
+	defer l.levels.mu.RUnlock()
+	return l.levels.base
+}
+
+// SetLevel changes the default level at runtime
+func (l *Logger) SetLevel(level LogLevel) {
+	l.levels.setBase(level)
+}
+
+// SetModuleLevel changes the level of one module at runtime
+func (l *Logger) SetModuleLevel(module string, level LogLevel) {
+	l.levels.setModule(module, level)
+}
+
+// ResetModuleLevel makes a module follow the default level again
+func (l *Logger) ResetModuleLevel(module string) {
+	l.levels.resetModule(module)
+}
+
+// ModuleLevels lists the modules that logged or have a level of their own
+func (l *Logger) ModuleLevels() []ModuleLevel {
+	return l.levels.list()
+}
+
+// Sinks lists where records are written
+func (l *Logger) Sinks() []string {
+	return append([]string{}, l.sinks...)
+}
+
 // Rotate performs manual log file rotation
 func (l *Logger) Rotate() error {
+	if l.fileWriter == nil {
+		return nil
+	}
 	return l.fileWriter.Rotate()
 }
 
-// Close closes and cleans up logger resources
+// Close closes and cleans up logger resources, pushing buffered records to
+// remote sinks
 func (l *Logger) Close() error {
-	return l.fileWriter.Close()
+	var errs []error
+	for _, closer := range l.closers {
+		errs = append(errs, closer.Close())
+	}
+	return errors.Join(errs...)
 }
 
 // shortenPath shortens file path
@@ -0,0 +1,186 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LokiConfig configures pushing records to Grafana Loki
+type LokiConfig struct {
+	// URL is the base URL of Loki, e.g. http://loki:3100
+	URL string
+	// Labels identify the stream of this server, e.g. app and environment.
+	// Records are further labelled with their level.
+	Labels map[string]string
+
+	// Username and Password authenticate with basic auth, and TenantID
+	// selects the tenant of a multi-tenant Loki
+	Username string
+	Password string
+	TenantID string
+
+	// Records are pushed once BatchSize are buffered or every
+	// FlushInterval, whichever comes first
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// maxLokiBuffer bounds the records buffered while Loki is unreachable, in
+// batches; older records are dropped beyond it
+const maxLokiBuffer = 10
+
+// lokiPusher buffers records and pushes them to Loki in batches
+type lokiPusher struct {
+	config LokiConfig
+	client *http.Client
+
+	mu      sync.Mutex
+	entries map[string][][2]string
+	count   int
+
+	flush chan struct{}
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newLokiPusher(config LokiConfig) (*lokiPusher, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("loki sink requires a URL")
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = 5 * time.Second
+	}
+
+	p := &lokiPusher{
+		config:  config,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		entries: map[string][][2]string{},
+		flush:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	p.wg.Add(1)
+	go p.run()
+
+	return p, nil
+}
+
+// emit buffers a formatted record in the stream of its level
+func (p *lokiPusher) emit(r slog.Record, line []byte) error {
+	level := LogLevel(r.Level).String()
+
+	p.mu.Lock()
+	if p.count >= p.config.BatchSize*maxLokiBuffer {
+		p.mu.Unlock()
+		return nil
+	}
+	p.entries[level] = append(p.entries[level], [2]string{strconv.FormatInt(r.Time.UnixNano(), 10), string(line)})
+	p.count++
+	full := p.count >= p.config.BatchSize
+	p.mu.Unlock()
+
+	if full {
+		select {
+		case p.flush <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (p *lokiPusher) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			p.push()
+			return
+		case <-ticker.C:
+			p.push()
+		case <-p.flush:
+			p.push()
+		}
+	}
+}
+
+// push sends the buffered records. Failures are reported on stderr rather
+// than logged, which would feed the records back into the pusher.
+func (p *lokiPusher) push() {
+	p.mu.Lock()
+	entries := p.entries
+	count := p.count
+	p.entries = map[string][][2]string{}
+	p.count = 0
+	p.mu.Unlock()
+
+	if count == 0 {
+		return
+	}
+
+	type stream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+	payload := struct {
+		Streams []stream `json:"streams"`
+	}{}
+	for level, values := range entries {
+		labels := map[string]string{"level": level}
+		for name, value := range p.config.Labels {
+			labels[name] = value
+		}
+		payload.Streams = append(payload.Streams, stream{Stream: labels, Values: values})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode %d log records for Loki: %v\n", count, err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(p.config.URL, "/")+"/loki/api/v1/push", bytes.NewReader(body))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to push %d log records to Loki: %v\n", count, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.config.Username != "" {
+		req.SetBasicAuth(p.config.Username, p.config.Password)
+	}
+	if p.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", p.config.TenantID)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to push %d log records to Loki: %v\n", count, err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		fmt.Fprintf(os.Stderr, "Failed to push %d log records to Loki: status %d\n", count, resp.StatusCode)
+	}
+}
+
+// Close pushes the remaining records and stops the pusher
+func (p *lokiPusher) Close() error {
+	close(p.done)
+	p.wg.Wait()
+	return nil
+}
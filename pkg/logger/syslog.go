@@ -0,0 +1,44 @@
+//go:build !windows && !plan9
+
+package logger
+
+import (
+	"io"
+	"log/slog"
+	"log/syslog"
+)
+
+// newSyslogHandler writes records to syslog at the priority matching their
+// level. An empty network and address use the local syslog daemon.
+func newSyslogHandler(config SyslogConfig, opts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	writer, err := syslog.Dial(config.Network, config.Address, syslog.LOG_INFO|syslog.LOG_DAEMON, config.Tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Syslog stamps messages itself
+	textOpts := *opts
+	textOpts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) == 0 && a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return a
+	}
+
+	handler := &lineHandler{
+		opts: &textOpts,
+		emit: func(r slog.Record, line []byte) error {
+			switch {
+			case r.Level >= slog.LevelError:
+				return writer.Err(string(line))
+			case r.Level >= slog.LevelWarn:
+				return writer.Warning(string(line))
+			case r.Level >= slog.LevelInfo:
+				return writer.Info(string(line))
+			default:
+				return writer.Debug(string(line))
+			}
+		},
+	}
+	return handler, writer, nil
+}
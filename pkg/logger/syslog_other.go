@@ -0,0 +1,14 @@
+//go:build windows || plan9
+
+package logger
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+)
+
+// newSyslogHandler is unavailable where the standard library has no syslog
+func newSyslogHandler(config SyslogConfig, opts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	return nil, nil, errors.New("syslog is not supported on this platform")
+}
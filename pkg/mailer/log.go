@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// LogProvider logs messages instead of sending them, for local development
+type LogProvider struct {
+	logger *logger.Logger
+}
+
+// NewLogProvider creates a provider that only logs outgoing messages
+func NewLogProvider(log *logger.Logger) *LogProvider {
+	if log == nil {
+		log = logger.DefaultLogger()
+	}
+	return &LogProvider{logger: log}
+}
+
+// Name returns the provider name
+func (p *LogProvider) Name() string {
+	return "log"
+}
+
+// Send logs the envelope of msg
+func (p *LogProvider) Send(ctx context.Context, msg *Message) error {
+	p.logger.Info("Email not sent, mail provider is log",
+		"from", msg.From,
+		"to", msg.To,
+		"subject", msg.Subject,
+	)
+	return nil
+}
@@ -0,0 +1,135 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Message is an email ready to be handed to a provider
+type Message struct {
+	From    string
+	To      []string
+	ReplyTo string
+	Subject string
+	HTML    string
+	Text    string
+	Headers map[string]string
+}
+
+// Provider delivers messages through a specific email service
+type Provider interface {
+	Name() string
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Config provides configuration for the mailer
+type Config struct {
+	// Provider selects the adapter: smtp, resend, ses or log
+	Provider string
+
+	// Default sender used when a message has no From address
+	From    string
+	ReplyTo string
+
+	SMTP   SMTPConfig
+	Resend ResendConfig
+	SES    SESConfig
+
+	// Logger is used by the log provider
+	Logger *logger.Logger
+}
+
+// Mailer renders templates and sends messages through a provider
+type Mailer struct {
+	provider  Provider
+	templates *Templates
+	from      string
+	replyTo   string
+}
+
+// NewMailer creates a mailer using the provider selected in cfg
+func NewMailer(cfg Config) (*Mailer, error) {
+	provider, err := NewProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := LoadTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mailer{
+		provider:  provider,
+		templates: templates,
+		from:      cfg.From,
+		replyTo:   cfg.ReplyTo,
+	}, nil
+}
+
+// NewProvider builds the provider adapter named in cfg
+func NewProvider(cfg Config) (Provider, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "smtp":
+		return NewSMTPProvider(cfg.SMTP)
+	case "resend":
+		return NewResendProvider(cfg.Resend)
+	case "ses":
+		return NewSESProvider(cfg.SES)
+	case "", "log":
+		return NewLogProvider(cfg.Logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail provider %q", cfg.Provider)
+	}
+}
+
+// ProviderName returns the name of the configured provider
+func (m *Mailer) ProviderName() string {
+	return m.provider.Name()
+}
+
+// Send delivers msg, filling in the default sender and reply-to address
+func (m *Mailer) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return fmt.Errorf("message has no recipients")
+	}
+	if msg.From == "" {
+		msg.From = m.from
+	}
+	if msg.From == "" {
+		return fmt.Errorf("message has no sender")
+	}
+	if msg.ReplyTo == "" {
+		msg.ReplyTo = m.replyTo
+	}
+
+	return m.provider.Send(ctx, msg)
+}
+
+// Render builds a message from a named template without sending it
+func (m *Mailer) Render(template string, to []string, data interface{}) (*Message, error) {
+	subject, html, text, err := m.templates.Render(template, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:      to,
+		Subject: subject,
+		HTML:    html,
+		Text:    text,
+	}, nil
+}
+
+// SendTemplate renders a named template and delivers it to the recipients
+func (m *Mailer) SendTemplate(ctx context.Context, template string, to []string, data interface{}) error {
+	msg, err := m.Render(template, to, data)
+	if err != nil {
+		return err
+	}
+
+	return m.Send(ctx, msg)
+}
@@ -0,0 +1,89 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ResendConfig provides configuration for the Resend provider
+type ResendConfig struct {
+	APIKey  string
+	BaseURL string
+	Timeout time.Duration
+}
+
+// ResendProvider sends messages through the Resend HTTP API
+type ResendProvider struct {
+	cfg    ResendConfig
+	client *http.Client
+}
+
+// NewResendProvider creates a Resend provider
+func NewResendProvider(cfg ResendConfig) (*ResendProvider, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("Resend API key is required")
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = "https://api.resend.com"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &ResendProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (p *ResendProvider) Name() string {
+	return "resend"
+}
+
+// Send delivers msg through the Resend emails endpoint
+func (p *ResendProvider) Send(ctx context.Context, msg *Message) error {
+	payload := map[string]interface{}{
+		"from":    msg.From,
+		"to":      msg.To,
+		"subject": msg.Subject,
+		"html":    msg.HTML,
+		"text":    msg.Text,
+	}
+	if msg.ReplyTo != "" {
+		payload["reply_to"] = msg.ReplyTo
+	}
+	if len(msg.Headers) > 0 {
+		payload["headers"] = msg.Headers
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode Resend payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.BaseURL+"/emails", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build Resend request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.cfg.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("resend request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("resend returned status %d: %s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SESConfig provides configuration for the Amazon SES provider
+type SESConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Timeout         time.Duration
+}
+
+// SESProvider sends messages through the Amazon SES v2 API
+type SESProvider struct {
+	cfg      SESConfig
+	client   *http.Client
+	endpoint string
+}
+
+// NewSESProvider creates an Amazon SES provider
+func NewSESProvider(cfg SESConfig) (*SESProvider, error) {
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("SES region is required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("SES access key ID and secret access key are required")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &SESProvider{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: fmt.Sprintf("https://email.%s.amazonaws.com", cfg.Region),
+	}, nil
+}
+
+// Name returns the provider name
+func (p *SESProvider) Name() string {
+	return "ses"
+}
+
+// Send delivers msg through the SES v2 SendEmail operation
+func (p *SESProvider) Send(ctx context.Context, msg *Message) error {
+	content := map[string]interface{}{
+		"Subject": map[string]string{"Data": msg.Subject, "Charset": "UTF-8"},
+	}
+	body := map[string]interface{}{}
+	if msg.HTML != "" {
+		body["Html"] = map[string]string{"Data": msg.HTML, "Charset": "UTF-8"}
+	}
+	if msg.Text != "" {
+		body["Text"] = map[string]string{"Data": msg.Text, "Charset": "UTF-8"}
+	}
+	content["Body"] = body
+
+	payload := map[string]interface{}{
+		"FromEmailAddress": msg.From,
+		"Destination":      map[string][]string{"ToAddresses": msg.To},
+		"Content":          map[string]interface{}{"Simple": content},
+	}
+	if msg.ReplyTo != "" {
+		payload["ReplyToAddresses"] = []string{msg.ReplyTo}
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode SES payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/v2/email/outbound-emails", bytes.NewReader(encoded))
+	if err != nil {
+		return fmt.Errorf("failed to build SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	p.sign(req, encoded, time.Now().UTC())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ses request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("ses returned status %d: %s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req
+func (p *SESProvider) sign(req *http.Request, payload []byte, now time.Time) {
+	const service = "ses"
+
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if p.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.cfg.SessionToken)
+	}
+
+	signedHeaders := "content-type;host;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("content-type:%s\nhost:%s\nx-amz-date:%s\n",
+		req.Header.Get("Content-Type"), req.URL.Host, amzDate)
+	if p.cfg.SessionToken != "" {
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += fmt.Sprintf("x-amz-security-token:%s\n", p.cfg.SessionToken)
+	}
+
+	canonicalRequest := fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s",
+		req.Method, req.URL.EscapedPath(), req.URL.RawQuery, canonicalHeaders, signedHeaders, payloadHash)
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, p.cfg.Region, service)
+	stringToSign := fmt.Sprintf("AWS4-HMAC-SHA256\n%s\n%s\n%s", amzDate, scope, sha256Hex([]byte(canonicalRequest)))
+
+	signingKey := hmacSHA256([]byte("AWS4"+p.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, p.cfg.Region)
+	signingKey = hmacSHA256(signingKey, service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.cfg.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,174 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig provides configuration for the SMTP provider
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// SMTPProvider sends messages through an SMTP server
+type SMTPProvider struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPProvider creates an SMTP provider
+func NewSMTPProvider(cfg SMTPConfig) (*SMTPProvider, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("SMTP host is required")
+	}
+	if cfg.Port <= 0 {
+		cfg.Port = 587
+	}
+
+	return &SMTPProvider{cfg: cfg}, nil
+}
+
+// Name returns the provider name
+func (p *SMTPProvider) Name() string {
+	return "smtp"
+}
+
+// Send delivers msg using STARTTLS when the server supports it
+func (p *SMTPProvider) Send(ctx context.Context, msg *Message) error {
+	addr := net.JoinHostPort(p.cfg.Host, strconv.Itoa(p.cfg.Port))
+
+	var auth smtp.Auth
+	if p.cfg.Username != "" {
+		auth = smtp.PlainAuth("", p.cfg.Username, p.cfg.Password, p.cfg.Host)
+	}
+
+	body, err := buildMIMEMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, envelopeAddress(msg.From), envelopeAddresses(msg.To), body)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("smtp send failed: %w", err)
+		}
+		return nil
+	}
+}
+
+// headerSanitizer strips line breaks to prevent header injection
+var headerSanitizer = strings.NewReplacer("\r", "", "\n", "")
+
+// buildMIMEMessage encodes msg as a multipart/alternative MIME message
+func buildMIMEMessage(msg *Message) ([]byte, error) {
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"From":         msg.From,
+		"To":           strings.Join(msg.To, ", "),
+		"Subject":      mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date":         time.Now().UTC().Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+		"Content-Type": fmt.Sprintf("multipart/alternative; boundary=%q", boundary),
+	}
+	if msg.ReplyTo != "" {
+		headers["Reply-To"] = msg.ReplyTo
+	}
+	for key, value := range msg.Headers {
+		headers[key] = value
+	}
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, headerSanitizer.Replace(headers[key]))
+	}
+	buf.WriteString("\r\n")
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain", msg.Text},
+		{"text/html", msg.HTML},
+	}
+
+	for _, part := range parts {
+		if part.content == "" {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n", part.contentType)
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+		writer := quotedprintable.NewWriter(&buf)
+		if _, err := writer.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("failed to encode message body: %w", err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode message body: %w", err)
+		}
+		buf.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+// envelopeAddress strips the display name from an address
+func envelopeAddress(address string) string {
+	if start := strings.LastIndex(address, "<"); start >= 0 {
+		if end := strings.LastIndex(address, ">"); end > start {
+			return address[start+1 : end]
+		}
+	}
+	return strings.TrimSpace(address)
+}
+
+// envelopeAddresses strips the display names from addresses
+func envelopeAddresses(addresses []string) []string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = envelopeAddress(address)
+	}
+	return result
+}
+
+// randomBoundary returns a random MIME boundary
+func randomBoundary() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate MIME boundary: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,177 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlstd "html"
+	"html/template"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Template names shipped with the mailer
+const (
+	TemplateContactNotification = "contact_notification"
+	TemplateBookingConfirmation = "booking_confirmation"
+	TemplateNewsletter          = "newsletter"
+	TemplateNotification        = "notification"
+	TemplateProposal            = "proposal"
+	TemplatePortalLogin         = "portal_login"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Templates holds the parsed email templates keyed by name
+type Templates struct {
+	templates map[string]*template.Template
+}
+
+// LoadTemplates parses every template with the shared layout
+func LoadTemplates() (*Templates, error) {
+	layout, err := template.ParseFS(templateFS, "templates/layout.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email layout: %w", err)
+	}
+
+	files, err := fs.Glob(templateFS, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email templates: %w", err)
+	}
+
+	templates := make(map[string]*template.Template)
+	for _, file := range files {
+		name := strings.TrimSuffix(path.Base(file), ".html")
+		if name == "layout" {
+			continue
+		}
+
+		tmpl, err := template.Must(layout.Clone()).ParseFS(templateFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse email template %s: %w", name, err)
+		}
+		templates[name] = tmpl
+	}
+
+	return &Templates{templates: templates}, nil
+}
+
+// Render executes a template and returns its subject, HTML and plain text body
+func (t *Templates) Render(name string, data interface{}) (string, string, string, error) {
+	tmpl, ok := t.templates[name]
+	if !ok {
+		return "", "", "", fmt.Errorf("email template %q not found", name)
+	}
+
+	var subject bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render subject of %s: %w", name, err)
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, "layout", data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render email template %s: %w", name, err)
+	}
+
+	html := body.String()
+	return strings.TrimSpace(htmlstd.UnescapeString(subject.String())), html, htmlToText(html), nil
+}
+
+// Names returns the names of the loaded templates
+func (t *Templates) Names() []string {
+	names := make([]string, 0, len(t.templates))
+	for name := range t.templates {
+		names = append(names, name)
+	}
+	return names
+}
+
+var (
+	tagPattern        = regexp.MustCompile(`(?s)<[^>]*>`)
+	blockPattern      = regexp.MustCompile(`(?i)</?(p|div|br|tr|h[1-6]|li)[^>]*>`)
+	styleBlockPattern = regexp.MustCompile(`(?is)<(style|head)[^>]*>.*?</(style|head)>`)
+	blankLinesPattern = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// htmlToText derives a plain text alternative from an HTML body
+func htmlToText(body string) string {
+	text := styleBlockPattern.ReplaceAllString(body, "")
+	text = blockPattern.ReplaceAllString(text, "\n")
+	text = tagPattern.ReplaceAllString(text, "")
+	text = htmlstd.UnescapeString(text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// ContactNotificationData is the data of the contact_notification template
+type ContactNotificationData struct {
+	SiteName string
+	Name     string
+	Email    string
+	Subject  string
+	Message  string
+}
+
+// BookingConfirmationData is the data of the booking_confirmation template
+type BookingConfirmationData struct {
+	SiteName   string
+	Name       string
+	Date       string
+	Time       string
+	Topic      string
+	MeetingURL string
+}
+
+// NewsletterItem is an entry listed in a newsletter
+type NewsletterItem struct {
+	Title   string
+	Summary string
+	URL     string
+}
+
+// NewsletterData is the data of the newsletter template
+type NewsletterData struct {
+	SiteName       string
+	Title          string
+	Intro          string
+	Items          []NewsletterItem
+	UnsubscribeURL string
+}
+
+// NotificationData is the data of the notification template
+type NotificationData struct {
+	SiteName string
+	Title    string
+	Body     string
+	URL      string
+	Fields   [][2]string
+}
+
+// ProposalData is the data of the proposal template
+type ProposalData struct {
+	SiteName      string
+	Name          string
+	Title         string
+	Number        string
+	Total         string
+	ValidUntil    string
+	DownloadURL   string
+	AcceptURL     string
+	LinkExpiresAt string
+}
+
+// PortalLoginData is the data of the portal login template
+type PortalLoginData struct {
+	SiteName  string
+	Name      string
+	LoginURL  string
+	ExpiresIn string
+}
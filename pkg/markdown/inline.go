@@ -0,0 +1,190 @@
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// renderInline converts inline Markdown, escaping everything else
+func renderInline(text string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text) && isPunct(text[i+1]):
+			b.WriteString(html.EscapeString(text[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '`':
+			if end, inner, ok := codeSpan(text, i); ok {
+				b.WriteString("<code>" + html.EscapeString(inner) + "</code>")
+				i = end
+				continue
+			}
+
+		case c == '!' && strings.HasPrefix(text[i+1:], "["):
+			if end, label, target, ok := linkAt(text, i+1); ok {
+				b.WriteString(`<img src="` + html.EscapeString(safeURL(target)) + `" alt="` + html.EscapeString(label) + `">`)
+				i = end
+				continue
+			}
+
+		case c == '[':
+			if end, label, target, ok := linkAt(text, i); ok {
+				b.WriteString(`<a href="` + html.EscapeString(safeURL(target)) + `">` + renderInline(label) + "</a>")
+				i = end
+				continue
+			}
+
+		case (c == '*' || c == '_') && strings.HasPrefix(text[i:], strings.Repeat(string(c), 2)):
+			if end, inner, ok := emphasis(text, i, text[i:i+2]); ok {
+				b.WriteString("<strong>" + renderInline(inner) + "</strong>")
+				i = end
+				continue
+			}
+
+		case c == '*' || c == '_':
+			if end, inner, ok := emphasis(text, i, text[i:i+1]); ok {
+				b.WriteString("<em>" + renderInline(inner) + "</em>")
+				i = end
+				continue
+			}
+
+		case c == '\n':
+			b.WriteString("\n")
+			i++
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		b.WriteString(html.EscapeString(text[i : i+size]))
+		i += size
+	}
+
+	return b.String()
+}
+
+// codeSpan matches a code span opening at text[start] and returns the index
+// after it and its content
+func codeSpan(text string, start int) (int, string, bool) {
+	ticks := 0
+	for start+ticks < len(text) && text[start+ticks] == '`' {
+		ticks++
+	}
+	fence := strings.Repeat("`", ticks)
+
+	rest := text[start+ticks:]
+	end := strings.Index(rest, fence)
+	if end < 0 {
+		return 0, "", false
+	}
+	return start + ticks + end + ticks, strings.TrimSpace(rest[:end]), true
+}
+
+// linkAt matches [label](target) opening at text[start] and returns the index
+// after it, its label and its target
+func linkAt(text string, start int) (int, string, string, bool) {
+	depth := 0
+	closing := -1
+	for i := start; i < len(text) && closing < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 || !strings.HasPrefix(text[closing+1:], "(") {
+		return 0, "", "", false
+	}
+
+	end := strings.IndexByte(text[closing+2:], ')')
+	if end < 0 {
+		return 0, "", "", false
+	}
+
+	// Drop an optional title after the URL
+	target := strings.TrimSpace(text[closing+2 : closing+2+end])
+	if fields := strings.Fields(target); len(fields) > 0 {
+		target = strings.Trim(fields[0], "<>")
+	}
+
+	return closing + 2 + end + 1, text[start+1 : closing], target, true
+}
+
+// emphasis matches text wrapped in delim opening at text[start] and returns
+// the index after it and its content. Underscores inside words, as in
+// snake_case, are left alone.
+func emphasis(text string, start int, delim string) (int, string, bool) {
+	if delim[0] == '_' && start > 0 && isWordByte(text[start-1]) {
+		return 0, "", false
+	}
+
+	open := start + len(delim)
+	if open >= len(text) || text[open] == ' ' || text[open] == '\n' {
+		return 0, "", false
+	}
+
+	for i := open; i < len(text); i++ {
+		if text[i] == '\\' {
+			i++
+			continue
+		}
+		if text[i] == '`' {
+			if end, _, ok := codeSpan(text, i); ok {
+				i = end - 1
+				continue
+			}
+		}
+		if !strings.HasPrefix(text[i:], delim) || text[i-1] == ' ' {
+			continue
+		}
+		// A single delimiter must not be half of a double one
+		if len(delim) == 1 && strings.HasPrefix(text[i+1:], delim) {
+			i++
+			continue
+		}
+		after := i + len(delim)
+		if delim[0] == '_' && after < len(text) && isWordByte(text[after]) {
+			continue
+		}
+		return after, text[open:i], true
+	}
+
+	return 0, "", false
+}
+
+// safeURL returns target if it is a relative, http, https or mailto URL and
+// "#" otherwise, so that links cannot run scripts
+func safeURL(target string) string {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return "#"
+	}
+
+	switch strings.ToLower(parsed.Scheme) {
+	case "", "http", "https", "mailto":
+		return target
+	default:
+		return "#"
+	}
+}
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("`*_[]()#+-.!<>|~", c) >= 0
+}
+
+func isWordByte(c byte) bool {
+	return c >= utf8.RuneSelf || c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
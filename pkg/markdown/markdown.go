@@ -0,0 +1,250 @@
+// Package markdown renders a safe subset of Markdown to HTML
+//
+// Supported are ATX headings, paragraphs, emphasis, inline code, fenced code
+// blocks, links, images, block quotes, flat ordered and unordered lists and
+// horizontal rules. Raw HTML is always escaped and link targets are limited
+// to http, https, mailto and relative URLs, so the output can be embedded
+// without further sanitizing. Options let callers render fenced code blocks
+// of given languages themselves, e.g. diagrams. Headings get an id anchor,
+// which TableOfContents links to.
+package markdown
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// Options customizes rendering
+type Options struct {
+	// CodeBlock renders the fenced code block of language. Its HTML is
+	// written as is, so it must be safe to embed; returning false falls back
+	// to a plain code block.
+	CodeBlock func(language, code string) (string, bool)
+}
+
+// Render converts Markdown source to HTML
+func Render(source string) string {
+	return RenderWith(source, Options{})
+}
+
+// RenderWith converts Markdown source to HTML with opts
+func RenderWith(source string, opts Options) string {
+	out, _ := render(source, opts)
+	return out
+}
+
+// renderer holds the state of rendering one document
+type renderer struct {
+	opts Options
+	// anchors counts the uses of each heading anchor, so repeated headings
+	// get distinct ones
+	anchors  map[string]int
+	headings []Heading
+}
+
+// render converts Markdown source to HTML and returns its headings in
+// document order
+func render(source string, opts Options) (string, []Heading) {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+
+	r := &renderer{opts: opts, anchors: make(map[string]int)}
+	var b strings.Builder
+	renderBlocks(&b, lines, r)
+	return strings.TrimSuffix(b.String(), "\n"), r.headings
+}
+
+// renderBlocks writes the block level elements found in lines
+func renderBlocks(b *strings.Builder, lines []string, r *renderer) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case isFence(trimmed):
+			i = renderCodeBlock(b, lines, i, r.opts)
+
+		case headingLevel(trimmed) > 0:
+			level := headingLevel(trimmed)
+			text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(trimmed[level:]), "#"))
+			content := renderInline(text)
+			heading := r.heading(level, content)
+			tag := "h" + strconv.Itoa(level)
+			b.WriteString("<" + tag + ` id="` + heading.Anchor + `">` + content + "</" + tag + ">\n")
+			i++
+
+		case isRule(trimmed):
+			b.WriteString("<hr>\n")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimPrefix(text, " "))
+				i++
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted, r)
+			b.WriteString("</blockquote>\n")
+
+		case listMarker(trimmed) != "":
+			i = renderList(b, lines, i)
+
+		default:
+			var paragraph []string
+			for i < len(lines) && startsParagraphLine(lines[i], len(paragraph) == 0) {
+				paragraph = append(paragraph, strings.TrimSpace(lines[i]))
+				i++
+			}
+			b.WriteString("<p>" + renderInline(strings.Join(paragraph, "\n")) + "</p>\n")
+		}
+	}
+}
+
+// startsParagraphLine reports whether line continues the current paragraph
+func startsParagraphLine(line string, first bool) bool {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return false
+	}
+	if first {
+		return true
+	}
+	return !isFence(trimmed) && headingLevel(trimmed) == 0 && !isRule(trimmed) &&
+		!strings.HasPrefix(trimmed, ">") && listMarker(trimmed) == ""
+}
+
+// renderCodeBlock writes the fenced code block starting at lines[start] and
+// returns the index of the line after it
+func renderCodeBlock(b *strings.Builder, lines []string, start int, opts Options) int {
+	opening := strings.TrimSpace(lines[start])
+	fence := opening[:3]
+	language := strings.TrimSpace(strings.TrimLeft(opening, fence[:1]))
+	if fields := strings.Fields(language); len(fields) > 0 {
+		language = fields[0]
+	}
+
+	i := start + 1
+	var code []string
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+
+	if opts.CodeBlock != nil && language != "" {
+		if rendered, ok := opts.CodeBlock(language, strings.Join(code, "\n")); ok {
+			b.WriteString(rendered + "\n")
+			return i
+		}
+	}
+
+	b.WriteString("<pre><code")
+	if language != "" {
+		b.WriteString(` class="language-` + html.EscapeString(language) + `"`)
+	}
+	b.WriteString(">" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+	return i
+}
+
+// renderList writes the list starting at lines[start] and returns the index
+// of the line after it. Indented lines continue the previous item.
+func renderList(b *strings.Builder, lines []string, start int) int {
+	ordered := isOrderedMarker(listMarker(strings.TrimSpace(lines[start])))
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	var items []string
+	i := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			break
+		}
+
+		marker := listMarker(trimmed)
+		switch {
+		case marker != "" && isOrderedMarker(marker) == ordered:
+			items = append(items, strings.TrimSpace(trimmed[len(marker):]))
+		case marker == "" && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")):
+			items[len(items)-1] += "\n" + trimmed
+		default:
+			return writeList(b, tag, items, i)
+		}
+	}
+
+	return writeList(b, tag, items, i)
+}
+
+func writeList(b *strings.Builder, tag string, items []string, next int) int {
+	b.WriteString("<" + tag + ">\n")
+	for _, item := range items {
+		b.WriteString("<li>" + renderInline(item) + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+	return next
+}
+
+// headingLevel returns the level of an ATX heading, or 0 if line is not one
+func headingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0
+	}
+	if level < len(line) && line[level] != ' ' {
+		return 0
+	}
+	return level
+}
+
+// isFence reports whether line opens or closes a fenced code block
+func isFence(line string) bool {
+	return strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~")
+}
+
+// isRule reports whether line is a horizontal rule such as --- or ***
+func isRule(line string) bool {
+	compact := strings.ReplaceAll(line, " ", "")
+	if len(compact) < 3 {
+		return false
+	}
+	for _, c := range []string{"-", "*", "_"} {
+		if strings.Trim(compact, c) == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// listMarker returns the list marker line starts with, such as "- " or "2. ",
+// or an empty string if line is not a list item
+func listMarker(line string) string {
+	if len(line) >= 2 && strings.ContainsRune("-*+", rune(line[0])) && line[1] == ' ' {
+		return line[:2]
+	}
+
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && len(line) > digits+1 && (line[digits] == '.' || line[digits] == ')') && line[digits+1] == ' ' {
+		return line[:digits+2]
+	}
+	return ""
+}
+
+func isOrderedMarker(marker string) bool {
+	return marker != "" && marker[0] >= '0' && marker[0] <= '9'
+}
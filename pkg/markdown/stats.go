@@ -0,0 +1,37 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// wordsPerMinute is the reading speed reading times are estimated with
+const wordsPerMinute = 200
+
+// tagPattern matches the tags of rendered HTML
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// WordCount counts the words of Markdown source as it reads once rendered,
+// without markup
+func WordCount(source string) int {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(Render(source), " "))
+
+	words := 0
+	for _, field := range strings.Fields(text) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			words++
+		}
+	}
+	return words
+}
+
+// ReadingTime estimates the minutes needed to read words, rounded up so
+// that any text takes at least a minute
+func ReadingTime(words int) int {
+	if words <= 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
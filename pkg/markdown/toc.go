@@ -0,0 +1,75 @@
+package markdown
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Heading is an entry of a table of contents
+type Heading struct {
+	Level int    `json:"level" example:"2"`
+	Text  string `json:"text" example:"Architecture"`
+	// Anchor is the id of the rendered heading, linked to as "#" + Anchor
+	Anchor   string    `json:"anchor" example:"architecture"`
+	Children []Heading `json:"children,omitempty"`
+}
+
+// TableOfContents returns the headings of Markdown source as a tree, each
+// heading holding the deeper headings that follow it. Anchors match the ids
+// Render gives the headings.
+func TableOfContents(source string) []Heading {
+	_, headings := render(source, Options{})
+
+	toc := []Heading{}
+	for _, heading := range headings {
+		toc = nest(toc, heading)
+	}
+	return toc
+}
+
+// nest appends heading to the last entry of toc deeper than which it is,
+// or to toc itself
+func nest(toc []Heading, heading Heading) []Heading {
+	if last := len(toc) - 1; last >= 0 && heading.Level > toc[last].Level {
+		toc[last].Children = nest(toc[last].Children, heading)
+		return toc
+	}
+	return append(toc, heading)
+}
+
+// heading records a heading with its rendered content and returns it
+func (r *renderer) heading(level int, content string) Heading {
+	text := strings.TrimSpace(html.UnescapeString(tagPattern.ReplaceAllString(content, "")))
+
+	anchor := slug(text)
+	if n := r.anchors[anchor]; n > 0 {
+		r.anchors[anchor] = n + 1
+		anchor += "-" + strconv.Itoa(n)
+	} else {
+		r.anchors[anchor] = 1
+	}
+
+	heading := Heading{Level: level, Text: text, Anchor: anchor}
+	r.headings = append(r.headings, heading)
+	return heading
+}
+
+// slug turns heading text into an anchor the way GitHub does: lowercase
+// letters, digits, underscores and hyphens, with spaces as hyphens
+func slug(text string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '-':
+			b.WriteRune(c)
+		case c == ' ':
+			b.WriteByte('-')
+		}
+	}
+	if b.Len() == 0 {
+		return "section"
+	}
+	return b.String()
+}
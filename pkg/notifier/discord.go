@@ -0,0 +1,58 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+)
+
+// discordColors maps levels to embed colors
+var discordColors = map[Level]int{
+	LevelInfo:    0x38bdf8,
+	LevelWarning: 0xf59e0b,
+	LevelError:   0xef4444,
+}
+
+// DiscordSender posts notifications to a Discord webhook
+type DiscordSender struct {
+	webhookURL string
+}
+
+// NewDiscordSender creates a Discord sender
+func NewDiscordSender(webhookURL string) (*DiscordSender, error) {
+	if webhookURL == "" {
+		return nil, fmt.Errorf("discord webhook URL is required")
+	}
+
+	return &DiscordSender{webhookURL: webhookURL}, nil
+}
+
+// Send posts the notification as an embed
+func (s *DiscordSender) Send(ctx context.Context, n Notification) error {
+	fields := make([]map[string]interface{}, 0, len(n.Fields))
+	for _, field := range n.SortedFields() {
+		fields = append(fields, map[string]interface{}{
+			"name":   field[0],
+			"value":  field[1],
+			"inline": true,
+		})
+	}
+
+	embed := map[string]interface{}{
+		"title":       n.Title,
+		"description": n.Body,
+		"color":       discordColors[n.Level],
+		"fields":      fields,
+		"footer":      map[string]string{"text": n.Event},
+	}
+	if n.URL != "" {
+		embed["url"] = n.URL
+	}
+
+	err := postJSON(ctx, defaultClient, s.webhookURL, map[string]interface{}{
+		"embeds": []interface{}{embed},
+	})
+	if err != nil {
+		return fmt.Errorf("discord: %w", err)
+	}
+	return nil
+}
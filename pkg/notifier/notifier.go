@@ -0,0 +1,100 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Level represents the severity of a notification
+type Level string
+
+const (
+	LevelInfo    Level = "info"
+	LevelWarning Level = "warning"
+	LevelError   Level = "error"
+)
+
+// Notification is a message pushed to one or more channels
+type Notification struct {
+	Event  string
+	Level  Level
+	Title  string
+	Body   string
+	URL    string
+	Fields map[string]string
+}
+
+// Sender delivers notifications to a chat service
+type Sender interface {
+	Send(ctx context.Context, n Notification) error
+}
+
+// defaultClient is shared by the webhook based senders
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// SortedFields returns the notification fields ordered by key
+func (n Notification) SortedFields() [][2]string {
+	keys := make([]string, 0, len(n.Fields))
+	for key := range n.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([][2]string, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, [2]string{key, n.Fields[key]})
+	}
+	return fields
+}
+
+// PlainText renders the notification as plain text
+func (n Notification) PlainText() string {
+	var b strings.Builder
+	b.WriteString(n.Title)
+	if n.Body != "" {
+		b.WriteString("\n\n")
+		b.WriteString(n.Body)
+	}
+	for _, field := range n.SortedFields() {
+		fmt.Fprintf(&b, "\n%s: %s", field[0], field[1])
+	}
+	if n.URL != "" {
+		b.WriteString("\n\n")
+		b.WriteString(n.URL)
+	}
+	return b.String()
+}
+
+// postJSON sends payload to url and fails on non-2xx responses
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SlackSender posts notifications to a Slack incoming webhook
+type SlackSender struct {
+	webhookURL string
+}
+
+// NewSlackSender creates a Slack sender
+func NewSlackSender(webhookURL string) (*SlackSender, error) {
+	if webhookURL == "" {
+		return nil, fmt.Errorf("slack webhook URL is required")
+	}
+
+	return &SlackSender{webhookURL: webhookURL}, nil
+}
+
+// Send posts the notification using Block Kit sections
+func (s *SlackSender) Send(ctx context.Context, n Notification) error {
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]string{"type": "plain_text", "text": n.Title},
+		},
+	}
+
+	if n.Body != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]string{"type": "mrkdwn", "text": slackEscape(n.Body)},
+		})
+	}
+
+	if len(n.Fields) > 0 {
+		fields := make([]map[string]string, 0, len(n.Fields))
+		for _, field := range n.SortedFields() {
+			fields = append(fields, map[string]string{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*%s*\n%s", slackEscape(field[0]), slackEscape(field[1])),
+			})
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"type":   "section",
+			"fields": fields,
+		})
+	}
+
+	if n.URL != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]string{"type": "mrkdwn", "text": fmt.Sprintf("<%s|Open>", n.URL)},
+		})
+	}
+
+	err := postJSON(ctx, defaultClient, s.webhookURL, map[string]interface{}{
+		"text":   n.PlainText(),
+		"blocks": blocks,
+	})
+	if err != nil {
+		return fmt.Errorf("slack: %w", err)
+	}
+	return nil
+}
+
+// slackEscape escapes the control characters of Slack mrkdwn
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
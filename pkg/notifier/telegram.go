@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// TelegramSender posts notifications to a Telegram chat through a bot
+type TelegramSender struct {
+	botToken string
+	chatID   string
+	baseURL  string
+}
+
+// NewTelegramSender creates a Telegram sender
+func NewTelegramSender(botToken, chatID string) (*TelegramSender, error) {
+	if botToken == "" || chatID == "" {
+		return nil, fmt.Errorf("telegram bot token and chat ID are required")
+	}
+
+	return &TelegramSender{
+		botToken: botToken,
+		chatID:   chatID,
+		baseURL:  "https://api.telegram.org",
+	}, nil
+}
+
+// Send posts the notification with the sendMessage method
+func (s *TelegramSender) Send(ctx context.Context, n Notification) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<b>%s</b>", html.EscapeString(n.Title))
+	if n.Body != "" {
+		fmt.Fprintf(&b, "\n\n%s", html.EscapeString(n.Body))
+	}
+	for _, field := range n.SortedFields() {
+		fmt.Fprintf(&b, "\n<b>%s:</b> %s", html.EscapeString(field[0]), html.EscapeString(field[1]))
+	}
+	if n.URL != "" {
+		fmt.Fprintf(&b, "\n\n<a href=\"%s\">Open</a>", html.EscapeString(n.URL))
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", s.baseURL, s.botToken)
+	err := postJSON(ctx, defaultClient, url, map[string]interface{}{
+		"chat_id":                  s.chatID,
+		"text":                     b.String(),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
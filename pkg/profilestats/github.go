@@ -0,0 +1,78 @@
+package profilestats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// githubMaxRepoPages bounds the repository pages read when counting stars
+const githubMaxRepoPages = 10
+
+// GitHub reads followers, public repositories and stars of a GitHub user
+type GitHub struct {
+	client *http.Client
+	token  string
+}
+
+// NewGitHub creates the GitHub provider. The token is optional and only
+// raises the API rate limit.
+func NewGitHub(client *http.Client, token string) *GitHub {
+	return &GitHub{client: client, token: token}
+}
+
+func (g *GitHub) Name() string {
+	return "github"
+}
+
+// Fetch reads the user with login account. Stars are summed over the
+// user's own, non-forked public repositories.
+func (g *GitHub) Fetch(ctx context.Context, account string) (*Stats, error) {
+	var user struct {
+		HTMLURL     string `json:"html_url"`
+		Followers   int64  `json:"followers"`
+		PublicRepos int64  `json:"public_repos"`
+	}
+	if err := getJSON(ctx, g.client, "https://api.github.com/users/"+url.PathEscape(account), g.headers(), &user); err != nil {
+		return nil, fmt.Errorf("github: %w", err)
+	}
+
+	var stars int64
+	for page := 1; page <= githubMaxRepoPages; page++ {
+		var repos []struct {
+			Fork            bool  `json:"fork"`
+			StargazersCount int64 `json:"stargazers_count"`
+		}
+		repoURL := fmt.Sprintf("https://api.github.com/users/%s/repos?type=owner&per_page=100&page=%d", url.PathEscape(account), page)
+		if err := getJSON(ctx, g.client, repoURL, g.headers(), &repos); err != nil {
+			return nil, fmt.Errorf("github: %w", err)
+		}
+
+		for _, repo := range repos {
+			if !repo.Fork {
+				stars += repo.StargazersCount
+			}
+		}
+		if len(repos) < 100 {
+			break
+		}
+	}
+
+	return &Stats{
+		ProfileURL: user.HTMLURL,
+		Metrics: map[string]int64{
+			"followers":    user.Followers,
+			"public_repos": user.PublicRepos,
+			"stars":        stars,
+		},
+	}, nil
+}
+
+func (g *GitHub) headers() map[string]string {
+	headers := map[string]string{"Accept": "application/vnd.github+json"}
+	if g.token != "" {
+		headers["Authorization"] = "Bearer " + g.token
+	}
+	return headers
+}
@@ -0,0 +1,63 @@
+package profilestats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NPM reads the downloads of a set of npm packages
+type NPM struct {
+	client *http.Client
+}
+
+// NewNPM creates the npm provider
+func NewNPM(client *http.Client) *NPM {
+	return &NPM{client: client}
+}
+
+func (n *NPM) Name() string {
+	return "npm"
+}
+
+// Fetch sums the downloads over the last month of the comma separated
+// package names in account
+func (n *NPM) Fetch(ctx context.Context, account string) (*Stats, error) {
+	var packages []string
+	for _, name := range strings.Split(account, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			packages = append(packages, name)
+		}
+	}
+	if len(packages) == 0 {
+		return nil, fmt.Errorf("npm: no packages configured")
+	}
+
+	// Packages are requested one by one since scoped packages are not
+	// supported by the bulk endpoint
+	var downloads int64
+	for _, name := range packages {
+		var point struct {
+			Downloads int64 `json:"downloads"`
+		}
+		// Keep the slash of scoped names such as @scope/name
+		apiURL := "https://api.npmjs.org/downloads/point/last-month/" + strings.ReplaceAll(url.PathEscape(name), "%2F", "/")
+		if err := getJSON(ctx, n.client, apiURL, nil, &point); err != nil {
+			return nil, fmt.Errorf("npm: %s: %w", name, err)
+		}
+		downloads += point.Downloads
+	}
+
+	stats := &Stats{
+		Metrics: map[string]int64{
+			"packages":             int64(len(packages)),
+			"downloads_last_month": downloads,
+		},
+	}
+	if len(packages) == 1 {
+		stats.ProfileURL = "https://www.npmjs.com/package/" + packages[0]
+	}
+	return stats, nil
+}
@@ -0,0 +1,79 @@
+// Package profilestats reads public statistics from developer profiles such
+// as GitHub, Stack Overflow and npm
+package profilestats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Stats are the figures read from a profile, keyed by metric name
+type Stats struct {
+	ProfileURL string
+	Metrics    map[string]int64
+}
+
+// Provider reads the stats of an account on a single site
+type Provider interface {
+	// Name identifies the provider, such as "github"
+	Name() string
+
+	Fetch(ctx context.Context, account string) (*Stats, error)
+}
+
+// Source is an account to read with a provider
+type Source struct {
+	Provider Provider
+	Account  string
+}
+
+// NewHTTPClient creates the client shared by the providers
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	return &http.Client{Timeout: timeout}
+}
+
+// getJSON fetches url and decodes its JSON response into out
+func getJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "itsrama-profilestats/1.0")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, truncate(body, 200))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func truncate(body []byte, n int) string {
+	if len(body) > n {
+		return string(body[:n]) + "..."
+	}
+	return string(body)
+}
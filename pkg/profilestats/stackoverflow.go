@@ -0,0 +1,62 @@
+package profilestats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StackOverflow reads the reputation and badges of a Stack Overflow user
+type StackOverflow struct {
+	client *http.Client
+	key    string
+}
+
+// NewStackOverflow creates the Stack Overflow provider. The Stack Exchange
+// API key is optional and only raises the daily quota.
+func NewStackOverflow(client *http.Client, key string) *StackOverflow {
+	return &StackOverflow{client: client, key: key}
+}
+
+func (s *StackOverflow) Name() string {
+	return "stackoverflow"
+}
+
+// Fetch reads the user with the numeric ID account
+func (s *StackOverflow) Fetch(ctx context.Context, account string) (*Stats, error) {
+	query := url.Values{"site": {"stackoverflow"}}
+	if s.key != "" {
+		query.Set("key", s.key)
+	}
+
+	var result struct {
+		Items []struct {
+			Link        string `json:"link"`
+			Reputation  int64  `json:"reputation"`
+			BadgeCounts struct {
+				Gold   int64 `json:"gold"`
+				Silver int64 `json:"silver"`
+				Bronze int64 `json:"bronze"`
+			} `json:"badge_counts"`
+		} `json:"items"`
+	}
+	apiURL := "https://api.stackexchange.com/2.3/users/" + url.PathEscape(account) + "?" + query.Encode()
+	if err := getJSON(ctx, s.client, apiURL, nil, &result); err != nil {
+		return nil, fmt.Errorf("stackoverflow: %w", err)
+	}
+	if len(result.Items) == 0 {
+		return nil, fmt.Errorf("stackoverflow: user %s not found", account)
+	}
+
+	user := result.Items[0]
+	return &Stats{
+		ProfileURL: user.Link,
+		Metrics: map[string]int64{
+			"reputation":    user.Reputation,
+			"gold_badges":   user.BadgeCounts.Gold,
+			"silver_badges": user.BadgeCounts.Silver,
+			"bronze_badges": user.BadgeCounts.Bronze,
+		},
+	}, nil
+}
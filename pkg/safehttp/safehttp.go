@@ -0,0 +1,97 @@
+// Package safehttp builds HTTP clients for requesting URLs that come from
+// strangers. They refuse to connect to private, loopback and other
+// non-public addresses, so such URLs cannot reach internal services.
+package safehttp
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// maxRedirects bounds the redirects followed by a request
+const maxRedirects = 5
+
+// nonPublicNetworks are the ranges not reachable from the internet that the
+// net.IP predicates used by IsPublic do not cover
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "this network"
+	"100.64.0.0/10", // carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // benchmarking
+	"240.0.0.0/4",   // reserved, including broadcast
+)
+
+// NewClient creates a client with a per-request timeout that only connects
+// to public addresses and follows redirects to http(s) URLs only. The
+// address is checked when connecting, after DNS resolution, so a hostname
+// resolving to a private address is refused too.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublic(ip) {
+				return fmt.Errorf("safehttp: refusing to connect to %s", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("safehttp: too many redirects")
+			}
+			if !ValidURL(req.URL) {
+				return fmt.Errorf("safehttp: redirect to invalid URL %q", req.URL.String())
+			}
+			return nil
+		},
+	}
+}
+
+// ValidURL reports whether u is an absolute http(s) URL
+func ValidURL(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// IsPublic reports whether ip is reachable from the internet
+func IsPublic(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
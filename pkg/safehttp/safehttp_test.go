@@ -0,0 +1,80 @@
+package safehttp
+
+import (
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"1.1.1.1", true},
+		{"2606:4700:4700::1111", true},
+		{"100.63.255.255", true},
+		{"100.128.0.0", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"0.1.2.3", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"100.127.255.255", false},
+		{"192.0.0.8", false},
+		{"198.18.0.1", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"224.0.0.1", false},
+		{"ff02::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:100.64.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			ip := net.ParseIP(tt.ip)
+			if ip == nil {
+				t.Fatalf("invalid test IP %q", tt.ip)
+			}
+			if got := IsPublic(ip); got != tt.want {
+				t.Errorf("IsPublic(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://example.com/page", true},
+		{"http://example.com", true},
+		{"ftp://example.com", false},
+		{"file:///etc/passwd", false},
+		{"gopher://example.com", false},
+		{"/relative/path", false},
+		{"https://", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("invalid test URL %q: %v", tt.url, err)
+			}
+			if got := ValidURL(u); got != tt.want {
+				t.Errorf("ValidURL(%s) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
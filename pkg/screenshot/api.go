@@ -0,0 +1,66 @@
+package screenshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxScreenshotSize bounds the response read from a screenshot API
+const maxScreenshotSize = 20 << 20
+
+// apiCapturer fetches screenshots from an external screenshot service
+type apiCapturer struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+func newAPICapturer(cfg Config) *apiCapturer {
+	return &apiCapturer{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (c *apiCapturer) Capture(ctx context.Context, pageURL string) ([]byte, string, error) {
+	if err := validateURL(pageURL); err != nil {
+		return nil, "", err
+	}
+
+	endpoint := strings.NewReplacer(
+		"{url}", url.QueryEscape(pageURL),
+		"{width}", strconv.Itoa(c.cfg.Width),
+		"{height}", strconv.Itoa(c.cfg.Height),
+	).Replace(c.cfg.APIURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("screenshot: failed to build request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("screenshot: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("screenshot: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxScreenshotSize))
+	if err != nil {
+		return nil, "", fmt.Errorf("screenshot: failed to read response: %w", err)
+	}
+
+	contentType := http.DetectContentType(data)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		return nil, "", fmt.Errorf("screenshot: unexpected content type %s", contentType)
+	}
+
+	return data, contentType, nil
+}
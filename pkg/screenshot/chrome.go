@@ -0,0 +1,54 @@
+package screenshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// chromeCapturer captures screenshots with a local headless Chrome binary
+type chromeCapturer struct {
+	cfg Config
+}
+
+func newChromeCapturer(cfg Config) *chromeCapturer {
+	return &chromeCapturer{cfg: cfg}
+}
+
+func (c *chromeCapturer) Capture(ctx context.Context, pageURL string) ([]byte, string, error) {
+	if err := validateURL(pageURL); err != nil {
+		return nil, "", err
+	}
+
+	dir, err := os.MkdirTemp("", "screenshot-*")
+	if err != nil {
+		return nil, "", fmt.Errorf("screenshot: failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	output := filepath.Join(dir, "screenshot.png")
+	cmd := exec.CommandContext(ctx, c.cfg.ChromePath,
+		"--headless=new",
+		"--disable-gpu",
+		"--no-sandbox",
+		"--hide-scrollbars",
+		"--user-data-dir="+filepath.Join(dir, "profile"),
+		fmt.Sprintf("--window-size=%d,%d", c.cfg.Width, c.cfg.Height),
+		"--screenshot="+output,
+		pageURL,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, "", fmt.Errorf("screenshot: chrome failed: %w: %s", err, out)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		return nil, "", fmt.Errorf("screenshot: failed to read capture: %w", err)
+	}
+	return data, "image/png", nil
+}
@@ -0,0 +1,80 @@
+// Package screenshot captures rendered screenshots of web pages
+package screenshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ErrDisabled is returned by the capturer used when no provider is configured
+var ErrDisabled = errors.New("screenshot capture is disabled")
+
+// Capturer renders a web page and returns the screenshot as a PNG or JPEG
+type Capturer interface {
+	Capture(ctx context.Context, pageURL string) (data []byte, contentType string, err error)
+}
+
+// Config selects and configures a screenshot provider
+type Config struct {
+	// Provider is "api", "chrome" or "none"
+	Provider string
+
+	// APIURL is the screenshot API endpoint used by the api provider. The
+	// placeholders {url}, {width} and {height} are substituted per request.
+	APIURL string
+
+	// ChromePath is the headless Chrome or Chromium binary used by the chrome provider
+	ChromePath string
+
+	Width   int
+	Height  int
+	Timeout time.Duration
+}
+
+// NewCapturer creates the capturer for the configured provider
+func NewCapturer(cfg Config) (Capturer, error) {
+	if cfg.Width <= 0 {
+		cfg.Width = 1280
+	}
+	if cfg.Height <= 0 {
+		cfg.Height = 800
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+
+	switch cfg.Provider {
+	case "", "none":
+		return disabled{}, nil
+	case "api":
+		if cfg.APIURL == "" {
+			return nil, fmt.Errorf("screenshot API URL is required for the api provider")
+		}
+		return newAPICapturer(cfg), nil
+	case "chrome":
+		if cfg.ChromePath == "" {
+			cfg.ChromePath = "chromium"
+		}
+		return newChromeCapturer(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown screenshot provider %q", cfg.Provider)
+	}
+}
+
+// validateURL only allows absolute http(s) URLs to be captured
+func validateURL(pageURL string) error {
+	u, err := url.Parse(pageURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid page URL %q", pageURL)
+	}
+	return nil
+}
+
+type disabled struct{}
+
+func (disabled) Capture(ctx context.Context, pageURL string) ([]byte, string, error) {
+	return nil, "", ErrDisabled
+}
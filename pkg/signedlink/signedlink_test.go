@@ -0,0 +1,42 @@
+package signedlink
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	signer := New([]byte("secret"), "test")
+	expires, signature := signer.Sign(time.Now().Add(time.Hour), "a", "b")
+	expired, expiredSignature := signer.Sign(time.Now().Add(-time.Second), "a", "b")
+
+	tests := []struct {
+		name      string
+		signer    *Signer
+		expires   string
+		signature string
+		parts     []string
+		want      bool
+	}{
+		{"valid", signer, expires, signature, []string{"a", "b"}, true},
+		{"expired", signer, expired, expiredSignature, []string{"a", "b"}, false},
+		{"tampered signature", signer, expires, signature[:len(signature)-1] + "0", []string{"a", "b"}, false},
+		{"tampered expiry", signer, expired, signature, []string{"a", "b"}, false},
+		{"extended expiry", signer, "99999999999", signature, []string{"a", "b"}, false},
+		{"malformed expiry", signer, "soon", signature, []string{"a", "b"}, false},
+		{"other parts", signer, expires, signature, []string{"a", "c"}, false},
+		{"parts joined differently", signer, expires, signature, []string{"a|b"}, false},
+		{"missing parts", signer, expires, signature, []string{"a"}, false},
+		{"other purpose", New([]byte("secret"), "other"), expires, signature, []string{"a", "b"}, false},
+		{"other secret", New([]byte("other"), "test"), expires, signature, []string{"a", "b"}, false},
+		{"empty signature", signer, expires, "", []string{"a", "b"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.signer.Verify(tt.expires, tt.signature, tt.parts...); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Package slowcall logs and counts operations exceeding a latency threshold
+package slowcall
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/logger"
+)
+
+// Stat aggregates the slow calls of one operation
+type Stat struct {
+	Kind      string        `json:"kind" example:"query"`
+	Operation string        `json:"operation" example:"GET tech_stack"`
+	Count     int64         `json:"count" example:"12"`
+	Slowest   time.Duration `json:"slowest_ns" swaggertype:"integer" example:"812000000"`
+	Total     time.Duration `json:"total_ns" swaggertype:"integer" example:"5400000000"`
+	// LastDetails are the details of the most recent slow call, such as the
+	// query filters involved
+	LastDetails map[string]string `json:"last_details,omitempty"`
+	LastSeenAt  time.Time         `json:"last_seen_at"`
+}
+
+// Tracker logs the calls of one kind that take longer than its threshold
+// and keeps per operation counts of them
+type Tracker struct {
+	kind      string
+	threshold time.Duration
+	logger    *logger.Logger
+
+	mu    sync.Mutex
+	stats map[string]*Stat
+}
+
+// NewTracker creates a tracker of kind, such as "query" or "request". A
+// threshold of zero or less disables it.
+func NewTracker(kind string, threshold time.Duration, logger *logger.Logger) *Tracker {
+	return &Tracker{
+		kind:      kind,
+		threshold: threshold,
+		logger:    logger,
+		stats:     map[string]*Stat{},
+	}
+}
+
+// Threshold returns the latency above which calls are slow
+func (t *Tracker) Threshold() time.Duration {
+	return t.threshold
+}
+
+// Observe records a call of operation that took elapsed, logging it with
+// details when it is slow. It reports whether the call was slow.
+func (t *Tracker) Observe(operation string, elapsed time.Duration, details map[string]string) bool {
+	if t == nil || t.threshold <= 0 || elapsed < t.threshold {
+		return false
+	}
+
+	t.mu.Lock()
+	stat, ok := t.stats[operation]
+	if !ok {
+		stat = &Stat{Kind: t.kind, Operation: operation}
+		t.stats[operation] = stat
+	}
+	stat.Count++
+	stat.Total += elapsed
+	stat.Slowest = max(stat.Slowest, elapsed)
+	stat.LastDetails = details
+	stat.LastSeenAt = time.Now().UTC()
+	t.mu.Unlock()
+
+	args := []any{"kind", t.kind, "operation", operation, "elapsed", elapsed, "threshold", t.threshold}
+	for key, value := range details {
+		args = append(args, key, value)
+	}
+	t.logger.Warn("Slow call", args...)
+
+	return true
+}
+
+// Stats returns the slow call counts, most frequent first
+func (t *Tracker) Stats() []Stat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := make([]Stat, 0, len(t.stats))
+	for _, stat := range t.stats {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Operation < stats[j].Operation
+	})
+	return stats
+}
+
+// Reset clears the counts
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	t.stats = map[string]*Stat{}
+	t.mu.Unlock()
+}
@@ -0,0 +1,246 @@
+// Package snippet fetches code from GitHub gists and repository files
+//
+// References are GitHub URLs as copied from the browser:
+//
+//	https://gist.github.com/user/0123456789abcdef
+//	https://gist.github.com/user/0123456789abcdef#file-main-go
+//	https://github.com/owner/repo/blob/main/cmd/main.go#L10-L40
+package snippet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxFileSize bounds the content read from GitHub
+const maxFileSize = 1 << 20
+
+var (
+	gistPattern  = regexp.MustCompile(`^/[A-Za-z0-9-]+/([0-9a-fA-F]+)/?$`)
+	blobPattern  = regexp.MustCompile(`^/([A-Za-z0-9-]+)/([A-Za-z0-9._-]+)/blob/([^/]+)/(.+)$`)
+	linesPattern = regexp.MustCompile(`^L(\d+)(?:-L(\d+))?$`)
+)
+
+// Ref points at a gist file or at lines of a repository file
+type Ref struct {
+	// URL is the reference as given, which links to the code on GitHub
+	URL string
+
+	// GistID and GistFile select a gist and, optionally, one of its files
+	// by the anchor GitHub gives it, e.g. "file-main-go"
+	GistID   string
+	GistFile string
+
+	// Owner, Repo, Revision and Path select a repository file; FromLine and
+	// ToLine its lines, both 0 for the whole file
+	Owner    string
+	Repo     string
+	Revision string
+	Path     string
+	FromLine int
+	ToLine   int
+}
+
+// Snippet is code fetched for a reference
+type Snippet struct {
+	URL      string
+	Filename string
+	Code     string
+	// StartLine is the number of the first line of Code in its file
+	StartLine int
+}
+
+// Parse parses a gist or repository file URL
+func Parse(raw string) (*Ref, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme != "https" {
+		return nil, fmt.Errorf("snippet: %q is not a GitHub URL", raw)
+	}
+
+	ref := &Ref{URL: u.String()}
+	switch u.Host {
+	case "gist.github.com":
+		match := gistPattern.FindStringSubmatch(u.Path)
+		if match == nil {
+			return nil, fmt.Errorf("snippet: %q is not a gist URL", raw)
+		}
+		ref.GistID = match[1]
+		ref.GistFile = u.Fragment
+		return ref, nil
+
+	case "github.com":
+		match := blobPattern.FindStringSubmatch(u.Path)
+		if match == nil {
+			return nil, fmt.Errorf("snippet: %q is not a repository file URL", raw)
+		}
+		ref.Owner, ref.Repo, ref.Revision, ref.Path = match[1], match[2], match[3], match[4]
+
+		if u.Fragment != "" {
+			lines := linesPattern.FindStringSubmatch(u.Fragment)
+			if lines == nil {
+				return nil, fmt.Errorf("snippet: %q is not a line range", u.Fragment)
+			}
+			ref.FromLine, _ = strconv.Atoi(lines[1])
+			ref.ToLine = ref.FromLine
+			if lines[2] != "" {
+				ref.ToLine, _ = strconv.Atoi(lines[2])
+			}
+			if ref.FromLine < 1 || ref.ToLine < ref.FromLine {
+				return nil, fmt.Errorf("snippet: invalid line range %q", u.Fragment)
+			}
+		}
+		return ref, nil
+
+	default:
+		return nil, fmt.Errorf("snippet: %q is not a GitHub URL", raw)
+	}
+}
+
+// Client fetches snippets from GitHub
+type Client struct {
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a client. The token is optional and only raises the
+// API rate limit.
+func NewClient(token string, timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+		token:      token,
+	}
+}
+
+// Fetch fetches the code ref points at
+func (c *Client) Fetch(ctx context.Context, ref *Ref) (*Snippet, error) {
+	if ref.GistID != "" {
+		return c.fetchGist(ctx, ref)
+	}
+	return c.fetchFile(ctx, ref)
+}
+
+// fetchGist reads the selected file of a gist, or its first file by name
+func (c *Client) fetchGist(ctx context.Context, ref *Ref) (*Snippet, error) {
+	body, err := c.get(ctx, "https://api.github.com/gists/"+url.PathEscape(ref.GistID), "application/vnd.github+json")
+	if err != nil {
+		return nil, err
+	}
+
+	var gist struct {
+		Files map[string]struct {
+			Filename string `json:"filename"`
+			Content  string `json:"content"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(body, &gist); err != nil {
+		return nil, fmt.Errorf("snippet: failed to decode gist: %w", err)
+	}
+
+	names := make([]string, 0, len(gist.Files))
+	for name := range gist.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if ref.GistFile == "" || ref.GistFile == gistAnchor(name) {
+			return &Snippet{
+				URL:       ref.URL,
+				Filename:  name,
+				Code:      strings.TrimRight(gist.Files[name].Content, "\n"),
+				StartLine: 1,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("snippet: gist %s has no file %q", ref.GistID, ref.GistFile)
+}
+
+// fetchFile reads the selected lines of a repository file
+func (c *Client) fetchFile(ctx context.Context, ref *Ref) (*Snippet, error) {
+	segments := strings.Split(ref.Path, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	rawURL := fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s",
+		url.PathEscape(ref.Owner), url.PathEscape(ref.Repo), url.PathEscape(ref.Revision), strings.Join(segments, "/"))
+	body, err := c.get(ctx, rawURL, "text/plain")
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(body), "\n"), "\n")
+	from, to := 1, len(lines)
+	if ref.FromLine > 0 {
+		if ref.FromLine > len(lines) {
+			return nil, fmt.Errorf("snippet: %s has only %d lines", ref.Path, len(lines))
+		}
+		from, to = ref.FromLine, min(ref.ToLine, len(lines))
+	}
+
+	name := ref.Path
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return &Snippet{
+		URL:       ref.URL,
+		Filename:  name,
+		Code:      strings.Join(lines[from-1:to], "\n"),
+		StartLine: from,
+	}, nil
+}
+
+func (c *Client) get(ctx context.Context, target, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("snippet: failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", "itsrama-snippet/1.0")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("snippet: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("snippet: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("snippet: failed to read response: %w", err)
+	}
+	return body, nil
+}
+
+// gistAnchor is the anchor GitHub links a gist file with, e.g.
+// "file-main-go" for main.go
+func gistAnchor(filename string) string {
+	var b strings.Builder
+	b.WriteString("file-")
+	for _, r := range strings.ToLower(filename) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	return b.String()
+}
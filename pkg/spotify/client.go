@@ -0,0 +1,224 @@
+// Package spotify reads the listening activity of a single Spotify account
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Track is a song as returned by the Web API
+type Track struct {
+	Name         string   `json:"name"`
+	DurationMs   int      `json:"duration_ms"`
+	Artists      []Artist `json:"artists"`
+	Album        Album    `json:"album"`
+	ExternalURLs struct {
+		Spotify string `json:"spotify"`
+	} `json:"external_urls"`
+}
+
+// Artist credited on a track
+type Artist struct {
+	Name string `json:"name"`
+}
+
+// Album a track belongs to
+type Album struct {
+	Name   string  `json:"name"`
+	Images []Image `json:"images"`
+}
+
+// Image is album artwork; the Web API lists the largest first
+type Image struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Playback is the track currently loaded in the player
+type Playback struct {
+	IsPlaying  bool   `json:"is_playing"`
+	ProgressMs int    `json:"progress_ms"`
+	Item       *Track `json:"item"`
+}
+
+// PlayHistory is a track that finished playing
+type PlayHistory struct {
+	Track    Track     `json:"track"`
+	PlayedAt time.Time `json:"played_at"`
+}
+
+// Client is a minimal Web API client authorized by a long-lived refresh
+// token. Access tokens are refreshed on demand and reused until they expire.
+type Client struct {
+	clientID     string
+	clientSecret string
+	refreshToken string
+	accountsURL  string
+	apiURL       string
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewClient creates a client for the account that issued refreshToken
+func NewClient(clientID, clientSecret, refreshToken string, timeout time.Duration) (*Client, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("spotify client ID and secret are required")
+	}
+	if refreshToken == "" {
+		return nil, fmt.Errorf("spotify refresh token is required")
+	}
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Client{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		refreshToken: refreshToken,
+		accountsURL:  "https://accounts.spotify.com",
+		apiURL:       "https://api.spotify.com/v1",
+		httpClient:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// CurrentlyPlaying returns the track loaded in the player, or nil when
+// nothing is loaded or the player is busy with something other than a track
+func (c *Client) CurrentlyPlaying(ctx context.Context) (*Playback, error) {
+	var playback Playback
+	found, err := c.get(ctx, "/me/player/currently-playing", &playback)
+	if err != nil {
+		return nil, err
+	}
+	if !found || playback.Item == nil {
+		return nil, nil
+	}
+	return &playback, nil
+}
+
+// LastPlayed returns the most recently finished track, or nil if none
+func (c *Client) LastPlayed(ctx context.Context) (*PlayHistory, error) {
+	var history struct {
+		Items []PlayHistory `json:"items"`
+	}
+	if _, err := c.get(ctx, "/me/player/recently-played?limit=1", &history); err != nil {
+		return nil, err
+	}
+	if len(history.Items) == 0 {
+		return nil, nil
+	}
+	return &history.Items[0], nil
+}
+
+// get calls a Web API endpoint and decodes its response into out. It
+// reports false when the endpoint answered 204 No Content.
+func (c *Client) get(ctx context.Context, path string, out interface{}) (bool, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL+path, nil)
+	if err != nil {
+		return false, fmt.Errorf("spotify: failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("spotify: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return false, fmt.Errorf("spotify: failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// Force a refresh on the next call in case the token was revoked early
+		c.mu.Lock()
+		c.accessToken = ""
+		c.mu.Unlock()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("spotify: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return false, fmt.Errorf("spotify: failed to decode response: %w", err)
+	}
+	return true, nil
+}
+
+// token returns a valid access token, exchanging the refresh token when the
+// current one is missing or about to expire
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && time.Now().Add(time.Minute).Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {c.refreshToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.accountsURL+"/api/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("spotify: failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.clientID, c.clientSecret)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("spotify: token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("spotify: failed to read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("spotify: token refresh failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		ExpiresIn    int    `json:"expires_in"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("spotify: failed to decode token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("spotify: token response has no access token")
+	}
+
+	c.accessToken = result.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+
+	// Spotify may rotate the refresh token
+	if result.RefreshToken != "" {
+		c.refreshToken = result.RefreshToken
+	}
+
+	return c.accessToken, nil
+}
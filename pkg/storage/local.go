@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalConfig configures storage on the local disk
+type LocalConfig struct {
+	// Dir is the directory files are written below
+	Dir string
+	// BaseURL is the URL Dir is served from
+	BaseURL       string
+	DefaultFolder string
+	Limits        Limits
+}
+
+// LocalStorage keeps files on the local disk, for development without an
+// object store
+type LocalStorage struct {
+	config LocalConfig
+}
+
+// NewLocalStorage creates the directory files are stored in
+func NewLocalStorage(cfg LocalConfig) (*LocalStorage, error) {
+	if cfg.Dir == "" {
+		cfg.Dir = "./uploads"
+	}
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("base URL is required")
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalStorage{config: cfg}, nil
+}
+
+func (s *LocalStorage) Upload(ctx context.Context, file *multipart.FileHeader, path string, opts ...FileOptions) (string, error) {
+	if err := s.config.Limits.Check(file); err != nil {
+		return "", err
+	}
+
+	data, err := ReadUpload(file)
+	if err != nil {
+		return "", err
+	}
+
+	return s.UploadBytes(ctx, data, path, file.Header.Get("Content-Type"))
+}
+
+// UploadBytes writes through a temporary file so readers never see partial
+// files
+func (s *LocalStorage) UploadBytes(ctx context.Context, data []byte, path string, contentType string) (string, error) {
+	path = InFolder(s.config.DefaultFolder, path)
+	target, err := s.file(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return "", fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return path, nil
+}
+
+func (s *LocalStorage) Download(ctx context.Context, path string) ([]byte, error) {
+	target, err := s.file(InFolder(s.config.DefaultFolder, path))
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(target)
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, path string) error {
+	target, err := s.file(path)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) GetPublicURL(path string) (string, error) {
+	path = InFolder(s.config.DefaultFolder, path)
+	return s.config.BaseURL + "/" + (&url.URL{Path: strings.TrimLeft(path, "/")}).EscapedPath(), nil
+}
+
+// Check verifies that the storage directory is writable
+func (s *LocalStorage) Check(ctx context.Context) error {
+	tmp, err := os.CreateTemp(s.config.Dir, ".check-*")
+	if err != nil {
+		return fmt.Errorf("storage directory is not writable: %w", err)
+	}
+	tmp.Close()
+	return os.Remove(tmp.Name())
+}
+
+// file maps a storage path to a file below Dir, refusing paths that escape it
+func (s *LocalStorage) file(p string) (string, error) {
+	cleaned := path.Clean("/" + p)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid storage path %q", p)
+	}
+	return filepath.Join(s.config.Dir, filepath.FromSlash(cleaned)), nil
+}
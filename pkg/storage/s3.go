@@ -0,0 +1,287 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3-compatible object store such as Cloudflare R2
+type S3Config struct {
+	// Endpoint is the URL of the S3 API, e.g.
+	// https://<account>.r2.cloudflarestorage.com for R2
+	Endpoint string
+	// Region is "auto" for R2
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicURL is the URL the bucket is publicly served from, such as a
+	// custom domain connected to an R2 bucket
+	PublicURL string
+
+	DefaultFolder string
+	CacheControl  string
+	Limits        Limits
+	Timeout       time.Duration
+}
+
+// S3Storage keeps files in an S3-compatible bucket, addressed path-style
+// and signed with AWS Signature Version 4
+type S3Storage struct {
+	httpClient *http.Client
+	endpoint   *url.URL
+	config     S3Config
+}
+
+// NewS3Storage creates an S3 storage client
+func NewS3Storage(cfg S3Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint is required")
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access key is required")
+	}
+	if cfg.PublicURL == "" {
+		return nil, fmt.Errorf("public URL is required")
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q", cfg.Endpoint)
+	}
+
+	if cfg.Region == "" {
+		cfg.Region = "auto"
+	}
+	if cfg.CacheControl == "" {
+		cfg.CacheControl = "public, max-age=3600, must-revalidate"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+
+	return &S3Storage{
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		endpoint:   endpoint,
+		config:     cfg,
+	}, nil
+}
+
+func (s *S3Storage) Upload(ctx context.Context, file *multipart.FileHeader, path string, opts ...FileOptions) (string, error) {
+	if err := s.config.Limits.Check(file); err != nil {
+		return "", err
+	}
+
+	data, err := ReadUpload(file)
+	if err != nil {
+		return "", err
+	}
+
+	fileOpts := FileOptions{
+		CacheControl: stringPtr(s.config.CacheControl),
+		ContentType:  stringPtr(file.Header.Get("Content-Type")),
+	}
+	if len(opts) > 0 {
+		fileOpts = fileOpts.Merge(opts[0])
+	}
+
+	return s.put(ctx, data, path, fileOpts)
+}
+
+func (s *S3Storage) UploadBytes(ctx context.Context, data []byte, path string, contentType string) (string, error) {
+	return s.put(ctx, data, path, FileOptions{
+		CacheControl: stringPtr(s.config.CacheControl),
+		ContentType:  stringPtr(contentType),
+	})
+}
+
+func (s *S3Storage) Download(ctx context.Context, path string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, InFolder(s.config.DefaultFolder, path), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
+
+func (s *S3Storage) Delete(ctx context.Context, path string) error {
+	resp, err := s.do(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *S3Storage) GetPublicURL(path string) (string, error) {
+	return s.config.PublicURL + "/" + escapeKey(InFolder(s.config.DefaultFolder, path)), nil
+}
+
+// Check verifies that the bucket exists and the credentials can access it
+func (s *S3Storage) Check(ctx context.Context) error {
+	target := *s.endpoint
+	target.Path = strings.TrimRight(s.endpoint.Path, "/") + "/" + s.config.Bucket
+	target.RawPath = strings.TrimRight(s.endpoint.EscapedPath(), "/") + "/" + escapeKey(s.config.Bucket)
+
+	resp, err := s.send(ctx, http.MethodHead, target.String(), nil, nil)
+	if err != nil {
+		return fmt.Errorf("bucket %q is not available: %w", s.config.Bucket, err)
+	}
+	return resp.Body.Close()
+}
+
+// put stores data at path. S3 always replaces existing objects, so the
+// upsert option has no effect.
+func (s *S3Storage) put(ctx context.Context, data []byte, path string, opts FileOptions) (string, error) {
+	path = InFolder(s.config.DefaultFolder, path)
+
+	header := http.Header{}
+	if opts.ContentType != nil && *opts.ContentType != "" {
+		header.Set("Content-Type", *opts.ContentType)
+	}
+	if opts.CacheControl != nil && *opts.CacheControl != "" {
+		header.Set("Cache-Control", *opts.CacheControl)
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, path, data, header)
+	if err != nil {
+		return "", err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// do sends a signed request for the object at key and fails on error statuses
+func (s *S3Storage) do(ctx context.Context, method, key string, body []byte, header http.Header) (*http.Response, error) {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return nil, fmt.Errorf("invalid storage path %q", key)
+	}
+
+	target := *s.endpoint
+	target.Path = strings.TrimRight(s.endpoint.Path, "/") + "/" + s.config.Bucket + "/" + key
+	target.RawPath = strings.TrimRight(s.endpoint.EscapedPath(), "/") + "/" + escapeKey(s.config.Bucket) + "/" + escapeKey(key)
+
+	resp, err := s.send(ctx, method, target.String(), body, header)
+	if err != nil {
+		return nil, fmt.Errorf("storage %s %s failed: %w", method, key, err)
+	}
+	return resp, nil
+}
+
+// send signs and sends a request and fails on error statuses
+func (s *S3Storage) send(ctx context.Context, method, target string, body []byte, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return resp, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req
+func (s *S3Storage) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, strings.ToLower(name))
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// escapeKey percent-encodes an object key as S3 expects, keeping slashes
+func escapeKey(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || c == '/' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
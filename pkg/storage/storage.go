@@ -0,0 +1,135 @@
+// Package storage abstracts the object store uploaded and generated files
+// are kept in, so that services do not depend on a particular provider
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path"
+	"strings"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/imageproc"
+)
+
+// Storage stores files by slash-separated path
+type Storage interface {
+	// Upload stores an uploaded file at path after checking its size and
+	// type, returning the path it was stored at
+	Upload(ctx context.Context, file *multipart.FileHeader, path string, opts ...FileOptions) (string, error)
+	// UploadBytes stores raw content at path, replacing any existing file
+	UploadBytes(ctx context.Context, data []byte, path string, contentType string) (string, error)
+	// Download retrieves the content of a file
+	Download(ctx context.Context, path string) ([]byte, error)
+	// Delete removes a file
+	Delete(ctx context.Context, path string) error
+	// GetPublicURL returns the URL a stored file is served from
+	GetPublicURL(path string) (string, error)
+	// Check verifies that the backend is reachable and its bucket exists
+	Check(ctx context.Context) error
+}
+
+// FileOptions overrides how an uploaded file is stored
+type FileOptions struct {
+	CacheControl *string
+	ContentType  *string
+	Upsert       *bool
+}
+
+// Limits restricts the files accepted by Upload
+type Limits struct {
+	MaxFileSize      int64
+	AllowedFileTypes []string
+}
+
+// DefaultLimits returns the limits used when none are configured
+func DefaultLimits() Limits {
+	return Limits{
+		MaxFileSize: 10 * 1024 * 1024, // 10MB
+		AllowedFileTypes: []string{
+			"image/jpeg", "image/png", "image/webp", "application/pdf",
+		},
+	}
+}
+
+// Check reports whether an uploaded file is within the limits
+func (l Limits) Check(file *multipart.FileHeader) error {
+	if l.MaxFileSize > 0 && file.Size > l.MaxFileSize {
+		return fmt.Errorf("file size %d bytes exceeds maximum limit of %d",
+			file.Size, l.MaxFileSize)
+	}
+
+	fileType := file.Header.Get("Content-Type")
+	if !l.allows(fileType) {
+		return fmt.Errorf("unsupported file type: %s", fileType)
+	}
+
+	return nil
+}
+
+// allows checks if the file type is in the allowed list
+func (l Limits) allows(fileType string) bool {
+	// If no allowed types specified, allow all
+	if len(l.AllowedFileTypes) == 0 {
+		return true
+	}
+
+	fileType = strings.ToLower(strings.TrimSpace(fileType))
+	for _, allowedType := range l.AllowedFileTypes {
+		if strings.ToLower(allowedType) == fileType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge applies the options set in custom over defaults
+func (o FileOptions) Merge(custom FileOptions) FileOptions {
+	if custom.Upsert != nil {
+		o.Upsert = custom.Upsert
+	}
+	if custom.CacheControl != nil {
+		o.CacheControl = custom.CacheControl
+	}
+	if custom.ContentType != nil {
+		o.ContentType = custom.ContentType
+	}
+	return o
+}
+
+// ReadUpload reads the content of an uploaded file. The metadata of images,
+// such as the location a photo was taken at, is removed and photos are
+// turned upright, so that none of it is ever stored.
+func ReadUpload(file *multipart.FileHeader) ([]byte, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+
+	data, err = imageproc.Sanitize(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sanitize uploaded image: %w", err)
+	}
+	return data, nil
+}
+
+// InFolder places p below folder unless it is there already
+func InFolder(folder, p string) string {
+	if !strings.HasPrefix(p, folder) {
+		p = path.Join(folder, p)
+	}
+	return path.Clean(p)
+}
+
+// stringPtr creates a pointer for an optional value
+func stringPtr(s string) *string {
+	return &s
+}
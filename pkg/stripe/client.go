@@ -0,0 +1,165 @@
+// Package stripe creates Stripe Checkout sessions and verifies Stripe
+// webhook events using the REST API directly
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const apiURL = "https://api.stripe.com/v1"
+
+// zeroDecimalCurrencies are charged in whole units rather than cents
+var zeroDecimalCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "JPY": true, "KMF": true,
+	"KRW": true, "MGA": true, "PYG": true, "RWF": true, "UGX": true, "VND": true,
+	"VUV": true, "XAF": true, "XOF": true, "XPF": true,
+}
+
+// CheckoutParams describes a one-off payment to collect with Checkout
+type CheckoutParams struct {
+	// Amount is in major units, such as dollars, and converted for Stripe
+	Amount        float64
+	Currency      string
+	Name          string
+	Description   string
+	CustomerEmail string
+
+	// SuccessURL may contain {CHECKOUT_SESSION_ID}
+	SuccessURL string
+	CancelURL  string
+
+	// ClientReferenceID and Metadata are echoed back in webhook events
+	ClientReferenceID string
+	Metadata          map[string]string
+}
+
+// CheckoutSession is the subset of a Stripe Checkout session used here
+type CheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	Status            string            `json:"status"`
+	PaymentStatus     string            `json:"payment_status"`
+	PaymentIntent     string            `json:"payment_intent"`
+	AmountTotal       int64             `json:"amount_total"`
+	Currency          string            `json:"currency"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	Metadata          map[string]string `json:"metadata"`
+	ExpiresAt         int64             `json:"expires_at"`
+}
+
+// Client calls the Stripe API with a secret key
+type Client struct {
+	secretKey  string
+	httpClient *http.Client
+}
+
+// NewClient creates a client authenticating with secretKey
+func NewClient(secretKey string, timeout time.Duration) (*Client, error) {
+	if secretKey == "" {
+		return nil, fmt.Errorf("stripe secret key is required")
+	}
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+
+	return &Client{
+		secretKey:  secretKey,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// CreateCheckoutSession creates a hosted Checkout page collecting a single payment
+func (c *Client) CreateCheckoutSession(ctx context.Context, params CheckoutParams) (*CheckoutSession, error) {
+	currency := strings.ToUpper(params.Currency)
+
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("success_url", params.SuccessURL)
+	form.Set("cancel_url", params.CancelURL)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", strings.ToLower(currency))
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(ToMinorUnits(params.Amount, currency), 10))
+	form.Set("line_items[0][price_data][product_data][name]", params.Name)
+	if params.Description != "" {
+		form.Set("line_items[0][price_data][product_data][description]", params.Description)
+	}
+	if params.CustomerEmail != "" {
+		form.Set("customer_email", params.CustomerEmail)
+	}
+	if params.ClientReferenceID != "" {
+		form.Set("client_reference_id", params.ClientReferenceID)
+	}
+	for key, value := range params.Metadata {
+		form.Set("metadata["+key+"]", value)
+		form.Set("payment_intent_data[metadata]["+key+"]", value)
+	}
+
+	var session CheckoutSession
+	if err := c.post(ctx, "/checkout/sessions", form, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ToMinorUnits converts amount to the smallest unit of currency
+func ToMinorUnits(amount float64, currency string) int64 {
+	if zeroDecimalCurrencies[strings.ToUpper(currency)] {
+		return int64(math.Round(amount))
+	}
+	return int64(math.Round(amount * 100))
+}
+
+// FromMinorUnits converts an amount in the smallest unit of currency back
+// to major units
+func FromMinorUnits(amount int64, currency string) float64 {
+	if zeroDecimalCurrencies[strings.ToUpper(currency)] {
+		return float64(amount)
+	}
+	return float64(amount) / 100
+}
+
+func (c *Client) post(ctx context.Context, path string, form url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("stripe: failed to build request: %w", err)
+	}
+	req.SetBasicAuth(c.secretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("stripe: failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("stripe: %s (status %d)", apiErr.Error.Message, resp.StatusCode)
+		}
+		return fmt.Errorf("stripe: unexpected status %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("stripe: failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook event types handled for Checkout payments
+const (
+	EventCheckoutCompleted             = "checkout.session.completed"
+	EventCheckoutAsyncPaymentSucceeded = "checkout.session.async_payment_succeeded"
+	EventCheckoutAsyncPaymentFailed    = "checkout.session.async_payment_failed"
+	EventCheckoutExpired               = "checkout.session.expired"
+)
+
+// DefaultTolerance is how old a webhook signature may be before it is
+// rejected as a possible replay
+const DefaultTolerance = 5 * time.Minute
+
+// Event is a webhook event
+type Event struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// CheckoutSession decodes the Checkout session an event is about
+func (e *Event) CheckoutSession() (*CheckoutSession, error) {
+	var session CheckoutSession
+	if err := json.Unmarshal(e.Data.Object, &session); err != nil {
+		return nil, fmt.Errorf("stripe: failed to decode checkout session: %w", err)
+	}
+	return &session, nil
+}
+
+// ConstructEvent verifies the Stripe-Signature header of a webhook payload
+// against the endpoint secret and decodes the event
+func ConstructEvent(payload []byte, header, secret string, tolerance time.Duration) (*Event, error) {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return nil, fmt.Errorf("stripe: malformed signature header")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("stripe: malformed signature timestamp")
+	}
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)) > tolerance {
+		return nil, fmt.Errorf("stripe: signature timestamp is too old")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	valid := false
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("stripe: signature mismatch")
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("stripe: failed to decode event: %w", err)
+	}
+	return &event, nil
+}
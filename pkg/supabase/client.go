@@ -3,6 +3,7 @@ package supabase
 import (
 	"fmt"
 
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
 	"github.com/supabase-community/supabase-go"
 )
 
@@ -10,6 +11,11 @@ type SupabaseClientConfig struct {
 	ProjectID string
 	ApiSecret string
 	Schema    string
+	// URL overrides the project URL derived from ProjectID, e.g. to use a
+	// local Supabase stack
+	URL string
+	// SlowQueries, when set, is told about every PostgREST call
+	SlowQueries *slowcall.Tracker
 }
 
 type SupabaseClient struct {
@@ -17,16 +23,24 @@ type SupabaseClient struct {
 }
 
 func NewSupabaseClient(cfg SupabaseClientConfig) (*SupabaseClient, error) {
-	if cfg.ApiSecret == "" || cfg.ProjectID == "" {
+	if cfg.ApiSecret == "" || (cfg.ProjectID == "" && cfg.URL == "") {
 		return nil, fmt.Errorf("supabase API key & project ID cannot be empty")
 	}
 
-	client, err := supabase.NewClient(fmt.Sprintf("https://%s.supabase.co", cfg.ProjectID), cfg.ApiSecret, &supabase.ClientOptions{
+	url := cfg.URL
+	if url == "" {
+		url = fmt.Sprintf("https://%s.supabase.co", cfg.ProjectID)
+	}
+
+	client, err := supabase.NewClient(url, cfg.ApiSecret, &supabase.ClientOptions{
 		Schema: cfg.Schema,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Supabase client: %v", err)
 	}
+	if cfg.SlowQueries != nil && !observeQueries(client, cfg.SlowQueries) {
+		return nil, fmt.Errorf("failed to instrument Supabase client for slow queries")
+	}
 
 	return &SupabaseClient{
 		client: client,
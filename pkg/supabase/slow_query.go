@@ -0,0 +1,70 @@
+package supabase
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/slowcall"
+	postgrest "github.com/supabase-community/postgrest-go"
+	"github.com/supabase-community/supabase-go"
+)
+
+// restPath prefixes the PostgREST endpoints of a Supabase project
+const restPath = "/rest/v1/"
+
+// slowQueryTransport times the PostgREST calls and reports the slow ones
+type slowQueryTransport struct {
+	next    http.RoundTripper
+	tracker *slowcall.Tracker
+}
+
+func (t *slowQueryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	if elapsed >= t.tracker.Threshold() {
+		table := req.URL.Path
+		if i := strings.Index(table, restPath); i >= 0 {
+			table = table[i+len(restPath):]
+		}
+
+		details := map[string]string{"table": table}
+		if filters, unescapeErr := url.QueryUnescape(req.URL.RawQuery); unescapeErr == nil && filters != "" {
+			details["filters"] = filters
+		}
+		if resp != nil {
+			details["status"] = strconv.Itoa(resp.StatusCode)
+		}
+		t.tracker.Observe(req.Method+" "+table, elapsed, details)
+	}
+
+	return resp, err
+}
+
+// observeQueries routes the PostgREST calls of client through a transport
+// reporting the slow ones to tracker. supabase-go keeps its PostgREST client
+// private, so it is reached by reflection; the PostgREST transport hands
+// requests to Parent when set, and to http.DefaultTransport otherwise.
+func observeQueries(client *supabase.Client, tracker *slowcall.Tracker) bool {
+	rest := reflect.ValueOf(client).Elem().FieldByName("rest")
+	if !rest.IsValid() || rest.Type() != reflect.TypeOf((*postgrest.Client)(nil)) || rest.IsNil() {
+		return false
+	}
+
+	restClient := (*postgrest.Client)(rest.UnsafePointer())
+	if restClient.Transport == nil {
+		return false
+	}
+
+	next := restClient.Transport.Parent
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	restClient.Transport.Parent = &slowQueryTransport{next: next, tracker: tracker}
+	return true
+}
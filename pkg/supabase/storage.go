@@ -1,13 +1,13 @@
 package supabase
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"log"
 	"mime/multipart"
-	"path/filepath"
 	"strings"
 
+	"github.com/holycann/itsrama-portfolio-backend/pkg/storage"
 	storage_go "github.com/supabase-community/storage-go"
 )
 
@@ -36,6 +36,8 @@ type SupabaseStorage struct {
 	Config StorageConfig
 }
 
+var _ storage.Storage = (*SupabaseStorage)(nil)
+
 // NewSupabaseStorage creates an enhanced Supabase storage client with robust configuration
 func NewSupabaseStorage(cfg StorageConfig) (*SupabaseStorage, error) {
 	// Validate required configuration parameters
@@ -51,16 +53,13 @@ func NewSupabaseStorage(cfg StorageConfig) (*SupabaseStorage, error) {
 		cfg.Headers = make(map[string]string)
 	}
 
-	// Set default max file size if not specified
+	// Set default limits if not specified
+	defaults := storage.DefaultLimits()
 	if cfg.MaxFileSize <= 0 {
-		cfg.MaxFileSize = 10 * 1024 * 1024 // 10MB default
+		cfg.MaxFileSize = defaults.MaxFileSize
 	}
-
-	// Set default allowed file types if not specified
 	if len(cfg.AllowedFileTypes) == 0 {
-		cfg.AllowedFileTypes = []string{
-			"image/jpeg", "image/png", "image/webp", "application/pdf",
-		}
+		cfg.AllowedFileTypes = defaults.AllowedFileTypes
 	}
 
 	// Set default cache control if not specified
@@ -90,36 +89,26 @@ func (s *SupabaseStorage) Upload(
 	ctx context.Context,
 	file *multipart.FileHeader,
 	path string,
-	opts ...storage_go.FileOptions,
+	opts ...storage.FileOptions,
 ) (string, error) {
-	// Validate file size
-	if file.Size > s.Config.MaxFileSize {
-		return "", fmt.Errorf("file size %d bytes exceeds maximum limit of %d",
-			file.Size, s.Config.MaxFileSize)
+	// Validate file size and type
+	limits := storage.Limits{
+		MaxFileSize:      s.Config.MaxFileSize,
+		AllowedFileTypes: s.Config.AllowedFileTypes,
 	}
-
-	// Validate file type
-	fileType := file.Header.Get("Content-Type")
-	if !s.isAllowedFileType(fileType) {
-		return "", fmt.Errorf("unsupported file type: %s", fileType)
+	if err := limits.Check(file); err != nil {
+		return "", err
 	}
+	fileType := file.Header.Get("Content-Type")
 
-	// Open the file
-	src, err := file.Open()
+	// Read the file, removing image metadata
+	data, err := storage.ReadUpload(file)
 	if err != nil {
-		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+		return "", err
 	}
 
-	// Explicitly check and handle Close error
-	defer func() {
-		if closeErr := src.Close(); closeErr != nil {
-			// Log the close error or handle it as needed
-			log.Printf("Failed to close file: %v", closeErr)
-		}
-	}()
-
 	// Prepare file options
-	fileOpts := storage_go.FileOptions{
+	fileOpts := storage.FileOptions{
 		Upsert:       boolPtr(true),
 		CacheControl: stringPtr(s.Config.DefaultCacheControl),
 		ContentType:  stringPtr(fileType),
@@ -127,20 +116,21 @@ func (s *SupabaseStorage) Upload(
 
 	// Merge with any provided options
 	if len(opts) > 0 {
-		fileOpts = mergeFileOptions(fileOpts, opts[0])
+		fileOpts = fileOpts.Merge(opts[0])
 	}
 
-	if !strings.HasPrefix(path, s.Config.DefaultFolder) {
-		path = filepath.Clean(filepath.Join(s.Config.DefaultFolder, path))
-	}
-	path = filepath.ToSlash(path)
+	path = storage.InFolder(s.Config.DefaultFolder, path)
 
 	// Upload file
 	_, err = s.client.UploadFile(
 		s.Config.BucketID,
 		path,
-		src,
-		fileOpts,
+		bytes.NewReader(data),
+		storage_go.FileOptions{
+			Upsert:       fileOpts.Upsert,
+			CacheControl: fileOpts.CacheControl,
+			ContentType:  fileOpts.ContentType,
+		},
 	)
 	if err != nil {
 		return "", err
@@ -148,38 +138,42 @@ func (s *SupabaseStorage) Upload(
 	return path, nil
 }
 
-// mergeFileOptions combines default and custom file options
-func mergeFileOptions(defaultOpts, customOpts storage_go.FileOptions) storage_go.FileOptions {
-	if customOpts.Upsert != nil {
-		defaultOpts.Upsert = customOpts.Upsert
-	}
-	if customOpts.CacheControl != nil {
-		defaultOpts.CacheControl = customOpts.CacheControl
-	}
-	if customOpts.ContentType != nil {
-		defaultOpts.ContentType = customOpts.ContentType
-	}
-	return defaultOpts
-}
+// UploadBytes stores raw content at path, replacing any existing file
+func (s *SupabaseStorage) UploadBytes(
+	ctx context.Context,
+	data []byte,
+	path string,
+	contentType string,
+) (string, error) {
+	path = storage.InFolder(s.Config.DefaultFolder, path)
 
-// isAllowedFileType checks if the file type is in the allowed list
-func (s *SupabaseStorage) isAllowedFileType(fileType string) bool {
-	// If no allowed types specified, allow all
-	if len(s.Config.AllowedFileTypes) == 0 {
-		return true
+	_, err := s.client.UploadFile(
+		s.Config.BucketID,
+		path,
+		bytes.NewReader(data),
+		storage_go.FileOptions{
+			Upsert:       boolPtr(true),
+			CacheControl: stringPtr(s.Config.DefaultCacheControl),
+			ContentType:  stringPtr(contentType),
+		},
+	)
+	if err != nil {
+		return "", err
 	}
+	return path, nil
+}
 
-	// Normalize file type
-	fileType = strings.ToLower(strings.TrimSpace(fileType))
-
-	// Check if file type matches any allowed type
-	for _, allowedType := range s.Config.AllowedFileTypes {
-		if strings.ToLower(allowedType) == fileType {
-			return true
-		}
-	}
+// Download retrieves the content of a file
+func (s *SupabaseStorage) Download(
+	ctx context.Context,
+	path string,
+) ([]byte, error) {
+	path = storage.InFolder(s.Config.DefaultFolder, path)
 
-	return false
+	return s.client.DownloadFile(
+		s.Config.BucketID,
+		path,
+	)
 }
 
 // GetFileType determines the general category of a file based on its MIME type
@@ -211,18 +205,13 @@ func (s *SupabaseStorage) GetFileType(fileType string) string {
 // Delete removes a file from storage
 func (s *SupabaseStorage) Delete(
 	ctx context.Context,
-	filepath string,
-) (string, error) {
+	path string,
+) error {
 	_, err := s.client.RemoveFile(
 		s.Config.BucketID,
-		[]string{filepath},
+		[]string{path},
 	)
-	if err != nil {
-		return "", err
-	}
-
-	// Return success message as a string
-	return "File deleted successfully", nil
+	return err
 }
 
 // ListFiles retrieves files in a specific path
@@ -243,20 +232,23 @@ func (s *SupabaseStorage) ListFiles(
 	)
 }
 
+// Check verifies that the storage bucket exists
+func (s *SupabaseStorage) Check(ctx context.Context) error {
+	if _, err := s.client.GetBucket(s.Config.BucketID); err != nil {
+		return fmt.Errorf("bucket %q is not available: %w", s.Config.BucketID, err)
+	}
+	return nil
+}
+
 // GetPublicURL generates a public URL for a file
 func (s *SupabaseStorage) GetPublicURL(
 	path string,
-	transformOpts ...storage_go.UrlOptions,
 ) (string, error) {
-	if !strings.HasPrefix(path, s.Config.DefaultFolder) {
-		path = filepath.Clean(filepath.Join(s.Config.DefaultFolder, path))
-	}
-	path = filepath.ToSlash(path)
+	path = storage.InFolder(s.Config.DefaultFolder, path)
 
 	resp := s.client.GetPublicUrl(
 		s.Config.BucketID,
 		path,
-		transformOpts...,
 	)
 	return resp.SignedURL, nil
 }
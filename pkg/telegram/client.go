@@ -0,0 +1,131 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Update is an incoming update received from the Bot API
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+// Message is a chat message received from the Bot API
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	Chat      Chat   `json:"chat"`
+	From      *User  `json:"from,omitempty"`
+	Text      string `json:"text"`
+	Date      int64  `json:"date"`
+}
+
+// Chat identifies the conversation a message belongs to
+type Chat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// User is the sender of a message
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username,omitempty"`
+}
+
+// apiResponse is the envelope wrapping every Bot API response
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result"`
+	Description string          `json:"description"`
+}
+
+// Client is a minimal Telegram Bot API client
+type Client struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a Bot API client for the given bot token
+func NewClient(token string) (*Client, error) {
+	if token == "" {
+		return nil, fmt.Errorf("telegram bot token is required")
+	}
+
+	return &Client{
+		token:   token,
+		baseURL: "https://api.telegram.org",
+		// Long polling holds requests open, so the timeout must exceed the poll timeout
+		httpClient: &http.Client{Timeout: 90 * time.Second},
+	}, nil
+}
+
+// GetUpdates long polls for updates newer than offset
+func (c *Client) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	var updates []Update
+	err := c.call(ctx, "getUpdates", map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}, &updates)
+	if err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// SendMessage sends an HTML formatted message to a chat
+func (c *Client) SendMessage(ctx context.Context, chatID int64, text string) error {
+	return c.call(ctx, "sendMessage", map[string]interface{}{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}, nil)
+}
+
+// call invokes a Bot API method and decodes its result into out
+func (c *Client) call(ctx context.Context, method string, payload interface{}, out interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("telegram: failed to encode payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/%s", c.baseURL, c.token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("telegram: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("telegram: %s request failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("telegram: failed to read response: %w", err)
+	}
+
+	var result apiResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("telegram: unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+	if !result.OK {
+		return fmt.Errorf("telegram: %s failed: %s", method, result.Description)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(result.Result, out); err != nil {
+			return fmt.Errorf("telegram: failed to decode %s result: %w", method, err)
+		}
+	}
+	return nil
+}
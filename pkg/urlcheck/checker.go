@@ -0,0 +1,101 @@
+// Package urlcheck verifies that URLs are reachable
+package urlcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Result is the outcome of checking a single URL
+type Result struct {
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+// OK reports whether the URL answered with a 2xx status
+func (r Result) OK() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Checker checks URLs concurrently
+type Checker struct {
+	httpClient  *http.Client
+	userAgent   string
+	concurrency int
+}
+
+// NewChecker creates a checker with a per-request timeout and a bound on
+// the number of concurrent requests
+func NewChecker(timeout time.Duration, concurrency int, userAgent string) *Checker {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	return &Checker{
+		httpClient:  &http.Client{Timeout: timeout},
+		userAgent:   userAgent,
+		concurrency: concurrency,
+	}
+}
+
+// CheckAll checks every URL and returns the results in the same order
+func (c *Checker) CheckAll(ctx context.Context, urls []string) []Result {
+	results := make([]Result, len(urls))
+	sem := make(chan struct{}, c.concurrency)
+
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i] = Result{URL: url, Err: ctx.Err()}
+				return
+			}
+
+			results[i] = c.Check(ctx, url)
+		}(i, url)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Check requests url with HEAD, falling back to GET for servers that do not
+// support HEAD
+func (c *Checker) Check(ctx context.Context, url string) Result {
+	status, err := c.do(ctx, http.MethodHead, url)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented || status == http.StatusForbidden) {
+		status, err = c.do(ctx, http.MethodGet, url)
+	}
+	return Result{URL: url, StatusCode: status, Err: err}
+}
+
+func (c *Checker) do(ctx context.Context, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("invalid URL: %w", err)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,107 @@
+// Package wakatime reads coding activity from the WakaTime API or a
+// compatible server such as Wakapi
+package wakatime
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// dateLayout is the date format used by the summaries endpoint
+const dateLayout = "2006-01-02"
+
+// Summary is the coding activity of a single day
+type Summary struct {
+	Range struct {
+		Date string `json:"date"`
+	} `json:"range"`
+	GrandTotal struct {
+		TotalSeconds float64 `json:"total_seconds"`
+	} `json:"grand_total"`
+	Languages []StatItem `json:"languages"`
+}
+
+// StatItem is the time spent in a language, editor or project
+type StatItem struct {
+	Name         string  `json:"name"`
+	TotalSeconds float64 `json:"total_seconds"`
+	Percent      float64 `json:"percent"`
+}
+
+// Client is a minimal WakaTime API client authorized by an API key
+type Client struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the account owning apiKey. baseURL
+// defaults to the WakaTime API.
+func NewClient(apiKey, baseURL string, timeout time.Duration) (*Client, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("wakatime API key is required")
+	}
+	if baseURL == "" {
+		baseURL = "https://wakatime.com/api/v1"
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &Client{
+		apiKey:     apiKey,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Summaries returns the daily coding activity between start and end, inclusive
+func (c *Client) Summaries(ctx context.Context, start, end time.Time) ([]Summary, error) {
+	query := url.Values{
+		"start": {start.Format(dateLayout)},
+		"end":   {end.Format(dateLayout)},
+	}
+
+	var result struct {
+		Data []Summary `json:"data"`
+	}
+	if err := c.get(ctx, "/users/current/summaries?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// get calls an API endpoint and decodes its response into out
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("wakatime: failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.apiKey)))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("wakatime: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+	if err != nil {
+		return fmt.Errorf("wakatime: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("wakatime: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("wakatime: failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Package webmention verifies received Webmentions and sends Webmentions to
+// the pages linked from local content, as described in
+// https://www.w3.org/TR/webmention/
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/holycann/itsrama-portfolio-backend/pkg/safehttp"
+)
+
+// maxPageSize bounds the part of a page read when verifying or discovering
+const maxPageSize = 1 << 20
+
+// ErrGone is returned when a page answers 404 or 410, meaning a mention
+// from it should be removed
+var ErrGone = errors.New("webmention: page is gone")
+
+// ErrNoEndpoint is returned when a page does not advertise a Webmention endpoint
+var ErrNoEndpoint = errors.New("webmention: no endpoint advertised")
+
+// Page is a fetched web page
+type Page struct {
+	// URL is the final URL after redirects
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+}
+
+// Client fetches pages and sends Webmentions. It refuses to connect to
+// private and loopback addresses, since the URLs it fetches come from
+// strangers.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+// NewClient creates a client with a per-request timeout
+func NewClient(timeout time.Duration, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if userAgent == "" {
+		userAgent = "itsrama-webmention/1.0"
+	}
+
+	return &Client{
+		httpClient: safehttp.NewClient(timeout),
+		userAgent:  userAgent,
+	}
+}
+
+// Fetch retrieves an HTML or text page
+func (c *Client) Fetch(ctx context.Context, pageURL string) (*Page, error) {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("webmention: invalid URL %q", pageURL)
+	}
+	if err := validateURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("webmention: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "text/html, text/plain;q=0.9, */*;q=0.1")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webmention: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return nil, ErrGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("webmention: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		return nil, fmt.Errorf("webmention: failed to read page: %w", err)
+	}
+
+	return &Page{URL: resp.Request.URL, Header: resp.Header, Body: body}, nil
+}
+
+// Discover returns the Webmention endpoint advertised by a page, from its
+// Link header or its HTML
+func (c *Client) Discover(ctx context.Context, target string) (string, error) {
+	page, err := c.Fetch(ctx, target)
+	if err != nil {
+		return "", err
+	}
+
+	if endpoint, ok := endpointFromHeader(page.Header.Values("Link"), page.URL); ok {
+		return endpoint, nil
+	}
+	if isHTML(page.Header) {
+		if endpoint, ok := endpointFromHTML(page.Body, page.URL); ok {
+			return endpoint, nil
+		}
+	}
+	return "", ErrNoEndpoint
+}
+
+// Send notifies endpoint that source links to target and returns the status
+// the endpoint answered with
+func (c *Client) Send(ctx context.Context, endpoint, source, target string) (int, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return 0, fmt.Errorf("webmention: invalid endpoint %q", endpoint)
+	}
+	if err := validateURL(u); err != nil {
+		return 0, err
+	}
+
+	form := url.Values{}
+	form.Set("source", source)
+	form.Set("target", target)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, fmt.Errorf("webmention: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webmention: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxPageSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webmention: endpoint answered %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// validateURL only allows absolute http(s) URLs
+func validateURL(u *url.URL) error {
+	if !safehttp.ValidURL(u) {
+		return fmt.Errorf("webmention: invalid URL %q", u.String())
+	}
+	return nil
+}
+
+func isHTML(header http.Header) bool {
+	contentType := header.Get("Content-Type")
+	return contentType == "" || strings.Contains(contentType, "html")
+}
@@ -0,0 +1,312 @@
+package webmention
+
+import (
+	"bytes"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// linkHeaderPattern matches the URL and parameters of one Link header value
+var linkHeaderPattern = regexp.MustCompile(`<([^>]*)>\s*((?:;\s*[^;,]+)*)`)
+
+// relPattern matches a rel parameter of a Link header value
+var relPattern = regexp.MustCompile(`(?i)rel\s*=\s*"?([^";]+)"?`)
+
+// Entry is what is known about the page a mention comes from
+type Entry struct {
+	Title      string
+	AuthorName string
+	AuthorURL  string
+	Excerpt    string
+}
+
+// endpointFromHeader returns the first Link header URL with rel webmention
+func endpointFromHeader(values []string, base *url.URL) (string, bool) {
+	for _, value := range values {
+		for _, match := range linkHeaderPattern.FindAllStringSubmatch(value, -1) {
+			rel := relPattern.FindStringSubmatch(match[2])
+			if rel == nil || !hasRel(rel[1], "webmention") {
+				continue
+			}
+			if endpoint, ok := resolve(base, match[1]); ok {
+				return endpoint, true
+			}
+		}
+	}
+	return "", false
+}
+
+// endpointFromHTML returns the first <link> or <a> with rel webmention in
+// document order
+func endpointFromHTML(body []byte, base *url.URL) (string, bool) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	var endpoint string
+	var found bool
+	walk(doc, func(n *html.Node) bool {
+		if n.DataAtom != atom.Link && n.DataAtom != atom.A {
+			return true
+		}
+		href, hasHref := attr(n, "href")
+		if !hasHref || !hasRel(attrValue(n, "rel"), "webmention") {
+			return true
+		}
+		endpoint, found = resolve(base, href)
+		return !found
+	})
+	return endpoint, found
+}
+
+// LinksTo reports whether an HTML or text page links to target
+func LinksTo(page *Page, target string) bool {
+	if !isHTML(page.Header) {
+		return bytes.Contains(page.Body, []byte(target))
+	}
+
+	want := normalizeURL(target)
+	for _, link := range Links(page.Body, page.URL) {
+		if normalizeURL(link) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// Links returns the absolute http(s) URLs an HTML document links to
+func Links(body []byte, base *url.URL) []string {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	var links []string
+	seen := make(map[string]bool)
+	walk(doc, func(n *html.Node) bool {
+		var value string
+		var ok bool
+		switch n.DataAtom {
+		case atom.A, atom.Area:
+			value, ok = attr(n, "href")
+		case atom.Img, atom.Video, atom.Audio, atom.Source:
+			value, ok = attr(n, "src")
+		}
+		if !ok {
+			return true
+		}
+
+		link, ok := resolve(base, value)
+		if ok && !seen[link] && (strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://")) {
+			seen[link] = true
+			links = append(links, link)
+		}
+		return true
+	})
+	return links
+}
+
+// ParseEntry reads the title, author and an excerpt of a page, preferring
+// microformats2 h-entry properties
+func ParseEntry(page *Page) Entry {
+	var entry Entry
+	if !isHTML(page.Header) {
+		entry.Excerpt = excerpt(string(page.Body))
+		return entry
+	}
+
+	doc, err := html.Parse(bytes.NewReader(page.Body))
+	if err != nil {
+		return entry
+	}
+
+	var title, metaAuthor, metaDescription string
+	walk(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+
+		switch {
+		case n.DataAtom == atom.Title && title == "":
+			title = text(n)
+		case n.DataAtom == atom.Meta:
+			switch strings.ToLower(attrValue(n, "name")) {
+			case "author":
+				metaAuthor = attrValue(n, "content")
+			case "description":
+				metaDescription = attrValue(n, "content")
+			}
+		}
+
+		classes := strings.Fields(attrValue(n, "class"))
+		switch {
+		case hasClass(classes, "p-name") && entry.Title == "" && !insideAuthor(n):
+			entry.Title = text(n)
+		case hasClass(classes, "p-author") && entry.AuthorName == "":
+			entry.AuthorName, entry.AuthorURL = author(n, page.URL)
+		case (hasClass(classes, "e-content") || hasClass(classes, "p-content") || hasClass(classes, "p-summary")) && entry.Excerpt == "":
+			entry.Excerpt = excerpt(text(n))
+		}
+		return true
+	})
+
+	if entry.Title == "" {
+		entry.Title = strings.TrimSpace(title)
+	}
+	if entry.AuthorName == "" {
+		entry.AuthorName = strings.TrimSpace(metaAuthor)
+	}
+	if entry.Excerpt == "" {
+		entry.Excerpt = excerpt(metaDescription)
+	}
+	entry.Title = truncate(entry.Title, 255)
+	entry.AuthorName = truncate(entry.AuthorName, 255)
+	return entry
+}
+
+// author reads the name and URL of a p-author, which is often an h-card
+func author(n *html.Node, base *url.URL) (string, string) {
+	var name, authorURL string
+	walk(n, func(child *html.Node) bool {
+		classes := strings.Fields(attrValue(child, "class"))
+		if hasClass(classes, "p-name") && name == "" {
+			name = text(child)
+		}
+		if hasClass(classes, "u-url") && authorURL == "" {
+			if href, ok := attr(child, "href"); ok {
+				authorURL, _ = resolve(base, href)
+			}
+		}
+		return true
+	})
+
+	if name == "" {
+		name = text(n)
+	}
+	if authorURL == "" && n.DataAtom == atom.A {
+		if href, ok := attr(n, "href"); ok {
+			authorURL, _ = resolve(base, href)
+		}
+	}
+	return strings.TrimSpace(name), authorURL
+}
+
+// insideAuthor reports whether n is part of a p-author, whose p-name is the
+// author's rather than the entry's
+func insideAuthor(n *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if hasClass(strings.Fields(attrValue(p, "class")), "p-author") {
+			return true
+		}
+	}
+	return false
+}
+
+// walk visits n and its descendants in document order until visit returns false
+func walk(n *html.Node, visit func(*html.Node) bool) bool {
+	if !visit(n) {
+		return false
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if !walk(child, visit) {
+			return false
+		}
+	}
+	return true
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attrValue(n *html.Node, key string) string {
+	value, _ := attr(n, key)
+	return value
+}
+
+func hasRel(rel, want string) bool {
+	for _, value := range strings.Fields(strings.ToLower(rel)) {
+		if value == want {
+			return true
+		}
+	}
+	return false
+}
+
+func hasClass(classes []string, want string) bool {
+	for _, class := range classes {
+		if class == want {
+			return true
+		}
+	}
+	return false
+}
+
+// text returns the text content of n with whitespace collapsed
+func text(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(child *html.Node) bool {
+		if child.Type == html.ElementNode && (child.DataAtom == atom.Script || child.DataAtom == atom.Style) {
+			return true
+		}
+		if child.Type == html.TextNode {
+			b.WriteString(child.Data)
+			b.WriteByte(' ')
+		}
+		return true
+	})
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// resolve makes ref absolute against base, dropping the fragment
+func resolve(base *url.URL, ref string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return "", false
+	}
+	if base != nil {
+		u = base.ResolveReference(u)
+	}
+	u.Fragment = ""
+	return u.String(), true
+}
+
+// normalizeURL makes URLs comparable regardless of a trailing slash and the
+// case of the host
+func normalizeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.Path = strings.TrimRight(u.Path, "/")
+	return u.String()
+}
+
+// excerpt shortens text to a short summary at a word boundary
+func excerpt(s string) string {
+	return truncate(strings.Join(strings.Fields(s), " "), 300)
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	cut := string(runes[:max])
+	if i := strings.LastIndex(cut, " "); i > max/2 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}